@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	activityJSON  bool
+	activitySince string
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Show a chronological feed of recent bean activity",
+	Long: `Shows what happened to beans recently, oldest first: beans created, status
+changes and completions (from each bean's status history), and git commits
+mentioning a bean (when git integration is enabled). The bean in focus (see
+'beans focus') is shown first.
+
+--since accepts a relative duration (30m, 36h, 7d, 2w) or a date (2006-01-02).
+Use --json for machine-readable output, e.g. for standups and reports.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		since, err := parseSince(activitySince, time.Now())
+		if err != nil {
+			return cmdError(activityJSON, output.ErrValidation, "invalid --since: %s", err)
+		}
+
+		resolver := &graph.Resolver{Core: core}
+		events, err := resolver.Query().Activity(context.Background(), since)
+		if err != nil {
+			return cmdError(activityJSON, output.ErrGit, "querying activity: %s", err)
+		}
+
+		if activityJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(events)
+		}
+
+		if focus, _ := core.Focus(); focus != nil {
+			fmt.Println(ui.Bold.Render("Focus") + "  " + ui.ID.Render(focus.ID) + " " + focus.Title + ui.Muted.Render(" ("+focus.Status+")"))
+			fmt.Println()
+		}
+
+		if len(events) == 0 {
+			fmt.Println(ui.Muted.Render("No activity since " + since.Local().Format("2006-01-02 15:04") + "."))
+			return nil
+		}
+
+		var day string
+		for _, e := range events {
+			local := e.Time.Local()
+			if d := local.Format("Mon 2006-01-02"); d != day {
+				if day != "" {
+					fmt.Println()
+				}
+				fmt.Println(ui.Bold.Render(d))
+				day = d
+			}
+			fmt.Printf("  %s  %s %s\n", ui.Muted.Render(local.Format("15:04")), ui.ID.Render(e.BeanID), formatActivity(e))
+		}
+		return nil
+	},
+}
+
+// formatActivity describes an activity event for human-readable output.
+func formatActivity(e *beancore.ActivityEvent) string {
+	switch e.Kind {
+	case beancore.ActivityCreated:
+		return ui.Success.Render("created") + " " + e.Title
+	case beancore.ActivityStatusChanged:
+		return e.Title + " " + ui.Muted.Render(e.From+" → ") + e.Status
+	case beancore.ActivityCompleted:
+		return e.Title + " " + ui.Success.Render("completed")
+	case beancore.ActivityCommit:
+		return ui.Muted.Render(e.Commit.ShortHash) + " " + e.Commit.Subject
+	default:
+		return e.Title
+	}
+}
+
+// parseSince parses a duration or date relative to now. It accepts Go durations
+// (36h, 30m), day and week counts (7d, 2w) and dates (2006-01-02).
+func parseSince(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	if n := len(s); n > 1 && (s[n-1] == 'd' || s[n-1] == 'w') {
+		count, err := strconv.Atoi(s[:n-1])
+		if err == nil && count >= 0 {
+			days := count
+			if s[n-1] == 'w' {
+				days *= 7
+			}
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a duration or date (use e.g. 36h, 7d, 2w or 2006-01-02)", s)
+}
+
+func init() {
+	activityCmd.Flags().BoolVar(&activityJSON, "json", false, "Output as JSON")
+	activityCmd.Flags().StringVar(&activitySince, "since", "7d", "Show activity since a duration ago (36h, 7d, 2w) or a date (2006-01-02)")
+	rootCmd.AddCommand(activityCmd)
+}
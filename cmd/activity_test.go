@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "7d", want: now.AddDate(0, 0, -7)},
+		{in: "2w", want: now.AddDate(0, 0, -14)},
+		{in: "36h", want: now.Add(-36 * time.Hour)},
+		{in: "2025-01-01", want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local)},
+		{in: "yesterday", wantErr: true},
+		{in: "-3d", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseSince(tt.in, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSince(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parseSince(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+//go:embed agents.tmpl
+var agentsTemplate string
+
+// Markers delimiting the section `beans agents init` manages in an
+// instructions file. Everything between them is replaced on each run.
+const (
+	agentsBeginMarker = "<!-- BEGIN BEANS (generated by 'beans agents init', edits will be overwritten) -->"
+	agentsEndMarker   = "<!-- END BEANS -->"
+)
+
+// agentsFiles are the instructions files updated by default: AGENTS.md is
+// always written, CLAUDE.md only if the project already has one.
+var agentsFiles = []string{"AGENTS.md", "CLAUDE.md"}
+
+// agentsData holds the data for the agents template.
+type agentsData struct {
+	Path           string
+	Prefix         string
+	ExampleID      string
+	DefaultStatus  string
+	DefaultType    string
+	Statuses       []config.StatusConfig
+	Types          []config.TypeConfig
+	Priorities     []config.PriorityConfig
+	Tags           []config.TagConfig
+	Required       []agentsRequired
+	RequireIfMatch bool
+}
+
+// agentsRequired describes what beans of one type must have.
+type agentsRequired struct {
+	Type string
+	What string
+}
+
+// agentsResult reports what happened to one instructions file.
+type agentsResult struct {
+	Path   string `json:"path"`
+	Action string `json:"action"` // created, updated or unchanged
+}
+
+var (
+	agentsFilesFlag []string
+	agentsPrint     bool
+	agentsJSON      bool
+)
+
+var agentsCmd = &cobra.Command{
+	Use:   "agents",
+	Short: "Manage instructions for AI coding agents",
+}
+
+var agentsInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write the beans workflow into AGENTS.md/CLAUDE.md",
+	Long: `Writes a section describing this project's bean workflow (ID format, statuses,
+types, priorities, tags, required fields and the common commands) into the
+agent instructions files at the project root. The section is generated from
+.beans.yml, so re-run this after changing the config to keep agents in sync.
+
+By default AGENTS.md is created or updated, and CLAUDE.md is updated if it
+exists. The section sits between BEGIN BEANS/END BEANS markers; only that part
+of the file is rewritten, so the rest of it can be edited freely.
+
+  beans agents init                    # update AGENTS.md (and CLAUDE.md)
+  beans agents init --file CLAUDE.md   # only CLAUDE.md
+  beans agents init --print            # show the section without writing it`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		section, err := renderAgentsSection(cfg)
+		if err != nil {
+			return cmdError(agentsJSON, output.ErrValidation, "failed to render agent instructions: %v", err)
+		}
+		if agentsPrint {
+			fmt.Print(section)
+			return nil
+		}
+
+		root := cfg.ConfigDir()
+		files := agentsFilesFlag
+		if len(files) == 0 {
+			files = []string{agentsFiles[0]}
+			for _, name := range agentsFiles[1:] {
+				if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+					files = append(files, name)
+				}
+			}
+		}
+
+		var results []agentsResult
+		for _, name := range files {
+			path := name
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(root, name)
+			}
+			action, err := writeAgentsSection(path, section)
+			if err != nil {
+				return cmdError(agentsJSON, output.ErrFileError, "failed to update %s: %v", name, err)
+			}
+			results = append(results, agentsResult{Path: name, Action: action})
+		}
+
+		if agentsJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(results)
+		}
+		for _, r := range results {
+			switch r.Action {
+			case "unchanged":
+				fmt.Println(ui.Muted.Render(r.Path + " is up to date"))
+			default:
+				fmt.Println(ui.Success.Render(strings.ToUpper(r.Action[:1])+r.Action[1:]+" ") + ui.Bold.Render(r.Path))
+			}
+		}
+		return nil
+	},
+}
+
+// renderAgentsSection renders the managed section, markers included, from the
+// project config.
+func renderAgentsSection(c *config.Config) (string, error) {
+	tmpl, err := template.New("agents").Parse(agentsTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	path := c.Beans.Path
+	if rel, err := filepath.Rel(c.ConfigDir(), c.ResolveBeansPath()); err == nil && c.ConfigDir() != "" {
+		path = rel
+	}
+	if path == "" {
+		path = config.DefaultBeansPath
+	}
+
+	data := agentsData{
+		Path:           filepath.ToSlash(path),
+		Prefix:         c.Beans.Prefix,
+		ExampleID:      exampleBeanID(c),
+		DefaultStatus:  c.GetDefaultStatus(),
+		DefaultType:    c.GetDefaultType(),
+		Statuses:       config.DefaultStatuses,
+		Types:          config.DefaultTypes,
+		Priorities:     config.DefaultPriorities,
+		Tags:           c.Beans.Tags,
+		RequireIfMatch: c.Beans.RequireIfMatch,
+	}
+	for _, name := range c.TypeNames() {
+		req := c.RequiredFor(name)
+		var what []string
+		if len(req.Fields) > 0 {
+			what = append(what, strings.Join(req.Fields, ", "))
+		}
+		for _, s := range req.Sections {
+			what = append(what, fmt.Sprintf("a %q section", s))
+		}
+		if len(what) > 0 {
+			data.Required = append(data.Required, agentsRequired{Type: name, What: strings.Join(what, ", ")})
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(agentsBeginMarker + "\n")
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	buf.WriteString(agentsEndMarker + "\n")
+	return buf.String(), nil
+}
+
+// exampleBeanID returns a fixed ID in the project's ID format, so the
+// generated section doesn't change between runs.
+func exampleBeanID(c *config.Config) string {
+	length := c.Beans.IDLength
+	if length <= 0 {
+		length = 4
+	}
+	prefix := c.Beans.Prefix
+	if c.Beans.IDUserPrefix {
+		prefix += "<user>-"
+	}
+	switch c.GetIDScheme() {
+	case config.IDSchemeSequential:
+		return bean.NewSequentialID(prefix, length, nil)
+	case config.IDSchemeDate:
+		prefix += "261015"
+	}
+	const sample = "x7k2m9q4p8r3w6t5"
+	if length > len(sample) {
+		return prefix + strings.Repeat("x", length)
+	}
+	return prefix + sample[:length]
+}
+
+// writeAgentsSection puts section into the file at path: it replaces an
+// existing managed section, or appends one (creating the file if needed).
+// Returns "created", "updated" or "unchanged".
+func writeAgentsSection(path, section string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+	action := "updated"
+	if err != nil {
+		action = "created"
+	}
+
+	content, err := upsertAgentsSection(string(data), section)
+	if err != nil {
+		return "", err
+	}
+	if content == string(data) {
+		return "unchanged", nil
+	}
+	return action, os.WriteFile(path, []byte(content), 0644)
+}
+
+// upsertAgentsSection replaces the managed section in content with section,
+// or appends section if content has none.
+func upsertAgentsSection(content, section string) (string, error) {
+	start := strings.Index(content, agentsBeginMarker)
+	if start < 0 {
+		// Match older markers whose explanatory suffix has changed
+		start = strings.Index(content, "<!-- BEGIN BEANS")
+	}
+	if start < 0 {
+		if content == "" {
+			return section, nil
+		}
+		content = strings.TrimRight(content, "\n")
+		return content + "\n\n" + section, nil
+	}
+
+	end := strings.Index(content[start:], agentsEndMarker)
+	if end < 0 {
+		return "", fmt.Errorf("found %q without a matching %q", "BEGIN BEANS", agentsEndMarker)
+	}
+	end += start + len(agentsEndMarker)
+	if end < len(content) && content[end] == '\n' {
+		end++
+	}
+	return content[:start] + section + content[end:], nil
+}
+
+func init() {
+	agentsInitCmd.Flags().StringArrayVar(&agentsFilesFlag, "file", nil, "Instructions file to update, relative to the project root (repeatable)")
+	agentsInitCmd.Flags().BoolVar(&agentsPrint, "print", false, "Print the section instead of writing it")
+	agentsInitCmd.Flags().BoolVar(&agentsJSON, "json", false, "Output as JSON")
+	agentsInitCmd.MarkFlagsMutuallyExclusive("print", "json")
+	agentsCmd.AddCommand(agentsInitCmd)
+	rootCmd.AddCommand(agentsCmd)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestRenderAgentsSection(t *testing.T) {
+	c := config.Default()
+	c.Beans.Prefix = "app-"
+	c.Beans.IDScheme = config.IDSchemeSequential
+	c.Beans.Tags = []config.TagConfig{{Name: "area/api", Description: "Backend"}}
+	c.Beans.Required = map[string]config.RequiredConfig{
+		"bug": {Fields: []string{"priority"}, Sections: []string{"Repro Steps"}},
+	}
+	c.Beans.RequireIfMatch = true
+
+	section, err := renderAgentsSection(c)
+	if err != nil {
+		t.Fatalf("renderAgentsSection() error = %v", err)
+	}
+	for _, want := range []string{
+		agentsBeginMarker,
+		"`app-0001`",
+		"`in-progress`",
+		"`completed` (archived)",
+		"`deferred`",
+		"`area/api` - Backend",
+		"`bug`: priority, a \"Repro Steps\" section",
+		"--if-match",
+		agentsEndMarker + "\n",
+	} {
+		if !strings.Contains(section, want) {
+			t.Errorf("section missing %q:\n%s", want, section)
+		}
+	}
+
+	// Without tags or requirements, those parts are left out
+	plain, err := renderAgentsSection(config.Default())
+	if err != nil {
+		t.Fatalf("renderAgentsSection() error = %v", err)
+	}
+	for _, unwanted := range []string{"**Tags**", "**Required fields**", "--if-match"} {
+		if strings.Contains(plain, unwanted) {
+			t.Errorf("section unexpectedly contains %q", unwanted)
+		}
+	}
+}
+
+func TestUpsertAgentsSection(t *testing.T) {
+	section := agentsBeginMarker + "\nnew\n" + agentsEndMarker + "\n"
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr bool
+	}{
+		{"empty file", "", section, false},
+		{"appends", "# Project\n\nNotes\n", "# Project\n\nNotes\n\n" + section, false},
+		{
+			"replaces in place",
+			"# Project\n\n" + agentsBeginMarker + "\nold\n" + agentsEndMarker + "\n\n## More\n",
+			"# Project\n\n" + section + "\n## More\n",
+			false,
+		},
+		{
+			"replaces older marker",
+			"<!-- BEGIN BEANS -->\nold\n" + agentsEndMarker + "\n",
+			section,
+			false,
+		},
+		{"unterminated", agentsBeginMarker + "\nold\n", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upsertAgentsSection(tt.content, section)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got:\n%q\nwant:\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,13 +2,22 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
 	"github.com/spf13/cobra"
 )
 
-var archiveJSON bool
+var (
+	archiveJSON bool
+
+	compactOlderThan string
+	compactDryRun    bool
+	compactJSON      bool
+)
 
 var archiveCmd = &cobra.Command{
 	Use:   "archive",
@@ -17,7 +26,16 @@ var archiveCmd = &cobra.Command{
 Archived beans are preserved for project memory and remain visible in all queries.
 The archive keeps the main .beans directory tidy while preserving project history.
 
-Relationships (parent, blocking) are preserved in archived beans.`,
+Relationships (parent, blocking) are preserved in archived beans.
+
+Set archive_layout in .beans.yml to choose how the archive is organized:
+"flat" (default) puts every bean directly in .beans/archive/, "date" sorts
+them into year/month folders by when they were completed or scrapped
+(archive/2025/01/), and "mirror" keeps the subdirectory they were in
+(archive/team/api/), so unarchived beans return to that folder.
+
+Use 'beans archive compact' to fold old archived beans into a single bundle
+file once the archive grows large.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		allBeans := core.All()
 
@@ -63,7 +81,69 @@ Relationships (parent, blocking) are preserved in archived beans.`,
 	},
 }
 
+var archiveCompactCmd = &cobra.Command{
+	Use:   "compact",
+	Short: "Bundle old archived beans into a single file",
+	Long: `Moves archived beans that were completed or scrapped before --older-than out
+of their own files and into an append-only bundle (.beans/archive/bundle.jsonl,
+with an index in bundle.index.json), to keep the number of files down in
+long-lived projects.
+
+Compacted beans are still loaded, shown and queried like any other archived
+bean. Updating, unarchiving or renaming one gives it its own file again;
+deleting one records the deletion in the bundle.
+
+--older-than accepts a relative duration (36h, 7d, 2w) or a date (2006-01-02).
+
+Examples:
+  beans archive compact --dry-run
+  beans archive compact --older-than 52w
+  beans archive compact --older-than 2024-01-01`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		before, err := parseSince(compactOlderThan, time.Now())
+		if err != nil {
+			return cmdError(compactJSON, output.ErrValidation, "invalid --older-than: %s", err)
+		}
+
+		compacted, err := core.CompactArchive(before, compactDryRun)
+		if err != nil {
+			return cmdError(compactJSON, output.ErrFileError, "compacting archive: %s", err)
+		}
+
+		verb := "Compacted"
+		if compactDryRun {
+			verb = "Would compact"
+		}
+		message := fmt.Sprintf("%s %d archived bean(s) into %s", verb, len(compacted), beancore.ArchiveDir+"/"+beancore.BundleFileName)
+
+		if compactJSON {
+			return output.JSON(output.Response{
+				Success:   true,
+				Beans:     compacted,
+				Count:     len(compacted),
+				Message:   message,
+				Redaction: jsonRedaction(),
+			})
+		}
+
+		if len(compacted) == 0 {
+			fmt.Println(ui.Muted.Render("No archived beans to compact."))
+			return nil
+		}
+		for _, b := range compacted {
+			fmt.Printf("  %s  %s\n", ui.ID.Render(b.ID), b.Title)
+		}
+		fmt.Println(ui.Success.Render(message))
+		return nil
+	},
+}
+
 func init() {
 	archiveCmd.Flags().BoolVar(&archiveJSON, "json", false, "Output as JSON")
+	archiveCompactCmd.Flags().StringVar(&compactOlderThan, "older-than", "90d", "Only compact beans completed or scrapped before this (duration or date)")
+	archiveCompactCmd.Flags().BoolVar(&compactDryRun, "dry-run", false, "Show what would be compacted without changing anything")
+	archiveCompactCmd.Flags().BoolVar(&compactJSON, "json", false, "Output as JSON")
+	archiveCmd.AddCommand(archiveCompactCmd)
 	rootCmd.AddCommand(archiveCmd)
 }
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/hmans/beans/internal/bench"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchJSON       bool
+	benchCheck      bool
+	benchCPUProfile string
+	benchMemProfile string
+)
+
+type benchReport struct {
+	Beans   int            `json:"beans"`
+	Results []bench.Result `json:"results"`
+}
+
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Time loading, listing and searching this repository's beans",
+	Long: `Times the operations beans' commands are built on against this
+repository: loading all beans, listing, looking one up, filtering, building
+the tree and searching. Each is reported per run, with allocations, next to
+its performance budget.
+
+Write profiles to look into slow ones with go tool pprof:
+
+  beans bench --cpuprofile cpu.out --memprofile mem.out
+
+With --check, exits with status 1 if any operation is over its budget.`,
+	Hidden: true,
+	Args:   cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if benchCPUProfile != "" {
+			f, err := os.Create(benchCPUProfile)
+			if err != nil {
+				return cmdError(benchJSON, output.ErrFileError, "creating CPU profile: %v", err)
+			}
+			defer f.Close()
+			if err := pprof.StartCPUProfile(f); err != nil {
+				return cmdError(benchJSON, output.ErrFileError, "starting CPU profile: %v", err)
+			}
+		}
+
+		report := benchReport{Beans: len(core.All())}
+		report.Results = bench.Run(bench.Cases(core), report.Beans)
+
+		if benchCPUProfile != "" {
+			pprof.StopCPUProfile()
+		}
+		if benchMemProfile != "" {
+			f, err := os.Create(benchMemProfile)
+			if err != nil {
+				return cmdError(benchJSON, output.ErrFileError, "creating memory profile: %v", err)
+			}
+			defer f.Close()
+			runtime.GC()
+			if err := pprof.WriteHeapProfile(f); err != nil {
+				return cmdError(benchJSON, output.ErrFileError, "writing memory profile: %v", err)
+			}
+		}
+
+		over := 0
+		for _, r := range report.Results {
+			if r.OverBudget {
+				over++
+			}
+		}
+
+		if benchJSON {
+			data, _ := json.MarshalIndent(report, "", "  ")
+			fmt.Println(string(data))
+		} else {
+			fmt.Printf("%d beans\n\n", report.Beans)
+			for _, r := range report.Results {
+				mark := ui.Success.Render("✓")
+				if r.OverBudget {
+					mark = ui.Danger.Render("✗")
+				}
+				fmt.Printf("  %s %-7s %12s/op %10d allocs/op %12s/op  %s\n", mark, r.Name,
+					roundDuration(time.Duration(r.NsPerOp)), r.AllocsPerOp, formatBytes(r.BytesPerOp),
+					ui.Muted.Render("(budget "+r.Budget.String()+")"))
+			}
+			if over > 0 {
+				fmt.Println()
+				fmt.Println(ui.Danger.Render(fmt.Sprintf("%d operation(s) over budget", over)))
+			}
+		}
+
+		if benchCheck && over > 0 {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+// roundDuration rounds d to about three significant digits.
+func roundDuration(d time.Duration) time.Duration {
+	for _, unit := range []time.Duration{time.Second, time.Millisecond, time.Microsecond} {
+		if d >= unit {
+			return d.Round(unit / 100)
+		}
+	}
+	return d
+}
+
+// formatBytes formats a byte count with a binary unit.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGT"[exp])
+}
+
+func init() {
+	benchCmd.Flags().BoolVar(&benchJSON, "json", false, "Output as JSON")
+	benchCmd.Flags().BoolVar(&benchCheck, "check", false, "Exit with status 1 if an operation is over budget")
+	benchCmd.Flags().StringVar(&benchCPUProfile, "cpuprofile", "", "Write a CPU profile to `file`")
+	benchCmd.Flags().StringVar(&benchMemProfile, "memprofile", "", "Write a memory profile to `file`")
+	rootCmd.AddCommand(benchCmd)
+}
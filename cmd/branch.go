@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	branchAutoStash bool
+	branchJSON      bool
+)
+
+var branchCmd = &cobra.Command{
+	Use:   "branch <id>",
+	Short: "Create or switch to a bean's git branch",
+	Long: `Creates the bean's git branch from the base branch, or switches to it if it
+already exists, without changing the bean's status.
+
+Starting a bean only creates a branch automatically for the beans git.branch_for
+selects (by default, beans with children). Use this to give any other bean,
+such as a solo task, a dedicated branch:
+
+  git:
+    branch_for: parents            # beans with children (default)
+    branch_for: all                # every bean
+    branch_for: types:feature,bug  # beans of these types
+
+If the working tree has uncommitted changes, you'll be offered to stash them;
+--auto-stash does so without asking.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(branchJSON, args[0], err)
+		}
+		if !core.IsGitFlowEnabled() {
+			if err := core.EnableGitFlow("."); err != nil {
+				return cmdError(branchJSON, output.ErrGit, "git integration not available: %v", err)
+			}
+		}
+
+		if branchAutoStash {
+			core.SetAutoStash(true)
+		}
+		b, err := withStashRetry(branchJSON, func() (*bean.Bean, error) {
+			return resolver.Mutation().BranchBean(ctx, existing.ID)
+		})
+		if err != nil {
+			return cmdError(branchJSON, output.ErrGit, "failed to create branch: %v", err)
+		}
+
+		if branchJSON {
+			return output.Success(jsonRedaction(), b, "Switched to the bean's branch")
+		}
+		fmt.Println(ui.Success.Render("On branch ") + b.GitBranch + ui.Muted.Render(" for ") + ui.ID.Render(b.ID) + " " + b.Title)
+		return nil
+	},
+}
+
+func init() {
+	branchCmd.Flags().BoolVar(&branchAutoStash, "auto-stash", false, "Stash uncommitted changes around the branch switch and restore them afterwards")
+	branchCmd.Flags().BoolVar(&branchJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(branchCmd)
+}
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	burndownJSON   bool
+	burndownPoints bool
+	burndownWeekly bool
+)
+
+// burndownWidth is the width of the longest bar in the chart.
+const burndownWidth = 40
+
+// burndownData is a bean's burndown for JSON output.
+type burndownData struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// Unit is what is counted: "beans" or "points".
+	Unit string `json:"unit"`
+	// Beans is the number of leaf beans counted.
+	Beans int             `json:"beans"`
+	Days  []burndownPoint `json:"days"`
+}
+
+// burndownPoint is the work in scope and remaining at the end of a day.
+type burndownPoint struct {
+	Date      string `json:"date"`
+	Total     int    `json:"total"`
+	Remaining int    `json:"remaining"`
+}
+
+var burndownCmd = &cobra.Command{
+	Use:   "burndown <milestone-id>",
+	Short: "Show a burndown chart for a milestone",
+	Long: `Shows how the work remaining in a milestone (or any other bean with children)
+went down over time, one bar per day from when the milestone was created.
+
+The chart counts the leaf beans below the milestone (beans without children);
+with --points it sums their story points instead, counting unestimated beans as
+zero. Scrapped beans are excluded. When a bean was completed is taken from its
+status history, falling back to when its git branch was merged or it was last
+updated.
+
+Use --json to get the data for charting elsewhere.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		root, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || root == nil {
+			return beanNotFound(burndownJSON, args[0], err)
+		}
+		allBeans, err := resolver.Query().Beans(ctx, nil, nil)
+		if err != nil {
+			return cmdError(burndownJSON, output.ErrFileError, "querying beans: %v", err)
+		}
+
+		data := buildBurndown(root, allBeans, time.Now().UTC(), burndownPoints)
+		if data.Beans == 0 {
+			return cmdError(burndownJSON, output.ErrValidation, "%s has no child beans to burn down", root.ID)
+		}
+		if burndownWeekly {
+			data.Days = weeklyBurndown(data.Days)
+		}
+
+		if burndownJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(data)
+		}
+
+		fmt.Println(ui.Bold.Render("Burndown ") + ui.ID.Render(root.ID) + " " + root.Title +
+			ui.Muted.Render(fmt.Sprintf(" (%s remaining)", data.Unit)))
+		fmt.Print(renderBurndown(data.Days))
+		return nil
+	},
+}
+
+// buildBurndown computes the daily burndown of the leaf beans below root, from
+// the day root (or the earliest of them) was created until now.
+func buildBurndown(root *bean.Bean, beans []*bean.Bean, now time.Time, usePoints bool) burndownData {
+	data := burndownData{ID: root.ID, Title: root.Title, Unit: "beans", Days: []burndownPoint{}}
+	if usePoints {
+		data.Unit = "points"
+	}
+
+	leaves := leafDescendants(root.ID, beans)
+	data.Beans = len(leaves)
+	if len(leaves) == 0 {
+		return data
+	}
+
+	start := now
+	if root.CreatedAt != nil {
+		start = *root.CreatedAt
+	}
+	for _, b := range leaves {
+		if created, ok := burndownCreatedAt(b); ok && created.Before(start) {
+			start = created
+		}
+	}
+
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	for !day.After(now) {
+		end := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if end.After(now) {
+			end = now
+		}
+		point := burndownPoint{Date: day.Format("2006-01-02")}
+		for _, b := range leaves {
+			if created, ok := burndownCreatedAt(b); ok && created.After(end) {
+				continue
+			}
+			amount := 1
+			if usePoints {
+				amount = 0
+				if b.Points != nil {
+					amount = *b.Points
+				}
+			}
+			point.Total += amount
+			if !burndownDoneAt(b, end) {
+				point.Remaining += amount
+			}
+		}
+		data.Days = append(data.Days, point)
+		day = day.AddDate(0, 0, 1)
+	}
+	return data
+}
+
+// leafDescendants returns the beans below the given bean that have no children
+// themselves, excluding scrapped ones. Each bean is visited once, so a parent
+// cycle doesn't recurse forever.
+func leafDescendants(id string, beans []*bean.Bean) []*bean.Bean {
+	children := make(map[string][]*bean.Bean)
+	for _, b := range beans {
+		if b.Parent != "" {
+			children[b.Parent] = append(children[b.Parent], b)
+		}
+	}
+	var leaves []*bean.Bean
+	visited := map[string]bool{id: true}
+	var collect func(id string)
+	collect = func(id string) {
+		for _, child := range children[id] {
+			if visited[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			if len(children[child.ID]) > 0 {
+				collect(child.ID)
+			} else if child.Status != "scrapped" {
+				leaves = append(leaves, child)
+			}
+		}
+	}
+	collect(id)
+	return leaves
+}
+
+// burndownCreatedAt returns when a bean entered the burndown's scope.
+func burndownCreatedAt(b *bean.Bean) (time.Time, bool) {
+	if b.CreatedAt != nil {
+		return *b.CreatedAt, true
+	}
+	if len(b.StatusHistory) > 0 {
+		return b.StatusHistory[0].ChangedAt, true
+	}
+	return time.Time{}, false
+}
+
+// burndownDoneAt reports whether a bean was completed at t. Beans with a
+// status history are replayed, so reopened beans count as remaining again;
+// otherwise a completed bean is done from its git merge or last update.
+func burndownDoneAt(b *bean.Bean, t time.Time) bool {
+	if len(b.StatusHistory) > 0 {
+		status := ""
+		for _, change := range b.StatusHistory {
+			if !change.ChangedAt.After(t) {
+				status = change.Status
+			}
+		}
+		return status == "completed"
+	}
+	if b.Status != "completed" {
+		return false
+	}
+	finished := b.UpdatedAt
+	if b.GitMergedAt != nil {
+		finished = b.GitMergedAt
+	}
+	return finished == nil || !finished.After(t)
+}
+
+// weeklyBurndown keeps the last day of each week (and the very last day),
+// for long-running milestones.
+func weeklyBurndown(days []burndownPoint) []burndownPoint {
+	var weekly []burndownPoint
+	for i, d := range days {
+		date, _ := time.Parse("2006-01-02", d.Date)
+		if date.Weekday() == time.Sunday || i == len(days)-1 {
+			weekly = append(weekly, d)
+		}
+	}
+	return weekly
+}
+
+// renderBurndown draws one bar per day: remaining work as filled blocks, work
+// already done as shaded blocks, scaled to the largest scope.
+func renderBurndown(days []burndownPoint) string {
+	largest := 0
+	for _, d := range days {
+		largest = max(largest, d.Total)
+	}
+
+	var sb strings.Builder
+	for _, d := range days {
+		remaining, done := 0, 0
+		if largest > 0 {
+			remaining = (d.Remaining*burndownWidth + largest - 1) / largest
+			done = d.Total*burndownWidth/largest - remaining
+		}
+		bar := strings.Repeat("█", remaining) + ui.Muted.Render(strings.Repeat("░", max(done, 0)))
+		fmt.Fprintf(&sb, "  %s  %s %s\n", ui.Muted.Render(d.Date), bar,
+			fmt.Sprintf("%d", d.Remaining)+ui.Muted.Render(fmt.Sprintf("/%d", d.Total)))
+	}
+	return sb.String()
+}
+
+func init() {
+	burndownCmd.Flags().BoolVar(&burndownPoints, "points", false, "Burn down story points instead of beans")
+	burndownCmd.Flags().BoolVar(&burndownWeekly, "weekly", false, "Show one bar per week")
+	burndownCmd.Flags().BoolVar(&burndownJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(burndownCmd)
+}
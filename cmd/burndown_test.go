@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestBuildBurndown(t *testing.T) {
+	day := func(d, h int) *time.Time {
+		tm := time.Date(2025, 1, d, h, 0, 0, 0, time.UTC)
+		return &tm
+	}
+	two, three, five := 2, 3, 5
+
+	milestone := &bean.Bean{ID: "m", Title: "Milestone", Type: "milestone", CreatedAt: day(6, 9)}
+	beans := []*bean.Bean{
+		milestone,
+		{ID: "epic", Type: "epic", Parent: "m", Status: "in-progress", CreatedAt: day(6, 9), Points: &five},
+		// Completed on the 7th according to its history
+		{ID: "a", Type: "task", Parent: "epic", Status: "completed", CreatedAt: day(6, 10), Points: &three,
+			StatusHistory: []bean.StatusChange{{Status: "todo", ChangedAt: *day(6, 10)}, {Status: "completed", ChangedAt: *day(7, 12)}}},
+		// Completed on the 8th, then reopened on the 9th
+		{ID: "b", Type: "task", Parent: "epic", Status: "todo", CreatedAt: day(6, 10), Points: &two,
+			StatusHistory: []bean.StatusChange{{Status: "todo", ChangedAt: *day(6, 10)}, {Status: "completed", ChangedAt: *day(8, 12)}, {Status: "todo", ChangedAt: *day(9, 8)}}},
+		// No history: completed when last updated, on the 9th; added on the 7th
+		{ID: "c", Type: "bug", Parent: "m", Status: "completed", CreatedAt: day(7, 10), UpdatedAt: day(9, 15)},
+		{ID: "d", Type: "task", Parent: "m", Status: "scrapped", CreatedAt: day(6, 10), Points: &five},
+		{ID: "other", Type: "task", Status: "todo", CreatedAt: day(1, 10)},
+	}
+	now := *day(9, 18)
+
+	data := buildBurndown(milestone, beans, now, false)
+	if data.Beans != 3 || data.Unit != "beans" {
+		t.Fatalf("Beans = %d, Unit = %q, want 3 beans", data.Beans, data.Unit)
+	}
+	want := []burndownPoint{
+		{Date: "2025-01-06", Total: 2, Remaining: 2},
+		{Date: "2025-01-07", Total: 3, Remaining: 2},
+		{Date: "2025-01-08", Total: 3, Remaining: 1},
+		{Date: "2025-01-09", Total: 3, Remaining: 1},
+	}
+	if !reflect.DeepEqual(data.Days, want) {
+		t.Errorf("Days = %+v, want %+v", data.Days, want)
+	}
+
+	// Points exclude the epic's own estimate; the unestimated bug counts as zero
+	data = buildBurndown(milestone, beans, now, true)
+	want = []burndownPoint{
+		{Date: "2025-01-06", Total: 5, Remaining: 5},
+		{Date: "2025-01-07", Total: 5, Remaining: 2},
+		{Date: "2025-01-08", Total: 5, Remaining: 0},
+		{Date: "2025-01-09", Total: 5, Remaining: 2},
+	}
+	if !reflect.DeepEqual(data.Days, want) {
+		t.Errorf("points Days = %+v, want %+v", data.Days, want)
+	}
+
+	// 2025-01-12 is a Sunday
+	weekly := weeklyBurndown([]burndownPoint{{Date: "2025-01-11"}, {Date: "2025-01-12"}, {Date: "2025-01-13"}, {Date: "2025-01-14"}})
+	if len(weekly) != 2 || weekly[0].Date != "2025-01-12" || weekly[1].Date != "2025-01-14" {
+		t.Errorf("weeklyBurndown() = %+v", weekly)
+	}
+
+	if data := buildBurndown(&bean.Bean{ID: "lonely"}, beans, now, false); data.Beans != 0 {
+		t.Errorf("Beans = %d for a bean without children, want 0", data.Beans)
+	}
+}
+
+func TestLeafDescendantsCycle(t *testing.T) {
+	// root is its own grandchild's child
+	beans := []*bean.Bean{
+		{ID: "root", Parent: "b"},
+		{ID: "a", Parent: "root"},
+		{ID: "b", Parent: "a"},
+		{ID: "c", Parent: "b"},
+	}
+
+	var ids []string
+	for _, b := range leafDescendants("root", beans) {
+		ids = append(ids, b.ID)
+	}
+	if want := []string{"c"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("leafDescendants() = %v, want %v", ids, want)
+	}
+}
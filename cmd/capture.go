@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	captureFromClipboard bool
+	captureType          string
+	capturePriority      string
+	captureTags          []string
+	captureParent        string
+	captureJSON          bool
+)
+
+var captureCmd = &cobra.Command{
+	Use:   "capture",
+	Short: "Create a bean from stdin or the clipboard",
+	Long: `Creates a bean from text piped in on stdin, or from the clipboard with
+--from-clipboard: the first non-empty line becomes the title (without a
+leading "# "), the rest the body. The bean gets the default type and status
+unless --type says otherwise.
+
+Prints only the new bean's ID, so it can be piped on:
+
+  git log -1 --format=%B | beans capture --tag from-git
+  pbpaste | beans capture | xargs beans start`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if captureType != "" && !cfg.IsValidType(captureType) {
+			return cmdError(captureJSON, output.ErrValidation, "invalid type: %s (must be %s)", captureType, cfg.TypeList())
+		}
+		if capturePriority != "" && !cfg.IsValidPriority(capturePriority) {
+			return cmdError(captureJSON, output.ErrValidation, "invalid priority: %s (must be %s)", capturePriority, cfg.PriorityList())
+		}
+
+		var text string
+		if captureFromClipboard {
+			var err error
+			if text, err = clipboard.ReadAll(); err != nil {
+				return cmdError(captureJSON, output.ErrFileError, "reading clipboard: %v", err)
+			}
+		} else {
+			data, err := io.ReadAll(cmd.InOrStdin())
+			if err != nil {
+				return cmdError(captureJSON, output.ErrFileError, "reading stdin: %v", err)
+			}
+			text = string(data)
+		}
+		title, body := splitCapture(text)
+		if title == "" {
+			return cmdError(captureJSON, output.ErrValidation, "nothing to capture")
+		}
+
+		status := cfg.GetDefaultStatus()
+		input := model.CreateBeanInput{Title: title, Status: &status, Tags: captureTags}
+		if captureType != "" {
+			input.Type = &captureType
+		}
+		if capturePriority != "" {
+			input.Priority = &capturePriority
+		}
+		if body != "" {
+			input.Body = &body
+		}
+		if captureParent != "" {
+			input.Parent = &captureParent
+		}
+
+		resolver := &graph.Resolver{Core: core}
+		b, err := resolver.Mutation().CreateBean(context.Background(), input)
+		if err != nil {
+			return cmdError(captureJSON, output.ErrFileError, "failed to create bean: %v", err)
+		}
+		if captureJSON {
+			return output.Success(jsonRedaction(), b, "Bean created")
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), b.ID)
+		return nil
+	},
+}
+
+// splitCapture splits captured text into a title, its first non-empty line,
+// and a body, the rest.
+func splitCapture(text string) (title, body string) {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	first, rest, _ := strings.Cut(text, "\n")
+	title = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(first), "# "))
+	return title, strings.TrimSpace(rest)
+}
+
+func init() {
+	captureCmd.Flags().BoolVar(&captureFromClipboard, "from-clipboard", false, "Read the text from the clipboard instead of stdin")
+	captureCmd.Flags().StringVarP(&captureType, "type", "t", "", "Bean type (default: the configured default type)")
+	captureCmd.Flags().StringVarP(&capturePriority, "priority", "p", "", "Priority level")
+	captureCmd.Flags().StringArrayVar(&captureTags, "tag", nil, "Add tag (can be repeated)")
+	captureCmd.Flags().StringVar(&captureParent, "parent", "", "Parent bean ID")
+	captureCmd.Flags().BoolVar(&captureJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(captureCmd)
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import "testing"
+
+func TestSplitCapture(t *testing.T) {
+	tests := []struct {
+		text  string
+		title string
+		body  string
+	}{
+		{"Fix the build", "Fix the build", ""},
+		{"\n\n# Fix the build  \r\n\r\nIt fails on CI.\r\nSee logs.\n", "Fix the build", "It fails on CI.\nSee logs."},
+		{"  \n", "", ""},
+	}
+	for _, tt := range tests {
+		title, body := splitCapture(tt.text)
+		if title != tt.title || body != tt.body {
+			t.Errorf("splitCapture(%q) = %q, %q; want %q, %q", tt.text, title, body, tt.title, tt.body)
+		}
+	}
+}
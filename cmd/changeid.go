@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var changeIDJSON bool
+
+var changeIDCmd = &cobra.Command{
+	Use:     "change-id <id> <new-id>",
+	Aliases: []string{"reid"},
+	Short:   "Give a bean a new ID",
+	Long: `Gives a bean a new ID, renaming its file and rewriting every reference to it in
+other beans: parent, blocking and typed links, and mentions in bodies ([[id]] or
+#id; mentions by short ID stay short).
+
+The configured prefix is prepended to a new ID that lacks it. IDs may contain
+letters, digits, _, . and single dashes.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(changeIDJSON, args[0], err)
+		}
+		oldID := existing.ID
+
+		b, err := resolver.Mutation().ChangeBeanID(ctx, oldID, args[1])
+		if err != nil {
+			return cmdError(changeIDJSON, output.ErrValidation, "failed to change ID: %v", err)
+		}
+
+		if changeIDJSON {
+			return output.Success(jsonRedaction(), b, "Bean ID changed")
+		}
+		fmt.Println(ui.Success.Render("Changed ID of ") + b.Title + ui.Muted.Render(": ") +
+			ui.ID.Render(oldID) + ui.Muted.Render(" → ") + ui.ID.Render(b.ID))
+		return nil
+	},
+}
+
+func init() {
+	changeIDCmd.Flags().BoolVar(&changeIDJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(changeIDCmd)
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/output"
+	"github.com/spf13/cobra"
+)
+
+//go:embed changelog.tmpl
+var changelogTemplateContent string
+
+var (
+	changelogJSON      bool
+	changelogMilestone string
+	changelogTemplate  string
+)
+
+// changelogSections lists the changelog sections in order, by bean type.
+var changelogSections = []struct {
+	Type  string
+	Title string
+}{
+	{"feature", "Features"},
+	{"bug", "Bug Fixes"},
+	{"task", "Tasks"},
+}
+
+// changelogData holds the completed work of a milestone, grouped by type.
+type changelogData struct {
+	Milestone *bean.Bean       `json:"milestone"`
+	Sections  []changelogGroup `json:"sections"`
+}
+
+// changelogGroup is a changelog section with the beans completed in it.
+type changelogGroup struct {
+	Title string       `json:"title"`
+	Type  string       `json:"type"`
+	Beans []*bean.Bean `json:"beans"`
+}
+
+var changelogCmd = &cobra.Command{
+	Use:   "changelog",
+	Short: "Generate release notes from beans completed under a milestone",
+	Long: `Generates Markdown release notes from the beans completed under a milestone,
+grouped into Features, Bug Fixes and Tasks. All descendants of the milestone
+are included (e.g. tasks inside its epics); scrapped and unfinished beans are not.
+
+The milestone can be given by ID or by title (e.g. --milestone v1.2).
+
+The output format can be customized with a Go template, given with --template
+or the changelog_template config setting. The template receives .Milestone (a
+bean) and .Sections (each with .Title, .Type and .Beans), plus the functions
+firstParagraph and completedAt.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		milestone, err := findMilestone(resolver, changelogMilestone)
+		if err != nil {
+			return cmdError(changelogJSON, output.ErrNotFound, "%s", err)
+		}
+
+		allBeans, err := resolver.Query().Beans(context.Background(), nil, nil)
+		if err != nil {
+			return fmt.Errorf("querying beans: %w", err)
+		}
+		data := buildChangelog(milestone, allBeans)
+
+		if changelogJSON {
+			return jsonRedaction().Encode(cmd.OutOrStdout(), data)
+		}
+
+		tmplContent := changelogTemplateContent
+		tmplPath := changelogTemplate
+		if tmplPath == "" {
+			tmplPath = cfg.ResolveChangelogTemplate()
+		}
+		if tmplPath != "" {
+			content, err := os.ReadFile(tmplPath)
+			if err != nil {
+				return fmt.Errorf("reading changelog template: %w", err)
+			}
+			tmplContent = string(content)
+		}
+
+		md, err := renderChangelog(data, tmplContent)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), md)
+		return nil
+	},
+}
+
+// findMilestone looks up a milestone by ID, or failing that by title (case-insensitive).
+func findMilestone(resolver *graph.Resolver, ref string) (*bean.Bean, error) {
+	ctx := context.Background()
+	b, err := resolver.Query().Bean(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if b != nil {
+		if b.Type != "milestone" {
+			return nil, fmt.Errorf("bean %s is a %s, not a milestone", b.ID, b.Type)
+		}
+		return b, nil
+	}
+
+	milestones, err := resolver.Query().Beans(ctx, &model.BeanFilter{Type: []string{"milestone"}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*bean.Bean
+	for _, b := range milestones {
+		if strings.EqualFold(b.Title, ref) {
+			matches = append(matches, b)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no milestone found with ID or title %q", ref)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d milestones are titled %q; use the milestone ID instead", len(matches), ref)
+	}
+}
+
+// buildChangelog collects the completed descendants of the milestone,
+// grouped by type and ordered by completion time.
+func buildChangelog(milestone *bean.Bean, allBeans []*bean.Bean) *changelogData {
+	children := make(map[string][]*bean.Bean)
+	for _, b := range allBeans {
+		if b.Parent != "" {
+			children[b.Parent] = append(children[b.Parent], b)
+		}
+	}
+
+	byType := make(map[string][]*bean.Bean)
+	visited := map[string]bool{milestone.ID: true}
+	var walk func(id string)
+	walk = func(id string) {
+		for _, child := range children[id] {
+			if visited[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			if child.Status == "completed" {
+				byType[child.Type] = append(byType[child.Type], child)
+			}
+			walk(child.ID)
+		}
+	}
+	walk(milestone.ID)
+
+	data := &changelogData{Milestone: milestone, Sections: []changelogGroup{}}
+	for _, s := range changelogSections {
+		beans := byType[s.Type]
+		if len(beans) == 0 {
+			continue
+		}
+		sort.SliceStable(beans, func(i, j int) bool {
+			ti, tj := completedAt(beans[i]), completedAt(beans[j])
+			if !ti.Equal(tj) {
+				return ti.Before(tj)
+			}
+			return beans[i].ID < beans[j].ID
+		})
+		data.Sections = append(data.Sections, changelogGroup{Title: s.Title, Type: s.Type, Beans: beans})
+	}
+	return data
+}
+
+// completedAt returns when the bean was last completed according to its status
+// history, falling back to its last update time.
+func completedAt(b *bean.Bean) time.Time {
+	for i := len(b.StatusHistory) - 1; i >= 0; i-- {
+		if b.StatusHistory[i].Status == "completed" {
+			return b.StatusHistory[i].ChangedAt
+		}
+	}
+	if b.UpdatedAt != nil {
+		return *b.UpdatedAt
+	}
+	return time.Time{}
+}
+
+// renderChangelog renders the changelog with the given template.
+func renderChangelog(data *changelogData, tmplContent string) (string, error) {
+	tmpl, err := template.New("changelog").Funcs(template.FuncMap{
+		"firstParagraph": firstParagraph,
+		"completedAt":    completedAt,
+	}).Parse(tmplContent)
+	if err != nil {
+		return "", fmt.Errorf("parsing changelog template: %w", err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("rendering changelog: %w", err)
+	}
+	return sb.String(), nil
+}
+
+func init() {
+	changelogCmd.Flags().BoolVar(&changelogJSON, "json", false, "Output as JSON")
+	changelogCmd.Flags().StringVar(&changelogMilestone, "milestone", "", "Milestone ID or title (required)")
+	changelogCmd.Flags().StringVar(&changelogTemplate, "template", "", "Path to a custom Go template (overrides changelog_template config)")
+	changelogCmd.MarkFlagRequired("milestone")
+	reportCmd.AddCommand(changelogCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestBuildChangelog(t *testing.T) {
+	t1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	milestone := &bean.Bean{ID: "m1", Type: "milestone", Title: "v1.2", Status: "todo", Body: "The big one.\n\nDetails."}
+	beans := []*bean.Bean{
+		milestone,
+		{ID: "e1", Type: "epic", Title: "Auth", Status: "completed", Parent: "m1"},
+		{ID: "f2", Type: "feature", Title: "SSO", Status: "completed", Parent: "e1", UpdatedAt: &t2},
+		{ID: "f1", Type: "feature", Title: "Login", Status: "completed", Parent: "e1",
+			StatusHistory: []bean.StatusChange{{Status: "completed", ChangedAt: t1}}},
+		{ID: "b1", Type: "bug", Title: "Crash on start", Status: "completed", Parent: "m1"},
+		{ID: "t1", Type: "task", Title: "Unfinished", Status: "in-progress", Parent: "m1"},
+		{ID: "t2", Type: "task", Title: "Dropped", Status: "scrapped", Parent: "m1"},
+		{ID: "x1", Type: "feature", Title: "Other milestone", Status: "completed"},
+	}
+
+	data := buildChangelog(milestone, beans)
+
+	if len(data.Sections) != 2 {
+		t.Fatalf("got %d sections, want 2: %+v", len(data.Sections), data.Sections)
+	}
+	features := data.Sections[0]
+	if features.Title != "Features" || len(features.Beans) != 2 || features.Beans[0].ID != "f1" || features.Beans[1].ID != "f2" {
+		t.Errorf("Features section = %+v, want f1 then f2", features)
+	}
+	if bugs := data.Sections[1]; bugs.Title != "Bug Fixes" || len(bugs.Beans) != 1 || bugs.Beans[0].ID != "b1" {
+		t.Errorf("Bug Fixes section = %+v, want b1", bugs)
+	}
+
+	md, err := renderChangelog(data, changelogTemplateContent)
+	if err != nil {
+		t.Fatalf("renderChangelog() error = %v", err)
+	}
+	want := `# v1.2
+
+The big one.
+
+## Features
+
+- Login (f1)
+- SSO (f2)
+
+## Bug Fixes
+
+- Crash on start (b1)
+`
+	if md != want {
+		t.Errorf("renderChangelog() =\n%s\nwant:\n%s", md, want)
+	}
+}
+
+func TestRenderChangelogCustomTemplate(t *testing.T) {
+	data := &changelogData{
+		Milestone: &bean.Bean{Title: "v2"},
+		Sections:  []changelogGroup{{Title: "Features", Beans: []*bean.Bean{{ID: "a", Title: "A"}}}},
+	}
+
+	md, err := renderChangelog(data, `{{.Milestone.Title}}:{{range .Sections}}{{range .Beans}} {{.Title}}{{end}}{{end}}`)
+	if err != nil {
+		t.Fatalf("renderChangelog() error = %v", err)
+	}
+	if md != "v2: A" {
+		t.Errorf("renderChangelog() = %q, want %q", md, "v2: A")
+	}
+
+	if _, err := renderChangelog(data, "{{.Nope"); err == nil {
+		t.Error("expected error for invalid template")
+	}
+}
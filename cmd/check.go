@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/gitflow"
 	"github.com/hmans/beans/internal/ui"
 )
 
@@ -17,24 +20,58 @@ var (
 )
 
 type checkResult struct {
-	Success      bool                      `json:"success"`
-	ConfigErrors []string                  `json:"config_errors"`
-	BeanIssues   *beancore.LinkCheckResult `json:"bean_issues,omitempty"`
-	Fixed        int                       `json:"fixed,omitempty"`
+	Success           bool                         `json:"success"`
+	ConfigErrors      []string                     `json:"config_errors"`
+	FrontMatterIssues []beancore.FrontMatterIssues `json:"frontmatter_issues,omitempty"`
+	DirDefaults       []beancore.DirDefaultsIssue  `json:"dir_defaults_issues,omitempty"`
+	Required          []beancore.RequiredViolation `json:"required_violations,omitempty"`
+	BeanIssues        *beancore.LinkCheckResult    `json:"bean_issues,omitempty"`
+	Aliased           []beancore.MigratedFile      `json:"aliased,omitempty"`
+	AliasConflicts    []beancore.AliasConflict     `json:"alias_conflicts,omitempty"`
+	Unowned           []beancore.Ownership         `json:"unowned,omitempty"`
+	Filenames         []beancore.FilenameMismatch  `json:"filename_mismatches,omitempty"`
+	Fixed             int                          `json:"fixed,omitempty"`
 }
 
 var checkCmd = &cobra.Command{
-	Use:   "check",
-	Short: "Validate configuration and bean integrity",
+	Use:     "check [<id> <item-index>]",
+	Aliases: []string{"doctor"},
+	Short:   "Validate configuration and bean integrity, or toggle a checklist item",
 	Long: `Checks configuration and bean integrity, including:
 - Configuration settings (colors, default type)
+- Front matter (unknown fields, wrong value types, invalid statuses, types,
+  priorities, tags and link types), reported with line and column
+- Directory defaults (_defaults.yml files) with unknown keys, types,
+  priorities, tags or parents
+- Required fields and body sections per type (the "required" setting)
 - Broken links (links to non-existent beans)
 - Self-references (beans linking to themselves)
 - Circular dependencies (cycles in blocks/parent relationships)
+- Filenames that no longer match the bean's title (an error with
+  rename_on_slug_change, a warning otherwise)
 
-Use --fix to automatically remove broken links and self-references.
-Note: Cycles cannot be auto-fixed and require manual intervention.`,
+With a bean ID and item index, toggles that markdown checklist item
+('- [ ] ...', counted from 1) in the bean's body instead.
+
+Beans using legacy status or type names (the "aliases" setting) are listed
+as warnings; they work as is. So are open beans whose git branch changes files
+no CODEOWNERS rule covers (see 'beans owners').
+
+Use --fix to automatically remove broken links and self-references, to
+rewrite aliased names to the current ones, and to rename mismatched files.
+Note: Cycles, front matter issues and missing required fields cannot be
+auto-fixed and require manual intervention.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 && len(args) != 2 {
+			return fmt.Errorf("expected no arguments, or a bean ID and checklist item index")
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 2 {
+			return runChecklistToggle(args[0], args[1])
+		}
+
 		var configErrors []string
 		var fixed int
 
@@ -62,6 +99,84 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
+		// 2c. Check ID scheme
+		if !config.IsValidIDScheme(cfg.Beans.IDScheme) {
+			configErrors = append(configErrors, fmt.Sprintf("id_scheme '%s' is not valid (use random, sequential or date)", cfg.Beans.IDScheme))
+		}
+
+		// 2d. Check git sync hook mode
+		if !config.IsValidSyncHook(cfg.Beans.Git.SyncHook) {
+			configErrors = append(configErrors, fmt.Sprintf("git.sync_hook '%s' is not valid (use off, dry-run or apply)", cfg.Beans.Git.SyncHook))
+		}
+
+		// 2e. Check git branch template
+		if err := gitflow.ValidateBranchTemplate(cfg.Beans.Git.BranchTemplate); err != nil {
+			configErrors = append(configErrors, err.Error())
+		}
+
+		// 2f. Check git merge detection strategies and branch policy
+		for _, s := range cfg.Beans.Git.MergeDetection {
+			if !gitflow.IsValidMergeStrategy(s) {
+				configErrors = append(configErrors, fmt.Sprintf("git.merge_detection '%s' is not valid (use ancestry, message, tree or remote)", s))
+			}
+		}
+		configErrors = append(configErrors, cfg.ValidateBranchFor()...)
+
+		// 2g. Check required fields policy
+		configErrors = append(configErrors, cfg.ValidateRequired()...)
+
+		// 2h. Check tag registry entries are valid tags or namespace wildcards
+		for _, t := range cfg.Beans.Tags {
+			if err := bean.ValidateTagPattern(t.Name); err != nil {
+				configErrors = append(configErrors, fmt.Sprintf("tags: %s", err))
+			}
+		}
+
+		// 2i. Check SLA config
+		configErrors = append(configErrors, cfg.ValidateSLA()...)
+
+		// 2j. Check aging rules
+		configErrors = append(configErrors, cfg.ValidateAging()...)
+
+		// 2k. Check redaction fields
+		configErrors = append(configErrors, cfg.ValidateRedact()...)
+
+		// 2l. Check server auth settings
+		configErrors = append(configErrors, cfg.ValidateAuth()...)
+		configErrors = append(configErrors, cfg.ValidateRateLimit()...)
+
+		// 2m. Check status and type aliases
+		configErrors = append(configErrors, cfg.ValidateAliases()...)
+
+		// 2n. Check monorepo scopes
+		configErrors = append(configErrors, cfg.ValidateScopes()...)
+
+		// 2o. Check archive layout
+		if !config.IsValidArchiveLayout(cfg.Beans.ArchiveLayout) {
+			configErrors = append(configErrors, fmt.Sprintf("archive_layout '%s' is not valid (use flat, date or mirror)", cfg.Beans.ArchiveLayout))
+		}
+
+		// 2p. Check ignore patterns
+		configErrors = append(configErrors, cfg.ValidateIgnore()...)
+
+		// 2q. Check sync settings
+		configErrors = append(configErrors, cfg.ValidateSync()...)
+
+		// 2r. Check url_titles settings
+		configErrors = append(configErrors, cfg.ValidateURLTitles()...)
+
+		// 2s. Check secrets settings
+		configErrors = append(configErrors, cfg.ValidateSecrets()...)
+
+		// 2t. Check lint settings
+		configErrors = append(configErrors, cfg.ValidateLint()...)
+
+		// 2u. Check the title policy
+		configErrors = append(configErrors, cfg.ValidateTitles()...)
+
+		// 2v. Check the priority order
+		configErrors = append(configErrors, cfg.ValidatePriorityOrder()...)
+
 		// 3. Check all status colors are valid (hardcoded statuses)
 		for _, s := range config.DefaultStatuses {
 			if !ui.IsValidColor(s.Color) {
@@ -105,6 +220,140 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			}
 		}
 
+		// === Bean front matter checks ===
+		if !checkJSON {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Bean Front Matter"))
+		}
+
+		frontMatterIssues, err := core.ValidateFrontMatter()
+		if err != nil {
+			return err
+		}
+		frontMatterIssueCount := 0
+		for _, f := range frontMatterIssues {
+			frontMatterIssueCount += len(f.Issues)
+			if !checkJSON {
+				for _, issue := range f.Issues {
+					fmt.Printf("  %s %s:%s\n", ui.Danger.Render("✗"), f.Path, issue)
+				}
+			}
+		}
+		if !checkJSON && frontMatterIssueCount == 0 {
+			fmt.Printf("  %s No front matter issues found\n", ui.Success.Render("✓"))
+		}
+
+		// Aliased names work, so they're warnings; --fix rewrites them
+		aliased, err := core.RewriteAliases(!checkFix)
+		if err != nil {
+			return err
+		}
+		if checkFix {
+			fixed += len(aliased)
+		}
+		if !checkJSON {
+			for _, f := range aliased {
+				if checkFix {
+					fmt.Printf("  %s %s: %s\n", ui.Success.Render("✓"), f.Path, strings.Join(f.Changes, ", "))
+				} else {
+					fmt.Printf("  %s %s: %s (run with --fix to rewrite)\n", ui.Warning.Render("!"), f.Path, strings.Join(f.Changes, ", "))
+				}
+			}
+		}
+
+		// === Directory defaults checks ===
+		dirDefaults, err := core.CheckDirDefaults()
+		if err != nil {
+			return err
+		}
+		if len(dirDefaults) > 0 && !checkJSON {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Directory Defaults"))
+			for _, d := range dirDefaults {
+				fmt.Printf("  %s %s: %s\n", ui.Danger.Render("✗"), d.Path, d.Message)
+			}
+		}
+
+		// === Bean alias checks ===
+		aliasConflicts := core.CheckAliases()
+		if len(aliasConflicts) > 0 && !checkJSON {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Bean Aliases"))
+			for _, a := range aliasConflicts {
+				if a.ID != "" {
+					fmt.Printf("  %s alias %s of %s is the ID of %s\n", ui.Danger.Render("✗"), a.Alias, strings.Join(a.BeanIDs, ", "), a.ID)
+				} else {
+					fmt.Printf("  %s alias %s is shared by %s\n", ui.Danger.Render("✗"), a.Alias, strings.Join(a.BeanIDs, ", "))
+				}
+			}
+		}
+
+		// === Required fields checks ===
+		var required []beancore.RequiredViolation
+		if len(cfg.Beans.Required) > 0 {
+			if !checkJSON {
+				fmt.Println()
+				fmt.Println(ui.Bold.Render("Required Fields"))
+			}
+			required = core.CheckRequired()
+			if !checkJSON {
+				for _, v := range required {
+					fmt.Printf("  %s %s: %s beans require %s\n", ui.Danger.Render("✗"), v.BeanID, v.Type, strings.Join(v.Missing, ", "))
+				}
+				if len(required) == 0 {
+					fmt.Printf("  %s All beans have their required fields\n", ui.Success.Render("✓"))
+				}
+			}
+		}
+
+		// === Filename checks ===
+		// Only an error when the project asks for filenames to follow titles
+		filenames := core.CheckFilenames()
+		filenameIssues := 0
+		if len(filenames) > 0 {
+			if !checkJSON {
+				fmt.Println()
+				fmt.Println(ui.Bold.Render("Filenames"))
+			}
+			if checkFix {
+				n, err := core.FixFilenames()
+				if err != nil {
+					return fmt.Errorf("renaming bean files: %w", err)
+				}
+				fixed += n
+			} else if cfg.Beans.RenameOnSlugChange {
+				filenameIssues = len(filenames)
+			}
+			if !checkJSON {
+				for _, f := range filenames {
+					switch {
+					case checkFix:
+						fmt.Printf("  %s %s: renamed %s to %s\n", ui.Success.Render("✓"), f.BeanID, f.Path, f.Want)
+					case cfg.Beans.RenameOnSlugChange:
+						fmt.Printf("  %s %s: %s should be %s\n", ui.Danger.Render("✗"), f.BeanID, f.Path, f.Want)
+					default:
+						fmt.Printf("  %s %s: %s doesn't match the title (run with --fix to rename it to %s)\n", ui.Warning.Render("!"), f.BeanID, f.Path, f.Want)
+					}
+				}
+			}
+		}
+
+		// === Code ownership checks (warnings only) ===
+		unowned, err := core.CheckOwnership()
+		if err != nil && err != beancore.ErrNoCodeOwners {
+			return err
+		}
+		if err == nil && !checkJSON {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Code Ownership"))
+			for _, o := range unowned {
+				fmt.Printf("  %s %s: %d of %d changed files have no owner (%s)\n", ui.Warning.Render("!"), o.BeanID, len(o.Unowned), o.Files, strings.Join(o.Unowned, ", "))
+			}
+			if len(unowned) == 0 {
+				fmt.Printf("  %s All changed files have owners\n", ui.Success.Render("✓"))
+			}
+		}
+
 		// === Bean link checks ===
 		if !checkJSON {
 			fmt.Println()
@@ -119,7 +368,7 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			if err != nil {
 				return fmt.Errorf("fixing broken links: %w", err)
 			}
-			fixed = fixedCount
+			fixed += fixedCount
 
 			if !checkJSON {
 				for _, bl := range linkResult.BrokenLinks {
@@ -160,14 +409,21 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 		}
 
 		// === Summary ===
-		totalIssues := len(configErrors) + linkResult.TotalIssues()
+		totalIssues := len(configErrors) + frontMatterIssueCount + len(dirDefaults) + len(aliasConflicts) + len(required) + filenameIssues + linkResult.TotalIssues()
 
 		if checkJSON {
 			result := checkResult{
-				Success:      totalIssues == 0,
-				ConfigErrors: configErrors,
-				BeanIssues:   linkResult,
-				Fixed:        fixed,
+				Success:           totalIssues == 0,
+				ConfigErrors:      configErrors,
+				FrontMatterIssues: frontMatterIssues,
+				DirDefaults:       dirDefaults,
+				Required:          required,
+				BeanIssues:        linkResult,
+				Aliased:           aliased,
+				AliasConflicts:    aliasConflicts,
+				Unowned:           unowned,
+				Filenames:         filenames,
+				Fixed:             fixed,
 			}
 			data, _ := json.MarshalIndent(result, "", "  ")
 			fmt.Println(string(data))
@@ -178,7 +434,7 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 			} else if totalIssues == 0 && fixed > 0 {
 				fmt.Println(ui.Success.Render(fmt.Sprintf("Fixed %d issue(s)", fixed)))
 			} else if fixed > 0 {
-				// Some issues fixed, some remain (cycles)
+				// Some issues fixed, some remain (cycles, front matter)
 				fmt.Println(ui.Warning.Render(fmt.Sprintf("Fixed %d issue(s), %d require manual intervention", fixed, totalIssues)))
 			} else if totalIssues == 1 {
 				fmt.Println(ui.Danger.Render("1 issue found"))
@@ -198,6 +454,6 @@ Note: Cycles cannot be auto-fixed and require manual intervention.`,
 
 func init() {
 	checkCmd.Flags().BoolVar(&checkJSON, "json", false, "Output as JSON")
-	checkCmd.Flags().BoolVar(&checkFix, "fix", false, "Automatically fix broken links and self-references")
+	checkCmd.Flags().BoolVar(&checkFix, "fix", false, "Automatically fix broken links and self-references, rewrite aliased names and rename mismatched files")
 	rootCmd.AddCommand(checkCmd)
 }
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// runChecklistToggle toggles a bean's checklist item (`beans check <id> <item-index>`).
+func runChecklistToggle(id, indexArg string) error {
+	index, err := strconv.Atoi(indexArg)
+	if err != nil || index < 1 {
+		return cmdError(checkJSON, output.ErrValidation, "invalid item index: %s (must be a positive number)", indexArg)
+	}
+
+	ctx := context.Background()
+	resolver := &graph.Resolver{Core: core}
+
+	b, err := resolver.Query().Bean(ctx, id)
+	if err != nil || b == nil {
+		return beanNotFound(checkJSON, id, err)
+	}
+
+	b, err = resolver.Mutation().ToggleChecklistItem(ctx, b.ID, index, nil, nil)
+	if err != nil {
+		return mutationError(checkJSON, err)
+	}
+
+	checklist := b.Checklist()
+	item := checklist.Items[index-1]
+	verb := "Unchecked"
+	if item.Done {
+		verb = "Checked"
+	}
+
+	if checkJSON {
+		return output.Success(jsonRedaction(), b, fmt.Sprintf("%s item %d", verb, index))
+	}
+	fmt.Printf("%s %s %s %s\n",
+		ui.Success.Render(verb),
+		ui.ID.Render(b.ID),
+		item.Text,
+		ui.Muted.Render(fmt.Sprintf("(%d/%d done)", checklist.Done, checklist.Total)))
+	return nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloneTitle    string
+	cloneChildren bool
+	cloneJSON     bool
+)
+
+var cloneCmd = &cobra.Command{
+	Use:   "clone <id>",
+	Short: "Create a copy of a bean",
+	Long: `Creates a new bean with the same title, type, priority, points, tags, parent
+and body as an existing one. Timestamps, status history and git fields are not
+copied, and completed or scrapped beans are reset to the default status.
+
+Use --children to also clone all descendants, e.g. to reuse the structure of a
+recurring epic. Blocking links between the cloned beans are kept.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(cloneJSON, args[0], err)
+		}
+
+		var title *string
+		if cloneTitle != "" {
+			title = &cloneTitle
+		}
+		b, err := resolver.Mutation().CloneBean(ctx, existing.ID, title, &cloneChildren)
+		if err != nil {
+			return cmdError(cloneJSON, output.ErrFileError, "failed to clone bean: %v", err)
+		}
+
+		if cloneJSON {
+			return output.Success(jsonRedaction(), b, "Bean cloned")
+		}
+
+		fmt.Println(ui.Success.Render("Cloned ") + ui.ID.Render(existing.ID) + ui.Muted.Render(" → ") + ui.ID.Render(b.ID) + " " + b.Title)
+		if cloneChildren {
+			if n := countDescendants(resolver, b); n > 0 {
+				fmt.Println(ui.Muted.Render(fmt.Sprintf("Including %d child bean(s)", n)))
+			}
+		}
+		return nil
+	},
+}
+
+// countDescendants returns the number of beans below b in the parent hierarchy.
+func countDescendants(resolver *graph.Resolver, b *bean.Bean) int {
+	children, err := resolver.Bean().Children(context.Background(), b, nil)
+	if err != nil {
+		return 0
+	}
+	n := len(children)
+	for _, child := range children {
+		n += countDescendants(resolver, child)
+	}
+	return n
+}
+
+func init() {
+	cloneCmd.Flags().StringVar(&cloneTitle, "title", "", "Title for the clone (default: same as the original)")
+	cloneCmd.Flags().BoolVar(&cloneChildren, "children", false, "Also clone all child beans recursively")
+	cloneCmd.Flags().BoolVar(&cloneJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(cloneCmd)
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	configJSON  bool
+	configLocal bool
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and change settings in .beans.yml",
+	Long: `Reads and changes settings in .beans.yml, for setup scripts that shouldn't
+hand-edit the file.
+
+Settings are addressed by the YAML names of the nested settings joined by
+dots, e.g. beans.prefix or beans.git.auto_create_branch. Map entries are
+addressed by their key (beans.sla.critical.todo) and list elements by their
+index (beans.tags.0.name).`,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a setting",
+	Long: `Prints the value of a setting, taking .beans.local.yml into account.
+Settings that aren't set print their default. Lists, maps and objects are
+printed as YAML (or JSON with --json).
+
+Examples:
+  beans config get beans.prefix
+  beans config get beans.git`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		value, err := cfg.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		if configJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(value)
+		}
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Invalid:
+		case reflect.Struct, reflect.Map, reflect.Slice, reflect.Pointer:
+			data, err := yaml.Marshal(value)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), string(data))
+		default:
+			fmt.Fprintln(cmd.OutOrStdout(), value)
+		}
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a setting",
+	Long: `Sets a setting in .beans.yml, keeping the file's comments and layout.
+With --local, the setting is written to .beans.local.yml instead, which
+overrides .beans.yml for you alone and isn't meant to be committed.
+
+The value is parsed according to the setting's type: booleans and numbers as
+such, lists, maps and objects as YAML or JSON.
+
+Examples:
+  beans config set beans.prefix myapp-
+  beans config set beans.git.auto_create_branch true
+  beans config set beans.sla.critical '{todo: 2d, in-progress: 1w}'
+  beans config set --local beans.editor "code --wait"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := configPath
+		if path == "" {
+			path = filepath.Join(cfg.ConfigDir(), config.ConfigFileName)
+		}
+		if configLocal {
+			path = filepath.Join(filepath.Dir(path), config.LocalConfigFileName)
+		}
+		if err := config.SetValue(path, args[0], args[1]); err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), ui.Success.Render("Set "+args[0]))
+		return nil
+	},
+}
+
+func init() {
+	configGetCmd.Flags().BoolVar(&configJSON, "json", false, "Output as JSON")
+	configSetCmd.Flags().BoolVar(&configLocal, "local", false, "Write to "+config.LocalConfigFileName+" instead of "+config.ConfigFileName)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -1,12 +1,14 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/output"
 )
 
@@ -64,6 +66,28 @@ func cmdError(jsonMode bool, code string, format string, args ...any) error {
 	return fmt.Errorf(format, args...)
 }
 
+// jsonRedaction returns the redaction configured for bean JSON output.
+func jsonRedaction() bean.Redaction {
+	if cfg == nil {
+		return bean.Redaction{}
+	}
+	return cfg.Beans.Redact.Redaction()
+}
+
+// beanNotFound returns the error for an ID that didn't resolve to a bean:
+// the candidates if it's an ambiguous prefix (err is an AmbiguousIDError),
+// or else "bean not found".
+func beanNotFound(jsonMode bool, id string, err error) error {
+	var ambiguous *beancore.AmbiguousIDError
+	if errors.As(err, &ambiguous) {
+		if jsonMode {
+			return output.ErrorWithCandidates(output.ErrAmbiguousID, ambiguous.Error(), ambiguous.Candidates)
+		}
+		return ambiguous
+	}
+	return cmdError(jsonMode, output.ErrNotFound, "bean not found: %s", id)
+}
+
 // mergeTags combines existing tags with additions and removals.
 func mergeTags(existing, add, remove []string) []string {
 	tags := make(map[string]bool)
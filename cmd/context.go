@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	contextID         string
+	contextActionable bool
+	contextBudget     int
+	contextJSON       bool
+)
+
+// contextCharsPerToken is the rough number of characters per token used to
+// keep bundles within their token budget.
+const contextCharsPerToken = 4
+
+// contextNoteCost is the space reserved for a note about truncated or omitted content.
+const contextNoteCost = 64
+
+// actionableHeading heads the list of actionable beans.
+const actionableHeading = "In progress, then ready to start"
+
+// contextBundle is the context gathered for an agent, either around one bean
+// or listing the actionable beans.
+type contextBundle struct {
+	Bean       *contextBean  `json:"bean,omitempty"`
+	Ancestors  []contextRef  `json:"ancestors,omitempty"`
+	BlockedBy  []contextRef  `json:"blocked_by,omitempty"`
+	Blocking   []contextRef  `json:"blocking,omitempty"`
+	Children   []contextRef  `json:"children,omitempty"`
+	Siblings   []contextRef  `json:"siblings,omitempty"`
+	Actionable []contextRef  `json:"actionable,omitempty"`
+	Workflow   contextConfig `json:"workflow"`
+	// Omitted counts the list entries left out to stay within the budget;
+	// Truncated is set if the bean's body was shortened.
+	Omitted   int  `json:"omitted,omitempty"`
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// contextBean is the selected bean with its body.
+type contextBean struct {
+	contextRef
+	Tags []string `json:"tags,omitempty"`
+	Body string   `json:"body,omitempty"`
+}
+
+// contextRef is a one-line summary of a related bean.
+type contextRef struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Type     string `json:"type"`
+	Status   string `json:"status"`
+	Priority string `json:"priority,omitempty"`
+}
+
+// contextConfig lists the project's statuses, types and priorities.
+type contextConfig struct {
+	Statuses   []string `json:"statuses"`
+	Types      []string `json:"types"`
+	Priorities []string `json:"priorities"`
+}
+
+var contextCmd = &cobra.Command{
+	Use:   "context",
+	Short: "Output a compact context bundle for AI agents",
+	Long: `Outputs what an agent needs to work on a bean as a single, compact markdown (or
+JSON) document: the bean itself, its ancestors, the beans blocking it and
+blocked by it, its children and siblings, and the project's statuses and types.
+
+With --actionable, the bundle lists the beans that can be worked on instead: those
+in progress, then those ready to start (see 'beans list --ready').
+
+Without --id or --actionable, the bean in focus (see 'beans focus') is used, or
+the actionable beans if none is focused.
+
+The output is kept within --budget tokens (estimated at 4 characters each) by
+leaving out siblings, then children, then shortening the bean's body.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		var target *bean.Bean
+		switch {
+		case contextID != "" && contextActionable:
+			return cmdError(contextJSON, output.ErrValidation, "--id and --actionable are mutually exclusive")
+		case contextID != "":
+			b, err := resolver.Query().Bean(ctx, contextID)
+			if err != nil || b == nil {
+				return beanNotFound(contextJSON, contextID, err)
+			}
+			target = b
+		case !contextActionable:
+			target, _ = core.Focus()
+		}
+
+		var bundle *contextBundle
+		var err error
+		if target != nil {
+			bundle, err = beanContext(ctx, resolver, target)
+		} else {
+			bundle, err = actionableContext(ctx, resolver)
+		}
+		if err != nil {
+			return cmdError(contextJSON, output.ErrFileError, "gathering context: %v", err)
+		}
+		fitContext(bundle, contextBudget)
+
+		if contextJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(bundle)
+		}
+		fmt.Fprint(cmd.OutOrStdout(), renderContext(bundle))
+		return nil
+	},
+}
+
+// beanContext gathers the context around a bean.
+func beanContext(ctx context.Context, resolver *graph.Resolver, b *bean.Bean) (*contextBundle, error) {
+	bundle := &contextBundle{
+		Bean:     &contextBean{contextRef: newContextRef(b), Tags: b.Tags, Body: strings.TrimSpace(b.Body)},
+		Workflow: newContextConfig(),
+	}
+	beanResolver := resolver.Bean()
+
+	// Ancestors, outermost first
+	seen := map[string]bool{b.ID: true}
+	for p, _ := beanResolver.Parent(ctx, b); p != nil && !seen[p.ID]; p, _ = beanResolver.Parent(ctx, p) {
+		seen[p.ID] = true
+		bundle.Ancestors = append([]contextRef{newContextRef(p)}, bundle.Ancestors...)
+	}
+
+	blockers, err := beanResolver.BlockedBy(ctx, b, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range b.BlockedBy {
+		if blocker, err := core.Get(id); err == nil {
+			blockers = append(blockers, blocker)
+		}
+	}
+	bundle.BlockedBy = contextRefs(blockers, nil)
+
+	blocking, err := beanResolver.Blocking(ctx, b, nil)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Blocking = contextRefs(blocking, nil)
+
+	children, err := beanResolver.Children(ctx, b, nil)
+	if err != nil {
+		return nil, err
+	}
+	bean.SortByStatusPriorityAndType(children, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	bundle.Children = contextRefs(children, nil)
+
+	if b.Parent != "" {
+		siblings, err := resolver.Query().Beans(ctx, &model.BeanFilter{ParentID: &b.Parent}, nil)
+		if err != nil {
+			return nil, err
+		}
+		bean.SortByStatusPriorityAndType(siblings, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+		bundle.Siblings = contextRefs(siblings, map[string]bool{b.ID: true})
+	}
+	return bundle, nil
+}
+
+// actionableContext lists the beans in progress and those ready to start.
+func actionableContext(ctx context.Context, resolver *graph.Resolver) (*contextBundle, error) {
+	inProgress, err := resolver.Query().Beans(ctx, &model.BeanFilter{Status: []string{"in-progress"}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	notBlocked := false
+	ready, err := resolver.Query().Beans(ctx, &model.BeanFilter{
+		IsBlocked:     &notBlocked,
+		ExcludeStatus: []string{"in-progress", "completed", "scrapped", "draft"},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	sortFn := func(beans []*bean.Bean) {
+		bean.SortByStatusPriorityAndType(beans, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	}
+	sortFn(inProgress)
+	sortFn(ready)
+
+	bundle := &contextBundle{Workflow: newContextConfig()}
+	bundle.Actionable = append(contextRefs(inProgress, nil), contextRefs(ready, nil)...)
+	return bundle, nil
+}
+
+func newContextRef(b *bean.Bean) contextRef {
+	return contextRef{ID: b.ID, Title: b.Title, Type: b.Type, Status: b.Status, Priority: b.Priority}
+}
+
+// contextRefs summarizes beans, skipping duplicates and the given IDs.
+func contextRefs(beans []*bean.Bean, skip map[string]bool) []contextRef {
+	seen := make(map[string]bool)
+	var refs []contextRef
+	for _, b := range beans {
+		if skip[b.ID] || seen[b.ID] {
+			continue
+		}
+		seen[b.ID] = true
+		refs = append(refs, newContextRef(b))
+	}
+	return refs
+}
+
+func newContextConfig() contextConfig {
+	return contextConfig{Statuses: cfg.StatusNames(), Types: cfg.TypeNames(), Priorities: cfg.PriorityNames()}
+}
+
+// fitContext trims a bundle so its markdown rendering stays within budget
+// tokens. The bean, its ancestors and the workflow are always kept; then, in
+// order, blockers, blocked beans and the actionable list are kept while they
+// fit, the body gets what's left, then children and siblings.
+func fitContext(b *contextBundle, budget int) {
+	if budget <= 0 {
+		return
+	}
+	// Reserve room for the note about omitted entries
+	remaining := budget*contextCharsPerToken - contextNoteCost - len(renderContext(&contextBundle{
+		Bean:      beanWithoutBody(b.Bean),
+		Ancestors: b.Ancestors,
+		Workflow:  b.Workflow,
+	}))
+
+	keep := func(heading string, refs []contextRef) []contextRef {
+		for i, ref := range refs {
+			cost := len(contextLine(ref)) + 1
+			if i == 0 {
+				cost += len(heading) + 6
+			}
+			if cost > remaining {
+				b.Omitted += len(refs) - i
+				return refs[:i]
+			}
+			remaining -= cost
+		}
+		return refs
+	}
+
+	b.BlockedBy = keep("Blocked by", b.BlockedBy)
+	b.Blocking = keep("Blocking", b.Blocking)
+	b.Actionable = keep(actionableHeading, b.Actionable)
+	if b.Bean != nil && b.Bean.Body != "" {
+		// Leave room for the truncation note
+		if allowed := max(0, remaining-contextNoteCost); len(b.Bean.Body) > allowed {
+			for allowed > 0 && !utf8.RuneStart(b.Bean.Body[allowed]) {
+				allowed--
+			}
+			b.Bean.Body = strings.TrimSpace(b.Bean.Body[:allowed])
+			b.Truncated = true
+		}
+		remaining -= len(b.Bean.Body) + contextNoteCost
+	}
+	b.Children = keep("Children", b.Children)
+	b.Siblings = keep("Siblings", b.Siblings)
+}
+
+// beanWithoutBody returns a copy of b without its body, or nil.
+func beanWithoutBody(b *contextBean) *contextBean {
+	if b == nil {
+		return nil
+	}
+	c := *b
+	c.Body = ""
+	return &c
+}
+
+// contextLine renders a related bean as a markdown list item.
+func contextLine(r contextRef) string {
+	attrs := r.Type + ", " + r.Status
+	if r.Priority != "" {
+		attrs += ", " + r.Priority
+	}
+	return fmt.Sprintf("- %s (%s): %s", r.ID, attrs, r.Title)
+}
+
+// renderContext renders a bundle as markdown.
+func renderContext(b *contextBundle) string {
+	var sb strings.Builder
+	section := func(title string, refs []contextRef) {
+		if len(refs) == 0 {
+			return
+		}
+		fmt.Fprintf(&sb, "\n## %s\n\n", title)
+		for _, r := range refs {
+			sb.WriteString(contextLine(r) + "\n")
+		}
+	}
+
+	if b.Bean != nil {
+		fmt.Fprintf(&sb, "# %s: %s\n\n", b.Bean.ID, b.Bean.Title)
+		attrs := []string{"type: " + b.Bean.Type, "status: " + b.Bean.Status}
+		if b.Bean.Priority != "" {
+			attrs = append(attrs, "priority: "+b.Bean.Priority)
+		}
+		if len(b.Bean.Tags) > 0 {
+			attrs = append(attrs, "tags: "+strings.Join(b.Bean.Tags, ", "))
+		}
+		sb.WriteString(strings.Join(attrs, " · ") + "\n")
+		if b.Bean.Body != "" {
+			sb.WriteString("\n" + b.Bean.Body + "\n")
+			if b.Truncated {
+				sb.WriteString("\n[body truncated, see `beans show " + b.Bean.ID + "`]\n")
+			}
+		}
+		section("Ancestors", b.Ancestors)
+		section("Blocked by", b.BlockedBy)
+		section("Blocking", b.Blocking)
+		section("Children", b.Children)
+		section("Siblings", b.Siblings)
+	} else {
+		sb.WriteString("# Actionable beans\n")
+		if len(b.Actionable) == 0 {
+			sb.WriteString("\nNothing is in progress or ready to start.\n")
+		}
+		section(actionableHeading, b.Actionable)
+	}
+	if b.Omitted > 0 {
+		fmt.Fprintf(&sb, "\n[%d more related beans omitted]\n", b.Omitted)
+	}
+
+	sb.WriteString("\n## Workflow\n\n")
+	sb.WriteString("Statuses: " + strings.Join(b.Workflow.Statuses, ", ") + "\n")
+	sb.WriteString("Types: " + strings.Join(b.Workflow.Types, ", ") + "\n")
+	sb.WriteString("Priorities: " + strings.Join(b.Workflow.Priorities, ", ") + "\n")
+	return sb.String()
+}
+
+func init() {
+	contextCmd.Flags().StringVar(&contextID, "id", "", "Bean to gather context for (default: the bean in focus)")
+	contextCmd.Flags().BoolVar(&contextActionable, "actionable", false, "List the beans in progress and ready to start")
+	contextCmd.Flags().IntVar(&contextBudget, "budget", 2000, "Maximum size of the output in tokens (0 for no limit)")
+	contextCmd.Flags().BoolVar(&contextJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(contextCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFitContext(t *testing.T) {
+	newBundle := func() *contextBundle {
+		b := &contextBundle{
+			Bean: &contextBean{
+				contextRef: contextRef{ID: "abc1", Title: "Add login", Type: "feature", Status: "in-progress", Priority: "high"},
+				Body:       strings.Repeat("Some detail about the feature. ", 40),
+			},
+			Ancestors: []contextRef{{ID: "m1", Title: "Release 1", Type: "milestone", Status: "todo"}},
+			BlockedBy: []contextRef{{ID: "b1", Title: "Set up auth", Type: "task", Status: "todo"}},
+			Workflow:  contextConfig{Statuses: []string{"todo", "completed"}, Types: []string{"task"}, Priorities: []string{"high"}},
+		}
+		for i := range 30 {
+			b.Siblings = append(b.Siblings, contextRef{ID: fmt.Sprintf("s%d", i), Title: "Sibling", Type: "task", Status: "todo"})
+		}
+		return b
+	}
+
+	// A generous budget keeps everything
+	b := newBundle()
+	fitContext(b, 10000)
+	if b.Truncated || b.Omitted != 0 || len(b.Siblings) != 30 {
+		t.Errorf("large budget: truncated = %v, omitted = %d, siblings = %d", b.Truncated, b.Omitted, len(b.Siblings))
+	}
+
+	// A small budget drops siblings first and then shortens the body
+	b = newBundle()
+	fitContext(b, 200)
+	out := renderContext(b)
+	if len(out) > 200*contextCharsPerToken {
+		t.Errorf("rendered %d chars, want at most %d:\n%s", len(out), 200*contextCharsPerToken, out)
+	}
+	if len(b.Siblings) != 0 || b.Omitted != 30 || !b.Truncated {
+		t.Errorf("small budget: siblings = %d, omitted = %d, truncated = %v", len(b.Siblings), b.Omitted, b.Truncated)
+	}
+	for _, want := range []string{"# abc1: Add login", "- m1 (milestone, todo): Release 1", "## Blocked by", "[body truncated", "[30 more related beans omitted]", "Statuses: todo, completed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderActionableContext(t *testing.T) {
+	b := &contextBundle{
+		Actionable: []contextRef{{ID: "a1", Title: "Fix crash", Type: "bug", Status: "in-progress", Priority: "critical"}},
+		Workflow:   contextConfig{Statuses: []string{"todo"}, Types: []string{"bug"}},
+	}
+	out := renderContext(b)
+	if !strings.HasPrefix(out, "# Actionable beans\n") || !strings.Contains(out, "- a1 (bug, in-progress, critical): Fix crash") {
+		t.Errorf("unexpected output:\n%s", out)
+	}
+}
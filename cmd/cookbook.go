@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/pretty"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// queriesDirName is the directory inside the beans directory holding named queries.
+const queriesDirName = beancore.QueriesDir
+
+var cookbookJSON bool
+
+var cookbookCmd = &cobra.Command{
+	Use:   "q [name] [args...]",
+	Short: "Run a named GraphQL query",
+	Long: `Runs a named GraphQL query stored in .beans/queries/<name>.graphql, so teams can
+share complex queries instead of pasting GraphQL on the command line.
+
+Arguments fill the query's variables in the order they are declared, or by
+name with var=value. Values are converted to the variable's type; list
+variables take comma-separated values. Leading # comments in the file are
+shown as the query's description.
+
+Without arguments, lists the available queries.
+
+Example .beans/queries/open-by-tag.graphql:
+
+  # Open beans with a tag
+  query OpenByTag($tag: String!, $status: [String!] = ["todo", "in-progress"]) {
+    beans(filter: { tags: [$tag], status: $status }) { id title status }
+  }
+
+  beans q open-by-tag frontend
+  beans q open-by-tag frontend status=draft,todo`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := filepath.Join(core.Root(), queriesDirName)
+		if len(args) == 0 {
+			return listNamedQueries(dir)
+		}
+
+		q, err := loadNamedQuery(dir, args[0])
+		if err != nil {
+			return err
+		}
+		variables, err := bindQueryArgs(q, args[1:])
+		if err != nil {
+			return err
+		}
+
+		result, err := executeQuery(q.Source, variables, "")
+		if err != nil {
+			return err
+		}
+		if cookbookJSON {
+			fmt.Println(string(pretty.Pretty(result)))
+		} else {
+			fmt.Println(string(pretty.Color(pretty.Pretty(result), nil)))
+		}
+		return nil
+	},
+}
+
+// namedQuery is a GraphQL query stored in the queries directory.
+type namedQuery struct {
+	Name        string
+	Description string
+	Source      string
+}
+
+// loadNamedQueries returns the queries in dir, sorted by name. A missing
+// directory has no queries.
+func loadNamedQueries(dir string) ([]*namedQuery, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.graphql"))
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(paths)
+	queries := make([]*namedQuery, 0, len(paths))
+	for _, path := range paths {
+		q, err := loadNamedQuery(dir, strings.TrimSuffix(filepath.Base(path), ".graphql"))
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	return queries, nil
+}
+
+// loadNamedQuery reads the query with the given name from dir.
+func loadNamedQuery(dir, name string) (*namedQuery, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return nil, fmt.Errorf("invalid query name %q", name)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, name+".graphql"))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no query named %q (add %s.graphql to %s)", name, name, dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	source := string(data)
+	return &namedQuery{Name: name, Description: queryDescription(source), Source: source}, nil
+}
+
+// queryDescription returns the leading # comment lines of a query, joined.
+func queryDescription(source string) string {
+	var lines []string
+	for _, line := range strings.Split(source, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "#") {
+			break
+		}
+		if text := strings.TrimSpace(strings.TrimPrefix(line, "#")); text != "" {
+			lines = append(lines, text)
+		}
+	}
+	return strings.Join(lines, " ")
+}
+
+// variables returns the variables declared by the query's operation.
+func (q *namedQuery) variables() (ast.VariableDefinitionList, error) {
+	doc, err := parser.ParseQuery(&ast.Source{Name: q.Name, Input: q.Source})
+	if err != nil {
+		return nil, fmt.Errorf("parsing query %s: %w", q.Name, err)
+	}
+	switch len(doc.Operations) {
+	case 0:
+		return nil, fmt.Errorf("query %s has no operation", q.Name)
+	case 1:
+		return doc.Operations[0].VariableDefinitions, nil
+	default:
+		return nil, fmt.Errorf("query %s has %d operations, named queries must have one", q.Name, len(doc.Operations))
+	}
+}
+
+// usage returns the query's arguments as shown in help, e.g. "<tag> [status]".
+func (q *namedQuery) usage() string {
+	vars, err := q.variables()
+	if err != nil {
+		return ""
+	}
+	parts := make([]string, len(vars))
+	for i, v := range vars {
+		if v.Type.NonNull && v.DefaultValue == nil {
+			parts[i] = "<" + v.Variable + ">"
+		} else {
+			parts[i] = "[" + v.Variable + "]"
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// bindQueryArgs maps command line arguments to the query's variables:
+// positionally in declaration order, or by name with var=value.
+func bindQueryArgs(q *namedQuery, args []string) (map[string]any, error) {
+	vars, err := q.variables()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any)
+	next := 0
+	for _, arg := range args {
+		var def *ast.VariableDefinition
+		raw := arg
+		if name, value, ok := strings.Cut(arg, "="); ok && vars.ForName(name) != nil {
+			def, raw = vars.ForName(name), value
+		} else {
+			for next < len(vars) && values[vars[next].Variable] != nil {
+				next++
+			}
+			if next >= len(vars) {
+				return nil, fmt.Errorf("too many arguments (usage: beans q %s %s)", q.Name, q.usage())
+			}
+			def = vars[next]
+		}
+		value, err := coerceQueryArg(raw, def.Type)
+		if err != nil {
+			return nil, fmt.Errorf("argument %s: %w", def.Variable, err)
+		}
+		values[def.Variable] = value
+	}
+
+	for _, v := range vars {
+		if _, ok := values[v.Variable]; !ok && v.Type.NonNull && v.DefaultValue == nil {
+			return nil, fmt.Errorf("missing argument %s (usage: beans q %s %s)", v.Variable, q.Name, q.usage())
+		}
+	}
+	return values, nil
+}
+
+// coerceQueryArg converts a command line value to a GraphQL variable value
+// of the given type. List values are comma-separated.
+func coerceQueryArg(raw string, t *ast.Type) (any, error) {
+	if t.Elem != nil {
+		items := []any{}
+		for _, item := range strings.Split(raw, ",") {
+			value, err := coerceQueryArg(strings.TrimSpace(item), t.Elem)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		return items, nil
+	}
+	switch t.NamedType {
+	case "Int":
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an Int", raw)
+		}
+		return n, nil
+	case "Float":
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a Float", raw)
+		}
+		return f, nil
+	case "Boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a Boolean", raw)
+		}
+		return b, nil
+	default:
+		return raw, nil
+	}
+}
+
+// listNamedQueries prints the available queries with their arguments and descriptions.
+func listNamedQueries(dir string) error {
+	queries, err := loadNamedQueries(dir)
+	if err != nil {
+		return err
+	}
+	if len(queries) == 0 {
+		fmt.Println(ui.Muted.Render(fmt.Sprintf("No named queries yet. Add one as %s", filepath.Join(dir, "<name>.graphql"))))
+		return nil
+	}
+	for _, q := range queries {
+		line := q.Name
+		if usage := q.usage(); usage != "" {
+			line += " " + usage
+		}
+		if q.Description != "" {
+			line += "  " + ui.Muted.Render(q.Description)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+func init() {
+	cookbookCmd.Flags().BoolVar(&cookbookJSON, "json", false, "Output JSON without colors (for piping)")
+	rootCmd.AddCommand(cookbookCmd)
+}
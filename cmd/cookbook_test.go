@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const openByTagQuery = `# Open beans with a tag
+# (in-progress and todo by default)
+query OpenByTag($tag: String!, $status: [String!] = ["todo", "in-progress"], $limit: Int, $blocked: Boolean) {
+  beans(filter: { tags: [$tag], status: $status, isBlocked: $blocked }) { id title }
+}
+`
+
+func TestLoadNamedQueries(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "open-by-tag.graphql"), []byte(openByTagQuery), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "all.graphql"), []byte("{ beans { id } }"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	queries, err := loadNamedQueries(dir)
+	if err != nil {
+		t.Fatalf("loadNamedQueries() error = %v", err)
+	}
+	if len(queries) != 2 || queries[0].Name != "all" || queries[1].Name != "open-by-tag" {
+		t.Fatalf("loadNamedQueries() = %+v, want all, open-by-tag", queries)
+	}
+	if got, want := queries[1].Description, "Open beans with a tag (in-progress and todo by default)"; got != want {
+		t.Errorf("Description = %q, want %q", got, want)
+	}
+	if got, want := queries[1].usage(), "<tag> [status] [limit] [blocked]"; got != want {
+		t.Errorf("usage() = %q, want %q", got, want)
+	}
+
+	if _, err := loadNamedQuery(dir, "missing"); err == nil || !strings.Contains(err.Error(), `no query named "missing"`) {
+		t.Errorf("loadNamedQuery(missing) error = %v", err)
+	}
+	if _, err := loadNamedQuery(dir, "../secret"); err == nil {
+		t.Error("loadNamedQuery() should reject names with path separators")
+	}
+	if queries, err := loadNamedQueries(filepath.Join(dir, "nope")); err != nil || len(queries) != 0 {
+		t.Errorf("loadNamedQueries(missing dir) = %v, %v; want no queries", queries, err)
+	}
+}
+
+func TestBindQueryArgs(t *testing.T) {
+	q := &namedQuery{Name: "open-by-tag", Source: openByTagQuery}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    map[string]any
+		wantErr string
+	}{
+		{name: "positional", args: []string{"frontend"}, want: map[string]any{"tag": "frontend"}},
+		{name: "list and int", args: []string{"ui", "todo,draft", "5"}, want: map[string]any{"tag": "ui", "status": []any{"todo", "draft"}, "limit": 5}},
+		{name: "named", args: []string{"blocked=true", "ui"}, want: map[string]any{"tag": "ui", "blocked": true}},
+		{name: "value containing =", args: []string{"a=b"}, want: map[string]any{"tag": "a=b"}},
+		{name: "missing required", args: []string{"limit=3"}, wantErr: "missing argument tag"},
+		{name: "bad int", args: []string{"ui", "todo", "many"}, wantErr: `argument limit: "many" is not an Int`},
+		{name: "too many", args: []string{"a", "b", "1", "true", "extra"}, wantErr: "too many arguments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bindQueryArgs(q, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("bindQueryArgs() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("bindQueryArgs() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("bindQueryArgs() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
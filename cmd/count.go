@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/spf13/cobra"
+)
+
+var countCmd = &cobra.Command{
+	Use:   "count",
+	Short: "Print the number of beans matching filters",
+	Long: `Prints how many beans match the given filters, and nothing else, for shell
+scripts and hooks:
+
+  if [ "$(beans count --is-blocked)" -gt 0 ]; then ...
+
+Takes the same filters as 'beans list' (see 'beans list --help').`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := buildListFilter()
+		if err != nil {
+			return err
+		}
+
+		resolver := &graph.Resolver{Core: core}
+		beans, err := resolver.Query().Beans(context.Background(), filter, nil)
+		if err != nil {
+			return fmt.Errorf("querying beans: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), len(beans))
+		return nil
+	},
+}
+
+func init() {
+	addListFilterFlags(countCmd.Flags())
+	rootCmd.AddCommand(countCmd)
+}
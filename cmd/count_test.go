@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestCountCommand(t *testing.T) {
+	testCore, cleanup := setupShowTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	for _, b := range []*bean.Bean{
+		{ID: "one", Title: "One", Status: "todo", Type: "bug"},
+		{ID: "two", Title: "Two", Status: "todo", Type: "task"},
+		{ID: "three", Title: "Three", Status: "completed", Type: "bug"},
+	} {
+		if err := testCore.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		status []string
+		filter string
+		want   string
+	}{
+		{"all", nil, "", "3\n"},
+		{"status flag", []string{"todo"}, "", "2\n"},
+		{"filter expression", nil, "type:bug -status:completed", "1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listStatus, listFilter = tt.status, tt.filter
+			defer func() { listStatus, listFilter = nil, "" }()
+
+			var out bytes.Buffer
+			countCmd.SetOut(&out)
+			if err := countCmd.RunE(countCmd, nil); err != nil {
+				t.Fatalf("count error = %v", err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("count printed %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
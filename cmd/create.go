@@ -3,8 +3,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
+	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/graph"
 	"github.com/hmans/beans/internal/graph/model"
@@ -17,13 +19,20 @@ var (
 	createStatus    string
 	createType      string
 	createPriority  string
+	createPoints    int
 	createBody      string
 	createBodyFile  string
 	createTag       []string
+	createAlias     []string
+	createScope     string
 	createParent    string
 	createBlocking  []string
 	createBlockedBy []string
 	createPrefix    string
+	createDraft     bool
+	createLocal     bool
+	createDir       string
+	createURL       string
 	createJSON      bool
 )
 
@@ -31,7 +40,16 @@ var createCmd = &cobra.Command{
 	Use:     "create [title]",
 	Aliases: []string{"c", "new"},
 	Short:   "Create a new bean",
-	Long:    `Creates a new bean (issue) with a generated ID and optional title.`,
+	Long: `Creates a new bean (issue) with a generated ID and optional title.
+
+--url records the web page the bean is about as its source_url. With
+url_titles.enabled in .beans.yml, a bean created without a title is titled
+after that page, or after the first page its body links to (which also
+becomes its source_url).
+
+--alias gives the bean another identifier, such as its ID in a tracker it
+was imported from (JIRA-123, GH#456). Commands accept an alias wherever they
+take a bean ID, and search finds beans by their aliases.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		title := strings.Join(args, " ")
 		if title == "" {
@@ -48,12 +66,35 @@ var createCmd = &cobra.Command{
 		if createPriority != "" && !cfg.IsValidPriority(createPriority) {
 			return cmdError(createJSON, output.ErrValidation, "invalid priority: %s (must be %s)", createPriority, cfg.PriorityList())
 		}
+		if createPoints < 0 {
+			return cmdError(createJSON, output.ErrValidation, "invalid points: %d (must not be negative)", createPoints)
+		}
+
+		if createURL != "" && !bean.ValidSourceURL(createURL) {
+			return cmdError(createJSON, output.ErrValidation, "invalid --url: %s (must be an http or https URL)", createURL)
+		}
 
 		body, err := resolveContent(createBody, createBodyFile)
 		if err != nil {
 			return cmdError(createJSON, output.ErrFileError, "%s", err)
 		}
 
+		sourceURL := createURL
+		if sourceURL == "" && cfg.Beans.URLTitles.Enabled {
+			sourceURL = firstURL(body)
+		}
+		if sourceURL != "" && len(args) == 0 && cfg.Beans.URLTitles.Enabled {
+			timeout := cfg.Beans.URLTitles.GetTimeout()
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			pageTitle, err := fetchPageTitle(ctx, &http.Client{Timeout: timeout}, sourceURL)
+			cancel()
+			if err != nil {
+				logger.Warn("could not fetch page title", "url", sourceURL, "error", err)
+			} else {
+				title = pageTitle
+			}
+		}
+
 		// Build GraphQL input
 		input := model.CreateBeanInput{Title: title}
 		if createStatus != "" {
@@ -64,19 +105,37 @@ var createCmd = &cobra.Command{
 		}
 		if createType != "" {
 			input.Type = &createType
-		} else {
-			defaultType := cfg.GetDefaultType()
-			input.Type = &defaultType
 		}
 		if createPriority != "" {
 			input.Priority = &createPriority
 		}
+		if cmd.Flags().Changed("points") {
+			input.Points = &createPoints
+		}
 		if body != "" {
 			input.Body = &body
 		}
 		if len(createTag) > 0 {
 			input.Tags = createTag
 		}
+		if len(createAlias) > 0 {
+			input.Aliases = createAlias
+		}
+		if createScope != "" {
+			input.Scope = &createScope
+		}
+		if createDraft {
+			input.Draft = &createDraft
+		}
+		if createLocal {
+			input.Local = &createLocal
+		}
+		if createDir != "" {
+			input.Dir = &createDir
+		}
+		if sourceURL != "" {
+			input.SourceURL = &sourceURL
+		}
 
 		// Add parent
 		if createParent != "" {
@@ -106,9 +165,14 @@ var createCmd = &cobra.Command{
 		}
 
 		if createJSON {
-			return output.Success(b, "Bean created")
+			return output.Success(jsonRedaction(), b, "Bean created")
 		}
 
+		if b.Draft {
+			fmt.Println(ui.Success.Render("Created draft ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
+			fmt.Println(ui.Muted.Render("Publish it with: beans publish " + b.ID))
+			return nil
+		}
 		fmt.Println(ui.Success.Render("Created ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
 		return nil
 	},
@@ -132,13 +196,20 @@ func init() {
 	createCmd.Flags().StringVarP(&createStatus, "status", "s", "", "Initial status ("+strings.Join(statusNames, ", ")+")")
 	createCmd.Flags().StringVarP(&createType, "type", "t", "", "Bean type ("+strings.Join(typeNames, ", ")+")")
 	createCmd.Flags().StringVarP(&createPriority, "priority", "p", "", "Priority level ("+strings.Join(priorityNames, ", ")+")")
+	createCmd.Flags().IntVar(&createPoints, "points", 0, "Story point estimate")
 	createCmd.Flags().StringVarP(&createBody, "body", "d", "", "Body content (use '-' to read from stdin)")
 	createCmd.Flags().StringVar(&createBodyFile, "body-file", "", "Read body from file")
 	createCmd.Flags().StringArrayVar(&createTag, "tag", nil, "Add tag (can be repeated)")
+	createCmd.Flags().StringArrayVar(&createAlias, "alias", nil, "Another identifier for the bean, e.g. its ID in another tracker (can be repeated)")
+	createCmd.Flags().StringVar(&createScope, "scope", "", "Monorepo component, as a path relative to the repository root (e.g. packages/api)")
 	createCmd.Flags().StringVar(&createParent, "parent", "", "Parent bean ID")
 	createCmd.Flags().StringArrayVar(&createBlocking, "blocking", nil, "ID of bean this blocks (can be repeated)")
 	createCmd.Flags().StringArrayVar(&createBlockedBy, "blocked-by", nil, "ID of bean that blocks this one (can be repeated)")
 	createCmd.Flags().StringVar(&createPrefix, "prefix", "", "Custom ID prefix (overrides config prefix)")
+	createCmd.Flags().BoolVar(&createDraft, "draft", false, "Create as a draft, hidden from list and stats until published")
+	createCmd.Flags().BoolVar(&createLocal, "local", false, "Create a private bean in .beans/local/, never committed, exported or synced")
+	createCmd.Flags().StringVar(&createDir, "dir", "", "Directory in .beans/ to create the bean in (applies its _defaults.yml)")
+	createCmd.Flags().StringVar(&createURL, "url", "", "Web page the bean is about, recorded as its source_url")
 	createCmd.Flags().BoolVar(&createJSON, "json", false, "Output as JSON")
 	createCmd.MarkFlagsMutuallyExclusive("body", "body-file")
 	rootCmd.AddCommand(createCmd)
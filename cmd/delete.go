@@ -31,8 +31,12 @@ var deleteCmd = &cobra.Command{
 	Short:   "Delete one or more beans",
 	Long: `Deletes one or more beans after confirmation (use -f to skip confirmation).
 
-If other beans reference the target bean(s) (as parent or via blocking), you will be
-warned and those references will be removed after confirmation. Use -f to skip all warnings.`,
+If other beans reference the target bean(s) (as parent, via blocking or typed links,
+or by mentioning them in their body), you will be warned and those references will be
+removed after confirmation: links are dropped and mentions ([[id]] or #id) are struck
+through. Use -f to skip all warnings.
+
+To give a bean a new ID instead, keeping references to it intact, use 'beans change-id'.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
@@ -42,16 +46,14 @@ warned and those references will be removed after confirmation. Use -f to skip a
 		var targets []beanWithLinks
 		for _, id := range args {
 			b, err := resolver.Query().Bean(ctx, id)
-			if err != nil {
-				return cmdError(deleteJSON, output.ErrNotFound, "failed to find bean: %v", err)
+			if err != nil || b == nil {
+				return beanNotFound(deleteJSON, id, err)
 			}
-			if b == nil {
-				return cmdError(deleteJSON, output.ErrNotFound, "bean not found: %s", id)
+			links := core.FindIncomingLinks(b.ID)
+			for _, m := range core.MentionedBy(b.ID) {
+				links = append(links, beancore.IncomingLink{FromBean: m, LinkType: beancore.MentionLinkType})
 			}
-			targets = append(targets, beanWithLinks{
-				bean:  b,
-				links: core.FindIncomingLinks(b.ID),
-			})
+			targets = append(targets, beanWithLinks{bean: b, links: links})
 		}
 
 		// Prompt for confirmation (JSON implies force)
@@ -77,13 +79,14 @@ warned and those references will be removed after confirmation. Use -f to skip a
 		// Output results
 		if deleteJSON {
 			if len(deleted) == 1 {
-				return output.Success(deleted[0], "Bean deleted")
+				return output.Success(jsonRedaction(), deleted[0], "Bean deleted")
 			}
 			return output.JSON(output.Response{
-				Success: true,
-				Beans:   deleted,
-				Count:   len(deleted),
-				Message: fmt.Sprintf("%d beans deleted", len(deleted)),
+				Success:   true,
+				Beans:     deleted,
+				Count:     len(deleted),
+				Message:   fmt.Sprintf("%d beans deleted", len(deleted)),
+				Redaction: jsonRedaction(),
 			})
 		}
 
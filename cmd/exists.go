@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var existsCmd = &cobra.Command{
+	Use:   "exists <id>",
+	Short: "Exit with 0 if a bean exists, 1 if not",
+	Long: `Checks whether a bean exists, printing nothing, for shell scripts and hooks:
+
+  beans exists abc1 && beans update abc1 --status completed
+
+Drafts count as existing.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := core.Get(args[0]); err != nil {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(existsCmd)
+}
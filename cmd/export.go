@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export beans to other trackers and formats",
+	Long: `Exports beans to other trackers and formats. Local beans (in .beans/local/)
+are private and never exported.`,
+}
+
+// withoutLocal leaves out local beans, which are private and never exported.
+func withoutLocal(beans []*bean.Bean) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if !beancore.IsLocalPath(b.Path) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	finishForce     bool
+	finishArchive   bool
+	finishAutoStash bool
+	finishJSON      bool
+)
+
+var finishCmd = &cobra.Command{
+	Use:   "finish <id>",
+	Short: "Finish working on a bean",
+	Long: `Marks a bean as completed. With git integration enabled:
+
+- Verifies the bean's branch (or pull request) is merged into the base branch.
+  If require_merge is set, unmerged beans are refused unless --force is given.
+- Records the merge commit and time.
+- Switches back to the base branch if the bean's branch is checked out.
+
+Use --archive to move the bean to the archive afterwards. If switching branches
+is blocked by uncommitted changes, you'll be offered to stash them; --auto-stash
+does so without asking.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(finishJSON, args[0], err)
+		}
+
+		if finishAutoStash {
+			core.SetAutoStash(true)
+		}
+		b, err := withStashRetry(finishJSON, func() (*bean.Bean, error) {
+			return resolver.Mutation().FinishBean(ctx, existing.ID, &finishForce, &finishArchive)
+		})
+		if err != nil {
+			return cmdError(finishJSON, output.ErrGit, "failed to finish bean: %v", err)
+		}
+
+		if finishJSON {
+			return output.Success(jsonRedaction(), b, "Bean finished")
+		}
+
+		fmt.Println(ui.Success.Render("Completed ") + ui.ID.Render(b.ID) + " " + b.Title)
+		if b.GitMergeCommit != "" {
+			fmt.Println(ui.Muted.Render("Merged in ") + b.GitMergeCommit[:min(7, len(b.GitMergeCommit))])
+		}
+		if finishArchive {
+			fmt.Println(ui.Muted.Render("Archived to ") + b.Path)
+		}
+		if core.IsGitFlowEnabled() && b.GitBranch != "" {
+			fmt.Println()
+			fmt.Println(ui.Muted.Render("Don't forget to commit the updated bean."))
+		}
+		return nil
+	},
+}
+
+func init() {
+	finishCmd.Flags().BoolVar(&finishForce, "force", false, "Finish even if the bean's branch is not merged")
+	finishCmd.Flags().BoolVar(&finishArchive, "archive", false, "Archive the bean after completing it")
+	finishCmd.Flags().BoolVar(&finishAutoStash, "auto-stash", false, "Stash uncommitted changes around the branch switch and restore them afterwards")
+	finishCmd.Flags().BoolVar(&finishJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(finishCmd)
+}
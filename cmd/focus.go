@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	focusShow  bool
+	focusClear bool
+	focusJSON  bool
+)
+
+var focusCmd = &cobra.Command{
+	Use:   "focus [id]",
+	Short: "Set or show the bean you're working on",
+	Long: `Remembers which bean you're currently working on. The focus is kept in local
+state (.beans/.state, which is not committed), so it's personal to this clone.
+
+The focused bean is highlighted in the TUI, shown at the top of 'beans activity',
+and added as a "Bean: <id>" commit trailer by the prepare-commit-msg hook (see
+'beans git install-hooks') when you're not on a bean branch.
+
+  beans focus abc1      # focus on abc1
+  beans focus           # show the focused bean (same as --show)
+  beans focus --clear   # stop focusing`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if focusClear {
+			if len(args) > 0 {
+				return cmdError(focusJSON, output.ErrValidation, "--clear doesn't take a bean ID")
+			}
+			if _, err := core.SetFocus(""); err != nil {
+				return cmdError(focusJSON, output.ErrFileError, "failed to clear focus: %v", err)
+			}
+			if focusJSON {
+				return output.SuccessMessage("Focus cleared")
+			}
+			fmt.Println(ui.Success.Render("Focus cleared"))
+			return nil
+		}
+
+		if focusShow || len(args) == 0 {
+			b, err := core.Focus()
+			if err != nil {
+				return cmdError(focusJSON, output.ErrFileError, "failed to read focus: %v", err)
+			}
+			if focusJSON {
+				if b == nil {
+					return output.SuccessMessage("No bean in focus")
+				}
+				return output.Success(jsonRedaction(), b, "Bean in focus")
+			}
+			if b == nil {
+				fmt.Println(ui.Muted.Render("No bean in focus. Set one with 'beans focus <id>'."))
+				return nil
+			}
+			fmt.Println(ui.ID.Render(b.ID) + " " + b.Title + ui.Muted.Render(" ("+b.Status+")"))
+			return nil
+		}
+
+		resolver := &graph.Resolver{Core: core}
+		existing, err := resolver.Query().Bean(context.Background(), args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(focusJSON, args[0], err)
+		}
+		b, err := core.SetFocus(existing.ID)
+		if err != nil {
+			return cmdError(focusJSON, output.ErrFileError, "failed to set focus: %v", err)
+		}
+		if focusJSON {
+			return output.Success(jsonRedaction(), b, "Focus set")
+		}
+		fmt.Println(ui.Success.Render("Focused on ") + ui.ID.Render(b.ID) + " " + b.Title)
+		return nil
+	},
+}
+
+func init() {
+	focusCmd.Flags().BoolVar(&focusShow, "show", false, "Show the bean in focus")
+	focusCmd.Flags().BoolVar(&focusClear, "clear", false, "Clear the focus")
+	focusCmd.Flags().BoolVar(&focusJSON, "json", false, "Output as JSON")
+	focusCmd.MarkFlagsMutuallyExclusive("show", "clear")
+	rootCmd.AddCommand(focusCmd)
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	forecastMilestone string
+	forecastWeeks     int
+	forecastRuns      int
+	forecastPoints    bool
+	forecastJSON      bool
+)
+
+// forecastMaxWeeks caps a single simulation run, so a throughput of mostly
+// empty weeks can't run forever.
+const forecastMaxWeeks = 520
+
+// forecastPercentiles are the confidence levels reported by beans forecast.
+var forecastPercentiles = []int{50, 85, 95}
+
+// forecastData is the result of a forecast for JSON output.
+type forecastData struct {
+	Milestone string `json:"milestone,omitempty"`
+	// Unit is what is counted: "beans" or "points".
+	Unit      string `json:"unit"`
+	Remaining int    `json:"remaining"`
+	// Throughput is the amount completed in each of the past weeks that were
+	// sampled, oldest first.
+	Throughput []int              `json:"throughput"`
+	Runs       int                `json:"runs"`
+	Forecasts  []forecastEstimate `json:"forecasts"`
+}
+
+// forecastEstimate is the completion date reached by a percentage of runs.
+type forecastEstimate struct {
+	Percentile int       `json:"percentile"`
+	Weeks      int       `json:"weeks"`
+	Date       time.Time `json:"date"`
+}
+
+var forecastCmd = &cobra.Command{
+	Use:   "forecast",
+	Short: "Forecast when the remaining work will be done",
+	Long: `Estimates when the open beans of a milestone (or, without --milestone, of the
+whole project) will be completed, based on how many beans were completed per
+week recently (see beans stats).
+
+The forecast is a Monte Carlo simulation: each run draws a random past week's
+throughput for every future week until the remaining work is done. The dates
+by which 50%, 85% and 95% of the runs finished give a likely completion range.
+
+Only leaf beans (beans without children) are counted, and scrapped beans are
+ignored. With --points, story points are forecast instead of beans.
+
+  beans forecast --milestone abc1
+  beans forecast --points --weeks 8`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		allBeans, err := resolver.Query().Beans(ctx, nil, nil)
+		if err != nil {
+			return cmdError(forecastJSON, output.ErrFileError, "querying beans: %v", err)
+		}
+
+		var scope []*bean.Bean
+		var milestone *bean.Bean
+		if forecastMilestone != "" {
+			milestone, err = resolver.Query().Bean(ctx, forecastMilestone)
+			if err != nil || milestone == nil {
+				return beanNotFound(forecastJSON, forecastMilestone, err)
+			}
+			scope = leafDescendants(milestone.ID, allBeans)
+		} else {
+			scope = leafBeans(allBeans)
+		}
+
+		// Only sample full weeks, as the current one is still under way
+		now := time.Now().UTC()
+		stats := buildStats(allBeans, now.AddDate(0, 0, -7), forecastWeeks)
+		var throughput []int
+		for _, w := range stats.Velocity {
+			if forecastPoints {
+				throughput = append(throughput, w.Points)
+			} else {
+				throughput = append(throughput, w.Beans)
+			}
+		}
+
+		remaining := remainingWork(scope, forecastPoints)
+		data, err := buildForecast(remaining, throughput, now, forecastRuns, rand.New(rand.NewPCG(uint64(now.UnixNano()), 0)))
+		if err != nil {
+			return cmdError(forecastJSON, output.ErrValidation, "%v", err)
+		}
+		if forecastPoints {
+			data.Unit = "points"
+		}
+		if milestone != nil {
+			data.Milestone = milestone.ID
+		}
+
+		if forecastJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(data)
+		}
+
+		title := ui.Bold.Render("Forecast")
+		if milestone != nil {
+			title += " " + ui.ID.Render(milestone.ID) + " " + milestone.Title
+		}
+		fmt.Println(title + ui.Muted.Render(fmt.Sprintf(" (%d %s remaining)", data.Remaining, data.Unit)))
+		if data.Remaining == 0 {
+			fmt.Println(ui.Success.Render("  Nothing left to do"))
+			return nil
+		}
+		sum := 0
+		for _, n := range throughput {
+			sum += n
+		}
+		fmt.Println(ui.Muted.Render(fmt.Sprintf("  based on %.1f %s per week over the last %d weeks",
+			float64(sum)/float64(len(throughput)), data.Unit, len(throughput))))
+		for _, f := range data.Forecasts {
+			fmt.Printf("  %d%%  %s  %s\n", f.Percentile, f.Date.Format("2006-01-02"),
+				ui.Muted.Render(fmt.Sprintf("(%d weeks)", f.Weeks)))
+		}
+		return nil
+	},
+}
+
+// leafBeans returns the beans without children, excluding scrapped ones.
+func leafBeans(beans []*bean.Bean) []*bean.Bean {
+	hasChildren := make(map[string]bool)
+	for _, b := range beans {
+		if b.Parent != "" {
+			hasChildren[b.Parent] = true
+		}
+	}
+	var leaves []*bean.Bean
+	for _, b := range beans {
+		if !hasChildren[b.ID] && b.Status != "scrapped" {
+			leaves = append(leaves, b)
+		}
+	}
+	return leaves
+}
+
+// remainingWork counts the beans (or sums their points) that aren't completed.
+func remainingWork(beans []*bean.Bean, usePoints bool) int {
+	remaining := 0
+	for _, b := range beans {
+		if b.Status == "completed" {
+			continue
+		}
+		if !usePoints {
+			remaining++
+		} else if b.Points != nil {
+			remaining += *b.Points
+		}
+	}
+	return remaining
+}
+
+// buildForecast simulates finishing the remaining work the given number of
+// times, drawing each future week's throughput from the past weeks.
+func buildForecast(remaining int, throughput []int, now time.Time, runs int, rng *rand.Rand) (forecastData, error) {
+	data := forecastData{Unit: "beans", Remaining: remaining, Throughput: throughput, Forecasts: []forecastEstimate{}}
+	if remaining == 0 {
+		return data, nil
+	}
+	sum := 0
+	for _, n := range throughput {
+		sum += n
+	}
+	if sum == 0 {
+		return data, errors.New("nothing was completed in the sampled weeks; cannot forecast")
+	}
+
+	runs = max(runs, 1)
+	data.Runs = runs
+	results := make([]int, runs)
+	for i := range results {
+		weeks, left := 0, remaining
+		for left > 0 && weeks < forecastMaxWeeks {
+			left -= throughput[rng.IntN(len(throughput))]
+			weeks++
+		}
+		results[i] = weeks
+	}
+	sort.Ints(results)
+
+	for _, p := range forecastPercentiles {
+		idx := (p*runs+99)/100 - 1
+		weeks := results[max(idx, 0)]
+		data.Forecasts = append(data.Forecasts, forecastEstimate{
+			Percentile: p,
+			Weeks:      weeks,
+			Date:       now.AddDate(0, 0, 7*weeks).Truncate(24 * time.Hour),
+		})
+	}
+	return data, nil
+}
+
+func init() {
+	forecastCmd.Flags().StringVarP(&forecastMilestone, "milestone", "m", "", "Forecast the beans below this milestone (default: all beans)")
+	forecastCmd.Flags().IntVar(&forecastWeeks, "weeks", 12, "Number of past weeks to sample throughput from")
+	forecastCmd.Flags().IntVar(&forecastRuns, "runs", 10000, "Number of simulation runs")
+	forecastCmd.Flags().BoolVar(&forecastPoints, "points", false, "Forecast story points instead of beans")
+	forecastCmd.Flags().BoolVar(&forecastJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(forecastCmd)
+}
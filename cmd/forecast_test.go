@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestBuildForecast(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	// Constant throughput: every run takes exactly three weeks
+	data, err := buildForecast(5, []int{2, 2, 2}, now, 100, rng)
+	if err != nil {
+		t.Fatalf("buildForecast() error = %v", err)
+	}
+	if len(data.Forecasts) != 3 {
+		t.Fatalf("Forecasts = %+v, want 3", data.Forecasts)
+	}
+	for _, f := range data.Forecasts {
+		if f.Weeks != 3 || !f.Date.Equal(time.Date(2025, 2, 5, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("%d%% forecast = %d weeks, %v; want 3 weeks, 2025-02-05", f.Percentile, f.Weeks, f.Date)
+		}
+	}
+
+	// Varying throughput: higher percentiles never finish earlier
+	data, err = buildForecast(20, []int{0, 1, 5, 3}, now, 1000, rng)
+	if err != nil {
+		t.Fatalf("buildForecast() error = %v", err)
+	}
+	for i := 1; i < len(data.Forecasts); i++ {
+		if data.Forecasts[i].Weeks < data.Forecasts[i-1].Weeks {
+			t.Errorf("forecasts not increasing: %+v", data.Forecasts)
+		}
+	}
+	if data.Forecasts[0].Weeks < 4 {
+		t.Errorf("50%% forecast = %d weeks, want at least 4 (max throughput is 5)", data.Forecasts[0].Weeks)
+	}
+
+	if _, err := buildForecast(5, []int{0, 0}, now, 100, rng); err == nil {
+		t.Error("buildForecast() without throughput should fail")
+	}
+	if data, err := buildForecast(0, []int{0}, now, 100, rng); err != nil || len(data.Forecasts) != 0 {
+		t.Errorf("buildForecast() with nothing remaining = %+v, %v", data, err)
+	}
+}
+
+func TestRemainingWork(t *testing.T) {
+	three, five := 3, 5
+	beans := []*bean.Bean{
+		{ID: "epic", Status: "todo", Points: &five},
+		{ID: "a", Status: "todo", Parent: "epic", Points: &three},
+		{ID: "b", Status: "completed", Parent: "epic", Points: &five},
+		{ID: "c", Status: "in-progress"},
+		{ID: "d", Status: "scrapped", Points: &five},
+	}
+
+	leaves := leafBeans(beans)
+	if len(leaves) != 3 {
+		t.Fatalf("leafBeans() = %d beans, want 3", len(leaves))
+	}
+	if got := remainingWork(leaves, false); got != 2 {
+		t.Errorf("remaining beans = %d, want 2", got)
+	}
+	if got := remainingWork(leaves, true); got != 3 {
+		t.Errorf("remaining points = %d, want 3", got)
+	}
+}
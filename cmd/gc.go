@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gcApply bool
+	gcJSON  bool
+)
+
+// gcResult is the JSON output of beans gc.
+type gcResult struct {
+	Found   []beancore.Garbage `json:"found"`
+	Removed []beancore.Garbage `json:"removed"`
+	Applied bool               `json:"applied"`
+}
+
+var gcCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Find and clean up stray files in the beans directory",
+	Long: `Looks through the beans directory for things that don't belong there:
+
+  junk-file      editor backups, merge leftovers (.orig, .rej) and the like
+  empty-dir      directories with nothing (but junk) in them
+  stale-focus    a focus ('beans focus') on a bean that no longer exists
+  unknown-file   files beans doesn't use
+  shadowed-bean  bean files hidden by another file with the same ID
+  bad-filename   bean files not named <id>--<slug>.md
+
+By default, only reports what it finds. Use --apply to remove junk files and
+empty directories and clear a stale focus. Unknown files, shadowed beans and
+bad filenames are never touched; look at them and move, merge or delete them
+by hand.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		found, err := core.FindGarbage()
+		if err != nil {
+			return cmdError(gcJSON, output.ErrFileError, "failed to scan beans directory: %v", err)
+		}
+
+		var removed []beancore.Garbage
+		if gcApply {
+			removed, err = core.RemoveGarbage(found)
+			if err != nil {
+				return cmdError(gcJSON, output.ErrFileError, "%v", err)
+			}
+		}
+
+		if gcJSON {
+			result := gcResult{Found: found, Removed: removed, Applied: gcApply}
+			if result.Found == nil {
+				result.Found = []beancore.Garbage{}
+			}
+			if result.Removed == nil {
+				result.Removed = []beancore.Garbage{}
+			}
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(result)
+		}
+
+		if len(found) == 0 {
+			fmt.Println(ui.Success.Render("Nothing to clean up"))
+			return nil
+		}
+		removable := 0
+		for _, g := range found {
+			line := fmt.Sprintf("%-14s %s  %s", g.Kind, ui.Bold.Render(g.Path), ui.Muted.Render(g.Reason))
+			if g.Removable {
+				removable++
+			} else {
+				line += "  " + ui.Warning.Render("(check by hand)")
+			}
+			fmt.Println(line)
+		}
+		switch {
+		case gcApply:
+			fmt.Println()
+			fmt.Println(ui.Success.Render(fmt.Sprintf("Cleaned up %d item(s)", len(removed))))
+		case removable > 0:
+			fmt.Println()
+			fmt.Println(ui.Muted.Render(fmt.Sprintf("Run with --apply to clean up %d item(s)", removable)))
+		}
+		return nil
+	},
+}
+
+func init() {
+	gcCmd.Flags().BoolVar(&gcApply, "apply", false, "Remove what can be removed (default: report only)")
+	gcCmd.Flags().BoolVar(&gcJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(gcCmd)
+}
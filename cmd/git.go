@@ -0,0 +1,374 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/gitflow"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var gitHooksForce bool
+
+var gitCmd = &cobra.Command{
+	Use:   "git",
+	Short: "Manage git integration",
+	Long:  `Commands for integrating beans with git, such as installing git hooks.`,
+}
+
+var gitInstallHooksCmd = &cobra.Command{
+	Use:   "install-hooks",
+	Short: "Install git hooks managed by beans",
+	Long: `Installs git hooks into .git/hooks:
+
+- prepare-commit-msg: when committing on a bean branch (e.g. "beans-abc1/my-feature"),
+  appends a "Bean: beans-abc1" trailer to the commit message. On other branches,
+  the bean in focus (see 'beans focus') is used.
+- post-merge, post-checkout: synchronize bean status with git branches after merges
+  and branch switches, like 'beans sync'. Controlled by git.sync_hook in .beans.yml:
+  "off" (default) does nothing, "dry-run" only logs what would change, and "apply"
+  updates the beans.
+
+If any hook exists that was not installed by beans, no hook is installed unless
+--force is given.
+Hooks never block a commit, even if beans is missing or fails.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gf, err := gitflow.New(".")
+		if err != nil {
+			return fmt.Errorf("git integration not available: %w", err)
+		}
+
+		paths, err := gf.InstallHooks(hookNames(), gitHooksForce)
+		for _, path := range paths {
+			fmt.Println(ui.Success.Render("Installed ") + ui.Muted.Render(path))
+		}
+		return err
+	},
+}
+
+var gitUninstallHooksCmd = &cobra.Command{
+	Use:   "uninstall-hooks",
+	Short: "Remove git hooks installed by beans",
+	Long:  `Removes git hooks previously installed with 'beans git install-hooks'. Hooks installed by other tools are left untouched.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gf, err := gitflow.New(".")
+		if err != nil {
+			return fmt.Errorf("git integration not available: %w", err)
+		}
+
+		for _, name := range hookNames() {
+			removed, err := gf.UninstallHook(name)
+			if errors.Is(err, gitflow.ErrForeignHook) {
+				fmt.Println(ui.Muted.Render(fmt.Sprintf("Skipped %s (not managed by beans)", name)))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if removed {
+				fmt.Println(ui.Success.Render("Removed ") + ui.Muted.Render(name))
+			}
+		}
+		return nil
+	},
+}
+
+var gitInstallMergeDriverCmd = &cobra.Command{
+	Use:   "install-merge-driver",
+	Short: "Use beans to merge bean files during git merges",
+	Long: `Registers 'beans git merge-driver' as a git merge driver for bean files:
+
+- Adds a [merge "beans"] section to .git/config (local to this clone; every
+  contributor needs to run this command once).
+- Adds a line routing bean files to the driver to .gitattributes (commit it).
+
+When both sides of a merge (or rebase) changed the same bean, the driver merges
+the front matter field by field instead of producing conflict markers: tags and
+relationships are combined, the newest timestamps win, and fields changed on
+both sides take the value from the most recently updated version. Only body
+conflicts are left for manual resolution, with the usual conflict markers.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gf, err := gitflow.New(".")
+		if err != nil {
+			return fmt.Errorf("git integration not available: %w", err)
+		}
+
+		path, err := gf.InstallMergeDriver(beansFilePattern())
+		if err != nil {
+			return err
+		}
+		fmt.Println(ui.Success.Render("Installed ") + "merge driver " + ui.Muted.Render("(.git/config)"))
+		fmt.Println(ui.Success.Render("Updated ") + ui.Muted.Render(path) + " (commit this file)")
+		return nil
+	},
+}
+
+var gitUninstallMergeDriverCmd = &cobra.Command{
+	Use:   "uninstall-merge-driver",
+	Short: "Stop using beans to merge bean files",
+	Long:  `Removes the merge driver installed with 'beans git install-merge-driver' from .git/config and .gitattributes.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gf, err := gitflow.New(".")
+		if err != nil {
+			return fmt.Errorf("git integration not available: %w", err)
+		}
+		if err := gf.UninstallMergeDriver(); err != nil {
+			return err
+		}
+		fmt.Println(ui.Success.Render("Removed ") + "merge driver")
+		return nil
+	},
+}
+
+// errMergeConflict is returned by the merge driver when the merged bean file
+// has conflict markers. Execute exits with status 1 on it, which tells git
+// that the merge conflicted.
+var errMergeConflict = errors.New("merge conflicts need manual resolution")
+
+var gitMergeDriverCmd = &cobra.Command{
+	Use:    "merge-driver <base> <ours> <theirs> [path]",
+	Short:  "Merge two versions of a bean file (called by git)",
+	Hidden: true,
+	Args:   cobra.RangeArgs(3, 4),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var contents [3][]byte
+		for i, path := range args[:3] {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", path, err)
+			}
+			contents[i] = data
+		}
+		base, ours, theirs := contents[0], contents[1], contents[2]
+
+		name := "bean"
+		id := ""
+		if len(args) > 3 {
+			name = args[3]
+			id, _ = bean.ParseFilename(filepath.Base(args[3]))
+		}
+
+		merged, conflicted, err := beancore.MergeBeanFiles(base, ours, theirs, id)
+		if err != nil {
+			// Not a valid bean on some side: fall back to a plain text merge
+			logger.Warn("not a valid bean, falling back to text merge", "file", name, "error", err)
+			text, conflict, textErr := gitflow.MergeText(string(base), string(ours), string(theirs), [3]string{"ours", "base", "theirs"})
+			if textErr != nil {
+				return textErr
+			}
+			merged, conflicted = []byte(text), conflict
+		}
+
+		// git expects the result in place of our version
+		if err := os.WriteFile(args[1], merged, 0644); err != nil {
+			return fmt.Errorf("writing merge result: %w", err)
+		}
+		if conflicted {
+			logger.Error("conflicts need manual resolution", "file", name)
+			// Already reported; git only needs the exit status
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return errMergeConflict
+		}
+		return nil
+	},
+}
+
+var gitPrepareCommitMsgCmd = &cobra.Command{
+	Use:    "prepare-commit-msg <message-file> [source] [sha]",
+	Short:  "Append the current bean's ID as a commit trailer (called by git hook)",
+	Hidden: true,
+	Args:   cobra.RangeArgs(1, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Leave merge and squash messages generated by git alone
+		if len(args) > 1 && (args[1] == "merge" || args[1] == "squash") {
+			return nil
+		}
+
+		gf, err := gitflow.New(".")
+		if err != nil {
+			return nil
+		}
+		branch, err := gf.GetCurrentBranch()
+		if err != nil {
+			return nil
+		}
+
+		// Off bean branches, fall back to the bean in focus
+		b := beanForBranch(branch)
+		if b == nil {
+			b, _ = core.Focus()
+		}
+		if b == nil || cfg.IsArchiveStatus(b.Status) {
+			return nil
+		}
+
+		msgFile := args[0]
+		content, err := os.ReadFile(msgFile)
+		if err != nil {
+			return fmt.Errorf("reading commit message: %w", err)
+		}
+		updated := gitflow.AppendTrailer(string(content), gitflow.BeanTrailer, b.ID)
+		if updated == string(content) {
+			return nil
+		}
+		return os.WriteFile(msgFile, []byte(updated), 0644)
+	},
+}
+
+var gitSyncHookCmd = &cobra.Command{
+	Use:    "sync-hook <hook> [args...]",
+	Short:  "Sync bean status after merges and checkouts (called by git hooks)",
+	Hidden: true,
+	Args:   cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mode := cfg.GetSyncHook()
+		if mode == config.SyncHookOff {
+			return nil
+		}
+
+		// post-checkout passes <prev> <new> <flag>; flag is 0 for file checkouts
+		if args[0] == gitflow.PostCheckoutHook && (len(args) < 4 || args[3] != "1") {
+			return nil
+		}
+
+		if !core.IsGitFlowEnabled() {
+			if err := core.EnableGitFlow("."); err != nil {
+				return nil
+			}
+		}
+
+		resolver := &graph.Resolver{Core: core}
+		dryRun := mode == config.SyncHookDryRun
+		updatedBeans, err := resolver.Mutation().SyncGitBranches(context.Background(), &dryRun)
+		if err != nil {
+			logger.Warn("branch sync failed", "error", err)
+			return nil
+		}
+
+		for _, b := range updatedBeans {
+			fmt.Fprintln(os.Stderr, syncHookMessage(b, dryRun))
+		}
+		return nil
+	},
+}
+
+// beanForBranch finds the bean a git branch belongs to, using recorded branches
+// and the configured branch template, falling back to parsing "<id>/<slug>".
+func beanForBranch(branch string) *bean.Bean {
+	ctx := context.Background()
+	resolver := &graph.Resolver{Core: core}
+
+	matches, err := resolver.Query().Beans(ctx, &model.BeanFilter{GitBranch: &branch}, nil)
+	if err == nil && len(matches) == 1 {
+		return matches[0]
+	}
+
+	beanID, ok := gitflow.ParseBranchName(branch)
+	if !ok {
+		return nil
+	}
+	b, err := resolver.Query().Bean(ctx, beanID)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// syncHookMessage describes a status change made (or previewed) by the sync hook.
+func syncHookMessage(b *bean.Bean, dryRun bool) string {
+	source := "branch " + b.GitBranch
+	if b.GitPRState != "" {
+		source = "pull request " + b.GitPRURL
+	}
+
+	var reason string
+	switch b.Status {
+	case "completed":
+		reason = source + " merged"
+	case "scrapped":
+		if b.GitPRState != "" {
+			reason = source + " closed"
+		} else {
+			reason = source + " deleted"
+		}
+	default:
+		reason = source
+	}
+	if dryRun {
+		return fmt.Sprintf("beans: [dry-run] would mark %s as %s (%s)", b.ID, b.Status, reason)
+	}
+	return fmt.Sprintf("beans: marked %s as %s (%s)", b.ID, b.Status, reason)
+}
+
+// withStashRetry runs fn, a mutation that may switch git branches. If it fails
+// because the working tree is dirty, the user is asked whether to stash their
+// changes and retry. Prompting is skipped in JSON mode and when stdin isn't a
+// terminal; use --auto-stash (or git.auto_stash) to stash non-interactively.
+func withStashRetry[T any](jsonMode bool, fn func() (T, error)) (T, error) {
+	v, err := fn()
+	if err == nil || !errors.Is(err, beancore.ErrDirtyWorkingTree) {
+		return v, err
+	}
+	if jsonMode || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return v, err
+	}
+
+	fmt.Print("Working tree has uncommitted changes. Stash them, switch branches, and restore them afterwards? [y/N] ")
+	reader := bufio.NewReader(os.Stdin)
+	response, _ := reader.ReadString('\n')
+	response = strings.TrimSpace(strings.ToLower(response))
+	if response != "y" && response != "yes" {
+		return v, err
+	}
+
+	core.SetAutoStash(true)
+	return fn()
+}
+
+// beansFilePattern returns the .gitattributes pattern matching all bean files,
+// relative to the repository root (the current directory).
+func beansFilePattern() string {
+	dir := ".beans"
+	if cwd, err := os.Getwd(); err == nil && cfg != nil {
+		if rel, err := filepath.Rel(cwd, cfg.ResolveBeansPath()); err == nil && !strings.HasPrefix(rel, "..") {
+			dir = filepath.ToSlash(rel)
+		}
+	}
+	return dir + "/**/*.md"
+}
+
+// hookNames returns the names of all hooks managed by beans, sorted.
+func hookNames() []string {
+	names := make([]string, 0, len(gitflow.HookScripts))
+	for name := range gitflow.HookScripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	gitInstallHooksCmd.Flags().BoolVar(&gitHooksForce, "force", false, "Overwrite existing hooks not managed by beans")
+	gitCmd.AddCommand(gitInstallHooksCmd)
+	gitCmd.AddCommand(gitUninstallHooksCmd)
+	gitCmd.AddCommand(gitInstallMergeDriverCmd)
+	gitCmd.AddCommand(gitUninstallMergeDriverCmd)
+	gitCmd.AddCommand(gitMergeDriverCmd)
+	gitCmd.AddCommand(gitPrepareCommitMsgCmd)
+	gitCmd.AddCommand(gitSyncHookCmd)
+	rootCmd.AddCommand(gitCmd)
+}
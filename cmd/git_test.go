@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGitMergeDriver(t *testing.T) {
+	const base = "---\ntitle: Task\nstatus: todo\n---\n\nLine one\n"
+	write := func(dir, name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("clean merge", func(t *testing.T) {
+		dir := t.TempDir()
+		args := []string{
+			write(dir, "base", base),
+			write(dir, "ours", strings.Replace(base, "status: todo", "status: in-progress", 1)),
+			write(dir, "theirs", strings.Replace(base, "Line one", "Line ONE", 1)),
+		}
+		if err := gitMergeDriverCmd.RunE(gitMergeDriverCmd, args); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+		merged, _ := os.ReadFile(args[1])
+		if !strings.Contains(string(merged), "status: in-progress") || !strings.Contains(string(merged), "Line ONE") {
+			t.Errorf("merged = %q, want both changes", merged)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		dir := t.TempDir()
+		args := []string{
+			write(dir, "base", base),
+			write(dir, "ours", strings.Replace(base, "Line one", "Line ours", 1)),
+			write(dir, "theirs", strings.Replace(base, "Line one", "Line theirs", 1)),
+		}
+		if err := gitMergeDriverCmd.RunE(gitMergeDriverCmd, args); !errors.Is(err, errMergeConflict) {
+			t.Fatalf("RunE() error = %v, want errMergeConflict", err)
+		}
+		merged, _ := os.ReadFile(args[1])
+		if !strings.Contains(string(merged), "<<<<<<<") {
+			t.Errorf("merged = %q, want conflict markers", merged)
+		}
+	})
+}
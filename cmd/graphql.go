@@ -23,6 +23,7 @@ var (
 	queryVariables  string
 	queryOperation  string
 	querySchemaOnly bool
+	queryReadOnly   bool
 )
 
 var graphqlCmd = &cobra.Command{
@@ -53,6 +54,9 @@ Examples:
   echo '{ beans { id title } }' | beans graphql
   cat query.graphql | beans graphql
 
+  # Reject mutations (e.g. for queries from untrusted sources)
+  beans graphql --read-only "$(cat query.graphql)"
+
   # Print the schema
   beans graphql --schema`,
 	Args: func(cmd *cobra.Command, args []string) error {
@@ -142,6 +146,11 @@ func executeQuery(query string, variables map[string]any, operationName string)
 	})
 
 	exec := executor.New(es)
+	exec.SetErrorPresenter(graph.PresentError)
+	exec.AroundFields(graph.RedactFields(core.Config().Beans.Redact))
+	if queryReadOnly {
+		exec.Use(graph.ReadOnly{})
+	}
 
 	ctx := graphql.StartOperationTrace(context.Background())
 	params := &graphql.RawParams{
@@ -155,6 +164,8 @@ func executeQuery(query string, variables map[string]any, operationName string)
 		return nil, formatGraphQLErrors(errs)
 	}
 
+	// Deferred fragments can't arrive later here; resolve them in place
+	graph.RemoveDefer(opCtx.Doc)
 	ctx = graphql.WithOperationContext(ctx, opCtx)
 	handler, ctx := exec.DispatchOperation(ctx, opCtx)
 	resp := handler(ctx)
@@ -206,5 +217,6 @@ func init() {
 	graphqlCmd.Flags().StringVarP(&queryVariables, "variables", "v", "", "Query variables as JSON string")
 	graphqlCmd.Flags().StringVarP(&queryOperation, "operation", "o", "", "Operation name (for multi-operation documents)")
 	graphqlCmd.Flags().BoolVar(&querySchemaOnly, "schema", false, "Print the GraphQL schema and exit")
+	graphqlCmd.Flags().BoolVar(&queryReadOnly, "read-only", false, "Reject mutations")
 	rootCmd.AddCommand(graphqlCmd)
 }
@@ -0,0 +1,488 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	importDryRun bool
+	importJSON   bool
+	importTags   []string
+	importParent string
+	importAs     string
+)
+
+// importedBean is a bean read from another tracker, before it is created.
+type importedBean struct {
+	// ID is set once the bean has been created.
+	ID        string   `json:"id,omitempty"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	Type      string   `json:"type,omitempty"`
+	Priority  string   `json:"priority,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	Body      string   `json:"body,omitempty"`
+	BlockedBy []string `json:"blocked_by,omitempty"`
+	// Source is where the bean came from, e.g. TODO.md:12.
+	Source string `json:"source"`
+	// Skipped is set for beans that were imported before.
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import beans from other trackers",
+}
+
+var importMarkdownCmd = &cobra.Command{
+	Use:   "markdown <path>",
+	Short: "Import beans from markdown checklists and notes",
+	Long: `Imports an ad-hoc markdown tracker, such as a TODO.md or an Obsidian vault,
+from a file or a directory of .md files (hidden directories are skipped).
+
+Files with task list items ("- [ ] ...") become one bean per top-level item:
+  - [ ] todo, [/] in-progress, [x] completed, [-] scrapped
+  - #tags in the item become tags
+  - Obsidian Tasks priorities (🔺 ⏫ 🔼 🔽 ⏬) become priorities; dates are dropped
+  - anything indented below an item, including sub-tasks, becomes its body
+  - unchecked items under a heading named like a status ("## In Progress",
+    "## Backlog", "## Done") get that status; other headings below the top
+    level become tags
+
+Other files become one bean each (per-file notes): the title comes from the
+front matter, the first heading or the file name; status, type, priority and
+tags from the front matter; the rest of the file becomes the body.
+
+Use --as to treat every file as checklists or as notes, and --dry-run to see
+what would be imported.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if importAs != "" && importAs != "checklist" && importAs != "notes" {
+			return cmdError(importJSON, output.ErrValidation, "invalid --as value: %s (must be checklist or notes)", importAs)
+		}
+		for _, tag := range importTags {
+			if err := bean.ValidateTag(tag); err != nil {
+				return cmdError(importJSON, output.ErrValidation, "%s", err)
+			}
+		}
+		if importParent != "" {
+			if _, err := core.Get(importParent); err != nil {
+				return cmdError(importJSON, output.ErrNotFound, "parent bean not found: %s", importParent)
+			}
+		}
+
+		imported, err := readMarkdownTracker(args[0], importAs)
+		if err != nil {
+			return cmdError(importJSON, output.ErrFileError, "%s", err)
+		}
+		if err := createImportedBeans(imported); err != nil {
+			return cmdError(importJSON, output.ErrFileError, "%s", err)
+		}
+
+		if importJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(imported)
+		}
+		printImportedBeans(cmd.OutOrStdout(), imported, importDryRun)
+		return nil
+	},
+}
+
+// createImportedBeans creates the imported beans with the --tag and --parent
+// flags applied, setting their IDs. With --dry-run, nothing is created.
+func createImportedBeans(imported []*importedBean) error {
+	resolver := &graph.Resolver{Core: core}
+	for _, ib := range imported {
+		ib.Tags = addImportTags(ib.Tags, importTags...)
+		if importDryRun {
+			continue
+		}
+
+		input := model.CreateBeanInput{Title: ib.Title, Status: &ib.Status, Tags: ib.Tags}
+		if ib.Type != "" {
+			input.Type = &ib.Type
+		} else {
+			defaultType := cfg.GetDefaultType()
+			input.Type = &defaultType
+		}
+		if ib.Priority != "" {
+			input.Priority = &ib.Priority
+		}
+		if ib.Body != "" {
+			input.Body = &ib.Body
+		}
+		if importParent != "" {
+			input.Parent = &importParent
+		}
+		b, err := resolver.Mutation().CreateBean(context.Background(), input)
+		if err != nil {
+			return fmt.Errorf("importing %s: %w", ib.Source, err)
+		}
+		ib.ID = b.ID
+	}
+	return nil
+}
+
+// printImportedBeans prints one line per imported bean and a summary.
+func printImportedBeans(w io.Writer, imported []*importedBean, dryRun bool) {
+	for _, ib := range imported {
+		id := ib.ID
+		if dryRun && !ib.Skipped {
+			id = "-"
+		}
+		note := ib.Source
+		if ib.Skipped {
+			note += ", already imported"
+		}
+		fmt.Fprintf(w, "%s %s %s %s\n", ui.ID.Render(id), ib.Title,
+			ui.Muted.Render("("+ib.Status+")"), ui.Muted.Render(note))
+	}
+	count := 0
+	for _, ib := range imported {
+		if !ib.Skipped {
+			count++
+		}
+	}
+	verb := "Imported"
+	if dryRun {
+		verb = "Would import"
+	}
+	fmt.Fprintln(w, ui.Muted.Render(fmt.Sprintf("%s %d bean(s)", verb, count)))
+}
+
+// readMarkdownTracker reads the beans in a markdown file, or in all .md
+// files below a directory. as is "checklist", "notes", or empty to decide
+// per file.
+func readMarkdownTracker(path, as string) ([]*importedBean, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	if info.IsDir() {
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() && p != path && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			if !d.IsDir() && strings.HasSuffix(d.Name(), ".md") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		files = []string{path}
+	}
+
+	var imported []*importedBean
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+		source := file
+		if info.IsDir() {
+			source, _ = filepath.Rel(path, file)
+		}
+		checklist := as == "checklist" || (as == "" && markdownTaskPattern.Match(content))
+		if checklist {
+			imported = append(imported, parseMarkdownChecklist(string(content), source)...)
+		} else if ib := parseMarkdownNote(string(content), source); ib != nil {
+			imported = append(imported, ib)
+		}
+	}
+	return imported, nil
+}
+
+var (
+	// markdownTaskPattern matches a task list item: indentation, checkbox
+	// state and text.
+	markdownTaskPattern = regexp.MustCompile(`(?m)^([ \t]*)(?:[-*+]|\d+[.)]) \[(.)\][ \t]+(.*)$`)
+	markdownHeading     = regexp.MustCompile(`^(#{1,6})[ \t]+(.+?)[ \t#]*$`)
+	markdownInlineTag   = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_/-]+)`)
+	// obsidianDate matches Obsidian Tasks date and recurrence fields.
+	obsidianDate = regexp.MustCompile(`[📅⏳🛫✅➕❌]️?\s*\d{4}-\d{2}-\d{2}|🔁[^📅⏳🛫✅➕❌]*`)
+)
+
+// obsidianPriorities maps Obsidian Tasks priority markers to priorities.
+var obsidianPriorities = map[string]string{
+	"🔺": "critical",
+	"⏫": "high",
+	"🔼": "normal",
+	"🔽": "low",
+	"⏬": "deferred",
+}
+
+// checkboxStatuses maps task list checkbox states to statuses. A blank box
+// gets the default status.
+var checkboxStatuses = map[string]string{
+	"x": "completed",
+	"X": "completed",
+	"/": "in-progress",
+	"-": "scrapped",
+}
+
+// parseMarkdownChecklist turns each top-level task list item in a markdown
+// file into a bean.
+func parseMarkdownChecklist(content, source string) []*importedBean {
+	var imported []*importedBean
+	var current *importedBean
+	var body []string
+	currentIndent := 0
+	headingStatus, headingTag := "", ""
+
+	finish := func() {
+		if current != nil {
+			current.Body = strings.TrimSpace(dedent(body))
+			imported = append(imported, current)
+		}
+		current, body = nil, nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := scanner.Text()
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+
+		if current != nil && (strings.TrimSpace(line) == "" || indent > currentIndent) {
+			body = append(body, line)
+			continue
+		}
+		if m := markdownTaskPattern.FindStringSubmatch(line); m != nil {
+			finish()
+			title, priority, tags := parseTaskText(m[3])
+			if title == "" {
+				continue
+			}
+			status, ok := checkboxStatuses[m[2]]
+			if !ok {
+				status = headingStatus
+			}
+			if status == "" {
+				status = cfg.GetDefaultStatus()
+			}
+			if headingTag != "" {
+				tags = addImportTags(tags, headingTag)
+			}
+			current = &importedBean{
+				Title:    title,
+				Status:   status,
+				Priority: priority,
+				Tags:     tags,
+				Source:   fmt.Sprintf("%s:%d", source, lineNo),
+			}
+			currentIndent = len(m[1])
+			continue
+		}
+		finish()
+		if m := markdownHeading.FindStringSubmatch(line); m != nil {
+			headingStatus, headingTag = "", ""
+			if status, ok := importStatus(m[2]); ok {
+				headingStatus = status
+			} else if len(m[1]) > 1 {
+				if tag := bean.Slugify(m[2]); bean.ValidateTag(tag) == nil {
+					headingTag = tag
+				}
+			}
+		}
+	}
+	finish()
+	return imported
+}
+
+// parseTaskText splits the text of a task list item into its title, the
+// Obsidian Tasks priority and #tags.
+func parseTaskText(text string) (title, priority string, tags []string) {
+	text = obsidianDate.ReplaceAllString(text, "")
+	for _, marker := range slices.Sorted(maps.Keys(obsidianPriorities)) {
+		if strings.Contains(text, marker) {
+			text = strings.ReplaceAll(text, marker, "")
+			priority = obsidianPriorities[marker]
+		}
+	}
+	for _, m := range markdownInlineTag.FindAllStringSubmatch(text, -1) {
+		if tag := bean.NormalizeTag(m[1]); bean.ValidateTag(tag) == nil {
+			tags = addImportTags(tags, tag)
+		}
+	}
+	text = markdownInlineTag.ReplaceAllStringFunc(text, func(s string) string {
+		if tag := bean.NormalizeTag(strings.TrimLeft(s, " \t#")); bean.ValidateTag(tag) == nil {
+			return ""
+		}
+		return s
+	})
+	return strings.Join(strings.Fields(text), " "), priority, tags
+}
+
+// noteFrontMatter holds the front matter fields a markdown note is imported
+// from. Tags may be a list or a comma- or space-separated string.
+type noteFrontMatter struct {
+	Title     string    `yaml:"title"`
+	Status    string    `yaml:"status"`
+	Type      string    `yaml:"type"`
+	Priority  string    `yaml:"priority"`
+	Tags      yaml.Node `yaml:"tags"`
+	Done      bool      `yaml:"done"`
+	Completed bool      `yaml:"completed"`
+}
+
+// parseMarkdownNote turns a markdown note into a bean. Returns nil for empty
+// files.
+func parseMarkdownNote(content, source string) *importedBean {
+	var fm noteFrontMatter
+	body := content
+	if rest, ok := strings.CutPrefix(content, "---\n"); ok {
+		if end := strings.Index(rest, "\n---"); end >= 0 {
+			if yaml.Unmarshal([]byte(rest[:end+1]), &fm) == nil {
+				body = strings.TrimPrefix(rest[end+4:], "\n")
+			}
+		}
+	}
+	body = strings.TrimSpace(body)
+	if body == "" && fm.Title == "" {
+		return nil
+	}
+
+	ib := &importedBean{Title: fm.Title, Status: cfg.GetDefaultStatus(), Source: source}
+	if ib.Title == "" {
+		if first, rest, _ := strings.Cut(body, "\n"); strings.HasPrefix(first, "# ") {
+			ib.Title = strings.TrimSpace(strings.TrimPrefix(first, "# "))
+			body = strings.TrimSpace(rest)
+		} else {
+			ib.Title = strings.TrimSuffix(filepath.Base(source), filepath.Ext(source))
+		}
+	}
+	ib.Body = body
+
+	if status, ok := importStatus(fm.Status); ok {
+		ib.Status = status
+	} else if fm.Done || fm.Completed {
+		ib.Status = "completed"
+	}
+	if cfg.IsValidType(fm.Type) {
+		ib.Type = fm.Type
+	}
+	ib.Priority = importPriority(fm.Priority)
+
+	var tags []string
+	switch fm.Tags.Kind {
+	case yaml.SequenceNode:
+		fm.Tags.Decode(&tags)
+	case yaml.ScalarNode:
+		tags = strings.FieldsFunc(fm.Tags.Value, func(r rune) bool { return r == ',' || r == ' ' })
+	}
+	for _, tag := range tags {
+		if tag = bean.NormalizeTag(strings.TrimPrefix(tag, "#")); bean.ValidateTag(tag) == nil {
+			ib.Tags = addImportTags(ib.Tags, tag)
+		}
+	}
+	return ib
+}
+
+// importStatus maps a status name from another tracker, such as "Done" or
+// "In Progress", to a status.
+func importStatus(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", false
+	}
+	for _, candidate := range []string{name, strings.ReplaceAll(name, " ", "-"), strings.ReplaceAll(name, " ", "_")} {
+		if cfg.IsValidStatus(candidate) {
+			return candidate, true
+		}
+		if status, ok := cfg.StatusAliases()[candidate]; ok {
+			return status, true
+		}
+		if status, ok := bean.LegacyStatuses[candidate]; ok && cfg.IsValidStatus(status) {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+// importPriority maps a priority name from another tracker to a priority, or
+// returns an empty string.
+func importPriority(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch name {
+	case "highest", "urgent":
+		return "critical"
+	case "medium":
+		return "normal"
+	case "lowest":
+		return "deferred"
+	}
+	if cfg.IsValidPriority(name) {
+		return name
+	}
+	return ""
+}
+
+// addImportTags adds tags that aren't there yet, keeping their order.
+func addImportTags(tags []string, add ...string) []string {
+	for _, tag := range add {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// dedent removes the indentation common to all non-blank lines.
+func dedent(lines []string) string {
+	common := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if common < 0 || indent < common {
+			common = indent
+		}
+	}
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) >= common && common > 0 {
+			line = line[common:]
+		}
+		out[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Join(out, "\n")
+}
+
+func init() {
+	importMarkdownCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without creating beans")
+	importMarkdownCmd.Flags().BoolVar(&importJSON, "json", false, "Output as JSON")
+	importMarkdownCmd.Flags().StringArrayVar(&importTags, "tag", nil, "Add tag to every imported bean (can be repeated)")
+	importMarkdownCmd.Flags().StringVar(&importParent, "parent", "", "Parent bean ID for every imported bean")
+	importMarkdownCmd.Flags().StringVar(&importAs, "as", "", "Treat every file as checklist or notes (default: decide per file)")
+	importCmd.AddCommand(importMarkdownCmd)
+	rootCmd.AddCommand(importCmd)
+}
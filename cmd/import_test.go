@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestParseMarkdownChecklist(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	content := `# TODO
+
+- [ ] Write docs #docs
+- [x] Ship v1 ✅ 2024-05-01
+- [/] Refactor parser ⏫ 📅 2024-06-01
+  Needs the new lexer first.
+  - [ ] Tokens
+  - [x] Positions
+
+## Backend
+
+- [ ] Add caching #Perf #123
+- [-] Drop XML support
+
+## In Progress
+
+- [ ] Migrate database
+- [x] Rotate keys
+
+Some closing remarks.
+`
+	got := parseMarkdownChecklist(content, "TODO.md")
+
+	want := []*importedBean{
+		{Title: "Write docs", Status: "todo", Tags: []string{"docs"}, Source: "TODO.md:3"},
+		{Title: "Ship v1", Status: "completed", Source: "TODO.md:4"},
+		{Title: "Refactor parser", Status: "in-progress", Priority: "high", Source: "TODO.md:5",
+			Body: "Needs the new lexer first.\n- [ ] Tokens\n- [x] Positions"},
+		{Title: "Add caching #123", Status: "todo", Tags: []string{"perf", "backend"}, Source: "TODO.md:12"},
+		{Title: "Drop XML support", Status: "scrapped", Tags: []string{"backend"}, Source: "TODO.md:13"},
+		{Title: "Migrate database", Status: "in-progress", Source: "TODO.md:17"},
+		{Title: "Rotate keys", Status: "completed", Source: "TODO.md:18"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d beans, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("bean %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseMarkdownNote(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	tests := []struct {
+		name    string
+		content string
+		want    *importedBean
+	}{
+		{
+			name:    "front matter",
+			content: "---\ntitle: Fix login\nstatus: Done\npriority: highest\ntype: bug\ntags: [auth, \"#Security\"]\n---\n\nUsers get logged out.\n",
+			want: &importedBean{Title: "Fix login", Status: "completed", Type: "bug", Priority: "critical",
+				Tags: []string{"auth", "security"}, Body: "Users get logged out.", Source: "notes/login.md"},
+		},
+		{
+			name:    "heading title",
+			content: "# Caching layer\n\nAdd a cache in front of the API.\n",
+			want:    &importedBean{Title: "Caching layer", Status: "todo", Body: "Add a cache in front of the API.", Source: "notes/login.md"},
+		},
+		{
+			name:    "file name title and string tags",
+			content: "---\ntags: ops, infra\ndone: true\n---\nRotate the certificates.\n",
+			want: &importedBean{Title: "login", Status: "completed", Tags: []string{"ops", "infra"},
+				Body: "Rotate the certificates.", Source: "notes/login.md"},
+		},
+		{
+			name:    "empty",
+			content: "\n\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseMarkdownNote(tt.content, "notes/login.md")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMarkdownNote() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadMarkdownTracker(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, ".obsidian"), 0755)
+	os.WriteFile(filepath.Join(dir, ".obsidian", "ignored.md"), []byte("- [ ] Hidden\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "tasks.md"), []byte("- [ ] One\n- [ ] Two\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "idea.md"), []byte("# An idea\n\nDetails.\n"), 0644)
+
+	got, err := readMarkdownTracker(dir, "")
+	if err != nil {
+		t.Fatalf("readMarkdownTracker() error = %v", err)
+	}
+	var titles []string
+	for _, ib := range got {
+		titles = append(titles, ib.Title)
+	}
+	if want := []string{"An idea", "One", "Two"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("titles = %q, want %q", titles, want)
+	}
+
+	got, err = readMarkdownTracker(filepath.Join(dir, "tasks.md"), "notes")
+	if err != nil {
+		t.Fatalf("readMarkdownTracker() error = %v", err)
+	}
+	if len(got) != 1 || got[0].Title != "tasks" {
+		t.Errorf("readMarkdownTracker(--as notes) = %+v, want one note", got)
+	}
+}
+
+func TestParseTaskTextSeveralPriorities(t *testing.T) {
+	title, first, _ := parseTaskText("Ship it 🔺 ⏬")
+	if title != "Ship it" {
+		t.Errorf("title = %q, want Ship it", title)
+	}
+	for range 20 {
+		if _, priority, _ := parseTaskText("Ship it 🔺 ⏬"); priority != first {
+			t.Fatalf("priority = %q, then %q; want the same every time", first, priority)
+		}
+	}
+}
@@ -1,20 +1,25 @@
 package cmd
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
-	"github.com/spf13/cobra"
 	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/output"
+	"github.com/spf13/cobra"
 )
 
 var (
-	initJSON       bool
-	initClaudeHooks bool
+	initJSON           bool
+	initClaudeHooks    bool
+	initNoUserDefaults bool
+	initYes            bool
 )
 
 var initCmd = &cobra.Command{
@@ -22,6 +27,23 @@ var initCmd = &cobra.Command{
 	Short: "Initialize a beans project",
 	Long: `Creates a .beans directory and .beans.yml config file in the current directory.
 
+When run in a terminal, asks for the ID prefix, ID length, whether to use git
+integration (with the base branch detected from the repository) and whether
+to create an example bean. With --yes, the defaults are taken without asking
+and the example bean is created. Without a terminal or with --json, the
+defaults are taken and no example bean is created.
+
+Settings in ~/.config/beans/config.yml (or $XDG_CONFIG_HOME/beans/config.yml)
+are applied to the new config, so you can set your preferred ID length, prefix
+convention and other defaults once. In its prefix, {dir} stands for the
+project directory's name:
+
+  beans:
+    id_length: 6
+    prefix: "{dir}-"
+
+Use --no-user-defaults to ignore that file.
+
 Use --claude-hooks to also configure Claude Code hooks for automatic beans prime injection.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var projectDir string
@@ -63,10 +85,31 @@ Use --claude-hooks to also configure Claude Code hooks for automatic beans prime
 			dirName = filepath.Base(dir)
 		}
 
-		// Create default config file with directory name as prefix
-		// Config is saved at project root (not inside .beans/)
-		defaultCfg := config.DefaultWithPrefix(dirName + "-")
+		// Create default config file with directory name as prefix, applying
+		// the user's defaults. Config is saved at project root (not inside .beans/)
+		userDefaults := ""
+		if !initNoUserDefaults {
+			if path, err := config.UserDefaultsPath(); err == nil {
+				userDefaults = path
+			}
+		}
+		defaultCfg, err := config.NewProjectConfig(dirName, userDefaults)
+		if err != nil {
+			if initJSON {
+				return output.Error(output.ErrValidation, err.Error())
+			}
+			return fmt.Errorf("failed to apply user defaults: %w", err)
+		}
 		defaultCfg.SetConfigDir(projectDir)
+
+		createExample := initYes
+		if !initYes && !initJSON && isInteractive() {
+			wizard := &initWizard{in: bufio.NewReader(cmd.InOrStdin()), out: cmd.OutOrStdout()}
+			if createExample, err = wizard.run(defaultCfg, projectDir); err != nil {
+				return err
+			}
+		}
+
 		if err := defaultCfg.Save(projectDir); err != nil {
 			if initJSON {
 				return output.Error(output.ErrFileError, err.Error())
@@ -74,6 +117,23 @@ Use --claude-hooks to also configure Claude Code hooks for automatic beans prime
 			return fmt.Errorf("failed to create config: %w", err)
 		}
 
+		// Keep personal settings out of version control
+		if err := ensureGitignored(projectDir, config.LocalConfigFileName); err != nil {
+			if initJSON {
+				return output.Error(output.ErrFileError, err.Error())
+			}
+			return fmt.Errorf("failed to update .gitignore: %w", err)
+		}
+
+		if createExample {
+			if err := createExampleBean(beansDir, defaultCfg); err != nil {
+				if initJSON {
+					return output.Error(output.ErrFileError, err.Error())
+				}
+				return fmt.Errorf("failed to create example bean: %w", err)
+			}
+		}
+
 		// Configure Claude Code hooks if requested
 		if initClaudeHooks {
 			if err := configureClaudeHooks(projectDir); err != nil {
@@ -89,6 +149,9 @@ Use --claude-hooks to also configure Claude Code hooks for automatic beans prime
 		}
 
 		fmt.Println("Initialized beans project")
+		if createExample {
+			fmt.Println("Created an example bean (see beans list)")
+		}
 		if initClaudeHooks {
 			fmt.Println("Configured Claude Code hooks in .claude/settings.json")
 		}
@@ -96,6 +159,26 @@ Use --claude-hooks to also configure Claude Code hooks for automatic beans prime
 	},
 }
 
+// ensureGitignored adds a pattern to the project's .gitignore, creating the
+// file if needed. Nothing is changed if the pattern is already listed.
+func ensureGitignored(projectDir, pattern string) error {
+	path := filepath.Join(projectDir, ".gitignore")
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line == pattern || line == "/"+pattern {
+			return nil
+		}
+	}
+	if len(data) > 0 && !bytes.HasSuffix(data, []byte("\n")) {
+		data = append(data, '\n')
+	}
+	data = append(data, pattern+"\n"...)
+	return os.WriteFile(path, data, 0644)
+}
+
 // configureClaudeHooks creates or updates .claude/settings.json with beans prime hooks
 func configureClaudeHooks(projectDir string) error {
 	claudeDir := filepath.Join(projectDir, ".claude")
@@ -197,5 +280,7 @@ func hasBeansHook(hooks map[string]any, hookType string) bool {
 func init() {
 	initCmd.Flags().BoolVar(&initJSON, "json", false, "Output as JSON")
 	initCmd.Flags().BoolVar(&initClaudeHooks, "claude-hooks", false, "Configure Claude Code hooks for beans prime")
+	initCmd.Flags().BoolVarP(&initYes, "yes", "y", false, "Don't ask, take the defaults and create an example bean")
+	initCmd.Flags().BoolVar(&initNoUserDefaults, "no-user-defaults", false, "Ignore the user defaults in ~/.config/beans/config.yml")
 	rootCmd.AddCommand(initCmd)
 }
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestInitWizard(t *testing.T) {
+	tests := []struct {
+		name        string
+		git         bool
+		input       string
+		wantPrefix  string
+		wantLength  int
+		wantGit     bool
+		wantBase    string
+		wantExample bool
+	}{
+		{
+			name:        "defaults",
+			git:         true,
+			input:       "\n\n\n\n\n",
+			wantPrefix:  "proj-",
+			wantLength:  4,
+			wantGit:     true,
+			wantBase:    "main",
+			wantExample: true,
+		},
+		{
+			name:        "custom answers with a retry",
+			git:         true,
+			input:       "app-\n99\n6\nn\nno\n",
+			wantPrefix:  "app-",
+			wantLength:  6,
+			wantGit:     false,
+			wantBase:    "main",
+			wantExample: false,
+		},
+		{
+			name:        "no git repository",
+			input:       "\n\nmaybe\ny\n",
+			wantPrefix:  "proj-",
+			wantLength:  4,
+			wantGit:     false,
+			wantBase:    "main",
+			wantExample: true,
+		},
+		{
+			name:        "end of input takes defaults",
+			git:         true,
+			input:       "x-",
+			wantPrefix:  "x-",
+			wantLength:  4,
+			wantGit:     true,
+			wantBase:    "main",
+			wantExample: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.git {
+				if _, err := git.PlainInit(dir, false); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cfg := config.DefaultWithPrefix("proj-")
+			var out bytes.Buffer
+			w := &initWizard{in: bufio.NewReader(strings.NewReader(tt.input)), out: &out}
+
+			example, err := w.run(cfg, dir)
+			if err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+			got := cfg.Beans
+			if got.Prefix != tt.wantPrefix || got.IDLength != tt.wantLength || got.Git.Enabled != tt.wantGit || got.Git.BaseBranch != tt.wantBase || example != tt.wantExample {
+				t.Errorf("prefix %q, id length %d, git %v, base %q, example %v; want %q, %d, %v, %q, %v\noutput:\n%s",
+					got.Prefix, got.IDLength, got.Git.Enabled, got.Git.BaseBranch, example,
+					tt.wantPrefix, tt.wantLength, tt.wantGit, tt.wantBase, tt.wantExample, out.String())
+			}
+		})
+	}
+}
+
+func TestCreateExampleBean(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := createExampleBean(beansDir, config.DefaultWithPrefix("proj-")); err != nil {
+		t.Fatalf("createExampleBean() error = %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(beansDir, "proj-*.md"))
+	if len(files) != 1 {
+		t.Fatalf("got %d bean files, want 1", len(files))
+	}
+	data, _ := os.ReadFile(files[0])
+	if !strings.Contains(string(data), exampleBeanTitle) || !strings.Contains(string(data), "status: todo") {
+		t.Errorf("example bean = %s", data)
+	}
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/gitflow"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/ui"
+	"golang.org/x/term"
+)
+
+// exampleBeanTitle and exampleBeanBody describe the bean `beans init` can
+// create to show what beans look like.
+const (
+	exampleBeanTitle = "Try out beans"
+	exampleBeanBody  = `This is an example bean. Beans are markdown files in .beans/ with a bit of
+front matter; edit them with any editor or through the beans CLI.
+
+- [ ] List beans with ` + "`beans list`" + `
+- [ ] Start this bean with ` + "`beans update <id> --status in-progress`" + `
+- [ ] Browse beans with ` + "`beans tui`" + `
+- [ ] Delete this bean with ` + "`beans delete <id>`"
+)
+
+// initWizard asks the questions of the interactive `beans init`.
+type initWizard struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// run asks for the project's settings, starting from cfg, and updates cfg
+// with the answers. It returns whether to create an example bean.
+func (w *initWizard) run(cfg *config.Config, projectDir string) (bool, error) {
+	prefix, err := w.ask("ID prefix", cfg.Beans.Prefix, nil)
+	if err != nil {
+		return false, err
+	}
+	cfg.Beans.Prefix = prefix
+
+	idLength, err := w.ask("ID length", strconv.Itoa(cfg.Beans.IDLength), func(s string) error {
+		if n, err := strconv.Atoi(s); err != nil || n < 3 || n > 32 {
+			return fmt.Errorf("enter a number between 3 and 32")
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	cfg.Beans.IDLength, _ = strconv.Atoi(idLength)
+
+	// Offer git integration if the project is in a git repository
+	gf, gitErr := gitflow.New(projectDir)
+	useGit := false
+	if gitErr == nil {
+		if useGit, err = w.confirm("Enable git integration (branches for beans)?", cfg.Beans.Git.Enabled); err != nil {
+			return false, err
+		}
+	}
+	cfg.Beans.Git.Enabled = useGit
+	if useGit {
+		base := cfg.Beans.Git.BaseBranch
+		if detected, err := gf.GetMainBranch(); err == nil && detected != "" {
+			base = detected
+		}
+		if base, err = w.ask("Base branch", base, nil); err != nil {
+			return false, err
+		}
+		cfg.Beans.Git.BaseBranch = base
+	}
+
+	return w.confirm("Create an example bean?", true)
+}
+
+// ask asks a question and returns the answer, or def if the answer is
+// empty. Answers that fail validate are asked again.
+func (w *initWizard) ask(question, def string, validate func(string) error) (string, error) {
+	for {
+		answer, err := w.prompt(question, def)
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = def
+		}
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				fmt.Fprintln(w.out, ui.Warning.Render(err.Error()))
+				continue
+			}
+		}
+		return answer, nil
+	}
+}
+
+// confirm asks a yes/no question.
+func (w *initWizard) confirm(question string, def bool) (bool, error) {
+	hint := "y/N"
+	if def {
+		hint = "Y/n"
+	}
+	for {
+		answer, err := w.prompt(question, hint)
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(answer) {
+		case "":
+			return def, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(w.out, ui.Warning.Render("answer yes or no"))
+	}
+}
+
+// prompt prints a question with a hint and reads a line. At the end of the
+// input, the answer is empty.
+func (w *initWizard) prompt(question, hint string) (string, error) {
+	fmt.Fprintf(w.out, "%s %s: ", question, ui.Muted.Render("["+hint+"]"))
+	line, err := w.in.ReadString('\n')
+	if err == io.EOF {
+		fmt.Fprintln(w.out)
+		err = nil
+	}
+	return strings.TrimSpace(line), err
+}
+
+// createExampleBean adds the example bean to a freshly initialized project.
+func createExampleBean(beansDir string, cfg *config.Config) error {
+	c := beancore.New(beansDir, cfg)
+	c.SetLogger(logger)
+	if err := c.Load(); err != nil {
+		return err
+	}
+	body := exampleBeanBody
+	status := cfg.GetDefaultStatus()
+	resolver := &graph.Resolver{Core: c}
+	_, err := resolver.Mutation().CreateBean(context.Background(), model.CreateBeanInput{
+		Title:  exampleBeanTitle,
+		Status: &status,
+		Body:   &body,
+	})
+	return err
+}
+
+// isInteractive returns true if beans runs in a terminal that can answer questions.
+func isInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintJSON   bool
+	lintStrict bool
+)
+
+type lintResult struct {
+	Success  bool                 `json:"success"`
+	Errors   int                  `json:"errors"`
+	Warnings int                  `json:"warnings"`
+	Issues   []beancore.LintIssue `json:"issues"`
+}
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [<id>...]",
+	Short: "Check bean content against the project's writing rules",
+	Long: `Checks the content of all beans, or only the given ones, for:
+
+  empty-body           beans other than tasks without a description
+  acceptance-criteria  features without an "Acceptance Criteria" section
+  title-length         titles over the length budget (beans.lint.max_title_length,
+                       else beans.titles.max_length, else 72)
+  tag-format           tags that aren't lowercase words joined by hyphens
+
+Each rule can be set to off, warn or error in .beans.yml:
+
+  beans:
+    lint:
+      max_title_length: 60
+      rules:
+        acceptance-criteria: error
+        empty-body: off
+
+Exits with status 1 if any error-level issue is found, or with --strict if
+any issue is found at all, so it can gate CI.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if errs := cfg.ValidateLint(); len(errs) > 0 {
+			return cmdError(lintJSON, output.ErrValidation, "invalid lint settings: %s", errs[0])
+		}
+		issues, err := core.LintAll(args...)
+		if err != nil {
+			return cmdError(lintJSON, output.ErrNotFound, "%v", err)
+		}
+
+		result := lintResult{Issues: issues}
+		for _, issue := range issues {
+			if issue.Level == config.LintError {
+				result.Errors++
+			} else {
+				result.Warnings++
+			}
+		}
+		result.Success = result.Errors == 0 && (!lintStrict || result.Warnings == 0)
+
+		if lintJSON {
+			if result.Issues == nil {
+				result.Issues = []beancore.LintIssue{}
+			}
+			data, _ := json.MarshalIndent(result, "", "  ")
+			fmt.Println(string(data))
+		} else {
+			for _, issue := range issues {
+				mark := ui.Warning.Render("!")
+				if issue.Level == config.LintError {
+					mark = ui.Danger.Render("✗")
+				}
+				fmt.Printf("  %s %s: %s %s\n", mark, ui.ID.Render(issue.BeanID), issue.Message, ui.Muted.Render("("+issue.Rule+")"))
+			}
+			switch {
+			case len(issues) == 0:
+				fmt.Println(ui.Success.Render("No lint issues found"))
+			case result.Success:
+				fmt.Println()
+				fmt.Println(ui.Warning.Render(fmt.Sprintf("%d warning(s)", result.Warnings)))
+			default:
+				fmt.Println()
+				fmt.Println(ui.Danger.Render(fmt.Sprintf("%d error(s), %d warning(s)", result.Errors, result.Warnings)))
+			}
+		}
+
+		if !result.Success {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	lintCmd.Flags().BoolVar(&lintJSON, "json", false, "Output as JSON")
+	lintCmd.Flags().BoolVar(&lintStrict, "strict", false, "Fail on warnings too")
+	rootCmd.AddCommand(lintCmd)
+}
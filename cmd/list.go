@@ -4,39 +4,56 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"sort"
+	"time"
 
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/graph"
 	"github.com/hmans/beans/internal/graph/model"
 	"github.com/hmans/beans/internal/output"
 	"github.com/hmans/beans/internal/ui"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"golang.org/x/term"
 )
 
 var (
-	listJSON       bool
-	listSearch     string
-	listStatus     []string
-	listNoStatus   []string
-	listType       []string
-	listNoType     []string
-	listPriority   []string
-	listNoPriority []string
-	listTag        []string
-	listNoTag      []string
-	listHasParent   bool
-	listNoParent    bool
-	listParentID    string
-	listHasBlocking bool
-	listNoBlocking  bool
-	listIsBlocked   bool
-	listReady      bool
-	listQuiet      bool
-	listSort       string
-	listFull       bool
+	listJSON         bool
+	listSearch       string
+	listFilter       string
+	listTitleMatch   string
+	listTitleFuzzy   string
+	listStatus       []string
+	listNoStatus     []string
+	listType         []string
+	listNoType       []string
+	listPriority     []string
+	listNoPriority   []string
+	listTag          []string
+	listNoTag        []string
+	listScope        []string
+	listNoScope      []string
+	listHasParent    bool
+	listNoParent     bool
+	listParentID     string
+	listHasBlocking  bool
+	listNoBlocking   bool
+	listHasLink      []string
+	listIsBlocked    bool
+	listReady        bool
+	listQuiet        bool
+	listSort         string
+	listGroupBy      string
+	listFull         bool
+	listPoints       bool
+	listEstimates    bool
+	listSLABreached  bool
+	listDrafts       bool
+	listCreatedSince string
+	listCreatedUntil string
+	listUpdatedSince string
+	listUpdatedUntil string
 )
 
 var listCmd = &cobra.Command{
@@ -57,75 +74,58 @@ Search Syntax (--search/-S):
   user OR login  Either term matches
   slug:auth      Search only in slug field
   title:login    Search only in title field
-  body:auth      Search only in body field`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		// Build GraphQL filter from CLI flags
-		filter := &model.BeanFilter{
-			Status:          listStatus,
-			ExcludeStatus:   listNoStatus,
-			Type:            listType,
-			ExcludeType:     listNoType,
-			Priority:        listPriority,
-			ExcludePriority: listNoPriority,
-			Tags:            listTag,
-			ExcludeTags:     listNoTag,
-		}
-
-		// Add search filter if provided
-		if listSearch != "" {
-			filter.Search = &listSearch
-		}
-
-		// Add parent/blocks filters
-		if listHasParent {
-			filter.HasParent = &listHasParent
-		}
-		if listNoParent {
-			filter.NoParent = &listNoParent
-		}
-		if listParentID != "" {
-			filter.ParentID = &listParentID
-		}
-		if listHasBlocking {
-			filter.HasBlocking = &listHasBlocking
-		}
-		if listNoBlocking {
-			filter.NoBlocking = &listNoBlocking
-		}
-		// --ready and --is-blocked are mutually exclusive
-		if listReady && listIsBlocked {
-			return fmt.Errorf("--ready and --is-blocked are mutually exclusive")
-		}
+  body:auth      Search only in body field
 
-		if listIsBlocked {
-			filter.IsBlocked = &listIsBlocked
-		}
+Filter Expressions (--filter/-f):
+  Terms are AND-ed (with AND or just spaces) and negated with NOT or -.
+  OR combines values of one field; status:todo,draft means the same.
 
-		// --ready: beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)
-		if listReady {
-			isBlocked := false
-			filter.IsBlocked = &isBlocked
-			filter.ExcludeStatus = append(filter.ExcludeStatus, "in-progress", "completed", "scrapped", "draft")
+  status:todo AND NOT tag:blocked AND priority>=high
+  type:bug,feature -status:completed
+  status, type, priority, tag, scope   field:a,b
+  priority>=high, priority<normal       Compare by urgency
+  title:auth.*token, title~lgn          Regex or fuzzy title match
+  parent:<id>, link:<type>              Children of a bean, beans with a link type
+  has:parent, has:blocking, has:blocked-by, has:branch
+  is:blocked, is:breached, is:merged
+  created>=2025-01-01, updated<7d       A date or time, or a duration ago`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := buildListFilter()
+		if err != nil {
+			return err
 		}
 
 		// Execute query via GraphQL resolver
 		resolver := &graph.Resolver{Core: core}
-		beans, err := resolver.Query().Beans(context.Background(), filter)
+		beans, err := resolver.Query().Beans(context.Background(), filter, nil)
 		if err != nil {
 			return fmt.Errorf("querying beans: %w", err)
 		}
 
 		// Sort beans
-		sortBeans(beans, listSort, cfg)
+		if err := sortBeans(beans, listSort, cfg); err != nil {
+			return err
+		}
+
+		// Grouped output (--group-by)
+		var groups []beanGroup
+		if listGroupBy != "" {
+			if groups, err = groupBeans(beans, listGroupBy); err != nil {
+				return err
+			}
+		}
 
-		// JSON output (flat list)
+		// JSON output (flat list, or groups)
 		if listJSON {
 			if !listFull {
 				for _, b := range beans {
 					b.Body = ""
 				}
 			}
-			return output.SuccessMultiple(beans)
+			if listGroupBy != "" {
+				return output.SuccessGroups(jsonRedaction(), groups)
+			}
+			return output.SuccessMultiple(jsonRedaction(), beans)
 		}
 
 		// Quiet mode: just IDs (flat)
@@ -138,138 +138,274 @@ Search Syntax (--search/-S):
 
 		// Default: tree view
 		// We need all beans to find ancestors for context
-		allBeans, err := resolver.Query().Beans(context.Background(), nil)
+		allBeans, err := resolver.Query().Beans(context.Background(), &model.BeanFilter{IncludeDrafts: filter.IncludeDrafts}, nil)
 		if err != nil {
 			return fmt.Errorf("querying all beans for tree: %w", err)
 		}
 
 		// Create sort function for tree building
 		sortFn := func(b []*bean.Bean) {
-			sortBeans(b, listSort, cfg)
+			_ = sortBeans(b, listSort, cfg) // already checked above
 		}
 
-		// Build tree
-		tree := ui.BuildTree(beans, allBeans, sortFn)
-
-		if len(tree) == 0 {
+		if len(beans) == 0 {
 			fmt.Println(ui.Muted.Render("No beans found. Create one with: beans new <title>"))
 			return nil
 		}
 
-		// Calculate max ID width from all beans in tree
-		maxIDWidth := 2
-		for _, b := range allBeans {
-			if len(b.ID) > maxIDWidth {
-				maxIDWidth = len(b.ID)
+		if listGroupBy != "" {
+			for i, g := range groups {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Println(g.heading() + ui.Muted.Render(fmt.Sprintf(" (%d)", g.Count)))
+				printBeanTree(g.Beans, allBeans, sortFn)
 			}
+			return nil
 		}
-		maxIDWidth += 2
-
-		// Check if any beans have tags
-		hasTags := false
-		for _, b := range beans {
-			if len(b.Tags) > 0 {
-				hasTags = true
-				break
-			}
+		printBeanTree(beans, allBeans, sortFn)
+		return nil
+	},
+}
+
+// buildListFilter builds the GraphQL filter for the list filter flags, which
+// 'beans count' shares (see addListFilterFlags).
+func buildListFilter() (*model.BeanFilter, error) {
+	filter := &model.BeanFilter{
+		Status:          listStatus,
+		ExcludeStatus:   listNoStatus,
+		Type:            listType,
+		ExcludeType:     listNoType,
+		Priority:        listPriority,
+		ExcludePriority: listNoPriority,
+		Tags:            listTag,
+		ExcludeTags:     listNoTag,
+		Scope:           listScope,
+		ExcludeScope:    listNoScope,
+	}
+
+	// Add search filter if provided
+	if listSearch != "" {
+		filter.Search = &listSearch
+	}
+	if listFilter != "" {
+		if _, err := graph.ParseQuery(listFilter, cfg, time.Now()); err != nil {
+			return nil, fmt.Errorf("invalid --filter: %w", err)
 		}
+		filter.Query = &listFilter
+	}
+	if listTitleMatch != "" {
+		filter.TitleMatches = &listTitleMatch
+	}
+	if listTitleFuzzy != "" {
+		filter.TitleFuzzy = &listTitleFuzzy
+	}
+
+	// Add parent/blocks filters
+	if listHasParent {
+		filter.HasParent = &listHasParent
+	}
+	if listNoParent {
+		filter.NoParent = &listNoParent
+	}
+	if listParentID != "" {
+		filter.ParentID = &listParentID
+	}
+	if listHasBlocking {
+		filter.HasBlocking = &listHasBlocking
+	}
+	if listNoBlocking {
+		filter.NoBlocking = &listNoBlocking
+	}
+	if len(listHasLink) > 0 {
+		filter.HasLink = &model.LinkFilter{Types: listHasLink}
+	}
+	// --ready and --is-blocked are mutually exclusive
+	if listReady && listIsBlocked {
+		return nil, fmt.Errorf("--ready and --is-blocked are mutually exclusive")
+	}
+
+	if listIsBlocked {
+		filter.IsBlocked = &listIsBlocked
+	}
+	if listSLABreached {
+		filter.SLABreached = &listSLABreached
+	}
+	if listDrafts {
+		filter.IncludeDrafts = &listDrafts
+	}
 
-		// Detect terminal width (default to 80 if not a terminal)
-		termWidth := 80
-		if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
-			termWidth = w
+	// Time filters take durations ago (36h, 7d, 2w) or dates
+	now := time.Now()
+	for _, f := range []struct {
+		flag  string
+		value string
+		field **time.Time
+	}{
+		{"created-since", listCreatedSince, &filter.CreatedAfter},
+		{"created-until", listCreatedUntil, &filter.CreatedBefore},
+		{"updated-since", listUpdatedSince, &filter.UpdatedAfter},
+		{"updated-until", listUpdatedUntil, &filter.UpdatedBefore},
+	} {
+		if f.value == "" {
+			continue
 		}
+		t, err := parseSince(f.value, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", f.flag, err)
+		}
+		*f.field = &t
+	}
 
-		fmt.Print(ui.RenderTree(tree, cfg, maxIDWidth, hasTags, termWidth))
-		return nil
-	},
+	// --ready: beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)
+	if listReady {
+		isBlocked := false
+		filter.IsBlocked = &isBlocked
+		filter.ExcludeStatus = append(filter.ExcludeStatus, "in-progress", "completed", "scrapped", "draft")
+	}
+	return filter, nil
 }
 
-func sortBeans(beans []*bean.Bean, sortBy string, cfg *config.Config) {
-	statusNames := cfg.StatusNames()
-	priorityNames := cfg.PriorityNames()
-	typeNames := cfg.TypeNames()
+// printBeanTree prints beans as a tree, with their ancestors from allBeans
+// for context.
+func printBeanTree(beans, allBeans []*bean.Bean, sortFn func([]*bean.Bean)) {
+	tree := ui.BuildTree(beans, allBeans, sortFn)
+	if listPoints || listEstimates {
+		annotatePoints(tree, listEstimates)
+	}
+	annotateChecklists(tree)
+	annotateSLABreaches(tree, time.Now())
+	annotateMarks(tree)
 
-	switch sortBy {
-	case "created":
-		sort.Slice(beans, func(i, j int) bool {
-			if beans[i].CreatedAt == nil && beans[j].CreatedAt == nil {
-				return beans[i].ID < beans[j].ID
-			}
-			if beans[i].CreatedAt == nil {
-				return false
-			}
-			if beans[j].CreatedAt == nil {
-				return true
-			}
-			return beans[i].CreatedAt.After(*beans[j].CreatedAt)
-		})
-	case "updated":
-		sort.Slice(beans, func(i, j int) bool {
-			if beans[i].UpdatedAt == nil && beans[j].UpdatedAt == nil {
-				return beans[i].ID < beans[j].ID
-			}
-			if beans[i].UpdatedAt == nil {
-				return false
-			}
-			if beans[j].UpdatedAt == nil {
-				return true
-			}
-			return beans[i].UpdatedAt.After(*beans[j].UpdatedAt)
-		})
-	case "status":
-		// Build status order from configured statuses
-		statusOrder := make(map[string]int)
-		for i, s := range statusNames {
-			statusOrder[s] = i
+	// Calculate max ID width from all beans in tree
+	maxIDWidth := 2
+	for _, b := range allBeans {
+		if len(b.ID) > maxIDWidth {
+			maxIDWidth = len(b.ID)
 		}
-		sort.Slice(beans, func(i, j int) bool {
-			oi, oj := statusOrder[beans[i].Status], statusOrder[beans[j].Status]
-			if oi != oj {
-				return oi < oj
-			}
-			return beans[i].ID < beans[j].ID
-		})
-	case "priority":
-		// Build priority order from configured priorities
-		priorityOrder := make(map[string]int)
-		for i, p := range priorityNames {
-			priorityOrder[p] = i
+	}
+	maxIDWidth += 2
+
+	// Check if any beans have tags
+	hasTags := false
+	for _, b := range beans {
+		if len(b.Tags) > 0 {
+			hasTags = true
+			break
+		}
+	}
+
+	// Detect terminal width (default to 80 if not a terminal)
+	termWidth := 80
+	if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
+		termWidth = w
+	}
+
+	fmt.Print(ui.RenderTree(tree, cfg, maxIDWidth, hasTags, termWidth))
+	if listEstimates {
+		unestimated := 0
+		for _, node := range tree {
+			unestimated += core.RollupPoints(node.Bean.ID).Unestimated
 		}
-		// Find normal priority index for beans without priority
-		normalIdx := len(priorityNames)
-		for i, p := range priorityNames {
-			if p == "normal" {
-				normalIdx = i
-				break
+		if unestimated > 0 {
+			fmt.Printf("\n%s %d bean(s) without an estimate\n", ui.Warning.Render("!"), unestimated)
+		}
+	}
+}
+
+// annotatePoints sets each tree node's annotation to its story point rollup.
+// Beans with children show completed/total points of their descendants, and
+// other beans their own estimate. Children are those RollupPoints counts,
+// including ones the tree leaves out such as archived beans. With
+// flagUnestimated, beans without an estimate are flagged as well: leaves
+// without points, and parents with such descendants.
+func annotatePoints(nodes []*ui.TreeNode, flagUnestimated bool) {
+	for _, node := range nodes {
+		rollup := core.RollupPoints(node.Bean.ID)
+		switch {
+		case !hasChildren(node.Bean.ID):
+			if node.Bean.Points != nil {
+				node.Annotation = fmt.Sprintf("[%d pts]", *node.Bean.Points)
+			} else if flagUnestimated && rollup.Unestimated > 0 {
+				node.Annotation = "[no estimate]"
 			}
+		case flagUnestimated && rollup.Unestimated > 0:
+			node.Annotation = fmt.Sprintf("[%d/%d pts, %d unestimated]", rollup.Completed, rollup.Total, rollup.Unestimated)
+		case rollup.Total > 0:
+			node.Annotation = fmt.Sprintf("[%d/%d pts]", rollup.Completed, rollup.Total)
 		}
-		sort.Slice(beans, func(i, j int) bool {
-			pi := normalIdx
-			if beans[i].Priority != "" {
-				if order, ok := priorityOrder[beans[i].Priority]; ok {
-					pi = order
-				}
+		annotatePoints(node.Children, flagUnestimated)
+	}
+}
+
+// hasChildren reports whether any bean has the given bean as its parent.
+func hasChildren(id string) bool {
+	for _, link := range core.FindIncomingLinks(id) {
+		if link.LinkType == "parent" {
+			return true
+		}
+	}
+	return false
+}
+
+// annotateChecklists adds checklist progress (done/total items) to the
+// annotation of each tree node whose bean has a checklist.
+func annotateChecklists(nodes []*ui.TreeNode) {
+	for _, node := range nodes {
+		if progress := ui.ChecklistProgress(node.Bean); progress != "" {
+			if node.Annotation != "" {
+				node.Annotation += " "
 			}
-			pj := normalIdx
-			if beans[j].Priority != "" {
-				if order, ok := priorityOrder[beans[j].Priority]; ok {
-					pj = order
-				}
+			node.Annotation += progress
+		}
+		annotateChecklists(node.Children)
+	}
+}
+
+// annotateSLABreaches adds how far past its SLA deadline a bean is to the
+// annotation of each tree node whose bean breaches its SLA.
+func annotateSLABreaches(nodes []*ui.TreeNode, now time.Time) {
+	for _, node := range nodes {
+		if breach, ok := core.SLABreachFor(node.Bean, now); ok {
+			if node.Annotation != "" {
+				node.Annotation += " "
 			}
-			if pi != pj {
-				return pi < pj
+			node.Annotation += "SLA +" + formatDuration(int(breach.Overdue.Seconds()))
+		}
+		annotateSLABreaches(node.Children, now)
+	}
+}
+
+// annotateMarks marks draft and local beans in the tree.
+func annotateMarks(nodes []*ui.TreeNode) {
+	for _, node := range nodes {
+		for _, mark := range beanMarks(node.Bean) {
+			if node.Annotation != "" {
+				node.Annotation += " "
 			}
-			return beans[i].ID < beans[j].ID
-		})
-	case "id":
-		sort.Slice(beans, func(i, j int) bool {
-			return beans[i].ID < beans[j].ID
-		})
-	default:
-		// Default: sort by status order, then priority, then type order, then title (same as TUI)
-		bean.SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+			node.Annotation += mark
+		}
+		annotateMarks(node.Children)
+	}
+}
+
+// beanMarks returns the markers for beans that aren't shared with the team:
+// unpublished drafts and local beans.
+func beanMarks(b *bean.Bean) []string {
+	var marks []string
+	if b.Draft {
+		marks = append(marks, "draft")
+	}
+	if beancore.IsLocalPath(b.Path) {
+		marks = append(marks, "local")
 	}
+	return marks
+}
+
+// sortBeans sorts beans by --sort (see bean.SortOrders), defaulting to the
+// TUI's order: status, then priority, type and title.
+func sortBeans(beans []*bean.Bean, sortBy string, cfg *config.Config) error {
+	return bean.SortBy(beans, sortBy, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
 }
 
 func truncate(s string, maxLen int) string {
@@ -281,24 +417,44 @@ func truncate(s string, maxLen int) string {
 
 func init() {
 	listCmd.Flags().BoolVar(&listJSON, "json", false, "Output as JSON")
-	listCmd.Flags().StringVarP(&listSearch, "search", "S", "", "Full-text search in title and body")
-	listCmd.Flags().StringArrayVarP(&listStatus, "status", "s", nil, "Filter by status (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoStatus, "no-status", nil, "Exclude by status (can be repeated)")
-	listCmd.Flags().StringArrayVarP(&listType, "type", "t", nil, "Filter by type (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoType, "no-type", nil, "Exclude by type (can be repeated)")
-	listCmd.Flags().StringArrayVarP(&listPriority, "priority", "p", nil, "Filter by priority (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listNoPriority, "no-priority", nil, "Exclude by priority (can be repeated)")
-	listCmd.Flags().StringArrayVar(&listTag, "tag", nil, "Filter by tag (can be repeated, OR logic)")
-	listCmd.Flags().StringArrayVar(&listNoTag, "no-tag", nil, "Exclude beans with tag (can be repeated)")
-	listCmd.Flags().BoolVar(&listHasParent, "has-parent", false, "Filter beans with a parent")
-	listCmd.Flags().BoolVar(&listNoParent, "no-parent", false, "Filter beans without a parent")
-	listCmd.Flags().StringVar(&listParentID, "parent", "", "Filter by parent ID")
-	listCmd.Flags().BoolVar(&listHasBlocking, "has-blocking", false, "Filter beans that are blocking others")
-	listCmd.Flags().BoolVar(&listNoBlocking, "no-blocking", false, "Filter beans that aren't blocking others")
-	listCmd.Flags().BoolVar(&listIsBlocked, "is-blocked", false, "Filter beans that are blocked by others")
-	listCmd.Flags().BoolVar(&listReady, "ready", false, "Filter beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)")
+	addListFilterFlags(listCmd.Flags())
 	listCmd.Flags().BoolVarP(&listQuiet, "quiet", "q", false, "Only output IDs (one per line)")
-	listCmd.Flags().StringVar(&listSort, "sort", "", "Sort by: created, updated, status, priority, id (default: status, priority, type, title)")
+	listCmd.Flags().StringVar(&listGroupBy, "group-by", "", "Group beans by status, type, epic or owner (top CODEOWNERS owner), with counts; with --json, prints an array of groups")
+	listCmd.Flags().StringVar(&listSort, "sort", "", "Sort by: status (then priority), priority (then status), created, updated, id (default: status, priority, type, title)")
 	listCmd.Flags().BoolVar(&listFull, "full", false, "Include bean body in JSON output")
+	listCmd.Flags().BoolVar(&listPoints, "points", false, "Show story point rollups in the tree view")
+	listCmd.Flags().BoolVar(&listEstimates, "estimates", false, "Show story point rollups in the tree view and flag beans without an estimate")
 	rootCmd.AddCommand(listCmd)
 }
+
+// addListFilterFlags registers the flags buildListFilter reads.
+func addListFilterFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&listSearch, "search", "S", "", "Full-text search in title and body")
+	flags.StringVarP(&listFilter, "filter", "f", "", "Filter expression, e.g. 'status:todo AND NOT tag:blocked AND priority>=high'")
+	flags.StringVar(&listTitleMatch, "title-match", "", "Filter beans whose title matches a regular expression (case-insensitive)")
+	flags.StringVar(&listTitleFuzzy, "title~", "", "Filter beans whose title contains these characters in order (case-insensitive; lgn matches login)")
+	flags.StringArrayVarP(&listStatus, "status", "s", nil, "Filter by status (can be repeated)")
+	flags.StringArrayVar(&listNoStatus, "no-status", nil, "Exclude by status (can be repeated)")
+	flags.StringArrayVarP(&listType, "type", "t", nil, "Filter by type (can be repeated)")
+	flags.StringArrayVar(&listNoType, "no-type", nil, "Exclude by type (can be repeated)")
+	flags.StringArrayVarP(&listPriority, "priority", "p", nil, "Filter by priority (can be repeated)")
+	flags.StringArrayVar(&listNoPriority, "no-priority", nil, "Exclude by priority (can be repeated)")
+	flags.StringArrayVar(&listTag, "tag", nil, "Filter by tag (can be repeated, OR logic; area/* matches a namespace)")
+	flags.StringArrayVar(&listNoTag, "no-tag", nil, "Exclude beans with tag (can be repeated; area/* matches a namespace)")
+	flags.StringArrayVar(&listScope, "scope", nil, "Filter by scope, including scopes below it (can be repeated; packages matches packages/api)")
+	flags.StringArrayVar(&listNoScope, "no-scope", nil, "Exclude beans in scope, including scopes below it (can be repeated)")
+	flags.BoolVar(&listHasParent, "has-parent", false, "Filter beans with a parent")
+	flags.BoolVar(&listNoParent, "no-parent", false, "Filter beans without a parent")
+	flags.StringVar(&listParentID, "parent", "", "Filter by parent ID")
+	flags.BoolVar(&listHasBlocking, "has-blocking", false, "Filter beans that are blocking others")
+	flags.BoolVar(&listNoBlocking, "no-blocking", false, "Filter beans that aren't blocking others")
+	flags.StringArrayVar(&listHasLink, "has-link", nil, "Filter beans with a typed link of this type, in either direction (can be repeated; mentions matches [[id]]/#id body mentions)")
+	flags.BoolVar(&listIsBlocked, "is-blocked", false, "Filter beans that are blocked by others")
+	flags.BoolVar(&listSLABreached, "sla-breached", false, "Filter beans that have been in their status longer than their priority's SLA allows")
+	flags.BoolVar(&listReady, "ready", false, "Filter beans available to start (not blocked, excludes in-progress/completed/scrapped/draft)")
+	flags.BoolVar(&listDrafts, "drafts", false, "Include draft beans (hidden by default)")
+	flags.StringVar(&listCreatedSince, "created-since", "", "Filter beans created since a duration ago (36h, 7d, 2w) or a date (2006-01-02)")
+	flags.StringVar(&listCreatedUntil, "created-until", "", "Filter beans created before a duration ago (36h, 7d, 2w) or a date (2006-01-02)")
+	flags.StringVar(&listUpdatedSince, "updated-since", "", "Filter beans updated since a duration ago (36h, 7d, 2w) or a date (2006-01-02)")
+	flags.StringVar(&listUpdatedUntil, "updated-until", "", "Filter beans not updated since a duration ago (36h, 7d, 2w) or a date (2006-01-02)")
+}
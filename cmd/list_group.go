@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// groupByKeys are the values `beans list --group-by` accepts.
+var groupByKeys = []string{"status", "type", "epic", "owner"}
+
+// beanGroup is one section of `beans list --group-by`. Key is the status,
+// type, epic ID or owner the beans share; it's empty for beans without an
+// epic or owner.
+type beanGroup struct {
+	By    string       `json:"by"`
+	Key   string       `json:"key"`
+	Title string       `json:"title,omitempty"` // the epic's title
+	Count int          `json:"count"`
+	Beans []*bean.Bean `json:"beans"`
+}
+
+// heading renders the group's name for the tree view.
+func (g beanGroup) heading() string {
+	switch {
+	case g.Key == "" && g.By == "epic":
+		return ui.Muted.Render("No epic")
+	case g.Key == "":
+		return ui.Muted.Render("No owner")
+	case g.By == "epic":
+		return ui.ID.Render(g.Key) + " " + ui.Bold.Render(g.Title)
+	}
+	return ui.Bold.Render(g.Key)
+}
+
+// groupBeans splits beans into groups by status, type, epic or owner,
+// keeping their order within each group. Status and type groups follow the
+// configured order; epics and owners follow the order of their first bean,
+// with beans without one last.
+func groupBeans(beans []*bean.Bean, by string) ([]beanGroup, error) {
+	var key func(*bean.Bean) string
+	var order []string
+	switch by {
+	case "status":
+		key = func(b *bean.Bean) string { return b.Status }
+		order = cfg.StatusNames()
+	case "type":
+		key = func(b *bean.Bean) string { return b.Type }
+		order = cfg.TypeNames()
+	case "epic":
+		key = epicOf
+	case "owner":
+		owners, err := topOwners(beans)
+		if err != nil {
+			return nil, err
+		}
+		key = func(b *bean.Bean) string { return owners[b.ID] }
+	default:
+		return nil, fmt.Errorf("unknown --group-by %q (must be %s)", by, strings.Join(groupByKeys, ", "))
+	}
+
+	counts := countBy(beans, key)
+	// Keys outside the configured order, then beans without a key
+	for _, b := range beans {
+		if k := key(b); k != "" && !slices.Contains(order, k) {
+			order = append(order, k)
+		}
+	}
+	order = append(order, "")
+
+	var groups []beanGroup
+	for _, k := range order {
+		if counts[k] == 0 {
+			continue
+		}
+		g := beanGroup{By: by, Key: k, Count: counts[k]}
+		for _, b := range beans {
+			if key(b) == k {
+				g.Beans = append(g.Beans, b)
+			}
+		}
+		if by == "epic" && k != "" {
+			if epic, err := core.Get(k); err == nil {
+				g.Title = epic.Title
+			}
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// epicOf returns the ID of a bean's nearest epic: the bean itself if it is
+// one, or else its closest epic ancestor. Returns "" if there is none.
+func epicOf(b *bean.Bean) string {
+	seen := make(map[string]bool)
+	for b != nil && !seen[b.ID] {
+		if b.Type == "epic" {
+			return b.ID
+		}
+		seen[b.ID] = true
+		if b.Parent == "" {
+			return ""
+		}
+		parent, err := core.Get(b.Parent)
+		if err != nil {
+			return ""
+		}
+		b = parent
+	}
+	return ""
+}
+
+// topOwners maps each bean's ID to the top owner of the code it touches, per
+// CODEOWNERS (see 'beans owners'). Beans have no assignee field, so this is
+// who --group-by owner groups them by.
+func topOwners(beans []*bean.Bean) (map[string]string, error) {
+	if !core.IsGitFlowEnabled() {
+		// Not fatal: without git, owners are looked up for each bean's scope
+		_ = core.EnableGitFlow(".")
+	}
+	owners := make(map[string]string)
+	for _, b := range beans {
+		o, err := core.Ownership(b)
+		if err != nil {
+			return nil, fmt.Errorf("grouping by owner: %w", err)
+		}
+		if len(o.Owners) > 0 {
+			owners[b.ID] = o.Owners[0]
+		}
+	}
+	return owners, nil
+}
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/ui"
 )
 
 func TestSortBeans(t *testing.T) {
@@ -260,3 +263,83 @@ func TestListCommand_SortBeansWithGitFields(t *testing.T) {
 	}
 }
 
+func TestGroupBeans(t *testing.T) {
+	testCore, cleanup := setupShowTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	for _, b := range []*bean.Bean{
+		{ID: "epic", Title: "Epic", Status: "in-progress", Type: "epic"},
+		{ID: "feat", Title: "Feature", Status: "todo", Type: "feature", Parent: "epic"},
+		{ID: "task", Title: "Task", Status: "todo", Type: "task", Parent: "feat"},
+		{ID: "loose", Title: "Loose", Status: "completed", Type: "task"},
+	} {
+		if err := testCore.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	beans := testCore.All()
+	_ = sortBeans(beans, "id", cfg)
+
+	summarize := func(groups []beanGroup) []string {
+		var got []string
+		for _, g := range groups {
+			var ids []string
+			for _, b := range g.Beans {
+				ids = append(ids, b.ID)
+			}
+			if g.Count != len(g.Beans) {
+				t.Errorf("group %q count = %d, has %d beans", g.Key, g.Count, len(g.Beans))
+			}
+			got = append(got, g.Key+":"+strings.Join(ids, ","))
+		}
+		return got
+	}
+
+	tests := []struct {
+		by   string
+		want []string
+	}{
+		{"status", []string{"in-progress:epic", "todo:feat,task", "completed:loose"}},
+		{"type", []string{"epic:epic", "feature:feat", "task:loose,task"}},
+		{"epic", []string{"epic:epic,feat,task", ":loose"}},
+	}
+	for _, tt := range tests {
+		groups, err := groupBeans(beans, tt.by)
+		if err != nil {
+			t.Fatalf("groupBeans(%s) error = %v", tt.by, err)
+		}
+		if got := summarize(groups); !slices.Equal(got, tt.want) {
+			t.Errorf("groupBeans(%s) = %v, want %v", tt.by, got, tt.want)
+		}
+	}
+
+	if _, err := groupBeans(beans, "milestone"); err == nil {
+		t.Error("groupBeans(milestone): expected an error")
+	}
+}
+
+func TestAnnotatePointsHiddenChildren(t *testing.T) {
+	testCore, cleanup := setupShowTestCore(t)
+	defer cleanup()
+
+	three := 3
+	epic := &bean.Bean{ID: "epic", Title: "Epic", Status: "todo", Type: "epic"}
+	done := &bean.Bean{ID: "done", Title: "Done", Status: "completed", Parent: "epic", Points: &three}
+	for _, b := range []*bean.Bean{epic, done} {
+		if err := testCore.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	// The completed child is left out of the tree, as in a list without archived beans
+	for _, flagUnestimated := range []bool{false, true} {
+		tree := ui.BuildTree([]*bean.Bean{epic}, []*bean.Bean{epic}, func([]*bean.Bean) {})
+		annotatePoints(tree, flagUnestimated)
+		if got := tree[0].Annotation; got != "[3/3 pts]" {
+			t.Errorf("annotatePoints(flagUnestimated=%v) = %q, want [3/3 pts]", flagUnestimated, got)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	maintainDryRun bool
+	maintainJSON   bool
+)
+
+var maintainCmd = &cobra.Command{
+	Use:   "maintain",
+	Short: "Apply maintenance policies such as priority aging",
+	Long: `Applies the aging rules in .beans.yml, escalating beans that have sat in a
+status (by default todo or draft) for too long:
+
+  aging:
+    - after: 14d
+      priority: high
+      tag: aging
+    - after: 30d
+      priority: critical
+
+Rules only raise priority and add tags, so running maintain again changes
+nothing until more beans age. Use --dry-run to see what would change.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(cfg.Beans.Aging) == 0 {
+			return cmdError(maintainJSON, output.ErrValidation, "no aging rules configured (add aging to %s)", config.ConfigFileName)
+		}
+
+		resolver := &graph.Resolver{Core: core}
+		var actions []*beancore.AgingAction
+		var err error
+		if maintainDryRun {
+			actions, err = resolver.Query().AgingReport(context.Background())
+		} else {
+			actions, err = resolver.Mutation().ApplyAging(context.Background())
+		}
+		if err != nil {
+			if maintainJSON {
+				return mutationError(true, err)
+			}
+			printAgingActions(cmd.OutOrStdout(), actions, maintainDryRun)
+			return fmt.Errorf("applying aging rules: %w", err)
+		}
+
+		if maintainJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(actions)
+		}
+		printAgingActions(cmd.OutOrStdout(), actions, maintainDryRun)
+		return nil
+	},
+}
+
+// printAgingActions prints one line per escalation.
+func printAgingActions(w io.Writer, actions []*beancore.AgingAction, dryRun bool) {
+	if len(actions) == 0 {
+		fmt.Fprintln(w, ui.Muted.Render("No beans need escalating"))
+		return
+	}
+	for _, a := range actions {
+		var changes []string
+		if a.NewPriority != "" {
+			changes = append(changes, fmt.Sprintf("priority %s → %s", a.Priority, a.NewPriority))
+		}
+		for _, tag := range a.AddTags {
+			changes = append(changes, "+"+tag)
+		}
+		fmt.Fprintf(w, "%s %s %s %s\n", ui.ID.Render(a.BeanID), a.Title,
+			ui.Muted.Render(fmt.Sprintf("(%s since %s)", a.Status, a.Since.Local().Format("2006-01-02"))),
+			strings.Join(changes, ", "))
+	}
+	verb := "Escalated"
+	if dryRun {
+		verb = "Would escalate"
+	}
+	fmt.Fprintln(w, ui.Muted.Render(fmt.Sprintf("%s %d bean(s)", verb, len(actions))))
+}
+
+func init() {
+	maintainCmd.Flags().BoolVar(&maintainDryRun, "dry-run", false, "Show what would change without changing anything")
+	maintainCmd.Flags().BoolVar(&maintainJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(maintainCmd)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var mergeJSON bool
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge <duplicate> <canonical>",
+	Short: "Merge a duplicate bean into another",
+	Long: `Combines a duplicate bean with the canonical one:
+
+  - its tags, blocking, blocked-by and typed links are added to the canonical
+    bean, and its parent too if the canonical bean has none
+  - its body is appended to the canonical bean's under a "Merged from" heading
+  - other beans' references to it (links, children and [[id]]/#id mentions)
+    are pointed at the canonical bean
+  - it is scrapped with a 'duplicates' link to the canonical bean, and archived`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		dupe, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || dupe == nil {
+			return beanNotFound(mergeJSON, args[0], err)
+		}
+		canonical, err := resolver.Query().Bean(ctx, args[1])
+		if err != nil || canonical == nil {
+			return beanNotFound(mergeJSON, args[1], err)
+		}
+
+		b, err := resolver.Mutation().MergeBeans(ctx, dupe.ID, canonical.ID)
+		if err != nil {
+			return cmdError(mergeJSON, output.ErrValidation, "failed to merge beans: %v", err)
+		}
+
+		if mergeJSON {
+			return output.Success(jsonRedaction(), b, "Beans merged")
+		}
+		fmt.Println(ui.Success.Render("Merged ") + ui.ID.Render(dupe.ID) + " " + dupe.Title +
+			ui.Muted.Render(" into ") + ui.ID.Render(b.ID) + " " + b.Title)
+		return nil
+	},
+}
+
+func init() {
+	mergeCmd.Flags().BoolVar(&mergeJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(mergeCmd)
+}
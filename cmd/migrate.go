@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateDryRun bool
+	migrateJSON   bool
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade bean files to the current file format",
+	Long: `Upgrades every bean file, including archived beans, from the format version
+recorded as format_version in .beans.yml to the one this version of beans
+uses, then records the new version. Projects without a format_version are
+at version 0.
+
+Version 1 renames legacy statuses (open, done, wontfix, ...) to the current
+ones and replaces the old epic, feature and milestone fields with a parent
+link; if a bean has several, the others become related links.
+
+Bean bodies are never changed. Use --dry-run to see what would change.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		report, err := core.Migrate(migrateDryRun)
+		if err != nil {
+			if migrateJSON {
+				return cmdError(true, output.ErrFileError, "%s", err)
+			}
+			if report != nil {
+				printMigrationReport(cmd.OutOrStdout(), report)
+			}
+			return err
+		}
+
+		if !migrateDryRun && report.From != report.To {
+			path := configPath
+			if path == "" {
+				path = filepath.Join(cfg.ConfigDir(), config.ConfigFileName)
+			}
+			if err := config.SetValue(path, "beans.format_version", strconv.Itoa(report.To)); err != nil {
+				return cmdError(migrateJSON, output.ErrFileError, "recording format version: %s", err)
+			}
+		}
+
+		if migrateJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(report)
+		}
+		printMigrationReport(cmd.OutOrStdout(), report)
+		return nil
+	},
+}
+
+// printMigrationReport prints the changes made to each file and a summary.
+func printMigrationReport(w io.Writer, r *beancore.MigrationReport) {
+	if r.From == r.To {
+		fmt.Fprintln(w, ui.Muted.Render(fmt.Sprintf("Bean files are already at format version %d", r.To)))
+		return
+	}
+	for _, f := range r.Files {
+		fmt.Fprintln(w, ui.Bold.Render(f.Path))
+		for _, change := range f.Changes {
+			fmt.Fprintf(w, "  %s\n", change)
+		}
+	}
+	verb := "Migrated"
+	if r.DryRun {
+		verb = "Would migrate"
+	}
+	fmt.Fprintln(w, ui.Muted.Render(fmt.Sprintf("%s %d of %d bean file(s) from format version %d to %d",
+		verb, len(r.Files), r.Scanned, r.From, r.To)))
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "Show what would change without changing anything")
+	migrateCmd.Flags().BoolVar(&migrateJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(migrateCmd)
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/spf13/cobra"
 )
 
@@ -27,6 +27,8 @@ Run ` + "`beans prime`" + ` for workflow context, or install hooks for auto-inje
 For full workflow details: ` + "`beans prime`" + `
 --- END AGENTS.MD CONTENT ---
 
+Or let ` + "`beans agents init`" + ` write a fuller section generated from .beans.yml.
+
 For Claude Code users:
 
   Option 1 (automatic): Run ` + "`beans init --claude-hooks`" + `
@@ -54,13 +56,16 @@ var onboardCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Check if beans is initialized in this directory
 		if beansPath == "" && configPath == "" {
-			cwd, err := os.Getwd()
-			if err != nil {
-				return fmt.Errorf("failed to get working directory: %w", err)
+			start := rootDir
+			if start == "" {
+				cwd, err := os.Getwd()
+				if err != nil {
+					return fmt.Errorf("failed to get working directory: %w", err)
+				}
+				start = cwd
 			}
-			configFile, err := config.FindConfig(cwd)
-			if err != nil || configFile == "" {
-				return fmt.Errorf("beans not initialized in this directory (no .beans.yml found)")
+			if _, err := beancore.FindRoot(start); err != nil {
+				return fmt.Errorf("beans not initialized in this directory (no .beans directory or .beans.yml found; run 'beans init' to create one)")
 			}
 		}
 
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/spf13/cobra"
+)
+
+var openWeb bool
+
+var openCmd = &cobra.Command{
+	Use:   "open <id>",
+	Short: "Open a bean's file in your editor, or its URL in the browser",
+	Long: `Opens a bean's markdown file in your editor (the editor setting in .beans.yml,
+then $VISUAL, $EDITOR, vi or nano). Archived beans are found too; one
+compacted into the archive bundle gets its own file back first.
+
+With --web, opens the bean's pull request in the browser instead, or the page
+it was created from (source_url) if it has no pull request.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		b, err := core.Get(args[0])
+		if errors.Is(err, beancore.ErrNotFound) {
+			b, err = core.GetFromArchive(args[0])
+		}
+		if err != nil || b == nil {
+			return beanNotFound(false, args[0], err)
+		}
+
+		if openWeb {
+			url := beanURL(b)
+			if url == "" {
+				return fmt.Errorf("%s has no pull request or source URL", b.ID)
+			}
+			return openInBrowser(url)
+		}
+
+		if err := core.Materialize(b.ID); err != nil {
+			return fmt.Errorf("restoring %s from the archive bundle: %w", b.ID, err)
+		}
+		return editBean(b)
+	},
+}
+
+// beanURL returns the URL `beans open --web` opens: the bean's pull request,
+// or else the page it was created from.
+func beanURL(b *bean.Bean) string {
+	if b.GitPRURL != "" {
+		return b.GitPRURL
+	}
+	return b.SourceURL
+}
+
+// editBean opens a bean's file in the user's editor and, like the TUI, bumps
+// its updated_at if the file was changed.
+func editBean(b *bean.Bean) error {
+	path := core.FullPath(b)
+	before, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	editor := strings.Fields(cfg.GetEditor())
+	c := exec.Command(editor[0], append(editor[1:], path)...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("running %s: %w", editor[0], err)
+	}
+
+	after, err := os.Stat(path)
+	if err != nil || !after.ModTime().After(before.ModTime()) {
+		return nil
+	}
+	// Reload to pick up the edits, then save to set updated_at
+	if err := core.Load(); err != nil {
+		return err
+	}
+	if b, err = core.Get(b.ID); err == nil {
+		return core.Update(b, nil)
+	}
+	return nil
+}
+
+// openInBrowser opens a URL with the system's default handler. Only http(s)
+// URLs are opened, as the handler would run anything else, like file: URLs.
+func openInBrowser(url string) error {
+	if !bean.ValidSourceURL(url) {
+		return fmt.Errorf("not opening %q: only http(s) URLs can be opened", url)
+	}
+	var c *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		c = exec.Command("open", url)
+	case "windows":
+		c = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		c = exec.Command("xdg-open", url)
+	}
+	if err := c.Start(); err != nil {
+		return fmt.Errorf("opening %s: %w", url, err)
+	}
+	return nil
+}
+
+func init() {
+	openCmd.Flags().BoolVar(&openWeb, "web", false, "Open the bean's pull request (or source URL) in the browser")
+	rootCmd.AddCommand(openCmd)
+}
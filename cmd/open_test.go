@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestBeanURL(t *testing.T) {
+	tests := []struct {
+		bean *bean.Bean
+		want string
+	}{
+		{&bean.Bean{GitPRURL: "https://example.com/pr/1", SourceURL: "https://example.com/page"}, "https://example.com/pr/1"},
+		{&bean.Bean{SourceURL: "https://example.com/page"}, "https://example.com/page"},
+		{&bean.Bean{}, ""},
+	}
+	for _, tt := range tests {
+		if got := beanURL(tt.bean); got != tt.want {
+			t.Errorf("beanURL() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestOpenInBrowserRejectsOtherSchemes(t *testing.T) {
+	for _, url := range []string{"file:///etc/passwd", "javascript:alert(1)", "/tmp/x.html"} {
+		if err := openInBrowser(url); err == nil {
+			t.Errorf("openInBrowser(%q) = nil, want error", url)
+		}
+	}
+}
+
+func TestEditBean(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the editor")
+	}
+	testCore, cleanup := setupShowTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	b := &bean.Bean{ID: "edit", Slug: "edit", Title: "Edit me", Status: "todo"}
+	if err := testCore.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// A fake editor that rewrites the title
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\nsleep 0.01\nsed -i.bak 's/^title: .*/title: Edited/' \"$1\"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	cfg.Beans.Editor = script
+
+	if err := editBean(b); err != nil {
+		t.Fatalf("editBean() error = %v", err)
+	}
+	got, err := testCore.Get("edit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Title != "Edited" {
+		t.Errorf("title after editing = %q, want %q", got.Title, "Edited")
+	}
+}
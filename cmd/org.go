@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var orgTitle string
+
+// orgKeywords maps statuses to org TODO keywords, declared in the file's
+// #+TODO line in this order.
+var orgKeywords = []struct{ Status, Keyword string }{
+	{"draft", "DRAFT"},
+	{"todo", "TODO"},
+	{"in-progress", "STARTED"},
+	{"completed", "DONE"},
+	{"scrapped", "CANCELLED"},
+}
+
+// orgPriorities maps priorities to org priority cookies, declared with
+// #+PRIORITIES: A E C so that normal beans need none.
+var orgPriorities = map[string]string{
+	"critical": "A",
+	"high":     "B",
+	"low":      "D",
+	"deferred": "E",
+}
+
+var exportOrgCmd = &cobra.Command{
+	Use:   "org",
+	Short: "Export beans as an Org-mode file",
+	Long: `Writes all beans, including archived ones, as an Org-mode outline, nesting
+children under their parents:
+
+  beans export org > beans.org
+
+Statuses become TODO keywords (DRAFT, TODO, STARTED, DONE, CANCELLED) and
+priorities become priority cookies from [#A] (critical) to [#E] (deferred).
+Tags become headline tags, with - and / replaced by _ as Org requires; the
+bean's ID, type, priority and original tags are kept as properties. Bodies
+are converted lightly: headings become bold lines, code fences source blocks
+and links Org links.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		allBeans, err := resolver.Query().Beans(context.Background(), nil, nil)
+		if err != nil {
+			return fmt.Errorf("querying beans: %w", err)
+		}
+		allBeans = withoutLocal(allBeans)
+		tree := ui.BuildTree(allBeans, allBeans, func(b []*bean.Bean) {
+			bean.SortByStatusPriorityAndType(b, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+		})
+
+		title := orgTitle
+		if title == "" {
+			title = filepath.Base(cfg.ConfigDir())
+		}
+		fmt.Fprint(cmd.OutOrStdout(), renderOrg(tree, title))
+		return nil
+	},
+}
+
+// renderOrg renders a bean tree as an Org-mode file.
+func renderOrg(tree []*ui.TreeNode, title string) string {
+	var sb strings.Builder
+	if title != "" && title != "." {
+		fmt.Fprintf(&sb, "#+TITLE: %s\n", title)
+	}
+	var open, done []string
+	for _, k := range orgKeywords {
+		if cfg.IsArchiveStatus(k.Status) {
+			done = append(done, k.Keyword)
+		} else {
+			open = append(open, k.Keyword)
+		}
+	}
+	fmt.Fprintf(&sb, "#+TODO: %s | %s\n", strings.Join(open, " "), strings.Join(done, " "))
+	sb.WriteString("#+PRIORITIES: A E C\n")
+	for _, node := range tree {
+		sb.WriteString("\n")
+		renderOrgNode(&sb, node, 1)
+	}
+	return sb.String()
+}
+
+// renderOrgNode renders a bean as a headline at the given level, followed by
+// its children.
+func renderOrgNode(sb *strings.Builder, node *ui.TreeNode, level int) {
+	b := node.Bean
+	sb.WriteString(strings.Repeat("*", level))
+	sb.WriteString(" " + orgKeyword(b.Status))
+	if cookie, ok := orgPriorities[b.Priority]; ok {
+		sb.WriteString(" [#" + cookie + "]")
+	}
+	sb.WriteString(" " + b.Title)
+	if len(b.Tags) > 0 {
+		tags := make([]string, len(b.Tags))
+		for i, tag := range b.Tags {
+			tags[i] = strings.NewReplacer("-", "_", "/", "_").Replace(tag)
+		}
+		sb.WriteString(" :" + strings.Join(tags, ":") + ":")
+	}
+	sb.WriteString("\n")
+
+	if cfg.IsArchiveStatus(b.Status) {
+		if since, ok := b.StatusSince(); ok {
+			fmt.Fprintf(sb, "CLOSED: %s\n", orgTimestamp(since))
+		}
+	}
+	sb.WriteString(":PROPERTIES:\n")
+	fmt.Fprintf(sb, ":ID: %s\n", b.ID)
+	if b.Type != "" {
+		fmt.Fprintf(sb, ":TYPE: %s\n", b.Type)
+	}
+	if b.Priority != "" {
+		fmt.Fprintf(sb, ":BEAN_PRIORITY: %s\n", b.Priority)
+	}
+	if len(b.Tags) > 0 {
+		fmt.Fprintf(sb, ":BEAN_TAGS: %s\n", strings.Join(b.Tags, " "))
+	}
+	if b.CreatedAt != nil {
+		fmt.Fprintf(sb, ":CREATED: %s\n", orgTimestamp(*b.CreatedAt))
+	}
+	sb.WriteString(":END:\n")
+
+	if body := strings.TrimSpace(b.Body); body != "" {
+		sb.WriteString(markdownToOrg(body))
+		sb.WriteString("\n")
+	}
+	for _, child := range node.Children {
+		renderOrgNode(sb, child, level+1)
+	}
+}
+
+// orgKeyword returns the TODO keyword for a status.
+func orgKeyword(status string) string {
+	for _, k := range orgKeywords {
+		if k.Status == status {
+			return k.Keyword
+		}
+	}
+	return strings.ToUpper(strings.ReplaceAll(status, "-", "_"))
+}
+
+// orgTimestamp formats an inactive Org timestamp in local time.
+func orgTimestamp(t time.Time) string {
+	return t.Local().Format("[2006-01-02 Mon 15:04]")
+}
+
+var (
+	markdownLink   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownBullet = regexp.MustCompile(`^(\s*)[*+] `)
+	markdownFence  = regexp.MustCompile("^\\s*```\\s*(\\S*)")
+)
+
+// markdownToOrg converts the markdown of a bean body to Org markup where the
+// two differ in ways that matter: lines starting with * or # mean something
+// else in Org, as do code fences and links.
+func markdownToOrg(md string) string {
+	lines := strings.Split(md, "\n")
+	inCode := false
+	for i, line := range lines {
+		if m := markdownFence.FindStringSubmatch(line); m != nil {
+			if inCode {
+				lines[i] = "#+end_src"
+			} else if m[1] != "" {
+				lines[i] = "#+begin_src " + m[1]
+			} else {
+				lines[i] = "#+begin_src"
+			}
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+		if m := markdownHeading.FindStringSubmatch(line); m != nil {
+			lines[i] = "*" + m[2] + "*"
+			continue
+		}
+		line = markdownBullet.ReplaceAllString(line, "$1- ")
+		lines[i] = markdownLink.ReplaceAllString(line, "[[$2][$1]]")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func init() {
+	exportOrgCmd.Flags().StringVar(&orgTitle, "title", "", "Title of the Org file (default: the project directory name)")
+	exportCmd.AddCommand(exportOrgCmd)
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/ui"
+)
+
+func TestRenderOrg(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	epic := &bean.Bean{ID: "beans-epic", Title: "Launch", Status: "in-progress", Type: "epic", Priority: "high"}
+	task := &bean.Bean{ID: "beans-task", Title: "Write docs", Status: "todo", Type: "task", Parent: "beans-epic",
+		Tags: []string{"docs", "area/web-ui"}, Body: "## Notes\n\n* See [the guide](https://example.com)\n\n```go\n# not a heading\n```"}
+	done := &bean.Bean{ID: "beans-done", Title: "Old idea", Status: "scrapped", Priority: "deferred"}
+	all := []*bean.Bean{epic, task, done}
+
+	got := renderOrg(ui.BuildTree(all, all, func(b []*bean.Bean) {
+		bean.SortByStatusPriorityAndType(b, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	}), "project")
+
+	for _, want := range []string{
+		"#+TITLE: project\n",
+		"#+TODO: DRAFT TODO STARTED | DONE CANCELLED\n",
+		"* STARTED [#B] Launch\n:PROPERTIES:\n:ID: beans-epic\n:TYPE: epic\n:BEAN_PRIORITY: high\n:END:\n",
+		"** TODO Write docs :docs:area_web_ui:\n",
+		":BEAN_TAGS: docs area/web-ui\n",
+		"*Notes*\n\n- See [[https://example.com][the guide]]\n\n#+begin_src go\n# not a heading\n#+end_src\n",
+		"* CANCELLED [#E] Old idea\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("renderOrg() missing %q in:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "Launch") > strings.Index(got, "Old idea") {
+		t.Error("archived beans should come after active ones")
+	}
+}
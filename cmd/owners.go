@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var ownersJSON bool
+
+var ownersCmd = &cobra.Command{
+	Use:   "owners <id>",
+	Short: "Suggest assignees for a bean from CODEOWNERS",
+	Long: `Lists the owners of the code a bean touches, according to the repository's
+CODEOWNERS file (.github/CODEOWNERS, CODEOWNERS or docs/CODEOWNERS), as
+suggested assignees or reviewers.
+
+The files changed on the bean's git branch are looked up, owners of the most
+files first; once the branch is deleted, the files its merge commit changed are
+used. Beans without a branch use the directory of their scope. Changed files
+no CODEOWNERS rule covers are listed too, and 'beans check' warns about open
+beans with such files.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		b, err := resolver.Query().Bean(context.Background(), args[0])
+		if err != nil || b == nil {
+			return beanNotFound(ownersJSON, args[0], err)
+		}
+		if !core.IsGitFlowEnabled() && b.GitBranch != "" {
+			// Not fatal: without git, owners are looked up for the bean's scope
+			_ = core.EnableGitFlow(".")
+		}
+
+		o, err := core.Ownership(b)
+		if err != nil {
+			return cmdError(ownersJSON, output.ErrValidation, "%v", err)
+		}
+
+		if ownersJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(o)
+		}
+		if len(o.Owners) == 0 {
+			fmt.Println(ui.Muted.Render("No owners found for ") + ui.ID.Render(b.ID) + " " + b.Title)
+		} else {
+			fmt.Println(ui.Bold.Render("Owners of ") + ui.ID.Render(b.ID) + " " + b.Title)
+			for _, owner := range o.Owners {
+				fmt.Println("  " + owner)
+			}
+		}
+		if o.Files == 0 && b.Scope != "" {
+			fmt.Println(ui.Muted.Render("From scope " + b.Scope))
+		} else if o.Files == 0 {
+			fmt.Println(ui.Muted.Render("The bean has no branch changes or scope to look up"))
+		}
+		if len(o.Unowned) > 0 {
+			fmt.Printf("%s %d of %d changed files have no owner:\n", ui.Warning.Render("!"), len(o.Unowned), o.Files)
+			for _, f := range o.Unowned {
+				fmt.Println(ui.Muted.Render("  " + f))
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	ownersCmd.Flags().BoolVar(&ownersJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(ownersCmd)
+}
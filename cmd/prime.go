@@ -7,6 +7,7 @@ import (
 	"text/template"
 
 	"github.com/spf13/cobra"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 )
 
@@ -45,15 +46,18 @@ Use --export to output the default template for customization.`,
 		}
 
 		// If no explicit path given, check if a beans project exists by searching
-		// upward for a .beans.yml config file
+		// upward for a .beans directory or .beans.yml config file
 		if beansPath == "" && configPath == "" {
-			cwd, err := os.Getwd()
-			if err != nil {
-				return nil // Silently exit on error
+			start := rootDir
+			if start == "" {
+				cwd, err := os.Getwd()
+				if err != nil {
+					return nil // Silently exit on error
+				}
+				start = cwd
 			}
-			configFile, err := config.FindConfig(cwd)
-			if err != nil || configFile == "" {
-				// No config file found - silently exit
+			if _, err := beancore.FindRoot(start); err != nil {
+				// No project found - silently exit
 				return nil
 			}
 		}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var publishJSON bool
+
+var publishCmd = &cobra.Command{
+	Use:   "publish <id>",
+	Short: "Publish a draft bean",
+	Long: `Publishes a draft bean (created with 'beans create --draft'), so it shows up in
+'beans list', the tree, stats and other default queries.
+
+Drafts are beans still being written and are hidden until published; use
+'beans list --drafts' to see them. This is separate from the "draft" status,
+which marks a visible bean that needs refinement.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(publishJSON, args[0], err)
+		}
+		if !existing.Draft {
+			return cmdError(publishJSON, output.ErrValidation, "bean %s is not a draft", existing.ID)
+		}
+
+		b, err := resolver.Mutation().PublishBean(ctx, existing.ID)
+		if err != nil {
+			return cmdError(publishJSON, output.ErrFileError, "failed to publish bean: %v", err)
+		}
+
+		if publishJSON {
+			return output.Success(jsonRedaction(), b, "Bean published")
+		}
+
+		fmt.Println(ui.Success.Render("Published ") + ui.ID.Render(b.ID) + " " + b.Title)
+		return nil
+	},
+}
+
+func init() {
+	publishCmd.Flags().BoolVar(&publishJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(publishCmd)
+}
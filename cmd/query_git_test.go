@@ -27,7 +27,7 @@ func setupQueryGitTestCore(t *testing.T) (*beancore.Core, func()) {
 
 	cfg := config.Default()
 	testCore := beancore.New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -77,7 +77,7 @@ func TestQueryCommand_GitBranchFilter(t *testing.T) {
 			HasGitBranch: &hasGitBranch,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -97,7 +97,7 @@ func TestQueryCommand_GitBranchFilter(t *testing.T) {
 			HasGitBranch: &hasGitBranch,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -158,7 +158,7 @@ func TestQueryCommand_GitBranchMergedFilter(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -178,7 +178,7 @@ func TestQueryCommand_GitBranchMergedFilter(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -237,7 +237,7 @@ func TestQueryCommand_CombinedGitAndStatusFilters(t *testing.T) {
 			HasGitBranch: &hasGitBranch,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -258,7 +258,7 @@ func TestQueryCommand_CombinedGitAndStatusFilters(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -368,7 +368,7 @@ func TestQueryCommand_DocumentationExampleQueries(t *testing.T) {
 			HasGitBranch: &hasGitBranch,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -387,7 +387,7 @@ func TestQueryCommand_DocumentationExampleQueries(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -408,7 +408,7 @@ func TestQueryCommand_DocumentationExampleQueries(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
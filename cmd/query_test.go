@@ -530,7 +530,7 @@ func TestGetGraphQLSchema(t *testing.T) {
 	// Verify schema contains expected fields
 	expectedFields := []string{
 		"bean(id: ID!)",
-		"beans(filter: BeanFilter)",
+		"beans(filter: BeanFilter, orderBy: String)",
 		"blockedBy",
 		"blocking",
 		"parent",
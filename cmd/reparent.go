@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reparentMoveFiles bool
+	reparentIfMatch   string
+	reparentJSON      bool
+)
+
+var reparentCmd = &cobra.Command{
+	Use:   "reparent <id> <new-parent>",
+	Short: "Move a bean under a different parent",
+	Long: `Sets a new parent for a bean, keeping its children attached to it. The parent
+must be of an allowed type, and a bean cannot become its own ancestor.
+
+Use --move-files to also move the files of the bean and all its descendants into
+the directory holding the new parent's file, for projects that organize beans in
+subdirectories. To remove a parent, use 'beans update <id> --remove-parent'.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(reparentJSON, args[0], err)
+		}
+		parent, err := resolver.Query().Bean(ctx, args[1])
+		if err != nil || parent == nil {
+			return cmdError(reparentJSON, output.ErrNotFound, "parent bean not found: %s", args[1])
+		}
+
+		var ifMatch *string
+		if reparentIfMatch != "" {
+			ifMatch = &reparentIfMatch
+		}
+		b, err := resolver.Mutation().SetParent(ctx, existing.ID, &parent.ID, ifMatch, &reparentMoveFiles)
+		if err != nil {
+			return cmdError(reparentJSON, output.ErrValidation, "failed to reparent bean: %v", err)
+		}
+
+		if reparentJSON {
+			return output.Success(jsonRedaction(), b, "Bean reparented")
+		}
+
+		fmt.Println(ui.Success.Render("Moved ") + ui.ID.Render(b.ID) + " " + b.Title +
+			ui.Muted.Render(" under ") + ui.ID.Render(parent.ID) + " " + parent.Title)
+		if reparentMoveFiles {
+			fmt.Println(ui.Muted.Render("Files: ") + b.Path)
+		}
+		return nil
+	},
+}
+
+func init() {
+	reparentCmd.Flags().BoolVar(&reparentMoveFiles, "move-files", false, "Move the files of the bean and its descendants into the new parent's directory")
+	reparentCmd.Flags().StringVar(&reparentIfMatch, "if-match", "", "Only update if etag matches (optimistic locking)")
+	reparentCmd.Flags().BoolVar(&reparentJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(reparentCmd)
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate reports from beans",
+	Long:  `Generates documents such as changelogs from the beans in this project.`,
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve [<id>...]",
+	Short: "Print the full IDs of short or partial bean IDs",
+	Long: `Resolves bean IDs, including short IDs without the configured prefix and
+unique prefixes of IDs, to full IDs, printing one per line:
+
+  beans resolve ab3          # beans-ab3f
+  echo ab3 | beans resolve
+
+IDs are read from standard input, one per line, if none are given. An ID that
+matches several beans lists them on standard error; the command then exits 1,
+like it does for IDs matching no bean. Unlike other commands, prefixes are
+matched even without prefix_matching in .beans.yml.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids := args
+		if len(ids) == 0 {
+			scanner := bufio.NewScanner(os.Stdin)
+			for scanner.Scan() {
+				if id := strings.TrimSpace(scanner.Text()); id != "" {
+					ids = append(ids, id)
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return fmt.Errorf("reading IDs: %w", err)
+			}
+		}
+
+		failed := 0
+		for _, id := range ids {
+			matches := core.MatchIDs(id)
+			switch len(matches) {
+			case 1:
+				fmt.Fprintln(cmd.OutOrStdout(), matches[0])
+			case 0:
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s: no bean matches\n", id)
+				failed++
+			default:
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s: ambiguous, matches %d beans:\n", id, len(matches))
+				for _, match := range matches {
+					title := ""
+					if b, err := core.Get(match); err == nil {
+						title = b.Title
+					}
+					fmt.Fprintf(cmd.ErrOrStderr(), "  %s  %s\n", ui.ID.Render(match), title)
+				}
+				failed++
+			}
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resolveCmd)
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	_ "embed"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -61,7 +60,7 @@ var roadmapCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Query all beans via GraphQL resolver
 		resolver := &graph.Resolver{Core: core}
-		allBeans, err := resolver.Query().Beans(context.Background(), nil)
+		allBeans, err := resolver.Query().Beans(context.Background(), nil, nil)
 		if err != nil {
 			return fmt.Errorf("querying beans: %w", err)
 		}
@@ -71,9 +70,7 @@ var roadmapCmd = &cobra.Command{
 
 		// JSON output
 		if roadmapJSON {
-			enc := json.NewEncoder(cmd.OutOrStdout())
-			enc.SetIndent("", "  ")
-			return enc.Encode(data)
+			return jsonRedaction().Encode(cmd.OutOrStdout(), data)
 		}
 
 		// Markdown output
@@ -1,18 +1,32 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/logging"
+	"github.com/hmans/beans/internal/ui"
 )
 
 var core *beancore.Core
 var cfg *config.Config
 var beansPath string
 var configPath string
+var rootDir string
+var logLevel string
+var logFormat string
+var accessibleMode bool
+
+// logger is the leveled logger for non-fatal problems, set up from
+// --log-level/--log-format (or BEANS_LOG_LEVEL/BEANS_LOG_FORMAT).
+var logger = logging.New(os.Stderr, slog.LevelWarn, logging.FormatText)
 
 var rootCmd = &cobra.Command{
 	Use:   "beans",
@@ -21,8 +35,14 @@ var rootCmd = &cobra.Command{
 Track your work alongside your code and supercharge your coding agent with
 a full view of your project.`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-		// Skip core initialization for init, prime, and version commands
-		if cmd.Name() == "init" || cmd.Name() == "prime" || cmd.Name() == "version" {
+		if err := setupLogging(cmd); err != nil {
+			return err
+		}
+		setupAccessibility(cmd)
+
+		// Skip core initialization for init, prime, and version commands, and for
+		// the merge driver (other bean files may contain conflict markers mid-merge)
+		if cmd == initCmd || cmd.Name() == "prime" || cmd.Name() == "version" || cmd.Name() == "merge-driver" {
 			return nil
 		}
 
@@ -36,15 +56,29 @@ a full view of your project.`,
 				return fmt.Errorf("loading config from %s: %w", configPath, err)
 			}
 		} else {
-			// Search upward for .beans.yml
-			cwd, err := os.Getwd()
-			if err != nil {
-				return fmt.Errorf("getting current directory: %w", err)
+			// Search upward from --root or the current directory for the
+			// nearest .beans directory or .beans.yml, like git does
+			start := rootDir
+			if start == "" {
+				if start, err = os.Getwd(); err != nil {
+					return fmt.Errorf("getting current directory: %w", err)
+				}
+			}
+			projectDir, findErr := beancore.FindRoot(start)
+			if errors.Is(findErr, beancore.ErrNoRoot) && beansPath == "" {
+				return fmt.Errorf("no .beans directory found in %s or any parent directory (run 'beans init' to create one)", start)
+			} else if errors.Is(findErr, beancore.ErrNoRoot) {
+				// An explicit --beans-path doesn't need a project
+				projectDir, findErr = filepath.Abs(start)
+			}
+			if findErr != nil {
+				return fmt.Errorf("finding project: %w", findErr)
 			}
-			cfg, err = config.LoadFromDirectory(cwd)
+			cfg, err = config.Load(filepath.Join(projectDir, config.ConfigFileName))
 			if err != nil {
 				return fmt.Errorf("loading config: %w", err)
 			}
+			cfg.SetConfigDir(projectDir)
 		}
 
 		// Determine beans directory
@@ -65,7 +99,15 @@ a full view of your project.`,
 			}
 		}
 
+		ui.SetAbsoluteDates(cfg.Beans.AbsoluteDates)
+
 		core = beancore.New(root, cfg)
+		if cmd == checkCmd || cmd == migrateCmd {
+			// check reports front matter issues itself, and migrate fixes them
+			core.SetLogger(nil)
+		} else {
+			core.SetLogger(logger)
+		}
 		if err := core.Load(); err != nil {
 			return fmt.Errorf("loading beans: %w", err)
 		}
@@ -83,9 +125,60 @@ a full view of your project.`,
 	},
 }
 
+// setupLogging configures the logger from flags, falling back to environment
+// variables. By default, warnings and errors are logged as text; serve logs
+// info (its requests) as JSON.
+func setupLogging(cmd *cobra.Command) error {
+	level, format := slog.LevelWarn, logging.FormatText
+	if cmd == serveCmd {
+		level, format = slog.LevelInfo, logging.FormatJSON
+	}
+
+	if s := flagOrEnv(cmd, "log-level", logLevel, logging.EnvLevel); s != "" {
+		l, err := logging.ParseLevel(s)
+		if err != nil {
+			return err
+		}
+		level = l
+	}
+	if s := flagOrEnv(cmd, "log-format", logFormat, logging.EnvFormat); s != "" {
+		f, err := logging.ParseFormat(s)
+		if err != nil {
+			return err
+		}
+		format = f
+	}
+
+	logger = logging.New(os.Stderr, level, format)
+	slog.SetDefault(logger)
+	return nil
+}
+
+// setupAccessibility turns on accessible rendering (no colors, glyphs or box
+// drawing) from --accessible, falling back to BEANS_ACCESSIBLE.
+func setupAccessibility(cmd *cobra.Command) {
+	on := accessibleMode
+	if !cmd.Flags().Changed("accessible") {
+		on, _ = strconv.ParseBool(os.Getenv(ui.EnvAccessible))
+	}
+	ui.SetAccessible(on)
+}
+
+// flagOrEnv returns the flag's value if it was given, else the environment variable's.
+func flagOrEnv(cmd *cobra.Command, flag, value, env string) string {
+	if cmd.Flags().Changed(flag) {
+		return value
+	}
+	return os.Getenv(env)
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVar(&beansPath, "beans-path", "", "Path to data directory (overrides config)")
+	rootCmd.PersistentFlags().StringVar(&rootDir, "root", "", "Directory to start looking for the project in (default: current directory)")
 	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Path to config file (default: searches upward for .beans.yml)")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "", "Log level: debug, info, warn or error (default warn, info for serve; env "+logging.EnvLevel+")")
+	rootCmd.PersistentFlags().BoolVar(&accessibleMode, "accessible", false, "Screen-reader-friendly output: no colors, glyphs or box drawing (env "+ui.EnvAccessible+")")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "", "Log format: text or json (default text, json for serve; env "+logging.EnvFormat+")")
 }
 
 func Execute() {
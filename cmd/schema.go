@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/pretty"
+)
+
+var (
+	schemaSDL  bool
+	schemaJSON bool
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the GraphQL schema",
+	Long: `Prints the full GraphQL schema for code generators, editors and other
+GraphQL tooling: as SDL (schema definition language, the default; same as
+beans graphql --schema), or with --json as the result of the standard
+introspection query.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if schemaSDL && schemaJSON {
+			return fmt.Errorf("--sdl and --json are mutually exclusive")
+		}
+		if schemaJSON {
+			data, err := graph.Introspect(core)
+			if err != nil {
+				return fmt.Errorf("introspecting schema: %w", err)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), string(pretty.Pretty(data)))
+			return nil
+		}
+		fmt.Fprint(cmd.OutOrStdout(), GetGraphQLSchema())
+		return nil
+	},
+}
+
+func init() {
+	schemaCmd.Flags().BoolVar(&schemaSDL, "sdl", false, "Print the schema as SDL (default)")
+	schemaCmd.Flags().BoolVar(&schemaJSON, "json", false, "Print the introspection query result as JSON")
+	rootCmd.AddCommand(schemaCmd)
+}
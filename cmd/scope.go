@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var scopeJSON bool
+
+var scopeCmd = &cobra.Command{
+	Use:   "scope <id>",
+	Short: "Infer a bean's monorepo scope from its branch",
+	Long: `Sets a bean's scope from the files changed on its git branch: the configured
+scope containing most of them. Once the branch is merged, the files its merge
+commit changed are used instead. 'beans sync' does this automatically for beans
+without a scope when it detects their merge.
+
+Scopes are configured in .beans.yml as paths relative to the repository root;
+an entry ending in /* stands for each directory directly below it:
+
+  beans:
+    scopes:
+      - packages/*     # packages/api, packages/web, ...
+      - docs
+
+To set a scope by hand, use 'beans update <id> --scope <path>'. Filter by scope
+with 'beans list --scope <path>'.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(scopeJSON, args[0], err)
+		}
+		if !core.IsGitFlowEnabled() {
+			if err := core.EnableGitFlow("."); err != nil {
+				return cmdError(scopeJSON, output.ErrGit, "git integration not available: %v", err)
+			}
+		}
+
+		b, err := resolver.Mutation().InferScope(ctx, existing.ID)
+		if err != nil {
+			return cmdError(scopeJSON, output.ErrValidation, "failed to infer scope: %v", err)
+		}
+
+		if scopeJSON {
+			return output.Success(jsonRedaction(), b, "Scope inferred")
+		}
+		fmt.Println(ui.Success.Render("Scoped ") + ui.ID.Render(b.ID) + " " + b.Title + ui.Muted.Render(" to ") + b.Scope)
+		return nil
+	},
+}
+
+func init() {
+	scopeCmd.Flags().BoolVar(&scopeJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(scopeCmd)
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/web"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serveAddr          string
+	serveIntrospection bool
+	serveReadOnly      bool
+	serveRateLimit     float64
+	serveNoUI          bool
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the GraphQL API and web UI over HTTP",
+	Long: `Serves the beans GraphQL API at /graphql and, unless --no-ui is given, a
+web UI at / with a board, a tree and a detail view of the beans. Requests are POSTed as JSON
+({"query": ..., "variables": ..., "operationName": ...}) or, for queries, sent
+as GET with URL parameters. Beans changed on disk are picked up automatically.
+
+Clients that send "Accept: multipart/mixed" get incremental delivery:
+fragments marked @defer (e.g. "... @defer { children { id } }") arrive in
+later parts of a multipart/mixed response as they resolve, as Apollo Client
+and urql expect. Only fields with resolvers (links, rollups and the like) are
+deferred; @stream is not supported. Other clients get @defer'd fragments
+resolved in place.
+
+Schema introspection, which tools like GraphiQL need, is off unless enabled
+with --introspection or server.introspection in .beans.yml.
+
+With --read-only (or server.read_only), mutations are rejected with an error
+whose extensions carry {"code": "READ_ONLY"}, so the API can be exposed to
+dashboards without risking writes.
+
+With server.auth, clients must send "Authorization: Bearer <token>". Static
+tokens have a read or write scope; read tokens can only run queries. With
+OIDC, JWTs from the issuer are accepted and get write scope if they were
+granted write_scope (default beans:write).
+
+Every request is logged (as JSON, see --log-format) with its operation, status, duration and
+the number of beans returned. With --rate-limit (or server.rate_limit), each
+client IP may send that many requests per second on average; excess requests
+get 429 Too Many Requests.
+
+Settings (in .beans.yml):
+  server:
+    addr: 127.0.0.1:7373
+    introspection: true
+    read_only: true
+    auth:
+      tokens:
+        - name: dashboard
+          token_env: BEANS_DASHBOARD_TOKEN
+          scope: read
+        - name: ci
+          token_env: BEANS_CI_TOKEN
+          scope: write
+      oidc:
+        issuer: https://accounts.example.com
+        audience: beans
+    rate_limit:
+      requests_per_second: 10
+      burst: 20`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr := cfg.GetServerAddr()
+		if cmd.Flags().Changed("addr") {
+			addr = serveAddr
+		}
+		introspection := cfg.Beans.Server.Introspection
+		if cmd.Flags().Changed("introspection") {
+			introspection = serveIntrospection
+		}
+		readOnly := cfg.Beans.Server.ReadOnly
+		if cmd.Flags().Changed("read-only") {
+			readOnly = serveReadOnly
+		}
+
+		rateLimit := cfg.Beans.Server.RateLimit
+		if cmd.Flags().Changed("rate-limit") {
+			rateLimit.RequestsPerSecond = serveRateLimit
+		}
+		if rateLimit.RequestsPerSecond < 0 {
+			return fmt.Errorf("--rate-limit must not be negative")
+		}
+
+		auth, err := graph.NewAuthenticator(cfg.Beans.Server.Auth)
+		if err != nil {
+			return err
+		}
+		if auth == nil && !isLoopback(addr) {
+			logger.Warn("serving without authentication (see server.auth)", "addr", addr)
+		}
+
+		if err := core.StartWatching(); err != nil {
+			return fmt.Errorf("watching beans: %w", err)
+		}
+		defer core.Unwatch()
+
+		mux := http.NewServeMux()
+		mux.Handle("/graphql", graph.NewHandler(core, graph.ServerOptions{
+			Introspection: introspection,
+			Redact:        cfg.Beans.Redact,
+			ReadOnly:      readOnly,
+			Auth:          auth,
+			RateLimit:     rateLimit,
+			Logger:        logger,
+		}))
+		if !serveNoUI {
+			mux.Handle("/", web.Handler(webOptions(auth != nil, readOnly)))
+		}
+		server := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = server.Shutdown(shutdownCtx)
+		}()
+
+		if !serveNoUI {
+			logger.Debug("serving web UI", "url", "http://"+addr+"/")
+		}
+		logger.Debug("serving GraphQL", "url", "http://"+addr+"/graphql", "read_only", readOnly)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	},
+}
+
+// webOptions returns the web UI settings: the project name as its title and
+// a board column per status.
+func webOptions(auth, readOnly bool) web.Options {
+	opts := web.Options{Title: filepath.Base(cfg.ConfigDir()), Auth: auth, ReadOnly: readOnly}
+	for _, name := range cfg.StatusNames() {
+		s := cfg.GetStatus(name)
+		opts.Columns = append(opts.Columns, web.Column{Status: s.Name, Color: s.Color, Archive: s.Archive})
+	}
+	return opts
+}
+
+// isLoopback returns true if addr only listens on the loopback interface.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "", "Address to listen on (default from server.addr, or 127.0.0.1:7373)")
+	serveCmd.Flags().BoolVar(&serveIntrospection, "introspection", false, "Allow GraphQL schema introspection (overrides server.introspection)")
+	serveCmd.Flags().BoolVar(&serveReadOnly, "read-only", false, "Reject mutations (overrides server.read_only)")
+	serveCmd.Flags().Float64Var(&serveRateLimit, "rate-limit", 0, "Requests per second allowed per client, 0 for no limit (overrides server.rate_limit)")
+	serveCmd.Flags().BoolVar(&serveNoUI, "no-ui", false, "Serve only the GraphQL API, without the web UI")
+	rootCmd.AddCommand(serveCmd)
+}
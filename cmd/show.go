@@ -3,11 +3,15 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/gitflow"
 	"github.com/hmans/beans/internal/graph"
 	"github.com/hmans/beans/internal/output"
 	"github.com/hmans/beans/internal/ui"
@@ -19,13 +23,21 @@ var (
 	showRaw      bool
 	showBodyOnly bool
 	showETagOnly bool
+	showCommits  bool
+	showSection  string
 )
 
 var showCmd = &cobra.Command{
 	Use:   "show <id> [id...]",
 	Short: "Show a bean's contents",
-	Long:  `Displays the full contents of one or more beans, including front matter and body.`,
-	Args:  cobra.MinimumNArgs(1),
+	Long: `Displays the full contents of one or more beans, including front matter and body.
+
+Use --commits to also list git commits whose messages mention the bean's ID
+(e.g. "fixes abc1" or "Refs: beans-abc1").
+
+Use --section to output only one markdown section of the body, e.g.
+--section "Acceptance Criteria".`,
+	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		resolver := &graph.Resolver{Core: core}
 
@@ -33,27 +45,33 @@ var showCmd = &cobra.Command{
 		var beans []*bean.Bean
 		for _, id := range args {
 			b, err := resolver.Query().Bean(context.Background(), id)
-			if err != nil {
-				if showJSON {
-					return output.Error(output.ErrNotFound, err.Error())
-				}
-				return fmt.Errorf("failed to find bean: %w", err)
+			if err != nil || b == nil {
+				return beanNotFound(showJSON, id, err)
 			}
-			if b == nil {
-				if showJSON {
-					return output.Error(output.ErrNotFound, fmt.Sprintf("bean not found: %s", id))
+			beans = append(beans, b)
+		}
+
+		// Single body section (no heading, no styling)
+		if showSection != "" {
+			for i, b := range beans {
+				section, ok := b.Section(showSection)
+				if !ok {
+					return fmt.Errorf("bean %s has no section %q", b.ID, showSection)
+				}
+				if i > 0 {
+					fmt.Print("\n---\n\n")
 				}
-				return fmt.Errorf("bean not found: %s", id)
+				fmt.Println(section.Content)
 			}
-			beans = append(beans, b)
+			return nil
 		}
 
 		// JSON output
 		if showJSON {
 			if len(beans) == 1 {
-				return output.SuccessSingle(beans[0])
+				return output.SuccessSingle(jsonRedaction(), beans[0])
 			}
-			return output.SuccessMultiple(beans)
+			return output.SuccessMultiple(jsonRedaction(), beans)
 		}
 
 		// Raw markdown output (frontmatter + body)
@@ -93,6 +111,13 @@ var showCmd = &cobra.Command{
 			return nil
 		}
 
+		// Commit lookup requires git integration
+		if showCommits && !core.IsGitFlowEnabled() {
+			if err := core.EnableGitFlow("."); err != nil {
+				return fmt.Errorf("git integration not available: %w", err)
+			}
+		}
+
 		// Default: styled human-friendly output
 		for i, b := range beans {
 			if i > 0 {
@@ -101,6 +126,13 @@ var showCmd = &cobra.Command{
 				fmt.Println()
 			}
 			showStyledBean(b)
+			if showCommits {
+				commits, err := resolver.Bean().Commits(context.Background(), b, nil)
+				if err != nil {
+					return fmt.Errorf("failed to find commits: %w", err)
+				}
+				showCommitList(commits)
+			}
 		}
 
 		return nil
@@ -133,19 +165,40 @@ func showStyledBean(b *bean.Bean) {
 		header.WriteString("  ")
 		header.WriteString(ui.Muted.Render(strings.Join(b.Tags, ", ")))
 	}
+	if b.Scope != "" {
+		header.WriteString("  ")
+		header.WriteString(ui.Muted.Render("scope: " + b.Scope))
+	}
+	if b.Draft {
+		header.WriteString("  ")
+		header.WriteString(ui.Warning.Render("unpublished draft"))
+	}
+	if beancore.IsLocalPath(b.Path) {
+		header.WriteString("  ")
+		header.WriteString(ui.Warning.Render("local only"))
+	}
 	header.WriteString("\n")
 	header.WriteString(ui.Title.Render(b.Title))
+	if b.SourceURL != "" {
+		header.WriteString("\n")
+		header.WriteString(ui.Muted.Render("source: " + b.SourceURL))
+	}
+	if len(b.Aliases) > 0 {
+		header.WriteString("\n")
+		header.WriteString(ui.Muted.Render("aliases: " + strings.Join(b.Aliases, ", ")))
+	}
 
 	// Display relationships
-	if b.Parent != "" || len(b.Blocking) > 0 {
+	mentionedBy := core.MentionedBy(b.ID)
+	if b.Parent != "" || len(b.Blocking) > 0 || len(b.Links) > 0 || len(mentionedBy) > 0 {
 		header.WriteString("\n")
-		header.WriteString(ui.Muted.Render(strings.Repeat("─", 50)))
+		header.WriteString(ui.Muted.Render(strings.Repeat(ui.RuleGlyph.String(), 50)))
 		header.WriteString("\n")
-		header.WriteString(formatRelationships(b))
+		header.WriteString(formatRelationships(b, mentionedBy))
 	}
 
 	header.WriteString("\n")
-	header.WriteString(ui.Muted.Render(strings.Repeat("─", 50)))
+	header.WriteString(ui.Muted.Render(strings.Repeat(ui.RuleGlyph.String(), 50)))
 
 	headerBox := lipgloss.NewStyle().
 		MarginBottom(1).
@@ -155,8 +208,12 @@ func showStyledBean(b *bean.Bean) {
 
 	// Render the body with Glamour
 	if b.Body != "" {
+		style := glamour.WithAutoStyle()
+		if ui.Accessible() {
+			style = glamour.WithStylePath(ui.MarkdownStyle(""))
+		}
 		renderer, err := glamour.NewTermRenderer(
-			glamour.WithAutoStyle(),
+			style,
 			glamour.WithWordWrap(80),
 		)
 		if err != nil {
@@ -174,8 +231,26 @@ func showStyledBean(b *bean.Bean) {
 	}
 }
 
-// formatRelationships formats parent and blocks for display.
-func formatRelationships(b *bean.Bean) string {
+// showCommitList displays commits referencing a bean.
+func showCommitList(commits []*gitflow.CommitInfo) {
+	fmt.Println(ui.Muted.Render(strings.Repeat(ui.RuleGlyph.String(), 50)))
+	if len(commits) == 0 {
+		fmt.Println(ui.Muted.Render("No commits reference this bean."))
+		return
+	}
+	fmt.Println(ui.Bold.Render("Commits"))
+	for _, c := range commits {
+		fmt.Printf("%s %s %s %s\n",
+			ui.ID.Render(c.ShortHash),
+			ui.Muted.Render(c.Date.Format("2006-01-02")),
+			c.Subject,
+			ui.Muted.Render("("+c.Author+")"))
+	}
+}
+
+// formatRelationships formats parent, blocks, typed links and the beans
+// mentioning b for display.
+func formatRelationships(b *bean.Bean, mentionedBy []*bean.Bean) string {
 	var parts []string
 
 	// Display parent
@@ -191,6 +266,22 @@ func formatRelationships(b *bean.Bean) string {
 			ui.Muted.Render("blocking:"),
 			ui.ID.Render(target)))
 	}
+
+	// Display typed links
+	for _, linkType := range slices.Sorted(maps.Keys(b.Links)) {
+		for _, target := range b.Links[linkType] {
+			parts = append(parts, fmt.Sprintf("%s %s",
+				ui.Muted.Render(linkType+":"),
+				ui.ID.Render(target)))
+		}
+	}
+
+	// Display beans mentioning this one in their bodies
+	for _, other := range mentionedBy {
+		parts = append(parts, fmt.Sprintf("%s %s",
+			ui.Muted.Render("mentioned by:"),
+			ui.ID.Render(other.ID)))
+	}
 	return strings.Join(parts, "\n")
 }
 
@@ -199,6 +290,13 @@ func init() {
 	showCmd.Flags().BoolVar(&showRaw, "raw", false, "Output raw markdown without styling")
 	showCmd.Flags().BoolVar(&showBodyOnly, "body-only", false, "Output only the body content")
 	showCmd.Flags().BoolVar(&showETagOnly, "etag-only", false, "Output only the etag")
-	showCmd.MarkFlagsMutuallyExclusive("json", "raw", "body-only", "etag-only")
+	showCmd.Flags().BoolVar(&showCommits, "commits", false, "Also list git commits that mention the bean")
+	showCmd.Flags().StringVar(&showSection, "section", "", "Output only the body section with this heading")
+	showCmd.MarkFlagsMutuallyExclusive("json", "raw", "body-only", "etag-only", "section")
+	showCmd.MarkFlagsMutuallyExclusive("commits", "json")
+	showCmd.MarkFlagsMutuallyExclusive("commits", "raw")
+	showCmd.MarkFlagsMutuallyExclusive("commits", "body-only")
+	showCmd.MarkFlagsMutuallyExclusive("commits", "etag-only")
+	showCmd.MarkFlagsMutuallyExclusive("commits", "section")
 	rootCmd.AddCommand(showCmd)
 }
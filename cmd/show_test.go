@@ -27,7 +27,7 @@ func setupShowTestCore(t *testing.T) (*beancore.Core, func()) {
 
 	cfg := config.Default()
 	testCore := beancore.New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
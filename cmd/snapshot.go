@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotJSON    bool
+	snapshotMessage string
+	snapshotForce   bool
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Save and restore snapshots of all beans",
+	Long: `Snapshots save the whole beans directory (beans, queries, templates; not the
+local state) to a tarball, so risky bulk operations and experiments can be
+rolled back without touching git history. Snapshots are kept in
+.beans/.state/snapshots, which is never committed.
+
+  beans snapshot create -m "before retagging"
+  beans snapshot list
+  beans snapshot restore 20261015-1430`,
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Take a snapshot of all beans",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snap, err := core.CreateSnapshot(snapshotMessage)
+		if err != nil {
+			return cmdError(snapshotJSON, output.ErrFileError, "failed to create snapshot: %v", err)
+		}
+		if snapshotJSON {
+			return encodeSnapshot(cmd, snap)
+		}
+		fmt.Printf("%s %s %s\n", ui.Success.Render("Created snapshot"), ui.Bold.Render(snap.ID),
+			ui.Muted.Render(fmt.Sprintf("(%d beans, %d files)", snap.Beans, snap.Files)))
+		return nil
+	},
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List snapshots, newest first",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snaps, err := core.Snapshots()
+		if err != nil {
+			return cmdError(snapshotJSON, output.ErrFileError, "failed to list snapshots: %v", err)
+		}
+		if snapshotJSON {
+			if snaps == nil {
+				snaps = []*beancore.Snapshot{}
+			}
+			return encodeSnapshot(cmd, snaps)
+		}
+		if len(snaps) == 0 {
+			fmt.Println(ui.Muted.Render("No snapshots. Take one with: beans snapshot create"))
+			return nil
+		}
+		for _, s := range snaps {
+			line := fmt.Sprintf("%s  %s  %s", ui.Bold.Render(s.ID), s.CreatedAt.Local().Format("2006-01-02 15:04"),
+				ui.Muted.Render(fmt.Sprintf("%4d beans", s.Beans)))
+			if s.Message != "" {
+				line += "  " + s.Message
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore <id>",
+	Short: "Restore all beans from a snapshot",
+	Long: `Replaces everything in the beans directory with the contents of a snapshot
+(given by ID or a unique ID prefix). The current beans are snapshotted first,
+so the restore itself can be undone. Asks for confirmation unless -f is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snap, err := core.FindSnapshot(args[0])
+		if errors.Is(err, beancore.ErrSnapshotNotFound) {
+			return cmdError(snapshotJSON, output.ErrNotFound, "snapshot not found: %s", args[0])
+		}
+		if err != nil {
+			return cmdError(snapshotJSON, output.ErrValidation, "%v", err)
+		}
+
+		// JSON implies force, like delete
+		if !snapshotForce && !snapshotJSON {
+			fmt.Printf("Replace all %d beans with the %d in snapshot %s? [y/N] ", len(core.All()), snap.Beans, snap.ID)
+			response, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+			response = strings.TrimSpace(strings.ToLower(response))
+			if response != "y" && response != "yes" {
+				fmt.Println("Cancelled")
+				return nil
+			}
+		}
+
+		backup, err := core.RestoreSnapshot(snap.ID)
+		if err != nil {
+			return cmdError(snapshotJSON, output.ErrFileError, "failed to restore snapshot: %v", err)
+		}
+		if snapshotJSON {
+			return encodeSnapshot(cmd, map[string]*beancore.Snapshot{"restored": snap, "backup": backup})
+		}
+		fmt.Printf("%s %s %s\n", ui.Success.Render("Restored snapshot"), ui.Bold.Render(snap.ID),
+			ui.Muted.Render(fmt.Sprintf("(%d beans)", len(core.All()))))
+		fmt.Println(ui.Muted.Render("Previous beans saved as snapshot " + backup.ID))
+		return nil
+	},
+}
+
+// encodeSnapshot writes v as indented JSON.
+func encodeSnapshot(cmd *cobra.Command, v any) error {
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func init() {
+	snapshotCmd.PersistentFlags().BoolVar(&snapshotJSON, "json", false, "Output as JSON")
+	snapshotCreateCmd.Flags().StringVarP(&snapshotMessage, "message", "m", "", "Describe the snapshot")
+	snapshotRestoreCmd.Flags().BoolVarP(&snapshotForce, "force", "f", false, "Skip confirmation")
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+	rootCmd.AddCommand(snapshotCmd)
+}
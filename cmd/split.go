@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	splitItems    []int
+	splitSections []string
+	splitType     string
+	splitJSON     bool
+)
+
+var splitCmd = &cobra.Command{
+	Use:   "split <id>",
+	Short: "Break a bean down into child beans",
+	Long: `Extracts checklist items and body sections of a bean into new child beans,
+e.g. to break down an epic that has grown too large. A checklist item becomes a
+bean titled with its text (completed if checked); a section becomes a bean titled
+with its heading, with the section's content as body. Extracted items and
+sections are removed from the bean, and the new beans keep its tags and priority.
+
+Select what to extract with --item (the item's 1-based position among the bean's
+checklist items) and --section (a heading). Sections must all be at the same
+heading level. Without them, the checklist items and sections are listed to
+choose from.
+
+The new beans are one level below the bean (epics for a milestone, features for
+an epic, tasks for a feature); use --type to choose another type.
+
+  beans split abc1                  # choose interactively
+  beans split abc1 --item 1,3       # the first and third checklist item
+  beans split abc1 --section Design --type task`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(splitJSON, args[0], err)
+		}
+
+		if len(splitItems) == 0 && len(splitSections) == 0 {
+			if splitJSON || !isInteractive() {
+				return cmdError(splitJSON, output.ErrValidation, "select what to split off with --item or --section")
+			}
+			items, sections, err := chooseSplit(bufio.NewReader(os.Stdin), cmd.OutOrStdout(), existing)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 && len(sections) == 0 {
+				fmt.Println("Cancelled")
+				return nil
+			}
+			splitItems, splitSections = items, sections
+		}
+
+		var typeArg *string
+		if splitType != "" {
+			typeArg = &splitType
+		}
+		children, err := resolver.Mutation().SplitBean(ctx, existing.ID, splitItems, splitSections, typeArg)
+		if err != nil {
+			return cmdError(splitJSON, output.ErrValidation, "failed to split bean: %v", err)
+		}
+
+		if splitJSON {
+			return output.JSON(output.Response{
+				Success:   true,
+				Beans:     children,
+				Count:     len(children),
+				Message:   fmt.Sprintf("%d beans split off", len(children)),
+				Redaction: jsonRedaction(),
+			})
+		}
+		fmt.Println(ui.Success.Render("Split ") + ui.ID.Render(existing.ID) + " " + existing.Title + ui.Muted.Render(" into:"))
+		for _, child := range children {
+			fmt.Println("  " + ui.ID.Render(child.ID) + " " + child.Title + ui.Muted.Render(" ("+child.Type+")"))
+		}
+		return nil
+	},
+}
+
+// chooseSplit lists a bean's checklist items and sections and asks which to
+// split off. Items are chosen by number, sections by "s" and their number.
+func chooseSplit(in *bufio.Reader, out io.Writer, b *bean.Bean) (items []int, sections []string, err error) {
+	checklist := b.Checklist().Items
+	allSections := b.Sections()
+	if len(checklist) == 0 && len(allSections) == 0 {
+		return nil, nil, fmt.Errorf("%s has no checklist items or sections to split off", b.ID)
+	}
+
+	if len(checklist) > 0 {
+		fmt.Fprintln(out, ui.Bold.Render("Checklist items:"))
+		for _, item := range checklist {
+			mark := "[ ]"
+			if item.Done {
+				mark = "[x]"
+			}
+			fmt.Fprintf(out, "  %3d  %s %s\n", item.Index, mark, item.Text)
+		}
+	}
+	if len(allSections) > 0 {
+		fmt.Fprintln(out, ui.Bold.Render("Sections:"))
+		for i, s := range allSections {
+			fmt.Fprintf(out, "  %3s  %s%s\n", "s"+strconv.Itoa(i+1), strings.Repeat("  ", s.Level-1), s.Heading)
+		}
+	}
+
+	for {
+		fmt.Fprintf(out, "Split off %s: ", ui.Muted.Render("[e.g. 1 3 s2, empty to cancel]"))
+		line, err := in.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		items, sections, perr := parseSplitSelection(line, len(checklist), allSections)
+		if perr == nil || err == io.EOF {
+			return items, sections, perr
+		}
+		fmt.Fprintln(out, ui.Warning.Render(perr.Error()))
+	}
+}
+
+// parseSplitSelection parses a selection like "1 3 s2" (or "1,3,s2") of
+// checklist items and sections.
+func parseSplitSelection(line string, numItems int, sections []bean.Section) ([]int, []string, error) {
+	var items []int
+	var headings []string
+	for _, field := range strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\n' }) {
+		if rest, ok := strings.CutPrefix(strings.ToLower(field), "s"); ok {
+			n, err := strconv.Atoi(rest)
+			if err != nil || n < 1 || n > len(sections) {
+				return nil, nil, fmt.Errorf("no section %s", field)
+			}
+			headings = append(headings, sections[n-1].Heading)
+			continue
+		}
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 1 || n > numItems {
+			return nil, nil, fmt.Errorf("no checklist item %s", field)
+		}
+		items = append(items, n)
+	}
+	return items, headings, nil
+}
+
+func init() {
+	splitCmd.Flags().IntSliceVar(&splitItems, "item", nil, "Checklist item to split off, by position (can be repeated or comma-separated)")
+	splitCmd.Flags().StringArrayVar(&splitSections, "section", nil, "Section to split off, by heading (can be repeated)")
+	splitCmd.Flags().StringVarP(&splitType, "type", "t", "", "Type of the new beans (default: one level below the bean's)")
+	splitCmd.Flags().BoolVar(&splitJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(splitCmd)
+}
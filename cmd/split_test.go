@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestParseSplitSelection(t *testing.T) {
+	sections := []bean.Section{{Heading: "Design", Level: 2}, {Heading: "Rollout", Level: 2}}
+
+	items, headings, err := parseSplitSelection("1, 3 S2\n", 3, sections)
+	if err != nil {
+		t.Fatalf("parseSplitSelection() error = %v", err)
+	}
+	if !reflect.DeepEqual(items, []int{1, 3}) || !reflect.DeepEqual(headings, []string{"Rollout"}) {
+		t.Errorf("got items %v, sections %v", items, headings)
+	}
+
+	if items, headings, err := parseSplitSelection("\n", 3, sections); err != nil || items != nil || headings != nil {
+		t.Errorf("empty selection = %v, %v, %v; want nothing", items, headings, err)
+	}
+	for _, bad := range []string{"4", "0", "s3", "x"} {
+		if _, _, err := parseSplitSelection(bad, 3, sections); err == nil {
+			t.Errorf("parseSplitSelection(%q) should fail", bad)
+		}
+	}
+}
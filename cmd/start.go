@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	startNoBranch  bool
+	startAutoStash bool
+	startJSON      bool
+)
+
+var startCmd = &cobra.Command{
+	Use:   "start <id>",
+	Short: "Start working on a bean",
+	Long: `Marks a bean as in-progress. With git integration enabled, also creates the
+bean's branch from the base branch, or switches to it if it already exists.
+
+Use --no-branch to only change the status. If the working tree has uncommitted
+changes, you'll be offered to stash them; --auto-stash does so without asking.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		resolver := &graph.Resolver{Core: core}
+
+		existing, err := resolver.Query().Bean(ctx, args[0])
+		if err != nil || existing == nil {
+			return beanNotFound(startJSON, args[0], err)
+		}
+
+		if startAutoStash {
+			core.SetAutoStash(true)
+		}
+		createBranch := !startNoBranch
+		b, err := withStashRetry(startJSON, func() (*bean.Bean, error) {
+			return resolver.Mutation().StartBean(ctx, existing.ID, &createBranch)
+		})
+		if err != nil {
+			return cmdError(startJSON, output.ErrGit, "failed to start bean: %v", err)
+		}
+
+		if startJSON {
+			return output.Success(jsonRedaction(), b, "Bean started")
+		}
+
+		fmt.Println(ui.Success.Render("Started ") + ui.ID.Render(b.ID) + " " + b.Title)
+		if b.GitBranch != "" {
+			fmt.Println(ui.Muted.Render("Branch: ") + b.GitBranch)
+		}
+		fmt.Println()
+		fmt.Println(ui.Bold.Render("Next steps"))
+		fmt.Println("  1. Make your changes and commit them")
+		if b.GitBranch != "" {
+			fmt.Printf("  2. Merge %s (if you open a pull request: beans update %s --pr-url <url>)\n", b.GitBranch, b.ID)
+			fmt.Printf("  3. beans finish %s\n", b.ID)
+		} else {
+			fmt.Printf("  2. beans finish %s\n", b.ID)
+		}
+		return nil
+	},
+}
+
+func init() {
+	startCmd.Flags().BoolVar(&startNoBranch, "no-branch", false, "Don't create or switch to a git branch")
+	startCmd.Flags().BoolVar(&startAutoStash, "auto-stash", false, "Stash uncommitted changes around the branch switch and restore them afterwards")
+	startCmd.Flags().BoolVar(&startJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(startCmd)
+}
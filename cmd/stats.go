@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsJSON  bool
+	statsWeeks int
+)
+
+// statsData holds aggregate project statistics for JSON output.
+type statsData struct {
+	Total    int            `json:"total"`
+	ByStatus map[string]int `json:"by_status"`
+	ByType   map[string]int `json:"by_type"`
+	Points   pointsStats    `json:"points"`
+	Velocity []weekVelocity `json:"velocity"`
+	Cycle    cycleStats     `json:"cycle_time"`
+	Branch   branchStats    `json:"branch_cycle_time"`
+}
+
+// cycleStats summarizes how long completed beans took, based on their
+// status history. Durations are in seconds.
+type cycleStats struct {
+	Beans   int `json:"beans"`
+	Average int `json:"average"`
+	Median  int `json:"median"`
+	// TimeInStatus is the average time completed beans spent in each status
+	// before completion.
+	TimeInStatus map[string]int `json:"time_in_status"`
+}
+
+// branchStats summarizes how long beans' git branches lived, from creation
+// (git_created_at) until sync detected their merge (git_merged_at).
+// Durations are in seconds.
+type branchStats struct {
+	Beans   int `json:"beans"`
+	Average int `json:"average"`
+	Median  int `json:"median"`
+}
+
+// pointsStats summarizes story points across all estimated leaf beans.
+type pointsStats struct {
+	Total       int `json:"total"`
+	Completed   int `json:"completed"`
+	Remaining   int `json:"remaining"`
+	Unestimated int `json:"unestimated"`
+}
+
+// weekVelocity is the amount of work completed in a single week.
+type weekVelocity struct {
+	WeekStart time.Time `json:"week_start"`
+	Beans     int       `json:"beans"`
+	Points    int       `json:"points"`
+}
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show project statistics and velocity",
+	Long: `Shows bean counts by status and type, story point totals, and weekly velocity
+(beans and points completed per week).
+
+Points are summed over leaf beans only (beans without children), so estimates on
+epics and milestones are not counted twice. Scrapped beans are excluded.
+Unpublished drafts (see 'beans publish') aren't counted at all.
+
+Cycle time is measured for completed beans from when they first entered
+in-progress until completion, using the status history recorded in each bean.
+Branch cycle time is measured for beans with a merged git branch, from when the
+branch was created until its merge was detected.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		allBeans, err := resolver.Query().Beans(context.Background(), nil, nil)
+		if err != nil {
+			return fmt.Errorf("querying beans: %w", err)
+		}
+
+		data := buildStats(allBeans, time.Now().UTC(), statsWeeks)
+
+		if statsJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(data)
+		}
+
+		fmt.Println(ui.Bold.Render("Beans") + ui.Muted.Render(fmt.Sprintf(" (%d total)", data.Total)))
+		for _, s := range cfg.StatusNames() {
+			if n := data.ByStatus[s]; n > 0 {
+				fmt.Printf("  %-12s %d\n", s, n)
+			}
+		}
+		fmt.Println()
+		fmt.Println(ui.Bold.Render("Types"))
+		for _, t := range cfg.TypeNames() {
+			if n := data.ByType[t]; n > 0 {
+				fmt.Printf("  %-12s %d\n", t, n)
+			}
+		}
+		fmt.Println()
+		fmt.Println(ui.Bold.Render("Points"))
+		fmt.Printf("  %-12s %d\n", "total", data.Points.Total)
+		fmt.Printf("  %-12s %d\n", "completed", data.Points.Completed)
+		fmt.Printf("  %-12s %d\n", "remaining", data.Points.Remaining)
+		if data.Points.Unestimated > 0 {
+			fmt.Printf("  %-12s %d\n", "unestimated", data.Points.Unestimated)
+		}
+		fmt.Println()
+		fmt.Println(ui.Bold.Render("Velocity") + ui.Muted.Render(" (completed per week)"))
+		var sumPoints, sumBeans int
+		for _, w := range data.Velocity {
+			fmt.Printf("  %s  %3d beans  %3d pts\n", w.WeekStart.Format("2006-01-02"), w.Beans, w.Points)
+			sumPoints += w.Points
+			sumBeans += w.Beans
+		}
+		if len(data.Velocity) > 0 {
+			fmt.Println(ui.Muted.Render(fmt.Sprintf("  average: %.1f beans, %.1f pts per week",
+				float64(sumBeans)/float64(len(data.Velocity)),
+				float64(sumPoints)/float64(len(data.Velocity)))))
+		}
+		if data.Cycle.Beans > 0 {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Cycle time") + ui.Muted.Render(fmt.Sprintf(" (%d completed beans)", data.Cycle.Beans)))
+			fmt.Printf("  %-12s %s\n", "average", formatDuration(data.Cycle.Average))
+			fmt.Printf("  %-12s %s\n", "median", formatDuration(data.Cycle.Median))
+			fmt.Println(ui.Muted.Render("  average time in status:"))
+			for _, s := range cfg.StatusNames() {
+				if secs, ok := data.Cycle.TimeInStatus[s]; ok {
+					fmt.Printf("  %-12s %s\n", s, formatDuration(secs))
+				}
+			}
+		}
+		if data.Branch.Beans > 0 {
+			fmt.Println()
+			fmt.Println(ui.Bold.Render("Branch cycle time") + ui.Muted.Render(fmt.Sprintf(" (%d merged branches)", data.Branch.Beans)))
+			fmt.Printf("  %-12s %s\n", "average", formatDuration(data.Branch.Average))
+			fmt.Printf("  %-12s %s\n", "median", formatDuration(data.Branch.Median))
+		}
+		return nil
+	},
+}
+
+// buildStats computes statistics for the given beans.
+// Velocity covers the given number of weeks ending with the week containing now.
+func buildStats(beans []*bean.Bean, now time.Time, weeks int) statsData {
+	data := statsData{
+		Total:    len(beans),
+		ByStatus: countBy(beans, func(b *bean.Bean) string { return b.Status }),
+		ByType:   countBy(beans, func(b *bean.Bean) string { return b.Type }),
+		Velocity: []weekVelocity{},
+		Cycle:    cycleStats{TimeInStatus: make(map[string]int)},
+	}
+
+	hasChildren := make(map[string]bool)
+	for _, b := range beans {
+		if b.Parent != "" {
+			hasChildren[b.Parent] = true
+		}
+	}
+
+	if weeks < 1 {
+		weeks = 1
+	}
+	currentWeek := weekStart(now)
+	firstWeek := currentWeek.AddDate(0, 0, -7*(weeks-1))
+	for i := 0; i < weeks; i++ {
+		data.Velocity = append(data.Velocity, weekVelocity{WeekStart: firstWeek.AddDate(0, 0, 7*i)})
+	}
+
+	for _, b := range beans {
+		if b.Status == "scrapped" || hasChildren[b.ID] {
+			continue
+		}
+
+		points := 0
+		if b.Points != nil {
+			points = *b.Points
+			data.Points.Total += points
+			if b.Status == "completed" {
+				data.Points.Completed += points
+			}
+		} else {
+			data.Points.Unestimated++
+		}
+
+		if b.Status == "completed" && b.UpdatedAt != nil {
+			week := weekStart(*b.UpdatedAt)
+			if !week.Before(firstWeek) && !week.After(currentWeek) {
+				idx := int(week.Sub(firstWeek).Hours() / (24 * 7))
+				data.Velocity[idx].Beans++
+				data.Velocity[idx].Points += points
+			}
+		}
+	}
+	data.Points.Remaining = data.Points.Total - data.Points.Completed
+	data.Cycle = buildCycleStats(beans, now)
+	data.Branch = buildBranchStats(beans)
+
+	return data
+}
+
+// countBy counts beans by a key, such as their status or type.
+func countBy(beans []*bean.Bean, key func(*bean.Bean) string) map[string]int {
+	counts := make(map[string]int)
+	for _, b := range beans {
+		counts[key(b)]++
+	}
+	return counts
+}
+
+// buildCycleStats computes cycle time statistics for completed beans that
+// have a recorded status history.
+func buildCycleStats(beans []*bean.Bean, now time.Time) cycleStats {
+	stats := cycleStats{TimeInStatus: make(map[string]int)}
+
+	var cycles []time.Duration
+	statusTotals := make(map[string]time.Duration)
+	statusCounts := make(map[string]int)
+	for _, b := range beans {
+		cycle, ok := b.CycleTime()
+		if !ok {
+			continue
+		}
+		cycles = append(cycles, cycle)
+		for status, d := range b.TimeInStatus(now) {
+			if status == "completed" {
+				continue
+			}
+			statusTotals[status] += d
+			statusCounts[status]++
+		}
+	}
+	if len(cycles) == 0 {
+		return stats
+	}
+
+	stats.Beans = len(cycles)
+	stats.Average, stats.Median = averageAndMedian(cycles)
+	for status, d := range statusTotals {
+		stats.TimeInStatus[status] = int((d / time.Duration(statusCounts[status])).Seconds())
+	}
+	return stats
+}
+
+// buildBranchStats computes branch cycle time statistics for beans whose
+// git branch creation and merge were both recorded.
+func buildBranchStats(beans []*bean.Bean) branchStats {
+	var cycles []time.Duration
+	for _, b := range beans {
+		if cycle, ok := b.BranchCycleTime(); ok {
+			cycles = append(cycles, cycle)
+		}
+	}
+	if len(cycles) == 0 {
+		return branchStats{}
+	}
+	stats := branchStats{Beans: len(cycles)}
+	stats.Average, stats.Median = averageAndMedian(cycles)
+	return stats
+}
+
+// averageAndMedian returns the average and median of a non-empty list of
+// durations, in seconds. The list is sorted in place.
+func averageAndMedian(durations []time.Duration) (average, median int) {
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+	m := durations[len(durations)/2]
+	if len(durations)%2 == 0 {
+		m = (durations[len(durations)/2-1] + durations[len(durations)/2]) / 2
+	}
+	return int((total / time.Duration(len(durations))).Seconds()), int(m.Seconds())
+}
+
+// formatDuration renders a number of seconds as a compact duration like "3d 4h".
+func formatDuration(seconds int) string {
+	d := time.Duration(seconds) * time.Second
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
+
+// weekStart returns midnight UTC of the Monday starting the week containing t.
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7 // Monday = 0
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+func init() {
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Output as JSON")
+	statsCmd.Flags().IntVar(&statsWeeks, "weeks", 4, "Number of weeks to include in velocity report")
+	rootCmd.AddCommand(statsCmd)
+}
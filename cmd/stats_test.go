@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestBuildStats(t *testing.T) {
+	// Wednesday, so the current week started on Monday 2025-01-13
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	thisWeek := time.Date(2025, 1, 14, 9, 0, 0, 0, time.UTC)
+	lastWeek := time.Date(2025, 1, 8, 9, 0, 0, 0, time.UTC)
+	longAgo := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	three, five, eight := 3, 5, 8
+
+	beans := []*bean.Bean{
+		{ID: "epic", Type: "epic", Status: "in-progress", Points: &eight},
+		{ID: "a", Type: "task", Status: "completed", Parent: "epic", Points: &three, UpdatedAt: &thisWeek},
+		{ID: "b", Type: "task", Status: "completed", Parent: "epic", Points: &five, UpdatedAt: &lastWeek},
+		{ID: "c", Type: "bug", Status: "completed", UpdatedAt: &longAgo},
+		{ID: "d", Type: "task", Status: "todo", Points: &eight},
+		{ID: "e", Type: "task", Status: "scrapped", Points: &five},
+	}
+
+	data := buildStats(beans, now, 2)
+
+	if data.Total != 6 {
+		t.Errorf("Total = %d, want 6", data.Total)
+	}
+	if data.ByStatus["completed"] != 3 {
+		t.Errorf("ByStatus[completed] = %d, want 3", data.ByStatus["completed"])
+	}
+	if data.ByType["task"] != 4 {
+		t.Errorf("ByType[task] = %d, want 4", data.ByType["task"])
+	}
+
+	// Epic's own points and scrapped beans are excluded
+	wantPoints := pointsStats{Total: 16, Completed: 8, Remaining: 8, Unestimated: 1}
+	if data.Points != wantPoints {
+		t.Errorf("Points = %+v, want %+v", data.Points, wantPoints)
+	}
+
+	if len(data.Velocity) != 2 {
+		t.Fatalf("len(Velocity) = %d, want 2", len(data.Velocity))
+	}
+	if !data.Velocity[0].WeekStart.Equal(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Velocity[0].WeekStart = %v, want 2025-01-06", data.Velocity[0].WeekStart)
+	}
+	if data.Velocity[0].Points != 5 || data.Velocity[0].Beans != 1 {
+		t.Errorf("Velocity[0] = %+v, want 1 bean / 5 pts", data.Velocity[0])
+	}
+	if data.Velocity[1].Points != 3 || data.Velocity[1].Beans != 1 {
+		t.Errorf("Velocity[1] = %+v, want 1 bean / 3 pts", data.Velocity[1])
+	}
+}
+
+func TestBuildCycleStats(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := func(n int) time.Time { return start.Add(time.Duration(n) * time.Hour) }
+
+	beans := []*bean.Bean{
+		{ID: "a", Status: "completed", StatusHistory: []bean.StatusChange{
+			{Status: "todo", ChangedAt: h(0)}, {Status: "in-progress", ChangedAt: h(2)}, {Status: "completed", ChangedAt: h(4)},
+		}},
+		{ID: "b", Status: "completed", StatusHistory: []bean.StatusChange{
+			{Status: "todo", ChangedAt: h(0)}, {Status: "in-progress", ChangedAt: h(4)}, {Status: "completed", ChangedAt: h(10)},
+		}},
+		{ID: "c", Status: "completed", StatusHistory: []bean.StatusChange{
+			{Status: "in-progress", ChangedAt: h(0)}, {Status: "completed", ChangedAt: h(10)},
+		}},
+		// Not completed, or no history: ignored
+		{ID: "d", Status: "in-progress", StatusHistory: []bean.StatusChange{{Status: "in-progress", ChangedAt: h(0)}}},
+		{ID: "e", Status: "completed"},
+	}
+
+	got := buildCycleStats(beans, h(100))
+
+	hour := int(time.Hour.Seconds())
+	want := cycleStats{
+		Beans:   3,
+		Average: 6 * hour,
+		Median:  6 * hour,
+		TimeInStatus: map[string]int{
+			"todo":        3 * hour,
+			"in-progress": 6 * hour,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("buildCycleStats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildBranchStats(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := func(n int) *time.Time { t := start.Add(time.Duration(n) * time.Hour); return &t }
+
+	beans := []*bean.Bean{
+		{ID: "a", GitCreatedAt: h(0), GitMergedAt: h(2)},
+		{ID: "b", GitCreatedAt: h(0), GitMergedAt: h(4)},
+		{ID: "c", GitCreatedAt: h(0), GitMergedAt: h(12)},
+		// Branch not merged, or no branch: ignored
+		{ID: "d", GitCreatedAt: h(0)},
+		{ID: "e"},
+	}
+
+	hour := int(time.Hour.Seconds())
+	want := branchStats{Beans: 3, Average: 6 * hour, Median: 4 * hour}
+	if got := buildBranchStats(beans); got != want {
+		t.Errorf("buildBranchStats() = %+v, want %+v", got, want)
+	}
+	if got := buildBranchStats(nil); got != (branchStats{}) {
+		t.Errorf("buildBranchStats(nil) = %+v, want zero", got)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{90, "1m"},
+		{2*3600 + 15*60, "2h 15m"},
+		{3*86400 + 4*3600, "3d 4h"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.seconds); got != tt.want {
+			t.Errorf("formatDuration(%d) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	tests := []struct {
+		in   time.Time
+		want time.Time
+	}{
+		{time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 1, 19, 23, 59, 0, 0, time.UTC), time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := weekStart(tt.in); !got.Equal(tt.want) {
+			t.Errorf("weekStart(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -3,15 +3,21 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/gitflow"
 	"github.com/hmans/beans/internal/graph"
 	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var (
 	syncApply bool
 	syncJSON  bool
+	syncPull  bool
 )
 
 var syncCmd = &cobra.Command{
@@ -21,8 +27,29 @@ var syncCmd = &cobra.Command{
 - Merged branches → bean status becomes 'completed'
 - Deleted branches (not merged) → bean status becomes 'scrapped'
 
+In-progress beans are the exception: their branch may have been lost by
+accident, so when it is gone without having been merged (and isn't checked
+out), the bean is reported as stale instead, with the ways to resolve it:
+recreate the branch with 'beans branch <id>', or put the bean back to todo.
+
+Beans with a pull request URL (set via 'beans update --pr-url') are checked
+against the provider's API instead, so squash-merged PRs whose branches were
+deleted remotely are still detected:
+- Merged pull requests → 'completed'
+- Closed (unmerged) pull requests → 'scrapped'
+GitHub is supported; set GITHUB_TOKEN for private repositories.
+
 By default, shows a preview of changes without applying them.
-Use --apply to actually update the beans.`,
+Use --apply to actually update the beans.
+
+With --pull, the base branch is first fetched from origin and fast-forwarded
+(even without --apply), and the beans added, modified or deleted upstream are
+listed, so branch states are checked against what has actually been merged.
+The pull never merges: if the base branch has diverged from origin, sync stops
+and leaves it to you to merge or rebase.
+
+To sync beans with a GitHub project board or a Linear team, use
+'beans sync github-project' or 'beans sync linear'.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Check if git integration is enabled
 		if !core.IsGitFlowEnabled() {
@@ -32,25 +59,51 @@ Use --apply to actually update the beans.`,
 			}
 		}
 
+		var pulled *beancore.PullResult
+		if syncPull {
+			var err error
+			pulled, err = core.PullBaseBranch()
+			if err != nil {
+				return cmdError(syncJSON, output.ErrGit, "pull failed: %v", err)
+			}
+			if !syncJSON {
+				printPullResult(cmd.OutOrStdout(), pulled)
+			}
+		}
+
 		ctx := context.Background()
 		resolver := &graph.Resolver{Core: core}
 
-		// Perform the sync
-		updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx)
+		// Perform the sync (or just preview it unless --apply is given)
+		dryRun := !syncApply
+		updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx, &dryRun)
 		if err != nil {
 			return cmdError(syncJSON, output.ErrGit, "sync failed: %v", err)
 		}
+		stale, err := resolver.Query().StaleBeans(ctx)
+		if err != nil {
+			return cmdError(syncJSON, output.ErrGit, "checking for stale beans failed: %v", err)
+		}
 
 		if syncJSON {
-			return output.JSON(output.Response{
+			message := "Git branches synced"
+			if dryRun {
+				message = "Git sync preview (run with --apply to update beans)"
+			}
+			return jsonRedaction().Encode(cmd.OutOrStdout(), struct {
+				output.Response
+				Pull  *beancore.PullResult `json:"pull,omitempty"`
+				Stale []*bean.Bean         `json:"stale,omitempty"`
+			}{output.Response{
 				Success: true,
 				Beans:   updatedBeans,
 				Count:   len(updatedBeans),
-				Message: "Git branches synced",
-			})
+				Message: message,
+			}, pulled, stale})
 		}
 
 		// Human-readable output
+		printStaleBeans(cmd.OutOrStdout(), stale)
 		if len(updatedBeans) == 0 {
 			fmt.Println("No beans to sync")
 			return nil
@@ -65,8 +118,14 @@ Use --apply to actually update the beans.`,
 				switch b.Status {
 				case "completed":
 					reason = "Branch merged → would mark as completed"
+					if b.GitPRState == "merged" {
+						reason = "Pull request merged → would mark as completed"
+					}
 				case "scrapped":
 					reason = "Branch deleted → would mark as scrapped"
+					if b.GitPRState == "closed" {
+						reason = "Pull request closed → would mark as scrapped"
+					}
 				default:
 					reason = "Would update status"
 				}
@@ -83,13 +142,17 @@ Use --apply to actually update the beans.`,
 			var reason string
 			switch b.Status {
 			case "completed":
-				if b.GitBranch != "" {
+				if b.GitPRState == "merged" {
+					reason = fmt.Sprintf("Marked as completed (pull request %s merged)", b.GitPRURL)
+				} else if b.GitBranch != "" {
 					reason = fmt.Sprintf("Marked as completed (branch %s merged)", b.GitBranch)
 				} else {
 					reason = "Marked as completed"
 				}
 			case "scrapped":
-				if b.GitBranch != "" {
+				if b.GitPRState == "closed" {
+					reason = fmt.Sprintf("Marked as scrapped (pull request %s closed)", b.GitPRURL)
+				} else if b.GitBranch != "" {
 					reason = fmt.Sprintf("Marked as scrapped (branch %s deleted)", b.GitBranch)
 				} else {
 					reason = "Marked as scrapped"
@@ -104,8 +167,60 @@ Use --apply to actually update the beans.`,
 	},
 }
 
+// printPullResult summarizes what sync --pull brought in.
+func printPullResult(w io.Writer, pulled *beancore.PullResult) {
+	ref := pulled.Remote + "/" + pulled.Branch
+	if pulled.From == pulled.To {
+		fmt.Fprintf(w, "%s is up to date with %s\n\n", pulled.Branch, ref)
+		return
+	}
+	fmt.Fprintf(w, "Pulled %s (%s..%s)", ref, shortHash(pulled.From), shortHash(pulled.To))
+	if len(pulled.Beans) == 0 {
+		fmt.Fprintf(w, ", no beans changed upstream\n\n")
+		return
+	}
+	fmt.Fprintf(w, ", %d bean(s) changed upstream:\n", len(pulled.Beans))
+	for _, pb := range pulled.Beans {
+		var mark string
+		switch pb.Change {
+		case gitflow.FileAdded:
+			mark = ui.Success.Render("+ new     ")
+		case gitflow.FileDeleted:
+			mark = ui.Danger.Render("- deleted ")
+		default:
+			mark = ui.Warning.Render("~ modified")
+		}
+		fmt.Fprintf(w, "  %s %s %s\n", mark, ui.ID.Render(pb.ID), pb.Title)
+	}
+	fmt.Fprintln(w)
+}
+
+// printStaleBeans lists in-progress beans whose branch is gone, with the ways
+// to resolve them.
+func printStaleBeans(w io.Writer, stale []*bean.Bean) {
+	if len(stale) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "%s %d in-progress bean(s) whose branch is gone without having been merged:\n", ui.Warning.Render("Stale:"), len(stale))
+	for _, b := range stale {
+		fmt.Fprintf(w, "  ! %s  %s %s\n", ui.ID.Render(b.ID), b.Title, ui.Muted.Render("(branch "+b.GitBranch+")"))
+		fmt.Fprintf(w, "      %s beans branch %s\n", ui.Muted.Render("recreate the branch:"), b.ID)
+		fmt.Fprintf(w, "      %s beans update %s --status todo\n", ui.Muted.Render("or put it back:     "), b.ID)
+	}
+	fmt.Fprintln(w)
+}
+
+// shortHash abbreviates a commit hash for display.
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
+
 func init() {
 	syncCmd.Flags().BoolVar(&syncApply, "apply", false, "Apply changes (default: dry-run preview)")
 	syncCmd.Flags().BoolVar(&syncJSON, "json", false, "Output in JSON format")
+	syncCmd.Flags().BoolVar(&syncPull, "pull", false, "Fetch and fast-forward the base branch first, listing beans changed upstream")
 	rootCmd.AddCommand(syncCmd)
 }
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/tracker"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncGitHubOwner     string
+	syncGitHubProject   int
+	syncGitHubConflicts string
+	syncGitHubApply     bool
+	syncGitHubJSON      bool
+)
+
+var syncGitHubProjectCmd = &cobra.Command{
+	Use:   "github-project",
+	Short: "Sync beans with a GitHub project board, both ways",
+	Long: `Syncs beans with the cards of a GitHub project (Projects v2):
+- New cards become beans, and new beans become draft issue cards. Archived,
+  local and draft beans, and beans in an archive status, aren't added.
+- Titles, bodies and statuses of linked beans and cards are kept in sync,
+  from whichever side changed since the last sync.
+- Board columns map to statuses: Todo is todo, In Progress in-progress and
+  Done completed, unless sync.github_project.columns in .beans.yml says
+  otherwise. Cards in other columns keep their bean's status.
+
+When a bean and its card both changed, the newest change wins, or with
+--conflicts prompt (or sync.github_project.conflicts), you're asked.
+
+Which bean belongs to which card is kept in .beans/.sync/; commit it so
+everyone syncing links the same pairs. Deleting either side unlinks it.
+
+The owner and project number come from --owner and --project, or
+sync.github_project in .beans.yml. Set GITHUB_TOKEN to a token with the
+project scope.
+
+By default, shows a preview of changes without applying them.
+Use --apply to actually sync.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings := cfg.Beans.Sync.GitHubProject
+		owner := syncGitHubOwner
+		if owner == "" {
+			owner = settings.Owner
+		}
+		number := syncGitHubProject
+		if number == 0 {
+			number = settings.Project
+		}
+		if owner == "" || number <= 0 {
+			return cmdError(syncGitHubJSON, output.ErrValidation, "no project given (use --owner and --project, or set sync.github_project in .beans.yml)")
+		}
+		conflicts := settings.GetConflicts()
+		if syncGitHubConflicts != "" {
+			conflicts = syncGitHubConflicts
+		}
+		if conflicts != config.ConflictsNewest && conflicts != config.ConflictsPrompt {
+			return cmdError(syncGitHubJSON, output.ErrValidation, "invalid --conflicts: %s (use %s or %s)", conflicts, config.ConflictsNewest, config.ConflictsPrompt)
+		}
+		if conflicts == config.ConflictsPrompt && syncGitHubApply && (syncGitHubJSON || !isInteractive()) {
+			return cmdError(syncGitHubJSON, output.ErrValidation, "--conflicts prompt needs a terminal")
+		}
+		token := os.Getenv("GITHUB_TOKEN")
+		if token == "" {
+			return cmdError(syncGitHubJSON, output.ErrValidation, "GITHUB_TOKEN is not set (the projects API needs a token with the project scope)")
+		}
+
+		board := tracker.NewGitHubProject(token, owner, number)
+		board.StatusField = settings.GetStatusField()
+		board.Columns = settings.GetColumns()
+		board.Statuses = cfg.StatusNames()
+
+		opts := beancore.RemoteSyncOptions{DryRun: !syncGitHubApply}
+		if conflicts == config.ConflictsPrompt && syncGitHubApply {
+			opts.Resolve = promptSyncConflict(bufio.NewReader(cmd.InOrStdin()), "card")
+		}
+		changes, err := core.SyncRemote(context.Background(), board.MappingName(), board, opts)
+		if err != nil {
+			return cmdError(syncGitHubJSON, output.ErrRemote, "sync failed: %v", err)
+		}
+
+		return reportRemoteSync(cmd, changes, opts.DryRun, syncGitHubJSON, "GitHub project",
+			fmt.Sprintf("project %d of %s", number, owner), "card")
+	},
+}
+
+// reportRemoteSync prints what syncing with a tracker did (or would do).
+// target names what was synced with, noun the tracker's items.
+func reportRemoteSync(cmd *cobra.Command, changes []beancore.SyncChange, dryRun, jsonMode bool, tracker, target, noun string) error {
+	if jsonMode {
+		message := tracker + " synced"
+		if dryRun {
+			message = tracker + " sync preview (run with --apply to sync)"
+		}
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(struct {
+			Success bool                  `json:"success"`
+			Changes []beancore.SyncChange `json:"changes"`
+			Count   int                   `json:"count"`
+			Message string                `json:"message"`
+		}{true, changes, len(changes), message})
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("Beans and %s are in sync\n", target)
+		return nil
+	}
+	if dryRun {
+		fmt.Printf("Would sync %d change(s) with %s:\n\n", len(changes), target)
+	} else {
+		fmt.Printf("Synced %d change(s) with %s:\n\n", len(changes), target)
+	}
+	for _, c := range changes {
+		id := c.BeanID
+		if id == "" {
+			id = "(new)"
+		}
+		fmt.Printf("  %s %s  %s\n", syncChangeLabel(c, noun), ui.ID.Render(id), c.Title)
+	}
+	if dryRun {
+		fmt.Println("\nRun with --apply to sync")
+	}
+	return nil
+}
+
+// syncChangeLabel describes a sync change in a fixed-width label, calling
+// the tracker's items noun.
+func syncChangeLabel(c beancore.SyncChange, noun string) string {
+	var label string
+	warn := false
+	switch c.Action {
+	case beancore.SyncPushed:
+		label = "→ pushed"
+	case beancore.SyncPulled:
+		label = "← pulled"
+	case beancore.SyncCreatedItem:
+		label = "+ new " + noun
+	case beancore.SyncCreatedBean:
+		label = "+ new bean"
+	case beancore.SyncUnlinkedBean:
+		label, warn = "- bean gone", true
+	case beancore.SyncUnlinkedItem:
+		label, warn = "- "+noun+" gone", true
+	}
+	label = fmt.Sprintf("%-*s", len("- "+noun+" gone"), label)
+	if warn {
+		label = ui.Warning.Render(label)
+	} else {
+		label = ui.Success.Render(label)
+	}
+	if c.Conflict {
+		label += ui.Warning.Render(" (conflict)")
+	}
+	return label
+}
+
+// promptSyncConflict returns a conflict resolver asking which side to keep,
+// calling the tracker's items noun.
+func promptSyncConflict(in *bufio.Reader, noun string) func(b *bean.Bean, item beancore.RemoteItem) (bool, error) {
+	return func(b *bean.Bean, item beancore.RemoteItem) (bool, error) {
+		fmt.Printf("%s %s and its %s both changed since the last sync.\n", ui.Warning.Render("Conflict:"), ui.ID.Render(b.ID), noun)
+		fmt.Printf("  bean: %s %s\n", b.Title, ui.Muted.Render("("+b.Status+")"))
+		fmt.Printf("  %s: %s %s\n", noun, item.Title, ui.Muted.Render("("+strings.Join(item.Statuses, "/")+")"))
+		for {
+			fmt.Printf("Keep the [b]ean or the [%c]%s? ", noun[0], noun[1:])
+			response, err := in.ReadString('\n')
+			switch strings.TrimSpace(strings.ToLower(response)) {
+			case "b", "bean":
+				return false, nil
+			case noun[:1], noun:
+				return true, nil
+			}
+			if err != nil {
+				return false, fmt.Errorf("no answer for conflict on %s", b.ID)
+			}
+		}
+	}
+}
+
+func init() {
+	syncGitHubProjectCmd.Flags().StringVar(&syncGitHubOwner, "owner", "", "User or organization owning the project (default: sync.github_project.owner)")
+	syncGitHubProjectCmd.Flags().IntVar(&syncGitHubProject, "project", 0, "Project number (default: sync.github_project.project)")
+	syncGitHubProjectCmd.Flags().StringVar(&syncGitHubConflicts, "conflicts", "", "When a bean and its card both changed: newest (default) or prompt")
+	syncGitHubProjectCmd.Flags().BoolVar(&syncGitHubApply, "apply", false, "Apply changes (default: dry-run preview)")
+	syncGitHubProjectCmd.Flags().BoolVar(&syncGitHubJSON, "json", false, "Output in JSON format")
+	syncCmd.AddCommand(syncGitHubProjectCmd)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/tracker"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncLinearTeam      string
+	syncLinearConflicts string
+	syncLinearImport    bool
+	syncLinearApply     bool
+	syncLinearJSON      bool
+)
+
+var syncLinearCmd = &cobra.Command{
+	Use:   "linear",
+	Short: "Import and sync beans with a Linear team's issues, both ways",
+	Long: `Syncs beans with the issues of a Linear team:
+- New issues become beans, and new beans become issues. Archived, local and
+  draft beans, and beans in an archive status, aren't added.
+- Titles, descriptions, statuses, priorities and cycles of linked beans and
+  issues are kept in sync, from whichever side changed since the last sync.
+- Workflow states map to statuses by type (backlog is draft, unstarted todo,
+  started in-progress, completed completed and canceled scrapped), unless
+  sync.linear.states in .beans.yml maps statuses to state names or types.
+- Priorities map to Linear's (critical is urgent, normal medium, deferred
+  low), unless sync.linear.priorities says otherwise.
+- Cycles map to sprints: an issue in cycle 12 is tagged sprint/cycle-12.
+
+With --import, issues are only brought in: new and changed issues update
+beans, but nothing is created or changed in Linear. The first sync of a
+team imports all of its issues.
+
+When a bean and its issue both changed, the newest change wins, or with
+--conflicts prompt (or sync.linear.conflicts), you're asked.
+
+Which bean belongs to which issue is kept in .beans/.sync/; commit it so
+everyone syncing links the same pairs. Deleting either side unlinks it.
+
+The team key comes from --team or sync.linear.team in .beans.yml. The API
+key is read from sync.linear.token; keep it out of the repository with:
+  beans config set --local beans.sync.linear.token <key>
+
+By default, shows a preview of changes without applying them.
+Use --apply to actually sync.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings := cfg.Beans.Sync.Linear
+		team := syncLinearTeam
+		if team == "" {
+			team = settings.Team
+		}
+		if team == "" {
+			return cmdError(syncLinearJSON, output.ErrValidation, "no team given (use --team, or set sync.linear.team in .beans.yml)")
+		}
+		conflicts := settings.GetConflicts()
+		if syncLinearConflicts != "" {
+			conflicts = syncLinearConflicts
+		}
+		if conflicts != config.ConflictsNewest && conflicts != config.ConflictsPrompt {
+			return cmdError(syncLinearJSON, output.ErrValidation, "invalid --conflicts: %s (use %s or %s)", conflicts, config.ConflictsNewest, config.ConflictsPrompt)
+		}
+		prompt := conflicts == config.ConflictsPrompt && syncLinearApply && !syncLinearImport
+		if prompt && (syncLinearJSON || !isInteractive()) {
+			return cmdError(syncLinearJSON, output.ErrValidation, "--conflicts prompt needs a terminal")
+		}
+		if settings.Token == "" {
+			return cmdError(syncLinearJSON, output.ErrValidation, "no Linear API key (set one with: beans config set --local beans.sync.linear.token <key>)")
+		}
+
+		linear := tracker.NewLinear(settings.Token, team)
+		linear.States = settings.GetStates()
+		linear.Priorities = settings.GetPriorities()
+		linear.Statuses = cfg.StatusNames()
+		linear.PriorityNames = cfg.PriorityNames()
+
+		opts := beancore.RemoteSyncOptions{DryRun: !syncLinearApply, PullOnly: syncLinearImport}
+		if prompt {
+			opts.Resolve = promptSyncConflict(bufio.NewReader(cmd.InOrStdin()), "issue")
+		}
+		changes, err := core.SyncRemote(context.Background(), linear.MappingName(), linear, opts)
+		if err != nil {
+			return cmdError(syncLinearJSON, output.ErrRemote, "sync failed: %v", err)
+		}
+		return reportRemoteSync(cmd, changes, opts.DryRun, syncLinearJSON, "Linear", "Linear team "+team, "issue")
+	},
+}
+
+func init() {
+	syncLinearCmd.Flags().StringVar(&syncLinearTeam, "team", "", "Key of the team to sync with (default: sync.linear.team)")
+	syncLinearCmd.Flags().StringVar(&syncLinearConflicts, "conflicts", "", "When a bean and its issue both changed: newest (default) or prompt")
+	syncLinearCmd.Flags().BoolVar(&syncLinearImport, "import", false, "Only bring in new and changed issues, leaving Linear alone")
+	syncLinearCmd.Flags().BoolVar(&syncLinearApply, "apply", false, "Apply changes (default: dry-run preview)")
+	syncLinearCmd.Flags().BoolVar(&syncLinearJSON, "json", false, "Output in JSON format")
+	syncCmd.AddCommand(syncLinearCmd)
+}
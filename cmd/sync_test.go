@@ -1,11 +1,13 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/go-git/go-git/v5"
@@ -79,7 +81,7 @@ func setupSyncTestEnv(t *testing.T) (*beancore.Core, *git.Repository, string, fu
 	cfg.Beans.Git.AutoCreateBranch = true
 	cfg.Beans.Git.BaseBranch = "main"
 	testCore := beancore.New(beansDir, cfg)
-	testCore.SetWarnWriter(nil) // suppress warnings in tests
+	testCore.SetLogger(nil) // suppress warnings in tests
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -223,7 +225,7 @@ func TestSyncCommand_NoBeansWithGitBranches(t *testing.T) {
 	resolver := &graph.Resolver{Core: testCore}
 
 	// Call sync mutation
-	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx)
+	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx, nil)
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
@@ -313,7 +315,7 @@ func TestSyncCommand_MergedBranch(t *testing.T) {
 	mergeToMain(t, repo, commitHash)
 
 	// Now sync should mark the bean as completed
-	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx)
+	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx, nil)
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
@@ -409,18 +411,29 @@ func TestSyncCommand_DeletedBranch(t *testing.T) {
 	// Delete the branch (without merging)
 	deleteBranch(t, repo, updatedParent.GitBranch)
 
-	// Now sync should mark the bean as scrapped
-	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx)
+	// The bean is in progress, so sync leaves it alone and reports it as stale
+	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx, nil)
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
+	if len(updatedBeans) != 0 {
+		t.Fatalf("expected no beans to sync, got %d", len(updatedBeans))
+	}
 
-	if len(updatedBeans) != 1 {
-		t.Fatalf("expected 1 bean to sync, got %d", len(updatedBeans))
+	stale, err := resolver.Query().StaleBeans(ctx)
+	if err != nil {
+		t.Fatalf("StaleBeans() error = %v", err)
+	}
+	if len(stale) != 1 || stale[0].ID != parent.ID {
+		t.Fatalf("expected %s to be stale, got %v", parent.ID, stale)
 	}
 
-	if updatedBeans[0].Status != "scrapped" {
-		t.Errorf("expected status 'scrapped', got %q", updatedBeans[0].Status)
+	var buf bytes.Buffer
+	printStaleBeans(&buf, stale)
+	for _, want := range []string{parent.ID, "beans branch " + parent.ID, "beans update " + parent.ID + " --status todo"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("stale output missing %q:\n%s", want, buf.String())
+		}
 	}
 }
 
@@ -444,7 +457,7 @@ func TestSyncCommand_JSONOutput(t *testing.T) {
 	resolver := &graph.Resolver{Core: testCore}
 
 	// Call sync mutation
-	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx)
+	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx, nil)
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
@@ -600,15 +613,15 @@ func TestSyncCommand_MixedStates(t *testing.T) {
 	})
 	// Stay on this branch (it's still active)
 
-	// Sync should update parent-1 and parent-2, but not parent-3
-	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx)
+	// Sync should complete parent-1; parent-2 is stale and parent-3 active
+	updatedBeans, err := resolver.Mutation().SyncGitBranches(ctx, nil)
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
 
-	// Should have synced 2 beans (merged and deleted)
-	if len(updatedBeans) != 2 {
-		t.Fatalf("expected 2 beans to sync, got %d", len(updatedBeans))
+	// Should have synced only the merged bean
+	if len(updatedBeans) != 1 {
+		t.Fatalf("expected 1 bean to sync, got %d", len(updatedBeans))
 	}
 
 	// Check statuses
@@ -620,8 +633,8 @@ func TestSyncCommand_MixedStates(t *testing.T) {
 	if statusMap["parent-1"] != "completed" {
 		t.Errorf("expected parent-1 to be completed, got %q", statusMap["parent-1"])
 	}
-	if statusMap["parent-2"] != "scrapped" {
-		t.Errorf("expected parent-2 to be scrapped, got %q", statusMap["parent-2"])
+	if stale, _ := resolver.Query().StaleBeans(ctx); len(stale) != 1 || stale[0].ID != "parent-2" {
+		t.Errorf("expected parent-2 to be stale, got %v", stale)
 	}
 
 	// parent-3 should still be in-progress
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/output"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var tagsJSON bool
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "List and manage tags",
+	Long: `Lists all tags with the number of beans that have them.
+
+If .beans.yml has a tag registry ("tags"), registered tags are listed even
+if unused, and tags outside the registry are marked. New tags must then be
+registered before they can be added to beans.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		tags, err := resolver.Query().Tags(context.Background())
+		if err != nil {
+			return cmdError(tagsJSON, output.ErrValidation, "%s", err)
+		}
+
+		if tagsJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(tags)
+		}
+
+		if len(tags) == 0 {
+			fmt.Println(ui.Muted.Render("No tags found. Add one with: beans update <id> --tag <tag>"))
+			return nil
+		}
+
+		width := 0
+		for _, t := range tags {
+			width = max(width, len(t.Tag))
+		}
+		for _, t := range tags {
+			line := fmt.Sprintf("%-*s  %s", width, t.Tag, ui.Muted.Render(fmt.Sprintf("%4d", t.Count)))
+			if t.Description != "" {
+				line += "  " + ui.Muted.Render(t.Description)
+			}
+			if !cfg.IsAllowedTag(t.Tag) {
+				line += "  " + ui.Warning.Render("(not registered)")
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+var tagsRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a tag on every bean",
+	Long:  `Renames a tag on every bean that has it. Beans that already have the new tag simply lose the old one.`,
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		updated, err := resolver.Mutation().RenameTag(context.Background(), args[0], args[1])
+		if err != nil {
+			return mutationError(tagsJSON, err)
+		}
+		return reportTagChange(updated, fmt.Sprintf("Renamed tag %s to %s", args[0], args[1]))
+	},
+}
+
+var tagsRmCmd = &cobra.Command{
+	Use:     "rm <tag>",
+	Aliases: []string{"remove"},
+	Short:   "Remove a tag from every bean",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resolver := &graph.Resolver{Core: core}
+		updated, err := resolver.Mutation().DeleteTag(context.Background(), args[0])
+		if err != nil {
+			return mutationError(tagsJSON, err)
+		}
+		return reportTagChange(updated, fmt.Sprintf("Removed tag %s", args[0]))
+	},
+}
+
+// reportTagChange prints the outcome of a tag rename or removal.
+func reportTagChange(updated []*bean.Bean, message string) error {
+	if tagsJSON {
+		return output.JSON(output.Response{
+			Success:   true,
+			Beans:     updated,
+			Count:     len(updated),
+			Message:   message,
+			Redaction: jsonRedaction(),
+		})
+	}
+	fmt.Println(ui.Success.Render(message) + ui.Muted.Render(fmt.Sprintf(" (%d bean(s) updated)", len(updated))))
+	return nil
+}
+
+func init() {
+	tagsCmd.PersistentFlags().BoolVar(&tagsJSON, "json", false, "Output as JSON")
+	tagsCmd.AddCommand(tagsRenameCmd)
+	tagsCmd.AddCommand(tagsRmCmd)
+	rootCmd.AddCommand(tagsCmd)
+}
@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// taskwarriorTracker is the key of Taskwarrior UUIDs in a bean's external IDs.
+const taskwarriorTracker = "taskwarrior"
+
+// taskwarriorTimeFormat is the timestamp format of Taskwarrior's JSON.
+const taskwarriorTimeFormat = "20060102T150405Z"
+
+// taskwarriorNamespace derives stable UUIDs for beans that have none yet, so
+// exporting twice gives the same UUIDs.
+var taskwarriorNamespace = uuid.MustParse("6f0c9a52-3d8e-4c1b-9b7a-2e5f4d1c8a90")
+
+// taskwarriorTask is a task in Taskwarrior's import/export format.
+type taskwarriorTask struct {
+	UUID        string                  `json:"uuid"`
+	Description string                  `json:"description"`
+	Status      string                  `json:"status"`
+	Entry       string                  `json:"entry,omitempty"`
+	Modified    string                  `json:"modified,omitempty"`
+	Start       string                  `json:"start,omitempty"`
+	End         string                  `json:"end,omitempty"`
+	Priority    string                  `json:"priority,omitempty"`
+	Project     string                  `json:"project,omitempty"`
+	Tags        []string                `json:"tags,omitempty"`
+	Depends     taskwarriorDepends      `json:"depends,omitempty"`
+	Annotations []taskwarriorAnnotation `json:"annotations,omitempty"`
+}
+
+type taskwarriorAnnotation struct {
+	Entry       string `json:"entry,omitempty"`
+	Description string `json:"description"`
+}
+
+// taskwarriorDepends lists the UUIDs a task depends on. Taskwarrior 2.5 and
+// older write them as a comma-separated string, newer versions as a list.
+type taskwarriorDepends []string
+
+func (d *taskwarriorDepends) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*d = nil
+		for _, id := range strings.Split(s, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				*d = append(*d, id)
+			}
+		}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*d = list
+	return nil
+}
+
+// taskwarriorPriorities maps priorities to Taskwarrior's. Normal beans get
+// no Taskwarrior priority.
+var taskwarriorPriorities = map[string]string{
+	"critical": "H",
+	"high":     "H",
+	"low":      "L",
+	"deferred": "L",
+}
+
+var exportTaskwarriorCmd = &cobra.Command{
+	Use:   "taskwarrior",
+	Short: "Export beans as Taskwarrior JSON",
+	Long: `Writes all beans, including archived ones, as a Taskwarrior JSON array that
+'task import' reads, sorted by ID:
+
+  beans export taskwarrior > beans.json && task import beans.json
+
+Statuses become pending (with a start time for in-progress beans), completed
+or deleted; critical and high priorities become H, low and deferred L; blocked
+by and blocking links become dependencies; the body becomes an annotation.
+Beans imported from Taskwarrior keep their UUIDs, others get a UUID derived
+from their ID, so exporting again updates the same tasks.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		beans := withoutLocal(core.All())
+		slices.SortFunc(beans, func(a, b *bean.Bean) int { return strings.Compare(a.ID, b.ID) })
+
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(beansToTaskwarrior(beans))
+	},
+}
+
+var importTaskwarriorCmd = &cobra.Command{
+	Use:   "taskwarrior <file>",
+	Short: "Import tasks from Taskwarrior JSON",
+	Long: `Creates a bean for each task in Taskwarrior JSON, as written by 'task export'
+(a JSON array, or one task per line). Use - to read from stdin:
+
+  task export | beans import taskwarrior -
+
+Pending tasks become todo (in-progress if started), waiting tasks draft,
+completed tasks completed and deleted tasks scrapped; recurring task templates
+are skipped. H, M and L priorities become high, normal and low; dependencies
+become blocked-by links; annotations become the body; a project becomes a
+project/... tag. Each bean keeps its task's UUID, and tasks that were imported
+before (or exported from beans) are skipped.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, tag := range importTags {
+			if err := bean.ValidateTag(tag); err != nil {
+				return cmdError(importJSON, output.ErrValidation, "%s", err)
+			}
+		}
+
+		var data []byte
+		var err error
+		if args[0] == "-" {
+			data, err = io.ReadAll(cmd.InOrStdin())
+		} else {
+			data, err = os.ReadFile(args[0])
+		}
+		if err != nil {
+			return cmdError(importJSON, output.ErrFileError, "%s", err)
+		}
+		tasks, err := parseTaskwarrior(data)
+		if err != nil {
+			return cmdError(importJSON, output.ErrValidation, "%s", err)
+		}
+
+		imported, err := importTaskwarrior(tasks)
+		if err != nil {
+			return cmdError(importJSON, output.ErrFileError, "%s", err)
+		}
+
+		if importJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(imported)
+		}
+		printImportedBeans(cmd.OutOrStdout(), imported, importDryRun)
+		return nil
+	},
+}
+
+// taskwarriorUUID returns the UUID of a bean in Taskwarrior.
+func taskwarriorUUID(b *bean.Bean) string {
+	if id := b.ExternalIDs[taskwarriorTracker]; id != "" {
+		return id
+	}
+	return uuid.NewSHA1(taskwarriorNamespace, []byte(b.ID)).String()
+}
+
+// beansToTaskwarrior converts beans to Taskwarrior tasks, in the given order.
+func beansToTaskwarrior(beans []*bean.Bean) []taskwarriorTask {
+	uuids := make(map[string]string, len(beans))
+	for _, b := range beans {
+		uuids[b.ID] = taskwarriorUUID(b)
+	}
+	// A bean depends on the beans blocking it, whichever side records the link
+	depends := make(map[string][]string)
+	for _, b := range beans {
+		for _, blocker := range b.BlockedBy {
+			depends[b.ID] = append(depends[b.ID], blocker)
+		}
+		for _, blocked := range b.Blocking {
+			depends[blocked] = append(depends[blocked], b.ID)
+		}
+	}
+
+	tasks := make([]taskwarriorTask, 0, len(beans))
+	for _, b := range beans {
+		task := taskwarriorTask{
+			UUID:        uuids[b.ID],
+			Description: b.Title,
+			Status:      "pending",
+			Entry:       formatTaskwarriorTime(b.CreatedAt),
+			Modified:    formatTaskwarriorTime(b.UpdatedAt),
+			Priority:    taskwarriorPriorities[b.Priority],
+			Tags:        b.Tags,
+		}
+		since, _ := b.StatusSince()
+		switch b.Status {
+		case "in-progress":
+			task.Start = formatTaskwarriorTime(&since)
+		case "completed":
+			task.Status = "completed"
+			task.End = formatTaskwarriorTime(&since)
+		case "scrapped":
+			task.Status = "deleted"
+			task.End = formatTaskwarriorTime(&since)
+		}
+		for _, id := range depends[b.ID] {
+			if u, ok := uuids[id]; ok && !slices.Contains(task.Depends, u) {
+				task.Depends = append(task.Depends, u)
+			}
+		}
+		if body := strings.TrimSpace(b.Body); body != "" {
+			task.Annotations = []taskwarriorAnnotation{{Entry: task.Modified, Description: body}}
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
+
+// formatTaskwarriorTime formats a timestamp for Taskwarrior, or returns an
+// empty string if it isn't set.
+func formatTaskwarriorTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(taskwarriorTimeFormat)
+}
+
+// parseTaskwarrior reads tasks from a JSON array or from one JSON task per line.
+func parseTaskwarrior(data []byte) ([]taskwarriorTask, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var tasks []taskwarriorTask
+		if err := json.Unmarshal(data, &tasks); err != nil {
+			return nil, fmt.Errorf("parsing Taskwarrior JSON: %w", err)
+		}
+		return tasks, nil
+	}
+	var tasks []taskwarriorTask
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for dec.More() {
+		var task taskwarriorTask
+		if err := dec.Decode(&task); err != nil {
+			return nil, fmt.Errorf("parsing Taskwarrior JSON: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// importTaskwarrior creates a bean for each task that wasn't imported before
+// and links them by their dependencies. With --dry-run, nothing is created.
+func importTaskwarrior(tasks []taskwarriorTask) ([]*importedBean, error) {
+	existing := make(map[string]*bean.Bean)
+	for _, b := range core.All() {
+		existing[taskwarriorUUID(b)] = b
+	}
+
+	var imported []*importedBean
+	beanIDs := make(map[string]string, len(tasks))
+	depends := make(map[*importedBean]taskwarriorDepends)
+	for _, task := range tasks {
+		if task.Status == "recurring" {
+			continue
+		}
+		if b, ok := existing[task.UUID]; ok {
+			imported = append(imported, &importedBean{ID: b.ID, Title: b.Title, Status: b.Status, Source: task.UUID, Skipped: true})
+			beanIDs[task.UUID] = b.ID
+			continue
+		}
+
+		b := taskwarriorToBean(task)
+		b.Tags = addImportTags(b.Tags, importTags...)
+		ib := &importedBean{Title: b.Title, Status: b.Status, Priority: b.Priority, Tags: b.Tags, Body: b.Body, Source: task.UUID}
+		imported = append(imported, ib)
+		if importDryRun {
+			continue
+		}
+		if b.Type == "" {
+			b.Type = cfg.GetDefaultType()
+		}
+		if err := core.Create(b); err != nil {
+			return imported, fmt.Errorf("importing task %s: %w", task.UUID, err)
+		}
+		ib.ID = b.ID
+		beanIDs[task.UUID] = b.ID
+		depends[ib] = task.Depends
+	}
+
+	// Link dependencies once every task has a bean
+	for _, ib := range imported {
+		var blockedBy []string
+		for _, dep := range depends[ib] {
+			if id, ok := beanIDs[dep]; ok && !slices.Contains(blockedBy, id) {
+				blockedBy = append(blockedBy, id)
+			}
+		}
+		if len(blockedBy) == 0 {
+			continue
+		}
+		b, err := core.Get(ib.ID)
+		if err != nil {
+			return imported, err
+		}
+		etag := b.ETag()
+		b.BlockedBy = blockedBy
+		if err := core.Update(b, &etag); err != nil {
+			return imported, fmt.Errorf("linking dependencies of task %s: %w", ib.Source, err)
+		}
+		ib.BlockedBy = blockedBy
+	}
+	return imported, nil
+}
+
+// taskwarriorToBean converts a Taskwarrior task to a new bean. Dependencies
+// are left to the caller.
+func taskwarriorToBean(task taskwarriorTask) *bean.Bean {
+	b := &bean.Bean{
+		Title:       task.Description,
+		Slug:        bean.Slugify(task.Description),
+		Status:      cfg.GetDefaultStatus(),
+		ExternalIDs: map[string]string{taskwarriorTracker: task.UUID},
+	}
+	switch task.Status {
+	case "pending":
+		if task.Start != "" {
+			b.Status = "in-progress"
+		}
+	case "waiting":
+		b.Status = "draft"
+	case "completed":
+		b.Status = "completed"
+	case "deleted":
+		b.Status = "scrapped"
+	}
+	switch task.Priority {
+	case "H":
+		b.Priority = "high"
+	case "M":
+		b.Priority = "normal"
+	case "L":
+		b.Priority = "low"
+	}
+
+	for _, tag := range task.Tags {
+		if tag = bean.NormalizeTag(tag); bean.ValidateTag(tag) == nil {
+			b.Tags = addImportTags(b.Tags, tag)
+		}
+	}
+	if task.Project != "" {
+		segments := strings.Split(task.Project, ".")
+		for i, s := range segments {
+			segments[i] = bean.Slugify(s)
+		}
+		if tag := "project/" + strings.Join(segments, "/"); bean.ValidateTag(tag) == nil {
+			b.Tags = addImportTags(b.Tags, tag)
+		}
+	}
+
+	var notes []string
+	for _, a := range task.Annotations {
+		if d := strings.TrimSpace(a.Description); d != "" {
+			notes = append(notes, d)
+		}
+	}
+	b.Body = strings.Join(notes, "\n\n")
+	return b
+}
+
+func init() {
+	exportCmd.AddCommand(exportTaskwarriorCmd)
+
+	importTaskwarriorCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without creating beans")
+	importTaskwarriorCmd.Flags().BoolVar(&importJSON, "json", false, "Output as JSON")
+	importTaskwarriorCmd.Flags().StringArrayVar(&importTags, "tag", nil, "Add tag to every imported bean (can be repeated)")
+	importCmd.AddCommand(importTaskwarriorCmd)
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestParseTaskwarrior(t *testing.T) {
+	array := `[{"uuid":"a","description":"One","status":"pending","depends":"b,c"},
+{"uuid":"b","description":"Two","status":"completed","depends":["c"]}]`
+	lines := `{"uuid":"a","description":"One","status":"pending","depends":"b,c"}
+{"uuid":"b","description":"Two","status":"completed","depends":["c"]}
+`
+	for name, data := range map[string]string{"array": array, "lines": lines} {
+		tasks, err := parseTaskwarrior([]byte(data))
+		if err != nil {
+			t.Fatalf("%s: parseTaskwarrior() error = %v", name, err)
+		}
+		if len(tasks) != 2 {
+			t.Fatalf("%s: got %d tasks, want 2", name, len(tasks))
+		}
+		if !reflect.DeepEqual(tasks[0].Depends, taskwarriorDepends{"b", "c"}) || !reflect.DeepEqual(tasks[1].Depends, taskwarriorDepends{"c"}) {
+			t.Errorf("%s: depends = %q, %q", name, tasks[0].Depends, tasks[1].Depends)
+		}
+	}
+
+	if _, err := parseTaskwarrior([]byte("[{")); err == nil {
+		t.Error("parseTaskwarrior() error = nil for invalid JSON")
+	}
+}
+
+func TestTaskwarriorToBean(t *testing.T) {
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	b := taskwarriorToBean(taskwarriorTask{
+		UUID:        "0b1c2d3e-0000-4000-8000-000000000001",
+		Description: "Fix the build",
+		Status:      "pending",
+		Start:       "20240101T100000Z",
+		Priority:    "H",
+		Project:     "Home.Garden",
+		Tags:        []string{"Urgent", "not valid!"},
+		Annotations: []taskwarriorAnnotation{{Description: "First note"}, {Description: "Second note"}},
+	})
+	if b.Status != "in-progress" || b.Priority != "high" {
+		t.Errorf("status %q, priority %q; want in-progress, high", b.Status, b.Priority)
+	}
+	if want := []string{"urgent", "project/home/garden"}; !reflect.DeepEqual(b.Tags, want) {
+		t.Errorf("tags = %q, want %q", b.Tags, want)
+	}
+	if b.Body != "First note\n\nSecond note" {
+		t.Errorf("body = %q", b.Body)
+	}
+	if b.ExternalIDs[taskwarriorTracker] != "0b1c2d3e-0000-4000-8000-000000000001" {
+		t.Errorf("external IDs = %v, want the task UUID", b.ExternalIDs)
+	}
+}
+
+func TestTaskwarriorRoundTrip(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	tasks := []taskwarriorTask{
+		{UUID: "11111111-1111-4111-8111-111111111111", Description: "Design", Status: "completed"},
+		{UUID: "22222222-2222-4222-8222-222222222222", Description: "Build", Status: "pending", Priority: "L",
+			Depends: taskwarriorDepends{"11111111-1111-4111-8111-111111111111"}},
+		{UUID: "33333333-3333-4333-8333-333333333333", Description: "Template", Status: "recurring"},
+	}
+	imported, err := importTaskwarrior(tasks)
+	if err != nil {
+		t.Fatalf("importTaskwarrior() error = %v", err)
+	}
+	if len(imported) != 2 {
+		t.Fatalf("imported %d beans, want 2 (recurring templates are skipped)", len(imported))
+	}
+	build, err := testCore.Get(imported[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(build.BlockedBy, []string{imported[0].ID}) {
+		t.Errorf("Build blocked by %q, want %q", build.BlockedBy, imported[0].ID)
+	}
+
+	// Importing again skips the tasks
+	again, err := importTaskwarrior(tasks)
+	if err != nil {
+		t.Fatalf("importTaskwarrior() again error = %v", err)
+	}
+	for _, ib := range again {
+		if !ib.Skipped {
+			t.Errorf("%s imported twice", ib.Title)
+		}
+	}
+
+	// Exporting keeps the UUIDs and dependencies
+	exported := beansToTaskwarrior([]*bean.Bean{mustGet(t, imported[0].ID), build})
+	if exported[0].UUID != tasks[0].UUID || exported[0].Status != "completed" || exported[0].End == "" {
+		t.Errorf("exported Design = %+v", exported[0])
+	}
+	if exported[1].UUID != tasks[1].UUID || exported[1].Priority != "L" ||
+		!reflect.DeepEqual(exported[1].Depends, taskwarriorDepends{tasks[0].UUID}) {
+		t.Errorf("exported Build = %+v", exported[1])
+	}
+}
+
+func TestTaskwarriorUUIDStable(t *testing.T) {
+	b := &bean.Bean{ID: "beans-abcd"}
+	if taskwarriorUUID(b) != taskwarriorUUID(&bean.Bean{ID: "beans-abcd"}) {
+		t.Error("taskwarriorUUID() differs between calls")
+	}
+	if taskwarriorUUID(b) == taskwarriorUUID(&bean.Bean{ID: "beans-efgh"}) {
+		t.Error("taskwarriorUUID() is the same for different beans")
+	}
+}
+
+func mustGet(t *testing.T, id string) *bean.Bean {
+	t.Helper()
+	b, err := core.Get(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// trelloTracker is the key of Trello card IDs in a bean's external IDs.
+const trelloTracker = "trello"
+
+var importTrelloLists []string
+
+// trelloBoard is the part of a Trello board export (Menu → Print, export
+// and share → Export as JSON) beans are imported from.
+type trelloBoard struct {
+	Name       string            `json:"name"`
+	Lists      []trelloList      `json:"lists"`
+	Cards      []trelloCard      `json:"cards"`
+	Checklists []trelloChecklist `json:"checklists"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Desc     string  `json:"desc"`
+	IDList   string  `json:"idList"`
+	Closed   bool    `json:"closed"`
+	Pos      float64 `json:"pos"`
+	ShortURL string  `json:"shortUrl"`
+	Labels   []struct {
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"labels"`
+	Attachments []struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"attachments"`
+}
+
+type trelloChecklist struct {
+	ID         string  `json:"id"`
+	IDCard     string  `json:"idCard"`
+	Name       string  `json:"name"`
+	Pos        float64 `json:"pos"`
+	CheckItems []struct {
+		Name  string  `json:"name"`
+		State string  `json:"state"`
+		Pos   float64 `json:"pos"`
+	} `json:"checkItems"`
+}
+
+var importTrelloCmd = &cobra.Command{
+	Use:   "trello <export.json>",
+	Short: "Import cards from a Trello board export",
+	Long: `Creates a bean for each open card in a Trello board's JSON export (Menu →
+Print, export and share → Export as JSON), in board order:
+
+- The card's list sets the status. Lists named like a status ("In Progress",
+  "Done"), "To Do", "Doing", "Backlog" and "Ideas" map on their own; map
+  others with --list, e.g. --list "Ready for QA=in-progress". Cards in other
+  lists get the default status.
+- Labels become tags (the label's color if it has no name).
+- The description becomes the body, followed by checklists as task lists and
+  attachments as links.
+
+Archived cards and cards in archived lists are skipped. Each bean keeps its
+card's ID, and cards that were imported before are skipped.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, tag := range importTags {
+			if err := bean.ValidateTag(tag); err != nil {
+				return cmdError(importJSON, output.ErrValidation, "%s", err)
+			}
+		}
+		lists := make(map[string]string, len(importTrelloLists))
+		for _, mapping := range importTrelloLists {
+			name, status, ok := strings.Cut(mapping, "=")
+			if !ok || !cfg.IsValidStatus(strings.TrimSpace(status)) {
+				return cmdError(importJSON, output.ErrValidation, "invalid --list %q (use <list>=<status>, with status one of %s)", mapping, cfg.StatusList())
+			}
+			lists[strings.ToLower(strings.TrimSpace(name))] = strings.TrimSpace(status)
+		}
+
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return cmdError(importJSON, output.ErrFileError, "%s", err)
+		}
+		var board trelloBoard
+		if err := json.Unmarshal(data, &board); err != nil {
+			return cmdError(importJSON, output.ErrValidation, "parsing Trello export: %s", err)
+		}
+
+		imported, err := importTrello(board, lists)
+		if err != nil {
+			return cmdError(importJSON, output.ErrFileError, "%s", err)
+		}
+
+		if importJSON {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(imported)
+		}
+		printImportedBeans(cmd.OutOrStdout(), imported, importDryRun)
+		return nil
+	},
+}
+
+// importTrello creates a bean for each open card that wasn't imported before.
+// lists maps lowercased list names to statuses. With --dry-run, nothing is
+// created.
+func importTrello(board trelloBoard, lists map[string]string) ([]*importedBean, error) {
+	existing := make(map[string]*bean.Bean)
+	for _, b := range core.All() {
+		if id := b.ExternalIDs[trelloTracker]; id != "" {
+			existing[id] = b
+		}
+	}
+
+	listsByID := make(map[string]trelloList, len(board.Lists))
+	for _, l := range board.Lists {
+		listsByID[l.ID] = l
+	}
+	cards := board.Cards
+	sort.SliceStable(cards, func(i, j int) bool {
+		a, b := listsByID[cards[i].IDList], listsByID[cards[j].IDList]
+		if a.Pos != b.Pos {
+			return a.Pos < b.Pos
+		}
+		return cards[i].Pos < cards[j].Pos
+	})
+
+	var imported []*importedBean
+	for _, card := range cards {
+		list, ok := listsByID[card.IDList]
+		if card.Closed || (ok && list.Closed) {
+			continue
+		}
+		source := card.ShortURL
+		if source == "" {
+			source = card.ID
+		}
+		if b, ok := existing[card.ID]; ok {
+			imported = append(imported, &importedBean{ID: b.ID, Title: b.Title, Status: b.Status, Source: source, Skipped: true})
+			continue
+		}
+
+		b := trelloToBean(card, list.Name, lists, board.Checklists)
+		b.Tags = addImportTags(b.Tags, importTags...)
+		ib := &importedBean{Title: b.Title, Status: b.Status, Tags: b.Tags, Body: b.Body, Source: source}
+		imported = append(imported, ib)
+		if importDryRun {
+			continue
+		}
+		b.Type = cfg.GetDefaultType()
+		if err := core.Create(b); err != nil {
+			return imported, fmt.Errorf("importing card %s: %w", source, err)
+		}
+		ib.ID = b.ID
+	}
+	return imported, nil
+}
+
+// trelloListStatus returns the status for cards in a list: the one mapped by
+// --list, the status the list is named after, or the default status.
+func trelloListStatus(name string, lists map[string]string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if status, ok := lists[key]; ok {
+		return status
+	}
+	if status, ok := importStatus(name); ok {
+		return status
+	}
+	return cfg.GetDefaultStatus()
+}
+
+// trelloToBean converts a Trello card in the named list to a new bean.
+func trelloToBean(card trelloCard, listName string, lists map[string]string, checklists []trelloChecklist) *bean.Bean {
+	b := &bean.Bean{
+		Title:       card.Name,
+		Slug:        bean.Slugify(card.Name),
+		Status:      trelloListStatus(listName, lists),
+		ExternalIDs: map[string]string{trelloTracker: card.ID},
+	}
+	for _, label := range card.Labels {
+		name := label.Name
+		if name == "" {
+			name = label.Color
+		}
+		if tag := bean.Slugify(name); bean.ValidateTag(tag) == nil {
+			b.Tags = addImportTags(b.Tags, tag)
+		}
+	}
+
+	var sections []string
+	if desc := strings.TrimSpace(card.Desc); desc != "" {
+		sections = append(sections, desc)
+	}
+	var own []trelloChecklist
+	for _, cl := range checklists {
+		if cl.IDCard == card.ID {
+			own = append(own, cl)
+		}
+	}
+	sort.SliceStable(own, func(i, j int) bool { return own[i].Pos < own[j].Pos })
+	for _, cl := range own {
+		items := cl.CheckItems
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+		lines := []string{"## " + cl.Name, ""}
+		for _, item := range items {
+			box := "[ ]"
+			if item.State == "complete" {
+				box = "[x]"
+			}
+			lines = append(lines, "- "+box+" "+item.Name)
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if len(card.Attachments) > 0 {
+		lines := []string{"## Attachments", ""}
+		for _, a := range card.Attachments {
+			name := a.Name
+			if name == "" {
+				name = a.URL
+			}
+			lines = append(lines, fmt.Sprintf("- [%s](%s)", name, a.URL))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	b.Body = strings.Join(sections, "\n\n")
+	return b
+}
+
+func init() {
+	importTrelloCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Show what would be imported without creating beans")
+	importTrelloCmd.Flags().BoolVar(&importJSON, "json", false, "Output as JSON")
+	importTrelloCmd.Flags().StringArrayVar(&importTags, "tag", nil, "Add tag to every imported bean (can be repeated)")
+	importTrelloCmd.Flags().StringArrayVar(&importTrelloLists, "list", nil, "Map a list to a status, as <list>=<status> (can be repeated)")
+	importCmd.AddCommand(importTrelloCmd)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+const trelloExport = `{
+  "name": "Board",
+  "lists": [
+    {"id": "l2", "name": "Done", "pos": 2},
+    {"id": "l1", "name": "Doing", "pos": 1},
+    {"id": "l3", "name": "Ready for QA", "pos": 3},
+    {"id": "l4", "name": "Old", "pos": 4, "closed": true}
+  ],
+  "cards": [
+    {"id": "c1", "name": "Ship it", "desc": "Release notes", "idList": "l2", "pos": 1, "shortUrl": "https://trello.com/c/abc",
+     "labels": [{"name": "Release Blocker", "color": "red"}, {"name": "", "color": "green"}],
+     "attachments": [{"name": "spec.pdf", "url": "https://trello.com/spec.pdf"}]},
+    {"id": "c2", "name": "Write code", "idList": "l1", "pos": 1},
+    {"id": "c3", "name": "Test it", "idList": "l3", "pos": 1},
+    {"id": "c4", "name": "Archived card", "idList": "l1", "pos": 2, "closed": true},
+    {"id": "c5", "name": "In an archived list", "idList": "l4", "pos": 1}
+  ],
+  "checklists": [
+    {"id": "k2", "idCard": "c1", "name": "After", "pos": 2, "checkItems": [{"name": "Announce", "state": "incomplete", "pos": 1}]},
+    {"id": "k1", "idCard": "c1", "name": "Before", "pos": 1, "checkItems": [
+      {"name": "Tag", "state": "incomplete", "pos": 2}, {"name": "Build", "state": "complete", "pos": 1}]}
+  ]
+}`
+
+func TestImportTrello(t *testing.T) {
+	testCore, cleanup := setupQueryTestCore(t)
+	defer cleanup()
+	oldCfg := cfg
+	defer func() { cfg = oldCfg }()
+	cfg = config.Default()
+
+	var board trelloBoard
+	if err := json.Unmarshal([]byte(trelloExport), &board); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := importTrello(board, map[string]string{"ready for qa": "in-progress"})
+	if err != nil {
+		t.Fatalf("importTrello() error = %v", err)
+	}
+
+	var got [][2]string
+	for _, ib := range imported {
+		got = append(got, [2]string{ib.Title, ib.Status})
+	}
+	want := [][2]string{{"Write code", "in-progress"}, {"Ship it", "completed"}, {"Test it", "in-progress"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("imported = %v, want %v (in board order, archived cards skipped)", got, want)
+	}
+
+	ship, err := testCore.Get(imported[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"release-blocker", "green"}; !reflect.DeepEqual(ship.Tags, want) {
+		t.Errorf("tags = %q, want %q", ship.Tags, want)
+	}
+	wantBody := "Release notes\n\n## Before\n\n- [x] Build\n- [ ] Tag\n\n## After\n\n- [ ] Announce\n\n" +
+		"## Attachments\n\n- [spec.pdf](https://trello.com/spec.pdf)"
+	if ship.Body != wantBody {
+		t.Errorf("body = %q, want %q", ship.Body, wantBody)
+	}
+	if ship.ExternalIDs[trelloTracker] != "c1" {
+		t.Errorf("external IDs = %v, want the card ID", ship.ExternalIDs)
+	}
+
+	// Importing again skips the cards
+	again, err := importTrello(board, nil)
+	if err != nil {
+		t.Fatalf("importTrello() again error = %v", err)
+	}
+	for _, ib := range again {
+		if !ib.Skipped {
+			t.Errorf("%s imported twice", ib.Title)
+		}
+	}
+}
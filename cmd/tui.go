@@ -8,7 +8,11 @@ import (
 var tuiCmd = &cobra.Command{
 	Use:   "tui",
 	Short: "Open the interactive TUI",
-	Long:  `Opens an interactive terminal user interface for browsing and managing beans.`,
+	Long: `Opens an interactive terminal user interface for browsing and managing beans.
+
+It reopens where it was last quit: in the same view (list or board), on the
+same bean, with the same filters and board lanes. This is kept in
+.beans/.state/state.json, which is never committed.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return tui.Run(core, cfg)
 	},
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/graph"
@@ -19,22 +20,31 @@ var (
 	updateStatus          string
 	updateType            string
 	updatePriority        string
+	updatePoints          int
+	updatePRURL           string
+	updateScope           string
 	updateTitle           string
 	updateBody            string
 	updateBodyFile        string
 	updateBodyReplaceOld  string
 	updateBodyReplaceNew  string
 	updateBodyAppend      string
+	updateSection         string
 	updateParent          string
 	updateRemoveParent    bool
 	updateBlocking        []string
 	updateRemoveBlocking  []string
 	updateBlockedBy       []string
 	updateRemoveBlockedBy []string
+	updateLink            []string
+	updateRemoveLink      []string
 	updateTag             []string
 	updateRemoveTag       []string
+	updateAlias           []string
+	updateRemoveAlias     []string
 	updateIfMatch         string
 	updateJSON            bool
+	updateAutoStash       bool
 )
 
 var updateCmd = &cobra.Command{
@@ -50,7 +60,7 @@ var updateCmd = &cobra.Command{
 		// Find the bean
 		b, err := resolver.Query().Bean(ctx, args[0])
 		if err != nil {
-			return cmdError(updateJSON, output.ErrNotFound, "failed to find bean: %v", err)
+			return beanNotFound(updateJSON, args[0], err)
 		}
 
 		// If not found, check the archive and unarchive if present
@@ -58,12 +68,12 @@ var updateCmd = &cobra.Command{
 		if b == nil {
 			unarchived, unarchiveErr := core.LoadAndUnarchive(args[0])
 			if unarchiveErr != nil {
-				return cmdError(updateJSON, output.ErrNotFound, "bean not found: %s", args[0])
+				return beanNotFound(updateJSON, args[0], err)
 			}
 			// Re-query to get the model.Bean
 			b, err = resolver.Query().Bean(ctx, unarchived.ID)
 			if err != nil || b == nil {
-				return cmdError(updateJSON, output.ErrNotFound, "bean not found: %s", args[0])
+				return beanNotFound(updateJSON, args[0], err)
 			}
 			wasArchived = true
 		}
@@ -78,7 +88,7 @@ var updateCmd = &cobra.Command{
 		}
 
 		// Build and validate field updates
-		input, fieldChanges, err := buildUpdateInput(cmd, b.Tags, b.Body)
+		input, fieldChanges, err := buildUpdateInput(cmd, b.Tags, b.Aliases, b.Body)
 		if err != nil {
 			return cmdError(updateJSON, output.ErrValidation, "%s", err)
 		}
@@ -89,12 +99,33 @@ var updateCmd = &cobra.Command{
 			input.IfMatch = ifMatch
 		}
 
-		// Apply field updates
+		// Apply field updates (status changes may switch git branches)
+		if updateAutoStash {
+			core.SetAutoStash(true)
+		}
 		if hasFieldUpdates(input) {
-			b, err = resolver.Mutation().UpdateBean(ctx, b.ID, input)
+			b, err = withStashRetry(updateJSON, func() (*bean.Bean, error) {
+				return resolver.Mutation().UpdateBean(ctx, b.ID, input)
+			})
+			if err != nil {
+				return mutationError(updateJSON, err)
+			}
+		}
+
+		// Replace a single body section
+		if updateSection != "" {
+			if !cmd.Flags().Changed("body") && !cmd.Flags().Changed("body-file") {
+				return cmdError(updateJSON, output.ErrValidation, "--section requires --body or --body-file with the new section content")
+			}
+			content, err := resolveContent(updateBody, updateBodyFile)
+			if err != nil {
+				return cmdError(updateJSON, output.ErrValidation, "%s", err)
+			}
+			b, err = resolver.Mutation().UpdateBeanSection(ctx, b.ID, updateSection, content, ifMatch)
 			if err != nil {
 				return mutationError(updateJSON, err)
 			}
+			changes = append(changes, "body")
 		}
 
 		// Handle parent changes
@@ -103,7 +134,7 @@ var updateCmd = &cobra.Command{
 			if !updateRemoveParent && updateParent != "" {
 				parentID = &updateParent
 			}
-			b, err = resolver.Mutation().SetParent(ctx, b.ID, parentID, ifMatch)
+			b, err = resolver.Mutation().SetParent(ctx, b.ID, parentID, ifMatch, nil)
 			if err != nil {
 				return mutationError(updateJSON, err)
 			}
@@ -146,10 +177,34 @@ var updateCmd = &cobra.Command{
 			changes = append(changes, "blocked-by")
 		}
 
+		// Process typed link additions and removals
+		for _, spec := range updateLink {
+			linkType, targetID, err := parseLinkFlag(spec)
+			if err != nil {
+				return cmdError(updateJSON, output.ErrValidation, "%s", err)
+			}
+			b, err = resolver.Mutation().AddLink(ctx, b.ID, linkType, targetID, ifMatch)
+			if err != nil {
+				return mutationError(updateJSON, err)
+			}
+			changes = append(changes, "links")
+		}
+		for _, spec := range updateRemoveLink {
+			linkType, targetID, err := parseLinkFlag(spec)
+			if err != nil {
+				return cmdError(updateJSON, output.ErrValidation, "%s", err)
+			}
+			b, err = resolver.Mutation().RemoveLink(ctx, b.ID, linkType, targetID, ifMatch)
+			if err != nil {
+				return mutationError(updateJSON, err)
+			}
+			changes = append(changes, "links")
+		}
+
 		// Require at least one change
 		if len(changes) == 0 {
 			return cmdError(updateJSON, output.ErrValidation,
-				"no changes specified (use --status, --type, --priority, --title, --body, --parent, --blocking, --blocked-by, --tag, or their --remove-* variants)")
+				"no changes specified (use --status, --type, --priority, --points, --pr-url, --title, --body, --parent, --blocking, --blocked-by, --link, --tag, --alias, or their --remove-* variants)")
 		}
 
 		// Output result
@@ -158,7 +213,7 @@ var updateCmd = &cobra.Command{
 			if wasArchived {
 				msg = "Bean unarchived and updated"
 			}
-			return output.Success(b, msg)
+			return output.Success(jsonRedaction(), b, msg)
 		}
 
 		if wasArchived {
@@ -166,12 +221,17 @@ var updateCmd = &cobra.Command{
 		} else {
 			fmt.Println(ui.Success.Render("Updated ") + ui.ID.Render(b.ID) + " " + ui.Muted.Render(b.Path))
 		}
+		if cmd.Flags().Changed("title") {
+			if suggestion := core.SuggestTitle(b.Title); suggestion != "" {
+				fmt.Println(ui.Warning.Render("Suggested title: ") + suggestion + ui.Muted.Render(" (per the title policy in .beans.yml)"))
+			}
+		}
 		return nil
 	},
 }
 
 // buildUpdateInput constructs the GraphQL input from flags and returns which fields changed.
-func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody string) (model.UpdateBeanInput, []string, error) {
+func buildUpdateInput(cmd *cobra.Command, existingTags, existingAliases []string, currentBody string) (model.UpdateBeanInput, []string, error) {
 	var input model.UpdateBeanInput
 	var changes []string
 
@@ -199,13 +259,32 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 		changes = append(changes, "priority")
 	}
 
+	if cmd.Flags().Changed("points") {
+		if updatePoints < 0 {
+			return input, nil, fmt.Errorf("invalid points: %d (must not be negative)", updatePoints)
+		}
+		input.Points = &updatePoints
+		changes = append(changes, "points")
+	}
+
+	if cmd.Flags().Changed("scope") {
+		input.Scope = &updateScope
+		changes = append(changes, "scope")
+	}
+
+	if cmd.Flags().Changed("pr-url") {
+		input.GitPrURL = &updatePRURL
+		changes = append(changes, "pr-url")
+	}
+
 	if cmd.Flags().Changed("title") {
 		input.Title = &updateTitle
 		changes = append(changes, "title")
 	}
 
 	// Handle body modifications
-	if cmd.Flags().Changed("body") || cmd.Flags().Changed("body-file") {
+	// With --section, --body/--body-file set a single section instead (see updateBeanSection)
+	if updateSection == "" && (cmd.Flags().Changed("body") || cmd.Flags().Changed("body-file")) {
 		// Full body replacement
 		body, err := resolveContent(updateBody, updateBodyFile)
 		if err != nil {
@@ -244,13 +323,19 @@ func buildUpdateInput(cmd *cobra.Command, existingTags []string, currentBody str
 		changes = append(changes, "tags")
 	}
 
+	if len(updateAlias) > 0 || len(updateRemoveAlias) > 0 {
+		input.Aliases = mergeTags(existingAliases, updateAlias, updateRemoveAlias)
+		changes = append(changes, "aliases")
+	}
+
 	return input, changes, nil
 }
 
 // hasFieldUpdates returns true if any field in the input is set.
 func hasFieldUpdates(input model.UpdateBeanInput) bool {
-	return input.Status != nil || input.Type != nil || input.Priority != nil ||
-		input.Title != nil || input.Body != nil || input.BodyMod != nil || input.Tags != nil
+	return input.Status != nil || input.Type != nil || input.Priority != nil || input.Points != nil ||
+		input.Title != nil || input.Body != nil || input.BodyMod != nil || input.Tags != nil ||
+		input.Aliases != nil || input.GitPrURL != nil || input.Scope != nil
 }
 
 // isConflictError returns true if the error is an ETag-related conflict error.
@@ -260,6 +345,15 @@ func isConflictError(err error) bool {
 	return errors.As(err, &mismatchErr) || errors.As(err, &requiredErr)
 }
 
+// parseLinkFlag splits a --link value of the form type:id.
+func parseLinkFlag(spec string) (linkType, id string, err error) {
+	linkType, id, ok := strings.Cut(spec, ":")
+	if !ok || linkType == "" || id == "" {
+		return "", "", fmt.Errorf("invalid link %q (expected type:id, e.g. duplicates:abc1)", spec)
+	}
+	return linkType, id, nil
+}
+
 // mutationError returns a cmdError with the appropriate error code based on the error type.
 func mutationError(jsonOutput bool, err error) error {
 	if isConflictError(err) {
@@ -286,27 +380,38 @@ func init() {
 	updateCmd.Flags().StringVarP(&updateStatus, "status", "s", "", "New status ("+strings.Join(statusNames, ", ")+")")
 	updateCmd.Flags().StringVarP(&updateType, "type", "t", "", "New type ("+strings.Join(typeNames, ", ")+")")
 	updateCmd.Flags().StringVarP(&updatePriority, "priority", "p", "", "New priority ("+strings.Join(priorityNames, ", ")+", or empty to clear)")
+	updateCmd.Flags().IntVar(&updatePoints, "points", 0, "New story point estimate")
+	updateCmd.Flags().StringVar(&updateScope, "scope", "", "Monorepo component, as a path relative to the repository root (empty to clear; see 'beans scope' to infer it)")
+	updateCmd.Flags().StringVar(&updatePRURL, "pr-url", "", "Pull request URL, used by 'beans sync' to detect merges (empty to clear)")
 	updateCmd.Flags().StringVar(&updateTitle, "title", "", "New title")
 	updateCmd.Flags().StringVarP(&updateBody, "body", "d", "", "New body (use '-' to read from stdin)")
 	updateCmd.Flags().StringVar(&updateBodyFile, "body-file", "", "Read body from file")
 	updateCmd.Flags().StringVar(&updateBodyReplaceOld, "body-replace-old", "", "Text to find and replace (requires --body-replace-new)")
 	updateCmd.Flags().StringVar(&updateBodyReplaceNew, "body-replace-new", "", "Replacement text (requires --body-replace-old)")
 	updateCmd.Flags().StringVar(&updateBodyAppend, "body-append", "", "Text to append to body (use '-' for stdin)")
+	updateCmd.Flags().StringVar(&updateSection, "section", "", "Replace only the body section with this heading (content from --body or --body-file; appended if missing)")
 	updateCmd.Flags().StringVar(&updateParent, "parent", "", "Set parent bean ID")
 	updateCmd.Flags().BoolVar(&updateRemoveParent, "remove-parent", false, "Remove parent")
 	updateCmd.Flags().StringArrayVar(&updateBlocking, "blocking", nil, "ID of bean this blocks (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveBlocking, "remove-blocking", nil, "ID of bean to unblock (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateBlockedBy, "blocked-by", nil, "ID of bean that blocks this one (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveBlockedBy, "remove-blocked-by", nil, "ID of blocker bean to remove (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateLink, "link", nil, "Add a typed link as type:id, e.g. duplicates:abc1 (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveLink, "remove-link", nil, "Remove a typed link given as type:id (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateTag, "tag", nil, "Add tag (can be repeated)")
 	updateCmd.Flags().StringArrayVar(&updateRemoveTag, "remove-tag", nil, "Remove tag (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateAlias, "alias", nil, "Add alias, e.g. the bean's ID in another tracker (can be repeated)")
+	updateCmd.Flags().StringArrayVar(&updateRemoveAlias, "remove-alias", nil, "Remove alias (can be repeated)")
 	updateCmd.Flags().StringVar(&updateIfMatch, "if-match", "", "Only update if etag matches (optimistic locking)")
 	updateCmd.MarkFlagsMutuallyExclusive("parent", "remove-parent")
+	updateCmd.Flags().BoolVar(&updateAutoStash, "auto-stash", false, "Stash uncommitted changes around git branch switches and restore them afterwards")
 	updateCmd.Flags().BoolVar(&updateJSON, "json", false, "Output as JSON")
 	// body and body-file are mutually exclusive with body modifications
 	updateCmd.MarkFlagsMutuallyExclusive("body", "body-file", "body-replace-old")
 	updateCmd.MarkFlagsMutuallyExclusive("body", "body-file", "body-append")
 	// body-replace-old and body-append can now be used together!
 	updateCmd.MarkFlagsRequiredTogether("body-replace-old", "body-replace-new")
+	updateCmd.MarkFlagsMutuallyExclusive("section", "body-replace-old")
+	updateCmd.MarkFlagsMutuallyExclusive("section", "body-append")
 	rootCmd.AddCommand(updateCmd)
 }
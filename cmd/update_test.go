@@ -79,7 +79,7 @@ func setupUpdateTestEnv(t *testing.T) (*beancore.Core, *git.Repository, string,
 	cfg.Beans.Git.AutoCreateBranch = true
 	cfg.Beans.Git.BaseBranch = "main"
 	testCore := beancore.New(beansDir, cfg)
-	testCore.SetWarnWriter(nil) // suppress warnings in tests
+	testCore.SetLogger(nil) // suppress warnings in tests
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -111,7 +111,7 @@ func setupUpdateTestEnvNoGit(t *testing.T) (*beancore.Core, func()) {
 
 	cfg := config.Default()
 	testCore := beancore.New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -535,3 +535,29 @@ func TestUpdateCommand_ResponseOutput(t *testing.T) {
 		t.Error("Updated bean should have GitBranch set")
 	}
 }
+
+func TestParseLinkFlag(t *testing.T) {
+	tests := []struct {
+		spec     string
+		wantType string
+		wantID   string
+		wantErr  bool
+	}{
+		{"duplicates:abc1", "duplicates", "abc1", false},
+		{"related:beans-x:y", "related", "beans-x:y", false},
+		{"duplicates", "", "", true},
+		{":abc1", "", "", true},
+		{"related:", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			linkType, id, err := parseLinkFlag(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseLinkFlag(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if linkType != tt.wantType || id != tt.wantID {
+				t.Errorf("parseLinkFlag(%q) = %q, %q; want %q, %q", tt.spec, linkType, id, tt.wantType, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var (
+	// linkPattern matches a web link in text. Trailing punctuation is trimmed
+	// off by firstURL.
+	linkPattern = regexp.MustCompile("https?://[^\\s<>()\\[\\]\"'`]+")
+	// htmlTitlePattern matches the title element of an HTML page.
+	htmlTitlePattern = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+// maxTitlePageSize limits how much of a page is read looking for its title.
+const maxTitlePageSize = 512 * 1024
+
+// firstURL returns the first web link in text, or an empty string.
+func firstURL(text string) string {
+	return strings.TrimRight(linkPattern.FindString(text), ".,;:!?")
+}
+
+// fetchPageTitle fetches an HTML page and returns its title, with entities
+// decoded and whitespace collapsed.
+func fetchPageTitle(ctx context.Context, client *http.Client, pageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "beans")
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching %s: %s", pageURL, resp.Status)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return "", fmt.Errorf("%s is not an HTML page (%s)", pageURL, ct)
+	}
+
+	page, err := io.ReadAll(io.LimitReader(resp.Body, maxTitlePageSize))
+	if err != nil {
+		return "", err
+	}
+	m := htmlTitlePattern.FindSubmatch(page)
+	if m == nil {
+		return "", fmt.Errorf("%s has no title", pageURL)
+	}
+	title := strings.Join(strings.Fields(html.UnescapeString(string(m[1]))), " ")
+	if title == "" {
+		return "", fmt.Errorf("%s has no title", pageURL)
+	}
+	return title, nil
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFirstURL(t *testing.T) {
+	tests := map[string]string{
+		"See https://example.com/a?b=c.":         "https://example.com/a?b=c",
+		"[docs](https://example.com/docs), more": "https://example.com/docs",
+		"<http://example.com>":                   "http://example.com",
+		"no links here":                          "",
+	}
+	for text, want := range tests {
+		if got := firstURL(text); got != want {
+			t.Errorf("firstURL(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestFetchPageTitle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/page":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte("<html><head><TITLE>\n  Fish &amp; Chips\n</TITLE></head></html>"))
+		case "/json":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"title": "nope"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	title, err := fetchPageTitle(ctx, server.Client(), server.URL+"/page")
+	if err != nil || title != "Fish & Chips" {
+		t.Errorf("fetchPageTitle() = %q, %v", title, err)
+	}
+	for _, path := range []string{"/json", "/missing"} {
+		if _, err := fetchPageTitle(ctx, server.Client(), server.URL+path); err == nil {
+			t.Errorf("fetchPageTitle(%s) error = nil", path)
+		}
+	}
+}
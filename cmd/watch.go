@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchWebhook  string
+	watchInterval time.Duration
+	watchAging    bool
+	watchNotify   bool
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch beans, alert on SLA breaches and changes, and apply aging rules",
+	Long: `Watches the beans directory and reports beans that breach the SLA for their
+priority (sla in .beans.yml), re-checking whenever beans change and on an interval.
+
+Each breach is reported once, when it starts. With --webhook, breaches are also
+POSTed as JSON to the given URL:
+
+  {"event": "sla_breached", "breach": {"id": "...", "title": "...", "priority": "critical",
+   "status": "todo", "since": "...", "deadline": "..."}}
+
+With --aging, the aging rules (see beans maintain) are applied on every check.
+
+With --notify, beans being created or changing status are reported too, as a
+line on stdout and a desktop notification (notify-send on Linux, osascript on
+macOS), or a terminal bell where neither is available. Handy while an agent
+works through beans in another window.
+
+Runs until interrupted.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(cfg.Beans.SLA) == 0 && !watchAging && !watchNotify {
+			return fmt.Errorf("no SLAs configured (add sla to %s, or use --notify)", config.ConfigFileName)
+		}
+		if watchAging && len(cfg.Beans.Aging) == 0 {
+			return fmt.Errorf("no aging rules configured (add aging to %s)", config.ConfigFileName)
+		}
+		if watchInterval <= 0 {
+			return fmt.Errorf("--interval must be positive")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := core.StartWatching(); err != nil {
+			return fmt.Errorf("watching beans: %w", err)
+		}
+		defer core.Unwatch()
+		events, unsubscribe := core.Subscribe()
+		defer unsubscribe()
+
+		alerter := &slaAlerter{webhook: watchWebhook, out: os.Stdout, logger: logger}
+		var notifier *beanNotifier
+		if watchNotify {
+			notifier = newBeanNotifier(os.Stdout, core.All())
+			notifier.send = desktopNotifier()
+			notifier.logger = logger
+		}
+		fmt.Fprintf(os.Stderr, "Watching beans (Ctrl+C to stop)\n")
+		check := func() {
+			if watchAging {
+				actions, err := core.ApplyAging(time.Now())
+				if err != nil {
+					logger.Warn("failed to apply aging rules", "error", err)
+				}
+				if len(actions) > 0 {
+					refs := make([]*beancore.AgingAction, len(actions))
+					for i := range actions {
+						refs[i] = &actions[i]
+					}
+					printAgingActions(os.Stdout, refs, false)
+				}
+			}
+			alerter.check(ctx, core.SLABreaches(time.Now()))
+		}
+		check()
+
+		ticker := time.NewTicker(watchInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			case batch, ok := <-events:
+				if !ok {
+					return nil
+				}
+				if notifier != nil {
+					notifier.handle(batch)
+				}
+			}
+			check()
+		}
+	},
+}
+
+// slaAlerter reports SLA breaches once each, when they start. A bean that
+// leaves its status and breaches again later is reported again.
+type slaAlerter struct {
+	webhook string
+	client  *http.Client
+	out     io.Writer
+	logger  *slog.Logger
+	alerted map[string]bool
+}
+
+// check reports breaches that weren't reported yet.
+func (a *slaAlerter) check(ctx context.Context, breaches []beancore.SLABreach) {
+	current := make(map[string]bool, len(breaches))
+	for _, breach := range breaches {
+		key := breach.BeanID + "\x00" + breach.Status + "\x00" + breach.Since.String()
+		current[key] = true
+		if a.alerted[key] {
+			continue
+		}
+		fmt.Fprintf(a.out, "%s %s %s %s in %s past its SLA by %s\n",
+			ui.Danger.Render("SLA breached:"), ui.ID.Render(breach.BeanID), breach.Title,
+			ui.Muted.Render("("+breach.Priority+")"), breach.Status, formatDuration(int(breach.Overdue.Seconds())))
+		if a.webhook != "" {
+			if err := a.post(ctx, breach); err != nil {
+				a.logger.Warn("SLA webhook failed", "bean", breach.BeanID, "error", err)
+			}
+		}
+	}
+	a.alerted = current
+}
+
+// post sends a breach to the webhook.
+func (a *slaAlerter) post(ctx context.Context, breach beancore.SLABreach) error {
+	payload, err := json.Marshal(map[string]any{"event": "sla_breached", "breach": breach})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.webhook, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := a.client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// beanNotifier reports beans being created or changing status. Events don't
+// carry a bean's previous state, so it remembers each bean's status.
+type beanNotifier struct {
+	out io.Writer
+	// send shows a desktop notification; if nil, the terminal bell is rung.
+	send     func(title, message string) error
+	logger   *slog.Logger
+	statuses map[string]string
+}
+
+func newBeanNotifier(out io.Writer, beans []*bean.Bean) *beanNotifier {
+	n := &beanNotifier{out: out, statuses: make(map[string]string, len(beans))}
+	for _, b := range beans {
+		n.statuses[b.ID] = b.Status
+	}
+	return n
+}
+
+// handle reports the interesting events of a batch.
+func (n *beanNotifier) handle(events []beancore.BeanEvent) {
+	for _, event := range events {
+		if event.Type == beancore.EventDeleted {
+			delete(n.statuses, event.BeanID)
+			continue
+		}
+		b := event.Bean
+		old, known := n.statuses[b.ID]
+		n.statuses[b.ID] = b.Status
+
+		var title, line string
+		switch {
+		case !known:
+			title = "Bean created"
+			line = fmt.Sprintf("%s %s %s %s", ui.Success.Render("Created:"), ui.ID.Render(b.ID), b.Title, ui.Muted.Render("("+b.Status+")"))
+		case old != b.Status:
+			title = "Bean " + b.Status
+			line = fmt.Sprintf("%s %s %s %s", ui.Warning.Render("Status:"), ui.ID.Render(b.ID), b.Title, ui.Muted.Render("("+old+" → "+b.Status+")"))
+		default:
+			continue
+		}
+
+		if n.send == nil {
+			line = "\a" + line
+		} else if err := n.send(title, b.ID+": "+b.Title); err != nil && n.logger != nil {
+			n.logger.Warn("desktop notification failed", "error", err)
+		}
+		fmt.Fprintln(n.out, line)
+	}
+}
+
+// desktopNotifier returns a function showing desktop notifications, or nil
+// if this system has no way to show them.
+func desktopNotifier() func(title, message string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		if _, err := exec.LookPath("osascript"); err == nil {
+			return func(title, message string) error {
+				script := fmt.Sprintf("display notification %q with title %q", message, title)
+				return exec.Command("osascript", "-e", script).Run()
+			}
+		}
+	default:
+		if _, err := exec.LookPath("notify-send"); err == nil {
+			return func(title, message string) error {
+				return exec.Command("notify-send", "--app-name=beans", title, message).Run()
+			}
+		}
+	}
+	return nil
+}
+
+func init() {
+	watchCmd.Flags().StringVar(&watchWebhook, "webhook", "", "POST SLA breaches as JSON to this URL")
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", time.Minute, "How often to re-check when nothing changes")
+	watchCmd.Flags().BoolVar(&watchAging, "aging", false, "Apply the aging rules on every check")
+	watchCmd.Flags().BoolVar(&watchNotify, "notify", false, "Report beans being created or changing status, with desktop notifications")
+	rootCmd.AddCommand(watchCmd)
+}
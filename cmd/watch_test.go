@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/logging"
+)
+
+func TestSLAAlerter(t *testing.T) {
+	var posted []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding webhook payload: %v", err)
+		}
+		posted = append(posted, payload)
+	}))
+	defer server.Close()
+
+	var out, errOut bytes.Buffer
+	alerter := &slaAlerter{webhook: server.URL, out: &out, logger: logging.New(&errOut, slog.LevelWarn, logging.FormatText)}
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	breach := beancore.SLABreach{BeanID: "beans-abc1", Title: "Late", Priority: "critical", Status: "todo", Since: since, Overdue: 3 * time.Hour}
+	ctx := context.Background()
+
+	alerter.check(ctx, []beancore.SLABreach{breach})
+	alerter.check(ctx, []beancore.SLABreach{breach})
+	if len(posted) != 1 {
+		t.Fatalf("webhook called %d times, want 1 (breaches are reported once)", len(posted))
+	}
+	if posted[0]["event"] != "sla_breached" {
+		t.Errorf("event = %v, want sla_breached", posted[0]["event"])
+	}
+	if got := posted[0]["breach"].(map[string]any)["id"]; got != "beans-abc1" {
+		t.Errorf("breach id = %v, want beans-abc1", got)
+	}
+	if !strings.Contains(out.String(), "beans-abc1") || strings.Count(out.String(), "\n") != 1 {
+		t.Errorf("output = %q, want one line about beans-abc1", out.String())
+	}
+
+	// Once resolved, a later breach is reported again
+	alerter.check(ctx, nil)
+	breach.Since = since.Add(24 * time.Hour)
+	alerter.check(ctx, []beancore.SLABreach{breach})
+	if len(posted) != 2 {
+		t.Errorf("webhook called %d times, want 2 after a new breach", len(posted))
+	}
+	if errOut.Len() != 0 {
+		t.Errorf("unexpected warnings: %s", errOut.String())
+	}
+}
+
+func TestSLAAlerterWebhookFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var out, errOut bytes.Buffer
+	alerter := &slaAlerter{webhook: server.URL, out: &out, logger: logging.New(&errOut, slog.LevelWarn, logging.FormatText)}
+	alerter.check(context.Background(), []beancore.SLABreach{{BeanID: "beans-abc1", Status: "todo"}})
+	if !strings.Contains(errOut.String(), "500") {
+		t.Errorf("warnings = %q, want webhook failure", errOut.String())
+	}
+}
+
+func TestBeanNotifier(t *testing.T) {
+	var out bytes.Buffer
+	var sent []string
+	n := newBeanNotifier(&out, []*bean.Bean{{ID: "beans-old1", Title: "Existing", Status: "todo"}})
+	n.send = func(title, message string) error {
+		sent = append(sent, title+"|"+message)
+		return nil
+	}
+
+	n.handle([]beancore.BeanEvent{
+		{Type: beancore.EventUpdated, BeanID: "beans-old1", Bean: &bean.Bean{ID: "beans-old1", Title: "Existing", Status: "todo", Body: "edited"}},
+		{Type: beancore.EventCreated, BeanID: "beans-new1", Bean: &bean.Bean{ID: "beans-new1", Title: "Fresh", Status: "draft"}},
+		{Type: beancore.EventUpdated, BeanID: "beans-old1", Bean: &bean.Bean{ID: "beans-old1", Title: "Existing", Status: "in-progress"}},
+		{Type: beancore.EventDeleted, BeanID: "beans-new1"},
+	})
+
+	want := []string{"Bean created|beans-new1: Fresh", "Bean in-progress|beans-old1: Existing"}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("notifications = %q, want %q", sent, want)
+	}
+	if lines := strings.Count(out.String(), "\n"); lines != 2 {
+		t.Errorf("printed %d lines, want 2: %q", lines, out.String())
+	}
+	if !strings.Contains(out.String(), "todo → in-progress") {
+		t.Errorf("output %q doesn't show the status change", out.String())
+	}
+
+	// Without desktop notifications, the bell is rung
+	out.Reset()
+	n.send = nil
+	n.handle([]beancore.BeanEvent{{Type: beancore.EventCreated, BeanID: "beans-new2", Bean: &bean.Bean{ID: "beans-new2", Title: "Bell", Status: "todo"}}})
+	if !strings.HasPrefix(out.String(), "\a") {
+		t.Errorf("output %q doesn't ring the bell", out.String())
+	}
+}
@@ -0,0 +1,64 @@
+package bean
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ResolveAliases replaces status and type names that are keys of statuses or
+// types with the names they map to, including statuses in the status history.
+// It reports whether anything was replaced.
+func (b *Bean) ResolveAliases(statuses, types map[string]string) bool {
+	resolved := false
+	if s, ok := statuses[b.Status]; ok {
+		b.Status, resolved = s, true
+	}
+	if t, ok := types[b.Type]; ok {
+		b.Type, resolved = t, true
+	}
+	for i, c := range b.StatusHistory {
+		if s, ok := statuses[c.Status]; ok {
+			b.StatusHistory[i].Status, resolved = s, true
+		}
+	}
+	return resolved
+}
+
+// RewriteAliases rewrites aliased status and type names in the front matter
+// of a bean file to the names they stand for, leaving everything else as it
+// is. It returns the rewritten file and the changes made; if there are none,
+// the file is returned unchanged.
+func RewriteAliases(content []byte, statuses, types map[string]string) ([]byte, []string, error) {
+	return rewriteFrontMatter(content, func(fm *yaml.Node) []string {
+		var changes []string
+		for _, field := range []struct {
+			name    string
+			aliases map[string]string
+		}{{"status", statuses}, {"type", types}} {
+			value := mappingValue(fm, field.name)
+			if value == nil || value.Kind != yaml.ScalarNode {
+				continue
+			}
+			if canonical, ok := field.aliases[value.Value]; ok {
+				changes = append(changes, fmt.Sprintf("%s %s → %s", field.name, value.Value, canonical))
+				value.Value = canonical
+			}
+		}
+		if history := mappingValue(fm, "status_history"); history != nil && history.Kind == yaml.SequenceNode {
+			renamed := 0
+			for _, entry := range history.Content {
+				if status := mappingValue(entry, "status"); status != nil {
+					if s, ok := statuses[status.Value]; ok {
+						status.Value = s
+						renamed++
+					}
+				}
+			}
+			if renamed > 0 {
+				changes = append(changes, fmt.Sprintf("renamed %d aliased statuses in status_history", renamed))
+			}
+		}
+		return changes
+	})
+}
@@ -0,0 +1,71 @@
+package bean
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveAliases(t *testing.T) {
+	statuses := map[string]string{"open": "todo", "done": "completed"}
+	types := map[string]string{"story": "feature"}
+
+	b := &Bean{Status: "done", Type: "story", StatusHistory: []StatusChange{{Status: "open"}, {Status: "done"}}}
+	if !b.ResolveAliases(statuses, types) {
+		t.Error("ResolveAliases() = false, want true")
+	}
+	if b.Status != "completed" || b.Type != "feature" {
+		t.Errorf("status %q, type %q; want completed, feature", b.Status, b.Type)
+	}
+	if b.StatusHistory[0].Status != "todo" || b.StatusHistory[1].Status != "completed" {
+		t.Errorf("StatusHistory = %+v, want todo then completed", b.StatusHistory)
+	}
+
+	b = &Bean{Status: "todo", Type: "task"}
+	if b.ResolveAliases(statuses, types) {
+		t.Error("ResolveAliases() = true for a bean without aliases")
+	}
+}
+
+func TestRewriteAliases(t *testing.T) {
+	content := `---
+# beans-abcd
+title:  Keep my spacing
+status: open
+type: story
+status_history:
+    - status: open
+      changed_at: 2025-01-01T00:00:00Z
+---
+
+Status: open stays in the body.
+`
+	want := `---
+# beans-abcd
+title: Keep my spacing
+status: todo
+type: feature
+status_history:
+    - status: todo
+      changed_at: 2025-01-01T00:00:00Z
+---
+
+Status: open stays in the body.
+`
+	got, changes, err := RewriteAliases([]byte(content), map[string]string{"open": "todo"}, map[string]string{"story": "feature"})
+	if err != nil {
+		t.Fatalf("RewriteAliases() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("RewriteAliases() =\n%s\nwant\n%s", got, want)
+	}
+	wantChanges := []string{"status open → todo", "type story → feature", "renamed 1 aliased statuses in status_history"}
+	if !reflect.DeepEqual(changes, wantChanges) {
+		t.Errorf("changes = %q, want %q", changes, wantChanges)
+	}
+
+	unchanged := "---\ntitle:  Test\nstatus: todo\n---\n"
+	got, changes, err = RewriteAliases([]byte(unchanged), map[string]string{"open": "todo"}, nil)
+	if err != nil || string(got) != unchanged || len(changes) != 0 {
+		t.Errorf("RewriteAliases() = %q, %q, %v; want the file unchanged", got, changes, err)
+	}
+}
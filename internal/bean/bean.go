@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"hash/fnv"
 	"io"
+	"maps"
+	"net/url"
+	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,26 +19,65 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// tagPattern matches valid tags: lowercase letters, numbers, and hyphens.
-// Must start with a letter, can contain hyphens but not consecutively or at the end.
-var tagPattern = regexp.MustCompile(`^[a-z][a-z0-9]*(?:-[a-z0-9]+)*$`)
+// tagPattern matches valid tags: lowercase letters, numbers, and hyphens,
+// optionally namespaced with slashes ("area/frontend"). Each segment must start
+// with a letter, can contain hyphens but not consecutively or at the end.
+var tagPattern = regexp.MustCompile(`^[a-z][a-z0-9]*(?:-[a-z0-9]+)*(?:/[a-z][a-z0-9]*(?:-[a-z0-9]+)*)*$`)
 
-// ValidateTag checks if a tag is valid (lowercase, URL-safe, single word).
+// TagNamespaceSeparator separates the segments of a namespaced tag.
+const TagNamespaceSeparator = "/"
+
+// ValidateTag checks if a tag is valid (lowercase, URL-safe, optionally namespaced).
 func ValidateTag(tag string) error {
 	if tag == "" {
 		return fmt.Errorf("tag cannot be empty")
 	}
 	if !tagPattern.MatchString(tag) {
-		return fmt.Errorf("invalid tag %q: must be lowercase, start with a letter, and contain only letters, numbers, and hyphens", tag)
+		return fmt.Errorf("invalid tag %q: must be lowercase, start with a letter, and contain only letters, numbers, and hyphens (use / to namespace, e.g. area/frontend)", tag)
 	}
 	return nil
 }
 
+// ValidateTagPattern checks if a tag filter is valid: either a tag or a
+// namespace wildcard like "area/*".
+func ValidateTagPattern(pattern string) error {
+	if ns, ok := strings.CutSuffix(pattern, TagNamespaceSeparator+"*"); ok {
+		return ValidateTag(ns)
+	}
+	return ValidateTag(pattern)
+}
+
+// TagNamespace returns the namespace of a tag ("area" for "area/frontend"),
+// or an empty string if the tag isn't namespaced.
+func TagNamespace(tag string) string {
+	if i := strings.LastIndex(tag, TagNamespaceSeparator); i >= 0 {
+		return tag[:i]
+	}
+	return ""
+}
+
+// MatchTag reports whether a tag matches a tag filter. A filter ending in "/*"
+// matches every tag in that namespace, including nested ones ("area/*" matches
+// "area/frontend" and "area/frontend/forms"); any other filter must match exactly.
+func MatchTag(pattern, tag string) bool {
+	if ns, ok := strings.CutSuffix(pattern, TagNamespaceSeparator+"*"); ok {
+		return strings.HasPrefix(tag, ns+TagNamespaceSeparator)
+	}
+	return pattern == tag
+}
+
 // NormalizeTag converts a tag to its canonical form (lowercase).
 func NormalizeTag(tag string) string {
 	return strings.ToLower(strings.TrimSpace(tag))
 }
 
+// ValidSourceURL reports whether s is an absolute http(s) URL, as source_url
+// must be.
+func ValidSourceURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
 // HasTag returns true if the bean has the specified tag.
 func (b *Bean) HasTag(tag string) bool {
 	normalized := NormalizeTag(tag)
@@ -132,6 +175,60 @@ func (b *Bean) RemoveBlockedBy(id string) {
 	b.BlockedBy = result
 }
 
+// HasLink returns true if this bean has a link of the given type to the given bean ID.
+func (b *Bean) HasLink(linkType, id string) bool {
+	for _, target := range b.Links[linkType] {
+		if target == id {
+			return true
+		}
+	}
+	return false
+}
+
+// AddLink adds a typed link to a bean ID if not already present.
+func (b *Bean) AddLink(linkType, id string) {
+	if b.HasLink(linkType, id) {
+		return
+	}
+	if b.Links == nil {
+		b.Links = make(map[string][]string)
+	}
+	b.Links[linkType] = append(b.Links[linkType], id)
+}
+
+// RemoveLink removes a typed link to a bean ID, dropping the link type when it becomes empty.
+func (b *Bean) RemoveLink(linkType, id string) {
+	result := make([]string, 0, len(b.Links[linkType]))
+	for _, target := range b.Links[linkType] {
+		if target != id {
+			result = append(result, target)
+		}
+	}
+	if len(result) == 0 {
+		delete(b.Links, linkType)
+	} else {
+		b.Links[linkType] = result
+	}
+	if len(b.Links) == 0 {
+		b.Links = nil
+	}
+}
+
+// AllAliases returns the bean's aliases followed by its external IDs (in
+// order of tracker), which name the bean too.
+func (b *Bean) AllAliases() []string {
+	if len(b.ExternalIDs) == 0 {
+		return b.Aliases
+	}
+	aliases := slices.Clone(b.Aliases)
+	for _, tracker := range slices.Sorted(maps.Keys(b.ExternalIDs)) {
+		if id := b.ExternalIDs[tracker]; id != "" && !slices.Contains(aliases, id) {
+			aliases = append(aliases, id)
+		}
+	}
+	return aliases
+}
+
 // Bean represents an issue stored as a markdown file with front matter.
 type Bean struct {
 	// ID is the unique NanoID identifier (from filename).
@@ -146,10 +243,16 @@ type Bean struct {
 	Status    string     `yaml:"status" json:"status"`
 	Type      string     `yaml:"type,omitempty" json:"type,omitempty"`
 	Priority  string     `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Points    *int       `yaml:"points,omitempty" json:"points,omitempty"`
 	Tags      []string   `yaml:"tags,omitempty" json:"tags,omitempty"`
 	CreatedAt *time.Time `yaml:"created_at,omitempty" json:"created_at,omitempty"`
 	UpdatedAt *time.Time `yaml:"updated_at,omitempty" json:"updated_at,omitempty"`
 
+	// Draft marks a bean that is still being written. Drafts are hidden from
+	// lists, the tree and stats until published. Not to be confused with the
+	// "draft" status, which is a visible bean that needs refinement.
+	Draft bool `yaml:"draft,omitempty" json:"draft,omitempty"`
+
 	// Body is the markdown content after the front matter.
 	Body string `yaml:"-" json:"body,omitempty"`
 
@@ -162,35 +265,91 @@ type Bean struct {
 	// BlockedBy is a list of bean IDs that are blocking this bean.
 	BlockedBy []string `yaml:"blocked_by,omitempty" json:"blocked_by,omitempty"`
 
+	// Scope is the part of a monorepo the bean concerns, as a path relative
+	// to the repository root (e.g. "packages/api"). See CleanScope.
+	Scope string `yaml:"scope,omitempty" json:"scope,omitempty"`
+
 	// Git integration fields
 	GitBranch      string     `yaml:"git_branch,omitempty" json:"git_branch,omitempty"`
 	GitCreatedAt   *time.Time `yaml:"git_created_at,omitempty" json:"git_created_at,omitempty"`
 	GitMergedAt    *time.Time `yaml:"git_merged_at,omitempty" json:"git_merged_at,omitempty"`
 	GitMergeCommit string     `yaml:"git_merge_commit,omitempty" json:"git_merge_commit,omitempty"`
+
+	// Pull request tracking fields
+	GitPRURL   string `yaml:"git_pr_url,omitempty" json:"git_pr_url,omitempty"`
+	GitPRState string `yaml:"git_pr_state,omitempty" json:"git_pr_state,omitempty"`
+
+	// StatusHistory records every status the bean has had, oldest first.
+	StatusHistory []StatusChange `yaml:"status_history,omitempty" json:"status_history,omitempty"`
+
+	// Rank is a lexicographic ordering key for manually ordering beans among
+	// their siblings (see RankBetween). Unranked beans sort after ranked ones.
+	Rank string `yaml:"rank,omitempty" json:"rank,omitempty"`
+
+	// Links holds typed links to other beans, keyed by link type
+	// (e.g. "duplicates", "supersedes"). Link types are defined in the config.
+	Links map[string][]string `yaml:"links,omitempty" json:"links,omitempty"`
+
+	// ExternalIDs holds the bean's IDs in other trackers it was imported from
+	// or exported to, keyed by tracker (e.g. "taskwarrior").
+	ExternalIDs map[string]string `yaml:"external_ids,omitempty" json:"external_ids,omitempty"`
+
+	// Aliases are other identifiers the bean can be referred to by, such as
+	// its ID in a tracker it was imported from (e.g. "JIRA-123", "GH#456").
+	// Core.Get resolves them like IDs, as it does ExternalIDs (see AllAliases).
+	Aliases []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`
+
+	// SourceURL is the web page the bean was created from, if any.
+	SourceURL string `yaml:"source_url,omitempty" json:"source_url,omitempty"`
+
+	// redaction, if set, is applied by MarshalJSON (see Redaction.Encode).
+	redaction *Redaction
 }
 
 // frontMatter is the subset of Bean that gets serialized to YAML front matter.
 type frontMatter struct {
-	Title          string     `yaml:"title"`
-	Status         string     `yaml:"status"`
-	Type           string     `yaml:"type,omitempty"`
-	Priority       string     `yaml:"priority,omitempty"`
-	Tags           []string   `yaml:"tags,omitempty"`
-	CreatedAt      *time.Time `yaml:"created_at,omitempty"`
-	UpdatedAt      *time.Time `yaml:"updated_at,omitempty"`
-	Parent         string     `yaml:"parent,omitempty"`
-	Blocking       []string   `yaml:"blocking,omitempty"`
-	BlockedBy      []string   `yaml:"blocked_by,omitempty"`
-	GitBranch      string     `yaml:"git_branch,omitempty"`
-	GitCreatedAt   *time.Time `yaml:"git_created_at,omitempty"`
-	GitMergedAt    *time.Time `yaml:"git_merged_at,omitempty"`
-	GitMergeCommit string     `yaml:"git_merge_commit,omitempty"`
+	Title          string              `yaml:"title"`
+	Status         string              `yaml:"status"`
+	Type           string              `yaml:"type,omitempty"`
+	Priority       string              `yaml:"priority,omitempty"`
+	Points         *int                `yaml:"points,omitempty"`
+	Tags           []string            `yaml:"tags,omitempty"`
+	Scope          string              `yaml:"scope,omitempty"`
+	CreatedAt      *time.Time          `yaml:"created_at,omitempty"`
+	UpdatedAt      *time.Time          `yaml:"updated_at,omitempty"`
+	Draft          bool                `yaml:"draft,omitempty"`
+	Parent         string              `yaml:"parent,omitempty"`
+	Blocking       []string            `yaml:"blocking,omitempty"`
+	BlockedBy      []string            `yaml:"blocked_by,omitempty"`
+	GitBranch      string              `yaml:"git_branch,omitempty"`
+	GitCreatedAt   *time.Time          `yaml:"git_created_at,omitempty"`
+	GitMergedAt    *time.Time          `yaml:"git_merged_at,omitempty"`
+	GitMergeCommit string              `yaml:"git_merge_commit,omitempty"`
+	GitPRURL       string              `yaml:"git_pr_url,omitempty"`
+	GitPRState     string              `yaml:"git_pr_state,omitempty"`
+	StatusHistory  []StatusChange      `yaml:"status_history,omitempty"`
+	Rank           string              `yaml:"rank,omitempty"`
+	Links          map[string][]string `yaml:"links,omitempty"`
+	ExternalIDs    map[string]string   `yaml:"external_ids,omitempty"`
+	Aliases        []string            `yaml:"aliases,omitempty"`
+	SourceURL      string              `yaml:"source_url,omitempty"`
+}
+
+// NormalizeLineEndings converts CRLF line endings (as in files checked out on
+// Windows with core.autocrlf) to LF. Beans are always read and written with LF.
+func NormalizeLineEndings(content []byte) []byte {
+	return bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
 }
 
 // Parse reads a bean from a reader (markdown with YAML front matter).
 func Parse(r io.Reader) (*Bean, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
 	var fm frontMatter
-	body, err := frontmatter.Parse(r, &fm)
+	body, err := frontmatter.Parse(bytes.NewReader(NormalizeLineEndings(content)), &fm)
 	if err != nil {
 		return nil, fmt.Errorf("parsing front matter: %w", err)
 	}
@@ -203,9 +362,12 @@ func Parse(r io.Reader) (*Bean, error) {
 		Status:         fm.Status,
 		Type:           fm.Type,
 		Priority:       fm.Priority,
+		Points:         fm.Points,
 		Tags:           fm.Tags,
+		Scope:          fm.Scope,
 		CreatedAt:      fm.CreatedAt,
 		UpdatedAt:      fm.UpdatedAt,
+		Draft:          fm.Draft,
 		Body:           bodyStr,
 		Parent:         fm.Parent,
 		Blocking:       fm.Blocking,
@@ -214,25 +376,44 @@ func Parse(r io.Reader) (*Bean, error) {
 		GitCreatedAt:   fm.GitCreatedAt,
 		GitMergedAt:    fm.GitMergedAt,
 		GitMergeCommit: fm.GitMergeCommit,
+		GitPRURL:       fm.GitPRURL,
+		GitPRState:     fm.GitPRState,
+		StatusHistory:  fm.StatusHistory,
+		Rank:           fm.Rank,
+		Links:          fm.Links,
+		ExternalIDs:    fm.ExternalIDs,
+		Aliases:        fm.Aliases,
+		SourceURL:      fm.SourceURL,
 	}, nil
 }
 
 // renderFrontMatter is used for YAML output with yaml.v3 (supports custom marshalers).
 type renderFrontMatter struct {
-	Title          string     `yaml:"title"`
-	Status         string     `yaml:"status"`
-	Type           string     `yaml:"type,omitempty"`
-	Priority       string     `yaml:"priority,omitempty"`
-	Tags           []string   `yaml:"tags,omitempty"`
-	CreatedAt      *time.Time `yaml:"created_at,omitempty"`
-	UpdatedAt      *time.Time `yaml:"updated_at,omitempty"`
-	Parent         string     `yaml:"parent,omitempty"`
-	Blocking       []string   `yaml:"blocking,omitempty"`
-	BlockedBy      []string   `yaml:"blocked_by,omitempty"`
-	GitBranch      string     `yaml:"git_branch,omitempty"`
-	GitCreatedAt   *time.Time `yaml:"git_created_at,omitempty"`
-	GitMergedAt    *time.Time `yaml:"git_merged_at,omitempty"`
-	GitMergeCommit string     `yaml:"git_merge_commit,omitempty"`
+	Title          string              `yaml:"title"`
+	Status         string              `yaml:"status"`
+	Type           string              `yaml:"type,omitempty"`
+	Priority       string              `yaml:"priority,omitempty"`
+	Points         *int                `yaml:"points,omitempty"`
+	Tags           []string            `yaml:"tags,omitempty"`
+	Scope          string              `yaml:"scope,omitempty"`
+	CreatedAt      *time.Time          `yaml:"created_at,omitempty"`
+	UpdatedAt      *time.Time          `yaml:"updated_at,omitempty"`
+	Draft          bool                `yaml:"draft,omitempty"`
+	Parent         string              `yaml:"parent,omitempty"`
+	Blocking       []string            `yaml:"blocking,omitempty"`
+	BlockedBy      []string            `yaml:"blocked_by,omitempty"`
+	GitBranch      string              `yaml:"git_branch,omitempty"`
+	GitCreatedAt   *time.Time          `yaml:"git_created_at,omitempty"`
+	GitMergedAt    *time.Time          `yaml:"git_merged_at,omitempty"`
+	GitMergeCommit string              `yaml:"git_merge_commit,omitempty"`
+	GitPRURL       string              `yaml:"git_pr_url,omitempty"`
+	GitPRState     string              `yaml:"git_pr_state,omitempty"`
+	StatusHistory  []StatusChange      `yaml:"status_history,omitempty"`
+	Rank           string              `yaml:"rank,omitempty"`
+	Links          map[string][]string `yaml:"links,omitempty"`
+	ExternalIDs    map[string]string   `yaml:"external_ids,omitempty"`
+	Aliases        []string            `yaml:"aliases,omitempty"`
+	SourceURL      string              `yaml:"source_url,omitempty"`
 }
 
 // Render serializes the bean back to markdown with YAML front matter.
@@ -242,9 +423,12 @@ func (b *Bean) Render() ([]byte, error) {
 		Status:         b.Status,
 		Type:           b.Type,
 		Priority:       b.Priority,
+		Points:         b.Points,
 		Tags:           b.Tags,
+		Scope:          b.Scope,
 		CreatedAt:      b.CreatedAt,
 		UpdatedAt:      b.UpdatedAt,
+		Draft:          b.Draft,
 		Parent:         b.Parent,
 		Blocking:       b.Blocking,
 		BlockedBy:      b.BlockedBy,
@@ -252,6 +436,14 @@ func (b *Bean) Render() ([]byte, error) {
 		GitCreatedAt:   b.GitCreatedAt,
 		GitMergedAt:    b.GitMergedAt,
 		GitMergeCommit: b.GitMergeCommit,
+		GitPRURL:       b.GitPRURL,
+		GitPRState:     b.GitPRState,
+		StatusHistory:  b.StatusHistory,
+		Rank:           b.Rank,
+		Links:          b.Links,
+		ExternalIDs:    b.ExternalIDs,
+		Aliases:        b.Aliases,
+		SourceURL:      b.SourceURL,
 	}
 
 	fmBytes, err := yaml.Marshal(&fm)
@@ -268,14 +460,14 @@ func (b *Bean) Render() ([]byte, error) {
 	}
 	buf.Write(fmBytes)
 	buf.WriteString("---\n")
-	if b.Body != "" {
+	if body := strings.ReplaceAll(b.Body, "\r\n", "\n"); body != "" {
 		// Only add newline separator if body doesn't already start with one
-		if !strings.HasPrefix(b.Body, "\n") {
+		if !strings.HasPrefix(body, "\n") {
 			buf.WriteString("\n")
 		}
-		buf.WriteString(b.Body)
+		buf.WriteString(body)
 		// Ensure trailing newline if body doesn't end with one
-		if !strings.HasSuffix(b.Body, "\n") {
+		if !strings.HasSuffix(body, "\n") {
 			buf.WriteString("\n")
 		}
 	} else {
@@ -301,14 +493,159 @@ func (b *Bean) ETag() string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// RedactedValue replaces the values of masked fields in JSON output.
+const RedactedValue = "[redacted]"
+
+// fileFields lists the fields, by JSON name, that are stored in a bean's file.
+var fileFields = []string{
+	"title", "status", "type", "priority", "points", "tags", "created_at",
+	"updated_at", "body", "parent", "blocking", "blocked_by", "scope",
+	"git_branch", "git_created_at", "git_merged_at", "git_merge_commit",
+	"git_pr_url", "git_pr_state", "status_history", "rank", "links",
+	"external_ids", "aliases", "source_url",
+}
+
+// DerivedFields lists fields, by JSON name, whose values are derived from
+// other fields: the slug and the path it is part of are made from the title,
+// and the etag hashes the whole file. Redacting a source field redacts the
+// fields derived from it as well.
+var DerivedFields = map[string][]string{
+	"slug": {"title"},
+	"path": {"title"},
+	"etag": fileFields,
+}
+
+// Redaction lists fields, by JSON name, that are left out of a bean's JSON
+// (Exclude) or replaced with RedactedValue (Mask), for teams that pipe bean
+// data into third-party tools.
+type Redaction struct {
+	Exclude []string
+	Mask    []string
+}
+
+// IsEmpty returns true if nothing is redacted.
+func (r Redaction) IsEmpty() bool {
+	return len(r.Exclude) == 0 && len(r.Mask) == 0
+}
+
+// Encode writes v to w as indented JSON, applying the redaction to every bean
+// in it. The beans in v are copied, not changed, so encoding the same beans
+// with different redactions at the same time is safe.
+func (r Redaction) Encode(w io.Writer, v any) error {
+	if !r.IsEmpty() && v != nil {
+		v = r.apply(reflect.ValueOf(v)).Interface()
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+var beanType = reflect.TypeFor[Bean]()
+
+// apply returns a copy of v in which every bean carries the redaction.
+func (r Redaction) apply(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		if v.Type().Elem() == beanType {
+			c := *v.Interface().(*Bean)
+			c.redaction = &r
+			return reflect.ValueOf(&c)
+		}
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(r.apply(v.Elem()))
+		return p
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		return r.apply(v.Elem())
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range c.NumField() {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(r.apply(v.Field(i)))
+			}
+		}
+		return c
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		if v.Kind() == reflect.Slice {
+			c.Set(reflect.MakeSlice(v.Type(), v.Len(), v.Len()))
+		}
+		for i := range v.Len() {
+			c.Index(i).Set(r.apply(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), r.apply(iter.Value()))
+		}
+		return c
+	}
+	return v
+}
+
 // MarshalJSON implements json.Marshaler to include computed etag field.
 func (b *Bean) MarshalJSON() ([]byte, error) {
 	type BeanAlias Bean // Avoid infinite recursion
-	return json.Marshal(&struct {
+	data, err := json.Marshal(&struct {
 		*BeanAlias
 		ETag string `json:"etag"`
 	}{
 		BeanAlias: (*BeanAlias)(b),
 		ETag:      b.ETag(),
 	})
+	if err != nil || b.redaction == nil {
+		return data, err
+	}
+	return b.redaction.redactJSON(data)
+}
+
+// redactJSON applies the redaction to a marshaled bean.
+func (r Redaction) redactJSON(data []byte) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range fields {
+		if redactedBy(name, r.Exclude) {
+			delete(fields, name)
+			continue
+		}
+		if !redactedBy(name, r.Mask) {
+			continue
+		}
+		var items []json.RawMessage
+		if json.Unmarshal(value, &items) == nil {
+			masked := make([]string, len(items))
+			for i := range masked {
+				masked[i] = RedactedValue
+			}
+			fields[name], _ = json.Marshal(masked)
+		} else {
+			fields[name], _ = json.Marshal(RedactedValue)
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// redactedBy reports whether names lists the field or a field it is derived from.
+func redactedBy(field string, names []string) bool {
+	for _, name := range names {
+		if name == field || slices.Contains(DerivedFields[field], name) {
+			return true
+		}
+	}
+	return false
 }
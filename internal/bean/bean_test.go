@@ -1,7 +1,10 @@
 package bean
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -302,6 +305,48 @@ func TestPriorityRoundtrip(t *testing.T) {
 	}
 }
 
+func TestPointsRoundtrip(t *testing.T) {
+	zero, five := 0, 5
+	tests := []struct {
+		name   string
+		points *int
+	}{
+		{"unestimated", nil},
+		{"zero", &zero},
+		{"five", &five},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := &Bean{
+				Title:  "Test Bean",
+				Status: "todo",
+				Points: tt.points,
+			}
+
+			rendered, err := original.Render()
+			if err != nil {
+				t.Fatalf("Render() error: %v", err)
+			}
+			if tt.points == nil && strings.Contains(string(rendered), "points:") {
+				t.Errorf("Render() should not contain 'points:' when unestimated:\n%s", rendered)
+			}
+
+			parsed, err := Parse(strings.NewReader(string(rendered)))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+
+			if (parsed.Points == nil) != (tt.points == nil) {
+				t.Fatalf("Points roundtrip failed: got %v, want %v", parsed.Points, tt.points)
+			}
+			if tt.points != nil && *parsed.Points != *tt.points {
+				t.Errorf("Points roundtrip failed: got %d, want %d", *parsed.Points, *tt.points)
+			}
+		})
+	}
+}
+
 func TestRender(t *testing.T) {
 	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 
@@ -1070,7 +1115,12 @@ func TestValidateTag(t *testing.T) {
 		{"-tag", true},     // starts with hyphen
 		{"tag-", true},     // ends with hyphen
 		{"my.tag", true},   // contains dot
-		{"my/tag", true},   // contains slash
+		{"area/frontend", false},
+		{"area/frontend/forms", false},
+		{"/tag", true},     // starts with slash
+		{"tag/", true},     // ends with slash
+		{"area//ui", true}, // empty segment
+		{"area/1ui", true}, // segment starts with number
 	}
 
 	for _, tt := range tests {
@@ -1086,6 +1136,44 @@ func TestValidateTag(t *testing.T) {
 	}
 }
 
+func TestMatchTag(t *testing.T) {
+	tests := []struct {
+		pattern string
+		tag     string
+		want    bool
+	}{
+		{"frontend", "frontend", true},
+		{"frontend", "backend", false},
+		{"area/frontend", "area/frontend", true},
+		{"area/*", "area/frontend", true},
+		{"area/*", "area/frontend/forms", true},
+		{"area/*", "area", false},
+		{"area/*", "areas/frontend", false},
+		{"area", "area/frontend", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.tag, func(t *testing.T) {
+			if got := MatchTag(tt.pattern, tt.tag); got != tt.want {
+				t.Errorf("MatchTag(%q, %q) = %v, want %v", tt.pattern, tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagNamespace(t *testing.T) {
+	tests := map[string]string{
+		"frontend":            "",
+		"area/frontend":       "area",
+		"area/frontend/forms": "area/frontend",
+	}
+	for tag, want := range tests {
+		if got := TagNamespace(tag); got != want {
+			t.Errorf("TagNamespace(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
+
 func TestNormalizeTag(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1368,6 +1456,30 @@ func TestTagsRoundtrip(t *testing.T) {
 	}
 }
 
+func TestAliasesRoundtrip(t *testing.T) {
+	original := &Bean{
+		Title:   "Test",
+		Status:  "todo",
+		Aliases: []string{"JIRA-123", "GH#456"},
+	}
+
+	rendered, err := original.Render()
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(rendered), "aliases:\n") {
+		t.Errorf("rendered front matter has no aliases:\n%s", rendered)
+	}
+
+	parsed, err := Parse(strings.NewReader(string(rendered)))
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if !slices.Equal(parsed.Aliases, original.Aliases) {
+		t.Errorf("Aliases = %v, want %v", parsed.Aliases, original.Aliases)
+	}
+}
+
 func TestRenderWithIDComment(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1709,3 +1821,149 @@ func TestETagChangesAfterModification(t *testing.T) {
 		t.Error("JSON etag should differ after modification")
 	}
 }
+
+func TestTypedLinks(t *testing.T) {
+	b := &Bean{ID: "a"}
+
+	b.AddLink("duplicates", "x")
+	b.AddLink("duplicates", "x")
+	b.AddLink("related", "y")
+	if !b.HasLink("duplicates", "x") || !b.HasLink("related", "y") || b.HasLink("related", "x") {
+		t.Errorf("Links = %v", b.Links)
+	}
+	if len(b.Links["duplicates"]) != 1 {
+		t.Errorf("AddLink should not add duplicates: %v", b.Links["duplicates"])
+	}
+
+	b.RemoveLink("duplicates", "x")
+	if _, ok := b.Links["duplicates"]; ok {
+		t.Errorf("empty link type should be dropped: %v", b.Links)
+	}
+	b.RemoveLink("related", "y")
+	if b.Links != nil {
+		t.Errorf("Links = %v, want nil", b.Links)
+	}
+
+	// Round trip through front matter
+	b.Title = "Linked"
+	b.AddLink("supersedes", "old1")
+	out, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	parsed, err := Parse(strings.NewReader(string(out)))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !parsed.HasLink("supersedes", "old1") {
+		t.Errorf("parsed Links = %v, want supersedes old1", parsed.Links)
+	}
+}
+
+// encodeRedacted encodes b with the redaction and decodes the result.
+func encodeRedacted(t *testing.T, r Redaction, b *Bean) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := r.Encode(&buf, b); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestRedactionEncode(t *testing.T) {
+	b := &Bean{ID: "abc1", Title: "Customer ACME is down", Status: "todo", Body: "Contact: jane@acme.example", Tags: []string{"acme", "urgent"}}
+
+	got := encodeRedacted(t, Redaction{Exclude: []string{"body"}, Mask: []string{"title", "tags"}}, b)
+	if _, ok := got["body"]; ok {
+		t.Error("excluded body is still present")
+	}
+	if got["title"] != RedactedValue {
+		t.Errorf("title = %v, want %s", got["title"], RedactedValue)
+	}
+	if tags := got["tags"].([]any); len(tags) != 2 || tags[0] != RedactedValue {
+		t.Errorf("tags = %v, want two masked values", tags)
+	}
+	if got["id"] != "abc1" || got["status"] != "todo" {
+		t.Errorf("unredacted fields changed: %v", got)
+	}
+
+	// Redacting doesn't change the bean, or how it marshals without a redaction.
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if !strings.Contains(string(data), "jane@acme.example") || b.redaction != nil {
+		t.Errorf("plain JSON = %s, want the body unredacted", data)
+	}
+}
+
+func TestRedactionEncodeNested(t *testing.T) {
+	b := &Bean{ID: "abc1", Title: "Customer ACME is down", Status: "todo"}
+	data := struct {
+		Epic  *Bean            `json:"epic"`
+		Items []*Bean          `json:"items"`
+		ByID  map[string]*Bean `json:"by_id"`
+		Any   any              `json:"any"`
+	}{b, []*Bean{b}, map[string]*Bean{"abc1": b}, []*Bean{b}}
+
+	var buf bytes.Buffer
+	if err := (Redaction{Exclude: []string{"title"}}).Encode(&buf, data); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if strings.Contains(buf.String(), "ACME") {
+		t.Errorf("nested beans not redacted: %s", buf.String())
+	}
+	if strings.Count(buf.String(), `"abc1"`) != 5 {
+		t.Errorf("want every bean encoded, got %s", buf.String())
+	}
+}
+
+func TestRedactionEncodeDerivedFields(t *testing.T) {
+	b := &Bean{ID: "abc1", Slug: "customer-acme-is-down", Path: "abc1--customer-acme-is-down.md", Title: "Customer ACME is down", Status: "todo", Body: "Contact: jane@acme.example"}
+
+	got := encodeRedacted(t, Redaction{Exclude: []string{"title"}}, b)
+	for _, field := range []string{"title", "slug", "path", "etag"} {
+		if _, ok := got[field]; ok {
+			t.Errorf("%s is still present with title excluded", field)
+		}
+	}
+	if got["id"] != "abc1" {
+		t.Errorf("id = %v, want abc1", got["id"])
+	}
+
+	got = encodeRedacted(t, Redaction{Mask: []string{"body"}}, b)
+	if got["etag"] != RedactedValue {
+		t.Errorf("etag = %v, want %s with body masked", got["etag"], RedactedValue)
+	}
+}
+
+func TestParseCRLF(t *testing.T) {
+	lf := "---\ntitle: Windows\nstatus: todo\ntags:\n    - a\n---\n\nLine one\n\n## Notes\n- [ ] item\n"
+	crlf := strings.ReplaceAll(lf, "\n", "\r\n")
+
+	want, err := Parse(strings.NewReader(lf))
+	if err != nil {
+		t.Fatalf("Parse(LF) error = %v", err)
+	}
+	got, err := Parse(strings.NewReader(crlf))
+	if err != nil {
+		t.Fatalf("Parse(CRLF) error = %v", err)
+	}
+	if got.Body != want.Body || got.Title != want.Title || !reflect.DeepEqual(got.Tags, want.Tags) {
+		t.Errorf("Parse(CRLF) = %q %q %v, want %q %q %v", got.Title, got.Body, got.Tags, want.Title, want.Body, want.Tags)
+	}
+
+	// Bodies with CRLF (e.g. from --body-file on Windows) are written with LF
+	got.Body = "Line one\r\nLine two"
+	out, err := got.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(string(out), "\r") {
+		t.Errorf("Render() = %q, want LF line endings", out)
+	}
+}
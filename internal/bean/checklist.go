@@ -0,0 +1,130 @@
+package bean
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// checklistPattern matches a markdown task list item ("- [ ] Do it", "* [x] Done").
+var checklistPattern = regexp.MustCompile(`^(\s*[-*+]\s+\[)([ xX])(\]\s+)(.*)$`)
+
+// ChecklistItem is a markdown task list item in a bean's body.
+type ChecklistItem struct {
+	// Index is the 1-based position of the item among all items in the body.
+	Index int    `json:"index"`
+	Text  string `json:"text"`
+	Done  bool   `json:"done"`
+}
+
+// Checklist summarizes the task list items in a bean's body.
+type Checklist struct {
+	Done  int             `json:"done"`
+	Total int             `json:"total"`
+	Items []ChecklistItem `json:"items"`
+}
+
+// Checklist returns the task list items in the body, ignoring fenced code.
+func (b *Bean) Checklist() Checklist {
+	checklist := Checklist{Items: []ChecklistItem{}}
+	forEachChecklistLine(strings.Split(b.Body, "\n"), func(_ int, m []string) {
+		item := ChecklistItem{
+			Index: checklist.Total + 1,
+			Text:  strings.TrimSpace(m[4]),
+			Done:  m[2] != " ",
+		}
+		checklist.Items = append(checklist.Items, item)
+		checklist.Total++
+		if item.Done {
+			checklist.Done++
+		}
+	})
+	return checklist
+}
+
+// SetChecklistItem checks or unchecks the task list item with the given
+// 1-based index.
+func (b *Bean) SetChecklistItem(index int, done bool) error {
+	lines := strings.Split(b.Body, "\n")
+	found := false
+	n := 0
+	forEachChecklistLine(lines, func(i int, m []string) {
+		n++
+		if n != index {
+			return
+		}
+		mark := " "
+		if done {
+			mark = "x"
+		}
+		lines[i] = m[1] + mark + m[3] + m[4]
+		found = true
+	})
+	if !found {
+		return fmt.Errorf("checklist item %d not found (bean has %d)", index, n)
+	}
+	b.Body = strings.Join(lines, "\n")
+	return nil
+}
+
+// ToggleChecklistItem flips the task list item with the given 1-based index,
+// returning its new state.
+func (b *Bean) ToggleChecklistItem(index int) (bool, error) {
+	items := b.Checklist().Items
+	if index < 1 || index > len(items) {
+		return false, fmt.Errorf("checklist item %d not found (bean has %d)", index, len(items))
+	}
+	done := !items[index-1].Done
+	return done, b.SetChecklistItem(index, done)
+}
+
+// RemoveChecklistItems removes the task list items with the given 1-based
+// indexes from the body.
+func (b *Bean) RemoveChecklistItems(indexes []int) error {
+	remove := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		remove[index] = true
+	}
+	lines := strings.Split(b.Body, "\n")
+	drop := make(map[int]bool, len(indexes))
+	n := 0
+	forEachChecklistLine(lines, func(i int, _ []string) {
+		n++
+		if remove[n] {
+			drop[i] = true
+		}
+	})
+	for _, index := range indexes {
+		if index < 1 || index > n {
+			return fmt.Errorf("checklist item %d not found (bean has %d)", index, n)
+		}
+	}
+
+	result := make([]string, 0, len(lines)-len(drop))
+	for i, line := range lines {
+		if !drop[i] {
+			result = append(result, line)
+		}
+	}
+	b.Body = strings.Join(result, "\n")
+	return nil
+}
+
+// forEachChecklistLine calls fn with the line index and pattern submatches of
+// every task list item outside fenced code blocks.
+func forEachChecklistLine(lines []string, fn func(i int, m []string)) {
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := checklistPattern.FindStringSubmatch(line); m != nil {
+			fn(i, m)
+		}
+	}
+}
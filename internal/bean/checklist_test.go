@@ -0,0 +1,76 @@
+package bean
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChecklist(t *testing.T) {
+	b := &Bean{Body: "## Acceptance Criteria\n\n- [ ] First\n- [x] Second\n  * [X] Nested\n- [] Not an item\n\n```\n- [ ] In code\n```\n+ [ ] Last"}
+
+	got := b.Checklist()
+	want := Checklist{
+		Done:  2,
+		Total: 4,
+		Items: []ChecklistItem{
+			{Index: 1, Text: "First"},
+			{Index: 2, Text: "Second", Done: true},
+			{Index: 3, Text: "Nested", Done: true},
+			{Index: 4, Text: "Last"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Checklist() = %+v, want %+v", got, want)
+	}
+
+	empty := (&Bean{Body: "No items"}).Checklist()
+	if empty.Total != 0 || empty.Items == nil {
+		t.Errorf("Checklist() of body without items = %+v, want empty non-nil items", empty)
+	}
+}
+
+func TestToggleChecklistItem(t *testing.T) {
+	b := &Bean{Body: "- [ ] First\n  * [X] Nested\n\n```\n- [ ] In code\n```\n- [ ] Last\n"}
+
+	done, err := b.ToggleChecklistItem(1)
+	if err != nil || !done {
+		t.Fatalf("ToggleChecklistItem(1) = %v, %v; want true, nil", done, err)
+	}
+	done, err = b.ToggleChecklistItem(2)
+	if err != nil || done {
+		t.Fatalf("ToggleChecklistItem(2) = %v, %v; want false, nil", done, err)
+	}
+	if err := b.SetChecklistItem(3, true); err != nil {
+		t.Fatalf("SetChecklistItem(3) error = %v", err)
+	}
+
+	want := "- [x] First\n  * [ ] Nested\n\n```\n- [ ] In code\n```\n- [x] Last\n"
+	if b.Body != want {
+		t.Errorf("Body = %q, want %q", b.Body, want)
+	}
+
+	for _, index := range []int{0, 4} {
+		if _, err := b.ToggleChecklistItem(index); err == nil {
+			t.Errorf("ToggleChecklistItem(%d) should fail", index)
+		}
+	}
+}
+
+func TestRemoveChecklistItems(t *testing.T) {
+	b := &Bean{Body: "Todo:\n- [ ] First\n- [x] Second\n\n```\n- [ ] In code\n```\n- [ ] Last\n"}
+
+	if err := b.RemoveChecklistItems([]int{1, 3}); err != nil {
+		t.Fatalf("RemoveChecklistItems() error = %v", err)
+	}
+	want := "Todo:\n- [x] Second\n\n```\n- [ ] In code\n```\n"
+	if b.Body != want {
+		t.Errorf("Body = %q, want %q", b.Body, want)
+	}
+
+	if err := b.RemoveChecklistItems([]int{2}); err == nil {
+		t.Error("RemoveChecklistItems(2) should fail")
+	}
+	if b.Body != want {
+		t.Errorf("Body changed by failed removal: %q", b.Body)
+	}
+}
@@ -0,0 +1,105 @@
+package bean
+
+import "time"
+
+// StatusChange records a bean entering a status.
+type StatusChange struct {
+	Status    string    `yaml:"status" json:"status"`
+	ChangedAt time.Time `yaml:"changed_at" json:"changed_at"`
+}
+
+// RecordStatusChange appends a status change to the bean's history.
+// Nothing is recorded if the bean was already in the given status.
+func (b *Bean) RecordStatusChange(status string, at time.Time) {
+	if n := len(b.StatusHistory); n > 0 && b.StatusHistory[n-1].Status == status {
+		return
+	}
+	b.StatusHistory = append(b.StatusHistory, StatusChange{Status: status, ChangedAt: at.UTC()})
+}
+
+// TimeInStatus returns the total time the bean has spent in each status
+// according to its history. The current status accrues time until now.
+func (b *Bean) TimeInStatus(now time.Time) map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for i, c := range b.StatusHistory {
+		end := now
+		if i+1 < len(b.StatusHistory) {
+			end = b.StatusHistory[i+1].ChangedAt
+		}
+		if end.After(c.ChangedAt) {
+			durations[c.Status] += end.Sub(c.ChangedAt)
+		}
+	}
+	return durations
+}
+
+// StatusSince returns when the bean entered its current status: the last
+// matching status history entry, falling back to its creation time. The
+// second return value is false if neither is known.
+func (b *Bean) StatusSince() (time.Time, bool) {
+	if n := len(b.StatusHistory); n > 0 && b.StatusHistory[n-1].Status == b.Status {
+		return b.StatusHistory[n-1].ChangedAt, true
+	}
+	if b.CreatedAt != nil {
+		return *b.CreatedAt, true
+	}
+	return time.Time{}, false
+}
+
+// CycleTime returns the time from when work first started on the bean
+// (it entered in-progress) until it was last completed. The second return
+// value is false if the bean is not completed or never was in progress.
+func (b *Bean) CycleTime() (time.Duration, bool) {
+	if b.Status != "completed" {
+		return 0, false
+	}
+	var started, completed *time.Time
+	for i := range b.StatusHistory {
+		c := &b.StatusHistory[i]
+		switch c.Status {
+		case "in-progress":
+			if started == nil {
+				started = &c.ChangedAt
+			}
+		case "completed":
+			completed = &c.ChangedAt
+		}
+	}
+	if started == nil || completed == nil || completed.Before(*started) {
+		return 0, false
+	}
+	return completed.Sub(*started), true
+}
+
+// BranchCycleTime returns the time from when the bean's git branch was
+// created (git_created_at) until its merge was detected (git_merged_at). The
+// second return value is false unless both are recorded.
+func (b *Bean) BranchCycleTime() (time.Duration, bool) {
+	if b.GitCreatedAt == nil || b.GitMergedAt == nil || b.GitMergedAt.Before(*b.GitCreatedAt) {
+		return 0, false
+	}
+	return b.GitMergedAt.Sub(*b.GitCreatedAt), true
+}
+
+// MergeStatusHistory merges two status histories into one ordered by time,
+// dropping entries that appear in both.
+func MergeStatusHistory(a, b []StatusChange) []StatusChange {
+	var result []StatusChange
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var next StatusChange
+		switch {
+		case j >= len(b) || (i < len(a) && !b[j].ChangedAt.Before(a[i].ChangedAt)):
+			next = a[i]
+			i++
+		default:
+			next = b[j]
+			j++
+		}
+		if n := len(result); n > 0 && result[n-1].Status == next.Status && result[n-1].ChangedAt.Equal(next.ChangedAt) {
+			continue
+		}
+		result = append(result, next)
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package bean
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRecordStatusChange(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	b := &Bean{}
+	b.RecordStatusChange("todo", t1)
+	b.RecordStatusChange("todo", t2)
+	b.RecordStatusChange("in-progress", t2)
+
+	want := []StatusChange{{"todo", t1}, {"in-progress", t2}}
+	if !reflect.DeepEqual(b.StatusHistory, want) {
+		t.Errorf("StatusHistory = %v, want %v", b.StatusHistory, want)
+	}
+}
+
+func TestTimeInStatus(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &Bean{StatusHistory: []StatusChange{
+		{"todo", start},
+		{"in-progress", start.Add(2 * time.Hour)},
+		{"todo", start.Add(3 * time.Hour)},
+		{"in-progress", start.Add(4 * time.Hour)},
+	}}
+
+	got := b.TimeInStatus(start.Add(10 * time.Hour))
+	want := map[string]time.Duration{
+		"todo":        3 * time.Hour,
+		"in-progress": 7 * time.Hour,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TimeInStatus() = %v, want %v", got, want)
+	}
+}
+
+func TestCycleTime(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := func(n int) time.Time { return start.Add(time.Duration(n) * time.Hour) }
+
+	tests := []struct {
+		name    string
+		status  string
+		history []StatusChange
+		want    time.Duration
+		wantOK  bool
+	}{
+		{
+			name:    "started and completed",
+			status:  "completed",
+			history: []StatusChange{{"todo", h(0)}, {"in-progress", h(1)}, {"completed", h(5)}},
+			want:    4 * time.Hour,
+			wantOK:  true,
+		},
+		{
+			name:    "reopened uses first start and last completion",
+			status:  "completed",
+			history: []StatusChange{{"in-progress", h(1)}, {"completed", h(2)}, {"in-progress", h(3)}, {"completed", h(6)}},
+			want:    5 * time.Hour,
+			wantOK:  true,
+		},
+		{
+			name:    "not completed",
+			status:  "in-progress",
+			history: []StatusChange{{"todo", h(0)}, {"in-progress", h(1)}},
+		},
+		{
+			name:    "never in progress",
+			status:  "completed",
+			history: []StatusChange{{"todo", h(0)}, {"completed", h(1)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bean{Status: tt.status, StatusHistory: tt.history}
+			got, ok := b.CycleTime()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("CycleTime() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBranchCycleTime(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	merged := created.Add(30 * time.Hour)
+
+	b := &Bean{GitCreatedAt: &created, GitMergedAt: &merged}
+	if got, ok := b.BranchCycleTime(); !ok || got != 30*time.Hour {
+		t.Errorf("BranchCycleTime() = %v, %v; want 30h, true", got, ok)
+	}
+
+	// Not merged, or merged before the (recreated) branch was created
+	for _, b := range []*Bean{{GitCreatedAt: &created}, {GitCreatedAt: &merged, GitMergedAt: &created}} {
+		if _, ok := b.BranchCycleTime(); ok {
+			t.Errorf("BranchCycleTime() ok for %+v, want false", b)
+		}
+	}
+}
+
+func TestStatusHistoryRoundtrip(t *testing.T) {
+	changed := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := &Bean{Title: "Test", Status: "todo", StatusHistory: []StatusChange{{"todo", changed}}}
+
+	content, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !bytes.Contains(content, []byte("status_history:")) {
+		t.Errorf("rendered content missing status_history:\n%s", content)
+	}
+
+	parsed, err := Parse(bytes.NewReader(content))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(parsed.StatusHistory, b.StatusHistory) {
+		t.Errorf("StatusHistory = %v, want %v", parsed.StatusHistory, b.StatusHistory)
+	}
+}
@@ -1,8 +1,11 @@
 package bean
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	gonanoid "github.com/matoous/go-nanoid/v2"
@@ -19,6 +22,32 @@ func NewID(prefix string, length int) string {
 	return prefix + id
 }
 
+// NewSequentialID returns the ID following the highest numeric ID with the given
+// prefix among existing, zero-padded to width (e.g. "beans-0042").
+func NewSequentialID(prefix string, width int, existing []string) string {
+	next := 1
+	for _, id := range existing {
+		rest, ok := strings.CutPrefix(id, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		n, err := strconv.Atoi(rest)
+		if err != nil || n < 0 || strings.ContainsAny(rest, "+-") {
+			continue
+		}
+		if n >= next {
+			next = n + 1
+		}
+	}
+	return fmt.Sprintf("%s%0*d", prefix, width, next)
+}
+
+// NewDateID generates an ID from the given date (as YYMMDD) followed by random
+// characters of the given length, e.g. "beans-261015x7k2".
+func NewDateID(prefix string, length int, date time.Time) string {
+	return NewID(prefix+date.Format("060102"), length)
+}
+
 // ParseFilename extracts the ID and optional slug from a bean filename.
 // Supports multiple formats for backward compatibility:
 //   - New format: "f7g--user-registration.md" -> ("f7g", "user-registration")
@@ -3,6 +3,7 @@ package bean
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestSlugify(t *testing.T) {
@@ -156,6 +157,42 @@ func TestNewID(t *testing.T) {
 	})
 }
 
+func TestNewSequentialID(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		width    int
+		existing []string
+		want     string
+	}{
+		{"first ID", "beans-", 4, nil, "beans-0001"},
+		{"follows highest", "beans-", 4, []string{"beans-0001", "beans-0007", "beans-0003"}, "beans-0008"},
+		{"ignores random IDs", "beans-", 4, []string{"beans-0002", "beans-x7k2", "beans-"}, "beans-0003"},
+		{"ignores other prefixes", "beans-alice-", 3, []string{"beans-bob-041", "beans-alice-002"}, "beans-alice-003"},
+		{"ignores signed numbers", "beans-", 4, []string{"beans-+900", "beans--900"}, "beans-0001"},
+		{"grows past width", "", 2, []string{"99"}, "100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSequentialID(tt.prefix, tt.width, tt.existing)
+			if got != tt.want {
+				t.Errorf("NewSequentialID(%q, %d, %v) = %q, want %q", tt.prefix, tt.width, tt.existing, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDateID(t *testing.T) {
+	id := NewDateID("beans-", 4, time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC))
+	if !strings.HasPrefix(id, "beans-261015") {
+		t.Errorf("NewDateID() = %q, want prefix %q", id, "beans-261015")
+	}
+	if len(id) != len("beans-261015")+4 {
+		t.Errorf("NewDateID() = %q, want 4 random characters after the date", id)
+	}
+}
+
 func TestParseFilenameAndBuildFilenameRoundtrip(t *testing.T) {
 	tests := []struct {
 		name string
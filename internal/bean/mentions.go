@@ -0,0 +1,104 @@
+package bean
+
+import (
+	"regexp"
+	"strings"
+)
+
+// mentionPattern matches references to other beans in a body: [[id]] or #id.
+// A # reference must not follow a word character, #, & or / (so headings,
+// URL fragments and HTML entities aren't taken for mentions) and must end
+// in a letter or digit (so trailing punctuation isn't part of it).
+var mentionPattern = regexp.MustCompile(`\[\[\s*([A-Za-z0-9][\w.-]*)\s*\]\]|(?:^|[^\w#&/])#([A-Za-z0-9](?:[\w.-]*[A-Za-z0-9])?)`)
+
+// inlineCodePattern matches inline code spans, which can't contain mentions.
+var inlineCodePattern = regexp.MustCompile("`+[^`]*`+")
+
+// mention is a reference to another bean on a line of a body.
+type mention struct {
+	start, end int    // byte offsets of the reference, including [[ ]] or #
+	ref        string // the referenced ID, as written
+}
+
+// lineMentions returns the mentions on a line, skipping inline code.
+func lineMentions(line string) []mention {
+	code := inlineCodePattern.FindAllStringIndex(line, -1)
+	var mentions []mention
+	for _, m := range mentionPattern.FindAllStringSubmatchIndex(line, -1) {
+		mt := mention{start: m[0], end: m[1]}
+		if m[2] >= 0 {
+			mt.ref = line[m[2]:m[3]]
+		} else {
+			mt.start = m[4] - 1 // the #
+			mt.ref = line[m[4]:m[5]]
+		}
+		inCode := false
+		for _, span := range code {
+			if mt.start < span[1] && mt.end > span[0] {
+				inCode = true
+				break
+			}
+		}
+		if !inCode {
+			mentions = append(mentions, mt)
+		}
+	}
+	return mentions
+}
+
+// forEachMentionLine calls fn with the line index and mentions of every
+// line outside fenced code blocks that mentions another bean.
+func forEachMentionLine(lines []string, fn func(i int, mentions []mention)) {
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if mentions := lineMentions(line); len(mentions) > 0 {
+			fn(i, mentions)
+		}
+	}
+}
+
+// MentionRefs returns the bean references in the body ([[id]] or #id),
+// in order of first appearance and without duplicates. References in code
+// are ignored. They aren't resolved: a reference may use a full or short ID,
+// or not name a bean at all (e.g. "#123" for an issue).
+func (b *Bean) MentionRefs() []string {
+	var refs []string
+	seen := make(map[string]bool)
+	forEachMentionLine(strings.Split(b.Body, "\n"), func(_ int, mentions []mention) {
+		for _, m := range mentions {
+			if !seen[m.ref] {
+				seen[m.ref] = true
+				refs = append(refs, m.ref)
+			}
+		}
+	})
+	return refs
+}
+
+// ReplaceMentions returns body with every bean reference replaced by what
+// replace returns for it, given the reference as written ("[[abc1]]" or
+// "#abc1") and the ID it names ("abc1"). References in code are left alone.
+func ReplaceMentions(body string, replace func(text, ref string) string) string {
+	lines := strings.Split(body, "\n")
+	forEachMentionLine(lines, func(i int, mentions []mention) {
+		line := lines[i]
+		var sb strings.Builder
+		last := 0
+		for _, m := range mentions {
+			sb.WriteString(line[last:m.start])
+			sb.WriteString(replace(line[m.start:m.end], m.ref))
+			last = m.end
+		}
+		sb.WriteString(line[last:])
+		lines[i] = sb.String()
+	})
+	return strings.Join(lines, "\n")
+}
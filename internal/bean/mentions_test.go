@@ -0,0 +1,48 @@
+package bean
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMentionRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"none", "Nothing to see here.", nil},
+		{"wiki style", "Depends on [[abc1]] and [[ beans-xyz9 ]].", []string{"abc1", "beans-xyz9"}},
+		{"hash style", "#abc1 fixes what #xyz9, and (#q2w3) broke.", []string{"abc1", "xyz9", "q2w3"}},
+		{"deduplicated", "[[abc1]], #abc1 and [[abc1]] again", []string{"abc1"}},
+		{"headings are not mentions", "# Heading\n## Another", nil},
+		{"URL fragments and entities are not mentions", "See http://x.test/page#section and &#123; or a#b", nil},
+		{"trailing punctuation", "Done in #abc1.", []string{"abc1"}},
+		{"dotted IDs", "See [[tmp.x1-abc1]] and #tmp.x1-xyz9.", []string{"tmp.x1-abc1", "tmp.x1-xyz9"}},
+		{"inline code", "Run `grep #abc1` but see [[xyz9]]", []string{"xyz9"}},
+		{"fenced code", "```\n[[abc1]]\n```\n~~~\n#def2\n~~~\n#xyz9", []string{"xyz9"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bean{Body: tt.body}
+			if got := b.MentionRefs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MentionRefs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceMentions(t *testing.T) {
+	body := "See [[abc1]] and #abc1, not `#abc1`.\n```\n[[abc1]]\n```\nAlso #xyz9"
+	got := ReplaceMentions(body, func(text, ref string) string {
+		if ref != "abc1" {
+			return text
+		}
+		return "<" + text + ">"
+	})
+	want := "See <[[abc1]]> and <#abc1>, not `#abc1`.\n```\n[[abc1]]\n```\nAlso #xyz9"
+	if got != want {
+		t.Errorf("ReplaceMentions() = %q, want %q", got, want)
+	}
+}
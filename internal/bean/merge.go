@@ -0,0 +1,177 @@
+package bean
+
+import "time"
+
+// MergeFrontMatter performs a three-way merge of the front matter of two
+// versions of a bean (ours and theirs) that diverged from a common base.
+// The body is taken from ours; body merging is left to the caller.
+//
+// Conflicts are resolved without manual intervention:
+//   - Fields changed on one side only take that side's value.
+//   - Fields changed differently on both sides take the value from the side
+//     that was updated most recently (ours on ties).
+//   - Tags and relationships are merged as sets: additions from both sides
+//     are kept, and entries removed on either side are dropped.
+//   - Status histories are combined in chronological order.
+//   - created_at and git_created_at keep the earliest time, updated_at and
+//     git_merged_at the latest.
+//
+// A nil base is treated as an empty bean (e.g. the file was added on both sides).
+func MergeFrontMatter(base, ours, theirs *Bean) *Bean {
+	if base == nil {
+		base = &Bean{}
+	}
+	preferTheirs := ours.UpdatedAt != nil && theirs.UpdatedAt != nil && theirs.UpdatedAt.After(*ours.UpdatedAt)
+
+	merged := *ours
+	merged.Title = mergeScalar(base.Title, ours.Title, theirs.Title, preferTheirs)
+	merged.Status = mergeScalar(base.Status, ours.Status, theirs.Status, preferTheirs)
+	merged.Type = mergeScalar(base.Type, ours.Type, theirs.Type, preferTheirs)
+	merged.Priority = mergeScalar(base.Priority, ours.Priority, theirs.Priority, preferTheirs)
+	merged.Points = mergePoints(base.Points, ours.Points, theirs.Points, preferTheirs)
+	merged.Parent = mergeScalar(base.Parent, ours.Parent, theirs.Parent, preferTheirs)
+	merged.Scope = mergeScalar(base.Scope, ours.Scope, theirs.Scope, preferTheirs)
+	merged.GitBranch = mergeScalar(base.GitBranch, ours.GitBranch, theirs.GitBranch, preferTheirs)
+	merged.GitMergeCommit = mergeScalar(base.GitMergeCommit, ours.GitMergeCommit, theirs.GitMergeCommit, preferTheirs)
+	merged.GitPRURL = mergeScalar(base.GitPRURL, ours.GitPRURL, theirs.GitPRURL, preferTheirs)
+	merged.GitPRState = mergeScalar(base.GitPRState, ours.GitPRState, theirs.GitPRState, preferTheirs)
+	merged.Rank = mergeScalar(base.Rank, ours.Rank, theirs.Rank, preferTheirs)
+	merged.Draft = mergeScalar(base.Draft, ours.Draft, theirs.Draft, preferTheirs)
+	merged.SourceURL = mergeScalar(base.SourceURL, ours.SourceURL, theirs.SourceURL, preferTheirs)
+
+	merged.Tags = mergeSet(base.Tags, ours.Tags, theirs.Tags)
+	merged.Aliases = mergeSet(base.Aliases, ours.Aliases, theirs.Aliases)
+	merged.Blocking = mergeSet(base.Blocking, ours.Blocking, theirs.Blocking)
+	merged.BlockedBy = mergeSet(base.BlockedBy, ours.BlockedBy, theirs.BlockedBy)
+	merged.Links = mergeLinks(base.Links, ours.Links, theirs.Links)
+	merged.ExternalIDs = mergeExternalIDs(base.ExternalIDs, ours.ExternalIDs, theirs.ExternalIDs, preferTheirs)
+	merged.StatusHistory = MergeStatusHistory(ours.StatusHistory, theirs.StatusHistory)
+
+	merged.CreatedAt = earliest(ours.CreatedAt, theirs.CreatedAt)
+	merged.UpdatedAt = latest(ours.UpdatedAt, theirs.UpdatedAt)
+	merged.GitCreatedAt = earliest(ours.GitCreatedAt, theirs.GitCreatedAt)
+	merged.GitMergedAt = latest(ours.GitMergedAt, theirs.GitMergedAt)
+
+	return &merged
+}
+
+// mergeScalar three-way merges a single value.
+func mergeScalar[T comparable](base, ours, theirs T, preferTheirs bool) T {
+	switch {
+	case ours == theirs, theirs == base:
+		return ours
+	case ours == base:
+		return theirs
+	case preferTheirs:
+		return theirs
+	default:
+		return ours
+	}
+}
+
+// mergePoints three-way merges an optional point estimate.
+func mergePoints(base, ours, theirs *int, preferTheirs bool) *int {
+	eq := func(a, b *int) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+	}
+	switch {
+	case eq(ours, theirs), eq(theirs, base):
+		return ours
+	case eq(ours, base):
+		return theirs
+	case preferTheirs:
+		return theirs
+	default:
+		return ours
+	}
+}
+
+// mergeSet three-way merges a list of unique values, preserving the order of
+// ours followed by additions from theirs.
+func mergeSet(base, ours, theirs []string) []string {
+	inBase := toSet(base)
+	inOurs := toSet(ours)
+	inTheirs := toSet(theirs)
+
+	var result []string
+	seen := make(map[string]bool)
+	for _, list := range [][]string{ours, theirs} {
+		for _, v := range list {
+			if seen[v] {
+				continue
+			}
+			// Removed on one side (present in base, missing on that side)
+			if inBase[v] && (!inOurs[v] || !inTheirs[v]) {
+				continue
+			}
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// mergeExternalIDs three-way merges external IDs, merging each tracker's ID
+// as a single value.
+func mergeExternalIDs(base, ours, theirs map[string]string, preferTheirs bool) map[string]string {
+	var result map[string]string
+	for _, ids := range []map[string]string{ours, theirs} {
+		for tracker := range ids {
+			if _, done := result[tracker]; done {
+				continue
+			}
+			if id := mergeScalar(base[tracker], ours[tracker], theirs[tracker], preferTheirs); id != "" {
+				if result == nil {
+					result = make(map[string]string)
+				}
+				result[tracker] = id
+			}
+		}
+	}
+	return result
+}
+
+// mergeLinks three-way merges typed links, merging each link type as a set.
+func mergeLinks(base, ours, theirs map[string][]string) map[string][]string {
+	var result map[string][]string
+	for _, links := range []map[string][]string{ours, theirs} {
+		for linkType := range links {
+			if _, done := result[linkType]; done {
+				continue
+			}
+			merged := mergeSet(base[linkType], ours[linkType], theirs[linkType])
+			if len(merged) == 0 {
+				continue
+			}
+			if result == nil {
+				result = make(map[string][]string)
+			}
+			result[linkType] = merged
+		}
+	}
+	return result
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// earliest returns the earlier of two optional times.
+func earliest(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.Before(*a)) {
+		return b
+	}
+	return a
+}
+
+// latest returns the later of two optional times.
+func latest(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,151 @@
+package bean
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMergeFrontMatter(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	one, two, three := 1, 2, 3
+
+	tests := []struct {
+		name   string
+		base   *Bean
+		ours   *Bean
+		theirs *Bean
+		check  func(t *testing.T, m *Bean)
+	}{
+		{
+			name:   "changes on different fields are combined",
+			base:   &Bean{Title: "A", Status: "todo", Priority: "normal"},
+			ours:   &Bean{Title: "A", Status: "in-progress", Priority: "normal"},
+			theirs: &Bean{Title: "A", Status: "todo", Priority: "high"},
+			check: func(t *testing.T, m *Bean) {
+				if m.Status != "in-progress" || m.Priority != "high" {
+					t.Errorf("got status %q, priority %q; want in-progress, high", m.Status, m.Priority)
+				}
+			},
+		},
+		{
+			name:   "both changed: most recently updated wins",
+			base:   &Bean{Title: "A", Status: "todo", UpdatedAt: &t1},
+			ours:   &Bean{Title: "Ours", Status: "completed", UpdatedAt: &t2},
+			theirs: &Bean{Title: "Theirs", Status: "scrapped", UpdatedAt: &t3},
+			check: func(t *testing.T, m *Bean) {
+				if m.Title != "Theirs" || m.Status != "scrapped" {
+					t.Errorf("got %q/%q, want theirs", m.Title, m.Status)
+				}
+				if !m.UpdatedAt.Equal(t3) {
+					t.Errorf("UpdatedAt = %v, want newest %v", m.UpdatedAt, t3)
+				}
+			},
+		},
+		{
+			name:   "both changed, no timestamps: ours wins",
+			base:   &Bean{Title: "A", Points: &one},
+			ours:   &Bean{Title: "Ours", Points: &two},
+			theirs: &Bean{Title: "Theirs", Points: &three},
+			check: func(t *testing.T, m *Bean) {
+				if m.Title != "Ours" || *m.Points != 2 {
+					t.Errorf("got %q/%d, want ours", m.Title, *m.Points)
+				}
+			},
+		},
+		{
+			name:   "points cleared on one side",
+			base:   &Bean{Title: "A", Points: &one},
+			ours:   &Bean{Title: "A", Points: &one},
+			theirs: &Bean{Title: "A"},
+			check: func(t *testing.T, m *Bean) {
+				if m.Points != nil {
+					t.Errorf("Points = %d, want cleared", *m.Points)
+				}
+			},
+		},
+		{
+			name:   "tags and links: union of additions, removals honored",
+			base:   &Bean{Tags: []string{"a", "b"}, Blocking: []string{"x"}},
+			ours:   &Bean{Tags: []string{"a", "b", "c"}, Blocking: []string{"x", "y"}},
+			theirs: &Bean{Tags: []string{"b", "d"}, Blocking: []string{"x", "z"}, BlockedBy: []string{"w"}},
+			check: func(t *testing.T, m *Bean) {
+				if want := []string{"b", "c", "d"}; !reflect.DeepEqual(m.Tags, want) {
+					t.Errorf("Tags = %v, want %v", m.Tags, want)
+				}
+				if want := []string{"x", "y", "z"}; !reflect.DeepEqual(m.Blocking, want) {
+					t.Errorf("Blocking = %v, want %v", m.Blocking, want)
+				}
+				if want := []string{"w"}; !reflect.DeepEqual(m.BlockedBy, want) {
+					t.Errorf("BlockedBy = %v, want %v", m.BlockedBy, want)
+				}
+			},
+		},
+		{
+			name:   "timestamps: earliest creation, latest merge",
+			base:   &Bean{},
+			ours:   &Bean{CreatedAt: &t2, GitCreatedAt: &t2, GitMergedAt: &t1},
+			theirs: &Bean{CreatedAt: &t1, GitMergedAt: &t3},
+			check: func(t *testing.T, m *Bean) {
+				if !m.CreatedAt.Equal(t1) || !m.GitCreatedAt.Equal(t2) || !m.GitMergedAt.Equal(t3) {
+					t.Errorf("got created %v, git created %v, merged %v", m.CreatedAt, m.GitCreatedAt, m.GitMergedAt)
+				}
+			},
+		},
+		{
+			name:   "status histories are combined chronologically",
+			base:   &Bean{StatusHistory: []StatusChange{{Status: "todo", ChangedAt: t1}}},
+			ours:   &Bean{StatusHistory: []StatusChange{{Status: "todo", ChangedAt: t1}, {Status: "in-progress", ChangedAt: t3}}},
+			theirs: &Bean{StatusHistory: []StatusChange{{Status: "todo", ChangedAt: t1}, {Status: "draft", ChangedAt: t2}}},
+			check: func(t *testing.T, m *Bean) {
+				want := []StatusChange{{"todo", t1}, {"draft", t2}, {"in-progress", t3}}
+				if !reflect.DeepEqual(m.StatusHistory, want) {
+					t.Errorf("StatusHistory = %v, want %v", m.StatusHistory, want)
+				}
+			},
+		},
+		{
+			name:   "typed links merged per link type",
+			base:   &Bean{Links: map[string][]string{"related": {"x", "y"}}},
+			ours:   &Bean{Links: map[string][]string{"related": {"x"}, "duplicates": {"d"}}},
+			theirs: &Bean{Links: map[string][]string{"related": {"x", "y", "z"}}},
+			check: func(t *testing.T, m *Bean) {
+				want := map[string][]string{"related": {"x", "z"}, "duplicates": {"d"}}
+				if !reflect.DeepEqual(m.Links, want) {
+					t.Errorf("Links = %v, want %v", m.Links, want)
+				}
+			},
+		},
+		{
+			name:   "external IDs merged per tracker",
+			base:   &Bean{ExternalIDs: map[string]string{"taskwarrior": "a", "jira": "J-1"}},
+			ours:   &Bean{ExternalIDs: map[string]string{"taskwarrior": "a"}},
+			theirs: &Bean{ExternalIDs: map[string]string{"taskwarrior": "b", "jira": "J-1", "github": "7"}},
+			check: func(t *testing.T, m *Bean) {
+				want := map[string]string{"taskwarrior": "b", "github": "7"}
+				if !reflect.DeepEqual(m.ExternalIDs, want) {
+					t.Errorf("ExternalIDs = %v, want %v", m.ExternalIDs, want)
+				}
+			},
+		},
+		{
+			name:   "nil base (added on both sides)",
+			base:   nil,
+			ours:   &Bean{Title: "Same", Tags: []string{"a"}},
+			theirs: &Bean{Title: "Same", Tags: []string{"b"}},
+			check: func(t *testing.T, m *Bean) {
+				if m.Title != "Same" || !reflect.DeepEqual(m.Tags, []string{"a", "b"}) {
+					t.Errorf("got %q %v", m.Title, m.Tags)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, MergeFrontMatter(tt.base, tt.ours, tt.theirs))
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package bean
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Migration upgrades bean front matter from the previous format version to
+// Version. Apply edits the front matter mapping in place and describes each
+// change it made.
+type Migration struct {
+	Version     int
+	Description string
+	Apply       func(fm *yaml.Node) []string
+}
+
+// Migrations lists the file format migrations, oldest first. Projects record
+// the version their files are in as format_version in .beans.yml; projects
+// without one are at version 0.
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "rename legacy statuses and turn epic/feature/milestone fields into parent links",
+		Apply:       migrateLegacyFields,
+	},
+}
+
+// CurrentFormatVersion is the version the last migration upgrades to, and
+// the one new projects start at.
+const CurrentFormatVersion = 1
+
+// Migrate applies the migrations after version from up to and including
+// version to to a bean file. It returns the migrated file and the changes
+// made; if there are none, the file is returned unchanged. The body is never
+// touched.
+func Migrate(content []byte, from, to int) ([]byte, []string, error) {
+	return rewriteFrontMatter(content, func(fm *yaml.Node) []string {
+		var changes []string
+		for _, m := range Migrations {
+			if m.Version > from && m.Version <= to {
+				changes = append(changes, m.Apply(fm)...)
+			}
+		}
+		return changes
+	})
+}
+
+// rewriteFrontMatter lets edit change the front matter mapping of a bean
+// file in place. It returns the file with the edited front matter and the
+// changes edit reports; if there are none, the file is returned unchanged.
+func rewriteFrontMatter(content []byte, edit func(fm *yaml.Node) []string) ([]byte, []string, error) {
+	fmBytes, _, ok := extractFrontMatter(content)
+	if !ok {
+		return nil, nil, fmt.Errorf("missing front matter")
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(fmBytes, &doc); err != nil {
+		return nil, nil, fmt.Errorf("parsing front matter: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return content, nil, nil
+	}
+
+	changes := edit(doc.Content[0])
+	if len(changes) == 0 {
+		return content, nil, nil
+	}
+
+	fmOut, err := yaml.Marshal(&doc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshaling front matter: %w", err)
+	}
+	normalized := bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	rest := normalized[4+len(fmBytes):]
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(fmOut)
+	buf.Write(rest)
+	return buf.Bytes(), changes, nil
+}
+
+// LegacyStatuses maps status names used by older versions of beans and by
+// other trackers to the current ones. Don't modify it.
+var LegacyStatuses = map[string]string{
+	"open":        "todo",
+	"ready":       "todo",
+	"to do":       "todo",
+	"ideas":       "draft",
+	"backlog":     "draft",
+	"in_progress": "in-progress",
+	"doing":       "in-progress",
+	"started":     "in-progress",
+	"done":        "completed",
+	"closed":      "completed",
+	"resolved":    "completed",
+	"wontfix":     "scrapped",
+	"cancelled":   "scrapped",
+	"canceled":    "scrapped",
+}
+
+// legacyParentFields are the old per-type parent fields, most specific first.
+var legacyParentFields = []string{"feature", "epic", "milestone"}
+
+// migrateLegacyFields renames legacy statuses (including in the status
+// history) and replaces the epic, feature and milestone fields with a parent
+// link. The most specific of them becomes the parent; the others, and any
+// that disagree with an existing parent, become related links so no
+// relationship is lost.
+func migrateLegacyFields(fm *yaml.Node) []string {
+	var changes []string
+
+	if status := mappingValue(fm, "status"); status != nil && status.Kind == yaml.ScalarNode {
+		if renamed, ok := LegacyStatuses[status.Value]; ok {
+			changes = append(changes, fmt.Sprintf("status %s → %s", status.Value, renamed))
+			status.Value = renamed
+		}
+	}
+	if history := mappingValue(fm, "status_history"); history != nil && history.Kind == yaml.SequenceNode {
+		renamed := 0
+		for _, entry := range history.Content {
+			if status := mappingValue(entry, "status"); status != nil {
+				if s, ok := LegacyStatuses[status.Value]; ok {
+					status.Value = s
+					renamed++
+				}
+			}
+		}
+		if renamed > 0 {
+			changes = append(changes, fmt.Sprintf("renamed %d legacy statuses in status_history", renamed))
+		}
+	}
+
+	parent := ""
+	if p := mappingValue(fm, "parent"); p != nil {
+		parent = p.Value
+	}
+	var related []string
+	for _, field := range legacyParentFields {
+		value := mappingValue(fm, field)
+		if value == nil {
+			continue
+		}
+		removeMappingKey(fm, field)
+		if value.Kind != yaml.ScalarNode || value.Value == "" {
+			changes = append(changes, fmt.Sprintf("removed empty %s field", field))
+			continue
+		}
+		switch {
+		case parent == "":
+			parent = value.Value
+			setMappingValue(fm, "parent", parent)
+			changes = append(changes, fmt.Sprintf("%s: %s → parent", field, value.Value))
+		case parent == value.Value:
+			changes = append(changes, fmt.Sprintf("removed %s field (same as parent)", field))
+		default:
+			related = append(related, value.Value)
+			changes = append(changes, fmt.Sprintf("%s: %s → related link", field, value.Value))
+		}
+	}
+	if len(related) > 0 {
+		addRelatedLinks(fm, related)
+	}
+
+	return changes
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil.
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	if m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets key to a string value, adding it if missing.
+func setMappingValue(m *yaml.Node, key, value string) {
+	if v := mappingValue(m, key); v != nil {
+		v.Kind, v.Tag, v.Value, v.Content = yaml.ScalarNode, "!!str", value, nil
+		return
+	}
+	m.Content = append(m.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
+
+// removeMappingKey removes key and its value from a mapping node.
+func removeMappingKey(m *yaml.Node, key string) {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			// Keep a comment on the first key (the bean's ID) with the mapping
+			if i == 0 && len(m.Content) > 2 && m.Content[2].HeadComment == "" {
+				m.Content[2].HeadComment = m.Content[0].HeadComment
+			}
+			m.Content = slices.Delete(m.Content, i, i+2)
+			return
+		}
+	}
+}
+
+// addRelatedLinks adds ids to the bean's related links.
+func addRelatedLinks(fm *yaml.Node, ids []string) {
+	links := mappingValue(fm, "links")
+	if links == nil || links.Kind != yaml.MappingNode {
+		removeMappingKey(fm, "links")
+		links = &yaml.Node{Kind: yaml.MappingNode}
+		fm.Content = append(fm.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "links"}, links)
+	}
+	related := mappingValue(links, "related")
+	if related == nil || related.Kind != yaml.SequenceNode {
+		removeMappingKey(links, "related")
+		related = &yaml.Node{Kind: yaml.SequenceNode}
+		links.Content = append(links.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "related"}, related)
+	}
+	for _, id := range ids {
+		if !slices.ContainsFunc(related.Content, func(n *yaml.Node) bool { return n.Value == id }) {
+			related.Content = append(related.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: id})
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package bean
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		want        string
+		wantChanges []string
+	}{
+		{
+			name: "legacy status and epic",
+			content: `---
+# beans-abcd
+title: Old bean
+status: open
+epic: beans-epic
+---
+
+Body stays as it is.
+`,
+			want: `---
+# beans-abcd
+title: Old bean
+status: todo
+parent: beans-epic
+---
+
+Body stays as it is.
+`,
+			wantChanges: []string{"status open → todo", "epic: beans-epic → parent"},
+		},
+		{
+			name: "most specific field becomes parent",
+			content: `---
+title: Old bean
+status: done
+milestone: beans-v1
+feature: beans-feat
+---
+`,
+			want: `---
+title: Old bean
+status: completed
+parent: beans-feat
+links:
+    related:
+        - beans-v1
+---
+`,
+			wantChanges: []string{"status done → completed", "feature: beans-feat → parent", "milestone: beans-v1 → related link"},
+		},
+		{
+			name: "existing parent wins",
+			content: `---
+title: Old bean
+status: todo
+parent: beans-p
+epic: beans-p
+feature: beans-f
+---
+`,
+			want: `---
+title: Old bean
+status: todo
+parent: beans-p
+links:
+    related:
+        - beans-f
+---
+`,
+			wantChanges: []string{"feature: beans-f → related link", "removed epic field (same as parent)"},
+		},
+		{
+			name: "status history",
+			content: `---
+title: Old bean
+status: in-progress
+status_history:
+    - status: backlog
+      changed_at: 2025-01-01T00:00:00Z
+    - status: in-progress
+      changed_at: 2025-01-02T00:00:00Z
+---
+`,
+			want: `---
+title: Old bean
+status: in-progress
+status_history:
+    - status: draft
+      changed_at: 2025-01-01T00:00:00Z
+    - status: in-progress
+      changed_at: 2025-01-02T00:00:00Z
+---
+`,
+			wantChanges: []string{"renamed 1 legacy statuses in status_history"},
+		},
+		{
+			name: "current format is left alone",
+			content: `---
+title:   Spacing is kept
+status: todo
+---
+`,
+			want: `---
+title:   Spacing is kept
+status: todo
+---
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changes, err := Migrate([]byte(tt.content), 0, CurrentFormatVersion)
+			if err != nil {
+				t.Fatalf("Migrate() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Migrate() =\n%s\nwant\n%s", got, tt.want)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("changes = %q, want %q", changes, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestMigrateSkipsAppliedVersions(t *testing.T) {
+	content := "---\ntitle: Bean\nstatus: open\n---\n"
+	got, changes, err := Migrate([]byte(content), 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != content || len(changes) != 0 {
+		t.Errorf("Migrate() = %q, %q; want the file unchanged", got, changes)
+	}
+}
+
+func TestMigrateMissingFrontMatter(t *testing.T) {
+	if _, _, err := Migrate([]byte("just text\n"), 0, 1); err == nil {
+		t.Error("Migrate() error = nil, want error")
+	}
+}
+
+func TestCurrentFormatVersion(t *testing.T) {
+	if last := Migrations[len(Migrations)-1].Version; last != CurrentFormatVersion {
+		t.Errorf("CurrentFormatVersion = %d, but the last migration is to version %d", CurrentFormatVersion, last)
+	}
+}
@@ -0,0 +1,112 @@
+package bean
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rankDigits are the digits of rank keys, in ascending order. Ranks are
+// base-36 fractions (the key "i" is 0.5), so a new key can always be generated
+// between any two existing keys without renumbering other beans.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// IsValidRank reports whether s is a well-formed rank key: non-empty, using only
+// rank digits, and not ending in the zero digit.
+func IsValidRank(s string) bool {
+	if s == "" || s[len(s)-1] == rankDigits[0] {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(rankDigits, s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RankBetween returns a rank key that sorts strictly between lo and hi.
+// An empty lo means "before hi", an empty hi means "after lo".
+func RankBetween(lo, hi string) (string, error) {
+	if lo != "" && !IsValidRank(lo) {
+		return "", fmt.Errorf("invalid rank %q", lo)
+	}
+	if hi != "" && !IsValidRank(hi) {
+		return "", fmt.Errorf("invalid rank %q", hi)
+	}
+	if lo != "" && hi != "" && lo >= hi {
+		return "", fmt.Errorf("rank %q must sort before %q", lo, hi)
+	}
+	return midpoint(lo, hi), nil
+}
+
+// midpoint computes a key between lo and hi (hi == "" meaning 1.0),
+// assuming both are valid and lo < hi. lo is treated as padded with zeros.
+func midpoint(lo, hi string) string {
+	// Keep the prefix shared by both keys
+	if hi != "" {
+		n := 0
+		for n < len(hi) && rankDigit(lo, n) == rankDigit(hi, n) {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(lo) {
+				rest = lo[n:]
+			}
+			return hi[:n] + midpoint(rest, hi[n:])
+		}
+	}
+
+	dl := rankDigit(lo, 0)
+	dh := len(rankDigits)
+	if hi != "" {
+		dh = rankDigit(hi, 0)
+	}
+	if dh-dl > 1 {
+		return string(rankDigits[(dl+dh)/2])
+	}
+	// Adjacent first digits: hi's first digit alone fits if hi continues,
+	// otherwise keep lo's first digit and go after the rest of lo
+	if len(hi) > 1 {
+		return hi[:1]
+	}
+	rest := ""
+	if len(lo) > 1 {
+		rest = lo[1:]
+	}
+	return string(rankDigits[dl]) + midpoint(rest, "")
+}
+
+// rankDigit returns the numeric value of the i-th digit of s, or 0 past its end.
+func rankDigit(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	return strings.IndexByte(rankDigits, s[i])
+}
+
+// RankSequence returns n evenly spaced, ascending rank keys.
+func RankSequence(n int) []string {
+	ranks := make([]string, 0, n)
+	if n <= 0 {
+		return ranks
+	}
+	base := len(rankDigits)
+	// Use the shortest key length that leaves room between consecutive keys
+	width, capacity := 1, base
+	for capacity <= n {
+		width++
+		capacity *= base
+	}
+	step := capacity / (n + 1)
+	for i := 1; i <= n; i++ {
+		v := i * step
+		key := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			key[j] = rankDigits[v%base]
+			v /= base
+		}
+		ranks = append(ranks, strings.TrimRight(string(key), rankDigits[:1]))
+	}
+	return ranks
+}
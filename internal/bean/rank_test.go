@@ -0,0 +1,88 @@
+package bean
+
+import (
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		lo, hi  string
+		wantErr bool
+	}{
+		{"empty range", "", "", false},
+		{"before", "", "i", false},
+		{"after", "i", "", false},
+		{"between", "a", "c", false},
+		{"adjacent digits", "a", "b", false},
+		{"hi extends lo", "a", "a1", false},
+		{"before smallest", "", "01", false},
+		{"after largest", "z", "", false},
+		{"after deep key", "zzz", "", false},
+		{"hi longer", "1", "2x", false},
+		{"equal", "a", "a", true},
+		{"reversed", "c", "a", true},
+		{"invalid digit", "A", "", true},
+		{"trailing zero", "a0", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RankBetween(tt.lo, tt.hi)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RankBetween(%q, %q) = %q, want error", tt.lo, tt.hi, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RankBetween(%q, %q) error: %v", tt.lo, tt.hi, err)
+			}
+			if !IsValidRank(got) {
+				t.Errorf("RankBetween(%q, %q) = %q, not a valid rank", tt.lo, tt.hi, got)
+			}
+			if tt.lo != "" && got <= tt.lo {
+				t.Errorf("RankBetween(%q, %q) = %q, want > %q", tt.lo, tt.hi, got, tt.lo)
+			}
+			if tt.hi != "" && got >= tt.hi {
+				t.Errorf("RankBetween(%q, %q) = %q, want < %q", tt.lo, tt.hi, got, tt.hi)
+			}
+		})
+	}
+}
+
+func TestRankBetweenRepeated(t *testing.T) {
+	// Repeatedly inserting at the same spot must keep producing ordered keys
+	lo, hi := "a", "b"
+	for i := 0; i < 100; i++ {
+		mid, err := RankBetween(lo, hi)
+		if err != nil {
+			t.Fatalf("iteration %d: %v", i, err)
+		}
+		if mid <= lo || mid >= hi {
+			t.Fatalf("iteration %d: %q not between %q and %q", i, mid, lo, hi)
+		}
+		if i%2 == 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+}
+
+func TestRankSequence(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 35, 36, 100} {
+		ranks := RankSequence(n)
+		if len(ranks) != n {
+			t.Fatalf("RankSequence(%d) returned %d ranks", n, len(ranks))
+		}
+		for i, r := range ranks {
+			if !IsValidRank(r) {
+				t.Errorf("RankSequence(%d)[%d] = %q, not a valid rank", n, i, r)
+			}
+			if i > 0 && ranks[i-1] >= r {
+				t.Errorf("RankSequence(%d) not ascending at %d: %q >= %q", n, i, ranks[i-1], r)
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package bean
+
+import (
+	"path"
+	"strings"
+)
+
+// CleanScope normalizes a scope to a clean, slash-separated path relative to
+// the repository root, e.g. "./packages/api/" becomes "packages/api".
+// Returns "" for the repository root itself.
+func CleanScope(scope string) string {
+	scope = strings.ReplaceAll(strings.TrimSpace(scope), `\`, "/")
+	if scope == "" {
+		return ""
+	}
+	scope = strings.Trim(path.Clean("/"+scope), "/")
+	return scope
+}
+
+// InScope returns true if scope is within filter: equal to it or below it.
+// "packages" contains "packages/api", but not "packages-old".
+func InScope(scope, filter string) bool {
+	scope, filter = CleanScope(scope), CleanScope(filter)
+	if scope == "" || filter == "" {
+		return scope == filter
+	}
+	return scope == filter || strings.HasPrefix(scope, filter+"/")
+}
@@ -0,0 +1,50 @@
+package bean
+
+import "testing"
+
+func TestCleanScope(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{".", ""},
+		{"/", ""},
+		{"packages/api", "packages/api"},
+		{"./packages/api/", "packages/api"},
+		{" packages//api ", "packages/api"},
+		{`packages\api`, "packages/api"},
+		{"packages/../docs", "docs"},
+		{"../outside", "outside"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := CleanScope(tt.input); got != tt.want {
+				t.Errorf("CleanScope(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInScope(t *testing.T) {
+	tests := []struct {
+		scope, filter string
+		want          bool
+	}{
+		{"packages/api", "packages/api", true},
+		{"packages/api", "packages", true},
+		{"packages/api/", "./packages", true},
+		{"packages-old", "packages", false},
+		{"packages", "packages/api", false},
+		{"", "packages", false},
+		{"packages", "", false},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		if got := InScope(tt.scope, tt.filter); got != tt.want {
+			t.Errorf("InScope(%q, %q) = %v, want %v", tt.scope, tt.filter, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package bean
+
+import "strings"
+
+// Section is a markdown section of a bean's body: a heading and everything
+// up to the next heading of the same or a higher level (so it includes any
+// subsections).
+type Section struct {
+	Heading string `json:"heading"`
+	Level   int    `json:"level"`
+	Content string `json:"content"`
+}
+
+// sectionSpan locates a section within the body's lines: the heading line
+// and the end of its content (exclusive).
+type sectionSpan struct {
+	Section
+	start, end int
+}
+
+// Sections returns the sections of the body in document order, including
+// nested ones. Headings inside fenced code blocks are ignored.
+func (b *Bean) Sections() []Section {
+	spans := parseSections(strings.Split(b.Body, "\n"))
+	sections := make([]Section, len(spans))
+	for i, s := range spans {
+		sections[i] = s.Section
+	}
+	return sections
+}
+
+// Section returns the first section whose heading matches, compared
+// case-insensitively.
+func (b *Bean) Section(heading string) (Section, bool) {
+	for _, s := range b.Sections() {
+		if strings.EqualFold(s.Heading, strings.TrimSpace(heading)) {
+			return s, true
+		}
+	}
+	return Section{}, false
+}
+
+// HasSection reports whether the body has a markdown heading (of any level)
+// with the given text, compared case-insensitively.
+func (b *Bean) HasSection(heading string) bool {
+	_, ok := b.Section(heading)
+	return ok
+}
+
+// SetSection replaces the content of the first section whose heading
+// matches (case-insensitively), keeping the heading itself. If there is no
+// such section, a new "## heading" section is appended to the body.
+func (b *Bean) SetSection(heading, content string) {
+	heading = strings.TrimSpace(heading)
+	content = strings.Trim(content, "\n")
+
+	lines := strings.Split(b.Body, "\n")
+	for _, s := range parseSections(lines) {
+		if !strings.EqualFold(s.Heading, heading) {
+			continue
+		}
+		result := append([]string{}, lines[:s.start+1]...)
+		result = append(result, "")
+		if content != "" {
+			result = append(result, strings.Split(content, "\n")...)
+			result = append(result, "")
+		}
+		result = append(result, lines[s.end:]...)
+		b.Body = strings.Join(result, "\n")
+		return
+	}
+
+	body := strings.TrimRight(b.Body, "\n")
+	if body != "" {
+		body += "\n\n"
+	}
+	body += "## " + heading + "\n"
+	if content != "" {
+		body += "\n" + content + "\n"
+	}
+	b.Body = body
+}
+
+// RemoveSection removes the first section whose heading matches
+// (case-insensitively) from the body, with its heading and subsections.
+// Reports whether there was such a section.
+func (b *Bean) RemoveSection(heading string) bool {
+	heading = strings.TrimSpace(heading)
+	lines := strings.Split(b.Body, "\n")
+	for _, s := range parseSections(lines) {
+		if !strings.EqualFold(s.Heading, heading) {
+			continue
+		}
+		before := strings.TrimRight(strings.Join(lines[:s.start], "\n"), "\n")
+		after := strings.Trim(strings.Join(lines[s.end:], "\n"), "\n")
+		switch {
+		case before == "":
+			b.Body = after
+		case after == "":
+			b.Body = before
+		default:
+			b.Body = before + "\n\n" + after
+		}
+		if b.Body != "" {
+			b.Body += "\n"
+		}
+		return true
+	}
+	return false
+}
+
+// parseSections finds all sections in the given lines.
+func parseSections(lines []string) []sectionSpan {
+	var spans []sectionSpan
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if level, text, ok := parseHeading(trimmed); ok {
+			spans = append(spans, sectionSpan{Section: Section{Heading: text, Level: level}, start: i})
+		}
+	}
+
+	for i := range spans {
+		spans[i].end = len(lines)
+		for _, next := range spans[i+1:] {
+			if next.Level <= spans[i].Level {
+				spans[i].end = next.start
+				break
+			}
+		}
+		content := strings.Join(lines[spans[i].start+1:spans[i].end], "\n")
+		spans[i].Content = strings.Trim(content, "\n")
+	}
+	return spans
+}
+
+// parseHeading parses an ATX markdown heading line ("## Text").
+func parseHeading(line string) (int, string, bool) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ' && line[level] != '\t') {
+		return 0, "", false
+	}
+	text := strings.TrimSpace(line[level:])
+	return level, strings.TrimSpace(strings.TrimRight(text, "#")), true
+}
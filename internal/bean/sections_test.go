@@ -0,0 +1,167 @@
+package bean
+
+import "testing"
+
+func TestHasSection(t *testing.T) {
+	body := "Intro\n\n## Repro Steps\n\n1. Run\n\n### Expected ###\n\n```\n## In Code\n```\n#NotAHeading\n"
+	b := &Bean{Body: body}
+
+	tests := []struct {
+		heading string
+		want    bool
+	}{
+		{"Repro Steps", true},
+		{"repro steps", true},
+		{"Expected", true},
+		{"In Code", false},
+		{"NotAHeading", false},
+		{"Missing", false},
+	}
+	for _, tt := range tests {
+		if got := b.HasSection(tt.heading); got != tt.want {
+			t.Errorf("HasSection(%q) = %v, want %v", tt.heading, got, tt.want)
+		}
+	}
+}
+
+func TestSections(t *testing.T) {
+	b := &Bean{Body: "Intro\n\n## Acceptance Criteria\n\n- [ ] Works\n\n### Details\n\nMore\n\n## Repro Steps\n\n1. Run\n"}
+
+	got := b.Sections()
+	want := []Section{
+		{Heading: "Acceptance Criteria", Level: 2, Content: "- [ ] Works\n\n### Details\n\nMore"},
+		{Heading: "Details", Level: 3, Content: "More"},
+		{Heading: "Repro Steps", Level: 2, Content: "1. Run"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d sections, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("section %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if s, _ := b.Section("repro steps"); s.Content != "1. Run" {
+		t.Errorf("Section(repro steps).Content = %q, want %q", s.Content, "1. Run")
+	}
+}
+
+func TestSetSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		heading string
+		content string
+		want    string
+	}{
+		{
+			name:    "replace middle section",
+			body:    "Intro\n\n## Repro Steps\n\nOld\n\n## Notes\n\nKeep\n",
+			heading: "repro steps",
+			content: "1. New\n2. Steps",
+			want:    "Intro\n\n## Repro Steps\n\n1. New\n2. Steps\n\n## Notes\n\nKeep\n",
+		},
+		{
+			name:    "replace last section",
+			body:    "## Notes\n\nOld\n",
+			heading: "Notes",
+			content: "New",
+			want:    "## Notes\n\nNew\n",
+		},
+		{
+			name:    "replace includes subsections",
+			body:    "## A\n\nOld\n\n### A.1\n\nNested\n\n## B\n",
+			heading: "A",
+			content: "New",
+			want:    "## A\n\nNew\n\n## B\n",
+		},
+		{
+			name:    "clear section",
+			body:    "## A\n\nOld\n\n## B\n",
+			heading: "A",
+			content: "",
+			want:    "## A\n\n## B\n",
+		},
+		{
+			name:    "append new section",
+			body:    "Intro\n",
+			heading: "Acceptance Criteria",
+			content: "- [ ] Works",
+			want:    "Intro\n\n## Acceptance Criteria\n\n- [ ] Works\n",
+		},
+		{
+			name:    "append to empty body",
+			body:    "",
+			heading: "Notes",
+			content: "Hi",
+			want:    "## Notes\n\nHi\n",
+		},
+		{
+			name:    "ignores headings in code",
+			body:    "```\n## Notes\n```\n",
+			heading: "Notes",
+			content: "Hi",
+			want:    "```\n## Notes\n```\n\n## Notes\n\nHi\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bean{Body: tt.body}
+			b.SetSection(tt.heading, tt.content)
+			if b.Body != tt.want {
+				t.Errorf("Body = %q, want %q", b.Body, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveSection(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		heading string
+		want    string
+		found   bool
+	}{
+		{
+			name:    "middle section",
+			body:    "Intro\n\n## A\n\nOld\n\n### A.1\n\nNested\n\n## B\n\nKeep\n",
+			heading: "a",
+			want:    "Intro\n\n## B\n\nKeep\n",
+			found:   true,
+		},
+		{
+			name:    "last section",
+			body:    "Intro\n\n## A\n\nOld\n",
+			heading: "A",
+			want:    "Intro\n",
+			found:   true,
+		},
+		{
+			name:    "only section",
+			body:    "## A\n\nOld\n",
+			heading: "A",
+			want:    "",
+			found:   true,
+		},
+		{
+			name:    "missing section",
+			body:    "Intro\n",
+			heading: "A",
+			want:    "Intro\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Bean{Body: tt.body}
+			if found := b.RemoveSection(tt.heading); found != tt.found {
+				t.Errorf("RemoveSection() = %v, want %v", found, tt.found)
+			}
+			if b.Body != tt.want {
+				t.Errorf("Body = %q, want %q", b.Body, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,99 @@
 package bean
 
 import (
+	"fmt"
 	"sort"
 	"strings"
+	"time"
 )
 
-// SortByStatusPriorityAndType sorts beans by status order, then priority, then type, then title.
+// SortOrders lists the orders SortBy accepts besides the default ("").
+var SortOrders = []string{"status", "priority", "created", "updated", "id"}
+
+// SortBy sorts beans by one of SortOrders, or by SortByStatusPriorityAndType
+// when orderBy is empty. Status and priority break ties with each other, then
+// by ID; created and updated put the newest first.
+func SortBy(beans []*Bean, orderBy string, statusNames, priorityNames, typeNames []string) error {
+	statusOrder := orderIndex(statusNames)
+	priorityOrder := orderIndex(priorityNames)
+	// Beans without priority sort as normal
+	normal, ok := priorityOrder["normal"]
+	if !ok {
+		normal = len(priorityNames)
+	}
+	getStatusOrder := func(b *Bean) int {
+		if order, ok := statusOrder[b.Status]; ok {
+			return order
+		}
+		return len(statusNames)
+	}
+	getPriorityOrder := func(b *Bean) int {
+		if b.Priority == "" {
+			return normal
+		}
+		if order, ok := priorityOrder[b.Priority]; ok {
+			return order
+		}
+		return len(priorityNames)
+	}
+	newestFirst := func(ti, tj *time.Time, i, j int) bool {
+		switch {
+		case ti == nil && tj == nil:
+			return beans[i].ID < beans[j].ID
+		case ti == nil:
+			return false
+		case tj == nil:
+			return true
+		}
+		return ti.After(*tj)
+	}
+
+	switch orderBy {
+	case "":
+		SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+	case "status":
+		sort.Slice(beans, func(i, j int) bool {
+			if si, sj := getStatusOrder(beans[i]), getStatusOrder(beans[j]); si != sj {
+				return si < sj
+			}
+			if pi, pj := getPriorityOrder(beans[i]), getPriorityOrder(beans[j]); pi != pj {
+				return pi < pj
+			}
+			return beans[i].ID < beans[j].ID
+		})
+	case "priority":
+		sort.Slice(beans, func(i, j int) bool {
+			if pi, pj := getPriorityOrder(beans[i]), getPriorityOrder(beans[j]); pi != pj {
+				return pi < pj
+			}
+			if si, sj := getStatusOrder(beans[i]), getStatusOrder(beans[j]); si != sj {
+				return si < sj
+			}
+			return beans[i].ID < beans[j].ID
+		})
+	case "created":
+		sort.Slice(beans, func(i, j int) bool { return newestFirst(beans[i].CreatedAt, beans[j].CreatedAt, i, j) })
+	case "updated":
+		sort.Slice(beans, func(i, j int) bool { return newestFirst(beans[i].UpdatedAt, beans[j].UpdatedAt, i, j) })
+	case "id":
+		sort.Slice(beans, func(i, j int) bool { return beans[i].ID < beans[j].ID })
+	default:
+		return fmt.Errorf("unknown sort order %q (must be %s)", orderBy, strings.Join(SortOrders, ", "))
+	}
+	return nil
+}
+
+// orderIndex maps each name to its position.
+func orderIndex(names []string) map[string]int {
+	order := make(map[string]int, len(names))
+	for i, name := range names {
+		order[name] = i
+	}
+	return order
+}
+
+// SortByStatusPriorityAndType sorts beans by status order, then manual rank, then priority,
+// then type, then title. Beans with a rank sort before unranked beans of the same status.
 // This is the default sorting used by both CLI and TUI.
 // Unrecognized statuses, priorities, and types are sorted last within their category.
 // Beans without priority are treated as "normal" priority for sorting purposes.
@@ -61,6 +149,14 @@ func SortByStatusPriorityAndType(beans []*Bean, statusNames, priorityNames, type
 		if oi != oj {
 			return oi < oj
 		}
+		// Manual rank within a status: ranked beans first, in rank order
+		ri, rj := beans[i].Rank, beans[j].Rank
+		if ri != rj {
+			if ri == "" || rj == "" {
+				return rj == ""
+			}
+			return ri < rj
+		}
 		// Secondary: priority order
 		pi, pj := getPriorityOrder(beans[i].Priority), getPriorityOrder(beans[j].Priority)
 		if pi != pj {
@@ -139,5 +139,75 @@ func TestSortByStatusPriorityAndType(t *testing.T) {
 			t.Errorf("First bean title = %q, want \"A\"", beans[0].Title)
 		}
 	})
+
+	t.Run("ranked beans first within status, in rank order", func(t *testing.T) {
+		beans := []*Bean{
+			{ID: "1", Title: "Unranked critical", Status: "todo", Priority: "critical"},
+			{ID: "2", Title: "Second", Status: "todo", Priority: "low", Rank: "m"},
+			{ID: "3", Title: "First", Status: "todo", Priority: "low", Rank: "c"},
+			{ID: "4", Title: "Draft", Status: "draft", Rank: "z"},
+		}
+
+		SortByStatusPriorityAndType(beans, statusNames, priorityNames, typeNames)
+
+		expectedOrder := []string{"Draft", "First", "Second", "Unranked critical"}
+		for i, expected := range expectedOrder {
+			if beans[i].Title != expected {
+				t.Errorf("beans[%d].Title = %q, want %q", i, beans[i].Title, expected)
+			}
+		}
+	})
 }
 
+
+func TestSortBy(t *testing.T) {
+	statusNames := []string{"in-progress", "todo", "completed"}
+	priorityNames := []string{"critical", "high", "normal", "low", "deferred"}
+	beans := func() []*Bean {
+		return []*Bean{
+			{ID: "a", Status: "todo", Priority: "low"},
+			{ID: "b", Status: "completed", Priority: "critical"},
+			{ID: "c", Status: "todo", Priority: "critical"},
+			{ID: "d", Status: "in-progress"},
+			{ID: "e", Status: "todo"},
+		}
+	}
+
+	tests := []struct {
+		orderBy string
+		want    []string
+	}{
+		{"status", []string{"d", "c", "e", "a", "b"}},
+		{"priority", []string{"c", "b", "d", "e", "a"}},
+		{"id", []string{"a", "b", "c", "d", "e"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			got := beans()
+			if err := SortBy(got, tt.orderBy, statusNames, priorityNames, nil); err != nil {
+				t.Fatalf("SortBy() error = %v", err)
+			}
+			for i, want := range tt.want {
+				if got[i].ID != want {
+					t.Errorf("SortBy(%s)[%d] = %q, want %q", tt.orderBy, i, got[i].ID, want)
+				}
+			}
+		})
+	}
+
+	t.Run("follows the priority order given", func(t *testing.T) {
+		got := beans()
+		if err := SortBy(got, "priority", statusNames, []string{"low", "critical", "high", "normal", "deferred"}, nil); err != nil {
+			t.Fatalf("SortBy() error = %v", err)
+		}
+		if got[0].ID != "a" {
+			t.Errorf("first bean = %q, want a", got[0].ID)
+		}
+	})
+
+	t.Run("unknown order", func(t *testing.T) {
+		if err := SortBy(beans(), "title", statusNames, priorityNames, nil); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
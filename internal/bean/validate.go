@@ -0,0 +1,369 @@
+package bean
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontMatterIssue is a problem found by strict front matter validation.
+// Line and Column are 1-based positions in the bean file.
+type FrontMatterIssue struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (i FrontMatterIssue) String() string {
+	return fmt.Sprintf("%d:%d: %s", i.Line, i.Column, i.Message)
+}
+
+// ValidationRules lists the values accepted for enum-like front matter
+// fields. A nil list accepts any value. Names in the alias maps are accepted
+// as statuses and types too.
+type ValidationRules struct {
+	Statuses      []string
+	Types         []string
+	Priorities    []string
+	LinkTypes     []string
+	StatusAliases map[string]string
+	TypeAliases   map[string]string
+}
+
+// fieldKind describes the expected YAML shape of a front matter field.
+type fieldKind int
+
+const (
+	kindString fieldKind = iota
+	kindInt
+	kindBool
+	kindTime
+	kindStringList
+	kindStatusHistory
+	kindLinks
+	kindStringMap
+)
+
+// frontMatterFields lists the known front matter fields and their kinds.
+// Keep in sync with frontMatter.
+var frontMatterFields = map[string]fieldKind{
+	"title":            kindString,
+	"status":           kindString,
+	"type":             kindString,
+	"priority":         kindString,
+	"points":           kindInt,
+	"tags":             kindStringList,
+	"scope":            kindString,
+	"created_at":       kindTime,
+	"updated_at":       kindTime,
+	"draft":            kindBool,
+	"parent":           kindString,
+	"blocking":         kindStringList,
+	"blocked_by":       kindStringList,
+	"git_branch":       kindString,
+	"git_created_at":   kindTime,
+	"git_merged_at":    kindTime,
+	"git_merge_commit": kindString,
+	"git_pr_url":       kindString,
+	"git_pr_state":     kindString,
+	"status_history":   kindStatusHistory,
+	"rank":             kindString,
+	"links":            kindLinks,
+	"external_ids":     kindStringMap,
+	"aliases":          kindStringList,
+	"source_url":       kindString,
+}
+
+// ValidateFrontMatter strictly validates the front matter of a bean file:
+// unknown or duplicate keys, values of the wrong type, and enum values not
+// allowed by rules. Parse is lenient and accepts all of these; this is meant
+// for diagnostics. Returns an error only if the front matter can't be read
+// as YAML at all.
+func ValidateFrontMatter(content []byte, rules ValidationRules) ([]FrontMatterIssue, error) {
+	fm, offset, ok := extractFrontMatter(content)
+	if !ok {
+		return []FrontMatterIssue{{Line: 1, Column: 1, Message: "missing front matter (expected a leading --- block)"}}, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(fm, &doc); err != nil {
+		return nil, fmt.Errorf("parsing front matter: %w", err)
+	}
+	v := &validator{rules: rules, offset: offset}
+	if len(doc.Content) == 0 {
+		v.add(nil, "", "empty front matter")
+		return v.issues, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		v.add(root, "", "front matter must be a mapping of fields")
+		return v.issues, nil
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		name := key.Value
+		if seen[name] {
+			v.add(key, name, fmt.Sprintf("duplicate field %q", name))
+			continue
+		}
+		seen[name] = true
+
+		kind, known := frontMatterFields[name]
+		if !known {
+			msg := fmt.Sprintf("unknown field %q", name)
+			if s := closestField(name); s != "" {
+				msg += fmt.Sprintf(" (did you mean %q?)", s)
+			}
+			v.add(key, name, msg)
+			continue
+		}
+		v.checkField(name, kind, value)
+	}
+	if !seen["title"] {
+		v.add(root, "title", "missing required field \"title\"")
+	}
+	return v.issues, nil
+}
+
+// extractFrontMatter returns the YAML between the leading --- delimiters and
+// the number of lines before it.
+func extractFrontMatter(content []byte) ([]byte, int, bool) {
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	if !bytes.HasPrefix(content, []byte("---\n")) {
+		return nil, 0, false
+	}
+	rest := content[4:]
+	if bytes.HasPrefix(rest, []byte("---\n")) || bytes.Equal(rest, []byte("---")) {
+		return nil, 1, true
+	}
+	end := bytes.Index(rest, []byte("\n---"))
+	if end < 0 {
+		return nil, 0, false
+	}
+	return rest[:end+1], 1, true
+}
+
+type validator struct {
+	rules  ValidationRules
+	offset int
+	issues []FrontMatterIssue
+}
+
+// add records an issue at the position of node (or the start of the front matter).
+func (v *validator) add(node *yaml.Node, field, msg string) {
+	line, col := v.offset+1, 1
+	if node != nil {
+		line, col = node.Line+v.offset, node.Column
+	}
+	v.issues = append(v.issues, FrontMatterIssue{Line: line, Column: col, Field: field, Message: msg})
+}
+
+func (v *validator) checkField(name string, kind fieldKind, value *yaml.Node) {
+	if isNull(value) {
+		return
+	}
+	switch kind {
+	case kindString:
+		if !v.expectScalar(name, value) {
+			return
+		}
+		switch name {
+		case "status":
+			v.checkEnum(name, value, v.rules.Statuses, v.rules.StatusAliases)
+		case "type":
+			v.checkEnum(name, value, v.rules.Types, v.rules.TypeAliases)
+		case "priority":
+			v.checkEnum(name, value, v.rules.Priorities, nil)
+		case "rank":
+			if !IsValidRank(value.Value) {
+				v.add(value, name, fmt.Sprintf("invalid rank %q (use digits and lowercase letters, not ending in 0)", value.Value))
+			}
+		case "scope":
+			if CleanScope(value.Value) != value.Value {
+				v.add(value, name, fmt.Sprintf("scope %q is not a clean relative path (use %q)", value.Value, CleanScope(value.Value)))
+			}
+		}
+	case kindInt:
+		if value.Kind != yaml.ScalarNode || value.ShortTag() != "!!int" {
+			v.add(value, name, fmt.Sprintf("%s must be an integer", name))
+		}
+	case kindBool:
+		if value.Kind != yaml.ScalarNode || value.ShortTag() != "!!bool" {
+			v.add(value, name, fmt.Sprintf("%s must be true or false", name))
+		}
+	case kindTime:
+		v.checkTime(name, value)
+	case kindStringList:
+		v.checkStringList(name, value)
+		if name == "tags" && value.Kind == yaml.SequenceNode {
+			for _, item := range value.Content {
+				if item.Kind == yaml.ScalarNode {
+					if err := ValidateTag(item.Value); err != nil {
+						v.add(item, name, err.Error())
+					}
+				}
+			}
+		}
+	case kindStatusHistory:
+		v.checkStatusHistory(value)
+	case kindLinks:
+		v.checkLinks(value)
+	case kindStringMap:
+		v.checkStringMap(name, value)
+	}
+}
+
+func (v *validator) expectScalar(name string, value *yaml.Node) bool {
+	if value.Kind != yaml.ScalarNode {
+		v.add(value, name, fmt.Sprintf("%s must be a single value, not a %s", name, kindName(value)))
+		return false
+	}
+	return true
+}
+
+func (v *validator) checkEnum(name string, value *yaml.Node, allowed []string, aliases map[string]string) {
+	if allowed == nil || value.Value == "" || slices.Contains(allowed, value.Value) {
+		return
+	}
+	if _, ok := aliases[value.Value]; ok {
+		return
+	}
+	v.add(value, name, fmt.Sprintf("invalid %s %q (must be %s)", name, value.Value, strings.Join(allowed, ", ")))
+}
+
+func (v *validator) checkTime(name string, value *yaml.Node) {
+	var t time.Time
+	if value.Kind != yaml.ScalarNode || value.Decode(&t) != nil {
+		v.add(value, name, fmt.Sprintf("%s must be a timestamp (e.g. 2006-01-02T15:04:05Z)", name))
+	}
+}
+
+func (v *validator) checkStringList(name string, value *yaml.Node) bool {
+	if value.Kind != yaml.SequenceNode {
+		v.add(value, name, fmt.Sprintf("%s must be a list", name))
+		return false
+	}
+	ok := true
+	for _, item := range value.Content {
+		if item.Kind != yaml.ScalarNode {
+			v.add(item, name, fmt.Sprintf("%s entries must be single values, not a %s", name, kindName(item)))
+			ok = false
+		}
+	}
+	return ok
+}
+
+func (v *validator) checkStatusHistory(value *yaml.Node) {
+	const name = "status_history"
+	if value.Kind != yaml.SequenceNode {
+		v.add(value, name, "status_history must be a list")
+		return
+	}
+	for _, entry := range value.Content {
+		if entry.Kind != yaml.MappingNode {
+			v.add(entry, name, "status_history entries must have status and changed_at")
+			continue
+		}
+		var hasStatus, hasChangedAt bool
+		for i := 0; i+1 < len(entry.Content); i += 2 {
+			key, val := entry.Content[i], entry.Content[i+1]
+			switch key.Value {
+			case "status":
+				hasStatus = true
+				if v.expectScalar(name, val) {
+					v.checkEnum("status", val, v.rules.Statuses, v.rules.StatusAliases)
+				}
+			case "changed_at":
+				hasChangedAt = true
+				v.checkTime(name, val)
+			default:
+				v.add(key, name, fmt.Sprintf("unknown status_history field %q", key.Value))
+			}
+		}
+		if !hasStatus || !hasChangedAt {
+			v.add(entry, name, "status_history entries must have status and changed_at")
+		}
+	}
+}
+
+func (v *validator) checkLinks(value *yaml.Node) {
+	const name = "links"
+	if value.Kind != yaml.MappingNode {
+		v.add(value, name, "links must map link types to lists of bean IDs")
+		return
+	}
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		key, val := value.Content[i], value.Content[i+1]
+		if v.rules.LinkTypes != nil && !slices.Contains(v.rules.LinkTypes, key.Value) {
+			v.add(key, name, fmt.Sprintf("invalid link type %q (must be %s)", key.Value, strings.Join(v.rules.LinkTypes, ", ")))
+		}
+		v.checkStringList(name+"."+key.Value, val)
+	}
+}
+
+func (v *validator) checkStringMap(name string, value *yaml.Node) {
+	if value.Kind != yaml.MappingNode {
+		v.add(value, name, fmt.Sprintf("%s must be a mapping", name))
+		return
+	}
+	for i := 1; i < len(value.Content); i += 2 {
+		if item := value.Content[i]; item.Kind != yaml.ScalarNode {
+			v.add(item, name, fmt.Sprintf("%s values must be single values, not a %s", name, kindName(item)))
+		}
+	}
+}
+
+func isNull(n *yaml.Node) bool {
+	return n.Kind == yaml.ScalarNode && n.ShortTag() == "!!null"
+}
+
+// kindName describes a YAML node's shape for error messages.
+func kindName(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.MappingNode:
+		return "mapping"
+	default:
+		return "value"
+	}
+}
+
+// closestField suggests a known field name for a misspelled one.
+func closestField(name string) string {
+	best, bestDist := "", 3 // only suggest names within two edits
+	for field := range frontMatterFields {
+		if d := editDistance(name, field); d < bestDist || (d == bestDist && field < best) {
+			best, bestDist = field, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,148 @@
+package bean
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFrontMatter(t *testing.T) {
+	rules := ValidationRules{
+		Statuses:   []string{"todo", "completed"},
+		Types:      []string{"task", "bug"},
+		Priorities: []string{"high", "normal"},
+		LinkTypes:  []string{"related"},
+
+		StatusAliases: map[string]string{"open": "todo"},
+		TypeAliases:   map[string]string{"story": "task"},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string // "line:col: message" substrings, in order
+	}{
+		{
+			name: "valid",
+			content: `---
+# abc
+title: Test
+status: todo
+type: bug
+priority: ""
+points: 3
+tags:
+    - backend
+created_at: 2024-01-01T00:00:00Z
+status_history:
+    - status: todo
+      changed_at: 2024-01-01T00:00:00Z
+links:
+    related:
+        - xyz
+rank: i
+external_ids:
+    taskwarrior: 0b1c2d3e-0000-4000-8000-000000000001
+---
+
+Body with: yaml-like text
+`,
+		},
+		{
+			name:    "unknown key with suggestion",
+			content: "---\ntitle: Test\nstauts: todo\n---\n",
+			want:    []string{`3:1: unknown field "stauts" (did you mean "status"?)`},
+		},
+		{
+			name:    "unknown key without suggestion",
+			content: "---\ntitle: Test\nassignee: bob\n---\n",
+			want:    []string{`3:1: unknown field "assignee"`},
+		},
+		{
+			name:    "invalid enum values",
+			content: "---\ntitle: Test\nstatus: doing\ntype: epic\npriority: urgent\n---\n",
+			want: []string{
+				`3:9: invalid status "doing" (must be todo, completed)`,
+				`4:7: invalid type "epic"`,
+				`5:11: invalid priority "urgent"`,
+			},
+		},
+		{
+			name:    "aliases are accepted",
+			content: "---\ntitle: Test\nstatus: open\ntype: story\nstatus_history:\n  - status: open\n    changed_at: 2024-01-01T00:00:00Z\n---\n",
+		},
+		{
+			name:    "wrong types",
+			content: "---\ntitle: [a, b]\npoints: lots\ntags: backend\ncreated_at: yesterday\ndraft: maybe\n---\n",
+			want: []string{
+				"2:8: title must be a single value, not a list",
+				"3:9: points must be an integer",
+				"4:7: tags must be a list",
+				"5:13: created_at must be a timestamp",
+				"6:8: draft must be true or false",
+			},
+		},
+		{
+			name:    "external IDs must map to single values",
+			content: "---\ntitle: Test\nexternal_ids:\n  jira: [a, b]\n---\n",
+			want:    []string{"4:9: external_ids values must be single values, not a list"},
+		},
+		{
+			name:    "invalid tag",
+			content: "---\ntitle: Test\ntags:\n  - ok\n  - Not OK\n---\n",
+			want:    []string{`5:5: invalid tag "Not OK"`},
+		},
+		{
+			name:    "invalid link type",
+			content: "---\ntitle: Test\nlinks:\n  blocks:\n    - abc\n---\n",
+			want:    []string{`4:3: invalid link type "blocks"`},
+		},
+		{
+			name:    "incomplete status history",
+			content: "---\ntitle: Test\nstatus_history:\n  - status: todo\n---\n",
+			want:    []string{"4:5: status_history entries must have status and changed_at"},
+		},
+		{
+			name:    "duplicate and missing title",
+			content: "---\nstatus: todo\nstatus: completed\n---\n",
+			want:    []string{`3:1: duplicate field "status"`, `missing required field "title"`},
+		},
+		{
+			name:    "missing front matter",
+			content: "just a body\n",
+			want:    []string{"1:1: missing front matter"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := ValidateFrontMatter([]byte(tt.content), rules)
+			if err != nil {
+				t.Fatalf("ValidateFrontMatter() error = %v", err)
+			}
+			if len(issues) != len(tt.want) {
+				t.Fatalf("got %d issues %v, want %d", len(issues), issues, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got := issues[i].String(); !strings.Contains(got, want) {
+					t.Errorf("issue %d = %q, want it to contain %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateFrontMatterNilRules(t *testing.T) {
+	issues, err := ValidateFrontMatter([]byte("---\ntitle: Test\nstatus: anything\n---\n"), ValidationRules{})
+	if err != nil {
+		t.Fatalf("ValidateFrontMatter() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("got issues %v, want none", issues)
+	}
+}
+
+func TestValidateFrontMatterInvalidYAML(t *testing.T) {
+	if _, err := ValidateFrontMatter([]byte("---\ntitle: [unclosed\n---\n"), ValidationRules{}); err == nil {
+		t.Error("expected error for invalid YAML")
+	}
+}
@@ -0,0 +1,116 @@
+package beancore
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// Activity event kinds.
+const (
+	ActivityCreated       = "created"
+	ActivityStatusChanged = "status_changed"
+	ActivityCompleted     = "completed"
+	ActivityCommit        = "commit"
+)
+
+// ActivityEvent is a single entry in the activity feed.
+type ActivityEvent struct {
+	Time   time.Time `json:"time"`
+	Kind   string    `json:"kind"`
+	BeanID string    `json:"bean_id"`
+	Title  string    `json:"title"`
+	// Status is the status entered, for status changes and completions.
+	Status string `json:"status,omitempty"`
+	// From is the previous status, for status changes and completions.
+	From string `json:"from,omitempty"`
+	// Commit is the commit mentioning the bean, for commit events.
+	Commit *gitflow.CommitInfo `json:"commit,omitempty"`
+}
+
+// Activity returns bean events that happened at or after since, oldest first.
+// Events are derived from creation timestamps, status histories and, when
+// git integration is enabled, commits whose messages mention a bean's ID.
+func (c *Core) Activity(since time.Time) ([]ActivityEvent, error) {
+	c.mu.RLock()
+	beans := make([]*bean.Bean, 0, len(c.beans))
+	for _, b := range c.beans {
+		beans = append(beans, b)
+	}
+	c.mu.RUnlock()
+	sort.Slice(beans, func(i, j int) bool { return beans[i].ID < beans[j].ID })
+
+	var events []ActivityEvent
+	for _, b := range beans {
+		if b.CreatedAt != nil && !b.CreatedAt.Before(since) {
+			event := ActivityEvent{Time: *b.CreatedAt, Kind: ActivityCreated, BeanID: b.ID, Title: b.Title}
+			if len(b.StatusHistory) > 0 {
+				event.Status = b.StatusHistory[0].Status
+			}
+			events = append(events, event)
+		}
+		// The first history entry is the status the bean was created with
+		for i := 1; i < len(b.StatusHistory); i++ {
+			change := b.StatusHistory[i]
+			if change.ChangedAt.Before(since) {
+				continue
+			}
+			kind := ActivityStatusChanged
+			if change.Status == "completed" {
+				kind = ActivityCompleted
+			}
+			events = append(events, ActivityEvent{
+				Time:   change.ChangedAt,
+				Kind:   kind,
+				BeanID: b.ID,
+				Title:  b.Title,
+				Status: change.Status,
+				From:   b.StatusHistory[i-1].Status,
+			})
+		}
+	}
+
+	if c.IsGitFlowEnabled() {
+		commitEvents, err := c.commitActivity(beans, since)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, commitEvents...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	return events, nil
+}
+
+// commitActivity returns an event for every commit since the given time that
+// mentions one of the beans, either by full ID or by short ID.
+func (c *Core) commitActivity(beans []*bean.Bean, since time.Time) ([]ActivityEvent, error) {
+	commits, err := c.gitFlow.CommitsSince(since)
+	if err != nil {
+		return nil, err
+	}
+
+	matchers := make([]*regexp.Regexp, len(beans))
+	for i, b := range beans {
+		ids := []string{b.ID}
+		if c.config != nil && c.config.Beans.Prefix != "" && strings.HasPrefix(b.ID, c.config.Beans.Prefix) {
+			ids = append(ids, strings.TrimPrefix(b.ID, c.config.Beans.Prefix))
+		}
+		matchers[i] = gitflow.BuildCommitMatcher(ids...)
+	}
+
+	var events []ActivityEvent
+	for i := range commits {
+		commit := &commits[i]
+		for j, b := range beans {
+			if matchers[j] != nil && matchers[j].MatchString(commit.Message) {
+				events = append(events, ActivityEvent{Time: commit.Date, Kind: ActivityCommit, BeanID: b.ID, Title: b.Title, Commit: commit})
+			}
+		}
+	}
+	return events, nil
+}
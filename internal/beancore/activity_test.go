@@ -0,0 +1,101 @@
+package beancore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestActivity(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	h := func(n int) time.Time { return start.Add(time.Duration(n) * time.Hour) }
+	created := h(0)
+	old := start.AddDate(0, -1, 0)
+
+	// Status histories are written directly so timestamps are deterministic
+	for _, b := range []*bean.Bean{
+		{ID: "act-1", Slug: "one", Title: "One", Status: "completed", CreatedAt: &created, StatusHistory: []bean.StatusChange{
+			{Status: "todo", ChangedAt: h(0)}, {Status: "in-progress", ChangedAt: h(1)}, {Status: "completed", ChangedAt: h(3)},
+		}},
+		{ID: "act-2", Slug: "two", Title: "Two", Status: "in-progress", CreatedAt: &old, StatusHistory: []bean.StatusChange{
+			{Status: "todo", ChangedAt: old}, {Status: "in-progress", ChangedAt: h(2)},
+		}},
+	} {
+		if err := core.saveToDisk(b); err != nil {
+			t.Fatalf("saveToDisk() error = %v", err)
+		}
+		core.beans[b.ID] = b
+	}
+
+	events, err := core.Activity(start)
+	if err != nil {
+		t.Fatalf("Activity() error = %v", err)
+	}
+
+	want := []struct {
+		kind, id, status, from string
+	}{
+		{ActivityCreated, "act-1", "todo", ""},
+		{ActivityStatusChanged, "act-1", "in-progress", "todo"},
+		{ActivityStatusChanged, "act-2", "in-progress", "todo"},
+		{ActivityCompleted, "act-1", "completed", "in-progress"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("Activity() returned %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Kind != w.kind || e.BeanID != w.id || e.Status != w.status || e.From != w.from {
+			t.Errorf("events[%d] = %s %s %s<-%s, want %s %s %s<-%s", i, e.Kind, e.BeanID, e.Status, e.From, w.kind, w.id, w.status, w.from)
+		}
+	}
+}
+
+func TestActivityCommits(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	core.config.Beans.Prefix = "test-"
+
+	b := createTestBean(t, core, "test-cm1", "Committed", "todo")
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("failed to open repo: %v", err)
+	}
+	w, _ := repo.Worktree()
+	for i, msg := range []string{"feat: work on cm1", "chore: unrelated"} {
+		name := fmt.Sprintf("file%d.txt", i)
+		os.WriteFile(filepath.Join(repoPath, name), []byte(msg), 0644)
+		w.Add(name)
+		if _, err := w.Commit(msg, &git.CommitOptions{
+			Author: &object.Signature{Name: "Test", Email: "test@example.com", When: time.Now()},
+		}); err != nil {
+			t.Fatalf("failed to commit: %v", err)
+		}
+	}
+
+	events, err := core.Activity(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("Activity() error = %v", err)
+	}
+
+	var commits []ActivityEvent
+	for _, e := range events {
+		if e.Kind == ActivityCommit {
+			commits = append(commits, e)
+		}
+	}
+	if len(commits) != 1 {
+		t.Fatalf("got %d commit events, want 1: %+v", len(commits), events)
+	}
+	if commits[0].BeanID != b.ID || commits[0].Commit.Subject != "feat: work on cm1" {
+		t.Errorf("commit event = %+v, want %s / feat: work on cm1", commits[0], b.ID)
+	}
+}
@@ -0,0 +1,110 @@
+package beancore
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// AgingAction is the escalation the aging rules call for on a bean.
+type AgingAction struct {
+	BeanID string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	// Since is when the bean entered its current status.
+	Since time.Time `json:"since"`
+	// Priority is the bean's current priority.
+	Priority string `json:"priority"`
+	// NewPriority is the priority to raise the bean to, empty if unchanged.
+	NewPriority string `json:"new_priority,omitempty"`
+	// AddTags are the tags to add to the bean.
+	AddTags []string `json:"add_tags,omitempty"`
+}
+
+// PlanAging returns the escalations the configured aging rules call for,
+// sorted by bean ID, without changing any beans.
+func (c *Core) PlanAging(now time.Time) []AgingAction {
+	if c.config == nil || len(c.config.Beans.Aging) == 0 {
+		return []AgingAction{}
+	}
+	actions := []AgingAction{}
+	for _, b := range c.All() {
+		if action, ok := c.agingActionFor(b, now); ok {
+			actions = append(actions, action)
+		}
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].BeanID < actions[j].BeanID })
+	return actions
+}
+
+// ApplyAging applies the escalations the configured aging rules call for and
+// returns them. Stops at the first bean that fails to update.
+func (c *Core) ApplyAging(now time.Time) ([]AgingAction, error) {
+	applied := []AgingAction{}
+	for _, action := range c.PlanAging(now) {
+		b, err := c.Get(action.BeanID)
+		if err != nil {
+			return applied, err
+		}
+		if action.NewPriority != "" {
+			b.Priority = action.NewPriority
+		}
+		for _, tag := range action.AddTags {
+			if err := b.AddTag(tag); err != nil {
+				return applied, fmt.Errorf("aging %s: %w", action.BeanID, err)
+			}
+		}
+		if err := c.Update(b, nil); err != nil {
+			return applied, fmt.Errorf("aging %s: %w", action.BeanID, err)
+		}
+		applied = append(applied, action)
+	}
+	return applied, nil
+}
+
+// agingActionFor returns what the aging rules call for on a bean. The second
+// return value is false if nothing needs to change.
+func (c *Core) agingActionFor(b *bean.Bean, now time.Time) (AgingAction, bool) {
+	since, ok := b.StatusSince()
+	if !ok {
+		return AgingAction{}, false
+	}
+	priority := b.Priority
+	if priority == "" {
+		priority = "normal"
+	}
+	action := AgingAction{BeanID: b.ID, Title: b.Title, Status: b.Status, Since: since, Priority: priority}
+
+	target := priority
+	for _, rule := range c.config.Beans.Aging {
+		after, err := config.ParseSLADuration(rule.After)
+		if err != nil || !rule.AppliesTo(b.Status) || now.Sub(since) < after {
+			continue
+		}
+		if rule.Priority != "" && priorityRank(rule.Priority) < priorityRank(target) {
+			target = rule.Priority
+		}
+		if tag := bean.NormalizeTag(rule.Tag); tag != "" && !b.HasTag(tag) && !slices.Contains(action.AddTags, tag) {
+			action.AddTags = append(action.AddTags, tag)
+		}
+	}
+	if target != priority {
+		action.NewPriority = target
+	}
+	return action, action.NewPriority != "" || len(action.AddTags) > 0
+}
+
+// priorityRank returns a priority's position in the hardcoded priorities,
+// lower being more urgent. Unknown priorities rank last.
+func priorityRank(priority string) int {
+	for i, p := range config.DefaultPriorities {
+		if p.Name == priority {
+			return i
+		}
+	}
+	return len(config.DefaultPriorities)
+}
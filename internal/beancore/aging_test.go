@@ -0,0 +1,83 @@
+package beancore
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestPlanAndApplyAging(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.config.Beans.Aging = []config.AgingRule{
+		{After: "14d", Priority: "high", Tag: "aging"},
+		{After: "30d", Priority: "critical"},
+		{Statuses: []string{"in-progress"}, After: "7d", Tag: "stalled"},
+	}
+
+	now := time.Now().UTC()
+	aged := func(id, status, priority string, age time.Duration, tags ...string) {
+		t.Helper()
+		at := now.Add(-age)
+		b := &bean.Bean{ID: id, Slug: id, Title: id, Status: status, Priority: priority, Tags: tags}
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", id, err)
+		}
+		b.StatusHistory = []bean.StatusChange{{Status: status, ChangedAt: at}}
+	}
+	aged("fresh", "todo", "normal", 2*24*time.Hour)
+	aged("old", "todo", "normal", 20*24*time.Hour)
+	aged("ancient", "draft", "low", 40*24*time.Hour)
+	aged("already", "todo", "critical", 20*24*time.Hour, "aging")
+	aged("wip", "in-progress", "normal", 10*24*time.Hour)
+	aged("done", "completed", "normal", 90*24*time.Hour)
+
+	plan := core.PlanAging(now)
+	got := make(map[string]AgingAction, len(plan))
+	for _, a := range plan {
+		got[a.BeanID] = a
+	}
+	if len(plan) != 3 {
+		t.Fatalf("PlanAging() = %+v, want actions for old, ancient and wip", plan)
+	}
+	if a := got["old"]; a.NewPriority != "high" || !reflect.DeepEqual(a.AddTags, []string{"aging"}) {
+		t.Errorf("old: %+v, want priority high and +aging", a)
+	}
+	if a := got["ancient"]; a.Priority != "low" || a.NewPriority != "critical" {
+		t.Errorf("ancient: %+v, want priority low → critical", a)
+	}
+	if a := got["wip"]; a.NewPriority != "" || !reflect.DeepEqual(a.AddTags, []string{"stalled"}) {
+		t.Errorf("wip: %+v, want only +stalled", a)
+	}
+
+	// Planning doesn't change anything
+	if b, _ := core.Get("old"); b.Priority != "normal" || b.HasTag("aging") {
+		t.Errorf("PlanAging() changed bean: priority %s, tags %v", b.Priority, b.Tags)
+	}
+
+	applied, err := core.ApplyAging(now)
+	if err != nil {
+		t.Fatalf("ApplyAging() error = %v", err)
+	}
+	if len(applied) != 3 {
+		t.Errorf("ApplyAging() applied %d actions, want 3", len(applied))
+	}
+	if b, _ := core.Get("old"); b.Priority != "high" || !b.HasTag("aging") {
+		t.Errorf("old after aging: priority %s, tags %v", b.Priority, b.Tags)
+	}
+
+	// Applying again changes nothing
+	if again := core.PlanAging(now); len(again) != 0 {
+		t.Errorf("PlanAging() after applying = %+v, want nothing", again)
+	}
+}
+
+func TestAgingWithoutRules(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTestBean(t, core, "aaa1", "A", "todo")
+	if plan := core.PlanAging(time.Now().Add(365 * 24 * time.Hour)); len(plan) != 0 {
+		t.Errorf("PlanAging() without rules = %+v, want nothing", plan)
+	}
+}
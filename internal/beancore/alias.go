@@ -0,0 +1,40 @@
+package beancore
+
+import (
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// resolveAliases replaces aliased status and type names in a bean that was
+// just read from disk with the names they stand for.
+func (c *Core) resolveAliases(b *bean.Bean) {
+	if c.config == nil {
+		return
+	}
+	b.ResolveAliases(c.config.StatusAliases(), c.config.TypeAliases())
+}
+
+// RewriteAliases rewrites aliased status and type names in every bean file
+// on disk, including archived beans, to the names they stand for. With
+// dryRun, nothing is written. Returns the files that use aliases, sorted by
+// path.
+func (c *Core) RewriteAliases(dryRun bool) ([]MigratedFile, error) {
+	if c.config == nil {
+		return []MigratedFile{}, nil
+	}
+	statuses, types := c.config.StatusAliases(), c.config.TypeAliases()
+	files, _, err := c.rewriteBeanFiles(dryRun, func(content []byte) ([]byte, []string, error) {
+		return bean.RewriteAliases(content, statuses, types)
+	})
+	if err != nil {
+		return files, fmt.Errorf("rewriting aliases: %w", err)
+	}
+
+	if !dryRun && len(files) > 0 {
+		if err := c.Load(); err != nil {
+			return files, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,52 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAliasesOnLoad(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	core.config.Beans.Aliases.Types = map[string]string{"story": "feature"}
+
+	content := "---\ntitle: Legacy\nstatus: done\ntype: story\n---\n"
+	os.WriteFile(filepath.Join(beansDir, "old1--legacy.md"), []byte(content), 0644)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	b, err := core.Get("old1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if b.Status != "completed" || b.Type != "feature" {
+		t.Errorf("status %q, type %q; want completed, feature", b.Status, b.Type)
+	}
+	if issues, _ := core.ValidateFrontMatter(); len(issues) != 0 {
+		t.Errorf("ValidateFrontMatter() = %+v, want aliases accepted", issues)
+	}
+
+	files, err := core.RewriteAliases(true)
+	if err != nil {
+		t.Fatalf("RewriteAliases(dry run) error = %v", err)
+	}
+	if len(files) != 1 || files[0].Path != "old1--legacy.md" {
+		t.Fatalf("RewriteAliases(dry run) = %+v, want old1--legacy.md", files)
+	}
+	if data, _ := os.ReadFile(filepath.Join(beansDir, "old1--legacy.md")); string(data) != content {
+		t.Errorf("dry run changed the file:\n%s", data)
+	}
+
+	if _, err := core.RewriteAliases(false); err != nil {
+		t.Fatalf("RewriteAliases() error = %v", err)
+	}
+	data, _ := os.ReadFile(filepath.Join(beansDir, "old1--legacy.md"))
+	if !strings.Contains(string(data), "status: completed\ntype: feature\n") {
+		t.Errorf("file not rewritten to canonical names:\n%s", data)
+	}
+	if files, _ := core.RewriteAliases(true); len(files) != 0 {
+		t.Errorf("RewriteAliases() after rewrite = %+v, want nothing left", files)
+	}
+}
@@ -0,0 +1,361 @@
+package beancore
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// The archive bundle holds archived beans compacted into a single file by
+// CompactArchive: one JSON entry per line, only ever appended to. The last
+// entry for an ID wins, and a deleted entry removes the bean. A bean with a
+// file of its own (in the archive or elsewhere) takes precedence over its
+// bundle entry, so writing a compacted bean simply gives it a file again.
+const (
+	// BundleFileName is the bundle file in the archive directory.
+	BundleFileName = "bundle.jsonl"
+	// BundleIndexName is the bundle's index in the archive directory, mapping
+	// bean IDs to the offsets of their entries. It's rebuilt when it doesn't
+	// match the bundle.
+	BundleIndexName = "bundle.index.json"
+)
+
+// bundleEntry is one line of the archive bundle.
+type bundleEntry struct {
+	ID string `json:"id"`
+	// Path is the bean's archive path, relative to the beans directory.
+	Path string `json:"path,omitempty"`
+	// Content is the bean's file content.
+	Content string `json:"content,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+}
+
+// bundleIndex maps bean IDs to the byte offsets of their latest entries.
+type bundleIndex struct {
+	// Size is the size of the bundle the index was built for.
+	Size    int64            `json:"size"`
+	Offsets map[string]int64 `json:"offsets"`
+}
+
+func (c *Core) bundlePath() string {
+	return filepath.Join(c.root, ArchiveDir, BundleFileName)
+}
+
+func (c *Core) bundleIndexPath() string {
+	return filepath.Join(c.root, ArchiveDir, BundleIndexName)
+}
+
+// scanBundle reads the whole bundle at path, returning the latest live entry
+// for each ID and an index of their offsets. A missing bundle is empty.
+func scanBundle(path string) (map[string]*bundleEntry, *bundleIndex, error) {
+	entries := make(map[string]*bundleEntry)
+	idx := &bundleIndex{Offsets: make(map[string]int64)}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return entries, idx, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 && len(bytes.TrimSpace(line)) > 0 {
+			var e bundleEntry
+			if jsonErr := json.Unmarshal(line, &e); jsonErr != nil {
+				return nil, nil, fmt.Errorf("%s at offset %d: %w", BundleFileName, offset, jsonErr)
+			}
+			if e.Deleted {
+				delete(entries, e.ID)
+				delete(idx.Offsets, e.ID)
+			} else {
+				entries[e.ID] = &e
+				idx.Offsets[e.ID] = offset
+			}
+		}
+		offset += int64(len(line))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	idx.Size = offset
+	return entries, idx, nil
+}
+
+// readBundleIndex returns the bundle's index, rebuilding (and rewriting) it
+// if it's missing or out of date.
+func (c *Core) readBundleIndex() (*bundleIndex, error) {
+	info, err := os.Stat(c.bundlePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return &bundleIndex{Offsets: map[string]int64{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := os.ReadFile(c.bundleIndexPath()); err == nil {
+		idx := &bundleIndex{}
+		if json.Unmarshal(data, idx) == nil && idx.Size == info.Size() && idx.Offsets != nil {
+			return idx, nil
+		}
+	}
+
+	_, idx, err := scanBundle(c.bundlePath())
+	if err != nil {
+		return nil, err
+	}
+	if err := c.writeBundleIndex(idx); err != nil {
+		c.logger.Warn("failed to rewrite archive bundle index", "error", err)
+	}
+	return idx, nil
+}
+
+func (c *Core) writeBundleIndex(idx *bundleIndex) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.bundleIndexPath(), append(data, '\n'), 0644)
+}
+
+// readBundleEntry reads the bundle entry at offset.
+func (c *Core) readBundleEntry(offset int64) (*bundleEntry, error) {
+	f, err := os.Open(c.bundlePath())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	e := &bundleEntry{}
+	if err := json.Unmarshal(line, e); err != nil {
+		return nil, fmt.Errorf("%s at offset %d: %w", BundleFileName, offset, err)
+	}
+	return e, nil
+}
+
+// parseBundleEntry turns a bundle entry into a bean.
+func (c *Core) parseBundleEntry(e *bundleEntry) (*bean.Bean, error) {
+	b, err := c.parseBean([]byte(e.Content), filepath.FromSlash(e.Path), false, func() *time.Time { return nil })
+	if err != nil {
+		return nil, fmt.Errorf("loading %s from %s: %w", e.ID, BundleFileName, err)
+	}
+	return b, nil
+}
+
+// loadBundleLocked adds the beans in the archive bundle that don't have files
+// of their own. Must be called with c.mu held, after loading bean files.
+func (c *Core) loadBundleLocked() error {
+	c.bundle = nil
+	entries, idx, err := scanBundle(c.bundlePath())
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	c.bundle = idx.Offsets
+
+	for id, e := range entries {
+		if _, ok := c.beans[id]; ok {
+			continue
+		}
+		b, err := c.parseBundleEntry(e)
+		if err != nil {
+			return err
+		}
+		c.beans[b.ID] = b
+	}
+	return nil
+}
+
+// appendBundleLocked appends entries to the archive bundle and updates its
+// index. Must be called with c.mu held.
+func (c *Core) appendBundleLocked(entries []bundleEntry) error {
+	if err := os.MkdirAll(filepath.Join(c.root, ArchiveDir), 0755); err != nil {
+		return fmt.Errorf("creating archive directory: %w", err)
+	}
+	idx, err := c.readBundleIndex()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	offset := idx.Size
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+		if e.Deleted {
+			delete(idx.Offsets, e.ID)
+		} else {
+			idx.Offsets[e.ID] = offset
+		}
+		offset += int64(len(line))
+		buf.Write(line)
+	}
+
+	f, err := os.OpenFile(c.bundlePath(), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("opening archive bundle: %w", err)
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return fmt.Errorf("writing archive bundle: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	idx.Size = offset
+	c.bundle = idx.Offsets
+	return c.writeBundleIndex(idx)
+}
+
+// inBundleLocked reports whether the bundle has an entry for id. Must be
+// called with c.mu held.
+func (c *Core) inBundleLocked(id string) bool {
+	_, ok := c.bundle[id]
+	return ok
+}
+
+// materializeLocked gives a bean that only lives in the archive bundle its
+// own file again, so it can be renamed or removed like any other. Must be
+// called with c.mu held.
+func (c *Core) materializeLocked(b *bean.Bean) error {
+	if !c.inBundleLocked(b.ID) || b.Path == "" {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(c.root, b.Path)); err == nil {
+		return nil
+	}
+	return c.saveToDisk(b)
+}
+
+// Materialize gives a bean that only lives in the archive bundle its own file
+// again, so it can be opened in an editor. Beans with a file are left alone.
+func (c *Core) Materialize(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, _, err := c.findBeanLocked(id)
+	if err != nil {
+		return err
+	}
+	return c.materializeLocked(b)
+}
+
+// forgetBundledLocked records in the bundle that the bean with the given ID
+// no longer exists under it, so it doesn't come back on the next load. Must
+// be called with c.mu held.
+func (c *Core) forgetBundledLocked(id string) error {
+	if !c.inBundleLocked(id) {
+		return nil
+	}
+	return c.appendBundleLocked([]bundleEntry{{ID: id, Deleted: true}})
+}
+
+// archivedAt returns when an archived bean was completed or scrapped, as far
+// as its history tells.
+func archivedAt(b *bean.Bean) time.Time {
+	if n := len(b.StatusHistory); n > 0 {
+		return b.StatusHistory[n-1].ChangedAt
+	}
+	if b.UpdatedAt != nil {
+		return *b.UpdatedAt
+	}
+	return time.Time{}
+}
+
+// CompactArchive moves archived beans that were completed or scrapped before
+// the given time from their own files into the archive bundle, to keep the
+// number of files down. Local beans are left alone, as the bundle is shared. They stay loaded like any other bean. With dryRun,
+// only returns the beans that would be compacted. Beans are sorted by ID.
+func (c *Core) CompactArchive(before time.Time, dryRun bool) ([]*bean.Bean, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var compact []*bean.Bean
+	for _, b := range c.beans {
+		if !c.isArchivedPath(b.Path) || IsLocalPath(b.Path) || !archivedAt(b).Before(before) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(c.root, b.Path)); err != nil {
+			continue // already compacted
+		}
+		compact = append(compact, b)
+	}
+	slices.SortFunc(compact, func(a, b *bean.Bean) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	if dryRun || len(compact) == 0 {
+		return compact, nil
+	}
+
+	entries := make([]bundleEntry, 0, len(compact))
+	for _, b := range compact {
+		content, err := os.ReadFile(filepath.Join(c.root, b.Path))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", b.Path, err)
+		}
+		entries = append(entries, bundleEntry{ID: b.ID, Path: filepath.ToSlash(b.Path), Content: string(content)})
+	}
+	if err := c.appendBundleLocked(entries); err != nil {
+		return nil, err
+	}
+
+	// Only remove the files once the bundle has them
+	for _, b := range compact {
+		if err := os.Remove(filepath.Join(c.root, b.Path)); err != nil {
+			return nil, fmt.Errorf("removing %s: %w", b.Path, err)
+		}
+	}
+	c.markChanged()
+	return compact, nil
+}
+
+// getFromBundle loads a bean from the archive bundle by full ID. Returns nil,
+// nil if the bundle doesn't have it.
+func (c *Core) getFromBundle(id string) (*bean.Bean, error) {
+	idx, err := c.readBundleIndex()
+	if err != nil {
+		return nil, err
+	}
+	offset, ok := idx.Offsets[id]
+	if !ok {
+		return nil, nil
+	}
+	e, err := c.readBundleEntry(offset)
+	if err != nil {
+		return nil, err
+	}
+	return c.parseBundleEntry(e)
+}
@@ -0,0 +1,152 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestCompactArchive(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	longAgo := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, id := range []string{"old1", "old2", "old3"} {
+		b := &bean.Bean{ID: id, Slug: "done", Title: "Done " + id, Status: "completed",
+			StatusHistory: []bean.StatusChange{{Status: "completed", ChangedAt: longAgo}}}
+		if err := core.Create(b); err != nil {
+			t.Fatal(err)
+		}
+		if err := core.Archive(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	createTestBean(t, core, "new1", "Recent", "completed")
+	if err := core.Archive("new1"); err != nil {
+		t.Fatal(err)
+	}
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	preview, err := core.CompactArchive(cutoff, true)
+	if err != nil || len(preview) != 3 {
+		t.Fatalf("CompactArchive(dry run) = %d beans, %v; want 3", len(preview), err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, ArchiveDir, "old1--done.md")); err != nil {
+		t.Fatalf("dry run removed a file: %v", err)
+	}
+
+	compacted, err := core.CompactArchive(cutoff, false)
+	if err != nil || len(compacted) != 3 {
+		t.Fatalf("CompactArchive() = %d beans, %v; want 3", len(compacted), err)
+	}
+	for _, id := range []string{"old1", "old2", "old3"} {
+		if _, err := os.Stat(filepath.Join(beansDir, ArchiveDir, id+"--done.md")); !os.IsNotExist(err) {
+			t.Errorf("%s still has its own file", id)
+		}
+	}
+	if again, _ := core.CompactArchive(cutoff, false); len(again) != 0 {
+		t.Errorf("second CompactArchive() compacted %d beans, want 0", len(again))
+	}
+
+	// Compacted beans are loaded from the bundle
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if n := len(core.All()); n != 4 {
+		t.Errorf("All() = %d beans after reload, want 4", n)
+	}
+	b, err := core.Get("old1")
+	if err != nil || b.Title != "Done old1" || !core.IsArchived("old1") {
+		t.Fatalf("Get(old1) = %v, %v", b, err)
+	}
+
+	// GetFromArchive reads the bundle through its index, rebuilding it if needed
+	os.Remove(filepath.Join(beansDir, ArchiveDir, BundleIndexName))
+	if got, err := core.GetFromArchive("old2"); err != nil || got == nil || got.Title != "Done old2" {
+		t.Errorf("GetFromArchive(old2) = %v, %v", got, err)
+	}
+
+	// Materialize gives a compacted bean its own file, e.g. to edit it
+	if err := core.Materialize("old3"); err != nil {
+		t.Fatalf("Materialize() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, ArchiveDir, "old3--done.md")); err != nil {
+		t.Errorf("Materialize() didn't restore the file: %v", err)
+	}
+
+	// Updating a compacted bean gives it its own file again
+	b.Title = "Done and updated"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	// Deleting one leaves a tombstone so it doesn't come back
+	if err := core.Delete("old2"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	// Unarchiving moves one back out
+	if err := core.Unarchive("old3"); err != nil {
+		t.Fatalf("Unarchive() error = %v", err)
+	}
+
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got, err := core.Get("old1"); err != nil || got.Title != "Done and updated" {
+		t.Errorf("Get(old1) after update = %v, %v", got, err)
+	}
+	if _, err := core.Get("old2"); err != ErrNotFound {
+		t.Errorf("Get(old2) after delete error = %v, want ErrNotFound", err)
+	}
+	if got, err := core.GetFromArchive("old2"); err != nil || got != nil {
+		t.Errorf("GetFromArchive(old2) after delete = %v, %v", got, err)
+	}
+	if core.IsArchived("old3") {
+		t.Error("old3 still archived after Unarchive")
+	}
+}
+
+func TestWatchCompactedArchive(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	longAgo := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	b := &bean.Bean{ID: "old1", Slug: "done", Title: "Done", Status: "completed",
+		StatusHistory: []bean.StatusChange{{Status: "completed", ChangedAt: longAgo}}}
+	if err := core.Create(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.Archive("old1"); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := make(chan struct{}, 1)
+	if err := core.Watch(func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}); err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	defer core.Unwatch()
+	time.Sleep(50 * time.Millisecond)
+
+	// Another process compacts the archive, removing the bean's file
+	other := New(beansDir, core.config)
+	other.SetLogger(nil)
+	if err := other.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if compacted, err := other.CompactArchive(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), false); err != nil || len(compacted) != 1 {
+		t.Fatalf("CompactArchive() = %d beans, %v; want 1", len(compacted), err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(500 * time.Millisecond):
+	}
+	time.Sleep(150 * time.Millisecond)
+
+	if got, err := core.Get("old1"); err != nil || got.Title != "Done" {
+		t.Errorf("Get(old1) after compaction = %v, %v", got, err)
+	}
+}
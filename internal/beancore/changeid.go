@@ -0,0 +1,112 @@
+package beancore
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// validIDPattern matches IDs that can be mentioned in a body: letters, digits,
+// _, . and -, starting and ending with a letter or digit. IDs must not contain
+// "--" either, which separates the ID from the slug in filenames.
+var validIDPattern = regexp.MustCompile(`^[A-Za-z0-9](?:[\w.-]*[A-Za-z0-9])?$`)
+
+// ChangeID gives a bean a new ID, renaming its file and rewriting every
+// reference to it: parent, blocking, blocked_by and typed links of other beans,
+// and mentions in bodies. Both IDs may be short; the configured prefix is
+// prepended to a new ID that lacks it.
+func (c *Core) ChangeID(id, newID string) (*bean.Bean, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, oldID, err := c.findBeanLocked(id)
+	if err != nil {
+		return nil, err
+	}
+	newID = c.normalizeID(strings.TrimSpace(newID))
+	if newID == oldID {
+		return b, nil
+	}
+	if !validIDPattern.MatchString(newID) || strings.Contains(newID, "--") {
+		return nil, fmt.Errorf("invalid ID %q: use letters, digits, _, . and single dashes", newID)
+	}
+	if _, exists := c.beans[newID]; exists {
+		return nil, fmt.Errorf("a bean with ID %s already exists", newID)
+	}
+
+	newPath := beanPath(filepath.Dir(b.Path), bean.BuildFilename(newID, b.Slug))
+	if _, err := os.Stat(filepath.Join(c.root, newPath)); err == nil {
+		return nil, fmt.Errorf("file %s already exists", newPath)
+	}
+	if err := c.materializeLocked(b); err != nil {
+		return nil, err
+	}
+
+	// References are resolved while the bean is still known by its old ID.
+	// They are rewritten before the file is renamed, and the beans restored if
+	// either step fails.
+	saved := make(map[string]bean.Bean, len(c.beans))
+	for id, other := range c.beans {
+		copied := *other
+		copied.Links = maps.Clone(other.Links)
+		saved[id] = copied
+	}
+	if _, err := c.rewriteReferencesLocked(oldID, newID); err != nil {
+		c.restoreBeansLocked(saved)
+		return nil, err
+	}
+	oldPath := filepath.Join(c.root, b.Path)
+	if err := os.Rename(oldPath, filepath.Join(c.root, newPath)); err != nil {
+		c.restoreBeansLocked(saved)
+		return nil, fmt.Errorf("renaming %s: %w", b.ID, err)
+	}
+	b.Path = newPath
+	if err := c.forgetBundledLocked(oldID); err != nil {
+		if renameErr := os.Rename(filepath.Join(c.root, newPath), oldPath); renameErr != nil {
+			c.logger.Warn("failed to restore bean file", "bean", oldID, "error", renameErr)
+			return nil, err
+		}
+		c.restoreBeansLocked(saved)
+		return nil, err
+	}
+
+	b.ID = newID
+	delete(c.beans, oldID)
+	c.beans[newID] = b
+	if err := c.saveToDisk(b); err != nil {
+		return nil, err
+	}
+
+	// Update search index if active (best-effort, don't fail the rename)
+	if c.searchIndex != nil {
+		if err := c.searchIndex.DeleteBean(oldID); err != nil {
+			c.logger.Warn("failed to remove bean from search index", "bean", oldID, "error", err)
+		}
+		if err := c.searchIndex.IndexBean(b); err != nil {
+			c.logger.Warn("failed to index bean", "bean", newID, "error", err)
+		}
+	}
+
+	return b, nil
+}
+
+// restoreBeansLocked puts back beans that changed since saved was taken, in
+// memory and on disk. Beans that can't be written back are logged.
+func (c *Core) restoreBeansLocked(saved map[string]bean.Bean) {
+	for id, b := range c.beans {
+		old, ok := saved[id]
+		if !ok || reflect.DeepEqual(*b, old) {
+			continue
+		}
+		*b = old
+		if err := c.saveToDisk(b); err != nil {
+			c.logger.Warn("failed to restore bean", "bean", id, "error", err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package beancore
+
+import (
+	"github.com/hmans/beans/internal/bean"
+)
+
+// CloneOptions controls the behavior of CloneBean.
+type CloneOptions struct {
+	// Title overrides the title of the cloned bean (not of cloned children).
+	Title string
+	// Children also clones all descendants, recreating the hierarchy under the clone.
+	Children bool
+}
+
+// CloneBean creates a copy of a bean with a new ID, keeping its title, type,
+// priority, points, tags, parent and body. Timestamps, status history and git
+// fields are not copied, and beans in an archive status (completed, scrapped)
+// are reset to the default status, since a clone is new work.
+//
+// With opts.Children, descendants are cloned too. Blocking links between
+// cloned beans are recreated between the clones; links to beans outside the
+// cloned set are dropped. Returns the clone of the requested bean.
+func (c *Core) CloneBean(id string, opts CloneOptions) (*bean.Bean, error) {
+	src, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the beans to clone, parents before children
+	sources := []*bean.Bean{src}
+	if opts.Children {
+		c.mu.RLock()
+		seen := map[string]bool{src.ID: true}
+		for i := 0; i < len(sources); i++ {
+			for _, child := range c.childrenLocked(sources[i].ID) {
+				if !seen[child.ID] {
+					seen[child.ID] = true
+					sources = append(sources, child)
+				}
+			}
+		}
+		c.mu.RUnlock()
+	}
+
+	// Create the clones one by one, so every ID scheme sees the previous ones
+	newIDs := make(map[string]string, len(sources))
+	clones := make([]*bean.Bean, len(sources))
+	for i, s := range sources {
+		clone := c.cloneFields(s)
+		if i == 0 && opts.Title != "" {
+			clone.Title = opts.Title
+			clone.Slug = bean.Slugify(opts.Title)
+		}
+		if newParent, ok := newIDs[s.Parent]; ok {
+			clone.Parent = newParent
+		}
+		if err := c.Create(clone); err != nil {
+			return nil, err
+		}
+		newIDs[s.ID] = clone.ID
+		clones[i] = clone
+	}
+
+	// Recreate links between the clones
+	for i, s := range sources {
+		blocking := remapLinks(s.Blocking, newIDs)
+		blockedBy := remapLinks(s.BlockedBy, newIDs)
+		if len(blocking) == 0 && len(blockedBy) == 0 {
+			continue
+		}
+		clones[i].Blocking = blocking
+		clones[i].BlockedBy = blockedBy
+		if err := c.Update(clones[i], nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return clones[0], nil
+}
+
+// cloneFields copies the cloneable fields of a bean into a new bean.
+func (c *Core) cloneFields(s *bean.Bean) *bean.Bean {
+	clone := &bean.Bean{
+		Slug:     s.Slug,
+		Title:    s.Title,
+		Status:   s.Status,
+		Type:     s.Type,
+		Priority: s.Priority,
+		Parent:   s.Parent,
+		Body:     s.Body,
+	}
+	if s.Slug == "" {
+		clone.Slug = bean.Slugify(s.Title)
+	}
+	if c.config != nil && c.config.IsArchiveStatus(s.Status) {
+		clone.Status = c.config.GetDefaultStatus()
+	}
+	if s.Points != nil {
+		points := *s.Points
+		clone.Points = &points
+	}
+	if len(s.Tags) > 0 {
+		clone.Tags = append([]string(nil), s.Tags...)
+	}
+	return clone
+}
+
+// remapLinks maps link targets to their clones, dropping targets that weren't cloned.
+func remapLinks(targets []string, newIDs map[string]string) []string {
+	var result []string
+	for _, t := range targets {
+		if id, ok := newIDs[t]; ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
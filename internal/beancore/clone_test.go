@@ -0,0 +1,96 @@
+package beancore
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestCloneBean(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	five := 5
+	src := &bean.Bean{ID: "src1", Slug: "source", Title: "Source", Status: "completed", Type: "feature",
+		Priority: "high", Points: &five, Tags: []string{"ui"}, Body: "Some body", GitBranch: "src1/source"}
+	if err := core.Create(src); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	clone, err := core.CloneBean("src1", CloneOptions{})
+	if err != nil {
+		t.Fatalf("CloneBean() error = %v", err)
+	}
+
+	if clone.ID == "" || clone.ID == src.ID {
+		t.Errorf("clone ID = %q, want a new ID", clone.ID)
+	}
+	if clone.Title != "Source" || clone.Type != "feature" || clone.Priority != "high" || clone.Body != "Some body" {
+		t.Errorf("clone fields not copied: %+v", clone)
+	}
+	if clone.Points == nil || *clone.Points != 5 || clone.Points == src.Points {
+		t.Errorf("Points = %v, want a copy of 5", clone.Points)
+	}
+	if !reflect.DeepEqual(clone.Tags, []string{"ui"}) {
+		t.Errorf("Tags = %v, want [ui]", clone.Tags)
+	}
+	if clone.Status != "todo" {
+		t.Errorf("Status = %q, want completed bean reset to default todo", clone.Status)
+	}
+	if clone.GitBranch != "" {
+		t.Errorf("GitBranch = %q, want git fields dropped", clone.GitBranch)
+	}
+	if len(clone.StatusHistory) != 1 || clone.StatusHistory[0].Status != "todo" {
+		t.Errorf("StatusHistory = %v, want fresh history", clone.StatusHistory)
+	}
+
+	if _, err := core.CloneBean("nope", CloneOptions{}); err != ErrNotFound {
+		t.Errorf("CloneBean(nope) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestCloneBeanWithChildren(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	epic := createTestBean(t, core, "epic1", "Release", "todo")
+	a := &bean.Bean{ID: "a1", Slug: "a", Title: "Prepare", Status: "todo", Parent: "epic1", Blocking: []string{"b1", "ext1"}}
+	b := &bean.Bean{ID: "b1", Slug: "b", Title: "Ship", Status: "todo", Parent: "epic1"}
+	c := &bean.Bean{ID: "c1", Slug: "c", Title: "Announce", Status: "todo", Parent: "b1"}
+	createTestBean(t, core, "ext1", "External", "todo")
+	for _, x := range []*bean.Bean{a, b, c} {
+		if err := core.Create(x); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	clone, err := core.CloneBean(epic.ID, CloneOptions{Title: "Release 2", Children: true})
+	if err != nil {
+		t.Fatalf("CloneBean() error = %v", err)
+	}
+	if clone.Title != "Release 2" || clone.Slug != "release-2" {
+		t.Errorf("clone title/slug = %q/%q, want Release 2/release-2", clone.Title, clone.Slug)
+	}
+
+	// Index the clones by title
+	byTitle := map[string]*bean.Bean{}
+	for _, x := range core.All() {
+		if x.ID != a.ID && x.ID != b.ID && x.ID != c.ID && x.ID != epic.ID {
+			byTitle[x.Title] = x
+		}
+	}
+	prepare, ship, announce := byTitle["Prepare"], byTitle["Ship"], byTitle["Announce"]
+	if prepare == nil || ship == nil || announce == nil {
+		t.Fatalf("children not cloned: %v", byTitle)
+	}
+	if prepare.Parent != clone.ID || ship.Parent != clone.ID || announce.Parent != ship.ID {
+		t.Errorf("hierarchy not recreated: prepare→%s ship→%s announce→%s", prepare.Parent, ship.Parent, announce.Parent)
+	}
+	if !reflect.DeepEqual(prepare.Blocking, []string{ship.ID}) {
+		t.Errorf("Blocking = %v, want only the cloned sibling %s", prepare.Blocking, ship.ID)
+	}
+
+	// Originals are untouched
+	if orig, _ := core.Get("a1"); !reflect.DeepEqual(orig.Blocking, []string{"b1", "ext1"}) {
+		t.Errorf("original Blocking changed: %v", orig.Blocking)
+	}
+}
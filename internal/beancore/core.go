@@ -3,20 +3,26 @@
 package beancore
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"hash/fnv"
-	"io"
+	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/gitflow"
+	"github.com/hmans/beans/internal/logging"
 	"github.com/hmans/beans/internal/search"
 )
 
@@ -52,11 +58,43 @@ type Core struct {
 	mu    sync.RWMutex
 	beans map[string]*bean.Bean // ID -> Bean
 
+	// Generation counter, bumped on every change to the bean set (see Generation)
+	generation atomic.Uint64
+
+	// Reverse link and mention indexes (lazily built for the current
+	// generation; see index.go)
+	linkIndex        map[string][]IncomingLink
+	mentionIndex     map[string][]string
+	mentionedByIndex map[string][]string
+	aliasIndex       map[string][]string
+	linkIndexGen     uint64
+	indexMu          sync.Mutex
+
 	// Search index (optional, lazy-initialized)
 	searchIndex *search.Index
 
+	// Offsets of the beans in the archive bundle (see bundle.go)
+	bundle map[string]int64
+
+	// Built-in and configured secret patterns, compiled on first use after
+	// each load (see secrets.go)
+	secretPatterns []secretPattern
+	secretsMu      sync.Mutex
+
+	// Directory defaults by directory, read on first use after each load
+	// (see defaults.go)
+	dirDefaults   map[string]DirDefaults
+	dirDefaultsMu sync.Mutex
+
+	// The current user's handle for ID prefixes, resolved on first use, as
+	// that runs git (see ids.go)
+	handle     string
+	handleOnce sync.Once
+
 	// Git integration (optional)
-	gitFlow *gitflow.GitFlow
+	gitFlow    *gitflow.GitFlow
+	prProvider gitflow.PRProvider // lazily defaults to GitHub
+	autoStash  bool               // stash dirty trees around branch operations (see withCleanTree)
 
 	// File watching (optional)
 	watching bool
@@ -68,8 +106,8 @@ type Core struct {
 	subMu       sync.RWMutex
 	nextSubID   uint64
 
-	// Warning logger for non-fatal errors (defaults to stderr)
-	warnWriter io.Writer
+	// Logger for non-fatal problems (defaults to warnings on stderr)
+	logger *slog.Logger
 }
 
 // New creates a new Core with the given root path and configuration.
@@ -79,21 +117,25 @@ func New(root string, cfg *config.Config) *Core {
 		config:      cfg,
 		beans:       make(map[string]*bean.Bean),
 		subscribers: make(map[uint64]*subscription),
-		warnWriter:  os.Stderr,
+		logger:      logging.New(os.Stderr, slog.LevelWarn, logging.FormatText),
 	}
 }
 
-// SetWarnWriter sets the writer for warning messages.
-// Pass nil to disable warnings.
-func (c *Core) SetWarnWriter(w io.Writer) {
-	c.warnWriter = w
+// SetLogger sets the logger for non-fatal problems, which is also passed on
+// to git integration. Pass nil to disable logging.
+func (c *Core) SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = logging.Discard
+	}
+	c.logger = l
+	if c.gitFlow != nil {
+		c.gitFlow.SetLogger(l)
+	}
 }
 
-// logWarn logs a warning message if a warn writer is configured.
-func (c *Core) logWarn(format string, args ...any) {
-	if c.warnWriter != nil {
-		fmt.Fprintf(c.warnWriter, "warning: "+format+"\n", args...)
-	}
+// Logger returns the core's logger.
+func (c *Core) Logger() *slog.Logger {
+	return c.logger
 }
 
 // Root returns the absolute path to the .beans directory.
@@ -119,11 +161,18 @@ func (c *Core) Load() error {
 func (c *Core) loadFromDisk() error {
 	// Clear existing beans
 	c.beans = make(map[string]*bean.Bean)
+	c.markChanged()
+	c.resetLoadCaches()
 
 	// Walk the entire .beans directory tree, loading all .md files
-	err := filepath.WalkDir(c.root, func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return err
+	err := c.walkUnignored(c.root, func(path string, d os.DirEntry) error {
+		// Defaults files are read as their beans are; just warn about broken ones
+		if !d.IsDir() && d.Name() == DefaultsFileName {
+			rel, _ := filepath.Rel(c.root, filepath.Dir(path))
+			if _, err := c.readDirDefaults(rel); err != nil {
+				c.logger.Warn("ignoring invalid defaults file", "file", filepath.Join(rel, DefaultsFileName), "error", err)
+			}
+			return nil
 		}
 
 		// Skip non-.md files
@@ -131,7 +180,7 @@ func (c *Core) loadFromDisk() error {
 			return nil
 		}
 
-		b, loadErr := c.loadBean(path)
+		b, loadErr := c.loadBeanChecked(path)
 		if loadErr != nil {
 			return fmt.Errorf("loading %s: %w", path, loadErr)
 		}
@@ -143,43 +192,124 @@ func (c *Core) loadFromDisk() error {
 		return err
 	}
 
+	// Archived beans compacted into the bundle
+	if err := c.loadBundleLocked(); err != nil {
+		return err
+	}
+
 	// Reinitialize search index if it was active: close and re-create (best-effort, don't fail load)
 	if c.searchIndex != nil {
 		c.searchIndex.Close()
 		c.searchIndex = nil
 
 		if err := c.ensureSearchIndexLocked(); err != nil {
-			c.logWarn("failed to reinitialize search index after reload: %v", err)
+			c.logger.Warn("failed to reinitialize search index after reload", "error", err)
 		}
 	}
 
 	return nil
 }
 
+// resetLoadCaches forgets what is cached per load: the compiled secret
+// patterns and the directory defaults.
+func (c *Core) resetLoadCaches() {
+	c.secretsMu.Lock()
+	c.secretPatterns = nil
+	c.secretsMu.Unlock()
+	c.forgetDirDefaults()
+}
+
+// isIgnored reports whether a path in the beans directory matches the
+// configured ignore patterns.
+func (c *Core) isIgnored(path string, isDir bool) bool {
+	if c.config == nil {
+		return false
+	}
+	rel, err := filepath.Rel(c.root, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return false
+	}
+	return c.config.IsIgnored(rel, isDir)
+}
+
+// walkUnignored walks the file tree at root like filepath.WalkDir, skipping
+// files and directories that match the ignore patterns. Errors reading the
+// tree end the walk.
+func (c *Core) walkUnignored(root string, fn func(path string, d os.DirEntry) error) error {
+	return filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if c.isIgnored(path, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return fn(path, d)
+	})
+}
+
 // loadBean reads and parses a single bean file.
 func (c *Core) loadBean(path string) (*bean.Bean, error) {
-	f, err := os.Open(path)
+	return c.readBean(path, false)
+}
+
+// loadBeanChecked is loadBean, but also warns about any front matter issues
+// found by strict validation. Used when beans are (re)loaded from disk.
+func (c *Core) loadBeanChecked(path string) (*bean.Bean, error) {
+	return c.readBean(path, true)
+}
+
+func (c *Core) readBean(path string, warn bool) (*bean.Bean, error) {
+	content, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	b, err := bean.Parse(f)
+	relPath, err := filepath.Rel(c.root, path)
 	if err != nil {
 		return nil, err
 	}
 
-	// Set metadata from path
-	relPath, err := filepath.Rel(c.root, path)
+	return c.parseBean(content, relPath, warn, func() *time.Time {
+		// Use file modification time as fallback
+		info, statErr := os.Stat(path)
+		if statErr != nil {
+			return nil
+		}
+		modTime := info.ModTime().UTC().Truncate(time.Second)
+		return &modTime
+	})
+}
+
+// parseBean parses the content of the bean file at relPath (relative to the
+// beans directory). fallbackTime supplies the creation time of beans that
+// have no timestamps.
+func (c *Core) parseBean(content []byte, relPath string, warn bool, fallbackTime func() *time.Time) (*bean.Bean, error) {
+	// Parse is lenient; surface anything strict validation objects to,
+	// with positions, before a hard parse error hides the details.
+	if warn {
+		c.warnFrontMatterIssues(relPath, content)
+	}
+
+	b, err := bean.Parse(bytes.NewReader(content))
 	if err != nil {
 		return nil, err
 	}
-	b.Path = relPath
+
+	c.resolveAliases(b)
+
+	// Set metadata from path
+	b.Path = filepath.ToSlash(relPath)
 
 	// Extract ID and slug from filename
-	filename := filepath.Base(path)
+	filename := filepath.Base(relPath)
 	b.ID, b.Slug = bean.ParseFilename(filename)
 
+	// Fill in what the directory's defaults file sets
+	c.applyDirDefaults(b, filepath.Dir(relPath))
+
 	// Apply defaults for GraphQL non-nullable fields
 	if b.Type == "" {
 		b.Type = "task"
@@ -197,12 +327,7 @@ func (c *Core) loadBean(path string) (*bean.Bean, error) {
 		if b.UpdatedAt != nil {
 			b.CreatedAt = b.UpdatedAt
 		} else {
-			// Use file modification time as fallback
-			info, statErr := os.Stat(path)
-			if statErr == nil {
-				modTime := info.ModTime().UTC().Truncate(time.Second)
-				b.CreatedAt = &modTime
-			}
+			b.CreatedAt = fallbackTime()
 		}
 	}
 	if b.UpdatedAt == nil {
@@ -284,34 +409,33 @@ func (c *Core) All() []*bean.Bean {
 
 // Get finds a bean by exact ID match.
 // If a prefix is configured and the query doesn't include it, the prefix is automatically prepended.
-// For example, with prefix "beans-", Get("abc") will match "beans-abc" but Get("ab") will not.
+// For example, with prefix "beans-", Get("abc") will match "beans-abc" but Get("ab") will not,
+// unless prefix_matching is enabled and no other bean's ID starts with "beans-ab".
+// If other beans' IDs do, Get returns an *AmbiguousIDError listing them.
 func (c *Core) Get(id string) (*bean.Bean, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	// Try exact match
-	if b, ok := c.beans[id]; ok {
-		return b, nil
-	}
-
-	// If not found and we have a configured prefix that isn't already in the query,
-	// try with the prefix prepended (allows short IDs like "abc" to match "beans-abc")
-	if c.config != nil && c.config.Beans.Prefix != "" && !strings.HasPrefix(id, c.config.Beans.Prefix) {
-		if b, ok := c.beans[c.config.Beans.Prefix+id]; ok {
-			return b, nil
-		}
-	}
-
-	return nil, ErrNotFound
+	b, _, err := c.findBeanLocked(id)
+	return b, err
 }
 
-// NormalizeID resolves a potentially short ID to its full form.
+// NormalizeID resolves a potentially short ID, or an alias, to its full form, as Get would.
 // If a prefix is configured and the query doesn't include it, the prefix is automatically prepended.
 // Returns the full ID and true if found, or the original ID and false if not found.
 func (c *Core) NormalizeID(id string) (string, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	if _, fullID, err := c.findBeanLocked(id); err == nil {
+		return fullID, true
+	}
+	return id, false
+}
+
+// resolveIDLocked returns the ID of the bean a full or short ID names.
+// Must be called with c.mu held.
+func (c *Core) resolveIDLocked(id string) (string, bool) {
 	// Try exact match
 	if _, ok := c.beans[id]; ok {
 		return id, true
@@ -326,31 +450,71 @@ func (c *Core) NormalizeID(id string) (string, bool) {
 		}
 	}
 
-	return id, false
+	return "", false
 }
 
 // Create adds a new bean, generating an ID if needed, and writes it to disk.
 func (c *Core) Create(b *bean.Bean) error {
+	return c.create(b, "")
+}
+
+// CreateInDir is Create, but puts the bean's file into dir (relative to the
+// beans directory), where it gets the directory's defaults (see DirDefaults).
+func (c *Core) CreateInDir(b *bean.Bean, dir string) error {
+	dir = filepath.Clean(dir)
+	if filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("directory %s is outside the beans directory", dir)
+	}
+	if dir == "." {
+		dir = ""
+	}
+	if c.isArchivedPath(dir + "/") {
+		return fmt.Errorf("cannot create beans in the archive directory")
+	}
+	return c.create(b, dir)
+}
+
+// create implements Create, CreateInDir and CreateLocal. Unless the bean already has a
+// path, its file goes into dir (relative to the beans directory).
+func (c *Core) create(b *bean.Bean, dir string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Generate ID if not provided
 	if b.ID == "" {
-		prefix := ""
-		length := 4
-		if c.config != nil {
-			prefix = c.config.Beans.Prefix
-			if c.config.Beans.IDLength > 0 {
-				length = c.config.Beans.IDLength
-			}
-		}
-		b.ID = bean.NewID(prefix, length)
+		b.ID = c.newID("")
+	}
+	if b.Path == "" && dir != "" {
+		b.Path = beanPath(dir, bean.BuildFilename(b.ID, b.Slug))
+	}
+
+	// Fill in the directory's defaults, then the project's
+	c.applyDirDefaults(b, filepath.Dir(b.Path))
+	if b.Type == "" && c.config != nil {
+		b.Type = c.config.GetDefaultType()
+	}
+
+	if err := c.checkTitle(&b.Title); err != nil {
+		return err
+	}
+	if err := c.checkRequired(b, nil); err != nil {
+		return err
+	}
+	if err := c.checkTags(b, nil); err != nil {
+		return err
+	}
+	if err := c.checkAliases(b, nil); err != nil {
+		return err
+	}
+	if err := c.checkSecrets(b, nil); err != nil {
+		return err
 	}
 
 	// Set timestamps
 	now := time.Now().UTC().Truncate(time.Second)
 	b.CreatedAt = &now
 	b.UpdatedAt = &now
+	b.RecordStatusChange(b.Status, now)
 
 	// Write to disk
 	if err := c.saveToDisk(b); err != nil {
@@ -363,7 +527,7 @@ func (c *Core) Create(b *bean.Bean) error {
 	// Update search index if active (best-effort, don't fail create)
 	if c.searchIndex != nil {
 		if err := c.searchIndex.IndexBean(b); err != nil {
-			c.logWarn("failed to index bean %s: %v", b.ID, err)
+			c.logger.Warn("failed to index bean", "bean", b.ID, "error", err)
 		}
 	}
 
@@ -383,6 +547,11 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string) error {
 		return ErrNotFound
 	}
 
+	// A compacted bean gets its own file back
+	if err := c.materializeLocked(existingBean); err != nil {
+		return err
+	}
+
 	// Reload old state from disk to get true previous state
 	// (needed because user might have modified the bean from Get() before calling Update)
 	var oldBean *bean.Bean
@@ -434,6 +603,19 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string) error {
 		}
 	}
 
+	if err := c.checkRequired(b, oldBean); err != nil {
+		return err
+	}
+	if err := c.checkTags(b, oldBean); err != nil {
+		return err
+	}
+	if err := c.checkAliases(b, oldBean); err != nil {
+		return err
+	}
+	if err := c.checkSecrets(b, oldBean); err != nil {
+		return err
+	}
+
 	// Preserve CreatedAt from old bean
 	if b.CreatedAt == nil && oldBean.CreatedAt != nil {
 		b.CreatedAt = oldBean.CreatedAt
@@ -443,6 +625,22 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string) error {
 	now := time.Now().UTC().Truncate(time.Second)
 	b.UpdatedAt = &now
 
+	// Record status transitions, seeding the history of beans created before
+	// it was tracked with their previous status
+	if len(b.StatusHistory) == 0 {
+		b.StatusHistory = append([]bean.StatusChange(nil), oldBean.StatusHistory...)
+	}
+	if oldBean.Status != b.Status {
+		if len(b.StatusHistory) == 0 && oldBean.Status != "" {
+			seed := now
+			if oldBean.CreatedAt != nil {
+				seed = *oldBean.CreatedAt
+			}
+			b.RecordStatusChange(oldBean.Status, seed)
+		}
+		b.RecordStatusChange(b.Status, now)
+	}
+
 	// GIT HOOK: Detect status transition and handle git branch creation
 	if c.IsGitFlowEnabled() {
 		if err := c.handleGitTransition(oldBean, b); err != nil {
@@ -450,6 +648,13 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string) error {
 		}
 	}
 
+	// Keep the filename in step with the title
+	if c.config != nil && c.config.Beans.RenameOnSlugChange && b.Title != oldBean.Title {
+		if err := c.renameToSlugLocked(b, bean.Slugify(b.Title)); err != nil {
+			return err
+		}
+	}
+
 	// Write to disk
 	if err := c.saveToDisk(b); err != nil {
 		return err
@@ -461,7 +666,7 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string) error {
 	// Update search index if active (best-effort, don't fail update)
 	if c.searchIndex != nil {
 		if err := c.searchIndex.IndexBean(b); err != nil {
-			c.logWarn("failed to update bean %s in search index: %v", b.ID, err)
+			c.logger.Warn("failed to update bean in search index", "bean", b.ID, "error", err)
 		}
 	}
 
@@ -470,6 +675,9 @@ func (c *Core) Update(b *bean.Bean, ifMatch *string) error {
 
 // saveToDisk writes a bean to the filesystem.
 func (c *Core) saveToDisk(b *bean.Bean) error {
+	// The bean's links may have changed
+	c.markChanged()
+
 	// Determine the file path
 	var path string
 	if b.Path != "" {
@@ -480,7 +688,12 @@ func (c *Core) saveToDisk(b *bean.Bean) error {
 		b.Path = filename
 	}
 
-	// Ensure parent directory exists
+	// Ensure parent directory exists (and keeps local beans out of git)
+	if IsLocalPath(b.Path) {
+		if err := c.ensureLocalDir(); err != nil {
+			return err
+		}
+	}
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("creating directory: %w", err)
@@ -500,42 +713,34 @@ func (c *Core) saveToDisk(b *bean.Bean) error {
 }
 
 // Delete removes a bean by exact ID match.
-// Supports short IDs (without prefix) if a prefix is configured.
+// Supports short IDs (without prefix) if a prefix is configured, and unique
+// prefixes if prefix_matching is enabled.
 func (c *Core) Delete(id string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Find the bean by exact match
-	targetID := id
-	targetBean, ok := c.beans[id]
-
-	// If not found and we have a configured prefix, try with prefix prepended
-	if !ok && c.config != nil && c.config.Beans.Prefix != "" && !strings.HasPrefix(id, c.config.Beans.Prefix) {
-		fullID := c.config.Beans.Prefix + id
-		if b, found := c.beans[fullID]; found {
-			targetID = fullID
-			targetBean = b
-			ok = true
-		}
-	}
-
-	if !ok {
-		return ErrNotFound
+	targetBean, targetID, err := c.findBeanLocked(id)
+	if err != nil {
+		return err
 	}
 
-	// Remove from disk
+	// Remove from disk (a compacted bean may have no file of its own)
 	path := filepath.Join(c.root, targetBean.Path)
-	if err := os.Remove(path); err != nil {
+	if err := os.Remove(path); err != nil && !(os.IsNotExist(err) && c.inBundleLocked(targetID)) {
+		return err
+	}
+	if err := c.forgetBundledLocked(targetID); err != nil {
 		return err
 	}
 
 	// Remove from in-memory map
 	delete(c.beans, targetID)
+	c.markChanged()
 
 	// Update search index if active (best-effort, don't fail delete)
 	if c.searchIndex != nil {
 		if err := c.searchIndex.DeleteBean(targetID); err != nil {
-			c.logWarn("failed to remove bean %s from search index: %v", targetID, err)
+			c.logger.Warn("failed to remove bean from search index", "bean", targetID, "error", err)
 		}
 	}
 
@@ -559,15 +764,14 @@ func (c *Core) Archive(id string) error {
 		return nil // Already archived, nothing to do
 	}
 
-	// Ensure archive directory exists
-	archivePath := filepath.Join(c.root, ArchiveDir)
-	if err := os.MkdirAll(archivePath, 0755); err != nil {
+	// Ensure the archive directory (for the configured layout) exists
+	newRelPath := c.archivePathFor(targetBean)
+	if err := os.MkdirAll(filepath.Join(c.root, filepath.Dir(newRelPath)), 0755); err != nil {
 		return fmt.Errorf("creating archive directory: %w", err)
 	}
 
 	// Move the file
 	oldPath := filepath.Join(c.root, targetBean.Path)
-	newRelPath := filepath.Join(ArchiveDir, filepath.Base(targetBean.Path))
 	newPath := filepath.Join(c.root, newRelPath)
 
 	if err := os.Rename(oldPath, newPath); err != nil {
@@ -577,6 +781,7 @@ func (c *Core) Archive(id string) error {
 	// Update bean's path
 	targetBean.Path = newRelPath
 	c.beans[targetID] = targetBean
+	c.markChanged()
 
 	return nil
 }
@@ -598,17 +803,10 @@ func (c *Core) Unarchive(id string) error {
 		return nil // Not archived, nothing to do
 	}
 
-	// Move the file back to main directory
-	oldPath := filepath.Join(c.root, targetBean.Path)
-	newRelPath := filepath.Base(targetBean.Path)
-	newPath := filepath.Join(c.root, newRelPath)
-
-	if err := os.Rename(oldPath, newPath); err != nil {
-		return fmt.Errorf("moving bean from archive: %w", err)
+	// Move the file back to where it was before archiving
+	if err := c.moveFromArchiveLocked(targetBean); err != nil {
+		return err
 	}
-
-	// Update bean's path
-	targetBean.Path = newRelPath
 	c.beans[targetID] = targetBean
 
 	return nil
@@ -630,8 +828,72 @@ func (c *Core) IsArchived(id string) bool {
 
 // isArchivedPath returns true if the path indicates an archived bean.
 func (c *Core) isArchivedPath(path string) bool {
-	return strings.HasPrefix(path, ArchiveDir+string(filepath.Separator)) ||
-		strings.HasPrefix(path, ArchiveDir+"/")
+	path = strings.TrimPrefix(filepath.ToSlash(path), LocalDir+"/")
+	return strings.HasPrefix(path, ArchiveDir+"/")
+}
+
+// archiveMonthDir matches the year/month directories of the date archive layout.
+var archiveMonthDir = regexp.MustCompile(`^\d{4}/\d{2}$`)
+
+// archivePathFor returns the path (relative to the beans directory) b's file
+// moves to when it's archived, following the configured archive layout.
+func (c *Core) archivePathFor(b *bean.Bean) string {
+	name := filepath.Base(b.Path)
+	if IsLocalPath(b.Path) {
+		// Local beans have an archive of their own, so they stay out of git
+		return beanPath(LocalDir, ArchiveDir, name)
+	}
+	layout := config.ArchiveLayoutFlat
+	if c.config != nil {
+		layout = c.config.GetArchiveLayout()
+	}
+
+	switch layout {
+	case config.ArchiveLayoutDate:
+		at := time.Now().UTC()
+		if n := len(b.StatusHistory); n > 0 {
+			at = b.StatusHistory[n-1].ChangedAt
+		} else if b.UpdatedAt != nil {
+			at = *b.UpdatedAt
+		}
+		return beanPath(ArchiveDir, at.Format("2006"), at.Format("01"), name)
+	case config.ArchiveLayoutMirror:
+		return beanPath(ArchiveDir, filepath.Dir(b.Path), name)
+	}
+	return beanPath(ArchiveDir, name)
+}
+
+// unarchivedPath returns where an archived file goes back to: its original
+// subdirectory, as kept by the mirror layout, or the beans directory. Works
+// for files archived with any layout, whatever the current setting.
+func unarchivedPath(archived string) string {
+	if IsLocalPath(archived) {
+		return beanPath(LocalDir, filepath.Base(archived))
+	}
+	rel := strings.TrimPrefix(filepath.ToSlash(archived), ArchiveDir+"/")
+	dir := path.Dir(rel)
+	if archiveMonthDir.MatchString(dir) {
+		dir = "."
+	}
+	return beanPath(dir, filepath.Base(archived))
+}
+
+// moveFromArchiveLocked moves an archived bean's file back out of the archive
+// and updates its path. Must be called with c.mu held.
+func (c *Core) moveFromArchiveLocked(b *bean.Bean) error {
+	if err := c.materializeLocked(b); err != nil {
+		return err
+	}
+	newRelPath := unarchivedPath(b.Path)
+	if err := os.MkdirAll(filepath.Join(c.root, filepath.Dir(newRelPath)), 0755); err != nil {
+		return fmt.Errorf("creating directory: %w", err)
+	}
+	if err := os.Rename(filepath.Join(c.root, b.Path), filepath.Join(c.root, newRelPath)); err != nil {
+		return fmt.Errorf("moving bean from archive: %w", err)
+	}
+	b.Path = newRelPath
+	c.markChanged()
+	return nil
 }
 
 // normalizeID returns the full ID with prefix if a prefix is configured
@@ -643,8 +905,8 @@ func (c *Core) normalizeID(id string) string {
 	return id
 }
 
-// findBeanLocked finds a bean by ID, supporting short IDs.
-// Must be called with lock held.
+// findBeanLocked finds a bean by ID, supporting short IDs, aliases and, with
+// prefix_matching, unique prefixes. Must be called with lock held.
 func (c *Core) findBeanLocked(id string) (*bean.Bean, string, error) {
 	// Try exact match
 	if b, ok := c.beans[id]; ok {
@@ -659,6 +921,26 @@ func (c *Core) findBeanLocked(id string) (*bean.Bean, string, error) {
 		}
 	}
 
+	// Aliases (e.g. an ID from an imported tracker) name beans too
+	switch ids := c.aliasIDsLocked(id); len(ids) {
+	case 0:
+	case 1:
+		return c.beans[ids[0]], ids[0], nil
+	default:
+		return nil, "", &AmbiguousIDError{ID: id, Candidates: ids}
+	}
+
+	// With prefix_matching, any unique prefix of an ID will do
+	if c.config != nil && c.config.Beans.PrefixMatching {
+		switch ids := c.matchIDsLocked(id); len(ids) {
+		case 0:
+		case 1:
+			return c.beans[ids[0]], ids[0], nil
+		default:
+			return nil, "", &AmbiguousIDError{ID: id, Candidates: ids}
+		}
+	}
+
 	return nil, "", ErrNotFound
 }
 
@@ -673,25 +955,26 @@ func (c *Core) GetFromArchive(id string) (*bean.Bean, error) {
 		return nil, nil
 	}
 
-	// Look for the bean file in the archive
-	entries, err := os.ReadDir(archiveDir)
+	// Look for the bean file anywhere in the archive (see archive layouts)
+	var found string
+	err := c.walkUnignored(archiveDir, func(p string, d os.DirEntry) error {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".md") {
+			return nil
+		}
+		if fileID, _ := bean.ParseFilename(d.Name()); fileID == fullID {
+			found = p
+			return filepath.SkipAll
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
-			continue
-		}
-
-		fileID, _ := bean.ParseFilename(entry.Name())
-		if fileID == fullID {
-			path := filepath.Join(archiveDir, entry.Name())
-			return c.loadBean(path)
-		}
+	if found == "" {
+		// It may have been compacted
+		return c.getFromBundle(fullID)
 	}
-
-	return nil, nil
+	return c.loadBean(found)
 }
 
 // LoadAndUnarchive finds a bean in the archive, loads it, unarchives it,
@@ -711,17 +994,10 @@ func (c *Core) LoadAndUnarchive(id string) (*bean.Bean, error) {
 		return b, nil
 	}
 
-	// Move file from archive to main directory
-	oldPath := filepath.Join(c.root, b.Path)
-	newRelPath := filepath.Base(b.Path)
-	newPath := filepath.Join(c.root, newRelPath)
-
-	if err := os.Rename(oldPath, newPath); err != nil {
-		return nil, fmt.Errorf("moving bean from archive: %w", err)
+	// Move file back out of the archive
+	if err := c.moveFromArchiveLocked(b); err != nil {
+		return nil, err
 	}
-
-	// Update bean's path
-	b.Path = newRelPath
 	c.beans[targetID] = b
 
 	return b, nil
@@ -743,6 +1019,15 @@ func (c *Core) EnableGitFlow(repoPath string) error {
 	if err != nil {
 		return fmt.Errorf("failed to initialize git flow: %w", err)
 	}
+	if c.config != nil {
+		if err := gf.SetBranchTemplate(c.config.Beans.Git.BranchTemplate); err != nil {
+			return fmt.Errorf("failed to initialize git flow: %w", err)
+		}
+		if err := gf.SetMergeStrategies(c.config.Beans.Git.MergeDetection); err != nil {
+			return fmt.Errorf("failed to initialize git flow: %w", err)
+		}
+	}
+	gf.SetLogger(c.logger)
 	c.gitFlow = gf
 	return nil
 }
@@ -775,7 +1060,7 @@ func (c *Core) hasChildren(beanID string) bool {
 
 // getBaseBranch returns the configured base branch or auto-detects it.
 func (c *Core) getBaseBranch() string {
-	if c.config.Beans.Git.BaseBranch != "" {
+	if c.config != nil && c.config.Beans.Git.BaseBranch != "" {
 		return c.config.Beans.Git.BaseBranch
 	}
 	// Try to detect main branch
@@ -799,44 +1084,31 @@ func (c *Core) createBranchForBean(b *bean.Bean) error {
 			return fmt.Errorf("failed to check branch existence: %w", err)
 		}
 		if exists {
-			if err := c.gitFlow.SwitchBranch(b.GitBranch); err != nil {
-				return fmt.Errorf("failed to switch to branch %q: %w", b.GitBranch, err)
-			}
-			return nil
+			return c.withCleanTree(func() error {
+				if err := c.gitFlow.SwitchBranch(b.GitBranch); err != nil {
+					return fmt.Errorf("failed to switch to branch %q: %w", b.GitBranch, err)
+				}
+				return nil
+			})
 		}
 		// Branch reference exists but branch is gone - will create new one
 	}
 
-	// Auto-commit beans if enabled and only .beans/ has changes
-	if c.config != nil && c.config.Beans.Git.AutoCommitBeans {
-		hasOnlyBeans, err := c.gitFlow.HasOnlyBeansDirChanges()
-		if err != nil {
-			return fmt.Errorf("failed to check for bean changes: %w", err)
-		}
-		if hasOnlyBeans {
-			if err := c.gitFlow.CommitBeans("chore: update beans"); err != nil {
-				c.logWarn("Failed to auto-commit beans: %v", err)
-				// Continue anyway - let the clean tree check handle it
-			}
-		}
-	}
-
-	// Check if working tree is clean
-	clean, err := c.gitFlow.IsWorkingTreeClean()
-	if err != nil {
-		return fmt.Errorf("failed to check working tree status: %w", err)
-	}
-	if !clean {
-		return fmt.Errorf("working tree has uncommitted changes - commit or stash them before creating a branch")
-	}
-
 	// Get base branch from config
 	baseBranch := c.getBaseBranch()
 
 	// GitHub Flow: Create branch FROM base branch, not from HEAD
-	branchName, err := c.gitFlow.CreateBranch(b.ID, b.Slug, baseBranch)
+	var branchName string
+	err := c.withCleanTree(func() error {
+		var err error
+		branchName, err = c.gitFlow.CreateBeanBranch(branchNameData(b), baseBranch)
+		if err != nil {
+			return fmt.Errorf("failed to create branch: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create branch: %w", err)
+		return err
 	}
 
 	// Update bean metadata
@@ -847,6 +1119,35 @@ func (c *Core) createBranchForBean(b *bean.Bean) error {
 	return nil
 }
 
+// BranchNameFor returns the git branch name the configured branch template
+// produces for the given bean. This is the name a new branch would get, which
+// may differ from b.GitBranch if the bean's branch was created earlier.
+func (c *Core) BranchNameFor(b *bean.Bean) (string, error) {
+	tmpl := ""
+	if c.config != nil {
+		tmpl = c.config.Beans.Git.BranchTemplate
+	}
+	return gitflow.RenderBranchName(tmpl, branchNameData(b))
+}
+
+// IsBeanBranch reports whether the named git branch belongs to the given bean,
+// either as its recorded branch or as the name the branch template produces.
+func (c *Core) IsBeanBranch(b *bean.Bean, branch string) bool {
+	if branch == "" {
+		return false
+	}
+	if b.GitBranch == branch {
+		return true
+	}
+	name, err := c.BranchNameFor(b)
+	return err == nil && name == branch
+}
+
+// branchNameData returns the branch template values for a bean.
+func branchNameData(b *bean.Bean) gitflow.BranchNameData {
+	return gitflow.BranchNameData{ID: b.ID, Slug: b.Slug, Type: b.Type}
+}
+
 // handleGitTransition manages git branch lifecycle during status changes.
 // Must be called with lock held.
 func (c *Core) handleGitTransition(oldBean, newBean *bean.Bean) error {
@@ -857,10 +1158,9 @@ func (c *Core) handleGitTransition(oldBean, newBean *bean.Bean) error {
 
 	// Detect transition to 'in-progress'
 	if newBean.Status == "in-progress" && oldBean.Status != "in-progress" {
-		// Check if auto-create is enabled
+		// Check if auto-create is enabled and the branch policy covers this bean
 		if c.config != nil && c.config.Beans.Git.AutoCreateBranch {
-			// Check if bean has children (is a parent bean)
-			if c.hasChildren(newBean.ID) {
+			if c.config.WantsBranch(newBean.Type, c.hasChildren(newBean.ID)) {
 				if err := c.createBranchForBean(newBean); err != nil {
 					return err
 				}
@@ -883,6 +1183,18 @@ type SyncResult struct {
 // - Deleted branches (not merged) → mark as "scrapped"
 // Returns the list of updated beans and any errors encountered.
 func (c *Core) SyncGitBranches() (*SyncResult, error) {
+	return c.syncGitBranches(true)
+}
+
+// PreviewGitSync reports which beans SyncGitBranches would update, without
+// modifying or saving anything. The returned beans are copies carrying the
+// status they would be changed to.
+func (c *Core) PreviewGitSync() (*SyncResult, error) {
+	return c.syncGitBranches(false)
+}
+
+// syncGitBranches implements SyncGitBranches and PreviewGitSync.
+func (c *Core) syncGitBranches(apply bool) (*SyncResult, error) {
 	if !c.IsGitFlowEnabled() {
 		return nil, fmt.Errorf("git integration is not enabled")
 	}
@@ -900,22 +1212,38 @@ func (c *Core) SyncGitBranches() (*SyncResult, error) {
 
 	// Process each bean that has a git branch
 	for _, b := range beans {
-		if b.GitBranch == "" {
+		if b.GitBranch == "" && b.GitPRURL == "" {
 			continue
 		}
+		if IsLocalPath(b.Path) {
+			continue // local beans are private, and not synced
+		}
 
+		if !apply {
+			preview := *b
+			b = &preview
+		}
+
+		oldStatus := b.Status
 		updated, err := c.syncSingleBean(b, baseBranch)
 		if err != nil {
 			result.Errors = append(result.Errors, fmt.Errorf("bean %s: %w", b.ID, err))
 			continue
 		}
 
-		if updated {
-			// Update the bean (no etag check for automated sync)
+		if !updated {
+			continue
+		}
+
+		// Update the bean (no etag check for automated sync)
+		if apply {
 			if err := c.Update(b, nil); err != nil {
 				result.Errors = append(result.Errors, fmt.Errorf("bean %s: failed to update: %w", b.ID, err))
 				continue
 			}
+		}
+		// Only status changes are reported; a newly seen PR state is just saved
+		if b.Status != oldStatus {
 			result.Updated = append(result.Updated, b)
 		}
 	}
@@ -926,6 +1254,19 @@ func (c *Core) SyncGitBranches() (*SyncResult, error) {
 // syncSingleBean checks a single bean's git branch status and updates the bean if needed.
 // Returns true if the bean was modified.
 func (c *Core) syncSingleBean(b *bean.Bean, baseBranch string) (bool, error) {
+	// A pull request, when known, is authoritative: it also catches squash
+	// merges whose branches were deleted remotely.
+	if b.GitPRURL != "" {
+		updated, err := c.syncFromPR(b)
+		if err == nil {
+			return updated, nil
+		}
+		if b.GitBranch == "" {
+			return false, err
+		}
+		c.logger.Warn("PR status unavailable, falling back to branch detection", "bean", b.ID, "error", err)
+	}
+
 	status, err := c.gitFlow.GetBranchStatus(b.GitBranch, baseBranch)
 	if err != nil {
 		return false, fmt.Errorf("failed to check branch status: %w", err)
@@ -936,17 +1277,33 @@ func (c *Core) syncSingleBean(b *bean.Bean, baseBranch string) (bool, error) {
 		// Branch is merged → mark as completed
 		if b.Status != "completed" {
 			b.Status = "completed"
+			// Record when the merge was detected, for branch cycle time
+			if b.GitMergedAt == nil {
+				now := time.Now().UTC().Truncate(time.Second)
+				b.GitMergedAt = &now
+			}
 			// Try to get merge commit hash
 			_, hash, _ := c.gitFlow.IsBranchMerged(b.GitBranch, baseBranch)
 			if hash != nil {
 				b.GitMergeCommit = hash.String()
-				now := time.Now().UTC().Truncate(time.Second)
-				b.GitMergedAt = &now
+			}
+			// Infer the scope from what the branch changed, if scopes are configured
+			if b.Scope == "" && c.config != nil && len(c.config.Beans.Scopes) > 0 {
+				if scope, err := c.InferScope(b); err != nil {
+					c.logger.Warn("could not infer scope", "bean", b.ID, "error", err)
+				} else {
+					b.Scope = scope
+				}
 			}
 			return true, nil
 		}
 
 	case gitflow.BranchStatusDeleted:
+		// An in-progress bean may have lost its branch by accident, so it is
+		// left alone and reported by StaleBeans instead.
+		if b.Status == "in-progress" {
+			return false, nil
+		}
 		// Branch was deleted without merging → mark as scrapped
 		if b.Status != "scrapped" {
 			b.Status = "scrapped"
@@ -957,6 +1314,55 @@ func (c *Core) syncSingleBean(b *bean.Bean, baseBranch string) (bool, error) {
 	return false, nil
 }
 
+// StaleBeans returns the in-progress beans whose git branch no longer exists
+// and was never merged, and isn't checked out either, sorted by ID. Sync
+// leaves these alone: the branch can be recreated, or the bean put back to
+// todo. Beans with a pull request URL are left to the pull request check.
+func (c *Core) StaleBeans() ([]*bean.Bean, error) {
+	if !c.IsGitFlowEnabled() {
+		return nil, fmt.Errorf("git integration is not enabled")
+	}
+
+	baseBranch := c.getBaseBranch()
+	current, err := c.gitFlow.GetCurrentBranch()
+	if err != nil {
+		current = ""
+	}
+
+	stale := make([]*bean.Bean, 0)
+	for _, b := range c.All() {
+		if b.Status != "in-progress" || b.GitBranch == "" || b.GitPRURL != "" || b.GitBranch == current {
+			continue
+		}
+		status, err := c.gitFlow.GetBranchStatus(b.GitBranch, baseBranch)
+		if err != nil {
+			c.logger.Warn("failed to check branch status", "bean", b.ID, "branch", b.GitBranch, "error", err)
+			continue
+		}
+		if status == gitflow.BranchStatusDeleted {
+			stale = append(stale, b)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].ID < stale[j].ID })
+	return stale, nil
+}
+
+// FindCommits returns git commits whose messages mention the given bean,
+// either by full ID or by short ID (without the configured prefix).
+// Results are ordered newest first; limit <= 0 means no limit.
+func (c *Core) FindCommits(id string, limit int) ([]gitflow.CommitInfo, error) {
+	if !c.IsGitFlowEnabled() {
+		return nil, fmt.Errorf("git integration is not enabled")
+	}
+
+	ids := []string{id}
+	if c.config != nil && c.config.Beans.Prefix != "" && strings.HasPrefix(id, c.config.Beans.Prefix) {
+		ids = append(ids, strings.TrimPrefix(id, c.config.Beans.Prefix))
+	}
+
+	return c.gitFlow.FindCommits(ids, limit)
+}
+
 // Close stops any active file watcher and cleans up resources.
 func (c *Core) Close() error {
 	c.mu.Lock()
@@ -75,7 +75,7 @@ func setupGitTestCore(t *testing.T, cfg *config.Config) (*Core, *git.Repository,
 	}
 
 	testCore := New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -334,7 +334,7 @@ func TestCore_GitIntegration_AutoEnable(t *testing.T) {
 	cfg := config.Default()
 	cfg.Beans.Git.Enabled = true
 	testCore := New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
@@ -386,7 +386,7 @@ func TestCore_GitIntegration_DisabledInConfig(t *testing.T) {
 	cfg.Beans.Git.Enabled = false
 	cfg.Beans.Git.AutoCreateBranch = false
 	testCore := New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
@@ -445,7 +445,7 @@ func TestCore_GitIntegration_NoGitRepo(t *testing.T) {
 	cfg := config.Default()
 	cfg.Beans.Git.Enabled = true
 	testCore := New(beansDir, cfg)
-	testCore.SetWarnWriter(nil)
+	testCore.SetLogger(nil)
 
 	if err := testCore.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -27,7 +28,7 @@ func setupTestCore(t *testing.T) (*Core, string) {
 
 	cfg := config.Default()
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil) // suppress warnings in tests
+	core.SetLogger(nil) // suppress warnings in tests
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -46,7 +47,7 @@ func setupTestCoreWithRequireIfMatch(t *testing.T) (*Core, string) {
 	cfg := config.Default()
 	cfg.Beans.RequireIfMatch = true
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil) // suppress warnings in tests
+	core.SetLogger(nil) // suppress warnings in tests
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -244,7 +245,7 @@ func TestGetShortID(t *testing.T) {
 
 	cfg := config.DefaultWithPrefix("beans-")
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -333,6 +334,71 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateRecordsStatusHistory(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	b := &bean.Bean{ID: "hist", Slug: "hist", Title: "History", Status: "todo"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if len(b.StatusHistory) != 1 || b.StatusHistory[0].Status != "todo" {
+		t.Fatalf("StatusHistory after create = %v, want [todo]", b.StatusHistory)
+	}
+
+	// Updates that don't change the status don't add entries
+	b.Title = "Renamed"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	b.Status = "in-progress"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// History survives a reload from disk
+	loaded, err := core.loadBean(core.FullPath(b))
+	if err != nil {
+		t.Fatalf("loadBean() error = %v", err)
+	}
+	var statuses []string
+	for _, c := range loaded.StatusHistory {
+		statuses = append(statuses, c.Status)
+	}
+	if want := []string{"todo", "in-progress"}; !reflect.DeepEqual(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
+}
+
+func TestUpdateSeedsStatusHistory(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	// Beans created before history tracking have no history on disk
+	b := createTestBean(t, core, "old1", "Old Bean", "todo")
+	b.StatusHistory = nil
+	content, err := b.Render()
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if err := os.WriteFile(core.FullPath(b), content, 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	b.Status = "completed"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	if len(b.StatusHistory) != 2 {
+		t.Fatalf("StatusHistory = %v, want 2 entries", b.StatusHistory)
+	}
+	if b.StatusHistory[0].Status != "todo" || !b.StatusHistory[0].ChangedAt.Equal(*b.CreatedAt) {
+		t.Errorf("first entry = %v, want todo at creation time %v", b.StatusHistory[0], b.CreatedAt)
+	}
+	if b.StatusHistory[1].Status != "completed" {
+		t.Errorf("second entry = %v, want completed", b.StatusHistory[1])
+	}
+}
+
 func TestUpdateNotFound(t *testing.T) {
 	core, _ := setupTestCore(t)
 
@@ -396,7 +462,7 @@ func TestDeleteShortID(t *testing.T) {
 
 	cfg := config.DefaultWithPrefix("beans-")
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -1291,7 +1357,7 @@ func TestArchivedBeansAlwaysLoaded(t *testing.T) {
 
 	// Create a new core and load - archived beans should always be included
 	core2 := New(beansDir, config.Default())
-	core2.SetWarnWriter(nil)
+	core2.SetLogger(nil)
 	if err := core2.Load(); err != nil {
 		t.Fatalf("Load() error = %v", err)
 	}
@@ -1356,7 +1422,7 @@ func TestLoadFromSubdirectories(t *testing.T) {
 
 	// Load and verify all beans are found
 	core := New(beansDir, config.Default())
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("Load() error = %v", err)
 	}
@@ -1416,7 +1482,7 @@ func TestGetFromArchive(t *testing.T) {
 
 	// Create a new core - archived beans are loaded but GetFromArchive reads directly from disk
 	core2 := New(beansDir, config.Default())
-	core2.SetWarnWriter(nil)
+	core2.SetLogger(nil)
 	if err := core2.Load(); err != nil {
 		t.Fatalf("Load() error = %v", err)
 	}
@@ -1452,7 +1518,7 @@ func TestGetFromArchive(t *testing.T) {
 			t.Fatalf("failed to create .beans dir: %v", err)
 		}
 		core3 := New(freshBeansDir, config.Default())
-		core3.SetWarnWriter(nil)
+		core3.SetLogger(nil)
 		if err := core3.Load(); err != nil {
 			t.Fatalf("Load() error = %v", err)
 		}
@@ -1477,7 +1543,7 @@ func TestLoadAndUnarchive(t *testing.T) {
 
 	// Create a new core - archived beans are now always loaded
 	core2 := New(beansDir, config.Default())
-	core2.SetWarnWriter(nil)
+	core2.SetLogger(nil)
 	if err := core2.Load(); err != nil {
 		t.Fatalf("Load() error = %v", err)
 	}
@@ -1530,6 +1596,101 @@ func TestLoadAndUnarchiveNotFound(t *testing.T) {
 	}
 }
 
+func TestLoadSkipsIgnoredFiles(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	core.Config().Beans.Ignore = []string{"drafts/", "*.draft.md"}
+	createTestBean(t, core, "keep", "Real bean", "todo")
+
+	os.MkdirAll(filepath.Join(beansDir, "drafts"), 0755)
+	os.WriteFile(filepath.Join(beansDir, "drafts", "scratch.md"), []byte("---\ntitle: [unclosed\n---\n"), 0644)
+	os.WriteFile(filepath.Join(beansDir, "idea.draft.md"), []byte("just some notes\n"), 0644)
+
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if beans := core.All(); len(beans) != 1 || beans[0].ID != "keep" {
+		t.Errorf("All() = %v, want only keep", beans)
+	}
+
+	// The watcher skips them as well
+	if err := core.StartWatching(); err != nil {
+		t.Fatalf("StartWatching() error = %v", err)
+	}
+	defer core.Unwatch()
+	ch, unsub := core.Subscribe()
+	defer unsub()
+	time.Sleep(50 * time.Millisecond)
+
+	os.WriteFile(filepath.Join(beansDir, "drafts", "another.md"), []byte("scratch\n"), 0644)
+	os.WriteFile(filepath.Join(beansDir, "other.draft.md"), []byte("scratch\n"), 0644)
+	select {
+	case events := <-ch:
+		t.Errorf("got events for ignored files: %+v", events)
+	case <-time.After(300 * time.Millisecond):
+	}
+	if n := len(core.All()); n != 1 {
+		t.Errorf("All() = %d beans after writing ignored files, want 1", n)
+	}
+}
+
+func TestArchiveLayouts(t *testing.T) {
+	completedAt := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		layout      string
+		dir         string // directory the bean starts in
+		wantArchive string
+	}{
+		{config.ArchiveLayoutFlat, "team", "archive/lay1--test.md"},
+		{config.ArchiveLayoutDate, "", "archive/2025/01/lay1--test.md"},
+		{config.ArchiveLayoutMirror, "team/api", "archive/team/api/lay1--test.md"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			core, beansDir := setupTestCore(t)
+			core.Config().Beans.ArchiveLayout = tt.layout
+
+			b := &bean.Bean{
+				ID: "lay1", Slug: "test", Title: "Test", Status: "completed",
+				Path:          filepath.Join(tt.dir, "lay1--test.md"),
+				StatusHistory: []bean.StatusChange{{Status: "completed", ChangedAt: completedAt}},
+			}
+			if err := core.Create(b); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			if err := core.Archive("lay1"); err != nil {
+				t.Fatalf("Archive() error = %v", err)
+			}
+			if filepath.ToSlash(b.Path) != tt.wantArchive {
+				t.Errorf("archived path = %q, want %q", b.Path, tt.wantArchive)
+			}
+			if !core.IsArchived("lay1") {
+				t.Error("IsArchived() = false")
+			}
+			if got, err := core.GetFromArchive("lay1"); err != nil || got == nil {
+				t.Errorf("GetFromArchive() = %v, %v", got, err)
+			}
+
+			// Unarchiving works whatever the layout is set to by then
+			core.Config().Beans.ArchiveLayout = ""
+			if err := core.Unarchive("lay1"); err != nil {
+				t.Fatalf("Unarchive() error = %v", err)
+			}
+			wantBack := "lay1--test.md"
+			if tt.layout == config.ArchiveLayoutMirror {
+				wantBack = filepath.Join(tt.dir, wantBack)
+			}
+			if b.Path != wantBack {
+				t.Errorf("unarchived path = %q, want %q", b.Path, wantBack)
+			}
+			if _, err := os.Stat(filepath.Join(beansDir, wantBack)); err != nil {
+				t.Errorf("unarchived file missing: %v", err)
+			}
+		})
+	}
+}
+
 func TestArchiveShortID(t *testing.T) {
 	// Create a core with a configured prefix
 	tmpDir := t.TempDir()
@@ -1540,7 +1701,7 @@ func TestArchiveShortID(t *testing.T) {
 
 	cfg := config.DefaultWithPrefix("beans-")
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -1566,7 +1727,7 @@ func TestNormalizeID(t *testing.T) {
 
 	cfg := config.DefaultWithPrefix("beans-")
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -1811,7 +1972,7 @@ func setupTestCoreWithGit(t *testing.T) (*Core, string, string) {
 	cfg.Beans.Git.BaseBranch = "main"
 
 	core := New(beansDir, cfg)
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -1824,6 +1985,46 @@ func setupTestCoreWithGit(t *testing.T) (*Core, string, string) {
 	return core, beansDir, tmpDir
 }
 
+func TestIsBeanBranch(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.Config().Beans.Git.BranchTemplate = "feature/{{.Type}}/{{.ID}}-{{.Slug}}"
+
+	b := &bean.Bean{
+		ID:        "beans-abc1",
+		Slug:      "login",
+		Type:      "epic",
+		GitBranch: "beans-abc1/old-name",
+	}
+
+	tests := []struct {
+		branch string
+		want   bool
+	}{
+		{"feature/epic/beans-abc1-login", true},
+		{"beans-abc1/old-name", true},
+		{"beans-abc1/login", false},
+		{"feature/epic/beans-abc2-login", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			if got := core.IsBeanBranch(b, tt.branch); got != tt.want {
+				t.Errorf("IsBeanBranch(%q) = %v, want %v", tt.branch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnableGitFlow_InvalidBranchTemplate(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	core.Config().Beans.Git.BranchTemplate = "{{.Slug}}"
+
+	if err := core.EnableGitFlow(repoPath); err == nil {
+		t.Error("EnableGitFlow() should fail with a template missing {{.ID}}")
+	}
+}
+
 func TestGitFlow_AutoCreateBranch_ParentBean(t *testing.T) {
 	core, _, repoPath := setupTestCoreWithGit(t)
 
@@ -1946,6 +2147,39 @@ func TestGitFlow_AutoCreateBranch_NonParentBean(t *testing.T) {
 	}
 }
 
+func TestGitFlow_AutoCreateBranch_BranchFor(t *testing.T) {
+	tests := []struct {
+		branchFor string
+		beanType  string
+		want      bool
+	}{
+		{"all", "task", true},
+		{"types:feature,bug", "bug", true},
+		{"types:feature,bug", "task", false},
+		{"parents", "bug", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branchFor+"/"+tt.beanType, func(t *testing.T) {
+			core, _, _ := setupTestCoreWithGit(t)
+			core.config.Beans.Git.BranchFor = tt.branchFor
+
+			solo := &bean.Bean{ID: "beans-solo1", Slug: "solo", Title: "Solo", Status: "todo", Type: tt.beanType}
+			if err := core.Create(solo); err != nil {
+				t.Fatalf("Create error = %v", err)
+			}
+			solo.Status = "in-progress"
+			if err := core.Update(solo, nil); err != nil {
+				t.Fatalf("Update error = %v", err)
+			}
+
+			if got := solo.GitBranch != ""; got != tt.want {
+				t.Errorf("branch created = %v (GitBranch %q), want %v", got, solo.GitBranch, tt.want)
+			}
+		})
+	}
+}
+
 func TestGitFlow_AutoCreateBranch_FromBaseBranch(t *testing.T) {
 	core, _, repoPath := setupTestCoreWithGit(t)
 
@@ -2594,8 +2828,8 @@ func TestGitFlow_SyncGitBranches_MergedBranch(t *testing.T) {
 	}
 
 	// Verify merge metadata
-	if synced.GitMergedAt == nil {
-		t.Error("GitMergedAt should be set")
+	if _, ok := synced.BranchCycleTime(); !ok {
+		t.Error("GitMergedAt should be set, after GitCreatedAt")
 	}
 	if synced.GitMergeCommit == "" {
 		t.Error("GitMergeCommit should be set")
@@ -2653,20 +2887,159 @@ func TestGitFlow_SyncGitBranches_DeletedBranch(t *testing.T) {
 	w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("main")})
 	repo.Storer.RemoveReference(plumbing.NewBranchReferenceName(branchName))
 
-	// Sync should mark bean as scrapped
+	// An in-progress bean isn't scrapped, it's reported as stale
 	result, err := core.SyncGitBranches()
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
-	if len(result.Updated) == 0 {
-		t.Error("SyncGitBranches() should update the bean")
+	if len(result.Updated) != 0 {
+		t.Errorf("SyncGitBranches() updated %d beans, want 0", len(result.Updated))
 	}
-
-	// Verify bean is now scrapped
 	synced, _ := core.Get("beans-feature1")
+	if synced.Status != "in-progress" {
+		t.Errorf("Status = %q, want %q", synced.Status, "in-progress")
+	}
+
+	stale, err := core.StaleBeans()
+	if err != nil {
+		t.Fatalf("StaleBeans() error = %v", err)
+	}
+	if len(stale) != 1 || stale[0].ID != "beans-feature1" {
+		t.Errorf("StaleBeans() = %v, want [beans-feature1]", stale)
+	}
+}
+
+func TestGitFlow_SyncGitBranches_DeletedBranch_NotStarted(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+
+	repo, _ := git.PlainOpen(repoPath)
+	w, _ := repo.Worktree()
+
+	core.Create(&bean.Bean{ID: "beans-task1", Slug: "task", Title: "Task", Status: "todo"})
+	b, err := core.BranchBean("beans-task1")
+	if err != nil {
+		t.Fatalf("BranchBean() error = %v", err)
+	}
+
+	// Switch back to main and delete the branch (without merging)
+	w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("main"), Keep: true})
+	repo.Storer.RemoveReference(plumbing.NewBranchReferenceName(b.GitBranch))
+
+	// A bean that was never started is scrapped
+	result, err := core.SyncGitBranches()
+	if err != nil {
+		t.Fatalf("SyncGitBranches() error = %v", err)
+	}
+	if len(result.Updated) != 1 {
+		t.Errorf("SyncGitBranches() updated %d beans, want 1", len(result.Updated))
+	}
+	synced, _ := core.Get("beans-task1")
 	if synced.Status != "scrapped" {
 		t.Errorf("Status = %q, want %q", synced.Status, "scrapped")
 	}
+	if stale, _ := core.StaleBeans(); len(stale) != 0 {
+		t.Errorf("StaleBeans() = %v, want none", stale)
+	}
+}
+
+func TestGitFlow_StaleBeans(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+
+	repo, _ := git.PlainOpen(repoPath)
+	w, _ := repo.Worktree()
+
+	for _, id := range []string{"beans-lost1", "beans-here1", "beans-kept1"} {
+		core.Create(&bean.Bean{ID: id, Slug: "work", Title: "Work", Status: "todo"})
+	}
+	branches := map[string]string{}
+	for _, id := range []string{"beans-lost1", "beans-here1", "beans-kept1"} {
+		b, err := core.BranchBean(id)
+		if err != nil {
+			t.Fatalf("BranchBean(%s) error = %v", id, err)
+		}
+		b.Status = "in-progress"
+		if err := core.Update(b, nil); err != nil {
+			t.Fatalf("Update(%s) error = %v", id, err)
+		}
+		branches[id] = b.GitBranch
+	}
+
+	// beans-lost1's branch is gone; beans-here1's is gone too, but it's the
+	// branch that's checked out; beans-kept1's still exists
+	w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(branches["beans-here1"]), Keep: true})
+	repo.Storer.RemoveReference(plumbing.NewBranchReferenceName(branches["beans-lost1"]))
+
+	stale, err := core.StaleBeans()
+	if err != nil {
+		t.Fatalf("StaleBeans() error = %v", err)
+	}
+	if len(stale) != 1 || stale[0].ID != "beans-lost1" {
+		t.Errorf("StaleBeans() = %v, want [beans-lost1]", stale)
+	}
+}
+
+func TestGitFlow_PreviewGitSync(t *testing.T) {
+	core, beansDir, repoPath := setupTestCoreWithGit(t)
+
+	repo, _ := git.PlainOpen(repoPath)
+	w, _ := repo.Worktree()
+
+	// Create parent bean and branch
+	parent := &bean.Bean{
+		ID:     "beans-feature1",
+		Slug:   "feature",
+		Title:  "Feature",
+		Status: "todo",
+	}
+	core.Create(parent)
+
+	child := &bean.Bean{
+		ID:     "beans-task1",
+		Slug:   "task",
+		Title:  "Task",
+		Status: "todo",
+		Parent: "beans-feature1",
+	}
+	core.Create(child)
+
+	w.Add(".beans")
+	w.Commit("Add beans", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test", Email: "test@example.com"},
+	})
+
+	// Branch the bean without starting it (in-progress beans aren't scrapped)
+	parent, err := core.BranchBean("beans-feature1")
+	if err != nil {
+		t.Fatalf("BranchBean() error = %v", err)
+	}
+
+	// Switch back to main and delete the branch (without merging)
+	branchName := parent.GitBranch
+	w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("main"), Keep: true})
+	repo.Storer.RemoveReference(plumbing.NewBranchReferenceName(branchName))
+
+	before, _ := os.ReadFile(filepath.Join(beansDir, parent.Path))
+
+	result, err := core.PreviewGitSync()
+	if err != nil {
+		t.Fatalf("PreviewGitSync() error = %v", err)
+	}
+	if len(result.Updated) != 1 {
+		t.Fatalf("PreviewGitSync() returned %d beans, want 1", len(result.Updated))
+	}
+	if result.Updated[0].Status != "scrapped" {
+		t.Errorf("preview Status = %q, want %q", result.Updated[0].Status, "scrapped")
+	}
+
+	// Nothing should have changed in memory or on disk
+	current, _ := core.Get("beans-feature1")
+	if current.Status != "todo" {
+		t.Errorf("Status after preview = %q, want %q", current.Status, "todo")
+	}
+	after, _ := os.ReadFile(filepath.Join(beansDir, parent.Path))
+	if string(before) != string(after) {
+		t.Error("PreviewGitSync() should not modify the bean file")
+	}
 }
 
 func TestGitFlow_SyncGitBranches_MultipleBeans(t *testing.T) {
@@ -2757,13 +3130,14 @@ func TestGitFlow_SyncGitBranches_MultipleBeans(t *testing.T) {
 	// Delete feature2 (it exists but we'll simulate deletion)
 	repo.Storer.RemoveReference(plumbing.NewBranchReferenceName(branch2))
 
-	// Sync should update both beans
+	// Sync should complete feature1; feature2 is in progress, so it's left
+	// alone and reported as stale
 	result, err := core.SyncGitBranches()
 	if err != nil {
 		t.Fatalf("SyncGitBranches() error = %v", err)
 	}
-	if len(result.Updated) != 2 {
-		t.Errorf("SyncGitBranches() updated %d beans, want 2", len(result.Updated))
+	if len(result.Updated) != 1 {
+		t.Errorf("SyncGitBranches() updated %d beans, want 1", len(result.Updated))
 	}
 
 	// Verify statuses
@@ -2773,8 +3147,11 @@ func TestGitFlow_SyncGitBranches_MultipleBeans(t *testing.T) {
 	}
 
 	synced2, _ := core.Get("beans-feature2")
-	if synced2.Status != "scrapped" {
-		t.Errorf("feature2 status = %q, want scrapped", synced2.Status)
+	if synced2.Status != "in-progress" {
+		t.Errorf("feature2 status = %q, want in-progress", synced2.Status)
+	}
+	if stale, _ := core.StaleBeans(); len(stale) != 1 || stale[0].ID != "beans-feature2" {
+		t.Errorf("StaleBeans() = %v, want [beans-feature2]", stale)
 	}
 }
 
@@ -0,0 +1,190 @@
+package beancore
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultsFileName is the name of the file setting defaults for the beans in
+// a directory of the beans directory (and its subdirectories), e.g. so that
+// everything in a milestone's folder is tagged and parented to it.
+const DefaultsFileName = "_defaults.yml"
+
+// DirDefaults are the defaults set by a directory's DefaultsFileName. They
+// fill in what beans created in or loaded from the directory leave empty;
+// tags are added to the bean's own.
+type DirDefaults struct {
+	Type     string   `yaml:"type,omitempty"`
+	Priority string   `yaml:"priority,omitempty"`
+	Tags     []string `yaml:"tags,omitempty"`
+	// Parent is the bean the directory's beans belong to, typically a milestone.
+	Parent string `yaml:"parent,omitempty"`
+}
+
+// DirDefaultsIssue is a problem with a DefaultsFileName found by CheckDirDefaults.
+type DirDefaultsIssue struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// readDirDefaults reads the defaults file in dir (relative to the beans
+// directory). It returns nil if the directory has none.
+func (c *Core) readDirDefaults(dir string) (*DirDefaults, error) {
+	data, err := os.ReadFile(filepath.Join(c.root, dir, DefaultsFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var d DirDefaults
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&d); err != nil && err != io.EOF {
+		var typeErr *yaml.TypeError
+		if errors.As(err, &typeErr) {
+			// One line per problem reads badly in check's list
+			return nil, errors.New(strings.Join(typeErr.Errors, "; "))
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+// dirDefaultsFor returns the defaults for beans in dir, combining the
+// defaults files from dir up to the beans directory. Nearer files win; tags
+// from all of them are used. Invalid files are skipped (Load warns about them).
+// The result is cached per directory until the next load.
+func (c *Core) dirDefaultsFor(dir string) DirDefaults {
+	dir = filepath.Clean(dir)
+	c.dirDefaultsMu.Lock()
+	defer c.dirDefaultsMu.Unlock()
+	if d, ok := c.dirDefaults[dir]; ok {
+		return d
+	}
+	if c.dirDefaults == nil {
+		c.dirDefaults = make(map[string]DirDefaults)
+	}
+	c.dirDefaults[dir] = c.mergeDirDefaults(dir)
+	return c.dirDefaults[dir]
+}
+
+// forgetDirDefaults drops the cached directory defaults, so defaults files
+// are read again.
+func (c *Core) forgetDirDefaults() {
+	c.dirDefaultsMu.Lock()
+	c.dirDefaults = nil
+	c.dirDefaultsMu.Unlock()
+}
+
+// mergeDirDefaults reads and combines the defaults files for dirDefaultsFor.
+func (c *Core) mergeDirDefaults(dir string) DirDefaults {
+	var merged DirDefaults
+	for {
+		if dir == "." {
+			dir = ""
+		}
+		if d, _ := c.readDirDefaults(dir); d != nil {
+			merged.Type = cmp.Or(merged.Type, d.Type)
+			merged.Priority = cmp.Or(merged.Priority, d.Priority)
+			merged.Parent = cmp.Or(merged.Parent, d.Parent)
+			for _, tag := range d.Tags {
+				if !slices.Contains(merged.Tags, tag) {
+					merged.Tags = append(merged.Tags, tag)
+				}
+			}
+		}
+		if dir == "" {
+			return merged
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// applyDirDefaults fills in the bean's type, priority and parent from the
+// defaults for dir where they are empty, and adds the default tags.
+func (c *Core) applyDirDefaults(b *bean.Bean, dir string) {
+	d := c.dirDefaultsFor(dir)
+	if b.Type == "" {
+		b.Type = d.Type
+	}
+	if b.Priority == "" {
+		b.Priority = d.Priority
+	}
+	// The folder's milestone itself lives in the folder too
+	if b.Parent == "" && d.Parent != b.ID {
+		b.Parent = d.Parent
+	}
+	for _, tag := range d.Tags {
+		if !b.HasTag(tag) {
+			b.Tags = append(b.Tags, tag)
+		}
+	}
+}
+
+// CheckDirDefaults validates every defaults file in the beans directory:
+// that it parses, and that its type, priority, tags and parent exist.
+// Results are sorted by path.
+func (c *Core) CheckDirDefaults() ([]DirDefaultsIssue, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var issues []DirDefaultsIssue
+	err := c.walkUnignored(c.root, func(path string, d os.DirEntry) error {
+		if d.IsDir() || d.Name() != DefaultsFileName {
+			return nil
+		}
+		rel, err := filepath.Rel(c.root, path)
+		if err != nil {
+			return err
+		}
+		add := func(format string, args ...any) {
+			issues = append(issues, DirDefaultsIssue{Path: filepath.ToSlash(rel), Message: fmt.Sprintf(format, args...)})
+		}
+
+		defaults, err := c.readDirDefaults(filepath.Dir(rel))
+		if err != nil {
+			add("%v", err)
+			return nil
+		}
+		if c.config != nil {
+			if defaults.Type != "" && !c.config.IsValidType(defaults.Type) {
+				add("invalid type '%s' (must be %s)", defaults.Type, c.config.TypeList())
+			}
+			if defaults.Priority != "" && !c.config.IsValidPriority(defaults.Priority) {
+				add("invalid priority '%s' (must be %s)", defaults.Priority, c.config.PriorityList())
+			}
+			if c.config.HasTagRegistry() {
+				for _, tag := range defaults.Tags {
+					if !c.config.IsAllowedTag(tag) {
+						add("tag '%s' is not registered", tag)
+					}
+				}
+			}
+		}
+		if defaults.Parent != "" {
+			if _, ok := c.beans[defaults.Parent]; !ok {
+				add("parent '%s' does not exist", defaults.Parent)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("checking defaults files: %w", err)
+	}
+
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Path < issues[j].Path })
+	return issues, nil
+}
@@ -0,0 +1,144 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func writeDefaults(t *testing.T, beansDir, dir, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(beansDir, dir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beansDir, dir, DefaultsFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDirDefaults(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	milestone := &bean.Bean{ID: "m1", Slug: "v1", Title: "v1", Status: "todo", Type: "milestone"}
+	if err := core.CreateInDir(milestone, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	writeDefaults(t, beansDir, "", "tags: [project]\n")
+	writeDefaults(t, beansDir, "v1", "type: feature\npriority: high\ntags: [v1]\nparent: m1\n")
+	writeDefaults(t, beansDir, "v1/docs", "type: task\n")
+
+	// Beans created in the directory get its defaults, plus those further up
+	b := &bean.Bean{Slug: "login", Title: "Login", Status: "todo", Tags: []string{"auth"}}
+	if err := core.CreateInDir(b, "v1/docs"); err != nil {
+		t.Fatalf("CreateInDir() error = %v", err)
+	}
+	if b.Type != "task" || b.Priority != "high" || b.Parent != "m1" {
+		t.Errorf("type, priority, parent = %q, %q, %q; want task, high, m1", b.Type, b.Priority, b.Parent)
+	}
+	if want := []string{"auth", "v1", "project"}; !slices.Equal(b.Tags, want) {
+		t.Errorf("Tags = %v, want %v", b.Tags, want)
+	}
+
+	// What the bean sets itself wins
+	own := &bean.Bean{Slug: "bug", Title: "Bug", Status: "todo", Type: "bug", Priority: "low"}
+	if err := core.CreateInDir(own, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	if own.Type != "bug" || own.Priority != "low" {
+		t.Errorf("type, priority = %q, %q; want bug, low", own.Type, own.Priority)
+	}
+
+	// Beans dropped into the directory get them when loaded
+	os.WriteFile(filepath.Join(beansDir, "v1", "x1--dropped.md"), []byte("---\ntitle: Dropped\nstatus: todo\n---\n"), 0644)
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+	dropped, err := core.Get("x1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dropped.Type != "feature" || dropped.Parent != "m1" || !dropped.HasTag("v1") {
+		t.Errorf("loaded bean = type %q, parent %q, tags %v", dropped.Type, dropped.Parent, dropped.Tags)
+	}
+
+	// ... except the milestone itself, which can't be its own parent
+	if m, _ := core.Get("m1"); m.Parent != "" || m.Type != "milestone" {
+		t.Errorf("milestone = type %q, parent %q", m.Type, m.Parent)
+	}
+
+	// Outside the directory, only the root defaults apply
+	root := createTestBean(t, core, "r1", "Root", "todo")
+	if root.Type != "task" || root.Parent != "" || !slices.Equal(root.Tags, []string{"project"}) {
+		t.Errorf("root bean = type %q, parent %q, tags %v", root.Type, root.Parent, root.Tags)
+	}
+
+	if garbage, _ := core.FindGarbage(); len(garbage) != 0 {
+		t.Errorf("FindGarbage() = %+v, want nothing", garbage)
+	}
+}
+
+func TestDirDefaultsCachedPerLoad(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	first := &bean.Bean{Slug: "first", Title: "First", Status: "todo"}
+	if err := core.CreateInDir(first, "v1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Defaults files are read once per load
+	writeDefaults(t, beansDir, "v1", "tags: [v1]\n")
+	second := &bean.Bean{Slug: "second", Title: "Second", Status: "todo"}
+	if err := core.CreateInDir(second, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	if second.HasTag("v1") {
+		t.Errorf("Tags = %v, want the defaults cached before the file was written", second.Tags)
+	}
+
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+	third := &bean.Bean{Slug: "third", Title: "Third", Status: "todo"}
+	if err := core.CreateInDir(third, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	if !third.HasTag("v1") {
+		t.Errorf("Tags = %v, want the defaults read again after Load", third.Tags)
+	}
+}
+
+func TestCreateInDirRejectsOutsidePaths(t *testing.T) {
+	core, _ := setupTestCore(t)
+	for _, dir := range []string{"..", "../elsewhere", "/tmp", "archive"} {
+		b := &bean.Bean{Slug: "x", Title: "X", Status: "todo"}
+		if err := core.CreateInDir(b, dir); err == nil {
+			t.Errorf("CreateInDir(%q) succeeded, want error", dir)
+		}
+	}
+}
+
+func TestCheckDirDefaults(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	writeDefaults(t, beansDir, "good", "type: bug\n")
+	writeDefaults(t, beansDir, "bad", "type: saga\npriority: urgent\nparent: nope\n")
+	writeDefaults(t, beansDir, "typo", "tpye: bug\n")
+
+	issues, err := core.CheckDirDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	for _, i := range issues {
+		paths = append(paths, i.Path)
+	}
+	want := []string{
+		filepath.Join("bad", DefaultsFileName),
+		filepath.Join("bad", DefaultsFileName),
+		filepath.Join("bad", DefaultsFileName),
+		filepath.Join("typo", DefaultsFileName),
+	}
+	if !slices.Equal(paths, want) {
+		t.Errorf("CheckDirDefaults() = %+v", issues)
+	}
+}
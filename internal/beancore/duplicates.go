@@ -0,0 +1,143 @@
+package beancore
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// DuplicatesLinkType is the typed link a merged duplicate keeps to the bean
+// it was merged into.
+const DuplicatesLinkType = "duplicates"
+
+// MergeBeans merges a duplicate bean into a canonical one. The duplicate's
+// tags, blocking, blocked_by and typed links are added to the canonical bean,
+// its parent too if the canonical bean has none, and its body is appended
+// under a "Merged from" heading. References to the duplicate in other beans
+// (links, children and body mentions) are pointed at the canonical bean. The
+// duplicate is then scrapped with a "duplicates" link to the canonical bean
+// and archived. Returns the canonical bean.
+func (c *Core) MergeBeans(dupeID, canonicalID string) (*bean.Bean, error) {
+	dupe, err := c.Get(dupeID)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := c.Get(canonicalID)
+	if err != nil {
+		return nil, err
+	}
+	if dupe.ID == canonical.ID {
+		return nil, fmt.Errorf("cannot merge a bean into itself")
+	}
+
+	// The duplicate's parent is only taken over if it's valid for the
+	// canonical bean
+	inheritParent := canonical.Parent == "" && dupe.Parent != "" && dupe.Parent != canonical.ID &&
+		c.ValidateParent(canonical, dupe.Parent) == nil &&
+		c.DetectCycle(canonical.ID, "parent", dupe.Parent) == nil
+
+	if err := c.mergeInto(dupe, canonical, inheritParent); err != nil {
+		return nil, err
+	}
+
+	dupe.Status = "scrapped"
+	if err := c.Update(dupe, nil); err != nil {
+		return nil, fmt.Errorf("scrapping %s: %w", dupe.ID, err)
+	}
+	if err := c.Archive(dupe.ID); err != nil {
+		return nil, fmt.Errorf("archiving %s: %w", dupe.ID, err)
+	}
+	return canonical, nil
+}
+
+// mergeInto moves the duplicate's content and links into the canonical bean
+// and points references to the duplicate at it.
+func (c *Core) mergeInto(dupe, canonical *bean.Bean, inheritParent bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, tag := range dupe.Tags {
+		if err := canonical.AddTag(tag); err != nil {
+			return err
+		}
+	}
+	if inheritParent {
+		canonical.Parent = dupe.Parent
+	}
+	for _, id := range dupe.Blocking {
+		if id != canonical.ID {
+			canonical.AddBlocking(id)
+		}
+	}
+	for _, id := range dupe.BlockedBy {
+		if id != canonical.ID {
+			canonical.AddBlockedBy(id)
+		}
+	}
+	for _, linkType := range sortedLinkTypes(dupe) {
+		for _, id := range dupe.Links[linkType] {
+			if id != canonical.ID {
+				canonical.AddLink(linkType, id)
+			}
+		}
+	}
+	if body := strings.TrimSpace(dupe.Body); body != "" {
+		merged := fmt.Sprintf("## Merged from %s: %s\n\n%s", dupe.ID, dupe.Title, body)
+		canonical.Body = bean.AppendWithSeparator(canonical.Body, merged)
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	canonical.UpdatedAt = &now
+
+	// The duplicate's links now live on the canonical bean
+	dupe.Blocking = nil
+	dupe.BlockedBy = nil
+	dupe.Links = map[string][]string{DuplicatesLinkType: {canonical.ID}}
+
+	// Drop references to the duplicate where the canonical bean is already
+	// referenced the same way, and the canonical bean's own, before pointing
+	// the rest at it
+	var dropped []*bean.Bean
+	for _, b := range c.beans {
+		if b.ID == dupe.ID {
+			continue
+		}
+		self := b.ID == canonical.ID
+		changed := false
+		if (self || b.IsBlocking(canonical.ID)) && b.IsBlocking(dupe.ID) {
+			b.RemoveBlocking(dupe.ID)
+			changed = true
+		}
+		if (self || b.IsBlockedBy(canonical.ID)) && b.IsBlockedBy(dupe.ID) {
+			b.RemoveBlockedBy(dupe.ID)
+			changed = true
+		}
+		for _, linkType := range sortedLinkTypes(b) {
+			if (self || b.HasLink(linkType, canonical.ID)) && b.HasLink(linkType, dupe.ID) {
+				b.RemoveLink(linkType, dupe.ID)
+				changed = true
+			}
+		}
+		if self && b.Parent == dupe.ID {
+			b.Parent = ""
+		}
+		if changed && !self {
+			dropped = append(dropped, b)
+		}
+	}
+	if _, err := c.rewriteReferencesLocked(dupe.ID, canonical.ID); err != nil {
+		return err
+	}
+	// Beans that lost their only reference to the duplicate weren't saved by
+	// the rewrite
+	for _, b := range dropped {
+		if err := c.saveToDisk(b); err != nil {
+			return err
+		}
+	}
+	if err := c.saveToDisk(canonical); err != nil {
+		return err
+	}
+	return c.saveToDisk(dupe)
+}
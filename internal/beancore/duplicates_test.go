@@ -0,0 +1,80 @@
+package beancore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestMergeBeans(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	for _, b := range []*bean.Bean{
+		{ID: "epic", Title: "Epic", Status: "todo", Type: "epic"},
+		{ID: "keep", Title: "Canonical", Status: "todo", Type: "feature", Tags: []string{"ui"}, Body: "Original body."},
+		{ID: "dupe", Title: "Duplicate", Status: "todo", Type: "feature", Parent: "epic", Tags: []string{"ui", "api"},
+			Blocking: []string{"other"}, Links: map[string][]string{"related": {"keep", "other"}}, Body: "Extra details."},
+		{ID: "child", Title: "Child", Status: "todo", Type: "task", Parent: "dupe"},
+		{ID: "other", Title: "Other", Status: "todo", Type: "task", BlockedBy: []string{"dupe", "keep"},
+			Body: "Follows [[dupe]]."},
+		{ID: "third", Title: "Third", Status: "todo", Type: "task", Blocking: []string{"dupe", "keep"}},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", b.ID, err)
+		}
+	}
+
+	merged, err := core.MergeBeans("dupe", "keep")
+	if err != nil {
+		t.Fatalf("MergeBeans() error = %v", err)
+	}
+
+	if merged.ID != "keep" || merged.Parent != "epic" {
+		t.Errorf("merged = %s with parent %q, want keep under epic", merged.ID, merged.Parent)
+	}
+	if !reflect.DeepEqual(merged.Tags, []string{"ui", "api"}) {
+		t.Errorf("Tags = %v, want [ui api]", merged.Tags)
+	}
+	if !reflect.DeepEqual(merged.Blocking, []string{"other"}) ||
+		!reflect.DeepEqual(merged.Links, map[string][]string{"related": {"other"}}) {
+		t.Errorf("links not moved: blocking = %v, links = %v", merged.Blocking, merged.Links)
+	}
+	if want := "Original body.\n\n## Merged from dupe: Duplicate\n\nExtra details."; merged.Body != want {
+		t.Errorf("Body = %q, want %q", merged.Body, want)
+	}
+
+	child, _ := core.Get("child")
+	if child.Parent != "keep" {
+		t.Errorf("child parent = %q, want keep", child.Parent)
+	}
+	other, _ := core.Get("other")
+	if !reflect.DeepEqual(other.BlockedBy, []string{"keep"}) {
+		t.Errorf("other blocked_by = %v, want [keep] only", other.BlockedBy)
+	}
+	if strings.TrimSpace(other.Body) != "Follows [[keep]]." {
+		t.Errorf("other body = %q", other.Body)
+	}
+
+	dupe, _ := core.Get("dupe")
+	if dupe.Status != "scrapped" || !core.IsArchived("dupe") {
+		t.Errorf("duplicate status = %q, archived = %v", dupe.Status, core.IsArchived("dupe"))
+	}
+	if !reflect.DeepEqual(dupe.Links, map[string][]string{DuplicatesLinkType: {"keep"}}) || len(dupe.Blocking) != 0 {
+		t.Errorf("duplicate links = %v, blocking = %v", dupe.Links, dupe.Blocking)
+	}
+
+	// References dropped in favour of the canonical bean's are saved too
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	third, _ := core.Get("third")
+	if !reflect.DeepEqual(third.Blocking, []string{"keep"}) {
+		t.Errorf("third blocking after reload = %v, want [keep] only", third.Blocking)
+	}
+
+	if _, err := core.MergeBeans("keep", "keep"); err == nil {
+		t.Error("MergeBeans() into itself should fail")
+	}
+}
@@ -0,0 +1,92 @@
+package beancore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// FilenameMismatch is a bean whose file isn't named after its ID and the
+// slug of its current title.
+type FilenameMismatch struct {
+	BeanID string `json:"bean_id"`
+	Path   string `json:"path"`
+	Want   string `json:"want"`
+}
+
+// CheckFilenames lists the beans whose filenames don't match their titles,
+// sorted by ID.
+func (c *Core) CheckFilenames() []FilenameMismatch {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var mismatches []FilenameMismatch
+	for _, b := range c.beans {
+		if want := slugPath(b, bean.Slugify(b.Title)); want != b.Path {
+			mismatches = append(mismatches, FilenameMismatch{BeanID: b.ID, Path: b.Path, Want: want})
+		}
+	}
+	slices.SortFunc(mismatches, func(a, b FilenameMismatch) int {
+		return strings.Compare(a.BeanID, b.BeanID)
+	})
+	return mismatches
+}
+
+// FixFilenames renames the files of beans whose filenames don't match their
+// titles, returning how many were renamed.
+func (c *Core) FixFilenames() (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	fixed := 0
+	for _, b := range c.beans {
+		if slugPath(b, bean.Slugify(b.Title)) == b.Path {
+			continue
+		}
+		if err := c.renameToSlugLocked(b, bean.Slugify(b.Title)); err != nil {
+			return fixed, err
+		}
+		if err := c.saveToDisk(b); err != nil {
+			return fixed, err
+		}
+		fixed++
+	}
+	return fixed, nil
+}
+
+// slugPath returns the path b's file should have with the given slug, in the
+// directory it's in now.
+func slugPath(b *bean.Bean, slug string) string {
+	return beanPath(filepath.Dir(b.Path), bean.BuildFilename(b.ID, slug))
+}
+
+// renameToSlugLocked gives b a new slug and renames its file to match. The
+// bean itself isn't saved. Must be called with c.mu held.
+func (c *Core) renameToSlugLocked(b *bean.Bean, slug string) error {
+	if b.Path == "" {
+		b.Slug = slug
+		return nil
+	}
+	newPath := slugPath(b, slug)
+	if newPath == b.Path {
+		b.Slug = slug
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(c.root, newPath)); err == nil {
+		return fmt.Errorf("cannot rename %s: file %s already exists", b.ID, newPath)
+	}
+	if err := c.materializeLocked(b); err != nil {
+		return err
+	}
+	if err := os.Rename(filepath.Join(c.root, b.Path), filepath.Join(c.root, newPath)); err != nil {
+		return fmt.Errorf("renaming %s: %w", b.ID, err)
+	}
+	b.Path = newPath
+	b.Slug = slug
+	c.markChanged()
+	return nil
+}
@@ -0,0 +1,102 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUpdateRenamesOnSlugChange(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	core.Config().Beans.RenameOnSlugChange = true
+	b := createTestBean(t, core, "ren1", "Old title", "todo")
+	oldPath := b.Path
+
+	b.Title = "Brand new title"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if b.Path != "ren1--brand-new-title.md" || b.Slug != "brand-new-title" {
+		t.Errorf("Path = %q, Slug = %q after title change", b.Path, b.Slug)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, oldPath)); !os.IsNotExist(err) {
+		t.Errorf("old file %s still exists", oldPath)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := core.Get("ren1"); err != nil || got.Title != "Brand new title" {
+		t.Errorf("Get() after reload = %v, %v", got, err)
+	}
+
+	// Without the setting, files keep their names
+	core.Config().Beans.RenameOnSlugChange = false
+	b, _ = core.Get("ren1")
+	b.Title = "Third title"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if b.Path != "ren1--brand-new-title.md" {
+		t.Errorf("Path = %q, want it unchanged", b.Path)
+	}
+}
+
+func TestCheckAndFixFilenames(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTestBean(t, core, "fn1", "Matches", "todo")
+	b := createTestBean(t, core, "fn2", "Before", "todo")
+	b.Title = "After"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	mismatches := core.CheckFilenames()
+	if len(mismatches) != 1 || mismatches[0].BeanID != "fn2" || mismatches[0].Want != "fn2--after.md" {
+		t.Fatalf("CheckFilenames() = %+v, want fn2 -> fn2--after.md", mismatches)
+	}
+
+	fixed, err := core.FixFilenames()
+	if err != nil || fixed != 1 {
+		t.Fatalf("FixFilenames() = %d, %v; want 1", fixed, err)
+	}
+	if m := core.CheckFilenames(); len(m) != 0 {
+		t.Errorf("CheckFilenames() after fix = %+v", m)
+	}
+}
+
+func TestWatchRenamedBean(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	b := createTestBean(t, core, "mv1", "Old name", "todo")
+
+	if err := core.StartWatching(); err != nil {
+		t.Fatalf("StartWatching() error = %v", err)
+	}
+	defer core.Unwatch()
+	ch, unsub := core.Subscribe()
+	defer unsub()
+	time.Sleep(50 * time.Millisecond)
+
+	// Another process renames the file
+	if err := os.Rename(filepath.Join(beansDir, b.Path), filepath.Join(beansDir, "mv1--new-name.md")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case events := <-ch:
+		for _, e := range events {
+			if e.Type == EventDeleted {
+				t.Errorf("rename reported as deletion: %+v", events)
+			}
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("timeout waiting for events")
+	}
+	got, err := core.Get("mv1")
+	if err != nil {
+		t.Fatalf("renamed bean gone: %v", err)
+	}
+	if got.Path != "mv1--new-name.md" {
+		t.Errorf("Path = %q, want mv1--new-name.md", got.Path)
+	}
+}
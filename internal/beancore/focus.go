@@ -0,0 +1,45 @@
+package beancore
+
+import (
+	"github.com/hmans/beans/internal/bean"
+)
+
+// Focus returns the bean currently in focus, or nil if no bean is focused or
+// the focused bean no longer exists.
+func (c *Core) Focus() (*bean.Bean, error) {
+	state, err := c.LoadState()
+	if err != nil {
+		return nil, err
+	}
+	if state.Focus == "" {
+		return nil, nil
+	}
+	b, err := c.Get(state.Focus)
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	return b, err
+}
+
+// SetFocus records the bean being worked on in the local state, so commands
+// like the prepare-commit-msg hook and activity reports can refer to it. An
+// empty id clears the focus. Returns the focused bean.
+func (c *Core) SetFocus(id string) (*bean.Bean, error) {
+	state, err := c.LoadState()
+	if err != nil {
+		return nil, err
+	}
+
+	var b *bean.Bean
+	if id != "" {
+		if b, err = c.Get(id); err != nil {
+			return nil, err
+		}
+		id = b.ID
+	}
+	state.Focus = id
+	if err := c.SaveState(state); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
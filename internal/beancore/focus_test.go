@@ -0,0 +1,58 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestFocus(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	if b, err := core.Focus(); err != nil || b != nil {
+		t.Fatalf("Focus() without state = %v, %v; want nil", b, err)
+	}
+
+	if err := core.Create(&bean.Bean{ID: "abc1", Title: "Work", Status: "todo", Type: "task"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := core.SetFocus("nope"); err != ErrNotFound {
+		t.Errorf("SetFocus(nope) error = %v, want ErrNotFound", err)
+	}
+
+	if _, err := core.SetFocus("abc1"); err != nil {
+		t.Fatalf("SetFocus() error = %v", err)
+	}
+	b, err := core.Focus()
+	if err != nil || b == nil || b.ID != "abc1" {
+		t.Fatalf("Focus() = %v, %v; want abc1", b, err)
+	}
+
+	// The state directory keeps itself out of git and isn't loaded as beans
+	if _, err := os.Stat(filepath.Join(beansDir, StateDirName, ".gitignore")); err != nil {
+		t.Errorf("state .gitignore missing: %v", err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if n := len(core.All()); n != 1 {
+		t.Errorf("All() = %d beans after reload, want 1", n)
+	}
+
+	// A deleted bean is no longer in focus
+	if err := core.Delete("abc1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if b, err := core.Focus(); err != nil || b != nil {
+		t.Errorf("Focus() after delete = %v, %v; want nil", b, err)
+	}
+
+	if _, err := core.SetFocus(""); err != nil {
+		t.Fatalf("SetFocus(\"\") error = %v", err)
+	}
+	if state, _ := core.LoadState(); state.Focus != "" {
+		t.Errorf("Focus = %q after clearing", state.Focus)
+	}
+}
@@ -0,0 +1,218 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// QueriesDir is the directory in the beans directory holding shared named
+// queries (see `beans q`).
+const QueriesDir = "queries"
+
+// Kinds of garbage found by FindGarbage.
+const (
+	// GarbageJunkFile is a leftover such as an editor backup or a merge's
+	// .orig file. Removable.
+	GarbageJunkFile = "junk-file"
+	// GarbageEmptyDir is a directory with nothing in it. Removable.
+	GarbageEmptyDir = "empty-dir"
+	// GarbageStaleFocus is a focus on a bean that no longer exists. Removable
+	// (the focus is cleared).
+	GarbageStaleFocus = "stale-focus"
+	// GarbageUnknownFile is a file beans doesn't use. Reported only, as it
+	// may be someone's notes.
+	GarbageUnknownFile = "unknown-file"
+	// GarbageShadowedBean is a bean file hidden by another file with the same
+	// ID. Reported only, as it may hold edits worth keeping.
+	GarbageShadowedBean = "shadowed-bean"
+	// GarbageBadFilename is a bean file not named <id>--<slug>.md. Reported
+	// only; the bean itself is fine.
+	GarbageBadFilename = "bad-filename"
+)
+
+// junkPatterns match leftover files that are safe to remove.
+var junkPatterns = []string{"*~", "*.orig", "*.rej", "*.bak", "*.tmp", ".*.swp", ".*.swo", "#*#", ".DS_Store", "Thumbs.db"}
+
+// Garbage is a file, directory or piece of local state found by FindGarbage.
+type Garbage struct {
+	Kind string `json:"kind"`
+	// Path is relative to the beans directory.
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+	// Removable is true if RemoveGarbage can clean it up.
+	Removable bool `json:"removable"`
+}
+
+// FindGarbage looks through the beans directory for files and directories
+// that don't belong there and for stale local state.
+func (c *Core) FindGarbage() ([]Garbage, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	beanPaths := make(map[string]*bean.Bean, len(c.beans))
+	for _, b := range c.beans {
+		beanPaths[filepath.ToSlash(b.Path)] = b
+	}
+
+	var found []Garbage
+	var dirs []string
+	err := c.walkUnignored(c.root, func(p string, d fs.DirEntry) error {
+		rel, err := filepath.Rel(c.root, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		name := d.Name()
+
+		if d.IsDir() {
+			if rel == StateDirName || rel == SyncDirName {
+				return filepath.SkipDir
+			}
+			dirs = append(dirs, rel)
+			return nil
+		}
+
+		switch {
+		case isJunkFile(name):
+			found = append(found, Garbage{Kind: GarbageJunkFile, Path: rel, Reason: "leftover file", Removable: true})
+		case strings.HasSuffix(name, ".md"):
+			b, ok := beanPaths[rel]
+			if !ok {
+				id, _ := bean.ParseFilename(name)
+				reason := "not loaded as a bean"
+				if other, exists := c.beans[id]; exists {
+					reason = "same ID as " + filepath.ToSlash(other.Path)
+				}
+				found = append(found, Garbage{Kind: GarbageShadowedBean, Path: rel, Reason: reason})
+				return nil
+			}
+			if want := bean.BuildFilename(b.ID, b.Slug); name != want {
+				found = append(found, Garbage{Kind: GarbageBadFilename, Path: rel, Reason: "should be named " + want})
+			}
+		case path.Dir(rel) == QueriesDir && strings.HasSuffix(name, ".graphql"):
+			// Shared query
+		case rel == ArchiveDir+"/"+BundleFileName || rel == ArchiveDir+"/"+BundleIndexName:
+			// Compacted archive
+		case name == DefaultsFileName:
+			// Defaults for the beans in its directory
+		case rel == LocalDir+"/.gitignore":
+			// Keeps local beans out of git
+		default:
+			found = append(found, Garbage{Kind: GarbageUnknownFile, Path: rel, Reason: "not used by beans"})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading beans directory: %w", err)
+	}
+
+	// A directory is empty if everything in it is junk or empty directories,
+	// so check the deepest ones first
+	slices.SortFunc(dirs, func(a, b string) int {
+		return strings.Count(b, "/") - strings.Count(a, "/")
+	})
+	emptyDirs := make(map[string]bool)
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(filepath.Join(c.root, filepath.FromSlash(dir)))
+		if err != nil {
+			return nil, err
+		}
+		empty := true
+		for _, e := range entries {
+			if !emptyDirs[dir+"/"+e.Name()] && !(!e.IsDir() && isJunkFile(e.Name())) {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			emptyDirs[dir] = true
+			found = append(found, Garbage{Kind: GarbageEmptyDir, Path: dir, Reason: "empty directory", Removable: true})
+		}
+	}
+
+	state, err := c.LoadState()
+	if err != nil {
+		return nil, err
+	}
+	if state.Focus != "" {
+		if _, ok := c.beans[state.Focus]; !ok {
+			found = append(found, Garbage{
+				Kind:      GarbageStaleFocus,
+				Path:      StateDirName + "/" + stateFileName,
+				Reason:    "focus on deleted bean " + state.Focus,
+				Removable: true,
+			})
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b Garbage) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return found, nil
+}
+
+// isJunkFile reports whether a file name matches junkPatterns.
+func isJunkFile(name string) bool {
+	for _, pattern := range junkPatterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveGarbage cleans up the removable items among garbage (as found by
+// FindGarbage), returning the ones it removed. Items that aren't removable
+// are skipped.
+func (c *Core) RemoveGarbage(garbage []Garbage) ([]Garbage, error) {
+	var removed []Garbage
+
+	// Files before directories, deepest directories first
+	items := slices.Clone(garbage)
+	slices.SortStableFunc(items, func(a, b Garbage) int {
+		if (a.Kind == GarbageEmptyDir) != (b.Kind == GarbageEmptyDir) {
+			if a.Kind == GarbageEmptyDir {
+				return 1
+			}
+			return -1
+		}
+		return strings.Count(b.Path, "/") - strings.Count(a.Path, "/")
+	})
+
+	for _, g := range items {
+		if !g.Removable {
+			continue
+		}
+		switch g.Kind {
+		case GarbageJunkFile:
+			if err := os.Remove(filepath.Join(c.root, filepath.FromSlash(g.Path))); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return removed, fmt.Errorf("removing %s: %w", g.Path, err)
+			}
+		case GarbageEmptyDir:
+			if err := os.Remove(filepath.Join(c.root, filepath.FromSlash(g.Path))); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return removed, fmt.Errorf("removing %s: %w", g.Path, err)
+			}
+		case GarbageStaleFocus:
+			state, err := c.LoadState()
+			if err != nil {
+				return removed, err
+			}
+			state.Focus = ""
+			if err := c.SaveState(state); err != nil {
+				return removed, err
+			}
+		default:
+			continue
+		}
+		removed = append(removed, g)
+	}
+	return removed, nil
+}
@@ -0,0 +1,112 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindAndRemoveGarbage(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	createTestBean(t, core, "aaa1", "Keep me", "todo")
+	createTestBean(t, core, "bbb2", "Gone soon", "todo")
+	if _, err := core.SetFocus("bbb2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.Delete("bbb2"); err != nil {
+		t.Fatal(err)
+	}
+
+	write := func(rel, content string) {
+		t.Helper()
+		full := filepath.Join(beansDir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("aaa1--keep-me.md~", "backup")
+	write("queries/open.graphql", "{ beans { id } }")
+	write("notes.txt", "mine")
+	write("old/nested/.DS_Store", "")
+	write("aaa1.md", "---\ntitle: Old copy\nstatus: todo\n---\n")
+	if err := os.MkdirAll(filepath.Join(beansDir, "empty"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := core.FindGarbage()
+	if err != nil {
+		t.Fatalf("FindGarbage() error = %v", err)
+	}
+	kinds := make(map[string]string)
+	for _, g := range found {
+		kinds[g.Path] = g.Kind
+	}
+	want := map[string]string{
+		"aaa1--keep-me.md~":    GarbageJunkFile,
+		"notes.txt":            GarbageUnknownFile,
+		"old/nested/.DS_Store": GarbageJunkFile,
+		"old/nested":           GarbageEmptyDir,
+		"old":                  GarbageEmptyDir,
+		"empty":                GarbageEmptyDir,
+		".state/state.json":    GarbageStaleFocus,
+	}
+	// One of the two aaa1 files shadows the other
+	if kinds["aaa1.md"] != GarbageShadowedBean && kinds["aaa1--keep-me.md"] != GarbageShadowedBean {
+		t.Errorf("no shadowed bean found in %v", kinds)
+	}
+	delete(kinds, "aaa1.md")
+	delete(kinds, "aaa1--keep-me.md")
+	if len(kinds) != len(want) {
+		t.Errorf("found %v, want %v", kinds, want)
+	}
+	for p, kind := range want {
+		if kinds[p] != kind {
+			t.Errorf("%s: kind %q, want %q", p, kinds[p], kind)
+		}
+	}
+
+	removed, err := core.RemoveGarbage(found)
+	if err != nil {
+		t.Fatalf("RemoveGarbage() error = %v", err)
+	}
+	if len(removed) != 6 {
+		t.Errorf("removed %d items, want 6", len(removed))
+	}
+	for _, gone := range []string{"aaa1--keep-me.md~", "old", "empty"} {
+		if _, err := os.Stat(filepath.Join(beansDir, gone)); !os.IsNotExist(err) {
+			t.Errorf("%s still exists", gone)
+		}
+	}
+	for _, kept := range []string{"notes.txt", "queries/open.graphql", "aaa1.md"} {
+		if _, err := os.Stat(filepath.Join(beansDir, kept)); err != nil {
+			t.Errorf("%s was removed: %v", kept, err)
+		}
+	}
+	if state, _ := core.LoadState(); state.Focus != "" {
+		t.Errorf("stale focus %q not cleared", state.Focus)
+	}
+}
+
+func TestFindGarbageBadFilename(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	if err := os.WriteFile(filepath.Join(beansDir, "ccc3.legacy-name.md"), []byte("---\ntitle: Legacy\nstatus: todo\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := core.FindGarbage()
+	if err != nil {
+		t.Fatalf("FindGarbage() error = %v", err)
+	}
+	if len(found) != 1 || found[0].Kind != GarbageBadFilename || found[0].Removable {
+		t.Errorf("FindGarbage() = %+v, want one non-removable bad-filename", found)
+	}
+}
@@ -0,0 +1,15 @@
+package beancore
+
+// Generation returns a counter that increases whenever beans are created,
+// updated, deleted, moved, reloaded or changed on disk. Callers can cache
+// structures derived from the bean set (trees, sorted lists, stats) and
+// rebuild them only when the generation differs from the one they were
+// built at. Generations are only meaningful within one Core.
+func (c *Core) Generation() uint64 {
+	return c.generation.Load()
+}
+
+// markChanged bumps the generation after a change to the bean set.
+func (c *Core) markChanged() {
+	c.generation.Add(1)
+}
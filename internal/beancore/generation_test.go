@@ -0,0 +1,45 @@
+package beancore
+
+import (
+	"testing"
+)
+
+func TestGeneration(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	tests := []struct {
+		name    string
+		op      func() error
+		changes bool
+	}{
+		{"create", func() error { createTestBean(t, core, "gen1", "Gen", "todo"); return nil }, true},
+		{"get", func() error { _, err := core.Get("gen1"); return err }, false},
+		{"all", func() error { core.All(); return nil }, false},
+		{"incoming links", func() error { core.FindIncomingLinks("gen1"); return nil }, false},
+		{"update", func() error {
+			b, _ := core.Get("gen1")
+			b.Title = "Renamed"
+			return core.Update(b, nil)
+		}, true},
+		{"archive", func() error { return core.Archive("gen1") }, true},
+		{"unarchive", func() error { return core.Unarchive("gen1") }, true},
+		{"load", core.Load, true},
+		{"delete", func() error { return core.Delete("gen1") }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := core.Generation()
+			if err := tt.op(); err != nil {
+				t.Fatalf("%s error = %v", tt.name, err)
+			}
+			after := core.Generation()
+			if tt.changes && after <= before {
+				t.Errorf("Generation() = %d after %s, want > %d", after, tt.name, before)
+			}
+			if !tt.changes && after != before {
+				t.Errorf("Generation() = %d after %s, want unchanged %d", after, tt.name, before)
+			}
+		})
+	}
+}
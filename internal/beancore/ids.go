@@ -0,0 +1,103 @@
+package beancore
+
+import (
+	"os"
+	"os/exec"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// NewID generates an unused ID for a new bean using the configured ID scheme.
+// An empty prefix means the configured prefix.
+func (c *Core) NewID(prefix string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.newID(prefix)
+}
+
+// newID is NewID without locking (must be called with lock held).
+func (c *Core) newID(prefix string) string {
+	length := 4
+	scheme := config.IDSchemeRandom
+	if c.config != nil {
+		if prefix == "" {
+			prefix = c.config.Beans.Prefix
+		}
+		if c.config.Beans.IDLength > 0 {
+			length = c.config.Beans.IDLength
+		}
+		scheme = c.config.GetIDScheme()
+		if c.config.Beans.IDUserPrefix {
+			if handle := c.userHandle(); handle != "" {
+				prefix += handle + "-"
+			}
+		}
+	}
+
+	// Random parts can collide on small ID lengths; retry a few times
+	var id string
+	for attempt := 0; attempt < 10; attempt++ {
+		switch scheme {
+		case config.IDSchemeSequential:
+			ids := make([]string, 0, len(c.beans))
+			for existing := range c.beans {
+				ids = append(ids, existing)
+			}
+			id = bean.NewSequentialID(prefix, length, ids)
+		case config.IDSchemeDate:
+			id = bean.NewDateID(prefix, length, time.Now())
+		default:
+			id = bean.NewID(prefix, length)
+		}
+		if _, exists := c.beans[id]; !exists {
+			break
+		}
+	}
+	return id
+}
+
+// userHandle returns the current user's handle (see lookupUserHandle),
+// looking it up only once per Core.
+func (c *Core) userHandle() string {
+	c.handleOnce.Do(func() {
+		c.handle = lookupUserHandle(c.root)
+	})
+	return c.handle
+}
+
+// lookupUserHandle returns a short, ID-safe name for the current user, taken
+// from $BEANS_USER, the local part of git's user.email in the repository at
+// dir, or the OS user name. Returns an empty string if none is available.
+func lookupUserHandle(dir string) string {
+	if handle := sanitizeHandle(os.Getenv("BEANS_USER")); handle != "" {
+		return handle
+	}
+	cmd := exec.Command("git", "config", "user.email")
+	cmd.Dir = dir
+	if out, err := cmd.Output(); err == nil {
+		local, _, _ := strings.Cut(strings.TrimSpace(string(out)), "@")
+		if handle := sanitizeHandle(local); handle != "" {
+			return handle
+		}
+	}
+	if u, err := user.Current(); err == nil {
+		return sanitizeHandle(u.Username)
+	}
+	return ""
+}
+
+// sanitizeHandle lowercases a name and strips everything but letters and
+// digits, so the handle can't break ID or branch name parsing.
+func sanitizeHandle(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package beancore
+
+import (
+	"os/exec"
+	"regexp"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestCreate_IDSchemes(t *testing.T) {
+	tests := []struct {
+		name       string
+		scheme     string
+		userPrefix bool
+		pattern    string
+	}{
+		{"random", "", false, `^test-[0-9a-z]{4}$`},
+		{"sequential", config.IDSchemeSequential, false, `^test-0003$`},
+		{"sequential per user", config.IDSchemeSequential, true, `^test-alice-0001$`},
+		{"date", config.IDSchemeDate, false, `^test-\d{6}[0-9a-z]{4}$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("BEANS_USER", "Alice")
+			core, _ := setupTestCore(t)
+			core.config.Beans.Prefix = "test-"
+			core.config.Beans.IDScheme = tt.scheme
+			core.config.Beans.IDUserPrefix = tt.userPrefix
+
+			// Existing beans: sequential numbering continues after them
+			for _, id := range []string{"test-0001", "test-0002", "test-bob-0005"} {
+				if err := core.Create(&bean.Bean{ID: id, Title: id, Status: "todo"}); err != nil {
+					t.Fatalf("Create() error = %v", err)
+				}
+			}
+
+			b := &bean.Bean{Title: "New", Status: "todo"}
+			if err := core.Create(b); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if !regexp.MustCompile(tt.pattern).MatchString(b.ID) {
+				t.Errorf("ID = %q, want match for %s", b.ID, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestUserHandleUsesRepoEmail(t *testing.T) {
+	t.Setenv("BEANS_USER", "")
+	core, _, repoDir := setupTestCoreWithGit(t)
+	if out, err := exec.Command("git", "-C", repoDir, "config", "user.email", "carol@example.com").CombinedOutput(); err != nil {
+		t.Fatalf("git config: %v\n%s", err, out)
+	}
+
+	if got := core.userHandle(); got != "carol" {
+		t.Errorf("userHandle() = %q, want carol from the repository's git config", got)
+	}
+
+	// The handle is looked up once, not for every new ID
+	if out, err := exec.Command("git", "-C", repoDir, "config", "user.email", "dave@example.com").CombinedOutput(); err != nil {
+		t.Fatalf("git config: %v\n%s", err, out)
+	}
+	if got := core.userHandle(); got != "carol" {
+		t.Errorf("userHandle() after changing user.email = %q, want the cached carol", got)
+	}
+}
+
+func TestSanitizeHandle(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"alice", "alice"},
+		{"Alice.Smith", "alicesmith"},
+		{"bob-42", "bob42"},
+		{"ünïcode", "ncode"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := sanitizeHandle(tt.input); got != tt.want {
+			t.Errorf("sanitizeHandle(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package beancore
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// buildLinkIndex maps each bean ID to the links pointing at it, so reverse
+// lookups (children, blockers, incoming typed links) don't scan every bean.
+// Must be called with c.mu held.
+func (c *Core) buildLinkIndex() map[string][]IncomingLink {
+	index := make(map[string][]IncomingLink)
+	for _, b := range c.beans {
+		if b.Parent != "" {
+			index[b.Parent] = append(index[b.Parent], IncomingLink{FromBean: b, LinkType: "parent"})
+		}
+		for _, target := range b.Blocking {
+			index[target] = append(index[target], IncomingLink{FromBean: b, LinkType: "blocking"})
+		}
+		// Inverse: if A has blocked_by B, then B links to A
+		for _, target := range b.BlockedBy {
+			index[target] = append(index[target], IncomingLink{FromBean: b, LinkType: "blocked_by"})
+		}
+		for _, linkType := range sortedLinkTypes(b) {
+			for _, target := range b.Links[linkType] {
+				index[target] = append(index[target], IncomingLink{FromBean: b, LinkType: linkType})
+			}
+		}
+	}
+	return index
+}
+
+// buildMentionIndex resolves the mentions in each bean's body ([[id]] or
+// #id, by full or short ID) to the beans they name, returning the mentioned
+// bean IDs per bean and the mentioning bean IDs per bean. References that
+// don't name an existing bean, and beans mentioning themselves, are left out.
+// Must be called with c.mu held.
+func (c *Core) buildMentionIndex() (mentions, mentionedBy map[string][]string) {
+	mentions = make(map[string][]string)
+	mentionedBy = make(map[string][]string)
+	for _, b := range c.beans {
+		seen := make(map[string]bool)
+		for _, ref := range b.MentionRefs() {
+			id, ok := c.resolveIDLocked(ref)
+			if !ok || id == b.ID || seen[id] {
+				continue
+			}
+			seen[id] = true
+			mentions[b.ID] = append(mentions[b.ID], id)
+			mentionedBy[id] = append(mentionedBy[id], b.ID)
+		}
+	}
+	for _, ids := range mentionedBy {
+		slices.Sort(ids)
+	}
+	return mentions, mentionedBy
+}
+
+// buildAliasIndex maps each alias (and external ID), lowercased, to the IDs of the beans that
+// have it (sorted; normally just one). Must be called with c.mu held.
+func (c *Core) buildAliasIndex() map[string][]string {
+	index := make(map[string][]string)
+	for _, b := range c.beans {
+		for _, alias := range b.AllAliases() {
+			key := strings.ToLower(alias)
+			if !slices.Contains(index[key], b.ID) {
+				index[key] = append(index[key], b.ID)
+			}
+		}
+	}
+	for _, ids := range index {
+		slices.Sort(ids)
+	}
+	return index
+}
+
+// refreshIndexLocked rebuilds the link, mention and alias indexes when beans changed
+// since they were built (see Generation). Must be called with c.mu held (read
+// or write) and c.indexMu locked.
+func (c *Core) refreshIndexLocked() {
+	if gen := c.Generation(); c.linkIndex == nil || c.linkIndexGen != gen {
+		c.linkIndex = c.buildLinkIndex()
+		c.mentionIndex, c.mentionedByIndex = c.buildMentionIndex()
+		c.aliasIndex = c.buildAliasIndex()
+		c.linkIndexGen = gen
+	}
+}
+
+// incomingLinksLocked returns the links pointing at targetID. Must be called
+// with c.mu held (read or write). The returned slice must not be modified.
+func (c *Core) incomingLinksLocked(targetID string) []IncomingLink {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+	c.refreshIndexLocked()
+	return c.linkIndex[targetID]
+}
+
+// mentionsLocked returns the IDs of the beans mentioned in id's body, in order
+// of first mention, and of the beans mentioning it, ordered by ID. Must be
+// called with c.mu held (read or write). The returned slices must not be modified.
+func (c *Core) mentionsLocked(id string) (mentions, mentionedBy []string) {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+	c.refreshIndexLocked()
+	return c.mentionIndex[id], c.mentionedByIndex[id]
+}
+
+// aliasIDsLocked returns the IDs of the beans with the alias, ignoring case.
+// Must be called with c.mu held (read or write). The returned slice must not
+// be modified.
+func (c *Core) aliasIDsLocked(alias string) []string {
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+	c.refreshIndexLocked()
+	return c.aliasIndex[strings.ToLower(alias)]
+}
+
+// childrenLocked returns the beans whose parent is id. Must be called with c.mu held.
+func (c *Core) childrenLocked(id string) []*bean.Bean {
+	var children []*bean.Bean
+	for _, link := range c.incomingLinksLocked(id) {
+		if link.LinkType == "parent" {
+			children = append(children, link.FromBean)
+		}
+	}
+	return children
+}
@@ -0,0 +1,116 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// incomingFrom returns the IDs of beans linking to id with the given link type.
+func incomingFrom(core *Core, id, linkType string) []string {
+	var ids []string
+	for _, link := range core.FindIncomingLinks(id) {
+		if link.LinkType == linkType {
+			ids = append(ids, link.FromBean.ID)
+		}
+	}
+	return ids
+}
+
+func TestLinkIndexInvalidation(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	createTestBean(t, core, "epic1", "Epic", "todo")
+	task := &bean.Bean{ID: "task1", Slug: "task", Title: "Task", Status: "todo", Parent: "epic1"}
+	if err := core.Create(task); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if got := incomingFrom(core, "epic1", "parent"); len(got) != 1 || got[0] != "task1" {
+		t.Fatalf("children of epic1 = %v, want [task1]", got)
+	}
+
+	t.Run("create", func(t *testing.T) {
+		other := &bean.Bean{ID: "task2", Slug: "other", Title: "Other", Status: "todo", Parent: "epic1"}
+		if err := core.Create(other); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if got := incomingFrom(core, "epic1", "parent"); len(got) != 2 {
+			t.Errorf("children of epic1 = %v, want 2 after create", got)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		task.Parent = ""
+		task.AddBlocking("task2")
+		if err := core.Update(task, nil); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if got := incomingFrom(core, "epic1", "parent"); len(got) != 1 || got[0] != "task2" {
+			t.Errorf("children of epic1 = %v, want [task2] after update", got)
+		}
+		if got := incomingFrom(core, "task2", "blocking"); len(got) != 1 || got[0] != "task1" {
+			t.Errorf("blockers of task2 = %v, want [task1]", got)
+		}
+		if !core.IsBlocked("task2") {
+			t.Error("task2 should be blocked by task1")
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := core.Delete("task2"); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if got := incomingFrom(core, "epic1", "parent"); len(got) != 0 {
+			t.Errorf("children of epic1 = %v, want none after delete", got)
+		}
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		task.Parent = "epic1"
+		if err := core.saveToDisk(task); err != nil {
+			t.Fatalf("saveToDisk() error = %v", err)
+		}
+		if err := core.Load(); err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+		links := core.FindIncomingLinks("epic1")
+		if len(links) != 1 || links[0].FromBean != core.beans["task1"] {
+			t.Errorf("links to epic1 = %v, want the reloaded task1", links)
+		}
+	})
+}
+
+func TestLinkIndexWatch(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	createTestBean(t, core, "epic1", "Epic", "todo")
+	if got := incomingFrom(core, "epic1", "parent"); len(got) != 0 {
+		t.Fatalf("children of epic1 = %v, want none", got)
+	}
+
+	if err := core.Watch(func() {}); err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	defer core.Unwatch()
+	time.Sleep(50 * time.Millisecond)
+
+	// A child written by another process must show up in the index
+	content := `---
+title: External Child
+status: todo
+parent: epic1
+---
+`
+	if err := os.WriteFile(filepath.Join(beansDir, "ext1--external.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	if got := incomingFrom(core, "epic1", "parent"); len(got) != 1 || got[0] != "ext1" {
+		t.Errorf("children of epic1 = %v, want [ext1] after external change", got)
+	}
+}
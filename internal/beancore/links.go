@@ -2,6 +2,8 @@ package beancore
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/hmans/beans/internal/bean"
@@ -54,32 +56,98 @@ func (c *Core) FindIncomingLinks(targetID string) []IncomingLink {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	var result []IncomingLink
-	for _, b := range c.beans {
-		// Check parent link
-		if b.Parent == targetID {
-			result = append(result, IncomingLink{
-				FromBean: b,
-				LinkType: "parent",
-			})
+	return append([]IncomingLink(nil), c.incomingLinksLocked(targetID)...)
+}
+
+// sortedLinkTypes returns the types of a bean's typed links in a stable order.
+func sortedLinkTypes(b *bean.Bean) []string {
+	return slices.Sorted(maps.Keys(b.Links))
+}
+
+// TypedLink is a typed link between two beans, seen from one of them.
+type TypedLink struct {
+	// Type is the link type name (e.g. "duplicates")
+	Type string
+	// Incoming is true if the other bean links to this one
+	Incoming bool
+	// BeanID is the ID of the bean at the other end
+	BeanID string
+}
+
+// MentionLinkType is the link type body mentions ([[id]] or #id) are
+// reported as by TypedLinks, so link filters can select them.
+const MentionLinkType = "mentions"
+
+// TypedLinks returns the typed links of a bean in both directions: its own
+// outgoing links first, then links from other beans to it, ordered by link
+// type and bean ID. Mentions in bean bodies are included as MentionLinkType
+// links. Structural links (parent, blocking) are not included.
+func (c *Core) TypedLinks(id string) []TypedLink {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	b, ok := c.beans[id]
+	if !ok {
+		return nil
+	}
+	mentions, mentionedBy := c.mentionsLocked(id)
+
+	var result []TypedLink
+	for _, linkType := range sortedLinkTypes(b) {
+		for _, target := range b.Links[linkType] {
+			result = append(result, TypedLink{Type: linkType, BeanID: target})
 		}
-		// Check blocking links
-		for _, blocked := range b.Blocking {
-			if blocked == targetID {
-				result = append(result, IncomingLink{
-					FromBean: b,
-					LinkType: "blocking",
-				})
-			}
+	}
+	for _, target := range mentions {
+		result = append(result, TypedLink{Type: MentionLinkType, BeanID: target})
+	}
+
+	var incoming []TypedLink
+	for _, link := range c.incomingLinksLocked(id) {
+		switch link.LinkType {
+		case "parent", "blocking", "blocked_by":
+			continue
 		}
-		// Check blocked_by links (inverse: if A has blocked_by B, then B links to A)
-		for _, blocker := range b.BlockedBy {
-			if blocker == targetID {
-				result = append(result, IncomingLink{
-					FromBean: b,
-					LinkType: "blocked_by",
-				})
-			}
+		incoming = append(incoming, TypedLink{Type: link.LinkType, Incoming: true, BeanID: link.FromBean.ID})
+	}
+	for _, source := range mentionedBy {
+		incoming = append(incoming, TypedLink{Type: MentionLinkType, Incoming: true, BeanID: source})
+	}
+	slices.SortFunc(incoming, func(a, b TypedLink) int {
+		if a.Type != b.Type {
+			return strings.Compare(a.Type, b.Type)
+		}
+		return strings.Compare(a.BeanID, b.BeanID)
+	})
+
+	return append(result, incoming...)
+}
+
+// Mentions returns the beans mentioned in a bean's body, in order of first mention.
+func (c *Core) Mentions(id string) []*bean.Bean {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	mentions, _ := c.mentionsLocked(id)
+	return c.beansByIDLocked(mentions)
+}
+
+// MentionedBy returns the beans whose bodies mention a bean, ordered by ID.
+func (c *Core) MentionedBy(id string) []*bean.Bean {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, mentionedBy := c.mentionsLocked(id)
+	return c.beansByIDLocked(mentionedBy)
+}
+
+// beansByIDLocked looks up beans by ID, skipping unknown IDs. Must be called
+// with c.mu held.
+func (c *Core) beansByIDLocked(ids []string) []*bean.Bean {
+	result := make([]*bean.Bean, 0, len(ids))
+	for _, id := range ids {
+		if b, ok := c.beans[id]; ok {
+			result = append(result, b)
 		}
 	}
 	return result
@@ -206,6 +274,24 @@ func (c *Core) CheckAllLinks() *LinkCheckResult {
 				})
 			}
 		}
+
+		// Check typed links
+		for _, linkType := range sortedLinkTypes(b) {
+			for _, target := range b.Links[linkType] {
+				if target == b.ID {
+					result.SelfLinks = append(result.SelfLinks, SelfLink{
+						BeanID:   b.ID,
+						LinkType: linkType,
+					})
+				} else if _, ok := c.beans[target]; !ok {
+					result.BrokenLinks = append(result.BrokenLinks, BrokenLink{
+						BeanID:   b.ID,
+						LinkType: linkType,
+						Target:   target,
+					})
+				}
+			}
+		}
 	}
 
 	// Check for cycles in blocking, blocked_by, and parent links
@@ -324,47 +410,105 @@ func canonicalCycleKey(path []string) string {
 	return key
 }
 
-// RemoveLinksTo removes all links pointing to the given target ID from all beans.
-// Returns the number of links removed.
+// RemoveLinksTo removes all references to the given bean from all other beans:
+// parent, blocking, blocked_by and typed links, and mentions of it in their
+// bodies, which are struck through ([[abc1]] or #abc1 becomes ~~abc1~~).
+// Supports short IDs. Returns the number of references removed, counting each
+// bean mentioning the target once.
 func (c *Core) RemoveLinksTo(targetID string) (int, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	removed := 0
+	if fullID, ok := c.resolveIDLocked(targetID); ok {
+		targetID = fullID
+	}
+	return c.rewriteReferencesLocked(targetID, "")
+}
+
+// rewriteReferencesLocked points every reference to oldID (parent, blocking,
+// blocked_by, typed links and body mentions, by full or short ID) at newID,
+// or removes it if newID is empty. Mentions keep their form: a short ID
+// mention stays short. Must be called with c.mu held for writing, while oldID
+// still names its bean. Returns the number of references rewritten.
+func (c *Core) rewriteReferencesLocked(oldID, newID string) (int, error) {
+	replace := func(ids []string) ([]string, int) {
+		if !slices.Contains(ids, oldID) {
+			return ids, 0
+		}
+		result := make([]string, 0, len(ids))
+		n := 0
+		for _, id := range ids {
+			if id != oldID {
+				result = append(result, id)
+				continue
+			}
+			n++
+			if newID != "" {
+				result = append(result, newID)
+			}
+		}
+		return result, n
+	}
+
+	rewritten := 0
 	for _, b := range c.beans {
-		changed := false
+		if newID == "" && b.ID == oldID {
+			continue
+		}
+		changed := 0
 
-		// Remove parent link
-		if b.Parent == targetID {
-			b.Parent = ""
-			changed = true
-			removed++
+		if b.Parent == oldID {
+			b.Parent = newID
+			changed++
 		}
 
-		// Remove blocking links
-		originalBlockingLen := len(b.Blocking)
-		b.RemoveBlocking(targetID)
-		if len(b.Blocking) < originalBlockingLen {
-			changed = true
-			removed += originalBlockingLen - len(b.Blocking)
+		var n int
+		b.Blocking, n = replace(b.Blocking)
+		changed += n
+		b.BlockedBy, n = replace(b.BlockedBy)
+		changed += n
+		for _, linkType := range sortedLinkTypes(b) {
+			if !b.HasLink(linkType, oldID) {
+				continue
+			}
+			changed++
+			if newID == "" {
+				b.RemoveLink(linkType, oldID)
+			} else {
+				b.Links[linkType], _ = replace(b.Links[linkType])
+			}
 		}
 
-		// Remove blocked_by links
-		originalBlockedByLen := len(b.BlockedBy)
-		b.RemoveBlockedBy(targetID)
-		if len(b.BlockedBy) < originalBlockedByLen {
-			changed = true
-			removed += originalBlockedByLen - len(b.BlockedBy)
+		body := bean.ReplaceMentions(b.Body, func(text, ref string) string {
+			if id, ok := c.resolveIDLocked(ref); !ok || id != oldID {
+				return text
+			}
+			if newID == "" {
+				return "~~" + ref + "~~"
+			}
+			id := newID
+			if ref != oldID && c.config != nil {
+				id = strings.TrimPrefix(newID, c.config.Beans.Prefix)
+			}
+			if strings.HasPrefix(text, "[[") {
+				return "[[" + id + "]]"
+			}
+			return "#" + id
+		})
+		if body != b.Body {
+			b.Body = body
+			changed++
 		}
 
-		if changed {
+		if changed > 0 {
+			rewritten += changed
 			if err := c.saveToDisk(b); err != nil {
-				return removed, err
+				return rewritten, err
 			}
 		}
 	}
 
-	return removed, nil
+	return rewritten, nil
 }
 
 // FixBrokenLinks removes all broken links (links to non-existent beans) and self-references.
@@ -431,6 +575,17 @@ func (c *Core) FixBrokenLinks() (int, error) {
 			fixed += originalBlockedByLen - len(newBlockedBy)
 		}
 
+		// Fix typed links
+		for _, linkType := range sortedLinkTypes(b) {
+			for _, target := range b.Links[linkType] {
+				if _, ok := c.beans[target]; target == b.ID || !ok {
+					b.RemoveLink(linkType, target)
+					changed = true
+					fixed++
+				}
+			}
+		}
+
 		if changed {
 			if err := c.saveToDisk(b); err != nil {
 				return fixed, err
@@ -537,12 +692,11 @@ func (c *Core) FindActiveBlockers(beanID string) []*bean.Bean {
 	}
 
 	// Check incoming blocking links (other beans that have this bean in their Blocking list)
-	for _, other := range c.beans {
-		for _, blocked := range other.Blocking {
-			if blocked == beanID && !isResolvedStatus(other.Status) && !seen[other.ID] {
-				seen[other.ID] = true
-				blockers = append(blockers, other)
-			}
+	for _, link := range c.incomingLinksLocked(beanID) {
+		other := link.FromBean
+		if link.LinkType == "blocking" && !isResolvedStatus(other.Status) && !seen[other.ID] {
+			seen[other.ID] = true
+			blockers = append(blockers, other)
 		}
 	}
 
@@ -1,9 +1,14 @@
 package beancore
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
 )
 
 func TestFindIncomingLinks(t *testing.T) {
@@ -327,6 +332,165 @@ func TestRemoveLinksTo(t *testing.T) {
 	}
 }
 
+func TestRemoveLinksToMentionsAndTypedLinks(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), BeansDir)
+	os.MkdirAll(beansDir, 0755)
+	core := New(beansDir, config.DefaultWithPrefix("beans-"))
+	core.SetLogger(nil)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	for _, b := range []*bean.Bean{
+		{ID: "beans-gone", Title: "Gone", Status: "todo"},
+		{ID: "beans-a", Title: "A", Status: "todo", BlockedBy: []string{"beans-gone"},
+			Links: map[string][]string{"related": {"beans-gone", "beans-b"}}},
+		{ID: "beans-b", Slug: "b", Title: "B", Status: "todo", Body: "After [[beans-gone]] and #gone, not `#gone` or #b."},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	// Short IDs are resolved
+	removed, err := core.RemoveLinksTo("gone")
+	if err != nil {
+		t.Fatalf("RemoveLinksTo() error = %v", err)
+	}
+	if removed != 3 {
+		t.Errorf("removed = %d, want 3", removed)
+	}
+
+	a, _ := core.Get("beans-a")
+	if len(a.BlockedBy) != 0 || !reflect.DeepEqual(a.Links["related"], []string{"beans-b"}) {
+		t.Errorf("bean A: blocked_by = %v, links = %v", a.BlockedBy, a.Links)
+	}
+	b, _ := core.Get("beans-b")
+	if want := "After ~~beans-gone~~ and ~~gone~~, not `#gone` or #b."; b.Body != want {
+		t.Errorf("bean B body = %q, want %q", b.Body, want)
+	}
+	if got := core.MentionedBy("beans-gone"); len(got) != 0 {
+		t.Errorf("MentionedBy(gone) = %d beans, want none", len(got))
+	}
+
+	// Changes are written to disk
+	reloaded := New(beansDir, config.DefaultWithPrefix("beans-"))
+	reloaded.SetLogger(nil)
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if b, _ := reloaded.Get("beans-b"); !strings.Contains(b.Body, "~~gone~~") {
+		t.Errorf("reloaded bean B body = %q", b.Body)
+	}
+}
+
+func TestChangeID(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), BeansDir)
+	os.MkdirAll(beansDir, 0755)
+	core := New(beansDir, config.DefaultWithPrefix("beans-"))
+	core.SetLogger(nil)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	for _, b := range []*bean.Bean{
+		{ID: "beans-old", Slug: "epic", Title: "Epic", Type: "epic", Status: "todo", Body: "Self: #old"},
+		{ID: "beans-kid", Slug: "kid", Title: "Kid", Status: "todo", Parent: "beans-old", Blocking: []string{"beans-old"},
+			Links: map[string][]string{"related": {"beans-old"}}, Body: "Part of [[beans-old]] (#old)."},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	b, err := core.ChangeID("old", "new")
+	if err != nil {
+		t.Fatalf("ChangeID() error = %v", err)
+	}
+	if b.ID != "beans-new" || b.Path != "beans-new--epic.md" || b.Body != "Self: #new" {
+		t.Errorf("bean = %s at %s, body %q", b.ID, b.Path, b.Body)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, "beans-old--epic.md")); !os.IsNotExist(err) {
+		t.Errorf("old file still exists: %v", err)
+	}
+	if _, err := core.Get("beans-old"); err != ErrNotFound {
+		t.Errorf("Get(old) error = %v, want ErrNotFound", err)
+	}
+
+	// Reload from disk to check the references were saved
+	reloaded := New(beansDir, config.DefaultWithPrefix("beans-"))
+	reloaded.SetLogger(nil)
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, err := reloaded.Get("beans-new"); err != nil {
+		t.Fatalf("Get(new) after reload error = %v", err)
+	}
+	kid, _ := reloaded.Get("beans-kid")
+	if kid.Parent != "beans-new" || !reflect.DeepEqual(kid.Blocking, []string{"beans-new"}) ||
+		!reflect.DeepEqual(kid.Links["related"], []string{"beans-new"}) {
+		t.Errorf("kid links: parent = %q, blocking = %v, links = %v", kid.Parent, kid.Blocking, kid.Links)
+	}
+	if want := "Part of [[beans-new]] (#new)."; strings.TrimSpace(kid.Body) != want {
+		t.Errorf("kid body = %q, want %q", kid.Body, want)
+	}
+
+	for _, tt := range []struct{ id, newID string }{
+		{"beans-new", "kid"},      // taken
+		{"beans-new", "bad--id"},  // ambiguous in filenames
+		{"beans-new", "with space"},
+		{"missing", "other"},
+	} {
+		if _, err := core.ChangeID(tt.id, tt.newID); err == nil {
+			t.Errorf("ChangeID(%q, %q) succeeded, want error", tt.id, tt.newID)
+		}
+	}
+}
+
+func TestChangeIDFailedRewrite(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	createTestBean(t, core, "old1", "Epic", "todo")
+	for _, id := range []string{"kid1", "kid2"} {
+		if err := core.Create(&bean.Bean{ID: id, Title: "Kid", Status: "todo", Parent: "old1", Body: "Part of #old1."}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	// Make kid2's file unwritable by putting a directory in its place
+	kid2, _ := core.Get("kid2")
+	path := filepath.Join(beansDir, kid2.Path)
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(path, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := core.ChangeID("old1", "new1"); err == nil {
+		t.Fatal("ChangeID() with an unwritable referencing bean should fail")
+	}
+	old, err := core.Get("old1")
+	if err != nil {
+		t.Fatalf("Get(old1) error = %v", err)
+	}
+	if _, err := os.Stat(core.FullPath(old)); err != nil {
+		t.Errorf("old file is gone: %v", err)
+	}
+	for _, id := range []string{"kid1", "kid2"} {
+		if kid, _ := core.Get(id); kid.Parent != "old1" || kid.Body != "Part of #old1." {
+			t.Errorf("%s in memory: parent = %q, body = %q, want unchanged", id, kid.Parent, kid.Body)
+		}
+	}
+	kid1, _ := core.Get("kid1")
+	reloaded, err := core.loadBean(core.FullPath(kid1))
+	if err != nil {
+		t.Fatalf("loadBean() error = %v", err)
+	}
+	if reloaded.Parent != "old1" || strings.TrimSpace(reloaded.Body) != "Part of #old1." {
+		t.Errorf("kid1 on disk: parent = %q, body = %q, want restored", reloaded.Parent, reloaded.Body)
+	}
+}
+
 func TestFixBrokenLinks(t *testing.T) {
 	core, _ := setupTestCore(t)
 
@@ -638,3 +802,141 @@ func TestIsResolvedStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestTypedLinks(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	dup := &bean.Bean{ID: "dup1", Title: "Dup", Status: "todo",
+		Links: map[string][]string{"duplicates": {"orig1"}, "related": {"other1"}}}
+	orig := &bean.Bean{ID: "orig1", Title: "Original", Status: "todo"}
+	other := &bean.Bean{ID: "other1", Title: "Other", Status: "todo",
+		Links: map[string][]string{"related": {"orig1"}}}
+	for _, b := range []*bean.Bean{dup, orig, other} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create error: %v", err)
+		}
+	}
+
+	t.Run("outgoing then incoming", func(t *testing.T) {
+		got := core.TypedLinks("dup1")
+		want := []TypedLink{
+			{Type: "duplicates", BeanID: "orig1"},
+			{Type: "related", BeanID: "other1"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("TypedLinks(dup1) = %+v, want %+v", got, want)
+		}
+
+		got = core.TypedLinks("orig1")
+		want = []TypedLink{
+			{Type: "duplicates", Incoming: true, BeanID: "dup1"},
+			{Type: "related", Incoming: true, BeanID: "other1"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("TypedLinks(orig1) = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("incoming links found", func(t *testing.T) {
+		links := core.FindIncomingLinks("orig1")
+		if len(links) != 2 {
+			t.Errorf("FindIncomingLinks(orig1) = %d links, want 2", len(links))
+		}
+	})
+
+	t.Run("broken and self links detected and fixed", func(t *testing.T) {
+		broken := &bean.Bean{ID: "brk1", Title: "Broken", Status: "todo",
+			Links: map[string][]string{"supersedes": {"gone1", "brk1", "orig1"}}}
+		if err := core.Create(broken); err != nil {
+			t.Fatalf("Create error: %v", err)
+		}
+
+		result := core.CheckAllLinks()
+		if len(result.BrokenLinks) != 1 || result.BrokenLinks[0].LinkType != "supersedes" {
+			t.Errorf("BrokenLinks = %+v, want one supersedes link", result.BrokenLinks)
+		}
+		if len(result.SelfLinks) != 1 {
+			t.Errorf("SelfLinks = %+v, want one", result.SelfLinks)
+		}
+
+		fixed, err := core.FixBrokenLinks()
+		if err != nil {
+			t.Fatalf("FixBrokenLinks error: %v", err)
+		}
+		if fixed != 2 {
+			t.Errorf("FixBrokenLinks() = %d, want 2", fixed)
+		}
+		if !reflect.DeepEqual(broken.Links, map[string][]string{"supersedes": {"orig1"}}) {
+			t.Errorf("Links after fix = %v", broken.Links)
+		}
+	})
+
+	t.Run("links removed when target is removed", func(t *testing.T) {
+		removed, err := core.RemoveLinksTo("orig1")
+		if err != nil {
+			t.Fatalf("RemoveLinksTo error: %v", err)
+		}
+		if removed != 3 {
+			t.Errorf("RemoveLinksTo() = %d, want 3", removed)
+		}
+		if dup.HasLink("duplicates", "orig1") || other.Links != nil {
+			t.Errorf("links to orig1 not removed: %v / %v", dup.Links, other.Links)
+		}
+	})
+}
+
+func TestMentions(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), BeansDir)
+	os.MkdirAll(beansDir, 0755)
+	core := New(beansDir, config.DefaultWithPrefix("beans-"))
+	core.SetLogger(nil)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	for _, b := range []*bean.Bean{
+		{ID: "beans-spec", Title: "Spec", Status: "todo", Body: "Implemented by [[beans-impl]] and #test. Mentions itself: #spec"},
+		{ID: "beans-impl", Title: "Impl", Status: "todo", Body: "See [[spec]]; unrelated to #123 and [[gone]]."},
+		{ID: "beans-test", Title: "Test", Status: "todo", Body: "Covers `#spec` only in code."},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	ids := func(beans []*bean.Bean) []string {
+		var result []string
+		for _, b := range beans {
+			result = append(result, b.ID)
+		}
+		return result
+	}
+	if got, want := ids(core.Mentions("beans-spec")), []string{"beans-impl", "beans-test"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Mentions(spec) = %v, want %v", got, want)
+	}
+	if got, want := ids(core.MentionedBy("beans-spec")), []string{"beans-impl"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MentionedBy(spec) = %v, want %v", got, want)
+	}
+	if got := core.Mentions("beans-test"); len(got) != 0 {
+		t.Errorf("Mentions(test) = %v, want none", ids(got))
+	}
+
+	got := core.TypedLinks("beans-impl")
+	want := []TypedLink{
+		{Type: MentionLinkType, BeanID: "beans-spec"},
+		{Type: MentionLinkType, Incoming: true, BeanID: "beans-spec"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TypedLinks(impl) = %+v, want %+v", got, want)
+	}
+
+	// Editing a body updates the index
+	test, _ := core.Get("beans-test")
+	test.Body = "Now covers [[beans-spec]]."
+	if err := core.Update(test, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got, want := ids(core.MentionedBy("beans-spec")), []string{"beans-impl", "beans-test"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MentionedBy(spec) after update = %v, want %v", got, want)
+	}
+}
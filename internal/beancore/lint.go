@@ -0,0 +1,93 @@
+package beancore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// acceptanceCriteriaSection is the body section LintAcceptanceCriteria looks
+// for in features.
+const acceptanceCriteriaSection = "Acceptance Criteria"
+
+// LintIssue is a problem `beans lint` found in a bean's content.
+type LintIssue struct {
+	BeanID string `json:"bean_id"`
+	// Rule is the lint rule that found the problem (see config.LintRules).
+	Rule string `json:"rule"`
+	// Level is the rule's level, "warn" or "error".
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// Lint checks a bean's content against the enabled lint rules and returns
+// the issues found, in rule order.
+func (c *Core) Lint(b *bean.Bean) []LintIssue {
+	var lint config.LintConfig
+	if c.config != nil {
+		lint = c.config.Beans.Lint
+	}
+
+	var issues []LintIssue
+	report := func(rule, format string, args ...any) {
+		if level := lint.Level(rule); level != config.LintOff {
+			issues = append(issues, LintIssue{BeanID: b.ID, Rule: rule, Level: level, Message: fmt.Sprintf(format, args...)})
+		}
+	}
+
+	if b.Type != "task" && strings.TrimSpace(b.Body) == "" {
+		report(config.LintEmptyBody, "%s has no description", typeOrBean(b))
+	}
+	if b.Type == "feature" && !b.HasSection(acceptanceCriteriaSection) {
+		report(config.LintAcceptanceCriteria, "feature has no %q section", acceptanceCriteriaSection)
+	}
+	max := lint.GetMaxTitleLength()
+	if lint.MaxTitleLength == 0 && c.titlePolicy().MaxLength > 0 {
+		max = c.titlePolicy().MaxLength
+	}
+	if n := utf8.RuneCountInString(b.Title); n > max {
+		report(config.LintTitleLength, "title is %d characters long (budget %d)", n, max)
+	}
+	for _, tag := range b.Tags {
+		if bean.ValidateTag(tag) != nil {
+			report(config.LintTagFormat, "tag %q must be lowercase words joined by hyphens, optionally namespaced with /", tag)
+		}
+	}
+	return issues
+}
+
+// LintAll lints all beans, or only those with the given IDs, sorted by bean
+// ID.
+func (c *Core) LintAll(ids ...string) ([]LintIssue, error) {
+	var beans []*bean.Bean
+	if len(ids) == 0 {
+		beans = c.All()
+	} else {
+		for _, id := range ids {
+			b, err := c.Get(id)
+			if err != nil {
+				return nil, err
+			}
+			beans = append(beans, b)
+		}
+	}
+	sort.SliceStable(beans, func(i, j int) bool { return beans[i].ID < beans[j].ID })
+
+	var issues []LintIssue
+	for _, b := range beans {
+		issues = append(issues, c.Lint(b)...)
+	}
+	return issues, nil
+}
+
+// typeOrBean names a bean by its type in messages, e.g. "bug".
+func typeOrBean(b *bean.Bean) string {
+	if b.Type == "" {
+		return "bean"
+	}
+	return b.Type
+}
@@ -0,0 +1,81 @@
+package beancore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestLint(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	tests := []struct {
+		name string
+		bean *bean.Bean
+		want []string
+	}{
+		{"clean task", &bean.Bean{ID: "a", Title: "Task", Type: "task"}, nil},
+		{"empty bug", &bean.Bean{ID: "b", Title: "Bug", Type: "bug", Body: "  \n"}, []string{config.LintEmptyBody}},
+		{"feature without criteria", &bean.Bean{ID: "c", Title: "Feature", Type: "feature", Body: "Do it"}, []string{config.LintAcceptanceCriteria}},
+		{"feature with criteria", &bean.Bean{ID: "d", Title: "Feature", Type: "feature", Body: "## Acceptance criteria\n\n- works"}, nil},
+		{"long title", &bean.Bean{ID: "e", Title: strings.Repeat("ü", 73), Type: "task"}, []string{config.LintTitleLength}},
+		{"bad tags", &bean.Bean{ID: "f", Title: "Task", Type: "task", Tags: []string{"ok", "Not_OK"}}, []string{config.LintTagFormat}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range core.Lint(tt.bean) {
+				got = append(got, issue.Rule)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lint() rules = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintRuleLevels(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.config.Beans.Lint = config.LintConfig{
+		Rules:          map[string]string{config.LintEmptyBody: config.LintOff, config.LintTitleLength: config.LintError},
+		MaxTitleLength: 5,
+	}
+
+	issues := core.Lint(&bean.Bean{ID: "a", Title: "Too long", Type: "bug", Tags: []string{"Bad"}})
+	want := []LintIssue{
+		{BeanID: "a", Rule: config.LintTitleLength, Level: config.LintError, Message: "title is 8 characters long (budget 5)"},
+		{BeanID: "a", Rule: config.LintTagFormat, Level: config.LintError, Message: `tag "Bad" must be lowercase words joined by hyphens, optionally namespaced with /`},
+	}
+	if !reflect.DeepEqual(issues, want) {
+		t.Errorf("Lint() = %+v, want %+v", issues, want)
+	}
+}
+
+func TestLintAll(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.config.Beans.Lint.MaxTitleLength = 3
+	createTestBean(t, core, "bbb2", "Second", "todo")
+	createTestBean(t, core, "aaa1", "First", "todo")
+
+	issues, err := core.LintAll()
+	if err != nil {
+		t.Fatalf("LintAll() error = %v", err)
+	}
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.BeanID)
+	}
+	if !reflect.DeepEqual(ids, []string{"aaa1", "bbb2"}) {
+		t.Errorf("LintAll() beans = %v, want both long titles in ID order", ids)
+	}
+
+	if issues, err := core.LintAll("bbb2"); err != nil || len(issues) != 1 {
+		t.Errorf("LintAll(bbb2) = %v, %v; want one issue", issues, err)
+	}
+	if _, err := core.LintAll("nope"); err == nil {
+		t.Error("LintAll(nope) should fail")
+	}
+}
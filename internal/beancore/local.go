@@ -0,0 +1,43 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// LocalDir is the directory inside the beans directory for private beans,
+// such as personal TODOs. Like the state directory, it contains a .gitignore
+// ignoring everything in it, so local beans are never committed. They are
+// loaded like any other bean, but left out of exports and git sync.
+const LocalDir = "local"
+
+// IsLocalPath returns true if path (relative to the beans directory) is in
+// the local directory, i.e. the bean stored there is a local-only bean.
+func IsLocalPath(path string) bool {
+	return strings.HasPrefix(filepath.ToSlash(path), LocalDir+"/")
+}
+
+// ensureLocalDir creates the local directory and its .gitignore if needed.
+func (c *Core) ensureLocalDir() error {
+	dir := filepath.Join(c.root, LocalDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating local directory: %w", err)
+	}
+	gitignore := filepath.Join(dir, ".gitignore")
+	if _, err := os.Stat(gitignore); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(gitignore, []byte("# Local-only beans, not meant to be committed\n*\n"), 0644); err != nil {
+			return fmt.Errorf("writing local .gitignore: %w", err)
+		}
+	}
+	return nil
+}
+
+// CreateLocal creates a bean in the local directory. See Create.
+func (c *Core) CreateLocal(b *bean.Bean) error {
+	return c.create(b, LocalDir)
+}
@@ -0,0 +1,81 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestCreateLocal(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	b := &bean.Bean{Slug: "buy-milk", Title: "Buy milk", Status: "todo"}
+	if err := core.CreateLocal(b); err != nil {
+		t.Fatalf("CreateLocal() error = %v", err)
+	}
+	if want := filepath.Join(LocalDir, bean.BuildFilename(b.ID, "buy-milk")); b.Path != want {
+		t.Errorf("Path = %q, want %q", b.Path, want)
+	}
+	if !IsLocalPath(b.Path) {
+		t.Error("IsLocalPath() = false for a local bean")
+	}
+	gitignore, err := os.ReadFile(filepath.Join(beansDir, LocalDir, ".gitignore"))
+	if err != nil {
+		t.Fatalf("local .gitignore missing: %v", err)
+	}
+	if string(gitignore) != "# Local-only beans, not meant to be committed\n*\n" {
+		t.Errorf(".gitignore = %q", gitignore)
+	}
+
+	// Loaded like any other bean
+	if err := core.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := core.Get(b.ID); err != nil {
+		t.Fatalf("Get() after reload error = %v", err)
+	}
+
+	// Nothing in the local directory is garbage
+	if garbage, _ := core.FindGarbage(); len(garbage) != 0 {
+		t.Errorf("FindGarbage() = %+v, want nothing", garbage)
+	}
+}
+
+func TestArchiveLocalBean(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	b := &bean.Bean{Slug: "done", Title: "Done", Status: "completed",
+		StatusHistory: []bean.StatusChange{{Status: "completed", ChangedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}}}
+	if err := core.CreateLocal(b); err != nil {
+		t.Fatal(err)
+	}
+
+	// Local beans are archived within the local directory, whatever the layout
+	core.Config().Beans.ArchiveLayout = "mirror"
+	if err := core.Archive(b.ID); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	if want := filepath.Join(LocalDir, ArchiveDir, filepath.Base(b.Path)); b.Path != want {
+		t.Errorf("archived Path = %q, want %q", b.Path, want)
+	}
+	if !core.IsArchived(b.ID) {
+		t.Error("IsArchived() = false after Archive()")
+	}
+
+	// ... and never compacted into the shared bundle
+	if compacted, _ := core.CompactArchive(time.Now(), false); len(compacted) != 0 {
+		t.Errorf("CompactArchive() compacted %d local beans", len(compacted))
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, b.Path)); err != nil {
+		t.Errorf("archived local file gone: %v", err)
+	}
+
+	if err := core.Unarchive(b.ID); err != nil {
+		t.Fatalf("Unarchive() error = %v", err)
+	}
+	if want := filepath.Join(LocalDir, filepath.Base(b.Path)); b.Path != want {
+		t.Errorf("unarchived Path = %q, want %q", b.Path, want)
+	}
+}
@@ -0,0 +1,71 @@
+package beancore
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// MergeBeanFiles three-way merges two versions of a bean file that diverged
+// from base, as done by the beans git merge driver. Front matter is merged
+// field by field (see bean.MergeFrontMatter) and never conflicts; the body is
+// merged line by line and may contain conflict markers, in which case
+// conflicted is true. id is written to the merged file's header comment.
+func MergeBeanFiles(base, ours, theirs []byte, id string) (merged []byte, conflicted bool, err error) {
+	oursBean, err := bean.Parse(bytes.NewReader(ours))
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing our version: %w", err)
+	}
+	theirsBean, err := bean.Parse(bytes.NewReader(theirs))
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing their version: %w", err)
+	}
+	// The base is empty when the file was added on both sides
+	var baseBean *bean.Bean
+	if len(bytes.TrimSpace(base)) > 0 {
+		if baseBean, err = bean.Parse(bytes.NewReader(base)); err != nil {
+			return nil, false, fmt.Errorf("parsing base version: %w", err)
+		}
+	}
+
+	result := bean.MergeFrontMatter(baseBean, oursBean, theirsBean)
+	result.ID = id
+
+	baseBody := ""
+	if baseBean != nil {
+		baseBody = baseBean.Body
+	}
+	switch {
+	case oursBean.Body == theirsBean.Body, theirsBean.Body == baseBody:
+		result.Body = oursBean.Body
+	case oursBean.Body == baseBody:
+		result.Body = theirsBean.Body
+	default:
+		body, conflict, err := gitflow.MergeText(
+			withNewline(baseBody), withNewline(oursBean.Body), withNewline(theirsBean.Body),
+			[3]string{"ours", "base", "theirs"},
+		)
+		if err != nil {
+			return nil, false, err
+		}
+		result.Body = body
+		conflicted = conflict
+	}
+
+	merged, err = result.Render()
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, conflicted, nil
+}
+
+// withNewline ensures text ends with a newline, so line merges don't treat
+// the last line specially.
+func withNewline(s string) string {
+	if s == "" || s[len(s)-1] == '\n' {
+		return s
+	}
+	return s + "\n"
+}
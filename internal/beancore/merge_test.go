@@ -0,0 +1,83 @@
+package beancore
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeBeanFiles(t *testing.T) {
+	const base = `---
+title: Task
+status: todo
+tags:
+    - a
+---
+
+Line one
+Line two
+Line three
+`
+
+	tests := []struct {
+		name         string
+		base         string
+		ours         string
+		theirs       string
+		wantConflict bool
+		wantContains []string
+	}{
+		{
+			name:   "front matter and body changes on both sides",
+			base:   base,
+			ours:   strings.Replace(strings.Replace(base, "status: todo", "status: in-progress", 1), "Line one", "Line ONE", 1),
+			theirs: strings.Replace(strings.Replace(base, "    - a\n", "    - a\n    - b\n", 1), "Line three", "Line THREE", 1),
+			wantContains: []string{
+				"# beans-abc1\n", "status: in-progress", "- a\n", "- b\n", "Line ONE", "Line THREE",
+			},
+		},
+		{
+			name:         "conflicting body lines",
+			base:         base,
+			ours:         strings.Replace(base, "Line two", "Our line", 1),
+			theirs:       strings.Replace(base, "Line two", "Their line", 1),
+			wantConflict: true,
+			wantContains: []string{"<<<<<<< ours", "Our line", "=======", "Their line", ">>>>>>> theirs"},
+		},
+		{
+			name:         "added on both sides",
+			base:         "",
+			ours:         "---\ntitle: Task\nstatus: todo\n---\n\nBody\n",
+			theirs:       "---\ntitle: Task\nstatus: todo\ntags:\n    - new\n---\n\nBody\n",
+			wantContains: []string{"- new", "Body"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicted, err := MergeBeanFiles([]byte(tt.base), []byte(tt.ours), []byte(tt.theirs), "beans-abc1")
+			if err != nil {
+				t.Fatalf("MergeBeanFiles() error = %v", err)
+			}
+			if conflicted != tt.wantConflict {
+				t.Errorf("conflicted = %v, want %v", conflicted, tt.wantConflict)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(string(merged), want) {
+					t.Errorf("merged file missing %q:\n%s", want, merged)
+				}
+			}
+			// Front matter must never contain conflict markers
+			fm := strings.SplitN(string(merged), "\n---\n", 2)[0]
+			if strings.Contains(fm, "<<<<<<<") {
+				t.Errorf("front matter contains conflict markers:\n%s", merged)
+			}
+		})
+	}
+}
+
+func TestMergeBeanFiles_InvalidInput(t *testing.T) {
+	_, _, err := MergeBeanFiles(nil, []byte("---\ntitle: [unclosed\n---\n"), []byte("---\ntitle: ok\n---\n"), "x")
+	if err == nil {
+		t.Error("MergeBeanFiles() should fail on unparseable front matter")
+	}
+}
@@ -0,0 +1,106 @@
+package beancore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// MigratedFile lists the changes a migration made (or would make) to a bean file.
+type MigratedFile struct {
+	Path    string   `json:"path"`
+	Changes []string `json:"changes"`
+}
+
+// MigrationReport summarizes a run of the file format migrations.
+type MigrationReport struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+	// Applied lists the descriptions of the migrations that ran.
+	Applied []string `json:"applied"`
+	// Scanned is the number of bean files looked at.
+	Scanned int            `json:"scanned"`
+	Files   []MigratedFile `json:"files"`
+	DryRun  bool           `json:"dry_run,omitempty"`
+}
+
+// Migrate upgrades every bean file on disk, including archived beans, from
+// the project's format version to the latest one. With dryRun, nothing is
+// written. Files are sorted by path. Recording the new format version in the
+// config file is up to the caller.
+func (c *Core) Migrate(dryRun bool) (*MigrationReport, error) {
+	from := 0
+	if c.config != nil {
+		from = c.config.Beans.FormatVersion
+	}
+	to := bean.CurrentFormatVersion
+	report := &MigrationReport{From: from, To: to, Applied: []string{}, Files: []MigratedFile{}, DryRun: dryRun}
+	if from > to {
+		return nil, fmt.Errorf("format version %d is newer than this version of beans supports (%d)", from, to)
+	}
+	for _, m := range bean.Migrations {
+		if m.Version > from && m.Version <= to {
+			report.Applied = append(report.Applied, m.Description)
+		}
+	}
+	if from == to {
+		return report, nil
+	}
+
+	files, scanned, err := c.rewriteBeanFiles(dryRun, func(content []byte) ([]byte, []string, error) {
+		return bean.Migrate(content, from, to)
+	})
+	report.Files, report.Scanned = files, scanned
+	if err != nil {
+		return report, fmt.Errorf("migrating beans: %w", err)
+	}
+
+	if !dryRun && len(report.Files) > 0 {
+		if err := c.Load(); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// rewriteBeanFiles runs rewrite on every bean file on disk, including
+// archived beans, and writes back the files it changed unless dryRun is set.
+// It returns the changed files sorted by path, and the number of files
+// scanned. Beans are not reloaded.
+func (c *Core) rewriteBeanFiles(dryRun bool, rewrite func(content []byte) ([]byte, []string, error)) ([]MigratedFile, int, error) {
+	files := []MigratedFile{}
+	scanned := 0
+	err := c.walkUnignored(c.root, func(path string, d os.DirEntry) error {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".md") {
+			return nil
+		}
+		scanned++
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(c.root, path)
+		if err != nil {
+			return err
+		}
+		rewritten, changes, err := rewrite(content)
+		if err != nil {
+			return fmt.Errorf("%s: %w", relPath, err)
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		files = append(files, MigratedFile{Path: filepath.ToSlash(relPath), Changes: changes})
+		if dryRun {
+			return nil
+		}
+		return os.WriteFile(path, rewritten, 0644)
+	})
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, scanned, err
+}
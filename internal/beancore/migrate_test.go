@@ -0,0 +1,69 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	core.config.Beans.FormatVersion = 0
+
+	legacy := "---\n# old1\ntitle: Old\nstatus: open\nepic: epic1\n---\n"
+	current := "---\n# new1\ntitle: New\nstatus: todo\n---\n"
+	os.MkdirAll(filepath.Join(beansDir, "archive"), 0755)
+	os.WriteFile(filepath.Join(beansDir, "old1--old.md"), []byte(legacy), 0644)
+	os.WriteFile(filepath.Join(beansDir, "archive", "old2--archived.md"), []byte(strings.Replace(legacy, "old1", "old2", 1)), 0644)
+	os.WriteFile(filepath.Join(beansDir, "new1--new.md"), []byte(current), 0644)
+
+	report, err := core.Migrate(true)
+	if err != nil {
+		t.Fatalf("Migrate(dry run) error = %v", err)
+	}
+	if report.From != 0 || report.To != 1 || report.Scanned != 3 || len(report.Files) != 2 {
+		t.Fatalf("Migrate(dry run) = %+v, want 2 of 3 files migrated from 0 to 1", report)
+	}
+	if report.Files[0].Path != filepath.Join("archive", "old2--archived.md") {
+		t.Errorf("Files[0].Path = %q, want the archived bean first", report.Files[0].Path)
+	}
+	if data, _ := os.ReadFile(filepath.Join(beansDir, "old1--old.md")); string(data) != legacy {
+		t.Errorf("dry run changed the file:\n%s", data)
+	}
+
+	if _, err := core.Migrate(false); err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	b, err := core.Get("old1")
+	if err != nil {
+		t.Fatalf("Get(old1) error = %v", err)
+	}
+	if b.Status != "todo" || b.Parent != "epic1" {
+		t.Errorf("old1: status %q, parent %q; want todo, epic1", b.Status, b.Parent)
+	}
+	if data, _ := os.ReadFile(filepath.Join(beansDir, "new1--new.md")); string(data) != current {
+		t.Errorf("current bean was rewritten:\n%s", data)
+	}
+}
+
+func TestMigrateUpToDate(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	report, err := core.Migrate(false)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if len(report.Applied) != 0 || report.Scanned != 0 {
+		t.Errorf("Migrate() = %+v, want nothing to do", report)
+	}
+}
+
+func TestMigrateNewerVersion(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.config.Beans.FormatVersion = 99
+
+	if _, err := core.Migrate(false); err == nil {
+		t.Error("Migrate() error = nil, want error for a newer format version")
+	}
+}
@@ -0,0 +1,81 @@
+package beancore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MoveSubtree moves the files of a bean and all its descendants into dir,
+// given relative to the beans directory ("" for the beans directory itself).
+// Archived beans stay in the archive. Returns the number of files moved.
+func (c *Core) MoveSubtree(id, dir string) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	root, _, err := c.findBeanLocked(id)
+	if err != nil {
+		return 0, err
+	}
+	dir = filepath.Clean(dir)
+	if dir == "." {
+		dir = ""
+	}
+	if c.isArchivedPath(dir + "/") {
+		return 0, fmt.Errorf("cannot move beans into the archive directory")
+	}
+	if IsLocalPath(dir + "/") {
+		if err := c.ensureLocalDir(); err != nil {
+			return 0, err
+		}
+	}
+
+	queue := []string{root.ID}
+	seen := map[string]bool{root.ID: true}
+	moved := 0
+	for len(queue) > 0 {
+		b := c.beans[queue[0]]
+		queue = queue[1:]
+		for _, child := range c.childrenLocked(b.ID) {
+			if !seen[child.ID] {
+				seen[child.ID] = true
+				queue = append(queue, child.ID)
+			}
+		}
+
+		if b.Path == "" || c.isArchivedPath(b.Path) {
+			continue
+		}
+		newRelPath := beanPath(dir, filepath.Base(b.Path))
+		if newRelPath == beanPath(b.Path) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(c.root, dir), 0755); err != nil {
+			return moved, fmt.Errorf("creating directory: %w", err)
+		}
+		if err := os.Rename(filepath.Join(c.root, b.Path), filepath.Join(c.root, newRelPath)); err != nil {
+			return moved, fmt.Errorf("moving %s: %w", b.ID, err)
+		}
+		b.Path = newRelPath
+		c.markChanged()
+		moved++
+	}
+	return moved, nil
+}
+
+// BeanDir returns the directory (relative to the beans directory) that holds
+// the bean's file, or "" for the beans directory itself. Archived beans map to "".
+func (c *Core) BeanDir(id string) (string, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return "", err
+	}
+	if b.Path == "" || c.isArchivedPath(b.Path) {
+		return "", nil
+	}
+	dir := filepath.Dir(b.Path)
+	if dir == "." {
+		return "", nil
+	}
+	return dir, nil
+}
@@ -0,0 +1,70 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestMoveSubtree(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	epic := createTestBean(t, core, "ep1", "Epic", "todo")
+	child := &bean.Bean{ID: "ch1", Slug: "child", Title: "Child", Status: "todo", Parent: "ep1"}
+	grandchild := &bean.Bean{ID: "gc1", Slug: "grandchild", Title: "Grandchild", Status: "todo", Parent: "ch1"}
+	archived := &bean.Bean{ID: "ar1", Slug: "archived", Title: "Archived", Status: "completed", Parent: "ep1"}
+	other := createTestBean(t, core, "ot1", "Other", "todo")
+	for _, b := range []*bean.Bean{child, grandchild, archived} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	if err := core.Archive("ar1"); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+
+	moved, err := core.MoveSubtree("ep1", "q3/auth")
+	if err != nil {
+		t.Fatalf("MoveSubtree() error = %v", err)
+	}
+	if moved != 3 {
+		t.Errorf("moved = %d, want 3", moved)
+	}
+
+	for _, b := range []*bean.Bean{epic, child, grandchild} {
+		want := filepath.Join("q3/auth", filepath.Base(b.Path))
+		if b.Path != want {
+			t.Errorf("%s Path = %q, want %q", b.ID, b.Path, want)
+		}
+		if _, err := os.Stat(filepath.Join(beansDir, b.Path)); err != nil {
+			t.Errorf("%s file not at new path: %v", b.ID, err)
+		}
+	}
+	if ar, _ := core.Get("ar1"); !core.isArchivedPath(ar.Path) {
+		t.Errorf("archived bean moved out of archive: %q", ar.Path)
+	}
+	if other.Path != "ot1--other.md" {
+		t.Errorf("unrelated bean moved: %q", other.Path)
+	}
+
+	if dir, _ := core.BeanDir("ch1"); dir != "q3/auth" {
+		t.Errorf("BeanDir() = %q, want q3/auth", dir)
+	}
+
+	// Moving back to the root
+	if _, err := core.MoveSubtree("ep1", ""); err != nil {
+		t.Fatalf("MoveSubtree() error = %v", err)
+	}
+	if epic.Path != "ep1--epic.md" {
+		t.Errorf("Path = %q, want ep1--epic.md", epic.Path)
+	}
+
+	if _, err := core.MoveSubtree("ep1", ArchiveDir); err == nil {
+		t.Error("expected error moving into the archive")
+	}
+	if _, err := core.MoveSubtree("nope", ""); err != ErrNotFound {
+		t.Errorf("MoveSubtree(nope) error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,127 @@
+package beancore
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// ErrNoCodeOwners is returned when the repository has no CODEOWNERS file.
+var ErrNoCodeOwners = errors.New("no CODEOWNERS file found (looked for .github/CODEOWNERS, CODEOWNERS and docs/CODEOWNERS)")
+
+// Ownership describes who owns the code a bean touches, per CODEOWNERS.
+type Ownership struct {
+	BeanID  string   `json:"bean_id"`
+	Owners  []string `json:"owners"`            // suggested assignees, owners of the most files first
+	Files   int      `json:"files"`             // files changed on the bean's branch (0 if derived from its scope)
+	Unowned []string `json:"unowned,omitempty"` // changed files no rule assigns an owner
+}
+
+// CodeOwners loads the repository's CODEOWNERS file. The repository root is
+// the git working tree if git integration is enabled, or else the directory
+// containing .beans.yml. Returns ErrNoCodeOwners if there is none.
+func (c *Core) CodeOwners() (*gitflow.CodeOwners, error) {
+	root := ""
+	if c.IsGitFlowEnabled() {
+		root = c.gitFlow.RepoPath()
+	} else if c.config != nil {
+		root = c.config.ConfigDir()
+	}
+	if root == "" {
+		return nil, ErrNoCodeOwners
+	}
+	co, err := gitflow.LoadCodeOwners(root)
+	if err != nil {
+		return nil, err
+	}
+	if co == nil {
+		return nil, ErrNoCodeOwners
+	}
+	return co, nil
+}
+
+// Ownership returns the owners of the code a bean touches: the files changed
+// on its git branch (or its merge commit, once the branch is deleted), or
+// else the directory of its scope.
+func (c *Core) Ownership(b *bean.Bean) (*Ownership, error) {
+	co, err := c.CodeOwners()
+	if err != nil {
+		return nil, err
+	}
+	return c.ownership(co, b)
+}
+
+func (c *Core) ownership(co *gitflow.CodeOwners, b *bean.Bean) (*Ownership, error) {
+	o := &Ownership{BeanID: b.ID, Owners: []string{}}
+
+	var files []string
+	if c.IsGitFlowEnabled() && (b.GitBranch != "" || b.GitMergeCommit != "") {
+		var err error
+		if files, err = c.branchFiles(b, c.getBaseBranch()); err != nil {
+			return nil, err
+		}
+	}
+	if len(files) == 0 {
+		if b.Scope != "" {
+			o.Owners = append(o.Owners, co.DirOwners(b.Scope)...)
+		}
+		return o, nil
+	}
+
+	o.Files = len(files)
+	counts := make(map[string]int)
+	for _, f := range files {
+		owners := co.Owners(f)
+		if len(owners) == 0 {
+			o.Unowned = append(o.Unowned, f)
+		}
+		for _, owner := range owners {
+			if counts[owner] == 0 {
+				o.Owners = append(o.Owners, owner)
+			}
+			counts[owner]++
+		}
+	}
+	sort.SliceStable(o.Owners, func(i, j int) bool {
+		return counts[o.Owners[i]] > counts[o.Owners[j]]
+	})
+	return o, nil
+}
+
+// CheckOwnership returns the ownership of every open bean (not completed or
+// scrapped) with a git branch that changes files no CODEOWNERS rule assigns an
+// owner. Returns ErrNoCodeOwners if the repository has no CODEOWNERS file.
+func (c *Core) CheckOwnership() ([]Ownership, error) {
+	co, err := c.CodeOwners()
+	if err != nil {
+		return nil, err
+	}
+	if !c.IsGitFlowEnabled() {
+		return nil, nil
+	}
+
+	c.mu.RLock()
+	var beans []*bean.Bean
+	for _, b := range c.beans {
+		if b.GitBranch != "" && (c.config == nil || !c.config.IsArchiveStatus(b.Status)) {
+			beans = append(beans, b)
+		}
+	}
+	c.mu.RUnlock()
+	sort.Slice(beans, func(i, j int) bool { return beans[i].ID < beans[j].ID })
+
+	var result []Ownership
+	for _, b := range beans {
+		o, err := c.ownership(co, b)
+		if err != nil {
+			c.logger.Warn("checking ownership failed", "bean", b.ID, "error", err)
+			continue
+		}
+		if len(o.Unowned) > 0 {
+			result = append(result, *o)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestGitFlow_Ownership(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+
+	core.Create(&bean.Bean{ID: "beans-task1", Slug: "task", Title: "Task", Status: "todo"})
+	core.Create(&bean.Bean{ID: "beans-scoped", Slug: "scoped", Title: "Scoped", Status: "todo", Scope: "packages/web"})
+	b, _ := core.Get("beans-task1")
+	if _, err := core.Ownership(b); err != ErrNoCodeOwners {
+		t.Fatalf("Ownership() without CODEOWNERS error = %v, want ErrNoCodeOwners", err)
+	}
+	if _, err := core.CheckOwnership(); err != ErrNoCodeOwners {
+		t.Fatalf("CheckOwnership() without CODEOWNERS error = %v, want ErrNoCodeOwners", err)
+	}
+
+	os.WriteFile(filepath.Join(repoPath, "CODEOWNERS"), []byte("/packages/api/ @api-team\n/packages/web/ @web-team\n*.md @docs\n"), 0644)
+	runGit(t, repoPath, "add", "CODEOWNERS")
+	runGit(t, repoPath, "commit", "--quiet", "-m", "codeowners")
+
+	b, err := core.BranchBean("beans-task1")
+	if err != nil {
+		t.Fatalf("BranchBean() error = %v", err)
+	}
+	for _, name := range []string{"packages/api/a.go", "packages/api/b.go", "packages/api/README.md", "tools/gen.go"} {
+		os.MkdirAll(filepath.Join(repoPath, filepath.Dir(name)), 0755)
+		os.WriteFile(filepath.Join(repoPath, name), []byte("x\n"), 0644)
+	}
+	runGit(t, repoPath, "add", "packages", "tools")
+	runGit(t, repoPath, "commit", "--quiet", "-m", "api work")
+
+	o, err := core.Ownership(b)
+	if err != nil {
+		t.Fatalf("Ownership() error = %v", err)
+	}
+	want := &Ownership{BeanID: "beans-task1", Owners: []string{"@api-team", "@docs"}, Files: 4, Unowned: []string{"tools/gen.go"}}
+	if !reflect.DeepEqual(o, want) {
+		t.Errorf("Ownership() = %+v, want %+v", o, want)
+	}
+
+	// Beans without a branch use their scope
+	scoped, _ := core.Get("beans-scoped")
+	o, err = core.Ownership(scoped)
+	if err != nil {
+		t.Fatalf("Ownership() for scoped bean error = %v", err)
+	}
+	if !reflect.DeepEqual(o.Owners, []string{"@web-team"}) || o.Files != 0 {
+		t.Errorf("Ownership() for scoped bean = %+v, want @web-team from its scope", o)
+	}
+
+	unowned, err := core.CheckOwnership()
+	if err != nil {
+		t.Fatalf("CheckOwnership() error = %v", err)
+	}
+	if len(unowned) != 1 || unowned[0].BeanID != "beans-task1" {
+		t.Errorf("CheckOwnership() = %+v, want beans-task1", unowned)
+	}
+}
@@ -0,0 +1,13 @@
+package beancore
+
+import "path/filepath"
+
+// Bean paths (bean.Bean.Path) are relative to the beans directory and always
+// use forward slashes, whatever the platform, so they compare, sort and show
+// the same everywhere. The os and path/filepath functions accept them as is,
+// on Windows too.
+
+// beanPath joins path elements into a bean path.
+func beanPath(elem ...string) string {
+	return filepath.ToSlash(filepath.Join(elem...))
+}
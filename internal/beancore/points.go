@@ -0,0 +1,64 @@
+package beancore
+
+import (
+	"github.com/hmans/beans/internal/bean"
+)
+
+// PointsRollup holds aggregated story points for a bean and its descendants.
+type PointsRollup struct {
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+	Remaining int `json:"remaining"`
+	// Unestimated counts the beans without children and without points
+	// that the totals leave out.
+	Unestimated int `json:"unestimated"`
+}
+
+// RollupPoints aggregates story points for the bean with the given ID.
+// Beans without children contribute their own points. Beans with children
+// (epics, milestones, features with sub-tasks) sum the rollups of their
+// children, so estimates on containers don't get counted twice.
+// Scrapped beans are excluded from all totals.
+// Beans without children and without points are counted as unestimated.
+func (c *Core) RollupPoints(id string) PointsRollup {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	b, ok := c.beans[id]
+	if !ok {
+		return PointsRollup{}
+	}
+
+	return rollupPoints(b, c.childrenLocked, make(map[string]bool))
+}
+
+// rollupPoints recursively aggregates points. The visited set guards against parent cycles.
+func rollupPoints(b *bean.Bean, children func(id string) []*bean.Bean, visited map[string]bool) PointsRollup {
+	var result PointsRollup
+	if visited[b.ID] || b.Status == "scrapped" {
+		return result
+	}
+	visited[b.ID] = true
+
+	kids := children(b.ID)
+	if len(kids) == 0 {
+		if b.Points != nil {
+			result.Total = *b.Points
+			if b.Status == "completed" {
+				result.Completed = *b.Points
+			}
+		} else {
+			result.Unestimated = 1
+		}
+	} else {
+		for _, child := range kids {
+			sub := rollupPoints(child, children, visited)
+			result.Total += sub.Total
+			result.Completed += sub.Completed
+			result.Unestimated += sub.Unestimated
+		}
+	}
+
+	result.Remaining = result.Total - result.Completed
+	return result
+}
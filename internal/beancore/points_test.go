@@ -0,0 +1,73 @@
+package beancore
+
+import (
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func createPointsBean(t *testing.T, core *Core, id, status, parent string, points *int) {
+	t.Helper()
+	b := &bean.Bean{
+		ID:     id,
+		Title:  id,
+		Status: status,
+		Parent: parent,
+		Points: points,
+	}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("failed to create test bean: %v", err)
+	}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestRollupPoints(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	createPointsBean(t, core, "milestone", "todo", "", nil)
+	createPointsBean(t, core, "epic", "in-progress", "milestone", intPtr(100)) // ignored: has children
+	createPointsBean(t, core, "task-1", "completed", "epic", intPtr(3))
+	createPointsBean(t, core, "task-2", "todo", "epic", intPtr(5))
+	createPointsBean(t, core, "task-3", "scrapped", "epic", intPtr(8))
+	createPointsBean(t, core, "task-4", "todo", "epic", nil)
+	createPointsBean(t, core, "feature", "todo", "milestone", intPtr(2))
+	createPointsBean(t, core, "orphan", "todo", "", intPtr(13))
+
+	tests := []struct {
+		id   string
+		want PointsRollup
+	}{
+		{"milestone", PointsRollup{Total: 10, Completed: 3, Remaining: 7, Unestimated: 1}},
+		{"epic", PointsRollup{Total: 8, Completed: 3, Remaining: 5, Unestimated: 1}},
+		{"task-1", PointsRollup{Total: 3, Completed: 3, Remaining: 0}},
+		{"task-3", PointsRollup{}},
+		{"task-4", PointsRollup{Unestimated: 1}},
+		{"orphan", PointsRollup{Total: 13, Completed: 0, Remaining: 13}},
+		{"nonexistent", PointsRollup{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got := core.RollupPoints(tt.id)
+			if got != tt.want {
+				t.Errorf("RollupPoints(%q) = %+v, want %+v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollupPointsWithCycle(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	createPointsBean(t, core, "a", "todo", "b", intPtr(1))
+	createPointsBean(t, core, "b", "todo", "a", intPtr(2))
+
+	// Should terminate despite the parent cycle
+	got := core.RollupPoints("a")
+	if got.Total != 0 {
+		t.Errorf("RollupPoints() with cycle = %+v, want zero total", got)
+	}
+}
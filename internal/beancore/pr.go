@@ -0,0 +1,64 @@
+package beancore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// SetPRProvider sets the provider used to look up pull request state during sync.
+// By default, pull requests are looked up on GitHub using $GITHUB_TOKEN if set.
+func (c *Core) SetPRProvider(p gitflow.PRProvider) {
+	c.prProvider = p
+}
+
+// getPRProvider returns the configured PR provider, defaulting to GitHub.
+func (c *Core) getPRProvider() gitflow.PRProvider {
+	if c.prProvider == nil {
+		c.prProvider = gitflow.NewGitHubProvider(os.Getenv("GITHUB_TOKEN"))
+	}
+	return c.prProvider
+}
+
+// syncFromPR updates a bean from the state of its pull request:
+// - merged → "completed" (with merge commit and time, if known)
+// - closed without merging → "scrapped"
+// - open → status unchanged
+// The last seen PR state is recorded in GitPRState. Returns true if the bean was modified.
+func (c *Core) syncFromPR(b *bean.Bean) (bool, error) {
+	status, err := c.getPRProvider().PRStatus(context.Background(), b.GitPRURL)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pull request: %w", err)
+	}
+
+	newStatus := b.Status
+	switch status.State {
+	case gitflow.PRStateMerged:
+		newStatus = "completed"
+	case gitflow.PRStateClosed:
+		newStatus = "scrapped"
+	}
+	if b.GitPRState == string(status.State) && b.Status == newStatus {
+		return false, nil
+	}
+
+	b.GitPRState = string(status.State)
+	if b.Status != newStatus {
+		b.Status = newStatus
+		if status.State == gitflow.PRStateMerged {
+			if status.MergeCommit != "" {
+				b.GitMergeCommit = status.MergeCommit
+			}
+			mergedAt := time.Now().UTC().Truncate(time.Second)
+			if status.MergedAt != nil {
+				mergedAt = status.MergedAt.UTC().Truncate(time.Second)
+			}
+			b.GitMergedAt = &mergedAt
+		}
+	}
+	return true, nil
+}
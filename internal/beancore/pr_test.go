@@ -0,0 +1,105 @@
+package beancore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// fakePRProvider returns canned pull request states keyed by URL.
+type fakePRProvider map[string]gitflow.PRState
+
+func (f fakePRProvider) PRStatus(ctx context.Context, prURL string) (*gitflow.PRStatus, error) {
+	state, ok := f[prURL]
+	if !ok {
+		return nil, errors.New("provider unavailable")
+	}
+	status := &gitflow.PRStatus{State: state}
+	if state == gitflow.PRStateMerged {
+		status.MergeCommit = "deadbeef"
+	}
+	return status, nil
+}
+
+func TestSyncGitBranches_PullRequests(t *testing.T) {
+	core, _, _ := setupTestCoreWithGit(t)
+	core.SetPRProvider(fakePRProvider{
+		"https://github.com/o/r/pull/1": gitflow.PRStateMerged,
+		"https://github.com/o/r/pull/2": gitflow.PRStateClosed,
+		"https://github.com/o/r/pull/3": gitflow.PRStateOpen,
+	})
+
+	beans := []*bean.Bean{
+		// Squash-merged PR whose branch no longer exists anywhere
+		{ID: "beans-merged", Title: "Merged", Status: "in-progress", GitBranch: "beans-merged/gone", GitPRURL: "https://github.com/o/r/pull/1"},
+		{ID: "beans-closed", Title: "Closed", Status: "in-progress", GitPRURL: "https://github.com/o/r/pull/2"},
+		// Open PR: branch deletion must not scrap the bean
+		{ID: "beans-open", Title: "Open", Status: "in-progress", GitBranch: "beans-open/gone", GitPRURL: "https://github.com/o/r/pull/3"},
+		// Provider failure without branch: reported as error
+		{ID: "beans-broken", Title: "Broken", Status: "in-progress", GitPRURL: "https://github.com/o/r/pull/4"},
+	}
+	for _, b := range beans {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	result, err := core.SyncGitBranches()
+	if err != nil {
+		t.Fatalf("SyncGitBranches() error = %v", err)
+	}
+	if len(result.Errors) != 1 {
+		t.Errorf("SyncGitBranches() errors = %v, want 1 error", result.Errors)
+	}
+	// The open PR's state is recorded, but its status didn't change
+	if len(result.Updated) != 2 {
+		t.Errorf("SyncGitBranches() updated %d beans, want 2 (merged and closed)", len(result.Updated))
+	}
+
+	tests := []struct {
+		id          string
+		wantStatus  string
+		wantPRState string
+	}{
+		{"beans-merged", "completed", "merged"},
+		{"beans-closed", "scrapped", "closed"},
+		{"beans-open", "in-progress", "open"},
+		{"beans-broken", "in-progress", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			b, err := core.Get(tt.id)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if b.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", b.Status, tt.wantStatus)
+			}
+			if b.GitPRState != tt.wantPRState {
+				t.Errorf("GitPRState = %q, want %q", b.GitPRState, tt.wantPRState)
+			}
+		})
+	}
+
+	merged, _ := core.Get("beans-merged")
+	if merged.GitMergeCommit != "deadbeef" || merged.GitMergedAt == nil {
+		t.Errorf("merge metadata not set: commit %q, merged at %v", merged.GitMergeCommit, merged.GitMergedAt)
+	}
+
+	// Nothing changed since, so nothing is saved or reported
+	open, _ := core.Get("beans-open")
+	updatedAt := *open.UpdatedAt
+	result, err = core.SyncGitBranches()
+	if err != nil {
+		t.Fatalf("SyncGitBranches() error = %v", err)
+	}
+	if len(result.Updated) != 0 {
+		t.Errorf("second SyncGitBranches() updated %d beans, want 0", len(result.Updated))
+	}
+	if open, _ := core.Get("beans-open"); !open.UpdatedAt.Equal(updatedAt) {
+		t.Errorf("unchanged bean was saved again: updated_at %v, was %v", open.UpdatedAt, updatedAt)
+	}
+}
@@ -0,0 +1,86 @@
+package beancore
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// PullRemote is the remote PullBaseBranch pulls from.
+const PullRemote = "origin"
+
+// PulledBean is a bean that was added, changed or deleted upstream.
+type PulledBean struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`  // the ID if the title isn't known
+	Change string `json:"change"` // gitflow.FileAdded, FileModified or FileDeleted
+}
+
+// PullResult describes what PullBaseBranch pulled.
+type PullResult struct {
+	Remote string       `json:"remote"`
+	Branch string       `json:"branch"`
+	From   string       `json:"from"`
+	To     string       `json:"to"`
+	Beans  []PulledBean `json:"beans"`
+}
+
+// PullBaseBranch fetches the base branch from PullRemote, fast-forwards it
+// and reloads the beans, reporting the beans changed upstream. It never
+// merges; a base branch that has diverged from the remote fails with
+// gitflow.ErrDiverged.
+func (c *Core) PullBaseBranch() (*PullResult, error) {
+	if !c.IsGitFlowEnabled() {
+		return nil, fmt.Errorf("git integration is not enabled")
+	}
+
+	c.mu.RLock()
+	baseBranch := c.getBaseBranch()
+	titles := make(map[string]string, len(c.beans))
+	for id, b := range c.beans {
+		titles[id] = b.Title
+	}
+	c.mu.RUnlock()
+
+	root, err := filepath.Abs(c.root)
+	if err != nil {
+		return nil, err
+	}
+	pulled, err := c.gitFlow.Pull(PullRemote, baseBranch, root)
+	if err != nil {
+		return nil, err
+	}
+	result := &PullResult{Remote: pulled.Remote, Branch: pulled.Branch, From: pulled.From, To: pulled.To, Beans: []PulledBean{}}
+	if len(pulled.Changes) == 0 {
+		return result, nil
+	}
+
+	if err := c.Load(); err != nil {
+		return nil, fmt.Errorf("reloading beans: %w", err)
+	}
+	for _, change := range pulled.Changes {
+		if !strings.HasSuffix(change.Path, ".md") {
+			continue
+		}
+		id, _ := bean.ParseFilename(path.Base(change.Path))
+		pb := PulledBean{ID: id, Title: titles[id], Change: change.Change}
+		if change.Change != gitflow.FileDeleted {
+			if b, err := c.Get(id); err == nil {
+				pb.ID, pb.Title = b.ID, b.Title
+			}
+		}
+		// Beans added upstream aren't loaded while another branch is
+		// checked out, and beans may have no title
+		if pb.Title == "" {
+			pb.Title = pb.ID
+		}
+		result.Beans = append(result.Beans, pb)
+	}
+	sort.Slice(result.Beans, func(i, j int) bool { return result.Beans[i].ID < result.Beans[j].ID })
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package beancore
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	args = append([]string{"-C", dir, "-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)
+	if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
+
+func TestPullBaseBranch(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	for _, b := range []*bean.Bean{
+		{ID: "beans-keep", Slug: "keep", Title: "Keep", Status: "todo"},
+		{ID: "beans-gone", Slug: "gone", Title: "Gone", Status: "todo"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	runGit(t, repoPath, "add", ".beans")
+	runGit(t, repoPath, "commit", "--quiet", "-m", "add beans")
+
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	upstream := filepath.Join(t.TempDir(), "upstream")
+	runGit(t, repoPath, "clone", "--quiet", "--bare", repoPath, remote)
+	runGit(t, repoPath, "remote", "add", "origin", remote)
+	runGit(t, repoPath, "clone", "--quiet", "--branch", "main", remote, upstream)
+
+	// Upstream, a teammate adds a bean, edits one and deletes another
+	newBean := &bean.Bean{ID: "beans-new1", Slug: "new", Title: "From upstream", Status: "todo"}
+	content, _ := newBean.Render()
+	os.WriteFile(filepath.Join(upstream, ".beans", "beans-new1--new.md"), content, 0644)
+	keep, _ := core.Get("beans-keep")
+	edited := *keep
+	edited.Title = "Keep (edited)"
+	content, _ = edited.Render()
+	os.WriteFile(filepath.Join(upstream, ".beans", "beans-keep--keep.md"), content, 0644)
+	runGit(t, upstream, "rm", "--quiet", ".beans/beans-gone--gone.md")
+	runGit(t, upstream, "add", ".beans")
+	runGit(t, upstream, "commit", "--quiet", "-m", "upstream beans")
+	runGit(t, upstream, "push", "--quiet", "origin", "main")
+
+	result, err := core.PullBaseBranch()
+	if err != nil {
+		t.Fatalf("PullBaseBranch() error = %v", err)
+	}
+	want := []PulledBean{
+		{ID: "beans-gone", Title: "Gone", Change: gitflow.FileDeleted},
+		{ID: "beans-keep", Title: "Keep (edited)", Change: gitflow.FileModified},
+		{ID: "beans-new1", Title: "From upstream", Change: gitflow.FileAdded},
+	}
+	if !reflect.DeepEqual(result.Beans, want) {
+		t.Errorf("Beans = %+v, want %+v", result.Beans, want)
+	}
+	if result.Branch != "main" || result.From == result.To {
+		t.Errorf("result = %+v, want main moved forward", result)
+	}
+
+	// Beans are reloaded
+	if _, err := core.Get("beans-new1"); err != nil {
+		t.Errorf("pulled bean not loaded: %v", err)
+	}
+	if _, err := core.Get("beans-gone"); err == nil {
+		t.Error("deleted bean still loaded")
+	}
+}
+
+func TestPullBaseBranchOnOtherBranch(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	upstream := filepath.Join(t.TempDir(), "upstream")
+	runGit(t, repoPath, "clone", "--quiet", "--bare", repoPath, remote)
+	runGit(t, repoPath, "remote", "add", "origin", remote)
+	runGit(t, repoPath, "clone", "--quiet", "--branch", "main", remote, upstream)
+	runGit(t, repoPath, "checkout", "--quiet", "-b", "feature")
+
+	os.MkdirAll(filepath.Join(upstream, ".beans"), 0755)
+	newBean := &bean.Bean{ID: "beans-new1", Slug: "new", Title: "From upstream", Status: "todo"}
+	content, _ := newBean.Render()
+	os.WriteFile(filepath.Join(upstream, ".beans", "beans-new1--new.md"), content, 0644)
+	runGit(t, upstream, "add", ".beans")
+	runGit(t, upstream, "commit", "--quiet", "-m", "upstream bean")
+	runGit(t, upstream, "push", "--quiet", "origin", "main")
+
+	result, err := core.PullBaseBranch()
+	if err != nil {
+		t.Fatalf("PullBaseBranch() error = %v", err)
+	}
+	want := []PulledBean{{ID: "beans-new1", Title: "beans-new1", Change: gitflow.FileAdded}}
+	if !reflect.DeepEqual(result.Beans, want) {
+		t.Errorf("Beans = %+v, want %+v with the ID as title", result.Beans, want)
+	}
+}
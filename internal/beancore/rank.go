@@ -0,0 +1,84 @@
+package beancore
+
+import (
+	"fmt"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// ReorderBean gives a bean a rank between two neighbours, so it sorts after
+// afterID and before beforeID. Either neighbour may be empty to move the bean
+// to the start or end. Neighbours must already be ranked; use RankBeans to
+// rank a set of beans from scratch.
+func (c *Core) ReorderBean(id, afterID, beforeID string) (*bean.Bean, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	lo, err := c.neighbourRank(b, afterID)
+	if err != nil {
+		return nil, err
+	}
+	hi, err := c.neighbourRank(b, beforeID)
+	if err != nil {
+		return nil, err
+	}
+	rank, err := bean.RankBetween(lo, hi)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Rank = rank
+	if err := c.Update(b, nil); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// neighbourRank returns the rank of the neighbour bean ref ("" for none).
+func (c *Core) neighbourRank(b *bean.Bean, ref string) (string, error) {
+	if ref == "" {
+		return "", nil
+	}
+	n, err := c.Get(ref)
+	if err != nil {
+		return "", err
+	}
+	if n.ID == b.ID {
+		return "", fmt.Errorf("bean cannot be reordered relative to itself")
+	}
+	if n.Rank == "" {
+		return "", fmt.Errorf("bean %s has no rank", n.ID)
+	}
+	return n.Rank, nil
+}
+
+// RankBeans assigns evenly spaced ranks to the given beans in order, replacing
+// any existing ranks. Only beans whose rank changes are written.
+func (c *Core) RankBeans(ids []string) ([]*bean.Bean, error) {
+	beans := make([]*bean.Bean, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		b, err := c.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if seen[b.ID] {
+			return nil, fmt.Errorf("bean %s listed more than once", b.ID)
+		}
+		seen[b.ID] = true
+		beans = append(beans, b)
+	}
+
+	for i, rank := range bean.RankSequence(len(beans)) {
+		if beans[i].Rank == rank {
+			continue
+		}
+		beans[i].Rank = rank
+		if err := c.Update(beans[i], nil); err != nil {
+			return nil, err
+		}
+	}
+	return beans, nil
+}
@@ -0,0 +1,92 @@
+package beancore
+
+import (
+	"testing"
+)
+
+func TestRankBeans(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	createTestBean(t, core, "a1", "A", "todo")
+	createTestBean(t, core, "b1", "B", "todo")
+	createTestBean(t, core, "c1", "C", "todo")
+
+	ranked, err := core.RankBeans([]string{"c1", "a1", "b1"})
+	if err != nil {
+		t.Fatalf("RankBeans() error = %v", err)
+	}
+	if len(ranked) != 3 {
+		t.Fatalf("RankBeans() returned %d beans, want 3", len(ranked))
+	}
+	if !(ranked[0].Rank < ranked[1].Rank && ranked[1].Rank < ranked[2].Rank) {
+		t.Errorf("ranks not ascending: %q %q %q", ranked[0].Rank, ranked[1].Rank, ranked[2].Rank)
+	}
+
+	// Ranks are persisted
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	c, _ := core.Get("c1")
+	a, _ := core.Get("a1")
+	if c.Rank == "" || c.Rank >= a.Rank {
+		t.Errorf("persisted ranks c1=%q a1=%q, want c1 before a1", c.Rank, a.Rank)
+	}
+
+	if _, err := core.RankBeans([]string{"a1", "a1"}); err == nil {
+		t.Error("RankBeans() with duplicate IDs should fail")
+	}
+	if _, err := core.RankBeans([]string{"nope"}); err != ErrNotFound {
+		t.Errorf("RankBeans(nope) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestReorderBean(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	createTestBean(t, core, "a1", "A", "todo")
+	createTestBean(t, core, "b1", "B", "todo")
+	createTestBean(t, core, "c1", "C", "todo")
+	createTestBean(t, core, "u1", "Unranked", "todo")
+	if _, err := core.RankBeans([]string{"a1", "b1", "c1"}); err != nil {
+		t.Fatalf("RankBeans() error = %v", err)
+	}
+	rank := func(id string) string {
+		b, _ := core.Get(id)
+		return b.Rank
+	}
+
+	tests := []struct {
+		name              string
+		id, after, before string
+		wantErr           bool
+	}{
+		{"between", "c1", "a1", "b1", false},
+		{"to start", "a1", "", "c1", false},
+		{"to end", "b1", "a1", "", false},
+		{"neighbour unranked", "a1", "u1", "", true},
+		{"neighbours out of order", "a1", "b1", "c1", true},
+		{"relative to itself", "a1", "a1", "", true},
+		{"not found", "nope", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := core.ReorderBean(tt.id, tt.after, tt.before)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("ReorderBean() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReorderBean() error = %v", err)
+			}
+			if tt.after != "" && b.Rank <= rank(tt.after) {
+				t.Errorf("rank %q not after %s (%q)", b.Rank, tt.after, rank(tt.after))
+			}
+			if tt.before != "" && b.Rank >= rank(tt.before) {
+				t.Errorf("rank %q not before %s (%q)", b.Rank, tt.before, rank(tt.before))
+			}
+		})
+	}
+}
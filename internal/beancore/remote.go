@@ -0,0 +1,406 @@
+package beancore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// SyncDirName is the directory inside the beans directory holding, for each
+// external tracker beans are synced with, which beans are linked to which of
+// its items. It is committed, so everyone syncing links the same pairs.
+const SyncDirName = ".sync"
+
+// SprintTagNamespace is the tag namespace naming the sprint a bean is planned
+// for when syncing with a SprintTracker: a bean tagged "sprint/cycle-12" is
+// in the sprint the tracker calls "cycle-12".
+const SprintTagNamespace = "sprint"
+
+// RemoteItem is an item in an external tracker, such as a card on a board.
+type RemoteItem struct {
+	// ID is the tracker's ID for the item.
+	ID    string
+	Title string
+	Body  string
+	// Statuses are the bean statuses the item's state (e.g. its column)
+	// stands for, preferred first. Empty if it doesn't map to any.
+	Statuses []string
+	// Priorities are the bean priorities the item's priority stands for,
+	// preferred first. Empty if it doesn't map to any, or the tracker has
+	// no priorities.
+	Priorities []string
+	// Sprint is the sprint the item is planned for, if any (see SprintTracker).
+	Sprint    string
+	UpdatedAt time.Time
+}
+
+// RemoteTracker is an external tracker beans can be synced with (see SyncRemote).
+type RemoteTracker interface {
+	// Items returns all of the tracker's items.
+	Items(ctx context.Context) ([]RemoteItem, error)
+	// CreateItem adds an item for the bean.
+	CreateItem(ctx context.Context, b *bean.Bean) (*RemoteItem, error)
+	// UpdateItem changes the item with the given ID to match the bean.
+	UpdateItem(ctx context.Context, id string, b *bean.Bean) (*RemoteItem, error)
+}
+
+// SprintTracker is a RemoteTracker planning its items in sprints, such as
+// Linear's cycles. SyncRemote syncs their sprints with the beans' sprint
+// tags (see SprintTagNamespace).
+type SprintTracker interface {
+	RemoteTracker
+	// HasSprints reports whether the tracker's items are planned in sprints.
+	HasSprints() bool
+}
+
+// BeanSprint returns the sprint a bean's sprint tag names, or an empty
+// string if it has none.
+func BeanSprint(b *bean.Bean) string {
+	for _, tag := range b.Tags {
+		if sprint, ok := strings.CutPrefix(tag, SprintTagNamespace+bean.TagNamespaceSeparator); ok {
+			return sprint
+		}
+	}
+	return ""
+}
+
+// SyncMapping links beans to the items of one tracker, with what they had in
+// common as of the last sync, so changes on either side can be told apart.
+type SyncMapping struct {
+	// Items maps bean IDs to their items.
+	Items map[string]SyncedItem `json:"items"`
+}
+
+// SyncedItem is a bean's linked item in a SyncMapping.
+type SyncedItem struct {
+	RemoteID string `json:"remote_id"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+	Priority string `json:"priority,omitempty"`
+	Sprint   string `json:"sprint,omitempty"`
+	// BodyHash is a hash of the (trimmed) body.
+	BodyHash string `json:"body_hash"`
+	// BeanDeleted marks a tombstone: the bean was deleted, so its item
+	// mustn't get a new bean.
+	BeanDeleted bool `json:"bean_deleted,omitempty"`
+}
+
+// SyncAction is what SyncRemote did (or would do) for a bean or item.
+type SyncAction string
+
+const (
+	SyncPushed       SyncAction = "pushed"        // the bean's changes went to its item
+	SyncPulled       SyncAction = "pulled"        // the item's changes went to its bean
+	SyncCreatedItem  SyncAction = "created-item"  // a new bean got an item
+	SyncCreatedBean  SyncAction = "created-bean"  // a new item got a bean
+	SyncUnlinkedBean SyncAction = "unlinked-bean" // the bean was deleted; its item is left alone
+	SyncUnlinkedItem SyncAction = "unlinked-item" // the item was deleted; its bean is left alone and not synced again
+)
+
+// SyncChange describes one thing SyncRemote did.
+type SyncChange struct {
+	Action   SyncAction `json:"action"`
+	BeanID   string     `json:"bean_id"`
+	RemoteID string     `json:"remote_id,omitempty"`
+	Title    string     `json:"title"`
+	// Conflict is set if both sides had changed since the last sync.
+	Conflict bool `json:"conflict,omitempty"`
+}
+
+// RemoteSyncOptions controls SyncRemote.
+type RemoteSyncOptions struct {
+	// DryRun reports what would change without changing anything.
+	DryRun bool
+	// PullOnly only brings in new and changed items, leaving the tracker
+	// alone: new beans get no item, and beans that changed (including
+	// conflicting ones) aren't synced until a full sync.
+	PullOnly bool
+	// Resolve decides a conflict, where both the bean and its item changed
+	// since the last sync, returning true to take the item's version. Nil
+	// means the newer change wins.
+	Resolve func(b *bean.Bean, item RemoteItem) (bool, error)
+}
+
+// syncMappingPath returns the path of the named tracker's mapping file.
+func (c *Core) syncMappingPath(name string) string {
+	return filepath.Join(c.root, SyncDirName, name+".json")
+}
+
+// LoadSyncMapping reads the mapping of the named tracker. A missing file
+// yields an empty mapping.
+func (c *Core) LoadSyncMapping(name string) (*SyncMapping, error) {
+	m := &SyncMapping{Items: map[string]SyncedItem{}}
+	data, err := os.ReadFile(c.syncMappingPath(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading sync mapping: %w", err)
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("parsing sync mapping %s: %w", name, err)
+	}
+	if m.Items == nil {
+		m.Items = map[string]SyncedItem{}
+	}
+	return m, nil
+}
+
+// saveSyncMapping writes the mapping of the named tracker.
+func (c *Core) saveSyncMapping(name string, m *SyncMapping) error {
+	path := c.syncMappingPath(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating sync directory: %w", err)
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing sync mapping: %w", err)
+	}
+	return nil
+}
+
+// SyncRemote syncs beans with the items of an external tracker both ways,
+// keeping track of which bean belongs to which item in a mapping named name
+// (see SyncDirName):
+//   - Linked beans and items that changed since the last sync are updated
+//     from the side that changed; if both did, opts.Resolve decides.
+//   - New items get a bean.
+//   - New active beans get an item. Archived, local and draft beans, and
+//     beans in an archive status, are left out.
+//   - When either side of a link was deleted, the link is dropped and the
+//     other side is left alone. A bean whose item was deleted doesn't get
+//     a new one.
+//
+// Titles, bodies, statuses and priorities are synced, and with a
+// SprintTracker, sprints. Changes are sorted by bean ID.
+func (c *Core) SyncRemote(ctx context.Context, name string, remote RemoteTracker, opts RemoteSyncOptions) (changes []SyncChange, err error) {
+	mapping, err := c.LoadSyncMapping(name)
+	if err != nil {
+		return nil, err
+	}
+	items, err := remote.Items(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching items: %w", err)
+	}
+	st, ok := remote.(SprintTracker)
+	sprints := ok && st.HasSprints()
+	itemsByID := make(map[string]RemoteItem, len(items))
+	for _, item := range items {
+		itemsByID[item.ID] = item
+	}
+
+	// Keep what was linked even if syncing fails halfway, so items created
+	// so far aren't created again next time
+	if !opts.DryRun {
+		defer func() {
+			if saveErr := c.saveSyncMapping(name, mapping); saveErr != nil && err == nil {
+				err = saveErr
+			}
+		}()
+	}
+
+	linked := make(map[string]bool, len(mapping.Items))
+
+	// Linked pairs
+	for _, beanID := range slices.Sorted(maps.Keys(mapping.Items)) {
+		entry := mapping.Items[beanID]
+		item, itemExists := itemsByID[entry.RemoteID]
+		b, beanErr := c.Get(beanID)
+		switch {
+		case entry.RemoteID == "":
+			// The bean's item was deleted earlier
+			if beanErr != nil {
+				delete(mapping.Items, beanID)
+			}
+			continue
+		case entry.BeanDeleted:
+			// Forget the tombstone once the item is gone too
+			if itemExists {
+				linked[item.ID] = true
+			} else {
+				delete(mapping.Items, beanID)
+			}
+			continue
+		case beanErr != nil:
+			changes = append(changes, SyncChange{Action: SyncUnlinkedBean, BeanID: beanID, RemoteID: entry.RemoteID, Title: item.Title})
+			if itemExists {
+				linked[item.ID] = true
+				mapping.Items[beanID] = SyncedItem{RemoteID: entry.RemoteID, BeanDeleted: true}
+			} else {
+				delete(mapping.Items, beanID)
+			}
+			continue
+		case !itemExists:
+			// Remember the bean, so it doesn't get a new item
+			changes = append(changes, SyncChange{Action: SyncUnlinkedItem, BeanID: beanID, RemoteID: entry.RemoteID, Title: b.Title})
+			mapping.Items[beanID] = SyncedItem{}
+			continue
+		}
+		linked[item.ID] = true
+
+		beanChanged := b.Title != entry.Title || b.Status != entry.Status || b.Priority != entry.Priority ||
+			bodyHash(b.Body) != entry.BodyHash || (sprints && BeanSprint(b) != entry.Sprint)
+		itemChanged := item.Title != entry.Title || bodyHash(item.Body) != entry.BodyHash ||
+			(len(item.Statuses) > 0 && !slices.Contains(item.Statuses, entry.Status)) ||
+			(len(item.Priorities) > 0 && !slices.Contains(item.Priorities, entry.Priority)) ||
+			(sprints && item.Sprint != entry.Sprint)
+		if !beanChanged && !itemChanged {
+			continue
+		}
+		if matchesRemote(b, item, sprints) {
+			// Both sides made the same change
+			mapping.Items[beanID] = syncedItem(b, item, sprints)
+			continue
+		}
+		if opts.PullOnly && beanChanged {
+			continue
+		}
+
+		pull := itemChanged
+		conflict := beanChanged && itemChanged
+		if conflict {
+			if opts.Resolve != nil {
+				if pull, err = opts.Resolve(b, item); err != nil {
+					return changes, err
+				}
+			} else {
+				pull = b.UpdatedAt == nil || item.UpdatedAt.After(*b.UpdatedAt)
+			}
+		}
+
+		change := SyncChange{Action: SyncPushed, BeanID: b.ID, RemoteID: item.ID, Title: b.Title, Conflict: conflict}
+		if pull {
+			change.Action, change.Title = SyncPulled, item.Title
+		}
+		changes = append(changes, change)
+		if opts.DryRun {
+			continue
+		}
+
+		if pull {
+			applyRemoteItem(b, item, sprints)
+			if err := c.Update(b, nil); err != nil {
+				return changes, fmt.Errorf("updating %s: %w", b.ID, err)
+			}
+		} else {
+			updated, err := remote.UpdateItem(ctx, item.ID, b)
+			if err != nil {
+				return changes, fmt.Errorf("updating item for %s: %w", b.ID, err)
+			}
+			item = *updated
+		}
+		mapping.Items[beanID] = syncedItem(b, item, sprints)
+	}
+
+	// New items
+	for _, item := range items {
+		if linked[item.ID] {
+			continue
+		}
+		b := &bean.Bean{Slug: bean.Slugify(item.Title), Title: item.Title}
+		applyRemoteItem(b, item, sprints)
+		if b.Status == "" && c.config != nil {
+			b.Status = c.config.GetDefaultStatus()
+		}
+		if !opts.DryRun {
+			if err := c.Create(b); err != nil {
+				return changes, fmt.Errorf("creating bean for %s: %w", item.Title, err)
+			}
+			mapping.Items[b.ID] = syncedItem(b, item, sprints)
+		}
+		changes = append(changes, SyncChange{Action: SyncCreatedBean, BeanID: b.ID, RemoteID: item.ID, Title: item.Title})
+	}
+
+	// New beans
+	var beans []*bean.Bean
+	if !opts.PullOnly {
+		beans = c.All()
+		sort.Slice(beans, func(i, j int) bool { return beans[i].ID < beans[j].ID })
+	}
+	for _, b := range beans {
+		if _, ok := mapping.Items[b.ID]; ok || !c.syncsToRemote(b) {
+			continue
+		}
+		change := SyncChange{Action: SyncCreatedItem, BeanID: b.ID, Title: b.Title}
+		if !opts.DryRun {
+			item, err := remote.CreateItem(ctx, b)
+			if err != nil {
+				return changes, fmt.Errorf("creating item for %s: %w", b.ID, err)
+			}
+			mapping.Items[b.ID] = syncedItem(b, *item, sprints)
+			change.RemoteID = item.ID
+		}
+		changes = append(changes, change)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].BeanID < changes[j].BeanID })
+	return changes, nil
+}
+
+// syncsToRemote reports whether a bean that isn't linked yet gets an item.
+func (c *Core) syncsToRemote(b *bean.Bean) bool {
+	if b.Draft || IsLocalPath(b.Path) || c.IsArchived(b.ID) {
+		return false
+	}
+	return c.config == nil || !c.config.IsArchiveStatus(b.Status)
+}
+
+// matchesRemote reports whether the bean's synced fields match the item's.
+func matchesRemote(b *bean.Bean, item RemoteItem, sprints bool) bool {
+	return b.Title == item.Title &&
+		strings.TrimSpace(b.Body) == strings.TrimSpace(item.Body) &&
+		(len(item.Statuses) == 0 || slices.Contains(item.Statuses, b.Status)) &&
+		(len(item.Priorities) == 0 || slices.Contains(item.Priorities, b.Priority)) &&
+		(!sprints || BeanSprint(b) == item.Sprint)
+}
+
+// applyRemoteItem copies the item's synced fields to the bean. Its status
+// and priority only change if the item's don't stand for them already.
+func applyRemoteItem(b *bean.Bean, item RemoteItem, sprints bool) {
+	b.Title = item.Title
+	b.Body = item.Body
+	if len(item.Statuses) > 0 && !slices.Contains(item.Statuses, b.Status) {
+		b.Status = item.Statuses[0]
+	}
+	if len(item.Priorities) > 0 && !slices.Contains(item.Priorities, b.Priority) {
+		b.Priority = item.Priorities[0]
+	}
+	if sprints && BeanSprint(b) != item.Sprint {
+		prefix := SprintTagNamespace + bean.TagNamespaceSeparator
+		b.Tags = slices.DeleteFunc(b.Tags, func(tag string) bool { return strings.HasPrefix(tag, prefix) })
+		if item.Sprint != "" {
+			b.Tags = append(b.Tags, prefix+item.Sprint)
+		}
+	}
+}
+
+// syncedItem records what a bean and its item, now in sync, have in common.
+func syncedItem(b *bean.Bean, item RemoteItem, sprints bool) SyncedItem {
+	synced := SyncedItem{RemoteID: item.ID, Title: b.Title, Status: b.Status, Priority: b.Priority, BodyHash: bodyHash(b.Body)}
+	if sprints {
+		synced.Sprint = BeanSprint(b)
+	}
+	return synced
+}
+
+// bodyHash hashes a body for SyncedItem, ignoring surrounding whitespace
+// that trackers tend to add or strip.
+func bodyHash(body string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(body)))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,320 @@
+package beancore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// fakeTracker is an in-memory RemoteTracker. Statuses map one to one, and
+// with sprints set, so do priorities and sprints.
+type fakeTracker struct {
+	items   map[string]*RemoteItem
+	next    int
+	now     time.Time
+	sprints bool
+}
+
+func newFakeTracker() *fakeTracker {
+	return &fakeTracker{items: map[string]*RemoteItem{}, now: time.Now().Add(time.Hour)}
+}
+
+func (f *fakeTracker) tick() time.Time {
+	f.now = f.now.Add(time.Second)
+	return f.now
+}
+
+func (f *fakeTracker) add(title, status string) *RemoteItem {
+	f.next++
+	item := &RemoteItem{ID: fmt.Sprintf("item%d", f.next), Title: title, Statuses: []string{status}, UpdatedAt: f.tick()}
+	f.items[item.ID] = item
+	return item
+}
+
+func (f *fakeTracker) Items(ctx context.Context) ([]RemoteItem, error) {
+	var items []RemoteItem
+	for i := 1; i <= f.next; i++ {
+		if item, ok := f.items[fmt.Sprintf("item%d", i)]; ok {
+			items = append(items, *item)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeTracker) CreateItem(ctx context.Context, b *bean.Bean) (*RemoteItem, error) {
+	item := f.add(b.Title, b.Status)
+	return f.UpdateItem(ctx, item.ID, b)
+}
+
+func (f *fakeTracker) UpdateItem(ctx context.Context, id string, b *bean.Bean) (*RemoteItem, error) {
+	item := f.items[id]
+	item.Title, item.Body, item.Statuses, item.UpdatedAt = b.Title, b.Body, []string{b.Status}, f.tick()
+	if f.sprints {
+		item.Priorities, item.Sprint = []string{b.Priority}, BeanSprint(b)
+	}
+	return item, nil
+}
+
+func (f *fakeTracker) HasSprints() bool {
+	return f.sprints
+}
+
+func TestSyncRemote(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	ctx := context.Background()
+	remote := newFakeTracker()
+
+	createTestBean(t, core, "b1", "Local task", "todo")
+	createTestBean(t, core, "b2", "Finished", "completed")
+	draft := &bean.Bean{ID: "b3", Slug: "wip", Title: "WIP", Status: "todo", Draft: true}
+	if err := core.Create(draft); err != nil {
+		t.Fatal(err)
+	}
+	card := remote.add("Board task", "in-progress")
+
+	// A dry run changes nothing
+	preview, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{DryRun: true})
+	if err != nil || len(preview) != 2 {
+		t.Fatalf("dry run = %+v, %v; want 2 changes", preview, err)
+	}
+	if len(remote.items) != 1 || len(core.All()) != 3 {
+		t.Fatal("dry run changed something")
+	}
+
+	// The first sync links new beans and items both ways
+	changes, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil {
+		t.Fatalf("SyncRemote() error = %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("changes = %+v, want 2", changes)
+	}
+	if len(remote.items) != 2 {
+		t.Errorf("remote has %d items, want 2 (the completed bean and draft stay local)", len(remote.items))
+	}
+	var pulled *bean.Bean
+	for _, c := range changes {
+		if c.Action == SyncCreatedBean {
+			pulled, _ = core.Get(c.BeanID)
+		}
+	}
+	if pulled == nil || pulled.Title != "Board task" || pulled.Status != "in-progress" {
+		t.Fatalf("bean for card = %+v", pulled)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, SyncDirName, "fake.json")); err != nil {
+		t.Fatalf("mapping not saved: %v", err)
+	}
+
+	// Nothing changed, nothing to do
+	if changes, _ := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); len(changes) != 0 {
+		t.Errorf("second sync = %+v, want no changes", changes)
+	}
+
+	// Changes flow from the side that changed
+	card.Title, card.Statuses, card.UpdatedAt = "Board task, renamed", []string{"completed"}, remote.tick()
+	b1, _ := core.Get("b1")
+	b1.Title = "Local task, renamed"
+	if err := core.Update(b1, nil); err != nil {
+		t.Fatal(err)
+	}
+	changes, err = core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil || len(changes) != 2 {
+		t.Fatalf("sync after changes = %+v, %v", changes, err)
+	}
+	if pulled.Title != "Board task, renamed" || pulled.Status != "completed" {
+		t.Errorf("pulled bean = %q (%s)", pulled.Title, pulled.Status)
+	}
+	entry := mustMapping(t, core).Items["b1"]
+	if item := remote.items[entry.RemoteID]; item.Title != "Local task, renamed" {
+		t.Errorf("pushed item title = %q", item.Title)
+	}
+
+	// Conflicts go to the resolver
+	b1.Title = "Bean side"
+	if err := core.Update(b1, nil); err != nil {
+		t.Fatal(err)
+	}
+	item := remote.items[entry.RemoteID]
+	item.Title, item.UpdatedAt = "Card side", remote.tick()
+	var asked bool
+	changes, err = core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{Resolve: func(b *bean.Bean, item RemoteItem) (bool, error) {
+		asked = true
+		return false, nil
+	}})
+	if err != nil || len(changes) != 1 || !changes[0].Conflict || changes[0].Action != SyncPushed {
+		t.Fatalf("conflict sync = %+v, %v", changes, err)
+	}
+	if !asked || item.Title != "Bean side" {
+		t.Errorf("resolver asked = %v, item title = %q", asked, item.Title)
+	}
+
+	// Deleting either side unlinks
+	delete(remote.items, entry.RemoteID)
+	changes, _ = core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if len(changes) != 1 || changes[0].Action != SyncUnlinkedItem {
+		t.Fatalf("sync after deleting card = %+v", changes)
+	}
+	if _, err := core.Get("b1"); err != nil {
+		t.Error("bean deleted along with its card")
+	}
+	if entry := mustMapping(t, core).Items["b1"]; entry.RemoteID != "" {
+		t.Error("b1 still linked")
+	}
+	if changes, _ := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); len(changes) != 0 {
+		t.Errorf("sync after unlinking = %+v, want no new card", changes)
+	}
+}
+
+func TestSyncRemoteDeletedBean(t *testing.T) {
+	core, _ := setupTestCore(t)
+	ctx := context.Background()
+	remote := newFakeTracker()
+	createTestBean(t, core, "b1", "Task", "todo")
+	if _, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := core.Delete("b1"); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil || len(changes) != 1 || changes[0].Action != SyncUnlinkedBean {
+		t.Fatalf("sync after deleting bean = %+v, %v", changes, err)
+	}
+	changes, err = core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil || len(changes) != 0 {
+		t.Fatalf("second sync = %+v, %v; want no changes", changes, err)
+	}
+	if beans := core.All(); len(beans) != 0 {
+		t.Errorf("deleted bean came back: %+v", beans)
+	}
+	if len(remote.items) != 1 {
+		t.Errorf("remote has %d items, want the item left alone", len(remote.items))
+	}
+
+	// The tombstone goes once the item is deleted too
+	remote.items = map[string]*RemoteItem{}
+	if _, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if items := mustMapping(t, core).Items; len(items) != 0 {
+		t.Errorf("mapping = %+v, want empty", items)
+	}
+}
+
+func TestSyncRemoteNewestWins(t *testing.T) {
+	core, _ := setupTestCore(t)
+	ctx := context.Background()
+	remote := newFakeTracker()
+	b := createTestBean(t, core, "b1", "Task", "todo")
+	if _, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The card changed after the bean (the fake's clock runs ahead)
+	b.Title = "Bean side"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatal(err)
+	}
+	item := remote.items["item1"]
+	item.Title, item.UpdatedAt = "Card side", remote.tick()
+
+	changes, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil || len(changes) != 1 || changes[0].Action != SyncPulled || !changes[0].Conflict {
+		t.Fatalf("changes = %+v, %v", changes, err)
+	}
+	if b.Title != "Card side" {
+		t.Errorf("Title = %q, want the card's", b.Title)
+	}
+}
+
+func TestSyncRemoteSprintsAndPriorities(t *testing.T) {
+	core, _ := setupTestCore(t)
+	ctx := context.Background()
+	remote := newFakeTracker()
+	remote.sprints = true
+
+	b := createTestBean(t, core, "b1", "Task", "todo")
+	b.Tags = []string{"backend", "sprint/cycle-1"}
+	if err := core.Update(b, nil); err != nil {
+		t.Fatal(err)
+	}
+	card := remote.add("Issue", "todo")
+	card.Priorities, card.Sprint = []string{"high"}, "cycle-2"
+
+	if _, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if item := remote.items["item2"]; item.Sprint != "cycle-1" || item.Priorities[0] != b.Priority {
+		t.Errorf("pushed item = %+v", item)
+	}
+	var pulled *bean.Bean
+	for _, other := range core.All() {
+		if other.Title == "Issue" {
+			pulled = other
+		}
+	}
+	if pulled == nil || pulled.Priority != "high" || !slices.Equal(pulled.Tags, []string{"sprint/cycle-2"}) {
+		t.Fatalf("pulled bean = %+v", pulled)
+	}
+
+	// Moving an item to another sprint retags its bean, keeping other tags
+	item := remote.items["item2"]
+	item.Sprint, item.Priorities, item.UpdatedAt = "", []string{"low"}, remote.tick()
+	changes, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil || len(changes) != 1 || changes[0].Action != SyncPulled {
+		t.Fatalf("changes = %+v, %v", changes, err)
+	}
+	if b.Priority != "low" || !slices.Equal(b.Tags, []string{"backend"}) {
+		t.Errorf("bean = %s %v", b.Priority, b.Tags)
+	}
+}
+
+func TestSyncRemotePullOnly(t *testing.T) {
+	core, _ := setupTestCore(t)
+	ctx := context.Background()
+	remote := newFakeTracker()
+	b := createTestBean(t, core, "b1", "Task", "todo")
+	if _, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	createTestBean(t, core, "b2", "Not pushed", "todo")
+	b.Title = "Bean side"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatal(err)
+	}
+	remote.add("Pulled in", "todo")
+
+	changes, err := core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{PullOnly: true})
+	if err != nil || len(changes) != 1 || changes[0].Action != SyncCreatedBean {
+		t.Fatalf("changes = %+v, %v", changes, err)
+	}
+	if len(remote.items) != 2 || remote.items["item1"].Title != "Task" {
+		t.Errorf("pull-only sync changed the tracker: %+v", remote.items)
+	}
+
+	// The bean's change is pushed by the next full sync
+	changes, err = core.SyncRemote(ctx, "fake", remote, RemoteSyncOptions{})
+	if err != nil || len(changes) != 2 {
+		t.Fatalf("full sync = %+v, %v", changes, err)
+	}
+	if remote.items["item1"].Title != "Bean side" {
+		t.Errorf("item title = %q", remote.items["item1"].Title)
+	}
+}
+
+func mustMapping(t *testing.T, core *Core) *SyncMapping {
+	t.Helper()
+	m, err := core.LoadSyncMapping("fake")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
@@ -0,0 +1,112 @@
+package beancore
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// RequiredFieldsError is returned when a bean lacks fields or body sections
+// required for its type by the project's required fields policy.
+type RequiredFieldsError struct {
+	Type    string
+	Missing []string
+}
+
+func (e *RequiredFieldsError) Error() string {
+	return fmt.Sprintf("%s beans require %s", e.Type, strings.Join(e.Missing, ", "))
+}
+
+// RequiredViolation describes a bean that doesn't satisfy the required
+// fields policy for its type.
+type RequiredViolation struct {
+	BeanID  string   `json:"bean_id"`
+	Type    string   `json:"type"`
+	Missing []string `json:"missing"`
+}
+
+// MissingRequired returns the required fields (by name) and body sections
+// (as `section "Name"`) that b lacks for its type.
+func (c *Core) MissingRequired(b *bean.Bean) []string {
+	if c.config == nil {
+		return nil
+	}
+	req := c.config.RequiredFor(b.Type)
+
+	var missing []string
+	for _, field := range req.Fields {
+		if !hasField(b, field) {
+			missing = append(missing, field)
+		}
+	}
+	for _, section := range req.Sections {
+		if !b.HasSection(section) {
+			missing = append(missing, fmt.Sprintf("section %q", section))
+		}
+	}
+	return missing
+}
+
+// checkRequired returns a RequiredFieldsError if b is missing anything its
+// type requires that old (the previous version, nil on create) wasn't
+// already missing. Beans that predate a policy can still be updated.
+func (c *Core) checkRequired(b, old *bean.Bean) error {
+	missing := c.MissingRequired(b)
+	if old != nil && old.Type == b.Type {
+		before := c.MissingRequired(old)
+		missing = slices.DeleteFunc(missing, func(m string) bool {
+			return slices.Contains(before, m)
+		})
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return &RequiredFieldsError{Type: b.Type, Missing: missing}
+}
+
+// CheckRequired returns all beans that don't satisfy the required fields
+// policy, sorted by ID.
+func (c *Core) CheckRequired() []RequiredViolation {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var violations []RequiredViolation
+	for _, b := range c.beans {
+		if missing := c.MissingRequired(b); len(missing) > 0 {
+			violations = append(violations, RequiredViolation{BeanID: b.ID, Type: b.Type, Missing: missing})
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool { return violations[i].BeanID < violations[j].BeanID })
+	return violations
+}
+
+// hasField reports whether a requirable field is set on b.
+func hasField(b *bean.Bean, field string) bool {
+	switch field {
+	case "status":
+		return b.Status != ""
+	case "priority":
+		return b.Priority != ""
+	case "points":
+		return b.Points != nil
+	case "tags":
+		return len(b.Tags) > 0
+	case "scope":
+		return b.Scope != ""
+	case "parent":
+		return b.Parent != ""
+	case "blocking":
+		return len(b.Blocking) > 0
+	case "blocked_by":
+		return len(b.BlockedBy) > 0
+	case "links":
+		return len(b.Links) > 0
+	case "body":
+		return strings.TrimSpace(b.Body) != ""
+	}
+	// Unknown fields are reported by config validation
+	return true
+}
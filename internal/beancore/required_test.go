@@ -0,0 +1,95 @@
+package beancore
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func setupRequiredCore(t *testing.T) (*Core, string) {
+	t.Helper()
+	core, beansDir := setupTestCore(t)
+	core.config.Beans.Required = map[string]config.RequiredConfig{
+		"bug": {Fields: []string{"priority"}, Sections: []string{"Repro Steps"}},
+	}
+	return core, beansDir
+}
+
+func TestCreateEnforcesRequired(t *testing.T) {
+	core, _ := setupRequiredCore(t)
+
+	err := core.Create(&bean.Bean{ID: "bug1", Slug: "bug", Title: "Bug", Status: "todo", Type: "bug"})
+	var reqErr *RequiredFieldsError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("Create() error = %v, want RequiredFieldsError", err)
+	}
+	want := []string{"priority", `section "Repro Steps"`}
+	if !reflect.DeepEqual(reqErr.Missing, want) {
+		t.Errorf("Missing = %v, want %v", reqErr.Missing, want)
+	}
+	if _, err := core.Get("bug1"); err == nil {
+		t.Error("bean should not have been created")
+	}
+
+	ok := &bean.Bean{ID: "bug2", Slug: "bug", Title: "Bug", Status: "todo", Type: "bug", Priority: "high", Body: "## Repro steps\n\n1. Run it"}
+	if err := core.Create(ok); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Other types are unaffected
+	if err := core.Create(&bean.Bean{ID: "task1", Slug: "task", Title: "Task", Status: "todo", Type: "task"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+}
+
+func TestUpdateEnforcesRequired(t *testing.T) {
+	core, _ := setupRequiredCore(t)
+	b := createTestBean(t, core, "aaa1", "Task", "todo")
+
+	// Changing the type requires the new type's fields
+	b.Type = "bug"
+	b.Priority = "high"
+	err := core.Update(b, nil)
+	var reqErr *RequiredFieldsError
+	if !errors.As(err, &reqErr) || !reflect.DeepEqual(reqErr.Missing, []string{`section "Repro Steps"`}) {
+		t.Fatalf("Update() error = %v, want missing section", err)
+	}
+
+	b.Body = "## Repro Steps\n\nRun it"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// Removing a required section is rejected
+	b.Body = "Gone"
+	if err := core.Update(b, nil); !errors.As(err, &reqErr) {
+		t.Errorf("Update() error = %v, want RequiredFieldsError", err)
+	}
+}
+
+func TestUpdateAllowsPreexistingViolations(t *testing.T) {
+	core, _ := setupTestCore(t)
+	b := &bean.Bean{ID: "bug1", Slug: "bug", Title: "Bug", Status: "todo", Type: "bug", Priority: "high"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// Policy introduced after the bean was created
+	core.config.Beans.Required = map[string]config.RequiredConfig{
+		"bug": {Sections: []string{"Repro Steps"}},
+	}
+
+	b.Status = "in-progress"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v, want pre-existing violation to be allowed", err)
+	}
+
+	got := core.CheckRequired()
+	want := []RequiredViolation{{BeanID: "bug1", Type: "bug", Missing: []string{`section "Repro Steps"`}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CheckRequired() = %v, want %v", got, want)
+	}
+}
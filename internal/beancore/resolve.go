@@ -0,0 +1,121 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// AmbiguousIDError is returned when an alias names more than one bean, or
+// prefix_matching is enabled and a partial ID matches more than one bean.
+type AmbiguousIDError struct {
+	ID         string
+	Candidates []string // the matching IDs, sorted
+}
+
+func (e *AmbiguousIDError) Error() string {
+	return fmt.Sprintf("ambiguous ID %q matches %d beans: %s", e.ID, len(e.Candidates), strings.Join(e.Candidates, ", "))
+}
+
+// MatchIDs returns the IDs of the beans id may refer to, sorted: just the
+// bean's own if id is a whole ID (with or without the configured prefix),
+// the beans with id as an alias, or else every ID starting with id, or with
+// id after the configured prefix.
+// Unlike Get, it matches prefixes whether or not prefix_matching is enabled.
+func (c *Core) MatchIDs(id string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	_, fullID, err := c.findBeanLocked(id)
+	if err == nil {
+		return []string{fullID}
+	}
+	var ambiguous *AmbiguousIDError
+	if errors.As(err, &ambiguous) {
+		return ambiguous.Candidates
+	}
+	return c.matchIDsLocked(id)
+}
+
+// matchIDsLocked returns the sorted IDs starting with id, or with id after
+// the configured prefix. Must be called with the lock held.
+func (c *Core) matchIDsLocked(id string) []string {
+	ids := []string{}
+	if id == "" {
+		return ids
+	}
+	fullID := c.normalizeID(id)
+	for candidate := range c.beans {
+		if strings.HasPrefix(candidate, id) || strings.HasPrefix(candidate, fullID) {
+			ids = append(ids, candidate)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// checkAliases rejects aliases that b newly has (compared to old, nil for a
+// new bean) and that already name another bean, as its ID or alias.
+// Must be called with the lock held.
+func (c *Core) checkAliases(b, old *bean.Bean) error {
+	for _, alias := range b.Aliases {
+		if old != nil && slices.Contains(old.Aliases, alias) {
+			continue
+		}
+		if strings.TrimSpace(alias) == "" || strings.ContainsFunc(alias, unicode.IsSpace) {
+			return fmt.Errorf("invalid alias %q: aliases can't be empty or contain spaces", alias)
+		}
+		if id, ok := c.resolveIDLocked(alias); ok && id != b.ID {
+			return fmt.Errorf("alias %q already names %s", alias, id)
+		}
+		for _, id := range c.aliasIDsLocked(alias) {
+			if id != b.ID {
+				return fmt.Errorf("alias %q already names %s", alias, id)
+			}
+		}
+	}
+	return nil
+}
+
+// AliasConflict is an alias that several beans share, or that is another
+// bean's ID (which wins), so it doesn't name the bean that has it.
+type AliasConflict struct {
+	Alias   string   `json:"alias"`
+	BeanIDs []string `json:"bean_ids"`     // the beans with the alias
+	ID      string   `json:"id,omitempty"` // the bean whose ID the alias is, if any
+}
+
+// CheckAliases returns the aliases that don't name exactly one bean, in
+// order of the first bean (by ID) that has them.
+func (c *Core) CheckAliases() []AliasConflict {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ids := make([]string, 0, len(c.beans))
+	for id := range c.beans {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var conflicts []AliasConflict
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		for _, alias := range c.beans[id].AllAliases() {
+			key := strings.ToLower(alias)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			holders := c.aliasIDsLocked(alias)
+			named, _ := c.resolveIDLocked(alias)
+			if len(holders) > 1 || (named != "" && named != holders[0]) {
+				conflicts = append(conflicts, AliasConflict{Alias: alias, BeanIDs: slices.Clone(holders), ID: named})
+			}
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,163 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestMatchIDs(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), BeansDir)
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create test .beans dir: %v", err)
+	}
+	cfg := config.DefaultWithPrefix("beans-")
+	core := New(beansDir, cfg)
+	core.SetLogger(nil)
+	if err := core.Load(); err != nil {
+		t.Fatalf("failed to load core: %v", err)
+	}
+	createTestBean(t, core, "beans-abc1", "First", "todo")
+	createTestBean(t, core, "beans-abd2", "Second", "todo")
+	createTestBean(t, core, "beans-ab", "Third", "todo")
+
+	tests := []struct {
+		id   string
+		want []string
+	}{
+		{"beans-abc1", []string{"beans-abc1"}},
+		{"abc", []string{"beans-abc1"}},
+		{"beans-abd", []string{"beans-abd2"}},
+		{"ab", []string{"beans-ab"}}, // a whole short ID wins over prefixes
+		{"a", []string{"beans-ab", "beans-abc1", "beans-abd2"}},
+		{"xyz", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		if got := core.MatchIDs(tt.id); !slices.Equal(got, tt.want) {
+			t.Errorf("MatchIDs(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+
+	// Get only matches prefixes with prefix_matching, and only unique ones
+	if _, err := core.Get("abc"); err != ErrNotFound {
+		t.Errorf("Get(abc) without prefix_matching: err = %v, want ErrNotFound", err)
+	}
+	cfg.Beans.PrefixMatching = true
+	if b, err := core.Get("abc"); err != nil || b.ID != "beans-abc1" {
+		t.Errorf("Get(abc) = %v, %v; want beans-abc1", b, err)
+	}
+	_, err := core.Get("a")
+	var ambiguous *AmbiguousIDError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("Get(a) with several matches: err = %v, want AmbiguousIDError", err)
+	}
+	if want := []string{"beans-ab", "beans-abc1", "beans-abd2"}; ambiguous.ID != "a" || !slices.Equal(ambiguous.Candidates, want) {
+		t.Errorf("AmbiguousIDError = %+v, want ID a and candidates %v", ambiguous, want)
+	}
+	if _, err := core.Get("xyz"); err != ErrNotFound {
+		t.Errorf("Get(xyz): err = %v, want ErrNotFound", err)
+	}
+
+	// Archive and Delete resolve unique prefixes too
+	if err := core.Archive("abd"); err != nil {
+		t.Fatalf("Archive(abd): %v", err)
+	}
+	if !core.IsArchived("beans-abd2") {
+		t.Error("beans-abd2 should be archived")
+	}
+	if err := core.Delete("a"); !errors.As(err, &ambiguous) {
+		t.Errorf("Delete(a): err = %v, want AmbiguousIDError", err)
+	}
+	if err := core.Delete("abc"); err != nil {
+		t.Fatalf("Delete(abc): %v", err)
+	}
+	if _, err := core.Get("beans-abc1"); err != ErrNotFound {
+		t.Errorf("beans-abc1 should be deleted, Get err = %v", err)
+	}
+}
+
+func TestAliases(t *testing.T) {
+	core, _ := setupTestCore(t)
+	jira := &bean.Bean{ID: "bean-1", Title: "Imported", Status: "todo", Aliases: []string{"JIRA-123", "GH#456"}}
+	if err := core.Create(jira); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	other := createTestBean(t, core, "bean-2", "Other", "todo")
+
+	// Aliases resolve like IDs, ignoring case
+	for _, alias := range []string{"JIRA-123", "jira-123", "GH#456"} {
+		if b, err := core.Get(alias); err != nil || b.ID != "bean-1" {
+			t.Errorf("Get(%s) = %v, %v; want bean-1", alias, b, err)
+		}
+	}
+	if id, ok := core.NormalizeID("JIRA-123"); !ok || id != "bean-1" {
+		t.Errorf("NormalizeID(JIRA-123) = %s, %v; want bean-1", id, ok)
+	}
+	if got := core.MatchIDs("gh#456"); !slices.Equal(got, []string{"bean-1"}) {
+		t.Errorf("MatchIDs(gh#456) = %v, want [bean-1]", got)
+	}
+
+	// An alias can't name another bean
+	other.Aliases = []string{"jira-123"}
+	if err := core.Update(other, nil); err == nil {
+		t.Error("Update with another bean's alias should fail")
+	}
+	other.Aliases = []string{"bean-1"}
+	if err := core.Update(other, nil); err == nil {
+		t.Error("Update with another bean's ID as alias should fail")
+	}
+	other.Aliases = []string{"JIRA 124"}
+	if err := core.Update(other, nil); err == nil {
+		t.Error("Update with an alias containing a space should fail")
+	}
+	other.Aliases = []string{"JIRA-124"}
+	if err := core.Update(other, nil); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if b, err := core.Get("JIRA-124"); err != nil || b.ID != "bean-2" {
+		t.Errorf("Get(JIRA-124) = %v, %v; want bean-2", b, err)
+	}
+	if conflicts := core.CheckAliases(); len(conflicts) != 0 {
+		t.Errorf("CheckAliases() = %v, want none", conflicts)
+	}
+
+	// Aliases shared in the files themselves are ambiguous
+	other.Aliases = []string{"JIRA-123", "bean-1"}
+	core.markChanged()
+	_, err := core.Get("JIRA-123")
+	var ambiguous *AmbiguousIDError
+	if !errors.As(err, &ambiguous) || !slices.Equal(ambiguous.Candidates, []string{"bean-1", "bean-2"}) {
+		t.Errorf("Get(JIRA-123) with a shared alias: err = %v, want AmbiguousIDError for bean-1, bean-2", err)
+	}
+	want := []AliasConflict{
+		{Alias: "JIRA-123", BeanIDs: []string{"bean-1", "bean-2"}},
+		{Alias: "bean-1", BeanIDs: []string{"bean-2"}, ID: "bean-1"},
+	}
+	if got := core.CheckAliases(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CheckAliases() = %+v, want %+v", got, want)
+	}
+}
+
+func TestExternalIDsResolve(t *testing.T) {
+	core, _ := setupTestCore(t)
+	imported := &bean.Bean{ID: "bean-1", Title: "Imported", Status: "todo",
+		ExternalIDs: map[string]string{"taskwarrior": "5e6f7a8b-0000-4000-8000-000000000001"}}
+	if err := core.Create(imported); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	// An imported bean is found by its ID in the tracker it came from
+	if b, err := core.Get("5e6f7a8b-0000-4000-8000-000000000001"); err != nil || b.ID != "bean-1" {
+		t.Errorf("Get(external ID) = %v, %v; want bean-1", b, err)
+	}
+	if results, err := core.Search("5e6f7a8b-0000-4000-8000-000000000001"); err != nil || len(results) != 1 {
+		t.Errorf("Search(external ID) = %v, %v; want bean-1", results, err)
+	}
+}
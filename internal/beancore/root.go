@@ -0,0 +1,38 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+// ErrNoRoot is returned by FindRoot when no beans project is found.
+var ErrNoRoot = errors.New("no .beans directory found")
+
+// FindRoot locates the project directory for startDir the way git locates a
+// repository: it walks up from startDir to the nearest directory containing a
+// .beans directory or a config file (which may point at a differently named
+// beans directory). It returns ErrNoRoot if it reaches the filesystem root.
+func FindRoot(startDir string) (string, error) {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, config.ConfigFileName)); err == nil {
+			return dir, nil
+		}
+		if info, err := os.Stat(filepath.Join(dir, BeansDir)); err == nil && info.IsDir() {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ErrNoRoot
+		}
+		dir = parent
+	}
+}
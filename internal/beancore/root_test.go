@@ -0,0 +1,50 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestFindRoot(t *testing.T) {
+	tmp := t.TempDir()
+	project := filepath.Join(tmp, "project")
+	nested := filepath.Join(project, "src", "pkg")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := FindRoot(nested); !errors.Is(err, ErrNoRoot) {
+		t.Errorf("FindRoot() without a project error = %v, want ErrNoRoot", err)
+	}
+
+	// A .beans directory marks the project
+	if err := Init(project); err != nil {
+		t.Fatal(err)
+	}
+	for _, start := range []string{project, nested} {
+		if got, err := FindRoot(start); err != nil || got != project {
+			t.Errorf("FindRoot(%s) = %q, %v; want %q", start, got, err, project)
+		}
+	}
+
+	// So does a config file, and the nearest project wins
+	sub := filepath.Join(project, "src")
+	if err := os.WriteFile(filepath.Join(sub, config.ConfigFileName), []byte("beans:\n  path: issues\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := FindRoot(nested); err != nil || got != sub {
+		t.Errorf("FindRoot() = %q, %v; want %q", got, err, sub)
+	}
+
+	// A file named .beans doesn't count
+	other := filepath.Join(tmp, "other")
+	os.MkdirAll(other, 0755)
+	os.WriteFile(filepath.Join(other, BeansDir), nil, 0644)
+	if _, err := FindRoot(other); !errors.Is(err, ErrNoRoot) {
+		t.Errorf("FindRoot() with a .beans file error = %v, want ErrNoRoot", err)
+	}
+}
@@ -0,0 +1,147 @@
+package beancore
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// InferScope infers a bean's scope from the files changed on its git branch:
+// the configured scope (scopes in .beans.yml) containing most of them. Ties
+// go to the most specific, then the alphabetically first scope. Returns ""
+// if none of the changed files is in a configured scope. Once the branch is
+// deleted, the changes of its recorded merge commit are used instead.
+func (c *Core) InferScope(b *bean.Bean) (string, error) {
+	if c.config == nil || len(c.config.Beans.Scopes) == 0 {
+		return "", fmt.Errorf("no scopes configured (add scopes to .beans.yml)")
+	}
+	if !c.IsGitFlowEnabled() {
+		return "", fmt.Errorf("git integration is not enabled")
+	}
+	if b.GitBranch == "" && b.GitMergeCommit == "" {
+		return "", fmt.Errorf("bean %s has no git branch", b.ID)
+	}
+	files, err := c.branchFiles(b, c.getBaseBranch())
+	if err != nil {
+		return "", err
+	}
+	return inferScope(c.config.Beans.Scopes, files), nil
+}
+
+// branchFiles returns the files changed on a bean's branch, falling back to
+// the changes of its recorded merge commit once the branch is deleted. Bean
+// files are left out.
+func (c *Core) branchFiles(b *bean.Bean, baseBranch string) ([]string, error) {
+	var files []string
+	var err error
+	if b.GitBranch != "" {
+		var exists bool
+		if exists, err = c.gitFlow.BranchExists(b.GitBranch); err != nil {
+			return nil, err
+		}
+		if exists {
+			files, err = c.gitFlow.ChangedFiles(b.GitBranch, baseBranch)
+		}
+	}
+	if files == nil && err == nil && b.GitMergeCommit != "" {
+		files, err = c.gitFlow.ChangedFiles(b.GitMergeCommit, b.GitMergeCommit+"^1")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	beansDir, err := c.beansDirInRepo()
+	if err != nil {
+		return nil, err
+	}
+	code := files[:0]
+	for _, f := range files {
+		if !bean.InScope(f, beansDir) {
+			code = append(code, f)
+		}
+	}
+	return code, nil
+}
+
+// beansDirInRepo returns the beans directory relative to the repository root,
+// or "" if it's outside the repository.
+func (c *Core) beansDirInRepo() (string, error) {
+	root, err := filepath.Abs(c.gitFlow.RepoPath())
+	if err != nil {
+		return "", err
+	}
+	dir, err := filepath.Abs(c.root)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", nil
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// inferScope returns the scope containing most of the given files.
+func inferScope(scopes, files []string) string {
+	counts := make(map[string]int)
+	for _, f := range files {
+		if s := scopeForPath(scopes, f); s != "" {
+			counts[s]++
+		}
+	}
+	candidates := make([]string, 0, len(counts))
+	for s := range counts {
+		candidates = append(candidates, s)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return a < b
+	})
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[0]
+}
+
+// scopeForPath returns the scope a file (relative to the repository root)
+// belongs to, or "" if it is in none. If several scopes contain the file,
+// the most specific one wins. An entry ending in /* yields the directory
+// below it the file is in, e.g. "packages/*" puts packages/api/main.go in
+// scope "packages/api".
+func scopeForPath(scopes []string, file string) string {
+	file = bean.CleanScope(file)
+	best := ""
+	for _, entry := range scopes {
+		scope := bean.CleanScope(entry)
+		if parent, ok := strings.CutSuffix("/"+scope, "/*"); ok {
+			parent = strings.TrimPrefix(parent, "/")
+			rest := file
+			if parent != "" {
+				if rest, ok = strings.CutPrefix(file, parent+"/"); !ok {
+					continue
+				}
+			}
+			dir, _, isDir := strings.Cut(rest, "/")
+			if !isDir {
+				continue
+			}
+			scope = path.Join(parent, dir)
+		} else if !bean.InScope(file, scope) {
+			continue
+		}
+		if len(scope) > len(best) {
+			best = scope
+		}
+	}
+	return best
+}
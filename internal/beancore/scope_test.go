@@ -0,0 +1,148 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestScopeForPath(t *testing.T) {
+	scopes := []string{"packages/*", "packages/api/internal", "docs", "./tools/"}
+	tests := []struct {
+		file string
+		want string
+	}{
+		{"packages/api/main.go", "packages/api"},
+		{"packages/web/src/app.ts", "packages/web"},
+		{"packages/api/internal/db.go", "packages/api/internal"},
+		{"packages/README.md", ""},
+		{"docs/guide.md", "docs"},
+		{"docs", "docs"},
+		{"docs-old/guide.md", ""},
+		{"tools/gen/main.go", "tools"},
+		{"README.md", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			if got := scopeForPath(scopes, tt.file); got != tt.want {
+				t.Errorf("scopeForPath(%q) = %q, want %q", tt.file, got, tt.want)
+			}
+		})
+	}
+
+	// A bare * makes every top-level directory a scope
+	if got := scopeForPath([]string{"*"}, "services/auth/main.go"); got != "services" {
+		t.Errorf("scopeForPath(*) = %q, want %q", got, "services")
+	}
+}
+
+func TestInferScopeFromFiles(t *testing.T) {
+	scopes := []string{"packages/*", "docs"}
+	tests := []struct {
+		name  string
+		files []string
+		want  string
+	}{
+		{"none", nil, ""},
+		{"outside all scopes", []string{"README.md", "go.mod"}, ""},
+		{"most files win", []string{"packages/api/a.go", "packages/api/b.go", "docs/x.md", "README.md"}, "packages/api"},
+		{"ties go to the most specific", []string{"packages/api/a.go", "docs/x.md"}, "packages/api"},
+		{"then alphabetical", []string{"packages/web/a.ts", "packages/api/a.go"}, "packages/api"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inferScope(scopes, tt.files); got != tt.want {
+				t.Errorf("inferScope() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitFlow_InferScope(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+
+	core.Create(&bean.Bean{ID: "beans-task1", Slug: "task", Title: "Task", Status: "todo"})
+	b, _ := core.Get("beans-task1")
+	if _, err := core.InferScope(b); err == nil {
+		t.Error("InferScope() without scopes configured should fail")
+	}
+	core.config.Beans.Scopes = []string{"packages/*", "docs"}
+	if _, err := core.InferScope(b); err == nil {
+		t.Error("InferScope() without a branch should fail")
+	}
+
+	b, err := core.BranchBean("beans-task1")
+	if err != nil {
+		t.Fatalf("BranchBean() error = %v", err)
+	}
+	for name, content := range map[string]string{
+		"packages/api/a.go": "package api\n",
+		"packages/api/b.go": "package api\n",
+		"docs/api.md":       "# API\n",
+	} {
+		os.MkdirAll(filepath.Join(repoPath, filepath.Dir(name)), 0755)
+		os.WriteFile(filepath.Join(repoPath, name), []byte(content), 0644)
+	}
+	runGit(t, repoPath, "add", ".")
+	runGit(t, repoPath, "commit", "--quiet", "-m", "api work")
+
+	scope, err := core.InferScope(b)
+	if err != nil {
+		t.Fatalf("InferScope() error = %v", err)
+	}
+	if scope != "packages/api" {
+		t.Errorf("InferScope() = %q, want %q", scope, "packages/api")
+	}
+
+	// Syncing the merged branch completes the bean and records its scope
+	runGit(t, repoPath, "checkout", "--quiet", "main")
+	runGit(t, repoPath, "merge", "--quiet", "--no-ff", "-m", "merge task", b.GitBranch)
+	if _, err := core.SyncGitBranches(); err != nil {
+		t.Fatalf("SyncGitBranches() error = %v", err)
+	}
+	synced, _ := core.Get("beans-task1")
+	if synced.Status != "completed" {
+		t.Errorf("Status = %q, want %q", synced.Status, "completed")
+	}
+	if synced.Scope != "packages/api" {
+		t.Errorf("Scope = %q, want %q", synced.Scope, "packages/api")
+	}
+
+	// Once the branch is deleted, the merge commit's changes are used
+	runGit(t, repoPath, "branch", "--quiet", "-D", b.GitBranch)
+	synced.Scope = ""
+	scope, err = core.InferScope(synced)
+	if err != nil {
+		t.Fatalf("InferScope() after branch deletion error = %v", err)
+	}
+	if scope != "packages/api" {
+		t.Errorf("InferScope() after branch deletion = %q, want %q", scope, "packages/api")
+	}
+}
+
+func TestGitFlow_SyncMergedWithoutConfig(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+
+	core.Create(&bean.Bean{ID: "beans-task1", Slug: "task", Title: "Task", Status: "todo"})
+	b, err := core.BranchBean("beans-task1")
+	if err != nil {
+		t.Fatalf("BranchBean() error = %v", err)
+	}
+	os.WriteFile(filepath.Join(repoPath, "a.go"), []byte("package a\n"), 0644)
+	runGit(t, repoPath, "add", ".")
+	runGit(t, repoPath, "commit", "--quiet", "-m", "work")
+	runGit(t, repoPath, "checkout", "--quiet", "main")
+	runGit(t, repoPath, "merge", "--quiet", "--no-ff", "-m", "merge task", b.GitBranch)
+
+	core.config = nil
+	if _, err := core.SyncGitBranches(); err != nil {
+		t.Fatalf("SyncGitBranches() error = %v", err)
+	}
+	if synced, _ := core.Get("beans-task1"); synced.Status != "completed" {
+		t.Errorf("Status = %q, want %q", synced.Status, "completed")
+	}
+}
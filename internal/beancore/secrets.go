@@ -0,0 +1,133 @@
+package beancore
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// secretPattern is a kind of credential and how to spot it.
+type secretPattern struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+// secretPatterns are the credentials scanned for (see config.SecretsConfig).
+var secretPatterns = []secretPattern{
+	{"AWS access key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"AWS secret key", regexp.MustCompile(`(?i)aws_?secret_?access_?key\W{0,3}[:=]\s*["']?[A-Za-z0-9/+=]{40}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{60,})\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"Stripe key", regexp.MustCompile(`\b[rs]k_live_[A-Za-z0-9]{20,}\b`)},
+	{"private key", regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----`)},
+	{"API key", regexp.MustCompile(`(?i)\b(?:api[_-]?key|api[_-]?secret|access[_-]?token|auth[_-]?token|client[_-]?secret|secret[_-]?key|password)\b["']?\s*[:=]\s*["']?[A-Za-z0-9_\-/+.=]{16,}`)},
+}
+
+// SecretFinding is a likely credential in a bean file. The credential itself
+// is left out, so reporting findings doesn't leak it.
+type SecretFinding struct {
+	// Kind names the kind of credential, e.g. "AWS access key".
+	Kind string `json:"kind"`
+	// Line is the line of the bean file it's on.
+	Line  int `json:"line"`
+	match string
+}
+
+// SecretsError is returned when a bean isn't written because it looks like
+// it contains credentials (secrets.mode: block).
+type SecretsError struct {
+	BeanID   string
+	Findings []SecretFinding
+}
+
+func (e *SecretsError) Error() string {
+	found := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		found[i] = fmt.Sprintf("%s on line %d", f.Kind, f.Line)
+	}
+	return fmt.Sprintf("%s looks like it contains a secret (%s); remove it, or change secrets.mode in .beans.yml", e.BeanID, strings.Join(found, ", "))
+}
+
+// ScanSecrets returns the likely credentials in a bean's file, in order.
+// Patterns from the config that don't compile are skipped (beans check
+// reports them).
+func (c *Core) ScanSecrets(b *bean.Bean) ([]SecretFinding, error) {
+	content, err := b.Render()
+	if err != nil {
+		return nil, err
+	}
+	patterns := c.compiledSecretPatterns()
+
+	var findings []SecretFinding
+	for i, line := range strings.Split(string(content), "\n") {
+		for _, p := range patterns {
+			if m := p.re.FindString(line); m != "" {
+				findings = append(findings, SecretFinding{Kind: p.kind, Line: i + 1, match: m})
+				break
+			}
+		}
+	}
+	return findings, nil
+}
+
+// compiledSecretPatterns returns the built-in secret patterns followed by the
+// configured ones, compiling the configured ones once per load.
+func (c *Core) compiledSecretPatterns() []secretPattern {
+	c.secretsMu.Lock()
+	defer c.secretsMu.Unlock()
+
+	if c.secretPatterns == nil {
+		c.secretPatterns = slices.Clip(secretPatterns)
+		if c.config != nil {
+			for _, p := range c.config.Beans.Secrets.Patterns {
+				if re, err := regexp.Compile(p); err == nil {
+					c.secretPatterns = append(c.secretPatterns, secretPattern{"secret matching " + p, re})
+				}
+			}
+		}
+	}
+	return c.secretPatterns
+}
+
+// checkSecrets scans b before it's written, per secrets.mode: findings that
+// old (the previous version, nil on create) didn't have yet are logged, or
+// refused with a SecretsError. Beans already holding a secret can still be
+// updated otherwise.
+func (c *Core) checkSecrets(b, old *bean.Bean) error {
+	if c.config == nil {
+		return nil
+	}
+	mode := c.config.Beans.Secrets.GetMode()
+	if mode != config.SecretsWarn && mode != config.SecretsBlock {
+		return nil
+	}
+
+	findings, err := c.ScanSecrets(b)
+	if err != nil || len(findings) == 0 {
+		return err
+	}
+	if old != nil {
+		before, err := c.ScanSecrets(old)
+		if err != nil {
+			return err
+		}
+		findings = slices.DeleteFunc(findings, func(f SecretFinding) bool {
+			return slices.ContainsFunc(before, func(o SecretFinding) bool { return o.match == f.match })
+		})
+		if len(findings) == 0 {
+			return nil
+		}
+	}
+
+	if mode == config.SecretsBlock {
+		return &SecretsError{BeanID: b.ID, Findings: findings}
+	}
+	for _, f := range findings {
+		c.logger.Warn("bean looks like it contains a secret", "bean", b.ID, "kind", f.Kind, "line", f.Line)
+	}
+	return nil
+}
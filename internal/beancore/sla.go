@@ -0,0 +1,84 @@
+package beancore
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// SLABreach describes a bean that has stayed in its status longer than the
+// SLA for its priority allows.
+type SLABreach struct {
+	BeanID   string `json:"id"`
+	Title    string `json:"title"`
+	Priority string `json:"priority"`
+	Status   string `json:"status"`
+	// Since is when the bean entered its current status.
+	Since time.Time `json:"since"`
+	// Deadline is when the bean should have left its status.
+	Deadline time.Time `json:"deadline"`
+	// Limit is how long the bean may stay in its status.
+	Limit time.Duration `json:"-"`
+	// Overdue is how far past the deadline the bean is.
+	Overdue time.Duration `json:"-"`
+}
+
+// SLADeadline returns when a bean must leave its current status under the
+// SLA for its priority. The second return value is false if no SLA applies.
+func (c *Core) SLADeadline(b *bean.Bean) (time.Time, bool) {
+	if c.config == nil {
+		return time.Time{}, false
+	}
+	limit, ok := c.config.SLAFor(b.Priority, b.Status)
+	if !ok {
+		return time.Time{}, false
+	}
+	since, ok := b.StatusSince()
+	if !ok {
+		return time.Time{}, false
+	}
+	return since.Add(limit), true
+}
+
+// SLABreachFor checks a bean against the configured SLAs. The second return
+// value is false if the bean has no SLA or is within it.
+func (c *Core) SLABreachFor(b *bean.Bean, now time.Time) (SLABreach, bool) {
+	deadline, ok := c.SLADeadline(b)
+	if !ok || !now.After(deadline) {
+		return SLABreach{}, false
+	}
+	since, _ := b.StatusSince()
+	priority := b.Priority
+	if priority == "" {
+		priority = "normal"
+	}
+	return SLABreach{
+		BeanID:   b.ID,
+		Title:    b.Title,
+		Priority: priority,
+		Status:   b.Status,
+		Since:    since,
+		Deadline: deadline,
+		Limit:    deadline.Sub(since),
+		Overdue:  now.Sub(deadline),
+	}, true
+}
+
+// SLABreaches returns all beans currently breaching their SLA, most overdue
+// first.
+func (c *Core) SLABreaches(now time.Time) []SLABreach {
+	var breaches []SLABreach
+	for _, b := range c.All() {
+		if breach, ok := c.SLABreachFor(b, now); ok {
+			breaches = append(breaches, breach)
+		}
+	}
+	sort.Slice(breaches, func(i, j int) bool {
+		if breaches[i].Overdue != breaches[j].Overdue {
+			return breaches[i].Overdue > breaches[j].Overdue
+		}
+		return breaches[i].BeanID < breaches[j].BeanID
+	})
+	return breaches
+}
@@ -0,0 +1,75 @@
+package beancore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestSLABreaches(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.config.Beans.SLA = map[string]map[string]string{
+		"critical": {"todo": "2d"},
+		"high":     {"todo": "1w"},
+	}
+
+	now := time.Now().UTC()
+	created := func(id, priority, status string, ago time.Duration) {
+		t.Helper()
+		at := now.Add(-ago)
+		b := &bean.Bean{ID: id, Slug: id, Title: id, Status: status, Priority: priority, CreatedAt: &at}
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create(%s) error = %v", id, err)
+		}
+		// Create records the status change at creation time; backdate it
+		b.StatusHistory = []bean.StatusChange{{Status: status, ChangedAt: at}}
+	}
+	created("crit-old", "critical", "todo", 5*24*time.Hour)
+	created("crit-new", "critical", "todo", time.Hour)
+	created("high-old", "high", "todo", 8*24*time.Hour)
+	created("crit-wip", "critical", "in-progress", 5*24*time.Hour)
+	created("norm-old", "normal", "todo", 30*24*time.Hour)
+
+	breaches := core.SLABreaches(now)
+	if len(breaches) != 2 {
+		t.Fatalf("SLABreaches() returned %d breaches, want 2: %+v", len(breaches), breaches)
+	}
+	if breaches[0].BeanID != "crit-old" || breaches[1].BeanID != "high-old" {
+		t.Errorf("SLABreaches() = %s, %s; want crit-old, high-old (most overdue first)", breaches[0].BeanID, breaches[1].BeanID)
+	}
+	if got := breaches[0].Overdue.Round(time.Hour); got != 3*24*time.Hour {
+		t.Errorf("crit-old overdue = %v, want 72h", got)
+	}
+
+	b, _ := core.Get("crit-new")
+	deadline, ok := core.SLADeadline(b)
+	if !ok || !deadline.Equal(now.Add(-time.Hour).Add(48*time.Hour)) {
+		t.Errorf("SLADeadline(crit-new) = %v, %v", deadline, ok)
+	}
+	b, _ = core.Get("norm-old")
+	if _, ok := core.SLADeadline(b); ok {
+		t.Error("SLADeadline(norm-old) should have no SLA")
+	}
+}
+
+func TestSLABreachUsesCurrentStatus(t *testing.T) {
+	core, _ := setupTestCore(t)
+	core.config.Beans.SLA = map[string]map[string]string{"critical": {"todo": "2d"}}
+
+	now := time.Now().UTC()
+	created := now.Add(-5 * 24 * time.Hour)
+	b := &bean.Bean{ID: "aaa1", Slug: "a", Title: "A", Status: "todo", Priority: "critical", CreatedAt: &created}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	// Moved back to todo an hour ago: the clock restarts
+	b.StatusHistory = []bean.StatusChange{
+		{Status: "todo", ChangedAt: created},
+		{Status: "in-progress", ChangedAt: now.Add(-4 * 24 * time.Hour)},
+		{Status: "todo", ChangedAt: now.Add(-time.Hour)},
+	}
+	if _, ok := core.SLABreachFor(b, now); ok {
+		t.Error("SLABreachFor() should measure from when the bean re-entered todo")
+	}
+}
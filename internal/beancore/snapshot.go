@@ -0,0 +1,362 @@
+package beancore
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// snapshotDirName is the directory in the local state directory holding
+// snapshots, so they're never committed.
+const snapshotDirName = "snapshots"
+
+// snapshotMetaName is the tarball entry holding a snapshot's metadata. It
+// comes first, so listing snapshots doesn't read whole archives.
+const snapshotMetaName = ".snapshot.json"
+
+// ErrSnapshotNotFound is returned when no snapshot matches an ID.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Snapshot describes a tarball of the beans directory taken by CreateSnapshot.
+type Snapshot struct {
+	// ID names the snapshot; it's the UTC time it was taken (20261015-143000).
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Message   string    `json:"message,omitempty"`
+	// Beans and Files count the beans and files (beans included) it holds.
+	Beans int `json:"beans"`
+	Files int `json:"files"`
+	// Path is where the snapshot is stored.
+	Path string `json:"path"`
+}
+
+// SnapshotDir returns the directory snapshots are stored in.
+func (c *Core) SnapshotDir() string {
+	return filepath.Join(c.StateDir(), snapshotDirName)
+}
+
+// CreateSnapshot saves everything in the beans directory except the local
+// state to a gzipped tarball, so it can be rolled back with RestoreSnapshot
+// without touching git history.
+func (c *Core) CreateSnapshot(message string) (*Snapshot, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.createSnapshotLocked(message)
+}
+
+// createSnapshotLocked does the work of CreateSnapshot; c.mu must be held.
+func (c *Core) createSnapshotLocked(message string) (*Snapshot, error) {
+	var files []string
+	fileCount := 0
+	err := filepath.WalkDir(c.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(c.root, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		if d.IsDir() {
+			if rel == StateDirName {
+				return filepath.SkipDir
+			}
+			files = append(files, rel)
+			return nil
+		}
+		if d.Type().IsRegular() {
+			files = append(files, rel)
+			fileCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading beans directory: %w", err)
+	}
+
+	dir := c.SnapshotDir()
+	if err := c.ensureStateDir(); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating snapshot directory: %w", err)
+	}
+
+	now := time.Now().UTC()
+	snap := &Snapshot{ID: now.Format("20060102-150405"), CreatedAt: now, Message: message, Beans: len(c.beans), Files: fileCount}
+	for n := 2; ; n++ {
+		snap.Path = filepath.Join(dir, snap.ID+".tar.gz")
+		if _, err := os.Stat(snap.Path); errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		snap.ID = fmt.Sprintf("%s-%d", now.Format("20060102-150405"), n)
+	}
+	if err := c.writeSnapshot(snap, files); err != nil {
+		os.Remove(snap.Path)
+		return nil, err
+	}
+	return snap, nil
+}
+
+// writeSnapshot writes the tarball for snap, holding its metadata followed by
+// files (paths relative to the beans directory).
+func (c *Core) writeSnapshot(snap *Snapshot, files []string) error {
+	f, err := os.OpenFile(snap.Path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("creating snapshot: %w", err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+
+	meta, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: snapshotMetaName, Mode: 0644, Size: int64(len(meta)), ModTime: snap.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(meta); err != nil {
+		return err
+	}
+
+	for _, rel := range files {
+		full := filepath.Join(c.root, rel)
+		info, err := os.Stat(full)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", rel, err)
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			continue
+		}
+		src, err := os.Open(full)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", rel, err)
+		}
+		_, err = io.Copy(tw, src)
+		src.Close()
+		if err != nil {
+			return fmt.Errorf("archiving %s: %w", rel, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// Snapshots lists the stored snapshots, newest first.
+func (c *Core) Snapshots() ([]*Snapshot, error) {
+	entries, err := os.ReadDir(c.SnapshotDir())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot directory: %w", err)
+	}
+
+	var snaps []*Snapshot
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".tar.gz") {
+			continue
+		}
+		snap, err := readSnapshotMeta(filepath.Join(c.SnapshotDir(), e.Name()))
+		if err != nil {
+			c.logger.Warn("skipping unreadable snapshot", "file", e.Name(), "error", err)
+			continue
+		}
+		snaps = append(snaps, snap)
+	}
+	slices.SortFunc(snaps, func(a, b *Snapshot) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return snaps, nil
+}
+
+// readSnapshotMeta reads the metadata of the snapshot at p.
+func readSnapshotMeta(p string) (*Snapshot, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(gz)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, err
+	}
+	if hdr.Name != snapshotMetaName {
+		return nil, fmt.Errorf("missing %s", snapshotMetaName)
+	}
+	snap := &Snapshot{}
+	if err := json.NewDecoder(tr).Decode(snap); err != nil {
+		return nil, err
+	}
+	// The snapshot may have been moved since it was taken
+	snap.Path = p
+	return snap, nil
+}
+
+// FindSnapshot returns the snapshot with the given ID, or the only one whose
+// ID starts with it.
+func (c *Core) FindSnapshot(id string) (*Snapshot, error) {
+	snaps, err := c.Snapshots()
+	if err != nil {
+		return nil, err
+	}
+	var found *Snapshot
+	for _, s := range snaps {
+		if s.ID == id {
+			return s, nil
+		}
+		if strings.HasPrefix(s.ID, id) {
+			if found != nil {
+				return nil, fmt.Errorf("snapshot ID %q is ambiguous", id)
+			}
+			found = s
+		}
+	}
+	if found == nil {
+		return nil, ErrSnapshotNotFound
+	}
+	return found, nil
+}
+
+// RestoreSnapshot replaces the contents of the beans directory (except the
+// local state) with the snapshot with the given ID and reloads the beans.
+// The current contents are snapshotted first, so a restore can be undone;
+// that snapshot is returned.
+func (c *Core) RestoreSnapshot(id string) (*Snapshot, error) {
+	snap, err := c.FindSnapshot(id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Read the whole snapshot before touching anything, so a damaged one
+	// can't leave the beans directory half restored
+	entries, err := readSnapshotEntries(snap.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot %s: %w", snap.ID, err)
+	}
+
+	backup, err := c.createSnapshotLocked("before restoring " + snap.ID)
+	if err != nil {
+		return nil, fmt.Errorf("snapshotting current beans: %w", err)
+	}
+
+	top, err := os.ReadDir(c.root)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range top {
+		if e.Name() == StateDirName {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(c.root, e.Name())); err != nil {
+			return nil, fmt.Errorf("clearing beans directory: %w", err)
+		}
+	}
+	for _, e := range entries {
+		full := filepath.Join(c.root, filepath.FromSlash(e.name))
+		if e.dir {
+			if err := os.MkdirAll(full, 0755); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(full, e.data, e.mode); err != nil {
+			return nil, fmt.Errorf("restoring %s: %w", e.name, err)
+		}
+	}
+
+	if err := c.loadFromDisk(); err != nil {
+		return backup, fmt.Errorf("reloading beans: %w", err)
+	}
+	return backup, nil
+}
+
+// snapshotEntry is a file or directory read from a snapshot.
+type snapshotEntry struct {
+	name string
+	dir  bool
+	mode os.FileMode
+	data []byte
+}
+
+// readSnapshotEntries reads the files and directories in the snapshot at p,
+// rejecting paths that would land outside the beans directory or in the
+// local state.
+func readSnapshotEntries(p string) ([]snapshotEntry, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []snapshotEntry
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name == snapshotMetaName {
+			continue
+		}
+		name := path.Clean(hdr.Name)
+		if !fs.ValidPath(name) || name == "." || name == StateDirName || strings.HasPrefix(name, StateDirName+"/") {
+			return nil, fmt.Errorf("invalid path %q", hdr.Name)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			entries = append(entries, snapshotEntry{name: name, dir: true})
+		case tar.TypeReg:
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, snapshotEntry{name: name, mode: hdr.FileInfo().Mode().Perm(), data: data})
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	createTestBean(t, core, "aaa1", "Keep me", "todo")
+	createTestBean(t, core, "bbb2", "Change me", "todo")
+	if err := os.MkdirAll(filepath.Join(beansDir, "queries"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beansDir, "queries", "open.graphql"), []byte("{ beans { id } }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := core.SetFocus("aaa1"); err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err := core.CreateSnapshot("before bulk edit")
+	if err != nil {
+		t.Fatalf("CreateSnapshot() error = %v", err)
+	}
+	if snap.Beans != 2 || snap.Files != 3 {
+		t.Errorf("snapshot has %d beans, %d files; want 2, 3", snap.Beans, snap.Files)
+	}
+
+	// Make a mess: delete one bean, add another, drop the query
+	if err := core.Delete("bbb2"); err != nil {
+		t.Fatal(err)
+	}
+	createTestBean(t, core, "ccc3", "Added later", "todo")
+	os.RemoveAll(filepath.Join(beansDir, "queries"))
+
+	backup, err := core.RestoreSnapshot(snap.ID[:8])
+	if err != nil {
+		t.Fatalf("RestoreSnapshot() error = %v", err)
+	}
+	if backup == nil || backup.Beans != 2 {
+		t.Errorf("backup snapshot = %+v, want one holding 2 beans", backup)
+	}
+
+	if _, err := core.Get("bbb2"); err != nil {
+		t.Errorf("bbb2 not restored: %v", err)
+	}
+	if _, err := core.Get("ccc3"); err != ErrNotFound {
+		t.Errorf("ccc3 still present after restore: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(beansDir, "queries", "open.graphql")); err != nil {
+		t.Errorf("query not restored: %v", err)
+	}
+	// Local state is left alone
+	if state, _ := core.LoadState(); state.Focus != "aaa1" {
+		t.Errorf("focus = %q after restore, want aaa1", state.Focus)
+	}
+
+	snaps, err := core.Snapshots()
+	if err != nil {
+		t.Fatalf("Snapshots() error = %v", err)
+	}
+	if len(snaps) != 2 || snaps[0].ID != backup.ID || snaps[1].Message != "before bulk edit" {
+		t.Errorf("Snapshots() = %+v, want the backup then the original", snaps)
+	}
+
+	if _, err := core.RestoreSnapshot("nope"); err != ErrSnapshotNotFound {
+		t.Errorf("RestoreSnapshot(nope) error = %v, want ErrSnapshotNotFound", err)
+	}
+}
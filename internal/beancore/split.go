@@ -0,0 +1,122 @@
+package beancore
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// SplitOptions selects what SplitBean extracts into child beans.
+type SplitOptions struct {
+	// Items are the 1-based indexes of checklist items to extract.
+	Items []int
+	// Sections are the headings of body sections to extract (case-insensitive).
+	Sections []string
+	// Type is the type of the new beans (default: the type below the split
+	// bean's, e.g. feature for an epic).
+	Type string
+}
+
+// childTypes maps bean types to the default type of beans split off them.
+var childTypes = map[string]string{
+	"milestone": "epic",
+	"epic":      "feature",
+	"feature":   "task",
+}
+
+// SplitBean breaks a bean down by moving checklist items and body sections
+// into new child beans, which keep the bean's tags and priority. A checklist
+// item becomes a bean titled with its text (completed if it was checked); a
+// section becomes a bean titled with its heading, with the section's content
+// as its body. Sections must all have the same heading level, so none is
+// part of another. The extracted items and sections are removed from the bean.
+// Returns the new beans: items in checklist order, then sections in the order
+// given.
+func (c *Core) SplitBean(id string, opts SplitOptions) ([]*bean.Bean, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Items) == 0 && len(opts.Sections) == 0 {
+		return nil, fmt.Errorf("nothing to split: select checklist items or sections")
+	}
+
+	childType := opts.Type
+	if childType == "" {
+		if childType = childTypes[b.Type]; childType == "" {
+			return nil, fmt.Errorf("%s beans cannot be split into child beans", b.Type)
+		}
+	}
+	if c.config != nil && !c.config.IsValidType(childType) {
+		return nil, fmt.Errorf("invalid type: %s", childType)
+	}
+
+	// Work out the new beans and the remaining body before changing anything
+	remaining := &bean.Bean{Body: b.Body}
+	items := slices.Clone(opts.Items)
+	slices.Sort(items)
+	items = slices.Compact(items)
+	if err := remaining.RemoveChecklistItems(items); err != nil {
+		return nil, err
+	}
+
+	var children []*bean.Bean
+	checklist := b.Checklist()
+	for _, index := range items {
+		item := checklist.Items[index-1]
+		child := c.splitChild(b, childType, item.Text, "")
+		if item.Done {
+			child.Status = "completed"
+		}
+		children = append(children, child)
+	}
+	level := 0
+	for _, heading := range opts.Sections {
+		section, ok := b.Section(heading)
+		if !ok {
+			return nil, fmt.Errorf("section %q not found", heading)
+		}
+		if level != 0 && section.Level != level {
+			return nil, fmt.Errorf("section %q is not at the same heading level as the other sections", section.Heading)
+		}
+		level = section.Level
+		if !remaining.RemoveSection(heading) {
+			return nil, fmt.Errorf("section %q selected twice", section.Heading)
+		}
+		children = append(children, c.splitChild(b, childType, section.Heading, section.Content))
+	}
+	if err := c.ValidateParent(children[0], b.ID); err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		if err := c.Create(child); err != nil {
+			return nil, err
+		}
+	}
+	b.Body = remaining.Body
+	if err := c.Update(b, nil); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+// splitChild returns a new child bean of parent, with parent's tags and priority.
+func (c *Core) splitChild(parent *bean.Bean, beanType, title, body string) *bean.Bean {
+	child := &bean.Bean{
+		Slug:     bean.Slugify(title),
+		Title:    title,
+		Type:     beanType,
+		Priority: parent.Priority,
+		Parent:   parent.ID,
+		Body:     body,
+	}
+	if c.config != nil {
+		child.Status = c.config.GetDefaultStatus()
+	}
+	if len(parent.Tags) > 0 {
+		child.Tags = append([]string(nil), parent.Tags...)
+	}
+	return child
+}
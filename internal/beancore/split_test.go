@@ -0,0 +1,76 @@
+package beancore
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestSplitBean(t *testing.T) {
+	core, _ := setupTestCore(t)
+
+	epic := &bean.Bean{ID: "epic1", Title: "Big epic", Status: "todo", Type: "epic", Priority: "high", Tags: []string{"ui"},
+		Body: "Overview\n\n- [ ] Login form\n- [x] Signup form\n- [ ] Keep me\n\n## Password reset\n\nEmail a link.\n\n## Notes\n\nStay.\n"}
+	if err := core.Create(epic); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	children, err := core.SplitBean("epic1", SplitOptions{Items: []int{2, 1}, Sections: []string{"password reset"}})
+	if err != nil {
+		t.Fatalf("SplitBean() error = %v", err)
+	}
+
+	var titles []string
+	for _, child := range children {
+		titles = append(titles, child.Title)
+		if child.Parent != "epic1" || child.Type != "feature" || child.Priority != "high" || !reflect.DeepEqual(child.Tags, []string{"ui"}) {
+			t.Errorf("child %q: parent = %q, type = %q, priority = %q, tags = %v", child.Title, child.Parent, child.Type, child.Priority, child.Tags)
+		}
+	}
+	if want := []string{"Login form", "Signup form", "Password reset"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("titles = %v, want %v", titles, want)
+	}
+	if children[0].Status != "todo" || children[1].Status != "completed" {
+		t.Errorf("statuses = %q, %q; want todo, completed", children[0].Status, children[1].Status)
+	}
+	if children[2].Body != "Email a link." {
+		t.Errorf("section child body = %q", children[2].Body)
+	}
+
+	updated, _ := core.Get("epic1")
+	if want := "Overview\n\n- [ ] Keep me\n\n## Notes\n\nStay."; strings.TrimSpace(updated.Body) != want {
+		t.Errorf("remaining body = %q, want %q", updated.Body, want)
+	}
+
+	t.Run("errors leave the bean alone", func(t *testing.T) {
+		for _, opts := range []SplitOptions{
+			{},
+			{Items: []int{5}},
+			{Sections: []string{"Missing"}},
+			{Items: []int{1}, Type: "milestone"},
+			{Sections: []string{"Notes", "notes"}},
+		} {
+			if _, err := core.SplitBean("epic1", opts); err == nil {
+				t.Errorf("SplitBean(%+v) should fail", opts)
+			}
+		}
+		if after, _ := core.Get("epic1"); after.Body != updated.Body {
+			t.Errorf("body changed to %q", after.Body)
+		}
+		task := &bean.Bean{ID: "task1", Title: "Task", Status: "todo", Type: "task", Body: "- [ ] Sub"}
+		core.Create(task)
+		if _, err := core.SplitBean("task1", SplitOptions{Items: []int{1}}); err == nil {
+			t.Error("splitting a task should fail")
+		}
+		nested := &bean.Bean{ID: "epic2", Title: "Nested", Status: "todo", Type: "epic", Body: "## Design\n\n### API\n\nEndpoints.\n"}
+		core.Create(nested)
+		if _, err := core.SplitBean("epic2", SplitOptions{Sections: []string{"Design", "API"}}); err == nil {
+			t.Error("splitting sections at different levels should fail")
+		}
+		if after, _ := core.Get("epic2"); after.Body != nested.Body {
+			t.Errorf("nested body changed to %q", after.Body)
+		}
+	})
+}
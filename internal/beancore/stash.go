@@ -0,0 +1,69 @@
+package beancore
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDirtyWorkingTree is returned when a git branch operation needs a clean
+// working tree and auto-stash is disabled.
+var ErrDirtyWorkingTree = errors.New("working tree has uncommitted changes - commit or stash them first")
+
+// SetAutoStash enables or disables auto-stash for this Core, overriding the
+// git.auto_stash config setting when enabled.
+func (c *Core) SetAutoStash(enabled bool) {
+	c.autoStash = enabled
+}
+
+// isAutoStashEnabled returns true if dirty working trees should be stashed
+// automatically around branch operations.
+func (c *Core) isAutoStashEnabled() bool {
+	return c.autoStash || (c.config != nil && c.config.Beans.Git.AutoStash)
+}
+
+// withCleanTree runs fn, which switches branches, with a clean working tree.
+// If the tree is dirty and auto-stash is enabled, changes confined to .beans/
+// are committed, and any other changes are stashed before fn runs and restored
+// afterwards. Otherwise, a dirty tree fails with ErrDirtyWorkingTree.
+// Must be called with lock held (or from a method that owns the bean state).
+func (c *Core) withCleanTree(fn func() error) error {
+	// Auto-commit beans if enabled and only .beans/ has changes
+	if c.config != nil && (c.config.Beans.Git.AutoCommitBeans || c.isAutoStashEnabled()) {
+		hasOnlyBeans, err := c.gitFlow.HasOnlyBeansDirChanges()
+		if err != nil {
+			return fmt.Errorf("failed to check for bean changes: %w", err)
+		}
+		if hasOnlyBeans {
+			if err := c.gitFlow.CommitBeans("chore: update beans"); err != nil {
+				c.logger.Warn("failed to auto-commit beans", "error", err)
+				// Continue anyway - let the clean tree check handle it
+			}
+		}
+	}
+
+	clean, err := c.gitFlow.IsWorkingTreeClean()
+	if err != nil {
+		return fmt.Errorf("failed to check working tree status: %w", err)
+	}
+	if clean {
+		return fn()
+	}
+	if !c.isAutoStashEnabled() {
+		return ErrDirtyWorkingTree
+	}
+
+	if _, err := c.gitFlow.Stash("beans: auto-stash"); err != nil {
+		return err
+	}
+
+	fnErr := fn()
+
+	// Restore the changes even if fn failed, so nothing is left behind in the stash
+	if err := c.gitFlow.StashPop(); err != nil {
+		if fnErr != nil {
+			return fmt.Errorf("%w (additionally, %v; your changes are in 'git stash list')", fnErr, err)
+		}
+		c.logger.Warn("your changes are kept in the stash (run 'git stash pop' to restore them)", "error", err)
+	}
+	return fnErr
+}
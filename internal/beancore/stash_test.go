@@ -0,0 +1,86 @@
+package beancore
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hmans/beans/internal/bean"
+)
+
+func TestStartBean_DirtyTree(t *testing.T) {
+	tests := []struct {
+		name      string
+		autoStash bool
+		wantErr   bool
+	}{
+		{"fails without auto-stash", false, true},
+		{"stashes and restores with auto-stash", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, _, repoPath := setupTestCoreWithGit(t)
+			core.SetAutoStash(tt.autoStash)
+			repo, _ := git.PlainOpen(repoPath)
+
+			if err := core.Create(&bean.Bean{ID: "beans-leaf1", Slug: "work", Title: "Work", Status: "todo"}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			os.WriteFile(filepath.Join(repoPath, "README.md"), []byte("# Changed\n"), 0644)
+			os.WriteFile(filepath.Join(repoPath, "notes.txt"), []byte("wip\n"), 0644)
+
+			b, err := core.StartBean("beans-leaf1", true)
+			if tt.wantErr {
+				if !errors.Is(err, ErrDirtyWorkingTree) {
+					t.Fatalf("StartBean() error = %v, want ErrDirtyWorkingTree", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("StartBean() error = %v", err)
+			}
+
+			head, _ := repo.Head()
+			if head.Name().Short() != b.GitBranch {
+				t.Errorf("current branch = %q, want %q", head.Name().Short(), b.GitBranch)
+			}
+
+			// Changes are carried over to the new branch
+			if content, _ := os.ReadFile(filepath.Join(repoPath, "README.md")); string(content) != "# Changed\n" {
+				t.Errorf("README.md = %q, want stashed change restored", content)
+			}
+			if _, err := os.Stat(filepath.Join(repoPath, "notes.txt")); err != nil {
+				t.Errorf("untracked file should be restored: %v", err)
+			}
+		})
+	}
+}
+
+func TestStartBean_AutoStashCommitsBeansOnlyChanges(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	core.config.Beans.Git.AutoCommitBeans = false
+	core.SetAutoStash(true)
+	repo, _ := git.PlainOpen(repoPath)
+
+	// The uncommitted bean file is the only change
+	if err := core.Create(&bean.Bean{ID: "beans-leaf1", Slug: "work", Title: "Work", Status: "todo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := core.StartBean("beans-leaf1", true); err != nil {
+		t.Fatalf("StartBean() error = %v", err)
+	}
+
+	mainRef, _ := repo.Reference(plumbing.NewBranchReferenceName("main"), true)
+	commit, _ := repo.CommitObject(mainRef.Hash())
+	if _, err := commit.File(".beans/beans-leaf1--work.md"); err != nil {
+		t.Errorf("bean file should be committed on main: %v", err)
+	}
+	if out, _ := exec.Command("git", "-C", repoPath, "stash", "list").Output(); len(out) != 0 {
+		t.Errorf("stash should be empty, got %q", out)
+	}
+}
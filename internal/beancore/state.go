@@ -0,0 +1,94 @@
+package beancore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// StateDirName is the directory inside the beans directory that holds local,
+// per-clone state. It contains a .gitignore ignoring everything in it, so the
+// state is never committed.
+const StateDirName = ".state"
+
+// stateFileName is the file in the state directory holding LocalState.
+const stateFileName = "state.json"
+
+// LocalState is state that belongs to whoever works in this clone rather than
+// to the project, such as the bean they're focused on.
+type LocalState struct {
+	// Focus is the ID of the bean currently being worked on (see SetFocus).
+	Focus string `json:"focus,omitempty"`
+	// TUI is where the TUI was left, restored on its next launch.
+	TUI TUIState `json:"tui,omitzero"`
+}
+
+// TUIState is the TUI's view, cursor and filters when it was last quit.
+type TUIState struct {
+	// View is the view to open in: "list" or "board".
+	View string `json:"view,omitempty"`
+	// Selected is the ID of the bean under the cursor.
+	Selected string `json:"selected,omitempty"`
+	// TagFilter is the tag the list was filtered by.
+	TagFilter string `json:"tag_filter,omitempty"`
+	// Filter is the text the list was filtered by.
+	Filter string `json:"filter,omitempty"`
+	// Lanes is how the board was split into swimlanes: "epic", "type" or "assignee".
+	Lanes string `json:"lanes,omitempty"`
+}
+
+// StateDir returns the path of the local state directory.
+func (c *Core) StateDir() string {
+	return filepath.Join(c.root, StateDirName)
+}
+
+// LoadState reads the local state. A missing state file yields an empty state.
+func (c *Core) LoadState() (*LocalState, error) {
+	state := &LocalState{}
+	data, err := os.ReadFile(filepath.Join(c.StateDir(), stateFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading local state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("parsing local state: %w", err)
+	}
+	return state, nil
+}
+
+// ensureStateDir creates the state directory and its .gitignore if needed.
+func (c *Core) ensureStateDir() error {
+	dir := c.StateDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating state directory: %w", err)
+	}
+	gitignore := filepath.Join(dir, ".gitignore")
+	if _, err := os.Stat(gitignore); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(gitignore, []byte("# Local beans state, not meant to be committed\n*\n"), 0644); err != nil {
+			return fmt.Errorf("writing state .gitignore: %w", err)
+		}
+	}
+	return nil
+}
+
+// SaveState writes the local state, creating the state directory (and its
+// .gitignore) if needed.
+func (c *Core) SaveState(state *LocalState) error {
+	if err := c.ensureStateDir(); err != nil {
+		return err
+	}
+	dir := c.StateDir()
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, stateFileName), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing local state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package beancore
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// TagCount is a tag and the number of beans that have it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+	// Description is the registered tag's description, if any.
+	Description string `json:"description,omitempty"`
+}
+
+// TagCounts returns all tags in use, plus any registered but unused tags
+// (with a count of 0), sorted by tag.
+func (c *Core) TagCounts() []TagCount {
+	c.mu.RLock()
+	counts := make(map[string]int)
+	for _, b := range c.beans {
+		for _, tag := range b.Tags {
+			counts[tag]++
+		}
+	}
+	c.mu.RUnlock()
+
+	if c.config != nil {
+		for _, tag := range c.config.TagNames() {
+			if strings.HasSuffix(tag, bean.TagNamespaceSeparator+"*") {
+				continue // namespace wildcard, not a tag
+			}
+			if _, ok := counts[tag]; !ok {
+				counts[tag] = 0
+			}
+		}
+	}
+
+	result := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tc := TagCount{Tag: tag, Count: count}
+		if c.config != nil {
+			if registered := c.config.GetTag(tag); registered != nil {
+				tc.Description = registered.Description
+			}
+		}
+		result = append(result, tc)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Tag < result[j].Tag })
+	return result
+}
+
+// RenameTag replaces a tag with another on every bean that has it, merging
+// the two if a bean already has both. Returns the updated beans.
+func (c *Core) RenameTag(oldTag, newTag string) ([]*bean.Bean, error) {
+	oldTag, newTag = bean.NormalizeTag(oldTag), bean.NormalizeTag(newTag)
+	if err := bean.ValidateTag(newTag); err != nil {
+		return nil, err
+	}
+	if oldTag == newTag {
+		return nil, fmt.Errorf("tag is already named %q", newTag)
+	}
+	if c.config != nil && c.config.HasTagRegistry() && !c.config.IsAllowedTag(newTag) {
+		return nil, fmt.Errorf("tag %q is not registered (use %s)", newTag, strings.Join(c.config.TagNames(), ", "))
+	}
+
+	return c.updateTagged(oldTag, func(b *bean.Bean) {
+		if b.HasTag(newTag) {
+			b.RemoveTag(oldTag)
+			return
+		}
+		b.Tags[slices.Index(b.Tags, oldTag)] = newTag
+	})
+}
+
+// RemoveTag removes a tag from every bean that has it. Returns the updated beans.
+func (c *Core) RemoveTag(tag string) ([]*bean.Bean, error) {
+	tag = bean.NormalizeTag(tag)
+	return c.updateTagged(tag, func(b *bean.Bean) {
+		b.RemoveTag(tag)
+	})
+}
+
+// updateTagged applies fn to a copy of every bean with the tag and saves
+// them. The beans in memory are only replaced once all are written; if one
+// fails, the files already written are restored.
+func (c *Core) updateTagged(tag string, fn func(b *bean.Bean)) ([]*bean.Bean, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var ids []string
+	for id, b := range c.beans {
+		if slices.Contains(b.Tags, tag) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	updated := make([]*bean.Bean, 0, len(ids))
+	for _, id := range ids {
+		old := c.beans[id]
+		if err := c.materializeLocked(old); err != nil {
+			return nil, err
+		}
+		b := *old
+		b.Tags = slices.Clone(old.Tags)
+		fn(&b)
+		if err := c.checkTags(&b, old); err != nil {
+			return nil, fmt.Errorf("updating %s: %w", id, err)
+		}
+		b.UpdatedAt = &now
+		updated = append(updated, &b)
+	}
+
+	for i, b := range updated {
+		if err := c.saveToDisk(b); err != nil {
+			for _, written := range updated[:i] {
+				if restoreErr := c.saveToDisk(c.beans[written.ID]); restoreErr != nil {
+					c.logger.Warn("failed to restore bean", "bean", written.ID, "error", restoreErr)
+				}
+			}
+			return nil, fmt.Errorf("updating %s: %w", b.ID, err)
+		}
+	}
+
+	for _, b := range updated {
+		c.beans[b.ID] = b
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(b); err != nil {
+				c.logger.Warn("failed to update bean in search index", "bean", b.ID, "error", err)
+			}
+		}
+	}
+	return updated, nil
+}
+
+// checkTags returns an error if b has a tag that old (the previous version,
+// nil on create) didn't have and that isn't allowed by the tag registry.
+func (c *Core) checkTags(b, old *bean.Bean) error {
+	if c.config == nil || !c.config.HasTagRegistry() {
+		return nil
+	}
+	for _, tag := range b.Tags {
+		if old != nil && slices.Contains(old.Tags, tag) {
+			continue
+		}
+		if !c.config.IsAllowedTag(tag) {
+			return fmt.Errorf("tag %q is not registered (use %s)", tag, strings.Join(c.config.TagNames(), ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func createTaggedBean(t *testing.T, core *Core, id string, tags ...string) *bean.Bean {
+	t.Helper()
+	b := &bean.Bean{ID: id, Slug: id, Title: id, Status: "todo", Tags: tags}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create(%s) error = %v", id, err)
+	}
+	return b
+}
+
+func TestTagCounts(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTaggedBean(t, core, "aaa1", "ui", "backend")
+	createTaggedBean(t, core, "bbb2", "ui")
+	core.config.Beans.Tags = []config.TagConfig{{Name: "docs", Description: "Documentation"}, {Name: "ui", Description: "User interface"}}
+
+	got := core.TagCounts()
+	want := []TagCount{
+		{Tag: "backend", Count: 1},
+		{Tag: "docs", Count: 0, Description: "Documentation"},
+		{Tag: "ui", Count: 2, Description: "User interface"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TagCounts() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTaggedBean(t, core, "aaa1", "alpha", "ui", "omega")
+	createTaggedBean(t, core, "bbb2", "ui", "frontend")
+	createTaggedBean(t, core, "ccc3", "backend")
+
+	updated, err := core.RenameTag("ui", "frontend")
+	if err != nil {
+		t.Fatalf("RenameTag() error = %v", err)
+	}
+	if len(updated) != 2 {
+		t.Errorf("RenameTag() updated %d beans, want 2", len(updated))
+	}
+
+	a, _ := core.Get("aaa1")
+	if want := []string{"alpha", "frontend", "omega"}; !reflect.DeepEqual(a.Tags, want) {
+		t.Errorf("aaa1 tags = %v, want %v (renamed in place)", a.Tags, want)
+	}
+	b, _ := core.Get("bbb2")
+	if want := []string{"frontend"}; !reflect.DeepEqual(b.Tags, want) {
+		t.Errorf("bbb2 tags = %v, want %v (merged)", b.Tags, want)
+	}
+
+	// Persisted to disk
+	reloaded, err := core.loadBean(core.FullPath(a))
+	if err != nil {
+		t.Fatalf("loadBean() error = %v", err)
+	}
+	if !reloaded.HasTag("frontend") || reloaded.HasTag("ui") {
+		t.Errorf("reloaded tags = %v, want ui renamed to frontend", reloaded.Tags)
+	}
+
+	if _, err := core.RenameTag("backend", "Not Valid"); err == nil {
+		t.Error("RenameTag() to an invalid tag should fail")
+	}
+	if _, err := core.RenameTag("backend", "backend"); err == nil {
+		t.Error("RenameTag() to the same tag should fail")
+	}
+}
+
+func TestRenameTagFailedSave(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	a := createTaggedBean(t, core, "aaa1", "ui")
+	b := createTaggedBean(t, core, "bbb2", "ui")
+
+	// Make bbb2's file unwritable by putting a directory in its place
+	path := filepath.Join(beansDir, b.Path)
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(path, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := core.RenameTag("ui", "frontend"); err == nil {
+		t.Fatal("RenameTag() with an unwritable bean should fail")
+	}
+	if got, _ := core.Get("aaa1"); !got.HasTag("ui") || got.HasTag("frontend") {
+		t.Errorf("aaa1 tags in memory = %v, want unchanged", got.Tags)
+	}
+	reloaded, err := core.loadBean(core.FullPath(a))
+	if err != nil {
+		t.Fatalf("loadBean() error = %v", err)
+	}
+	if !reloaded.HasTag("ui") || reloaded.HasTag("frontend") {
+		t.Errorf("aaa1 tags on disk = %v, want restored", reloaded.Tags)
+	}
+}
+
+func TestRemoveTag(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTaggedBean(t, core, "aaa1", "ui", "backend")
+	createTaggedBean(t, core, "bbb2", "backend")
+
+	updated, err := core.RemoveTag("backend")
+	if err != nil {
+		t.Fatalf("RemoveTag() error = %v", err)
+	}
+	if len(updated) != 2 {
+		t.Errorf("RemoveTag() updated %d beans, want 2", len(updated))
+	}
+	if got := core.TagCounts(); !reflect.DeepEqual(got, []TagCount{{Tag: "ui", Count: 1}}) {
+		t.Errorf("TagCounts() after removal = %+v", got)
+	}
+}
+
+func TestTagRegistry(t *testing.T) {
+	core, _ := setupTestCore(t)
+	legacy := createTaggedBean(t, core, "aaa1", "legacy")
+	core.config.Beans.Tags = []config.TagConfig{{Name: "ui"}}
+
+	if err := core.Create(&bean.Bean{ID: "bbb2", Slug: "b", Title: "B", Status: "todo", Tags: []string{"nope"}}); err == nil {
+		t.Error("Create() with an unregistered tag should fail")
+	}
+	createTaggedBean(t, core, "ccc3", "ui")
+
+	// Existing unregistered tags are kept, new ones are rejected
+	legacy.Status = "in-progress"
+	if err := core.Update(legacy, nil); err != nil {
+		t.Errorf("Update() keeping an unregistered tag error = %v", err)
+	}
+	legacy.Tags = append(legacy.Tags, "other")
+	if err := core.Update(legacy, nil); err == nil {
+		t.Error("Update() adding an unregistered tag should fail")
+	}
+
+	if _, err := core.RenameTag("legacy", "nope"); err == nil {
+		t.Error("RenameTag() to an unregistered tag should fail")
+	}
+}
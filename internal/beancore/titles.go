@@ -0,0 +1,120 @@
+package beancore
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+// TitlePolicyError is returned when a new bean's title breaks the project's
+// title policy (the "titles" setting).
+type TitlePolicyError struct {
+	Title      string
+	Problems   []string
+	Suggestion string // a title that follows the policy better, if any
+}
+
+func (e *TitlePolicyError) Error() string {
+	msg := fmt.Sprintf("title %q %s", e.Title, strings.Join(e.Problems, ", "))
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (try %q)", e.Suggestion)
+	}
+	return msg
+}
+
+// titlePolicy returns the project's title policy.
+func (c *Core) titlePolicy() config.TitlesConfig {
+	if c.config == nil {
+		return config.TitlesConfig{}
+	}
+	return c.config.Beans.Titles
+}
+
+// NormalizeTitle collapses whitespace in a title and applies the policy's
+// case.
+func (c *Core) NormalizeTitle(title string) string {
+	words := strings.Fields(title)
+	if c.titlePolicy().Case == config.TitleCaseSentence {
+		for i, w := range words {
+			words[i] = sentenceCaseWord(w, i == 0)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// TitleProblems returns how a title breaks the title policy, e.g.
+// `starts with "WIP:"`.
+func (c *Core) TitleProblems(title string) []string {
+	policy := c.titlePolicy()
+	var problems []string
+	if prefix := forbiddenPrefix(title, policy.ForbiddenPrefixes); prefix != "" {
+		problems = append(problems, fmt.Sprintf("starts with %q", prefix))
+	}
+	if n := utf8.RuneCountInString(title); policy.MaxLength > 0 && n > policy.MaxLength {
+		problems = append(problems, fmt.Sprintf("is %d characters long (max %d)", n, policy.MaxLength))
+	}
+	if policy.Case == config.TitleCaseSentence && c.NormalizeTitle(title) != title {
+		problems = append(problems, "isn't in sentence case")
+	}
+	return problems
+}
+
+// SuggestTitle returns a title that follows the title policy better than the
+// given one: without forbidden prefixes, and normalized. It returns an empty
+// string if there's nothing to suggest.
+func (c *Core) SuggestTitle(title string) string {
+	suggestion := title
+	prefixes := c.titlePolicy().ForbiddenPrefixes
+	for prefix := forbiddenPrefix(suggestion, prefixes); prefix != ""; prefix = forbiddenPrefix(suggestion, prefixes) {
+		suggestion = strings.TrimSpace(suggestion[len(prefix):])
+	}
+	suggestion = c.NormalizeTitle(suggestion)
+	if suggestion == title || suggestion == "" {
+		return ""
+	}
+	return suggestion
+}
+
+// checkTitle collapses whitespace in a new bean's title and returns a
+// TitlePolicyError if it breaks the title policy. The case isn't changed
+// behind the user's back; the error suggests a title instead.
+func (c *Core) checkTitle(title *string) error {
+	collapsed := strings.Join(strings.Fields(*title), " ")
+	if problems := c.TitleProblems(collapsed); len(problems) > 0 {
+		return &TitlePolicyError{Title: *title, Problems: problems, Suggestion: c.SuggestTitle(collapsed)}
+	}
+	*title = collapsed
+	return nil
+}
+
+// forbiddenPrefix returns the first of prefixes the title starts with,
+// case-insensitively, or an empty string.
+func forbiddenPrefix(title string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSpace(prefix)
+		if prefix != "" && len(title) >= len(prefix) && strings.EqualFold(title[:len(prefix)], prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// sentenceCaseWord capitalizes the first word of a title and lowercases
+// capitalized ones after it. Words with capitals inside them (GitHub, API,
+// iOS, gRPC), even the first, and single letters (I) are left alone.
+func sentenceCaseWord(w string, first bool) string {
+	r, size := utf8.DecodeRuneInString(w)
+	if strings.ContainsFunc(w[size:], unicode.IsUpper) {
+		return w
+	}
+	if first {
+		return string(unicode.ToUpper(r)) + w[size:]
+	}
+	if !unicode.IsUpper(r) || size == len(w) {
+		return w
+	}
+	return string(unicode.ToLower(r)) + w[size:]
+}
@@ -0,0 +1,97 @@
+package beancore
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func setupTitlesCore(t *testing.T) *Core {
+	t.Helper()
+	core, _ := setupTestCore(t)
+	core.config.Beans.Titles = config.TitlesConfig{
+		Case:              config.TitleCaseSentence,
+		MaxLength:         30,
+		ForbiddenPrefixes: []string{"WIP:", "TODO"},
+	}
+	return core
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	core := setupTitlesCore(t)
+	tests := map[string]string{
+		"  add   Dark Mode ":         "Add dark mode",
+		"Fix GitHub sync in the API": "Fix GitHub sync in the API",
+		"Should I Retry?":            "Should I retry?",
+		"über Title":                 "Über title",
+		"iOS Build Fails":            "iOS build fails",
+		"gRPC Timeouts":              "gRPC timeouts",
+	}
+	for in, want := range tests {
+		if got := core.NormalizeTitle(in); got != want {
+			t.Errorf("NormalizeTitle(%q) = %q, want %q", in, got, want)
+		}
+	}
+
+	core.config.Beans.Titles.Case = ""
+	if got := core.NormalizeTitle(" Keep  As Is "); got != "Keep As Is" {
+		t.Errorf("NormalizeTitle() without a case = %q", got)
+	}
+}
+
+func TestTitleProblemsAndSuggestions(t *testing.T) {
+	core := setupTitlesCore(t)
+
+	got := core.TitleProblems("wip: Add A Very Long Feature Title Here")
+	want := []string{`starts with "WIP:"`, "is 39 characters long (max 30)", "isn't in sentence case"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TitleProblems() = %q, want %q", got, want)
+	}
+	if got := core.TitleProblems("Add dark mode"); got != nil {
+		t.Errorf("TitleProblems() = %q, want none", got)
+	}
+
+	suggestions := map[string]string{
+		"WIP: todo Add Dark Mode": "Add dark mode",
+		"Add dark mode":           "",
+		"WIP:":                    "",
+	}
+	for in, want := range suggestions {
+		if got := core.SuggestTitle(in); got != want {
+			t.Errorf("SuggestTitle(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCreateAppliesTitlePolicy(t *testing.T) {
+	core := setupTitlesCore(t)
+
+	// A title in the wrong case is refused with a suggestion, not rewritten
+	err := core.Create(&bean.Bean{ID: "t0", Slug: "dark-mode", Title: "add  Dark Mode", Status: "todo"})
+	var policyErr *TitlePolicyError
+	if !errors.As(err, &policyErr) || policyErr.Suggestion != "Add dark mode" {
+		t.Fatalf("Create() error = %v, want a case violation suggesting %q", err, "Add dark mode")
+	}
+
+	b := &bean.Bean{ID: "t1", Slug: "dark-mode", Title: "Add  dark mode", Status: "todo"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if b.Title != "Add dark mode" {
+		t.Errorf("Title = %q, want whitespace collapsed", b.Title)
+	}
+
+	err = core.Create(&bean.Bean{ID: "t2", Slug: "wip", Title: "WIP: dark mode", Status: "todo"})
+	if !errors.As(err, &policyErr) || !reflect.DeepEqual(policyErr.Problems, []string{`starts with "WIP:"`}) {
+		t.Fatalf("Create() error = %v, want a forbidden prefix", err)
+	}
+
+	// Updates aren't held to the policy
+	b.Title = "WIP: Add Dark Mode"
+	if err := core.Update(b, nil); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+}
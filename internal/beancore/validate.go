@@ -0,0 +1,90 @@
+package beancore
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// FrontMatterIssues holds the strict validation issues of a single bean file.
+type FrontMatterIssues struct {
+	Path   string                  `json:"path"`
+	Issues []bean.FrontMatterIssue `json:"issues"`
+}
+
+// ValidationRules returns the enum values and aliases accepted by strict
+// front matter validation, taken from the project configuration.
+func (c *Core) ValidationRules() bean.ValidationRules {
+	if c.config == nil {
+		return bean.ValidationRules{}
+	}
+	return bean.ValidationRules{
+		Statuses:   c.config.StatusNames(),
+		Types:      c.config.TypeNames(),
+		Priorities: c.config.PriorityNames(),
+		LinkTypes:  c.config.LinkTypeNames(),
+
+		StatusAliases: c.config.StatusAliases(),
+		TypeAliases:   c.config.TypeAliases(),
+	}
+}
+
+// ValidateFrontMatter strictly validates the front matter of every bean file
+// on disk, including archived beans. Files without issues are omitted;
+// results are sorted by path.
+func (c *Core) ValidateFrontMatter() ([]FrontMatterIssues, error) {
+	rules := c.ValidationRules()
+
+	var results []FrontMatterIssues
+	err := c.walkUnignored(c.root, func(path string, d os.DirEntry) error {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".md") {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(c.root, path)
+		if err != nil {
+			return err
+		}
+
+		issues, err := bean.ValidateFrontMatter(content, rules)
+		if err != nil {
+			issues = []bean.FrontMatterIssue{{Line: 1, Column: 1, Message: err.Error()}}
+		}
+		if len(issues) > 0 {
+			results = append(results, FrontMatterIssues{Path: filepath.ToSlash(relPath), Issues: issues})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("validating front matter: %w", err)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Path < results[j].Path })
+	return results, nil
+}
+
+// warnFrontMatterIssues logs a warning for each strict validation issue in a
+// bean file that is being loaded.
+func (c *Core) warnFrontMatterIssues(relPath string, content []byte) {
+	if !c.logger.Enabled(context.Background(), slog.LevelWarn) {
+		return
+	}
+	issues, err := bean.ValidateFrontMatter(content, c.ValidationRules())
+	if err != nil {
+		c.logger.Warn("invalid front matter", "file", relPath, "error", err)
+		return
+	}
+	for _, issue := range issues {
+		c.logger.Warn(issue.Message, "file", relPath, "line", issue.Line, "column", issue.Column)
+	}
+}
@@ -0,0 +1,59 @@
+package beancore
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/logging"
+)
+
+func TestValidateFrontMatter(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+	createTestBean(t, core, "aaa1", "Good", "todo")
+
+	bad := "---\ntitle: Bad\nstatus: sleeping\n---\n"
+	if err := os.WriteFile(filepath.Join(beansDir, "bbb2--bad.md"), []byte(bad), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := core.ValidateFrontMatter()
+	if err != nil {
+		t.Fatalf("ValidateFrontMatter() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("got %d files with issues, want 1: %v", len(results), results)
+	}
+	if results[0].Path != "bbb2--bad.md" {
+		t.Errorf("Path = %q, want %q", results[0].Path, "bbb2--bad.md")
+	}
+	if len(results[0].Issues) != 1 || results[0].Issues[0].Line != 3 {
+		t.Errorf("Issues = %v, want one issue on line 3", results[0].Issues)
+	}
+}
+
+func TestLoadWarnsAboutFrontMatterIssues(t *testing.T) {
+	core, beansDir := setupTestCore(t)
+
+	bad := "---\ntitle: Bad\nstauts: todo\n---\n"
+	if err := os.WriteFile(filepath.Join(beansDir, "bbb2--bad.md"), []byte(bad), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var warnings bytes.Buffer
+	core.SetLogger(logging.New(&warnings, slog.LevelWarn, logging.FormatText))
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	want := `warning: unknown field "stauts" (did you mean "status"?) file=bbb2--bad.md line=3 column=1`
+	if !strings.Contains(warnings.String(), want) {
+		t.Errorf("warnings = %q, want it to contain %q", warnings.String(), want)
+	}
+	if _, err := core.Get("bbb2"); err != nil {
+		t.Errorf("bean with issues should still load: %v", err)
+	}
+}
@@ -134,6 +134,9 @@ func (c *Core) Watch(onChange func()) error {
 		if err != nil || !d.IsDir() || path == c.root {
 			return nil
 		}
+		if c.isIgnored(path, true) {
+			return filepath.SkipDir
+		}
 		_ = watcher.Add(path)
 		return nil
 	})
@@ -199,8 +202,23 @@ func (c *Core) watchLoop(watcher *fsnotify.Watcher) {
 				return
 			}
 
-			// Only care about .md files within the .beans directory tree
-			if !strings.HasSuffix(event.Name, ".md") {
+			// Watch directories created later, like new months in a date-based
+			// archive (best effort)
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() && !c.isIgnored(event.Name, true) {
+					_ = watcher.Add(event.Name)
+				}
+			}
+
+			// Edited defaults files apply to beans read from now on
+			if filepath.Base(event.Name) == DefaultsFileName {
+				c.forgetDirDefaults()
+				continue
+			}
+
+			// Only care about .md files within the .beans directory tree, and
+			// the archive bundle
+			if !strings.HasSuffix(event.Name, ".md") && event.Name != c.bundlePath() {
 				continue
 			}
 
@@ -210,6 +228,11 @@ func (c *Core) watchLoop(watcher *fsnotify.Watcher) {
 				continue
 			}
 
+			// Skip files matching the ignore patterns
+			if c.isIgnored(event.Name, false) {
+				continue
+			}
+
 			// Check if this is a relevant event
 			relevant := event.Op&fsnotify.Create != 0 ||
 				event.Op&fsnotify.Write != 0 ||
@@ -265,69 +288,94 @@ func (c *Core) handleChanges(changes map[string]fsnotify.Op) {
 
 	var events []BeanEvent
 
+	// Reload the archive bundle first, so beans compacted into it (by another
+	// process, say) are known before their files disappear below
+	if op, ok := changes[c.bundlePath()]; ok {
+		delete(changes, c.bundlePath())
+		if op&fsnotify.Create != 0 || op&fsnotify.Write != 0 {
+			events = append(events, c.reloadBundleLocked()...)
+		}
+	}
+
+	// Creates and writes go first, so a renamed bean file (old path removed,
+	// new path created) is seen as an update rather than a deletion
 	for path, op := range changes {
-		filename := filepath.Base(path)
-		id, _ := bean.ParseFilename(filename)
-
-		// Handle removes/renames (file is gone)
-		if op&fsnotify.Remove != 0 || op&fsnotify.Rename != 0 {
-			// Check if the file actually exists (rename might be followed by create)
-			if _, exists := c.beans[id]; exists {
-				// Only delete if it was in our map and file is actually gone
-				if !c.fileExists(path) {
-					delete(c.beans, id)
-
-					// Update search index
-					if c.searchIndex != nil {
-						if err := c.searchIndex.DeleteBean(id); err != nil {
-							c.logWarn("failed to remove bean %s from search index: %v", id, err)
-						}
-					}
-
-					events = append(events, BeanEvent{
-						Type:   EventDeleted,
-						Bean:   nil,
-						BeanID: id,
-					})
-				}
-			}
+		if op&fsnotify.Create == 0 && op&fsnotify.Write == 0 {
 			continue
 		}
+		if !c.fileExists(path) {
+			continue // removed again within the debounce window
+		}
 
-		// Handle creates/writes (file exists or was created)
-		if op&fsnotify.Create != 0 || op&fsnotify.Write != 0 {
-			newBean, err := c.loadBean(path)
-			if err != nil {
-				c.logWarn("failed to load bean from %s: %v", path, err)
-				continue
-			}
+		newBean, err := c.loadBeanChecked(path)
+		if err != nil {
+			c.logger.Warn("failed to load bean", "path", path, "error", err)
+			continue
+		}
 
-			_, existed := c.beans[newBean.ID]
-			c.beans[newBean.ID] = newBean
+		_, existed := c.beans[newBean.ID]
+		c.beans[newBean.ID] = newBean
 
-			// Update search index
-			if c.searchIndex != nil {
-				if err := c.searchIndex.IndexBean(newBean); err != nil {
-					c.logWarn("failed to index bean %s: %v", newBean.ID, err)
-				}
+		// Update search index
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(newBean); err != nil {
+				c.logger.Warn("failed to index bean", "bean", newBean.ID, "error", err)
 			}
+		}
 
-			if existed {
-				events = append(events, BeanEvent{
-					Type:   EventUpdated,
-					Bean:   newBean,
-					BeanID: newBean.ID,
-				})
-			} else {
-				events = append(events, BeanEvent{
-					Type:   EventCreated,
-					Bean:   newBean,
-					BeanID: newBean.ID,
-				})
+		if existed {
+			events = append(events, BeanEvent{
+				Type:   EventUpdated,
+				Bean:   newBean,
+				BeanID: newBean.ID,
+			})
+		} else {
+			events = append(events, BeanEvent{
+				Type:   EventCreated,
+				Bean:   newBean,
+				BeanID: newBean.ID,
+			})
+		}
+	}
+
+	// Handle removes/renames (file is gone)
+	for path, op := range changes {
+		if op&fsnotify.Remove == 0 && op&fsnotify.Rename == 0 {
+			continue
+		}
+		id, _ := bean.ParseFilename(filepath.Base(path))
+		b, exists := c.beans[id]
+		if !exists || c.fileExists(path) {
+			continue
+		}
+		// Compacted into the archive bundle, not deleted
+		if c.inBundleLocked(id) {
+			continue
+		}
+		// Only delete the bean if the file that's gone is the one backing it;
+		// after a rename, it lives on under its new name
+		if rel, err := filepath.Rel(c.root, path); err != nil || filepath.ToSlash(rel) != b.Path {
+			continue
+		}
+		delete(c.beans, id)
+
+		// Update search index
+		if c.searchIndex != nil {
+			if err := c.searchIndex.DeleteBean(id); err != nil {
+				c.logger.Warn("failed to remove bean from search index", "bean", id, "error", err)
 			}
 		}
+
+		events = append(events, BeanEvent{
+			Type:   EventDeleted,
+			Bean:   nil,
+			BeanID: id,
+		})
 	}
 
+	if len(events) > 0 {
+		c.markChanged()
+	}
 	callback := c.onChange
 	c.mu.Unlock()
 
@@ -340,6 +388,33 @@ func (c *Core) handleChanges(changes map[string]fsnotify.Op) {
 	}
 }
 
+// reloadBundleLocked rereads the archive bundle, returning events for the
+// beans it added. Must be called with c.mu held.
+func (c *Core) reloadBundleLocked() []BeanEvent {
+	known := make(map[string]bool, len(c.beans))
+	for id := range c.beans {
+		known[id] = true
+	}
+	if err := c.loadBundleLocked(); err != nil {
+		c.logger.Warn("failed to reload archive bundle", "error", err)
+		return nil
+	}
+
+	var events []BeanEvent
+	for id, b := range c.beans {
+		if known[id] {
+			continue
+		}
+		if c.searchIndex != nil {
+			if err := c.searchIndex.IndexBean(b); err != nil {
+				c.logger.Warn("failed to index bean", "bean", id, "error", err)
+			}
+		}
+		events = append(events, BeanEvent{Type: EventCreated, Bean: b, BeanID: id})
+	}
+	return events
+}
+
 // fileExists checks if a file exists at the given path.
 func (c *Core) fileExists(path string) bool {
 	_, err := os.Stat(path)
@@ -0,0 +1,180 @@
+package beancore
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/gitflow"
+)
+
+// FinishOptions controls the behavior of FinishBean.
+type FinishOptions struct {
+	// Force completes the bean even if its branch isn't merged (with require_merge enabled).
+	Force bool
+	// Archive moves the bean to the archive after completing it.
+	Archive bool
+}
+
+// StartBean marks a bean as in-progress. If git integration is enabled and
+// createBranch is set, the bean's branch is created from the base branch (or
+// switched to, if it already exists) regardless of auto_create_branch.
+func (c *Core) StartBean(id string, createBranch bool) (*bean.Bean, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if createBranch && c.IsGitFlowEnabled() {
+		c.mu.Lock()
+		err := c.createBranchForBean(b)
+		c.mu.Unlock()
+		if err != nil {
+			return nil, fmt.Errorf("git flow: %w", err)
+		}
+	}
+
+	b.Status = "in-progress"
+	if err := c.Update(b, nil); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// BranchBean creates the bean's git branch from the base branch, or switches
+// to it if it already exists, without changing the bean's status. Unlike the
+// branches auto_create_branch creates, this works for any bean.
+func (c *Core) BranchBean(id string) (*bean.Bean, error) {
+	if !c.IsGitFlowEnabled() {
+		return nil, fmt.Errorf("git integration is not enabled")
+	}
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	err = c.createBranchForBean(b)
+	c.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("git flow: %w", err)
+	}
+
+	if err := c.Update(b, nil); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// FinishBean marks a bean as completed. With git integration enabled, it
+// verifies that the bean's branch is merged into the base branch (refusing to
+// continue if require_merge is set, unless forced), records merge metadata,
+// and switches back to the base branch if the bean's branch is checked out.
+func (c *Core) FinishBean(id string, opts FinishOptions) (*bean.Bean, error) {
+	b, err := c.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var mergeCommit string
+	if c.IsGitFlowEnabled() && b.GitBranch != "" {
+		baseBranch := c.getBaseBranch()
+
+		merged, hash := c.isBeanMerged(b, baseBranch)
+		if !merged && c.config.Beans.Git.RequireMerge && !opts.Force {
+			return nil, fmt.Errorf("branch %q is not merged into %s (merge it first, or force to finish anyway)", b.GitBranch, baseBranch)
+		}
+		if hash != nil {
+			mergeCommit = hash.String()
+		}
+
+		// Return to the base branch and pick up its version of the beans
+		current, err := c.gitFlow.GetCurrentBranch()
+		if err == nil && c.IsBeanBranch(b, current) {
+			err := c.withCleanTree(func() error {
+				return c.gitFlow.SwitchBranch(baseBranch)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("git flow: switching to %s: %w", baseBranch, err)
+			}
+			if err := c.Load(); err != nil {
+				return nil, fmt.Errorf("reloading beans: %w", err)
+			}
+			if b, err = c.Get(id); err != nil {
+				return nil, err
+			}
+		}
+
+		if merged && b.GitMergedAt == nil {
+			now := time.Now().UTC().Truncate(time.Second)
+			b.GitMergedAt = &now
+			if mergeCommit != "" {
+				b.GitMergeCommit = mergeCommit
+			}
+		}
+	}
+
+	b.Status = "completed"
+	if err := c.Update(b, nil); err != nil {
+		return nil, err
+	}
+
+	if opts.Archive {
+		if err := c.Archive(b.ID); err != nil {
+			return nil, fmt.Errorf("archiving: %w", err)
+		}
+		if b, err = c.Get(b.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// isBeanMerged reports whether a bean's work has landed in the base branch,
+// either via a merged pull request or a merged branch.
+func (c *Core) isBeanMerged(b *bean.Bean, baseBranch string) (bool, *plumbing.Hash) {
+	if b.GitPRState == string(gitflow.PRStateMerged) {
+		return true, nil
+	}
+	merged, hash, err := c.gitFlow.IsBranchMerged(b.GitBranch, baseBranch)
+	if err != nil {
+		return false, nil
+	}
+	return merged, hash
+}
+
+// NextStatus returns the status after b's in workflow order (see
+// config.Config.WorkflowStatuses). It fails if b's status is the last step or
+// outside the workflow (scrapped), and if b is blocked by active beans and the
+// next status is past the default one: blocked beans can be readied, but not
+// started or finished.
+func (c *Core) NextStatus(b *bean.Bean) (string, error) {
+	if c.config == nil {
+		return "", fmt.Errorf("no workflow configured")
+	}
+	workflow := c.config.WorkflowStatuses()
+	i := slices.Index(workflow, b.Status)
+	if i < 0 {
+		return "", fmt.Errorf("%s is %s, which has no next status", b.ID, b.Status)
+	}
+	if i == len(workflow)-1 {
+		return "", fmt.Errorf("%s is already %s", b.ID, b.Status)
+	}
+	next := workflow[i+1]
+
+	if i >= slices.Index(workflow, c.config.GetDefaultStatus()) {
+		if blockers := c.FindActiveBlockers(b.ID); len(blockers) > 0 {
+			ids := make([]string, len(blockers))
+			for j, blocker := range blockers {
+				ids[j] = blocker.ID
+			}
+			slices.Sort(ids)
+			return "", fmt.Errorf("%s can't be %s while blocked by %s", b.ID, next, strings.Join(ids, ", "))
+		}
+	}
+	return next, nil
+}
@@ -0,0 +1,226 @@
+package beancore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hmans/beans/internal/bean"
+)
+
+// startTestBean creates and commits a bean, then starts it.
+func startTestBean(t *testing.T, core *Core, id string, repo *git.Repository) *bean.Bean {
+	t.Helper()
+	if err := core.Create(&bean.Bean{ID: id, Slug: "work", Title: "Work", Status: "todo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	w, _ := repo.Worktree()
+	w.Add(".beans")
+	w.Commit("Add bean", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test", Email: "test@example.com"},
+	})
+
+	b, err := core.StartBean(id, true)
+	if err != nil {
+		t.Fatalf("StartBean() error = %v", err)
+	}
+	return b
+}
+
+// commitWork commits a file and all bean changes on the current branch.
+func commitWork(t *testing.T, repo *git.Repository, repoPath string) plumbing.Hash {
+	t.Helper()
+	w, _ := repo.Worktree()
+	os.WriteFile(filepath.Join(repoPath, "work.txt"), []byte("work"), 0644)
+	w.Add("work.txt")
+	w.Add(".beans")
+	hash, err := w.Commit("Do work", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test", Email: "test@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	return hash
+}
+
+func TestStartBean(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	repo, _ := git.PlainOpen(repoPath)
+
+	// Leaf beans get a branch too when started explicitly
+	b := startTestBean(t, core, "beans-leaf1", repo)
+	if b.Status != "in-progress" {
+		t.Errorf("Status = %q, want %q", b.Status, "in-progress")
+	}
+	if b.GitBranch != "beans-leaf1/work" {
+		t.Errorf("GitBranch = %q, want %q", b.GitBranch, "beans-leaf1/work")
+	}
+	head, _ := repo.Head()
+	if head.Name().Short() != "beans-leaf1/work" {
+		t.Errorf("current branch = %q, want %q", head.Name().Short(), "beans-leaf1/work")
+	}
+}
+
+func TestStartBean_NoBranch(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	repo, _ := git.PlainOpen(repoPath)
+
+	if err := core.Create(&bean.Bean{ID: "beans-leaf1", Title: "Work", Status: "todo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	b, err := core.StartBean("beans-leaf1", false)
+	if err != nil {
+		t.Fatalf("StartBean() error = %v", err)
+	}
+	if b.Status != "in-progress" || b.GitBranch != "" {
+		t.Errorf("StartBean() = status %q, branch %q; want in-progress without branch", b.Status, b.GitBranch)
+	}
+	head, _ := repo.Head()
+	if head.Name().Short() != "main" {
+		t.Errorf("current branch = %q, want main", head.Name().Short())
+	}
+}
+
+func TestBranchBean(t *testing.T) {
+	core, _, repoPath := setupTestCoreWithGit(t)
+	repo, _ := git.PlainOpen(repoPath)
+
+	if err := core.Create(&bean.Bean{ID: "beans-leaf1", Slug: "work", Title: "Work", Status: "todo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	b, err := core.BranchBean("beans-leaf1")
+	if err != nil {
+		t.Fatalf("BranchBean() error = %v", err)
+	}
+	if b.Status != "todo" || b.GitBranch != "beans-leaf1/work" || b.GitCreatedAt == nil {
+		t.Errorf("BranchBean() = status %q, branch %q; want todo on beans-leaf1/work", b.Status, b.GitBranch)
+	}
+	head, _ := repo.Head()
+	if head.Name().Short() != "beans-leaf1/work" {
+		t.Errorf("current branch = %q, want beans-leaf1/work", head.Name().Short())
+	}
+
+	// Branching again switches back to the existing branch
+	w, _ := repo.Worktree()
+	if err := w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("main"), Keep: true}); err != nil {
+		t.Fatalf("checkout main: %v", err)
+	}
+	if _, err := core.BranchBean("beans-leaf1"); err != nil {
+		t.Fatalf("BranchBean() again error = %v", err)
+	}
+	if head, _ := repo.Head(); head.Name().Short() != "beans-leaf1/work" {
+		t.Errorf("current branch = %q, want beans-leaf1/work", head.Name().Short())
+	}
+}
+
+func TestFinishBean(t *testing.T) {
+	t.Run("unmerged branch is refused", func(t *testing.T) {
+		core, _, repoPath := setupTestCoreWithGit(t)
+		repo, _ := git.PlainOpen(repoPath)
+		startTestBean(t, core, "beans-leaf1", repo)
+		commitWork(t, repo, repoPath)
+
+		if _, err := core.FinishBean("beans-leaf1", FinishOptions{}); err == nil {
+			t.Fatal("FinishBean() should fail for unmerged branch")
+		}
+		b, _ := core.Get("beans-leaf1")
+		if b.Status != "in-progress" {
+			t.Errorf("Status = %q, want unchanged", b.Status)
+		}
+	})
+
+	t.Run("force finishes unmerged branch", func(t *testing.T) {
+		core, _, repoPath := setupTestCoreWithGit(t)
+		repo, _ := git.PlainOpen(repoPath)
+		startTestBean(t, core, "beans-leaf1", repo)
+		commitWork(t, repo, repoPath)
+
+		b, err := core.FinishBean("beans-leaf1", FinishOptions{Force: true})
+		if err != nil {
+			t.Fatalf("FinishBean() error = %v", err)
+		}
+		if b.Status != "completed" {
+			t.Errorf("Status = %q, want completed", b.Status)
+		}
+		if b.GitMergedAt != nil {
+			t.Error("GitMergedAt should not be set for unmerged branch")
+		}
+	})
+
+	t.Run("merged branch returns to base and archives", func(t *testing.T) {
+		core, _, repoPath := setupTestCoreWithGit(t)
+		repo, _ := git.PlainOpen(repoPath)
+		startTestBean(t, core, "beans-leaf1", repo)
+		workCommit := commitWork(t, repo, repoPath)
+
+		// Fast-forward main to the work commit
+		repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("main"), workCommit))
+
+		b, err := core.FinishBean("beans-leaf1", FinishOptions{Archive: true})
+		if err != nil {
+			t.Fatalf("FinishBean() error = %v", err)
+		}
+		if b.Status != "completed" {
+			t.Errorf("Status = %q, want completed", b.Status)
+		}
+		if b.GitMergedAt == nil {
+			t.Error("GitMergedAt should be set")
+		}
+		if !core.IsArchived("beans-leaf1") {
+			t.Error("bean should be archived")
+		}
+		head, _ := repo.Head()
+		if head.Name().Short() != "main" {
+			t.Errorf("current branch = %q, want main", head.Name().Short())
+		}
+	})
+}
+
+func TestNextStatus(t *testing.T) {
+	core, _ := setupTestCore(t)
+	createTestBean(t, core, "draft", "Draft", "draft")
+	createTestBean(t, core, "todo", "Todo", "todo")
+	createTestBean(t, core, "done", "Done", "completed")
+	createTestBean(t, core, "gone", "Gone", "scrapped")
+	if err := core.Create(&bean.Bean{ID: "blocker", Title: "Blocker", Status: "todo", Blocking: []string{"todo", "draft"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	next := func(id string) (string, error) {
+		b, err := core.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return core.NextStatus(b)
+	}
+
+	// Blocked beans can be readied, but not started
+	if got, err := next("draft"); got != "todo" || err != nil {
+		t.Errorf("NextStatus(draft) = %q, %v; want todo", got, err)
+	}
+	if _, err := next("todo"); err == nil || !strings.Contains(err.Error(), "blocked by blocker") {
+		t.Errorf("NextStatus(blocked todo) error = %v, want blocked by blocker", err)
+	}
+	if got, err := next("blocker"); got != "in-progress" || err != nil {
+		t.Errorf("NextStatus(todo) = %q, %v; want in-progress", got, err)
+	}
+	for _, id := range []string{"done", "gone"} {
+		if got, err := next(id); err == nil {
+			t.Errorf("NextStatus(%s) = %q, want an error", id, got)
+		}
+	}
+
+	// Finishing the blocker unblocks the bean
+	blocker, _ := core.Get("blocker")
+	blocker.Status = "completed"
+	if err := core.Update(blocker, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := next("todo"); got != "in-progress" || err != nil {
+		t.Errorf("NextStatus(unblocked todo) = %q, %v; want in-progress", got, err)
+	}
+}
@@ -0,0 +1,221 @@
+// Package bench times the Core operations that beans' commands are built on.
+// The same cases back the go test benchmarks (on generated beans) and the
+// hidden `beans bench` command (on a real repository).
+package bench
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// Case is a timed operation and the time it may take per run.
+type Case struct {
+	Name string
+	// Budget returns how long one run may take with the given number of beans.
+	Budget func(beans int) time.Duration
+	Run    func(b *testing.B)
+}
+
+// perBean is a budget growing with the number of beans.
+func perBean(d time.Duration) func(int) time.Duration {
+	return func(beans int) time.Duration { return time.Duration(max(beans, 1)) * d }
+}
+
+// flat is a budget independent of the number of beans.
+func flat(d time.Duration) func(int) time.Duration {
+	return func(int) time.Duration { return d }
+}
+
+// Cases returns the cases for a loaded core: Load, All, Get, filter, tree
+// and search.
+func Cases(core *beancore.Core) []Case {
+	cfg := core.Config()
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	sortFn := func(beans []*bean.Bean) {
+		bean.SortByStatusPriorityAndType(beans, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	}
+	filter := &model.BeanFilter{
+		Status:   []string{"todo", "in-progress"},
+		Priority: []string{"high", "critical"},
+		Tags:     []string{"backend"},
+	}
+
+	return []Case{
+		{
+			Name:   "load",
+			Budget: perBean(200 * time.Microsecond),
+			Run: func(b *testing.B) {
+				for b.Loop() {
+					if err := core.Load(); err != nil {
+						b.Fatal(err)
+					}
+				}
+			},
+		},
+		{
+			Name:   "all",
+			Budget: perBean(200 * time.Nanosecond),
+			Run: func(b *testing.B) {
+				for b.Loop() {
+					core.All()
+				}
+			},
+		},
+		{
+			Name:   "get",
+			Budget: flat(5 * time.Microsecond),
+			Run: func(b *testing.B) {
+				ids := beanIDs(core)
+				if len(ids) == 0 {
+					b.Skip("no beans")
+				}
+				i := 0
+				for b.Loop() {
+					if _, err := core.Get(ids[i%len(ids)]); err != nil {
+						b.Fatal(err)
+					}
+					i++
+				}
+			},
+		},
+		{
+			Name:   "filter",
+			Budget: perBean(2 * time.Microsecond),
+			Run: func(b *testing.B) {
+				all := core.All()
+				for b.Loop() {
+					graph.ApplyFilter(all, filter, core)
+				}
+			},
+		},
+		{
+			Name:   "tree",
+			Budget: perBean(10 * time.Microsecond),
+			Run: func(b *testing.B) {
+				all := core.All()
+				for b.Loop() {
+					ui.BuildTree(all, all, sortFn)
+				}
+			},
+		},
+		{
+			Name:   "search",
+			Budget: flat(250 * time.Millisecond),
+			Run: func(b *testing.B) {
+				// Build the index before timing; it's built once per process
+				if _, err := core.Search("bench"); err != nil {
+					b.Fatal(err)
+				}
+				for b.Loop() {
+					if _, err := core.Search("login OR flaky"); err != nil {
+						b.Fatal(err)
+					}
+				}
+			},
+		},
+	}
+}
+
+// Result is the outcome of running a case.
+type Result struct {
+	Name        string        `json:"name"`
+	Runs        int           `json:"runs"`
+	NsPerOp     int64         `json:"ns_per_op"`
+	AllocsPerOp int64         `json:"allocs_per_op"`
+	BytesPerOp  int64         `json:"bytes_per_op"`
+	Budget      time.Duration `json:"budget_ns"`
+	OverBudget  bool          `json:"over_budget"`
+}
+
+// Run runs the cases against a repository of the given number of beans.
+func Run(cases []Case, beans int) []Result {
+	results := make([]Result, 0, len(cases))
+	for _, c := range cases {
+		r := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			c.Run(b)
+		})
+		budget := c.Budget(beans)
+		results = append(results, Result{
+			Name:        c.Name,
+			Runs:        r.N,
+			NsPerOp:     r.NsPerOp(),
+			AllocsPerOp: r.AllocsPerOp(),
+			BytesPerOp:  r.AllocedBytesPerOp(),
+			Budget:      budget,
+			OverBudget:  r.N > 0 && time.Duration(r.NsPerOp()) > budget,
+		})
+	}
+	return results
+}
+
+func beanIDs(core *beancore.Core) []string {
+	all := core.All()
+	ids := make([]string, len(all))
+	for i, b := range all {
+		ids[i] = b.ID
+	}
+	return ids
+}
+
+var (
+	benchStatuses   = []string{"todo", "in-progress", "completed", "draft", "scrapped"}
+	benchPriorities = []string{"critical", "high", "normal", "low", "deferred"}
+	benchTags       = []string{"backend", "frontend", "infra", "docs", "ux", "security"}
+	benchWords      = []string{"login", "search", "export", "cache", "report", "sync", "flaky", "billing"}
+)
+
+// Generate writes n beans to dir, a .beans directory: every tenth is an epic
+// parenting the nine after it, with statuses, priorities and tags spread
+// over the defaults.
+func Generate(dir string, n int) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var epic string
+	for i := range n {
+		b := &bean.Bean{
+			ID:        fmt.Sprintf("bench-%06d", i),
+			Slug:      "bench",
+			Status:    benchStatuses[i%len(benchStatuses)],
+			Type:      "task",
+			Priority:  benchPriorities[i/3%len(benchPriorities)],
+			Tags:      []string{benchTags[i%len(benchTags)], benchTags[i/7%len(benchTags)]},
+			CreatedAt: &created,
+			UpdatedAt: &created,
+		}
+		word := benchWords[i%len(benchWords)]
+		if i%10 == 0 {
+			b.Type = "epic"
+			b.Title = fmt.Sprintf("Bench epic %d: %s", i/10, word)
+			epic = b.ID
+		} else {
+			b.Title = fmt.Sprintf("Bench task %d: fix %s", i, word)
+			b.Parent = epic
+		}
+		b.Body = fmt.Sprintf("The %s flow fails when the %s step times out.\n\n- [ ] Reproduce\n- [ ] Fix\n",
+			word, benchWords[(i+3)%len(benchWords)])
+
+		content, err := b.Render()
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, bean.BuildFilename(b.ID, b.Slug)), content, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
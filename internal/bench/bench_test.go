@@ -0,0 +1,87 @@
+package bench
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+)
+
+func loadGenerated(tb testing.TB, n int) *beancore.Core {
+	tb.Helper()
+	dir := filepath.Join(tb.TempDir(), beancore.BeansDir)
+	if err := Generate(dir, n); err != nil {
+		tb.Fatalf("Generate() error = %v", err)
+	}
+	core := beancore.New(dir, config.Default())
+	core.SetLogger(nil)
+	if err := core.Load(); err != nil {
+		tb.Fatalf("Load() error = %v", err)
+	}
+	tb.Cleanup(func() { core.Close() })
+	return core
+}
+
+func TestGenerate(t *testing.T) {
+	core := loadGenerated(t, 25)
+
+	if got := len(core.All()); got != 25 {
+		t.Fatalf("loaded %d beans, want 25", got)
+	}
+	b, err := core.Get("bench-000013")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Parent != "bench-000010" || b.Type != "task" {
+		t.Errorf("bench-000013 parent = %q, type = %q; want bench-000010, task", b.Parent, b.Type)
+	}
+	found, err := core.Search("login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) == 0 {
+		t.Error("search for login found nothing")
+	}
+}
+
+func TestCases(t *testing.T) {
+	core := loadGenerated(t, 1)
+	seen := map[string]bool{}
+	for _, c := range Cases(core) {
+		if seen[c.Name] {
+			t.Errorf("case %s listed twice", c.Name)
+		}
+		seen[c.Name] = true
+		if c.Budget(1000) <= 0 {
+			t.Errorf("case %s has no budget", c.Name)
+		}
+	}
+	for _, name := range []string{"load", "all", "get", "filter", "tree", "search"} {
+		if !seen[name] {
+			t.Errorf("no %s case", name)
+		}
+	}
+}
+
+// BenchmarkCore runs every case at 1k, 10k and 100k beans (100k is skipped
+// with -short):
+//
+//	go test ./internal/bench -run '^$' -bench . -benchtime 10x
+func BenchmarkCore(b *testing.B) {
+	for _, n := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("beans=%d", n), func(b *testing.B) {
+			if n > 10_000 && testing.Short() {
+				b.Skip("skipping 100k beans in short mode")
+			}
+			core := loadGenerated(b, n)
+			for _, c := range Cases(core) {
+				b.Run(c.Name, func(b *testing.B) {
+					b.ReportAllocs()
+					c.Run(b)
+				})
+			}
+		})
+	}
+}
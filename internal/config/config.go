@@ -1,16 +1,30 @@
 package config
 
 import (
+	"fmt"
+	"maps"
+	"math"
 	"os"
+	"os/exec"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hmans/beans/internal/bean"
 	"gopkg.in/yaml.v3"
 )
 
 const (
 	// ConfigFileName is the name of the config file at project root
 	ConfigFileName = ".beans.yml"
+	// LocalConfigFileName is the name of the per-user config file next to
+	// the project config. It is not meant to be committed; its settings
+	// override the project's.
+	LocalConfigFileName = ".beans.local.yml"
 	// DefaultBeansPath is the default directory for storing beans
 	DefaultBeansPath = ".beans"
 	// LegacyConfigFile is the old config file location (deprecated)
@@ -47,6 +61,20 @@ var DefaultPriorities = []PriorityConfig{
 	{Name: "deferred", Color: "gray", Description: "Explicitly pushed back, avoid doing unless necessary"},
 }
 
+// DefaultLinkTypes defines the built-in typed links between beans, in addition
+// to the structural parent and blocking links. Projects can add more via
+// link_types in the config.
+var DefaultLinkTypes = []LinkTypeConfig{
+	{Name: "related", Reverse: "related", Description: "Loosely related work"},
+	{Name: "duplicates", Reverse: "duplicated by", Description: "Describes the same work as the target"},
+	{Name: "supersedes", Reverse: "superseded by", Description: "Replaces the target"},
+	{Name: "implements", Reverse: "implemented by", Description: "Delivers what the target describes"},
+}
+
+// reservedLinkTypes are the structural link types and body mentions, which
+// can't be redefined.
+var reservedLinkTypes = []string{"parent", "blocking", "blocked_by", "mentions"}
+
 // StatusConfig defines a single status with its display color.
 type StatusConfig struct {
 	Name        string `yaml:"name"`
@@ -69,6 +97,14 @@ type PriorityConfig struct {
 	Description string `yaml:"description,omitempty"`
 }
 
+// LinkTypeConfig defines a typed link between beans. Name labels the link
+// from the linking bean's side, Reverse from the target's side.
+type LinkTypeConfig struct {
+	Name        string `yaml:"name"`
+	Reverse     string `yaml:"reverse,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
 // Config holds the beans configuration.
 // Note: Statuses are no longer stored in config - they are hardcoded like types.
 type Config struct {
@@ -82,22 +118,886 @@ type Config struct {
 // BeansConfig defines settings for bean creation.
 type BeansConfig struct {
 	// Path is the path to the beans directory (relative to config file location)
-	Path           string    `yaml:"path,omitempty"`
-	Prefix         string    `yaml:"prefix"`
-	IDLength       int       `yaml:"id_length"`
-	DefaultStatus  string    `yaml:"default_status,omitempty"`
-	DefaultType    string    `yaml:"default_type,omitempty"`
-	RequireIfMatch bool      `yaml:"require_if_match,omitempty"`
-	Git            GitConfig `yaml:"git,omitempty"`
+	Path string `yaml:"path,omitempty"`
+	// FormatVersion is the bean file format version the project's files are
+	// in. Projects from before versioning have none (version 0); `beans
+	// migrate` upgrades them.
+	FormatVersion  int    `yaml:"format_version,omitempty"`
+	Prefix         string `yaml:"prefix"`
+	IDLength       int    `yaml:"id_length"`
+	DefaultStatus  string `yaml:"default_status,omitempty"`
+	DefaultType    string `yaml:"default_type,omitempty"`
+	RequireIfMatch bool   `yaml:"require_if_match,omitempty"`
+	// RenameOnSlugChange renames a bean's file when its title changes, so the
+	// filename keeps matching the title's slug.
+	RenameOnSlugChange bool      `yaml:"rename_on_slug_change,omitempty"`
+	Git                GitConfig `yaml:"git,omitempty"`
+	// IDScheme controls how new IDs are generated: "random" (default),
+	// "sequential" or "date". See GetIDScheme.
+	IDScheme string `yaml:"id_scheme,omitempty"`
+	// ArchiveLayout controls where archived beans go: "flat" (default,
+	// archive/<file>), "date" (archive/2025/01/<file>, by completion month) or
+	// "mirror" (archive/<original subdirectory>/<file>). See GetArchiveLayout.
+	ArchiveLayout string `yaml:"archive_layout,omitempty"`
+	// Ignore lists glob patterns for files and directories in the beans
+	// directory that aren't beans, like scratch notes. A pattern without a
+	// slash matches names at any depth (*.draft.md), one with a slash matches
+	// paths from the beans directory (notes/*.md), and a trailing slash only
+	// matches directories (drafts/). See IsIgnored.
+	Ignore []string `yaml:"ignore,omitempty"`
+	// IDUserPrefix adds the current user's handle to new IDs (e.g. "beans-alice-0001"),
+	// so contributors creating beans on parallel branches never collide.
+	IDUserPrefix bool `yaml:"id_user_prefix,omitempty"`
+	// PrefixMatching lets commands take any unique prefix of a bean's ID
+	// ("abc" for "beans-abc1"), not just the whole ID with or without the
+	// configured prefix. A prefix matching several beans is an error that
+	// lists them.
+	PrefixMatching bool `yaml:"prefix_matching,omitempty"`
+	// ChangelogTemplate is a custom Go template for `beans report changelog`,
+	// relative to the config file. Empty uses the built-in template.
+	ChangelogTemplate string `yaml:"changelog_template,omitempty"`
+	// LinkTypes adds typed links to the built-in DefaultLinkTypes; an entry
+	// with the name of a built-in type overrides it.
+	LinkTypes []LinkTypeConfig `yaml:"link_types,omitempty"`
+	// Required declares, per bean type, the fields and body sections beans of
+	// that type must have. Enforced on create and update.
+	Required map[string]RequiredConfig `yaml:"required,omitempty"`
+	// Tags is an optional registry of allowed tags. When set, beans can only
+	// be given registered tags; tags they already have are kept.
+	Tags []TagConfig `yaml:"tags,omitempty"`
+	// SLA sets, per priority and status, how long a bean may stay in that
+	// status before it breaches its SLA, e.g. critical: {todo: 2d}.
+	// Durations accept days and weeks (2d, 1w) as well as Go durations (36h).
+	SLA map[string]map[string]string `yaml:"sla,omitempty"`
+	// Aging escalates beans that sit in a status for too long. Rules are
+	// applied by `beans maintain` and `beans watch --aging`.
+	Aging []AgingRule `yaml:"aging,omitempty"`
+	// Scopes lists the components of a monorepo beans can be scoped to, as
+	// paths relative to the repository root (e.g. packages/api). An entry
+	// ending in /* stands for each directory directly below it. Used to infer
+	// a bean's scope from the files its branch changes.
+	Scopes []string `yaml:"scopes,omitempty"`
+	// Server configures `beans serve`.
+	Server ServerConfig `yaml:"server,omitempty"`
+	// Redact hides bean fields from JSON output and the GraphQL API.
+	Redact RedactConfig `yaml:"redact,omitempty"`
+	// Aliases maps legacy status and type names to current ones. Beans using
+	// them are read as if they used the current names; `beans check --fix`
+	// rewrites their files.
+	Aliases AliasesConfig `yaml:"aliases,omitempty"`
+	// Editor is the command the TUI opens bean files with (default $VISUAL,
+	// then $EDITOR). Best set per user in .beans.local.yml.
+	Editor string `yaml:"editor,omitempty"`
+	// AbsoluteDates shows dates (2006-01-02) instead of relative times
+	// ("2d ago") in bean lists and the TUI.
+	AbsoluteDates bool `yaml:"absolute_dates,omitempty"`
+	// PriorityOrder reorders priorities, most urgent first, for sorting and
+	// priority comparisons. Priorities it leaves out follow in their usual
+	// order (critical, high, normal, low, deferred).
+	PriorityOrder []string `yaml:"priority_order,omitempty"`
+	// URLTitles fetches the titles of linked pages for new beans.
+	URLTitles URLTitlesConfig `yaml:"url_titles,omitempty"`
+	// Secrets scans beans for credentials before they are written.
+	Secrets SecretsConfig `yaml:"secrets,omitempty"`
+	// Titles is the project's title policy, applied when beans are created.
+	Titles TitlesConfig `yaml:"titles,omitempty"`
+	// Lint configures the content rules `beans lint` checks.
+	Lint LintConfig `yaml:"lint,omitempty"`
+	// Sync configures two-way sync with external trackers (`beans sync <tracker>`).
+	Sync SyncConfig `yaml:"sync,omitempty"`
+}
+
+// TitleCaseSentence is the title case that capitalizes only a title's first
+// word, keeping words with capitals inside them (GitHub, API, iOS) as they are.
+const TitleCaseSentence = "sentence"
+
+// TitlesConfig is a title policy. New beans' titles have their whitespace
+// collapsed and are refused, with a suggestion, if they break the policy;
+// existing beans get suggestions instead (the titleSuggestion GraphQL field).
+type TitlesConfig struct {
+	// Case is "sentence", or empty to leave titles' case alone.
+	Case string `yaml:"case,omitempty"`
+	// MaxLength is the longest a title may be, in characters (0 for no limit).
+	MaxLength int `yaml:"max_length,omitempty"`
+	// ForbiddenPrefixes lists prefixes titles may not start with, matched
+	// case-insensitively, e.g. "WIP:".
+	ForbiddenPrefixes []string `yaml:"forbidden_prefixes,omitempty"`
+}
+
+// ValidateTitles checks the title policy and returns a list of errors.
+func (c *Config) ValidateTitles() []string {
+	var errs []string
+	titles := c.Beans.Titles
+	if titles.Case != "" && titles.Case != TitleCaseSentence {
+		errs = append(errs, fmt.Sprintf("titles.case: '%s' is not valid (use %s, or leave it empty)", titles.Case, TitleCaseSentence))
+	}
+	if titles.MaxLength < 0 {
+		errs = append(errs, fmt.Sprintf("titles.max_length: %d must not be negative", titles.MaxLength))
+	}
+	for _, prefix := range titles.ForbiddenPrefixes {
+		if strings.TrimSpace(prefix) == "" {
+			errs = append(errs, "titles.forbidden_prefixes: prefixes cannot be empty")
+		}
+	}
+	return errs
+}
+
+// Lint rules checked by `beans lint`.
+const (
+	// LintEmptyBody flags beans other than tasks without a description.
+	LintEmptyBody = "empty-body"
+	// LintAcceptanceCriteria flags features without an "Acceptance Criteria"
+	// section.
+	LintAcceptanceCriteria = "acceptance-criteria"
+	// LintTitleLength flags titles longer than lint.max_title_length.
+	LintTitleLength = "title-length"
+	// LintTagFormat flags tags that aren't lowercase, hyphenated words.
+	LintTagFormat = "tag-format"
+)
+
+// LintRules lists all lint rules, in the order they are reported.
+var LintRules = []string{LintEmptyBody, LintAcceptanceCriteria, LintTitleLength, LintTagFormat}
+
+// Lint rule levels: disabled, reported, or reported and failing `beans lint`.
+const (
+	LintOff   = "off"
+	LintWarn  = "warn"
+	LintError = "error"
+)
+
+// DefaultLintLevels are the levels of rules lint.rules doesn't mention.
+var DefaultLintLevels = map[string]string{
+	LintEmptyBody:          LintWarn,
+	LintAcceptanceCriteria: LintWarn,
+	LintTitleLength:        LintWarn,
+	LintTagFormat:          LintError,
+}
+
+// DefaultMaxTitleLength is the title length budget when
+// lint.max_title_length isn't set.
+const DefaultMaxTitleLength = 72
+
+// LintConfig configures `beans lint`.
+type LintConfig struct {
+	// Rules sets the level of each rule: "off", "warn" or "error", e.g.
+	// title-length: error. Rules not listed use DefaultLintLevels.
+	Rules map[string]string `yaml:"rules,omitempty"`
+	// MaxTitleLength is the title length budget, in characters (default
+	// titles.max_length if set, else 72).
+	MaxTitleLength int `yaml:"max_title_length,omitempty"`
+}
+
+// Level returns the level of a lint rule.
+func (l LintConfig) Level(rule string) string {
+	if level, ok := l.Rules[rule]; ok {
+		return level
+	}
+	return DefaultLintLevels[rule]
+}
+
+// GetMaxTitleLength returns the title length budget, defaulting to
+// DefaultMaxTitleLength.
+func (l LintConfig) GetMaxTitleLength() int {
+	if l.MaxTitleLength <= 0 {
+		return DefaultMaxTitleLength
+	}
+	return l.MaxTitleLength
+}
+
+// ValidateLint checks the lint settings and returns a list of errors.
+func (c *Config) ValidateLint() []string {
+	var errs []string
+	for _, rule := range slices.Sorted(maps.Keys(c.Beans.Lint.Rules)) {
+		if !slices.Contains(LintRules, rule) {
+			errs = append(errs, fmt.Sprintf("lint.rules: unknown rule '%s' (use %s)", rule, strings.Join(LintRules, ", ")))
+			continue
+		}
+		if l := c.Beans.Lint.Rules[rule]; l != LintOff && l != LintWarn && l != LintError {
+			errs = append(errs, fmt.Sprintf("lint.rules.%s: '%s' is not valid (use %s, %s or %s)", rule, l, LintOff, LintWarn, LintError))
+		}
+	}
+	if c.Beans.Lint.MaxTitleLength < 0 {
+		errs = append(errs, fmt.Sprintf("lint.max_title_length: %d must not be negative", c.Beans.Lint.MaxTitleLength))
+	}
+	return errs
+}
+
+// Secret scanning modes: when a bean about to be written looks like it
+// contains a credential, nothing happens, a warning is logged, or the write
+// is refused.
+const (
+	SecretsOff   = "off"
+	SecretsWarn  = "warn"
+	SecretsBlock = "block"
+)
+
+// SecretsConfig configures scanning beans for credentials (AWS keys, API
+// tokens, private keys, ...) before they are written, so they don't get
+// committed along with them.
+type SecretsConfig struct {
+	// Mode is "off" (default), "warn" or "block".
+	Mode string `yaml:"mode,omitempty"`
+	// Patterns adds regular expressions to look for, e.g. for in-house token
+	// formats.
+	Patterns []string `yaml:"patterns,omitempty"`
+}
+
+// GetMode returns the scanning mode, defaulting to SecretsOff.
+func (s SecretsConfig) GetMode() string {
+	if s.Mode == "" {
+		return SecretsOff
+	}
+	return s.Mode
+}
+
+// ValidateSecrets checks the secrets settings and returns a list of errors.
+func (c *Config) ValidateSecrets() []string {
+	var errs []string
+	secrets := c.Beans.Secrets
+	if m := secrets.GetMode(); m != SecretsOff && m != SecretsWarn && m != SecretsBlock {
+		errs = append(errs, fmt.Sprintf("secrets.mode: '%s' is not valid (use %s, %s or %s)", m, SecretsOff, SecretsWarn, SecretsBlock))
+	}
+	for _, pattern := range secrets.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Sprintf("secrets.patterns: '%s' is not a valid regular expression: %s", pattern, err))
+		}
+	}
+	return errs
+}
+
+// DefaultURLTitleTimeout is how long fetching a page's title may take when
+// url_titles.timeout isn't set.
+const DefaultURLTitleTimeout = 5 * time.Second
+
+// URLTitlesConfig configures fetching page titles for `beans create`. When
+// enabled, a bean created without a title from a --url, or from a body
+// containing a link, is titled after the linked page.
+type URLTitlesConfig struct {
+	// Enabled opts in to fetching pages; nothing is fetched otherwise.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// Timeout limits how long fetching a page may take, as a Go duration
+	// (default 5s).
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// GetTimeout returns the fetch timeout, falling back to
+// DefaultURLTitleTimeout if it isn't set or invalid.
+func (u URLTitlesConfig) GetTimeout() time.Duration {
+	if d, err := time.ParseDuration(u.Timeout); err == nil && d > 0 {
+		return d
+	}
+	return DefaultURLTitleTimeout
+}
+
+// ValidateURLTitles checks the url_titles settings and returns a list of errors.
+func (c *Config) ValidateURLTitles() []string {
+	timeout := c.Beans.URLTitles.Timeout
+	if timeout == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(timeout); err != nil || d <= 0 {
+		return []string{fmt.Sprintf("url_titles.timeout: '%s' is not a valid duration (e.g. 5s)", timeout)}
+	}
+	return nil
+}
+
+// SyncConfig configures two-way sync with external trackers.
+type SyncConfig struct {
+	GitHubProject GitHubProjectConfig `yaml:"github_project,omitempty"`
+	Linear        LinearConfig        `yaml:"linear,omitempty"`
+}
+
+// Conflict rules for two-way sync: when a bean and its remote item both
+// changed since the last sync, the newest change wins, or the user is asked.
+const (
+	ConflictsNewest = "newest"
+	ConflictsPrompt = "prompt"
+)
+
+// DefaultGitHubProjectColumns maps statuses to the columns of GitHub's
+// default board template.
+var DefaultGitHubProjectColumns = map[string]string{
+	"draft":       "Todo",
+	"todo":        "Todo",
+	"in-progress": "In Progress",
+	"completed":   "Done",
+}
+
+// GitHubProjectConfig configures `beans sync github-project`.
+type GitHubProjectConfig struct {
+	// Owner is the user or organization the project belongs to.
+	Owner string `yaml:"owner,omitempty"`
+	// Project is the project's number, used when --project isn't given.
+	Project int `yaml:"project,omitempty"`
+	// StatusField is the single select field holding the board column
+	// (default "Status").
+	StatusField string `yaml:"status_field,omitempty"`
+	// Columns maps bean statuses to column names. Cards in a column no status
+	// maps to keep their bean's status; several statuses may share a column,
+	// in which case cards moved there get the first of them in status order.
+	// Empty means DefaultGitHubProjectColumns.
+	Columns map[string]string `yaml:"columns,omitempty"`
+	// Conflicts is the conflict rule: "newest" (default) or "prompt".
+	Conflicts string `yaml:"conflicts,omitempty"`
+}
+
+// GetStatusField returns the project's column field name.
+func (g GitHubProjectConfig) GetStatusField() string {
+	if g.StatusField == "" {
+		return "Status"
+	}
+	return g.StatusField
+}
+
+// GetColumns returns the status to column mapping.
+func (g GitHubProjectConfig) GetColumns() map[string]string {
+	if len(g.Columns) == 0 {
+		return DefaultGitHubProjectColumns
+	}
+	return g.Columns
+}
+
+// GetConflicts returns the conflict rule, defaulting to ConflictsNewest.
+func (g GitHubProjectConfig) GetConflicts() string {
+	if g.Conflicts == "" {
+		return ConflictsNewest
+	}
+	return g.Conflicts
+}
+
+// DefaultLinearStates maps statuses to the types of Linear's workflow states.
+var DefaultLinearStates = map[string]string{
+	"draft":       "backlog",
+	"todo":        "unstarted",
+	"in-progress": "started",
+	"completed":   "completed",
+	"scrapped":    "canceled",
+}
+
+// DefaultLinearPriorities maps priorities to Linear's: 1 is urgent, 2 high,
+// 3 medium and 4 low.
+var DefaultLinearPriorities = map[string]int{
+	"critical": 1,
+	"high":     2,
+	"normal":   3,
+	"low":      4,
+	"deferred": 4,
+}
+
+// LinearConfig configures `beans sync linear`.
+type LinearConfig struct {
+	// Team is the key of the team whose issues are synced (e.g. "ENG").
+	Team string `yaml:"team,omitempty"`
+	// Token is a Linear API key. Set it in .beans.local.yml, which isn't
+	// committed.
+	Token string `yaml:"token,omitempty"`
+	// States maps bean statuses to the names or types of the team's
+	// workflow states; statuses mapped to a type use the first state of that
+	// type. Issues in a state no status maps to keep their bean's status.
+	// Empty means DefaultLinearStates.
+	States map[string]string `yaml:"states,omitempty"`
+	// Priorities maps bean priorities to Linear's (0 to 4, 0 being none).
+	// Issues without a priority are normal, unless a priority maps to 0.
+	// Empty means DefaultLinearPriorities.
+	Priorities map[string]int `yaml:"priorities,omitempty"`
+	// Conflicts is the conflict rule: "newest" (default) or "prompt".
+	Conflicts string `yaml:"conflicts,omitempty"`
+}
+
+// GetStates returns the status to workflow state mapping.
+func (l LinearConfig) GetStates() map[string]string {
+	if len(l.States) == 0 {
+		return DefaultLinearStates
+	}
+	return l.States
+}
+
+// GetPriorities returns the priority mapping.
+func (l LinearConfig) GetPriorities() map[string]int {
+	if len(l.Priorities) == 0 {
+		return DefaultLinearPriorities
+	}
+	return l.Priorities
+}
+
+// GetConflicts returns the conflict rule, defaulting to ConflictsNewest.
+func (l LinearConfig) GetConflicts() string {
+	if l.Conflicts == "" {
+		return ConflictsNewest
+	}
+	return l.Conflicts
+}
+
+// AliasesConfig maps alias names to the status or type they stand for.
+type AliasesConfig struct {
+	// Statuses replaces bean.LegacyStatuses when set; set it to {} to
+	// disable the built-in aliases.
+	Statuses map[string]string `yaml:"statuses,omitempty"`
+	Types    map[string]string `yaml:"types,omitempty"`
+}
+
+// RedactConfig lists bean fields (by their JSON names, e.g. body or
+// git_pr_url) to hide from --json output, beans query and beans serve.
+type RedactConfig struct {
+	// Exclude lists fields that are left out entirely.
+	Exclude []string `yaml:"exclude,omitempty"`
+	// Mask lists fields whose values are replaced with "[redacted]".
+	Mask []string `yaml:"mask,omitempty"`
+}
+
+// RedactableFields lists the bean fields that can be excluded or masked.
+var RedactableFields = []string{
+	"slug", "path", "title", "status", "type", "priority", "points", "tags",
+	"created_at", "updated_at", "body", "parent", "blocking", "blocked_by", "scope",
+	"git_branch", "git_created_at", "git_merged_at", "git_merge_commit",
+	"git_pr_url", "git_pr_state", "status_history", "rank", "links", "external_ids",
+	"aliases", "source_url", "etag",
+}
+
+// IsEmpty returns true if nothing is redacted.
+func (r RedactConfig) IsEmpty() bool {
+	return len(r.Exclude) == 0 && len(r.Mask) == 0
 }
 
+// IsExcluded returns true if the field is left out.
+func (r RedactConfig) IsExcluded(field string) bool {
+	return slices.Contains(r.Exclude, field)
+}
+
+// IsMasked returns true if the field's value is masked.
+func (r RedactConfig) IsMasked(field string) bool {
+	return slices.Contains(r.Mask, field)
+}
+
+// Redaction returns the redaction to apply to bean JSON output.
+func (r RedactConfig) Redaction() bean.Redaction {
+	return bean.Redaction{Exclude: r.Exclude, Mask: r.Mask}
+}
+
+// ServerConfig defines settings for the GraphQL HTTP server.
+type ServerConfig struct {
+	// Addr is the address to listen on (default 127.0.0.1:7373).
+	Addr string `yaml:"addr,omitempty"`
+	// Introspection allows GraphQL schema introspection, which tools like
+	// GraphiQL need. Off by default.
+	Introspection bool `yaml:"introspection,omitempty"`
+	// ReadOnly rejects mutations, for exposing dashboards without risking writes.
+	ReadOnly bool `yaml:"read_only,omitempty"`
+	// Auth requires clients to authenticate with a bearer token.
+	Auth AuthConfig `yaml:"auth,omitempty"`
+	// RateLimit limits how fast each client may send requests.
+	RateLimit RateLimitConfig `yaml:"rate_limit,omitempty"`
+}
+
+// RateLimitConfig limits requests per client (by IP address) with a token
+// bucket. Rate limiting is off when RequestsPerSecond is 0.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate allowed.
+	RequestsPerSecond float64 `yaml:"requests_per_second,omitempty"`
+	// Burst is how many requests may be sent at once (default: one
+	// second's worth, at least 1).
+	Burst int `yaml:"burst,omitempty"`
+}
+
+// GetBurst returns the burst size, defaulting to one second's worth of requests.
+func (r RateLimitConfig) GetBurst() int {
+	if r.Burst > 0 {
+		return r.Burst
+	}
+	return max(1, int(math.Ceil(r.RequestsPerSecond)))
+}
+
+// Auth scopes: read allows queries, write also allows mutations.
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// DefaultOIDCWriteScope is the OAuth scope that grants OIDC clients write access.
+const DefaultOIDCWriteScope = "beans:write"
+
+// AuthConfig configures bearer token authentication for `beans serve`.
+// Static tokens and OIDC can be combined; with neither, no auth is required.
+type AuthConfig struct {
+	// Tokens are static bearer tokens, each with its own scope.
+	Tokens []AuthToken `yaml:"tokens,omitempty"`
+	// OIDC accepts JWTs issued by an OpenID Connect provider.
+	OIDC *OIDCConfig `yaml:"oidc,omitempty"`
+}
+
+// AuthToken is a static bearer token. The token itself is best kept out of
+// the config file: TokenEnv names an environment variable that holds it.
+type AuthToken struct {
+	// Name identifies the token in logs and error messages.
+	Name     string `yaml:"name"`
+	Token    string `yaml:"token,omitempty"`
+	TokenEnv string `yaml:"token_env,omitempty"`
+	// Scope is read or write (default read).
+	Scope string `yaml:"scope,omitempty"`
+}
+
+// Value returns the token, reading it from TokenEnv if set.
+func (t AuthToken) Value() string {
+	if t.TokenEnv != "" {
+		return os.Getenv(t.TokenEnv)
+	}
+	return t.Token
+}
+
+// GetScope returns the token's scope, defaulting to read.
+func (t AuthToken) GetScope() string {
+	if t.Scope == "" {
+		return ScopeRead
+	}
+	return t.Scope
+}
+
+// OIDCConfig validates JWT bearer tokens against an OpenID Connect issuer.
+// Valid tokens get read access; tokens granted WriteScope get write access.
+type OIDCConfig struct {
+	// Issuer is the provider's issuer URL; its signing keys are discovered
+	// from <issuer>/.well-known/openid-configuration.
+	Issuer string `yaml:"issuer"`
+	// Audience must appear in the token's aud claim.
+	Audience string `yaml:"audience"`
+	// WriteScope is the scope (in the scope or scp claim) that grants write
+	// access (default beans:write).
+	WriteScope string `yaml:"write_scope,omitempty"`
+}
+
+// GetWriteScope returns the scope that grants write access.
+func (o OIDCConfig) GetWriteScope() string {
+	if o.WriteScope == "" {
+		return DefaultOIDCWriteScope
+	}
+	return o.WriteScope
+}
+
+// IsEnabled returns true if clients must authenticate.
+func (a AuthConfig) IsEnabled() bool {
+	return len(a.Tokens) > 0 || a.OIDC != nil
+}
+
+// DefaultServerAddr is the address `beans serve` listens on by default.
+const DefaultServerAddr = "127.0.0.1:7373"
+
+// GetServerAddr returns the address to serve on, falling back to DefaultServerAddr.
+func (c *Config) GetServerAddr() string {
+	if c.Beans.Server.Addr == "" {
+		return DefaultServerAddr
+	}
+	return c.Beans.Server.Addr
+}
+
+// AgingRule escalates beans that have been in one of Statuses for at least
+// After. Rules only ever raise priority and add tags, so applying them again
+// changes nothing.
+type AgingRule struct {
+	// Statuses the rule applies to (default: todo and draft).
+	Statuses []string `yaml:"statuses,omitempty"`
+	// After is how long a bean must have been in its status, e.g. 14d.
+	After string `yaml:"after"`
+	// Priority raises the bean's priority to at least this level.
+	Priority string `yaml:"priority,omitempty"`
+	// Tag is added to the bean, e.g. "aging".
+	Tag string `yaml:"tag,omitempty"`
+}
+
+// DefaultAgingStatuses are the statuses aging rules apply to by default.
+var DefaultAgingStatuses = []string{"todo", "draft"}
+
+// AppliesTo returns true if the rule covers the given status.
+func (r AgingRule) AppliesTo(status string) bool {
+	if len(r.Statuses) == 0 {
+		return slices.Contains(DefaultAgingStatuses, status)
+	}
+	return slices.Contains(r.Statuses, status)
+}
+
+// TagConfig defines a registered tag.
+type TagConfig struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// RequiredConfig lists what beans of a type must have.
+type RequiredConfig struct {
+	// Fields are fields that must be set (see RequirableFields).
+	Fields []string `yaml:"fields,omitempty"`
+	// Sections are markdown headings the body must contain, e.g. "Repro Steps".
+	Sections []string `yaml:"sections,omitempty"`
+}
+
+// RequirableFields lists the fields that can be required per type.
+var RequirableFields = []string{"status", "priority", "points", "tags", "scope", "parent", "blocking", "blocked_by", "links", "body"}
+
 // GitConfig defines settings for git integration.
 type GitConfig struct {
-	Enabled          bool   `yaml:"enabled"`
-	AutoCreateBranch bool   `yaml:"auto_create_branch"`
-	AutoCommitBeans  bool   `yaml:"auto_commit_beans"`
-	BaseBranch       string `yaml:"base_branch,omitempty"`
-	RequireMerge     bool   `yaml:"require_merge"`
+	Enabled          bool `yaml:"enabled"`
+	AutoCreateBranch bool `yaml:"auto_create_branch"`
+	// BranchFor selects the beans auto_create_branch creates branches for:
+	// "parents" (default, beans with children), "all", or "types:feature,bug"
+	// for beans of the listed types.
+	BranchFor       string `yaml:"branch_for,omitempty"`
+	AutoCommitBeans bool   `yaml:"auto_commit_beans"`
+	BaseBranch      string `yaml:"base_branch,omitempty"`
+	RequireMerge    bool   `yaml:"require_merge"`
+	// AutoStash stashes uncommitted changes around branch switches instead of
+	// failing on a dirty working tree, and restores them afterwards.
+	AutoStash bool `yaml:"auto_stash,omitempty"`
+	// BranchTemplate names bean branches, e.g. "feature/{{.Type}}/{{.ID}}-{{.Slug}}".
+	// Empty means the default "{{.ID}}/{{.Slug}}".
+	BranchTemplate string `yaml:"branch_template,omitempty"`
+	// SyncHook controls what the post-merge/post-checkout hooks installed by
+	// `beans git install-hooks` do: "off" (default), "dry-run" or "apply".
+	SyncHook string `yaml:"sync_hook,omitempty"`
+	// MergeDetection lists the strategies used to detect merged branches, in
+	// order: "ancestry", "message", "tree" (squash/rebase merges by file
+	// content) and "remote" (also inspect origin/<branch>).
+	// Empty means ["ancestry", "message"].
+	MergeDetection []string `yaml:"merge_detection,omitempty"`
+}
+
+// Sync hook modes for GitConfig.SyncHook.
+const (
+	SyncHookOff    = "off"
+	SyncHookDryRun = "dry-run"
+	SyncHookApply  = "apply"
+)
+
+// IsValidSyncHook returns true if the given sync hook mode is recognized.
+// An empty mode is valid and means "off".
+func IsValidSyncHook(mode string) bool {
+	switch mode {
+	case "", SyncHookOff, SyncHookDryRun, SyncHookApply:
+		return true
+	}
+	return false
+}
+
+// GetSyncHook returns the configured sync hook mode.
+// Unset or unrecognized values are treated as "off".
+func (c *Config) GetSyncHook() string {
+	mode := c.Beans.Git.SyncHook
+	if mode == "" || !IsValidSyncHook(mode) {
+		return SyncHookOff
+	}
+	return mode
+}
+
+// Branch policies for GitConfig.BranchFor.
+const (
+	BranchForParents = "parents"
+	BranchForAll     = "all"
+	BranchForTypes   = "types"
+)
+
+// ParseBranchFor splits a branch_for setting into its policy and, for
+// "types:feature,bug" (or "types:[feature, bug]"), the listed types.
+// An empty setting means "parents".
+func ParseBranchFor(s string) (policy string, types []string, err error) {
+	switch s {
+	case "", BranchForParents:
+		return BranchForParents, nil, nil
+	case BranchForAll:
+		return BranchForAll, nil, nil
+	}
+	list, ok := strings.CutPrefix(s, BranchForTypes+":")
+	if !ok {
+		return "", nil, fmt.Errorf("'%s' is not a valid branch policy (use parents, all or types:<type>,...)", s)
+	}
+	list = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(list), "["), "]")
+	for _, t := range strings.Split(list, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return "", nil, fmt.Errorf("'%s' lists no types", s)
+	}
+	return BranchForTypes, types, nil
+}
+
+// WantsBranch returns true if auto_create_branch should create a branch for a
+// bean of the given type when it is started, according to branch_for.
+// Invalid settings fall back to "parents".
+func (c *Config) WantsBranch(beanType string, hasChildren bool) bool {
+	policy, types, err := ParseBranchFor(c.Beans.Git.BranchFor)
+	if err != nil {
+		policy = BranchForParents
+	}
+	switch policy {
+	case BranchForAll:
+		return true
+	case BranchForTypes:
+		return slices.Contains(types, beanType)
+	default:
+		return hasChildren
+	}
+}
+
+// ValidateBranchFor checks the branch_for setting and returns a description
+// of each problem.
+func (c *Config) ValidateBranchFor() []string {
+	_, types, err := ParseBranchFor(c.Beans.Git.BranchFor)
+	if err != nil {
+		return []string{"git.branch_for: " + err.Error()}
+	}
+	var errs []string
+	for _, t := range types {
+		if !c.IsValidType(t) {
+			errs = append(errs, fmt.Sprintf("git.branch_for: '%s' is not a valid type", t))
+		}
+	}
+	return errs
+}
+
+// ValidateScopes checks the scopes setting and returns a description of
+// each problem.
+func (c *Config) ValidateScopes() []string {
+	var errs []string
+	for _, entry := range c.Beans.Scopes {
+		clean := strings.Trim(path.Clean("/"+strings.TrimSpace(entry)), "/")
+		switch {
+		case clean == "":
+			errs = append(errs, fmt.Sprintf("scopes: '%s' is the repository root, not a scope", entry))
+		case path.IsAbs(entry) || strings.HasPrefix(entry, ".."):
+			errs = append(errs, fmt.Sprintf("scopes: '%s' must be relative to the repository root", entry))
+		case clean != "*" && strings.Contains(strings.TrimSuffix(clean, "/*"), "*"):
+			errs = append(errs, fmt.Sprintf("scopes: '%s' may only use * as its last element", entry))
+		}
+	}
+	return errs
+}
+
+// ID schemes for BeansConfig.IDScheme.
+const (
+	IDSchemeRandom     = "random"     // random characters, e.g. "beans-x7k2"
+	IDSchemeSequential = "sequential" // next free number, e.g. "beans-0042"
+	IDSchemeDate       = "date"       // creation date plus random characters, e.g. "beans-261015x7k2"
+)
+
+// IsValidIDScheme returns true if the given ID scheme is recognized.
+// An empty scheme is valid and means "random".
+func IsValidIDScheme(scheme string) bool {
+	switch scheme {
+	case "", IDSchemeRandom, IDSchemeSequential, IDSchemeDate:
+		return true
+	}
+	return false
+}
+
+// Archive layouts for BeansConfig.ArchiveLayout.
+const (
+	ArchiveLayoutFlat   = "flat"   // archive/<file>
+	ArchiveLayoutDate   = "date"   // archive/<year>/<month>/<file>
+	ArchiveLayoutMirror = "mirror" // archive/<original subdirectory>/<file>
+)
+
+// IsValidArchiveLayout returns true if the given archive layout is recognized.
+// An empty layout is valid and means "flat".
+func IsValidArchiveLayout(layout string) bool {
+	switch layout {
+	case "", ArchiveLayoutFlat, ArchiveLayoutDate, ArchiveLayoutMirror:
+		return true
+	}
+	return false
+}
+
+// GetArchiveLayout returns the configured archive layout.
+// Unset or unrecognized values are treated as "flat".
+func (c *Config) GetArchiveLayout() string {
+	if c.Beans.ArchiveLayout == "" || !IsValidArchiveLayout(c.Beans.ArchiveLayout) {
+		return ArchiveLayoutFlat
+	}
+	return c.Beans.ArchiveLayout
+}
+
+// IsIgnored reports whether a path in the beans directory (relative to it)
+// matches one of the ignore patterns, either itself or through one of the
+// directories it's in.
+func (c *Config) IsIgnored(rel string, isDir bool) bool {
+	if len(c.Beans.Ignore) == 0 {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	parts := strings.Split(rel, "/")
+	for i := range parts {
+		sub := strings.Join(parts[:i+1], "/")
+		subIsDir := isDir || i < len(parts)-1
+		for _, pattern := range c.Beans.Ignore {
+			if matchIgnorePattern(pattern, sub, subIsDir) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateIgnore returns an error message for each ignore pattern that is
+// empty or malformed.
+func (c *Config) ValidateIgnore() []string {
+	var errs []string
+	for _, pattern := range c.Beans.Ignore {
+		trimmed := strings.Trim(strings.TrimSpace(pattern), "/")
+		if trimmed == "" {
+			errs = append(errs, fmt.Sprintf("ignore: '%s' is an empty pattern", pattern))
+			continue
+		}
+		if _, err := path.Match(trimmed, ""); err != nil {
+			errs = append(errs, fmt.Sprintf("ignore: '%s' is not a valid pattern: %s", pattern, err))
+		}
+	}
+	return errs
+}
+
+// matchIgnorePattern matches a single ignore pattern against a path.
+func matchIgnorePattern(pattern, rel string, isDir bool) bool {
+	pattern = strings.TrimSpace(pattern)
+	if strings.HasSuffix(pattern, "/") {
+		if !isDir {
+			return false
+		}
+		pattern = strings.TrimRight(pattern, "/")
+	}
+	if pattern == "" {
+		return false
+	}
+	if strings.Contains(pattern, "/") {
+		ok, _ := path.Match(strings.TrimPrefix(pattern, "/"), rel)
+		return ok
+	}
+	ok, _ := path.Match(pattern, path.Base(rel))
+	return ok
+}
+
+// GetIDScheme returns the configured ID scheme.
+// Unset or unrecognized values are treated as "random".
+func (c *Config) GetIDScheme() string {
+	scheme := c.Beans.IDScheme
+	if scheme == "" || !IsValidIDScheme(scheme) {
+		return IDSchemeRandom
+	}
+	return scheme
+}
+
+// GetEditor returns the user's preferred editor command using the fallback
+// chain: the editor setting -> $VISUAL -> $EDITOR -> vi -> nano
+func (c *Config) GetEditor() string {
+	if c != nil && strings.TrimSpace(c.Beans.Editor) != "" {
+		return c.Beans.Editor
+	}
+	if editor := os.Getenv("VISUAL"); editor != "" {
+		return editor
+	}
+	if editor := os.Getenv("EDITOR"); editor != "" {
+		return editor
+	}
+	// Fallback chain: vi is more universal, nano as last resort
+	if _, err := exec.LookPath("vi"); err == nil {
+		return "vi"
+	}
+	return "nano"
 }
 
 // Default returns a Config with default values.
@@ -105,6 +1005,7 @@ func Default() *Config {
 	return &Config{
 		Beans: BeansConfig{
 			Path:          DefaultBeansPath,
+			FormatVersion: bean.CurrentFormatVersion,
 			Prefix:        "",
 			IDLength:      4,
 			DefaultStatus: "todo",
@@ -127,6 +1028,59 @@ func DefaultWithPrefix(prefix string) *Config {
 	return cfg
 }
 
+// UserDefaultsPath returns the path of the user-level defaults file applied
+// to new projects: $XDG_CONFIG_HOME/beans/config.yml, or
+// ~/.config/beans/config.yml if XDG_CONFIG_HOME isn't set.
+func UserDefaultsPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "beans", "config.yml"), nil
+}
+
+// PrefixDirPlaceholder is replaced with the project directory's name in a
+// prefix from the user defaults, e.g. "{dir}-".
+const PrefixDirPlaceholder = "{dir}"
+
+// NewProjectConfig returns the config for a new project in a directory with
+// the given name: the defaults (with prefix "<dirName>-"), overridden by the
+// user defaults file at userDefaultsPath if it exists. Pass an empty path to
+// skip user defaults.
+func NewProjectConfig(dirName, userDefaultsPath string) (*Config, error) {
+	cfg := DefaultWithPrefix(dirName + "-")
+	if userDefaultsPath == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(userDefaultsPath)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var base, user yaml.Node
+	if err := base.Encode(cfg); err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("%s: %w", userDefaultsPath, err)
+	}
+	if user.Kind == yaml.DocumentNode {
+		user = *user.Content[0]
+	}
+	merged := &Config{}
+	if err := mergeNodes(&base, &user).Decode(merged); err != nil {
+		return nil, fmt.Errorf("%s: %w", userDefaultsPath, err)
+	}
+	merged.Beans.Prefix = strings.ReplaceAll(merged.Beans.Prefix, PrefixDirPlaceholder, dirName)
+	return merged, nil
+}
+
 // FindConfig searches upward from the given directory for a .beans.yml config file.
 // Returns the absolute path to the config file, or empty string if not found.
 func FindConfig(startDir string) (string, error) {
@@ -152,6 +1106,12 @@ func FindConfig(startDir string) (string, error) {
 
 // Load reads configuration from the given config file path.
 // Returns default config if the file doesn't exist.
+//
+// Settings are taken, from lowest to highest precedence, from the defaults,
+// the config file, and the local config file (LocalConfigFileName) next to
+// it. The local file is merged key by key: nested settings it doesn't
+// mention keep the project's values, while lists and values it sets replace
+// them entirely.
 func Load(configPath string) (*Config, error) {
 	data, err := os.ReadFile(configPath)
 	if err != nil {
@@ -161,11 +1121,28 @@ func Load(configPath string) (*Config, error) {
 		return nil, err
 	}
 
-	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	localPath := filepath.Join(filepath.Dir(configPath), LocalConfigFileName)
+	if localData, err := os.ReadFile(localPath); err == nil {
+		var local yaml.Node
+		if err := yaml.Unmarshal(localData, &local); err != nil {
+			return nil, fmt.Errorf("%s: %w", LocalConfigFileName, err)
+		}
+		doc = *mergeNodes(&doc, &local)
+	} else if !os.IsNotExist(err) {
 		return nil, err
 	}
 
+	var cfg Config
+	if doc.Kind != 0 {
+		if err := doc.Decode(&cfg); err != nil {
+			return nil, err
+		}
+	}
+
 	// Store the config directory for resolving relative paths
 	cfg.configDir = filepath.Dir(configPath)
 
@@ -186,6 +1163,55 @@ func Load(configPath string) (*Config, error) {
 	return &cfg, nil
 }
 
+// mergeNodes merges the YAML document overlay into base: mappings are merged
+// key by key, anything else in overlay replaces what's in base. A null or
+// empty overlay (like `git:` with nothing after it) overrides nothing.
+func mergeNodes(base, overlay *yaml.Node) *yaml.Node {
+	switch {
+	case isNullNode(overlay):
+		return base
+	case isNullNode(base):
+		return overlay
+	case base.Kind == yaml.DocumentNode && overlay.Kind == yaml.DocumentNode:
+		merged := *base
+		merged.Content = []*yaml.Node{mergeNodes(base.Content[0], overlay.Content[0])}
+		return &merged
+	case base.Kind != yaml.MappingNode || overlay.Kind != yaml.MappingNode:
+		return overlay
+	}
+
+	merged := *base
+	merged.Content = slices.Clone(base.Content)
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+		found := false
+		for j := 0; j+1 < len(merged.Content); j += 2 {
+			if merged.Content[j].Value == key.Value {
+				merged.Content[j+1] = mergeNodes(merged.Content[j+1], value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged.Content = append(merged.Content, key, value)
+		}
+	}
+	return &merged
+}
+
+// isNullNode reports whether n is missing, an empty document or null.
+func isNullNode(n *yaml.Node) bool {
+	switch n.Kind {
+	case 0:
+		return true
+	case yaml.DocumentNode:
+		return len(n.Content) == 0 || isNullNode(n.Content[0])
+	case yaml.ScalarNode:
+		return n.Tag == "!!null"
+	}
+	return false
+}
+
 // LoadFromDirectory finds and loads the config file by searching upward from the given directory.
 // If no config file is found, returns a default config anchored at the given directory.
 func LoadFromDirectory(startDir string) (*Config, error) {
@@ -217,6 +1243,19 @@ func (c *Config) ResolveBeansPath() string {
 	return filepath.Join(c.configDir, c.Beans.Path)
 }
 
+// ResolveChangelogTemplate returns the absolute path to the custom changelog
+// template, or an empty string if none is configured.
+func (c *Config) ResolveChangelogTemplate() string {
+	if c.Beans.ChangelogTemplate == "" || filepath.IsAbs(c.Beans.ChangelogTemplate) {
+		return c.Beans.ChangelogTemplate
+	}
+	if c.configDir == "" {
+		cwd, _ := os.Getwd()
+		return filepath.Join(cwd, c.Beans.ChangelogTemplate)
+	}
+	return filepath.Join(c.configDir, c.Beans.ChangelogTemplate)
+}
+
 // ConfigDir returns the directory containing the config file.
 func (c *Config) ConfigDir() string {
 	return c.configDir
@@ -229,6 +1268,8 @@ func (c *Config) SetConfigDir(dir string) {
 
 // Save writes the configuration to the config file.
 // If configDir is set, saves to that directory; otherwise saves to the given directory.
+// Settings loaded from the local config file are written too, so only save
+// configs that weren't loaded with one.
 func (c *Config) Save(dir string) error {
 	targetDir := c.configDir
 	if targetDir == "" {
@@ -274,6 +1315,23 @@ func (c *Config) StatusNames() []string {
 	return names
 }
 
+// WorkflowStatuses returns the statuses a bean moves through, in order: the
+// open statuses from least to most active (the reverse of their sort order),
+// then the archive statuses except scrapped, which is a way out rather than a
+// step.
+func (c *Config) WorkflowStatuses() []string {
+	var open, done []string
+	for _, s := range c.StatusNames() {
+		switch {
+		case !c.IsArchiveStatus(s):
+			open = append([]string{s}, open...)
+		case s != "scrapped":
+			done = append(done, s)
+		}
+	}
+	return append(open, done...)
+}
+
 // GetStatus returns the StatusConfig for a given status name, or nil if not found.
 // Statuses are hardcoded and not configurable.
 func (c *Config) GetStatus(name string) *StatusConfig {
@@ -328,6 +1386,50 @@ func (c *Config) TypeNames() []string {
 	return names
 }
 
+// StatusAliases returns the status alias map: aliases.statuses if set,
+// bean.LegacyStatuses otherwise, less the names that are statuses of the
+// project's own and those that map to statuses it doesn't have.
+func (c *Config) StatusAliases() map[string]string {
+	if c.Beans.Aliases.Statuses != nil {
+		return c.Beans.Aliases.Statuses
+	}
+	aliases := make(map[string]string, len(bean.LegacyStatuses))
+	for alias, status := range bean.LegacyStatuses {
+		if !c.IsValidStatus(alias) && c.IsValidStatus(status) {
+			aliases[alias] = status
+		}
+	}
+	return aliases
+}
+
+// TypeAliases returns the type alias map.
+func (c *Config) TypeAliases() map[string]string {
+	return c.Beans.Aliases.Types
+}
+
+// ValidateAliases checks the alias maps and returns a description of each problem.
+func (c *Config) ValidateAliases() []string {
+	var errs []string
+	check := func(key, kind string, aliases map[string]string, isValid func(string) bool) {
+		names := make([]string, 0, len(aliases))
+		for alias := range aliases {
+			names = append(names, alias)
+		}
+		slices.Sort(names)
+		for _, alias := range names {
+			if isValid(alias) {
+				errs = append(errs, fmt.Sprintf("aliases.%s: '%s' is already a %s", key, alias, kind))
+			}
+			if target := aliases[alias]; !isValid(target) {
+				errs = append(errs, fmt.Sprintf("aliases.%s.%s: '%s' is not a valid %s", key, alias, target, kind))
+			}
+		}
+	}
+	check("statuses", "status", c.Beans.Aliases.Statuses, c.IsValidStatus)
+	check("types", "type", c.Beans.Aliases.Types, c.IsValidType)
+	return errs
+}
+
 // IsValidType returns true if the type is a valid hardcoded type.
 func (c *Config) IsValidType(typeName string) bool {
 	for _, t := range DefaultTypes {
@@ -390,15 +1492,36 @@ func (c *Config) GetPriority(name string) *PriorityConfig {
 	return nil
 }
 
-// PriorityNames returns a slice of valid priority names in order from highest to lowest.
+// PriorityNames returns a slice of valid priority names in order from highest to lowest,
+// as reordered by priority_order.
 func (c *Config) PriorityNames() []string {
-	names := make([]string, len(DefaultPriorities))
-	for i, p := range DefaultPriorities {
-		names[i] = p.Name
+	names := make([]string, 0, len(DefaultPriorities))
+	for _, name := range c.Beans.PriorityOrder {
+		if c.IsValidPriority(name) && name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	for _, p := range DefaultPriorities {
+		if !slices.Contains(names, p.Name) {
+			names = append(names, p.Name)
+		}
 	}
 	return names
 }
 
+// ValidatePriorityOrder checks priority_order and returns a description of each problem.
+func (c *Config) ValidatePriorityOrder() []string {
+	var errs []string
+	for i, name := range c.Beans.PriorityOrder {
+		if name == "" || !c.IsValidPriority(name) {
+			errs = append(errs, fmt.Sprintf("priority_order: '%s' is not a valid priority (must be %s)", name, c.PriorityList()))
+		} else if slices.Contains(c.Beans.PriorityOrder[:i], name) {
+			errs = append(errs, fmt.Sprintf("priority_order: '%s' is listed twice", name))
+		}
+	}
+	return errs
+}
+
 // IsValidPriority returns true if the priority is a valid hardcoded priority.
 // Empty string is valid (means no priority set).
 func (c *Config) IsValidPriority(priority string) bool {
@@ -421,3 +1544,323 @@ func (c *Config) PriorityList() string {
 	}
 	return strings.Join(names, ", ")
 }
+
+// RequiredFor returns the required fields and sections for a bean type.
+func (c *Config) RequiredFor(beanType string) RequiredConfig {
+	return c.Beans.Required[beanType]
+}
+
+// ValidateRequired checks the required fields policy, returning a message
+// for each unknown type or field.
+func (c *Config) ValidateRequired() []string {
+	var errs []string
+	types := make([]string, 0, len(c.Beans.Required))
+	for t := range c.Beans.Required {
+		types = append(types, t)
+	}
+	slices.Sort(types)
+	for _, t := range types {
+		if !c.IsValidType(t) {
+			errs = append(errs, fmt.Sprintf("required: '%s' is not a valid type", t))
+		}
+		for _, f := range c.Beans.Required[t].Fields {
+			if !slices.Contains(RequirableFields, f) {
+				errs = append(errs, fmt.Sprintf("required.%s: '%s' is not a field that can be required (use %s)", t, f, strings.Join(RequirableFields, ", ")))
+			}
+		}
+	}
+	return errs
+}
+
+// SLAFor returns how long a bean with the given priority may stay in the
+// given status. The second return value is false if there is no valid SLA.
+// An empty priority is treated as "normal".
+func (c *Config) SLAFor(priority, status string) (time.Duration, bool) {
+	if priority == "" {
+		priority = "normal"
+	}
+	limit, ok := c.Beans.SLA[priority][status]
+	if !ok {
+		return 0, false
+	}
+	d, err := ParseSLADuration(limit)
+	if err != nil {
+		return 0, false
+	}
+	return d, true
+}
+
+// ValidateSLA checks the SLA config and returns a description of each problem.
+func (c *Config) ValidateSLA() []string {
+	var errs []string
+	priorities := make([]string, 0, len(c.Beans.SLA))
+	for p := range c.Beans.SLA {
+		priorities = append(priorities, p)
+	}
+	slices.Sort(priorities)
+	for _, p := range priorities {
+		if !c.IsValidPriority(p) {
+			errs = append(errs, fmt.Sprintf("sla: '%s' is not a valid priority", p))
+		}
+		statuses := make([]string, 0, len(c.Beans.SLA[p]))
+		for s := range c.Beans.SLA[p] {
+			statuses = append(statuses, s)
+		}
+		slices.Sort(statuses)
+		for _, s := range statuses {
+			if !c.IsValidStatus(s) {
+				errs = append(errs, fmt.Sprintf("sla.%s: '%s' is not a valid status", p, s))
+			}
+			if _, err := ParseSLADuration(c.Beans.SLA[p][s]); err != nil {
+				errs = append(errs, fmt.Sprintf("sla.%s.%s: %s", p, s, err))
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateAging checks the aging rules and returns a description of each problem.
+func (c *Config) ValidateAging() []string {
+	var errs []string
+	for i, r := range c.Beans.Aging {
+		prefix := fmt.Sprintf("aging[%d]", i)
+		if _, err := ParseSLADuration(r.After); err != nil {
+			errs = append(errs, fmt.Sprintf("%s.after: %s", prefix, err))
+		}
+		for _, s := range r.Statuses {
+			if !c.IsValidStatus(s) {
+				errs = append(errs, fmt.Sprintf("%s.statuses: '%s' is not a valid status", prefix, s))
+			}
+		}
+		if r.Priority == "" && r.Tag == "" {
+			errs = append(errs, fmt.Sprintf("%s: set priority, tag or both", prefix))
+		}
+		if r.Priority != "" && !c.IsValidPriority(r.Priority) {
+			errs = append(errs, fmt.Sprintf("%s.priority: '%s' is not a valid priority", prefix, r.Priority))
+		}
+		if r.Tag != "" && !c.IsAllowedTag(r.Tag) {
+			errs = append(errs, fmt.Sprintf("%s.tag: '%s' is not in the tag registry", prefix, r.Tag))
+		}
+	}
+	return errs
+}
+
+// ValidateRedact checks the redaction config and returns a description of each problem.
+func (c *Config) ValidateRedact() []string {
+	var errs []string
+	check := func(key string, fields []string) {
+		for _, f := range fields {
+			if !slices.Contains(RedactableFields, f) {
+				errs = append(errs, fmt.Sprintf("redact.%s: '%s' is not a field that can be redacted (use %s)", key, f, strings.Join(RedactableFields, ", ")))
+			}
+		}
+	}
+	check("exclude", c.Beans.Redact.Exclude)
+	check("mask", c.Beans.Redact.Mask)
+	for _, f := range c.Beans.Redact.Mask {
+		if c.Beans.Redact.IsExcluded(f) {
+			errs = append(errs, fmt.Sprintf("redact: '%s' is both excluded and masked", f))
+		}
+	}
+	return errs
+}
+
+// ValidateSync checks the sync settings and returns a list of errors.
+func (c *Config) ValidateSync() []string {
+	var errs []string
+	gp := c.Beans.Sync.GitHubProject
+	for _, status := range slices.Sorted(maps.Keys(gp.Columns)) {
+		if !c.IsValidStatus(status) {
+			errs = append(errs, fmt.Sprintf("sync.github_project.columns: '%s' is not a valid status (must be %s)", status, c.StatusList()))
+		}
+	}
+	if gp.Project < 0 {
+		errs = append(errs, fmt.Sprintf("sync.github_project.project: must not be negative (got %d)", gp.Project))
+	}
+	if r := gp.GetConflicts(); r != ConflictsNewest && r != ConflictsPrompt {
+		errs = append(errs, fmt.Sprintf("sync.github_project.conflicts: '%s' is not valid (use %s or %s)", r, ConflictsNewest, ConflictsPrompt))
+	}
+
+	linear := c.Beans.Sync.Linear
+	for _, status := range slices.Sorted(maps.Keys(linear.States)) {
+		if !c.IsValidStatus(status) {
+			errs = append(errs, fmt.Sprintf("sync.linear.states: '%s' is not a valid status (must be %s)", status, c.StatusList()))
+		}
+	}
+	for _, priority := range slices.Sorted(maps.Keys(linear.Priorities)) {
+		if !c.IsValidPriority(priority) {
+			errs = append(errs, fmt.Sprintf("sync.linear.priorities: '%s' is not a valid priority (must be %s)", priority, c.PriorityList()))
+		}
+		if p := linear.Priorities[priority]; p < 0 || p > 4 {
+			errs = append(errs, fmt.Sprintf("sync.linear.priorities.%s: must be between 0 and 4 (got %d)", priority, p))
+		}
+	}
+	if r := linear.GetConflicts(); r != ConflictsNewest && r != ConflictsPrompt {
+		errs = append(errs, fmt.Sprintf("sync.linear.conflicts: '%s' is not valid (use %s or %s)", r, ConflictsNewest, ConflictsPrompt))
+	}
+	return errs
+}
+
+// ValidateAuth checks the server's auth settings and returns a list of errors.
+// Tokens read from the environment aren't checked, since they may only be
+// set where the server runs.
+func (c *Config) ValidateAuth() []string {
+	var errs []string
+	auth := c.Beans.Server.Auth
+	names := make(map[string]bool)
+	for i, t := range auth.Tokens {
+		name := t.Name
+		if name == "" {
+			errs = append(errs, fmt.Sprintf("server.auth.tokens[%d]: name is required", i))
+			name = fmt.Sprintf("[%d]", i)
+		} else if names[name] {
+			errs = append(errs, fmt.Sprintf("server.auth.tokens: duplicate name '%s'", name))
+		}
+		names[name] = true
+		if (t.Token == "") == (t.TokenEnv == "") {
+			errs = append(errs, fmt.Sprintf("server.auth.tokens.%s: set exactly one of token and token_env", name))
+		}
+		if scope := t.GetScope(); scope != ScopeRead && scope != ScopeWrite {
+			errs = append(errs, fmt.Sprintf("server.auth.tokens.%s: invalid scope '%s' (must be %s or %s)", name, scope, ScopeRead, ScopeWrite))
+		}
+	}
+	if o := auth.OIDC; o != nil {
+		if o.Issuer == "" {
+			errs = append(errs, "server.auth.oidc: issuer is required")
+		} else if !strings.HasPrefix(o.Issuer, "https://") && !strings.HasPrefix(o.Issuer, "http://localhost") && !strings.HasPrefix(o.Issuer, "http://127.0.0.1") {
+			errs = append(errs, fmt.Sprintf("server.auth.oidc: issuer '%s' must be an https URL", o.Issuer))
+		}
+		if o.Audience == "" {
+			errs = append(errs, "server.auth.oidc: audience is required")
+		}
+	}
+	return errs
+}
+
+// ValidateRateLimit checks the server's rate limit settings and returns a list of errors.
+func (c *Config) ValidateRateLimit() []string {
+	var errs []string
+	rl := c.Beans.Server.RateLimit
+	if rl.RequestsPerSecond < 0 {
+		errs = append(errs, fmt.Sprintf("server.rate_limit.requests_per_second: must not be negative (got %g)", rl.RequestsPerSecond))
+	}
+	if rl.Burst < 0 {
+		errs = append(errs, fmt.Sprintf("server.rate_limit.burst: must not be negative (got %d)", rl.Burst))
+	}
+	return errs
+}
+
+// ParseSLADuration parses an SLA duration: a day or week count (2d, 1w) or
+// a Go duration (36h, 90m). Durations must be positive.
+func ParseSLADuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if n := len(s); n > 1 && (s[n-1] == 'd' || s[n-1] == 'w') {
+		if count, err := strconv.Atoi(s[:n-1]); err == nil && count > 0 {
+			days := count
+			if s[n-1] == 'w' {
+				days *= 7
+			}
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return d, nil
+	}
+	return 0, fmt.Errorf("invalid duration %q (use e.g. 36h, 2d or 1w)", s)
+}
+
+// HasTagRegistry returns true if the config restricts tags to a registry.
+func (c *Config) HasTagRegistry() bool {
+	return len(c.Beans.Tags) > 0
+}
+
+// GetTag returns the registered TagConfig for a tag, or nil if not registered.
+func (c *Config) GetTag(name string) *TagConfig {
+	for i := range c.Beans.Tags {
+		if c.Beans.Tags[i].Name == name {
+			return &c.Beans.Tags[i]
+		}
+	}
+	return nil
+}
+
+// IsAllowedTag returns true if the tag may be added to beans: any tag if
+// there is no registry, otherwise only registered tags. A registry entry
+// ending in "/*" allows every tag in that namespace.
+func (c *Config) IsAllowedTag(name string) bool {
+	if !c.HasTagRegistry() || c.GetTag(name) != nil {
+		return true
+	}
+	for _, t := range c.Beans.Tags {
+		if bean.MatchTag(t.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// TagNames returns the names of the registered tags.
+func (c *Config) TagNames() []string {
+	names := make([]string, len(c.Beans.Tags))
+	for i, t := range c.Beans.Tags {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// LinkTypes returns the available typed links: the built-in link types, with
+// any configured overrides applied, followed by custom link types.
+// Structural link types (parent, blocking, blocked_by) and mentions can't be configured.
+func (c *Config) LinkTypes() []LinkTypeConfig {
+	types := append([]LinkTypeConfig(nil), DefaultLinkTypes...)
+	for _, lt := range c.Beans.LinkTypes {
+		if lt.Name == "" || slices.Contains(reservedLinkTypes, lt.Name) {
+			continue
+		}
+		if i := slices.IndexFunc(types, func(t LinkTypeConfig) bool { return t.Name == lt.Name }); i >= 0 {
+			types[i] = lt
+		} else {
+			types = append(types, lt)
+		}
+	}
+	return types
+}
+
+// GetLinkType returns the LinkTypeConfig for a given link type name, or nil if not found.
+func (c *Config) GetLinkType(name string) *LinkTypeConfig {
+	for _, lt := range c.LinkTypes() {
+		if lt.Name == name {
+			return &lt
+		}
+	}
+	return nil
+}
+
+// IsValidLinkType returns true if the link type is built in or configured.
+func (c *Config) IsValidLinkType(name string) bool {
+	return c.GetLinkType(name) != nil
+}
+
+// LinkTypeNames returns a slice of valid link type names.
+func (c *Config) LinkTypeNames() []string {
+	types := c.LinkTypes()
+	names := make([]string, len(types))
+	for i, lt := range types {
+		names[i] = lt.Name
+	}
+	return names
+}
+
+// LinkTypeList returns a comma-separated list of valid link types.
+func (c *Config) LinkTypeList() string {
+	return strings.Join(c.LinkTypeNames(), ", ")
+}
+
+// ReverseLabel returns the label of the link type as seen from the link's
+// target, falling back to the link type name.
+func (lt LinkTypeConfig) ReverseLabel() string {
+	if lt.Reverse != "" {
+		return lt.Reverse
+	}
+	return lt.Name
+}
@@ -3,7 +3,11 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDefault(t *testing.T) {
@@ -157,6 +161,230 @@ func TestGetDefaultType(t *testing.T) {
 	}
 }
 
+func TestGetSyncHook(t *testing.T) {
+	tests := []struct {
+		mode  string
+		want  string
+		valid bool
+	}{
+		{"", SyncHookOff, true},
+		{"off", SyncHookOff, true},
+		{"dry-run", SyncHookDryRun, true},
+		{"apply", SyncHookApply, true},
+		{"always", SyncHookOff, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			cfg := Default()
+			cfg.Beans.Git.SyncHook = tt.mode
+			if got := cfg.GetSyncHook(); got != tt.want {
+				t.Errorf("GetSyncHook() = %q, want %q", got, tt.want)
+			}
+			if got := IsValidSyncHook(tt.mode); got != tt.valid {
+				t.Errorf("IsValidSyncHook(%q) = %v, want %v", tt.mode, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestWantsBranch(t *testing.T) {
+	tests := []struct {
+		branchFor   string
+		beanType    string
+		hasChildren bool
+		want        bool
+	}{
+		{"", "task", true, true},
+		{"", "task", false, false},
+		{"parents", "feature", false, false},
+		{"all", "task", false, true},
+		{"types:feature,bug", "bug", false, true},
+		{"types:[feature, bug]", "feature", false, true},
+		{"types:feature,bug", "task", true, false},
+		{"sometimes", "task", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branchFor+"/"+tt.beanType, func(t *testing.T) {
+			cfg := Default()
+			cfg.Beans.Git.BranchFor = tt.branchFor
+			if got := cfg.WantsBranch(tt.beanType, tt.hasChildren); got != tt.want {
+				t.Errorf("WantsBranch(%q, %v) = %v, want %v", tt.beanType, tt.hasChildren, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBranchFor(t *testing.T) {
+	tests := []struct {
+		branchFor string
+		want      []string
+	}{
+		{"", nil},
+		{"all", nil},
+		{"types:feature,bug", nil},
+		{"types:feature,chore", []string{"git.branch_for: 'chore' is not a valid type"}},
+		{"types:", []string{"git.branch_for: 'types:' lists no types"}},
+		{"sometimes", []string{"git.branch_for: 'sometimes' is not a valid branch policy (use parents, all or types:<type>,...)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branchFor, func(t *testing.T) {
+			cfg := Default()
+			cfg.Beans.Git.BranchFor = tt.branchFor
+			if got := cfg.ValidateBranchFor(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBranchFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	tests := []struct {
+		scope string
+		want  []string
+	}{
+		{"packages/*", nil},
+		{"docs", nil},
+		{"*", nil},
+		{"./services/api/", nil},
+		{".", []string{"scopes: '.' is the repository root, not a scope"}},
+		{"/abs", []string{"scopes: '/abs' must be relative to the repository root"}},
+		{"../elsewhere", []string{"scopes: '../elsewhere' must be relative to the repository root"}},
+		{"packages/*/src", []string{"scopes: 'packages/*/src' may only use * as its last element"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			cfg := Default()
+			cfg.Beans.Scopes = []string{tt.scope}
+			if got := cfg.ValidateScopes(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateScopes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsIgnored(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Ignore = []string{"drafts/", "*.draft.md", "notes/*.md"}
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"drafts", true, true},
+		{"drafts/idea.md", false, true},
+		{"team/drafts/idea.md", false, true},
+		{"drafts", false, false},
+		{"plan.draft.md", false, true},
+		{"archive/plan.draft.md", false, true},
+		{"notes/todo.md", false, true},
+		{"team/notes/todo.md", false, false},
+		{"beans-abc1--title.md", false, false},
+	}
+	for _, tt := range tests {
+		if got := cfg.IsIgnored(tt.path, tt.isDir); got != tt.want {
+			t.Errorf("IsIgnored(%q, %v) = %v, want %v", tt.path, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestValidateIgnore(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Ignore = []string{"drafts/", "/", "[bad"}
+	want := []string{
+		"ignore: '/' is an empty pattern",
+		"ignore: '[bad' is not a valid pattern: syntax error in pattern",
+	}
+	if got := cfg.ValidateIgnore(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateIgnore() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateSync(t *testing.T) {
+	cfg := Default()
+	if got := cfg.ValidateSync(); got != nil {
+		t.Errorf("ValidateSync() on defaults = %q", got)
+	}
+	if got := cfg.Beans.Sync.GitHubProject.GetColumns()["in-progress"]; got != "In Progress" {
+		t.Errorf("default column for in-progress = %q", got)
+	}
+
+	cfg.Beans.Sync.GitHubProject = GitHubProjectConfig{
+		Columns:   map[string]string{"todo": "Backlog", "doing": "Doing"},
+		Conflicts: "oldest",
+	}
+	want := []string{
+		"sync.github_project.columns: 'doing' is not a valid status (must be " + cfg.StatusList() + ")",
+		"sync.github_project.conflicts: 'oldest' is not valid (use newest or prompt)",
+	}
+	if got := cfg.ValidateSync(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateSync() = %q, want %q", got, want)
+	}
+
+	cfg.Beans.Sync.GitHubProject = GitHubProjectConfig{}
+	cfg.Beans.Sync.Linear = LinearConfig{
+		States:     map[string]string{"todo": "Todo", "doing": "started"},
+		Priorities: map[string]int{"high": 2, "low": 5, "meh": 4},
+	}
+	want = []string{
+		"sync.linear.states: 'doing' is not a valid status (must be " + cfg.StatusList() + ")",
+		"sync.linear.priorities.low: must be between 0 and 4 (got 5)",
+		"sync.linear.priorities: 'meh' is not a valid priority (must be " + cfg.PriorityList() + ")",
+	}
+	if got := cfg.ValidateSync(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateSync() = %q, want %q", got, want)
+	}
+}
+
+func TestURLTitlesTimeout(t *testing.T) {
+	cfg := Default()
+	if got := cfg.Beans.URLTitles.GetTimeout(); got != DefaultURLTitleTimeout {
+		t.Errorf("GetTimeout() = %v, want the default", got)
+	}
+	cfg.Beans.URLTitles.Timeout = "2s"
+	if got := cfg.Beans.URLTitles.GetTimeout(); got != 2*time.Second {
+		t.Errorf("GetTimeout() = %v, want 2s", got)
+	}
+	if errs := cfg.ValidateURLTitles(); errs != nil {
+		t.Errorf("ValidateURLTitles() = %q", errs)
+	}
+	cfg.Beans.URLTitles.Timeout = "soon"
+	if errs := cfg.ValidateURLTitles(); len(errs) != 1 {
+		t.Errorf("ValidateURLTitles() = %q, want an error", errs)
+	}
+}
+
+func TestGetIDScheme(t *testing.T) {
+	tests := []struct {
+		scheme string
+		want   string
+		valid  bool
+	}{
+		{"", IDSchemeRandom, true},
+		{"random", IDSchemeRandom, true},
+		{"sequential", IDSchemeSequential, true},
+		{"date", IDSchemeDate, true},
+		{"uuid", IDSchemeRandom, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme, func(t *testing.T) {
+			cfg := Default()
+			cfg.Beans.IDScheme = tt.scheme
+			if got := cfg.GetIDScheme(); got != tt.want {
+				t.Errorf("GetIDScheme() = %q, want %q", got, tt.want)
+			}
+			if got := IsValidIDScheme(tt.scheme); got != tt.valid {
+				t.Errorf("IsValidIDScheme(%q) = %v, want %v", tt.scheme, got, tt.valid)
+			}
+		})
+	}
+}
+
 func TestIsArchiveStatus(t *testing.T) {
 	cfg := Default()
 
@@ -642,6 +870,27 @@ func TestLoadFromDirectory(t *testing.T) {
 	})
 }
 
+func TestResolveChangelogTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"unset", "", ""},
+		{"relative to config dir", "docs/changelog.tmpl", "/project/root/docs/changelog.tmpl"},
+		{"absolute", "/templates/changelog.tmpl", "/templates/changelog.tmpl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Beans: BeansConfig{ChangelogTemplate: tt.template}}
+			cfg.SetConfigDir("/project/root")
+			if got := cfg.ResolveChangelogTemplate(); got != tt.want {
+				t.Errorf("ResolveChangelogTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestResolveBeansPath(t *testing.T) {
 	t.Run("resolves relative path from config directory", func(t *testing.T) {
 		cfg := &Config{
@@ -741,6 +990,24 @@ func TestPriorityNames(t *testing.T) {
 	}
 }
 
+func TestPriorityOrder(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.PriorityOrder = []string{"high", "critical", "bogus", "high"}
+
+	want := []string{"high", "critical", "normal", "low", "deferred"}
+	if got := cfg.PriorityNames(); !slices.Equal(got, want) {
+		t.Errorf("PriorityNames() = %v, want %v", got, want)
+	}
+	if errs := cfg.ValidatePriorityOrder(); len(errs) != 2 {
+		t.Errorf("ValidatePriorityOrder() = %v, want errors for bogus and the repeated high", errs)
+	}
+
+	cfg.Beans.PriorityOrder = []string{"deferred"}
+	if errs := cfg.ValidatePriorityOrder(); len(errs) != 0 {
+		t.Errorf("ValidatePriorityOrder() = %v, want none", errs)
+	}
+}
+
 func TestGetPriority(t *testing.T) {
 	cfg := Default()
 
@@ -800,3 +1067,408 @@ func TestDefaultPrioritiesCount(t *testing.T) {
 		t.Errorf("len(DefaultPriorities) = %d, want 5", len(DefaultPriorities))
 	}
 }
+
+func TestLinkTypes(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.LinkTypes = []LinkTypeConfig{
+		{Name: "duplicates", Reverse: "is duplicated by"},
+		{Name: "tests", Reverse: "tested by"},
+		{Name: "blocking", Reverse: "nope"},
+		{Name: ""},
+	}
+
+	want := []string{"related", "duplicates", "supersedes", "implements", "tests"}
+	if got := cfg.LinkTypeNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("LinkTypeNames() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name        string
+		valid       bool
+		wantReverse string
+	}{
+		{"related", true, "related"},
+		{"duplicates", true, "is duplicated by"},
+		{"tests", true, "tested by"},
+		{"blocking", false, ""},
+		{"parent", false, ""},
+		{"unknown", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.IsValidLinkType(tt.name); got != tt.valid {
+				t.Errorf("IsValidLinkType(%q) = %v, want %v", tt.name, got, tt.valid)
+			}
+			if lt := cfg.GetLinkType(tt.name); lt != nil && lt.ReverseLabel() != tt.wantReverse {
+				t.Errorf("ReverseLabel() = %q, want %q", lt.ReverseLabel(), tt.wantReverse)
+			}
+		})
+	}
+
+	if got := (LinkTypeConfig{Name: "mirrors"}).ReverseLabel(); got != "mirrors" {
+		t.Errorf("ReverseLabel() without reverse = %q, want mirrors", got)
+	}
+}
+
+func TestValidateRequired(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Required = map[string]RequiredConfig{
+		"bug":   {Fields: []string{"priority"}, Sections: []string{"Repro Steps"}},
+		"story": {Fields: []string{"points"}},
+		"task":  {Fields: []string{"assignee"}},
+	}
+
+	got := cfg.ValidateRequired()
+	want := []string{
+		"required: 'story' is not a valid type",
+		"required.task: 'assignee' is not a field that can be required (use status, priority, points, tags, scope, parent, blocking, blocked_by, links, body)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateRequired() = %v, want %v", got, want)
+	}
+
+	if got := cfg.RequiredFor("bug").Sections; !reflect.DeepEqual(got, []string{"Repro Steps"}) {
+		t.Errorf("RequiredFor(bug).Sections = %v", got)
+	}
+	if got := cfg.RequiredFor("epic"); got.Fields != nil || got.Sections != nil {
+		t.Errorf("RequiredFor(epic) = %v, want empty", got)
+	}
+}
+
+func TestTagRegistry(t *testing.T) {
+	cfg := Default()
+	if cfg.HasTagRegistry() || !cfg.IsAllowedTag("anything") {
+		t.Error("without a registry, any tag should be allowed")
+	}
+
+	cfg.Beans.Tags = []TagConfig{{Name: "ui", Description: "User interface"}, {Name: "backend"}}
+	if !cfg.HasTagRegistry() {
+		t.Error("HasTagRegistry() = false, want true")
+	}
+	if !cfg.IsAllowedTag("ui") || cfg.IsAllowedTag("anything") {
+		t.Error("with a registry, only registered tags should be allowed")
+	}
+	if got := cfg.GetTag("ui"); got == nil || got.Description != "User interface" {
+		t.Errorf("GetTag(ui) = %v", got)
+	}
+	if got := cfg.TagNames(); !reflect.DeepEqual(got, []string{"ui", "backend"}) {
+		t.Errorf("TagNames() = %v", got)
+	}
+}
+
+func TestTagRegistryNamespaceWildcard(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Tags = []TagConfig{{Name: "area/*"}, {Name: "urgent"}}
+
+	tests := map[string]bool{
+		"area/frontend":       true,
+		"area/frontend/forms": true,
+		"area":                false,
+		"team/core":           false,
+		"urgent":              true,
+	}
+	for tag, want := range tests {
+		if got := cfg.IsAllowedTag(tag); got != want {
+			t.Errorf("IsAllowedTag(%q) = %v, want %v", tag, got, want)
+		}
+	}
+}
+
+func TestParseSLADuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "2d", want: 48 * time.Hour},
+		{in: "1w", want: 7 * 24 * time.Hour},
+		{in: "36h", want: 36 * time.Hour},
+		{in: "0d", wantErr: true},
+		{in: "-1h", wantErr: true},
+		{in: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSLADuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSLADuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSLADuration(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSLAFor(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.SLA = map[string]map[string]string{
+		"critical": {"todo": "2d"},
+		"normal":   {"in-progress": "1w"},
+	}
+
+	if got, ok := cfg.SLAFor("critical", "todo"); !ok || got != 48*time.Hour {
+		t.Errorf("SLAFor(critical, todo) = %v, %v", got, ok)
+	}
+	if got, ok := cfg.SLAFor("", "in-progress"); !ok || got != 7*24*time.Hour {
+		t.Errorf("SLAFor(\"\", in-progress) = %v, %v; empty priority should mean normal", got, ok)
+	}
+	if _, ok := cfg.SLAFor("critical", "in-progress"); ok {
+		t.Error("SLAFor(critical, in-progress) should have no SLA")
+	}
+}
+
+func TestValidateSLA(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.SLA = map[string]map[string]string{
+		"critical": {"todo": "2d", "waiting": "1d"},
+		"urgent":   {"todo": "soon"},
+	}
+
+	want := []string{
+		"sla.critical: 'waiting' is not a valid status",
+		"sla: 'urgent' is not a valid priority",
+		`sla.urgent.todo: invalid duration "soon" (use e.g. 36h, 2d or 1w)`,
+	}
+	if got := cfg.ValidateSLA(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateSLA() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateAging(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Tags = []TagConfig{{Name: "aging"}}
+	cfg.Beans.Aging = []AgingRule{
+		{After: "14d", Priority: "high", Tag: "aging"},
+		{After: "soon", Statuses: []string{"waiting"}, Priority: "urgent", Tag: "old"},
+		{After: "1d"},
+	}
+
+	want := []string{
+		`aging[1].after: invalid duration "soon" (use e.g. 36h, 2d or 1w)`,
+		"aging[1].statuses: 'waiting' is not a valid status",
+		"aging[1].priority: 'urgent' is not a valid priority",
+		"aging[1].tag: 'old' is not in the tag registry",
+		"aging[2]: set priority, tag or both",
+	}
+	if got := cfg.ValidateAging(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateAging() = %q, want %q", got, want)
+	}
+
+	if !(AgingRule{}).AppliesTo("draft") || (AgingRule{}).AppliesTo("in-progress") {
+		t.Error("rules without statuses should apply to todo and draft only")
+	}
+}
+
+func TestAliases(t *testing.T) {
+	cfg := Default()
+	if got := cfg.StatusAliases()["open"]; got != "todo" {
+		t.Errorf("StatusAliases()[open] = %q, want the built-in todo", got)
+	}
+
+	cfg.Beans.Aliases = AliasesConfig{
+		Statuses: map[string]string{"todo": "draft", "blocked": "waiting"},
+		Types:    map[string]string{"story": "feature"},
+	}
+	if _, ok := cfg.StatusAliases()["open"]; ok {
+		t.Error("aliases.statuses should replace the built-in aliases")
+	}
+	want := []string{
+		"aliases.statuses.blocked: 'waiting' is not a valid status",
+		"aliases.statuses: 'todo' is already a status",
+	}
+	if got := cfg.ValidateAliases(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateAliases() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateRedact(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Redact = RedactConfig{Exclude: []string{"body", "secret"}, Mask: []string{"title", "body"}}
+
+	want := []string{
+		"redact.exclude: 'secret' is not a field that can be redacted (use " + strings.Join(RedactableFields, ", ") + ")",
+		"redact: 'body' is both excluded and masked",
+	}
+	if got := cfg.ValidateRedact(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateRedact() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateAuth(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Server.Auth = AuthConfig{
+		Tokens: []AuthToken{
+			{Name: "dashboard", TokenEnv: "DASHBOARD_TOKEN"},
+			{Name: "ci", Token: "secret", TokenEnv: "CI_TOKEN", Scope: "admin"},
+			{Name: "dashboard", Token: "other"},
+			{Token: "anonymous"},
+		},
+		OIDC: &OIDCConfig{Issuer: "http://accounts.example.com"},
+	}
+
+	want := []string{
+		"server.auth.tokens.ci: set exactly one of token and token_env",
+		"server.auth.tokens.ci: invalid scope 'admin' (must be read or write)",
+		"server.auth.tokens: duplicate name 'dashboard'",
+		"server.auth.tokens[3]: name is required",
+		"server.auth.oidc: issuer 'http://accounts.example.com' must be an https URL",
+		"server.auth.oidc: audience is required",
+	}
+	if got := cfg.ValidateAuth(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ValidateAuth() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadLocalOverlay(t *testing.T) {
+	dir := t.TempDir()
+	project := `beans:
+  prefix: app-
+  editor: vim
+  git:
+    enabled: true
+    base_branch: main
+  tags:
+    - name: frontend
+    - name: backend
+`
+	local := `beans:
+  editor: code --wait
+  git:
+    base_branch: develop
+  tags:
+    - name: mine
+`
+	if err := os.WriteFile(filepath.Join(dir, ConfigFileName), []byte(project), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, LocalConfigFileName), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(filepath.Join(dir, ConfigFileName))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Beans.Prefix != "app-" {
+		t.Errorf("Prefix = %q, want app- from the project config", cfg.Beans.Prefix)
+	}
+	if cfg.Beans.Editor != "code --wait" {
+		t.Errorf("Editor = %q, want the local override", cfg.Beans.Editor)
+	}
+	if !cfg.Beans.Git.Enabled || cfg.Beans.Git.BaseBranch != "develop" {
+		t.Errorf("Git = %+v, want enabled kept and base_branch overridden", cfg.Beans.Git)
+	}
+	if len(cfg.Beans.Tags) != 1 || cfg.Beans.Tags[0].Name != "mine" {
+		t.Errorf("Tags = %v, want the local list to replace the project's", cfg.Beans.Tags)
+	}
+
+	// Null or empty values in the overlay override nothing
+	for _, local := range []string{"", "# nothing yet\n", "beans:\n", "beans:\n  editor:\n  git: ~\n"} {
+		if err := os.WriteFile(filepath.Join(dir, LocalConfigFileName), []byte(local), 0644); err != nil {
+			t.Fatal(err)
+		}
+		cfg, err := Load(filepath.Join(dir, ConfigFileName))
+		if err != nil {
+			t.Fatalf("Load() with local config %q error = %v", local, err)
+		}
+		if cfg.Beans.Editor != "vim" || cfg.Beans.Git.BaseBranch != "main" || cfg.Beans.Prefix != "app-" {
+			t.Errorf("local config %q overrode the project's: editor %q, git %+v", local, cfg.Beans.Editor, cfg.Beans.Git)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, LocalConfigFileName), []byte("beans: [\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(filepath.Join(dir, ConfigFileName)); err == nil {
+		t.Error("expected an error for an invalid local config")
+	}
+}
+
+func TestNewProjectConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	user := `beans:
+  id_length: 6
+  prefix: "x-{dir}-"
+  git:
+    base_branch: trunk
+`
+	if err := os.WriteFile(path, []byte(user), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewProjectConfig("shop", path)
+	if err != nil {
+		t.Fatalf("NewProjectConfig() error = %v", err)
+	}
+	if cfg.Beans.IDLength != 6 || cfg.Beans.Prefix != "x-shop-" {
+		t.Errorf("IDLength = %d, Prefix = %q; want 6 and x-shop-", cfg.Beans.IDLength, cfg.Beans.Prefix)
+	}
+	if cfg.Beans.Git.BaseBranch != "trunk" || !cfg.Beans.Git.Enabled || cfg.Beans.DefaultType != "task" {
+		t.Errorf("user defaults should only override what they set, got %+v", cfg.Beans)
+	}
+
+	cfg, err = NewProjectConfig("shop", filepath.Join(t.TempDir(), "missing.yml"))
+	if err != nil || cfg.Beans.Prefix != "shop-" || cfg.Beans.IDLength != 4 {
+		t.Errorf("without user defaults: %+v, %v", cfg, err)
+	}
+
+	if err := os.WriteFile(path, []byte("beans:\n  id_length: many\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewProjectConfig("shop", path); err == nil {
+		t.Error("expected an error for invalid user defaults")
+	}
+}
+
+func TestUserDefaultsPath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	if got, _ := UserDefaultsPath(); got != filepath.Join("/xdg", "beans", "config.yml") {
+		t.Errorf("UserDefaultsPath() = %q", got)
+	}
+}
+
+func TestValidateSecrets(t *testing.T) {
+	cfg := Default()
+	if got := cfg.Beans.Secrets.GetMode(); got != SecretsOff {
+		t.Errorf("GetMode() = %q, want off", got)
+	}
+	cfg.Beans.Secrets = SecretsConfig{Mode: SecretsBlock, Patterns: []string{`corp-[0-9a-f]{8}`}}
+	if errs := cfg.ValidateSecrets(); errs != nil {
+		t.Errorf("ValidateSecrets() = %q", errs)
+	}
+	cfg.Beans.Secrets = SecretsConfig{Mode: "refuse", Patterns: []string{`corp-(`}}
+	if errs := cfg.ValidateSecrets(); len(errs) != 2 {
+		t.Errorf("ValidateSecrets() = %q, want two errors", errs)
+	}
+}
+
+func TestValidateLint(t *testing.T) {
+	cfg := Default()
+	if got := cfg.Beans.Lint.Level(LintTagFormat); got != LintError {
+		t.Errorf("Level(tag-format) = %q, want the default", got)
+	}
+	if got := cfg.Beans.Lint.GetMaxTitleLength(); got != DefaultMaxTitleLength {
+		t.Errorf("GetMaxTitleLength() = %d, want the default", got)
+	}
+	cfg.Beans.Lint.Rules = map[string]string{LintTitleLength: LintOff}
+	if errs := cfg.ValidateLint(); errs != nil {
+		t.Errorf("ValidateLint() = %q", errs)
+	}
+	cfg.Beans.Lint = LintConfig{Rules: map[string]string{"spelling": LintWarn, LintEmptyBody: "loud"}, MaxTitleLength: -1}
+	if errs := cfg.ValidateLint(); len(errs) != 3 {
+		t.Errorf("ValidateLint() = %q, want three errors", errs)
+	}
+}
+
+func TestValidateTitles(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.Titles = TitlesConfig{Case: TitleCaseSentence, MaxLength: 60, ForbiddenPrefixes: []string{"WIP:"}}
+	if errs := cfg.ValidateTitles(); errs != nil {
+		t.Errorf("ValidateTitles() = %q", errs)
+	}
+	cfg.Beans.Titles = TitlesConfig{Case: "title", MaxLength: -1, ForbiddenPrefixes: []string{" "}}
+	if errs := cfg.ValidateTitles(); len(errs) != 3 {
+		t.Errorf("ValidateTitles() = %q, want three errors", errs)
+	}
+}
@@ -0,0 +1,302 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Settings are addressed by key paths: the YAML names of the nested settings
+// joined by dots, e.g. beans.git.base_branch. Map entries are addressed by
+// their key (beans.sla.critical.todo) and list elements by their index
+// (beans.tags.0.name).
+
+// configGetters resolve the settings whose defaults are applied by a Config
+// getter rather than by the getter of the struct holding them.
+var configGetters = map[string]func(c *Config) any{
+	"beans.default_status": func(c *Config) any { return c.GetDefaultStatus() },
+	"beans.default_type":   func(c *Config) any { return c.GetDefaultType() },
+	"beans.id_scheme":      func(c *Config) any { return c.GetIDScheme() },
+	"beans.archive_layout": func(c *Config) any { return c.GetArchiveLayout() },
+	"beans.editor":         func(c *Config) any { return c.GetEditor() },
+	"beans.git.sync_hook":  func(c *Config) any { return c.GetSyncHook() },
+	"beans.server.addr":    func(c *Config) any { return c.GetServerAddr() },
+}
+
+// Get returns the value of the setting at the key path. Settings that aren't
+// set in the config file have their default value: what the getter for the
+// setting (e.g. GetMode for a Mode field) resolves it to, if there is one.
+func (c *Config) Get(key string) (any, error) {
+	keys, err := splitKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if getter, ok := configGetters[strings.Join(keys, ".")]; ok {
+		return getter(c), nil
+	}
+	v := reflect.ValueOf(c).Elem()
+	var getter reflect.Value
+	for i, k := range keys {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return nil, nil
+			}
+			v = v.Elem()
+		}
+		at := strings.Join(keys[:i+1], ".")
+		switch v.Kind() {
+		case reflect.Struct:
+			field, ok := fieldByYAMLName(v.Type(), k)
+			if !ok {
+				return nil, fmt.Errorf("unknown setting %s", at)
+			}
+			if i == len(keys)-1 {
+				getter = v.MethodByName("Get" + field.Name)
+			}
+			v = v.FieldByIndex(field.Index)
+		case reflect.Map:
+			v = v.MapIndex(reflect.ValueOf(k))
+			if !v.IsValid() {
+				return nil, fmt.Errorf("%s is not set", at)
+			}
+		case reflect.Slice:
+			n, err := strconv.Atoi(k)
+			if err != nil || n < 0 || n >= v.Len() {
+				return nil, fmt.Errorf("%s: no list element %s (the list has %d)", at, k, v.Len())
+			}
+			v = v.Index(n)
+		default:
+			return nil, fmt.Errorf("unknown setting %s", at)
+		}
+	}
+	if getter.IsValid() && getter.Type().NumIn() == 0 && getter.Type().NumOut() == 1 {
+		value := getter.Call(nil)[0].Interface()
+		if d, ok := value.(time.Duration); ok {
+			return d.String(), nil
+		}
+		return value, nil
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, nil
+	}
+	return v.Interface(), nil
+}
+
+// SetValue sets the setting at the key path in the config file at path,
+// creating the file if it doesn't exist. Comments and the order of settings
+// are kept. The value is parsed according to the setting's type: booleans
+// and numbers as such, lists, maps and objects as YAML (or JSON).
+func SetValue(path, key, value string) error {
+	keys, err := splitKey(key)
+	if err != nil {
+		return err
+	}
+	t, err := typeAt(reflect.TypeOf(Config{}), keys)
+	if err != nil {
+		return err
+	}
+	valueNode, err := parseValue(t, value)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	if err := setNode(doc.Content[0], keys, valueNode); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(detectIndent(data))
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+
+	// Make sure the result still loads
+	if err := yaml.Unmarshal(buf.Bytes(), &Config{}); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// splitKey splits a key path into its parts.
+func splitKey(key string) ([]string, error) {
+	keys := strings.Split(key, ".")
+	for _, k := range keys {
+		if k == "" {
+			return nil, fmt.Errorf("invalid setting %q", key)
+		}
+	}
+	return keys, nil
+}
+
+// fieldByYAMLName finds the struct field with the given YAML name.
+func fieldByYAMLName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if f.IsExported() && tag == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// typeAt returns the type of the setting at the key path.
+func typeAt(t reflect.Type, keys []string) (reflect.Type, error) {
+	for i, k := range keys {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		at := strings.Join(keys[:i+1], ".")
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := fieldByYAMLName(t, k)
+			if !ok {
+				return nil, fmt.Errorf("unknown setting %s", at)
+			}
+			t = f.Type
+		case reflect.Map:
+			t = t.Elem()
+		case reflect.Slice:
+			if _, err := strconv.Atoi(k); err != nil {
+				return nil, fmt.Errorf("%s: list elements are addressed by index", at)
+			}
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("unknown setting %s", at)
+		}
+	}
+	return t, nil
+}
+
+// parseValue parses a value for a setting of type t into a YAML node.
+func parseValue(t reflect.Type, value string) (*yaml.Node, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}, nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", value)
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(b)}, nil
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(n)}, nil
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: strconv.FormatFloat(f, 'g', -1, 64)}, nil
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	if err := doc.Content[0].Decode(reflect.New(t).Interface()); err != nil {
+		return nil, err
+	}
+	return doc.Content[0], nil
+}
+
+// setNode sets the value at the key path below a mapping or sequence node,
+// creating missing mappings along the way.
+func setNode(node *yaml.Node, keys []string, value *yaml.Node) error {
+	for i, k := range keys {
+		last := i == len(keys)-1
+		at := strings.Join(keys[:i+1], ".")
+		switch node.Kind {
+		case yaml.MappingNode:
+			var child *yaml.Node
+			for j := 0; j+1 < len(node.Content); j += 2 {
+				if node.Content[j].Value == k {
+					child = node.Content[j+1]
+					if last {
+						keepComments(value, child)
+						node.Content[j+1] = value
+					}
+					break
+				}
+			}
+			if child == nil {
+				child = value
+				if !last {
+					child = &yaml.Node{Kind: yaml.MappingNode}
+					if _, err := strconv.Atoi(keys[i+1]); err == nil {
+						child.Kind = yaml.SequenceNode
+					}
+				}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: k}, child)
+			}
+			node = child
+		case yaml.SequenceNode:
+			n, err := strconv.Atoi(k)
+			if err != nil || n < 0 || n > len(node.Content) {
+				return fmt.Errorf("%s: no list element %s (the list has %d)", at, k, len(node.Content))
+			}
+			if n == len(node.Content) {
+				child := value
+				if !last {
+					child = &yaml.Node{Kind: yaml.MappingNode}
+				}
+				node.Content = append(node.Content, child)
+			} else if last {
+				keepComments(value, node.Content[n])
+				node.Content[n] = value
+			}
+			node = node.Content[n]
+		default:
+			return fmt.Errorf("%s: can't set a setting inside a %s value", at, strings.Join(keys[:i], "."))
+		}
+	}
+	return nil
+}
+
+// keepComments carries the comments of a replaced node over to its replacement.
+func keepComments(to, from *yaml.Node) {
+	to.HeadComment, to.LineComment, to.FootComment = from.HeadComment, from.LineComment, from.FootComment
+}
+
+// detectIndent returns the indentation width used in a YAML file, defaulting
+// to the 4 spaces Save writes.
+func detectIndent(data []byte) int {
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if n := len(line) - len(trimmed); n > 0 && trimmed != "" && !strings.HasPrefix(trimmed, "#") && !strings.HasPrefix(trimmed, "- ") {
+			return n
+		}
+	}
+	return 4
+}
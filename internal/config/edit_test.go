@@ -0,0 +1,162 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	cfg := Default()
+	cfg.Beans.SLA = map[string]map[string]string{"critical": {"todo": "2d"}}
+	cfg.Beans.Tags = []TagConfig{{Name: "frontend"}}
+
+	tests := []struct {
+		key     string
+		want    any
+		wantErr bool
+	}{
+		{"beans.prefix", "", false},
+		{"beans.id_length", 4, false},
+		{"beans.git.auto_create_branch", true, false},
+		{"beans.sla.critical.todo", "2d", false},
+		{"beans.tags.0.name", "frontend", false},
+		{"beans.server.auth.oidc", nil, false},
+		{"beans.server.addr", DefaultServerAddr, false},
+		{"beans.id_scheme", IDSchemeRandom, false},
+		{"beans.secrets.mode", SecretsOff, false},
+		{"beans.server.rate_limit.burst", 1, false},
+		{"beans.sla.low", nil, true},
+		{"beans.tags.1", nil, true},
+		{"beans.nope", nil, true},
+		{"beans..prefix", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := cfg.Get(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get(%q) = %#v, want %#v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	original := `# Project settings
+beans:
+  prefix: app- # short and sweet
+  git:
+    enabled: true
+`
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "replace scalar keeping comments",
+			key:   "beans.prefix",
+			value: "web-",
+			want: `# Project settings
+beans:
+  prefix: web- # short and sweet
+  git:
+    enabled: true
+`,
+		},
+		{
+			name:  "add nested setting",
+			key:   "beans.git.auto_create_branch",
+			value: "false",
+			want: `# Project settings
+beans:
+  prefix: app- # short and sweet
+  git:
+    enabled: true
+    auto_create_branch: false
+`,
+		},
+		{
+			name:  "create missing mappings",
+			key:   "beans.sla.critical.todo",
+			value: "2d",
+			want: `# Project settings
+beans:
+  prefix: app- # short and sweet
+  git:
+    enabled: true
+  sla:
+    critical:
+      todo: 2d
+`,
+		},
+		{
+			name:  "string that looks like a boolean",
+			key:   "beans.prefix",
+			value: "true",
+			want: `# Project settings
+beans:
+  prefix: "true" # short and sweet
+  git:
+    enabled: true
+`,
+		},
+		{
+			name:  "list as YAML",
+			key:   "beans.redact.exclude",
+			value: "[body, title]",
+			want: `# Project settings
+beans:
+  prefix: app- # short and sweet
+  git:
+    enabled: true
+  redact:
+    exclude: [body, title]
+`,
+		},
+		{name: "not a boolean", key: "beans.git.enabled", value: "maybe", wantErr: true},
+		{name: "not an integer", key: "beans.id_length", value: "four", wantErr: true},
+		{name: "unknown setting", key: "beans.colour", value: "red", wantErr: true},
+		{name: "wrong structure", key: "beans.git", value: "[1, 2]", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ConfigFileName)
+			if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+				t.Fatal(err)
+			}
+			err := SetValue(path, tt.key, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			data, _ := os.ReadFile(path)
+			want := tt.want
+			if tt.wantErr {
+				want = original
+			}
+			if string(data) != want {
+				t.Errorf("file =\n%s\nwant\n%s", data, want)
+			}
+		})
+	}
+}
+
+func TestSetValueCreatesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := SetValue(path, "beans.prefix", "new-"); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Beans.Prefix != "new-" {
+		t.Errorf("Prefix = %q, want new-", cfg.Beans.Prefix)
+	}
+}
@@ -0,0 +1,176 @@
+package gitflow
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CodeOwnersLocations are the paths (relative to the repository root) a
+// CODEOWNERS file is looked up at, in GitHub's order of precedence.
+var CodeOwnersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeOwnersRule is a single line of a CODEOWNERS file.
+type CodeOwnersRule struct {
+	Pattern string
+	Owners  []string // users (@user), teams (@org/team) or emails; empty unassigns
+	Line    int
+
+	re      *regexp.Regexp
+	dirOnly bool // pattern ends in /: matches directory contents only
+	shallow bool // pattern ends in /*: matches files directly in a directory only
+}
+
+// CodeOwners is a parsed CODEOWNERS file. As on GitHub, the last rule
+// matching a path wins.
+type CodeOwners struct {
+	Path  string // file the rules were loaded from, relative to the repository root
+	Rules []CodeOwnersRule
+}
+
+// LoadCodeOwners reads the first CODEOWNERS file found at
+// CodeOwnersLocations below root. Returns nil if there is none.
+func LoadCodeOwners(root string) (*CodeOwners, error) {
+	for _, loc := range CodeOwnersLocations {
+		f, err := os.Open(filepath.Join(root, filepath.FromSlash(loc)))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		co, err := ParseCodeOwners(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", loc, err)
+		}
+		co.Path = loc
+		return co, nil
+	}
+	return nil, nil
+}
+
+// ParseCodeOwners parses CODEOWNERS rules: a gitignore-style pattern followed
+// by owners, one per line. Blank lines and # comments are skipped. Negated
+// (!) and character class ([...]) patterns aren't supported by GitHub and
+// are rejected.
+func ParseCodeOwners(r io.Reader) (*CodeOwners, error) {
+	co := &CodeOwners{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, " #"); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		rule, err := newCodeOwnersRule(fields[0], fields[1:], line)
+		if err != nil {
+			return nil, err
+		}
+		co.Rules = append(co.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return co, nil
+}
+
+func newCodeOwnersRule(pattern string, owners []string, line int) (CodeOwnersRule, error) {
+	if strings.HasPrefix(pattern, "!") || strings.ContainsAny(pattern, "[]") {
+		return CodeOwnersRule{}, fmt.Errorf("line %d: unsupported pattern %q", line, pattern)
+	}
+	rule := CodeOwnersRule{Pattern: pattern, Owners: owners, Line: line}
+
+	p := pattern
+	if trimmed, ok := strings.CutSuffix(p, "/"); ok && trimmed != "" {
+		p, rule.dirOnly = trimmed, true
+	}
+	rule.shallow = strings.HasSuffix(p, "/*")
+	// A pattern with a slash before its end is relative to the repository
+	// root; one without matches at any depth
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	var re strings.Builder
+	re.WriteString("^")
+	if !anchored {
+		re.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			re.WriteString(".*")
+			i++
+		case p[i] == '*':
+			re.WriteString("[^/]*")
+		case p[i] == '?':
+			re.WriteString("[^/]")
+		default:
+			re.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	re.WriteString("$")
+	compiled, err := regexp.Compile(re.String())
+	if err != nil {
+		return CodeOwnersRule{}, fmt.Errorf("line %d: invalid pattern %q: %w", line, pattern, err)
+	}
+	rule.re = compiled
+	return rule, nil
+}
+
+// matches reports whether the rule applies to a path (relative to the
+// repository root). A rule matching a directory applies to everything in it.
+// A rule ending in /* only applies to files directly in its directory.
+func (r *CodeOwnersRule) matches(path string, isDir bool) bool {
+	if r.shallow {
+		return !isDir && r.re.MatchString(path)
+	}
+	if (isDir || !r.dirOnly) && r.re.MatchString(path) {
+		return true
+	}
+	for dir := path; ; {
+		i := strings.LastIndex(dir, "/")
+		if i < 0 {
+			return false
+		}
+		dir = dir[:i]
+		if r.re.MatchString(dir) {
+			return true
+		}
+	}
+}
+
+// Owners returns the owners of a file (relative to the repository root), or
+// nil if no rule assigns it an owner.
+func (co *CodeOwners) Owners(path string) []string {
+	return co.owners(path, false)
+}
+
+// DirOwners returns the owners of a directory (relative to the repository
+// root), or nil if no rule assigns it an owner.
+func (co *CodeOwners) DirOwners(dir string) []string {
+	return co.owners(dir, true)
+}
+
+func (co *CodeOwners) owners(path string, isDir bool) []string {
+	path = strings.Trim(filepath.ToSlash(path), "/")
+	for i := len(co.Rules) - 1; i >= 0; i-- {
+		if co.Rules[i].matches(path, isDir) {
+			if len(co.Rules[i].Owners) == 0 {
+				return nil
+			}
+			return co.Rules[i].Owners
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package gitflow
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCodeOwners(t *testing.T) {
+	co, err := ParseCodeOwners(strings.NewReader(`# Default owners
+*                 @org/everyone
+
+*.js              @js-owner # inline comment
+/build/logs/      @doctocat
+docs/*            docs@example.com
+apps/             @octocat
+/scripts/**/gen   @generator
+/vendor/
+`))
+	if err != nil {
+		t.Fatalf("ParseCodeOwners() error = %v", err)
+	}
+	if len(co.Rules) != 7 {
+		t.Fatalf("len(Rules) = %d, want 7", len(co.Rules))
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@org/everyone"}},
+		{"web/app.js", []string{"@js-owner"}},
+		{"build/logs/today.log", []string{"@doctocat"}},
+		{"build/logs/deep/today.log", []string{"@doctocat"}},
+		{"src/build/logs/today.log", []string{"@org/everyone"}},
+		{"docs/guide.md", []string{"docs@example.com"}},
+		{"docs/api/guide.md", []string{"@org/everyone"}},
+		{"apps/web/main.go", []string{"@octocat"}},
+		{"services/apps/main.go", []string{"@octocat"}},
+		{"scripts/gen", []string{"@generator"}},
+		{"scripts/a/b/gen", []string{"@generator"}},
+		{"vendor/lib/lib.go", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := co.Owners(tt.path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Owners(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+
+	if got := co.DirOwners("build/logs"); !reflect.DeepEqual(got, []string{"@doctocat"}) {
+		t.Errorf("DirOwners(build/logs) = %v, want [@doctocat]", got)
+	}
+	if got := co.DirOwners("docs"); !reflect.DeepEqual(got, []string{"@org/everyone"}) {
+		t.Errorf("DirOwners(docs) = %v, want [@org/everyone]", got)
+	}
+}
+
+func TestParseCodeOwners_Unsupported(t *testing.T) {
+	for _, line := range []string{"!secret.txt @a", "file[0-9].txt @a"} {
+		if _, err := ParseCodeOwners(strings.NewReader(line)); err == nil {
+			t.Errorf("ParseCodeOwners(%q) should fail", line)
+		}
+	}
+}
+
+func TestLoadCodeOwners(t *testing.T) {
+	root := t.TempDir()
+	co, err := LoadCodeOwners(root)
+	if err != nil || co != nil {
+		t.Fatalf("LoadCodeOwners() without a file = %v, %v; want nil", co, err)
+	}
+
+	os.WriteFile(filepath.Join(root, "CODEOWNERS"), []byte("* @root\n"), 0644)
+	os.MkdirAll(filepath.Join(root, ".github"), 0755)
+	os.WriteFile(filepath.Join(root, ".github", "CODEOWNERS"), []byte("* @github\n"), 0644)
+	co, err = LoadCodeOwners(root)
+	if err != nil {
+		t.Fatalf("LoadCodeOwners() error = %v", err)
+	}
+	if co.Path != ".github/CODEOWNERS" || !reflect.DeepEqual(co.Owners("x"), []string{"@github"}) {
+		t.Errorf("LoadCodeOwners() = %+v, want .github/CODEOWNERS to take precedence", co)
+	}
+}
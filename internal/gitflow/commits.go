@@ -0,0 +1,107 @@
+package gitflow
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Sentinel error used to stop iteration once enough commits were collected
+var errCommitLimitReached = errors.New("commit limit reached")
+
+// CommitInfo describes a git commit that references a bean.
+type CommitInfo struct {
+	Hash      string    `json:"hash"`
+	ShortHash string    `json:"short_hash"`
+	Subject   string    `json:"subject"`
+	Message   string    `json:"message"`
+	Author    string    `json:"author"`
+	Date      time.Time `json:"date"`
+}
+
+// BuildCommitMatcher returns a regexp matching any of the given IDs as a whole word
+// (e.g., "fixes abc1", "Refs: beans-abc1", "[beans-abc1]"). Empty IDs are ignored.
+func BuildCommitMatcher(ids ...string) *regexp.Regexp {
+	var alternatives []string
+	for _, id := range ids {
+		if id != "" {
+			alternatives = append(alternatives, regexp.QuoteMeta(strings.ToLower(id)))
+		}
+	}
+	if len(alternatives) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)(^|[^a-z0-9-])(` + strings.Join(alternatives, "|") + `)($|[^a-z0-9-])`)
+}
+
+// FindCommits returns commits reachable from any ref whose message mentions one of the given IDs.
+// Results are ordered newest first; limit <= 0 means no limit.
+func (g *GitFlow) FindCommits(ids []string, limit int) ([]CommitInfo, error) {
+	matcher := BuildCommitMatcher(ids...)
+	if matcher == nil {
+		return nil, nil
+	}
+
+	iter, err := g.repo.Log(&git.LogOptions{All: true, Order: git.LogOrderCommitterTime})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log: %w", err)
+	}
+	defer iter.Close()
+
+	var result []CommitInfo
+	err = iter.ForEach(func(c *object.Commit) error {
+		if !matcher.MatchString(c.Message) {
+			return nil
+		}
+		result = append(result, newCommitInfo(c))
+		if limit > 0 && len(result) >= limit {
+			return errCommitLimitReached
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errCommitLimitReached) {
+		return nil, fmt.Errorf("failed to iterate commits: %w", err)
+	}
+
+	return result, nil
+}
+
+// CommitsSince returns commits reachable from any ref that were committed at or
+// after since, ordered newest first.
+func (g *GitFlow) CommitsSince(since time.Time) ([]CommitInfo, error) {
+	iter, err := g.repo.Log(&git.LogOptions{All: true, Order: git.LogOrderCommitterTime, Since: &since})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log: %w", err)
+	}
+	defer iter.Close()
+
+	var result []CommitInfo
+	err = iter.ForEach(func(c *object.Commit) error {
+		result = append(result, newCommitInfo(c))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate commits: %w", err)
+	}
+	return result, nil
+}
+
+// newCommitInfo converts a go-git commit into a CommitInfo.
+func newCommitInfo(c *object.Commit) CommitInfo {
+	hash := c.Hash.String()
+	message := strings.TrimSpace(c.Message)
+	subject, _, _ := strings.Cut(message, "\n")
+	return CommitInfo{
+		Hash:      hash,
+		ShortHash: hash[:7],
+		Subject:   subject,
+		Message:   message,
+		Author:    c.Author.Name,
+		Date:      c.Author.When,
+	}
+}
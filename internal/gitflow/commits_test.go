@@ -0,0 +1,139 @@
+package gitflow
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestBuildCommitMatcher(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []string
+		message string
+		want    bool
+	}{
+		{"full id", []string{"beans-abc1"}, "fix: thing (beans-abc1)", true},
+		{"short id with verb", []string{"beans-abc1", "abc1"}, "fixes abc1", true},
+		{"start of message", []string{"abc1"}, "abc1: implement", true},
+		{"bracketed", []string{"abc1"}, "[abc1] implement", true},
+		{"case insensitive", []string{"abc1"}, "Fixes ABC1", true},
+		{"multiline body", []string{"abc1"}, "feat: thing\n\nRefs: abc1\n", true},
+		{"prefix of longer word", []string{"abc1"}, "fixes abc12", false},
+		{"suffix of longer word", []string{"abc1"}, "fixes xabc1", false},
+		{"part of hyphenated id", []string{"abc1"}, "fixes beans-abc1", false},
+		{"no mention", []string{"abc1"}, "unrelated change", false},
+		{"regexp metacharacters escaped", []string{"a.c1"}, "fixes abc1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := BuildCommitMatcher(tt.ids...)
+			if got := m.MatchString(tt.message); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.message, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildCommitMatcher_NoIDs(t *testing.T) {
+	if m := BuildCommitMatcher("", ""); m != nil {
+		t.Errorf("expected nil matcher for empty IDs, got %v", m)
+	}
+}
+
+func TestFindCommits(t *testing.T) {
+	dir, repo := setupTestRepo(t)
+	commitFile(t, repo, "a.txt", "a", "feat: first (beans-abc1)")
+	commitFile(t, repo, "b.txt", "b", "chore: unrelated")
+	commitFile(t, repo, "c.txt", "c", "fix: follow-up\n\nfixes abc1")
+	commitFile(t, repo, "d.txt", "d", "feat: other bean (beans-xyz9)")
+
+	gf, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	t.Run("all matches", func(t *testing.T) {
+		commits, err := gf.FindCommits([]string{"beans-abc1", "abc1"}, 0)
+		if err != nil {
+			t.Fatalf("FindCommits() error = %v", err)
+		}
+		if len(commits) != 2 {
+			t.Fatalf("expected 2 commits, got %d", len(commits))
+		}
+		subjects := map[string]bool{}
+		for _, c := range commits {
+			subjects[c.Subject] = true
+			if len(c.ShortHash) != 7 || c.Hash[:7] != c.ShortHash {
+				t.Errorf("unexpected short hash %q for %q", c.ShortHash, c.Hash)
+			}
+			if c.Author == "" {
+				t.Error("expected author to be set")
+			}
+		}
+		if !subjects["feat: first (beans-abc1)"] || !subjects["fix: follow-up"] {
+			t.Errorf("unexpected subjects: %v", subjects)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		commits, err := gf.FindCommits([]string{"beans-abc1", "abc1"}, 1)
+		if err != nil {
+			t.Fatalf("FindCommits() error = %v", err)
+		}
+		if len(commits) != 1 {
+			t.Errorf("expected 1 commit, got %d", len(commits))
+		}
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		commits, err := gf.FindCommits([]string{"beans-none"}, 0)
+		if err != nil {
+			t.Fatalf("FindCommits() error = %v", err)
+		}
+		if len(commits) != 0 {
+			t.Errorf("expected no commits, got %d", len(commits))
+		}
+	})
+}
+
+func TestCommitsSince(t *testing.T) {
+	dir, repo := setupTestRepo(t)
+	commitFile(t, repo, "old.txt", "old", "chore: undated")
+
+	// commitFile leaves the commit time unset, so date this one explicitly
+	w, _ := repo.Worktree()
+	os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0644)
+	w.Add("a.txt")
+	if _, err := w.Commit("feat: recent", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()},
+	}); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+
+	gf, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	commits, err := gf.CommitsSince(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CommitsSince() error = %v", err)
+	}
+	if len(commits) != 1 || commits[0].Subject != "feat: recent" {
+		t.Errorf("CommitsSince() = %v, want only the recent commit", commits)
+	}
+
+	commits, err = gf.CommitsSince(time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CommitsSince() error = %v", err)
+	}
+	if len(commits) != 0 {
+		t.Errorf("CommitsSince(future) returned %d commits, want 0", len(commits))
+	}
+}
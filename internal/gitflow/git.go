@@ -3,12 +3,14 @@ package gitflow
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/hmans/beans/internal/logging"
 )
 
 // Sentinel error used to break iteration when a merge commit is found
@@ -16,8 +18,13 @@ var errMergeCommitFound = errors.New("merge commit found")
 
 // GitFlow provides git operations for beans integration.
 type GitFlow struct {
-	repoPath string
-	repo     *git.Repository
+	repoPath       string
+	repo           *git.Repository
+	branchTemplate string // empty means BuildBranchName's default naming
+
+	mergeStrategies []MergeStrategy // empty means DefaultMergeStrategies
+
+	logger *slog.Logger
 }
 
 // New creates a new GitFlow instance for the given repository path.
@@ -31,14 +38,53 @@ func New(repoPath string) (*GitFlow, error) {
 	return &GitFlow{
 		repoPath: repoPath,
 		repo:     repo,
+		logger:   logging.Discard,
 	}, nil
 }
 
+// RepoPath returns the path of the repository's working tree.
+func (g *GitFlow) RepoPath() string {
+	return g.repoPath
+}
+
+// SetLogger sets the logger git operations are logged to (at debug level).
+// Pass nil to disable logging.
+func (g *GitFlow) SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = logging.Discard
+	}
+	g.logger = l
+}
+
+// SetBranchTemplate sets the template used to name new bean branches
+// (see RenderBranchName). An empty template restores the default naming.
+func (g *GitFlow) SetBranchTemplate(tmpl string) error {
+	if err := ValidateBranchTemplate(tmpl); err != nil {
+		return err
+	}
+	g.branchTemplate = tmpl
+	return nil
+}
+
+// BranchName returns the branch name for a bean using the configured template.
+func (g *GitFlow) BranchName(data BranchNameData) (string, error) {
+	return RenderBranchName(g.branchTemplate, data)
+}
+
 // CreateBranch creates a new git branch with the given name from the base branch.
 // This follows GitHub Flow principles: always branch from main (or configured base branch).
 // Returns the full branch name and switches to it.
 func (g *GitFlow) CreateBranch(beanID, slug, baseBranch string) (string, error) {
-	branchName := BuildBranchName(beanID, slug)
+	return g.CreateBeanBranch(BranchNameData{ID: beanID, Slug: slug}, baseBranch)
+}
+
+// CreateBeanBranch is like CreateBranch, but takes all values available to the
+// branch name template.
+func (g *GitFlow) CreateBeanBranch(data BranchNameData, baseBranch string) (string, error) {
+	branchName, err := g.BranchName(data)
+	if err != nil {
+		return "", fmt.Errorf("invalid branch name: %w", err)
+	}
 
 	// Check if branch already exists
 	exists, err := g.BranchExists(branchName)
@@ -65,6 +111,7 @@ func (g *GitFlow) CreateBranch(beanID, slug, baseBranch string) (string, error)
 	if err != nil {
 		return "", fmt.Errorf("failed to create branch: %w", err)
 	}
+	g.logger.Debug("created branch", "branch", branchName, "base", baseBranch)
 
 	// Switch to the new branch
 	if err := g.SwitchBranch(branchName); err != nil {
@@ -102,24 +149,38 @@ func (g *GitFlow) BranchExists(branchName string) (bool, error) {
 	return true, nil
 }
 
-// IsBranchMerged checks if the given branch is fully merged into the base branch.
-// Returns true if merged, along with the merge commit hash if found.
-// This handles multiple merge strategies:
-// - Regular merges (merge commits)
-// - Squash merges (GitHub default)
-// - Rebase merges (fast-forward)
-func (g *GitFlow) IsBranchMerged(branchName, baseBranch string) (bool, *plumbing.Hash, error) {
-	// Get the branch reference
-	branchRefName := plumbing.NewBranchReferenceName(branchName)
-	branchRef, err := g.repo.Reference(branchRefName, true)
-	if err == plumbing.ErrReferenceNotFound {
-		// Branch doesn't exist - check if it was merged and deleted
-		return g.wasBranchMergedAndDeleted(branchName, baseBranch)
+// ChangedFiles returns the files (relative to the repository root) changed on
+// rev since it diverged from base, like a pull request diff. Both may be any
+// revision git understands. Once rev has been merged into base with a merge
+// commit, the files it changed up to that merge are returned; fast-forwarded
+// branches have no changes left.
+func (g *GitFlow) ChangedFiles(rev, base string) ([]string, error) {
+	from := base
+	merges, err := g.runGit("rev-list", "--ancestry-path", "--merges", "--reverse", rev+".."+base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merges of %s: %w", rev, err)
 	}
+	if merge, _, _ := strings.Cut(merges, "\n"); merge != "" {
+		from = merge + "^1"
+	}
+	out, err := g.runGit("diff", "--name-only", "--no-renames", from+"..."+rev, "--")
 	if err != nil {
-		return false, nil, fmt.Errorf("failed to get branch reference: %w", err)
+		return nil, fmt.Errorf("failed to list files changed on %s: %w", rev, err)
+	}
+	if out == "" {
+		return nil, nil
 	}
+	return strings.Split(out, "\n"), nil
+}
 
+// IsBranchMerged checks if the given branch is fully merged into the base branch.
+// Returns true if merged, along with the merge commit hash if found.
+// The configured merge strategies (see SetMergeStrategies) are tried in order:
+// - ancestry: regular merges and fast-forwards
+// - tree: squash and rebase merges, by comparing file contents
+// - message: merge commits mentioning the branch (for deleted branches)
+// - remote: additionally checks remote-tracking branches (origin/<branch>)
+func (g *GitFlow) IsBranchMerged(branchName, baseBranch string) (bool, *plumbing.Hash, error) {
 	// Get the base branch reference
 	baseRefName := plumbing.NewBranchReferenceName(baseBranch)
 	baseRef, err := g.repo.Reference(baseRefName, true)
@@ -127,69 +188,49 @@ func (g *GitFlow) IsBranchMerged(branchName, baseBranch string) (bool, *plumbing
 		return false, nil, fmt.Errorf("failed to get base branch reference: %w", err)
 	}
 
-	branchCommit, err := g.repo.CommitObject(branchRef.Hash())
-	if err != nil {
-		return false, nil, fmt.Errorf("failed to get branch commit: %w", err)
-	}
-
 	baseCommit, err := g.repo.CommitObject(baseRef.Hash())
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to get base commit: %w", err)
 	}
 
-	// Strategy 1: Check if branch commit is an ancestor of base (regular merge or fast-forward)
-	isAncestor, err := branchCommit.IsAncestor(baseCommit)
-	if err != nil {
-		return false, nil, fmt.Errorf("failed to check ancestry: %w", err)
-	}
-	if isAncestor {
-		return true, &baseCommit.Hash, nil
-	}
-
-	// Strategy 2: Check if base contains all commits from branch (handles squash merges)
-	// For squash merges, the individual commits won't be in base, but if we can't find
-	// any unique commits in the branch that aren't reachable from base, it's merged
-	merged, err := g.areAllCommitsReachable(branchRef.Hash(), baseRef.Hash())
+	tips, err := g.branchTips(branchName)
 	if err != nil {
 		return false, nil, err
 	}
-	if merged {
-		return true, &baseCommit.Hash, nil
-	}
-
-	// Strategy 3: Check commit messages for merge references (fallback)
-	// This handles cases where commits were squashed and the original branch commits are gone
-	return g.wasBranchMergedAndDeleted(branchName, baseBranch)
-}
 
-// areAllCommitsReachable checks if all commits in the branch are reachable from base.
-// This handles squash merges where the branch commits don't exist in base, but the
-// content has been incorporated.
-func (g *GitFlow) areAllCommitsReachable(branchHash, baseHash plumbing.Hash) (bool, error) {
-	// Walk commits from base
-	baseReachable := make(map[plumbing.Hash]bool)
-	baseCommit, err := g.repo.CommitObject(baseHash)
-	if err != nil {
-		return false, fmt.Errorf("failed to get base commit: %w", err)
-	}
+	for _, strategy := range g.MergeStrategies() {
+		switch strategy {
+		case MergeStrategyAncestry:
+			for _, tip := range tips {
+				merged, err := g.isAncestorMerged(tip, baseCommit)
+				if err != nil {
+					return false, nil, err
+				}
+				if merged {
+					return true, &baseCommit.Hash, nil
+				}
+			}
 
-	iter := object.NewCommitIterCTime(baseCommit, nil, nil)
-	err = iter.ForEach(func(c *object.Commit) error {
-		baseReachable[c.Hash] = true
-		return nil
-	})
-	if err != nil {
-		return false, fmt.Errorf("failed to iterate base commits: %w", err)
-	}
+		case MergeStrategyTree:
+			for _, tip := range tips {
+				hash, err := g.findTreeMerge(tip, baseCommit)
+				if err != nil {
+					return false, nil, err
+				}
+				if hash != nil {
+					return true, hash, nil
+				}
+			}
 
-	// Check if branch commit is in base
-	if baseReachable[branchHash] {
-		return true, nil
+		case MergeStrategyMessage:
+			merged, hash, err := g.wasBranchMergedAndDeleted(branchName, baseBranch)
+			if err != nil || merged {
+				return merged, hash, err
+			}
+		}
 	}
 
-	// For more sophisticated squash merge detection, we'd need to compare
-	// tree contents, but that's expensive. For now, return false.
-	return false, nil
+	return false, nil, nil
 }
 
 // wasBranchMergedAndDeleted checks if a branch that no longer exists was previously merged.
@@ -244,6 +285,7 @@ func (g *GitFlow) SwitchBranch(branchName string) error {
 	if err != nil {
 		return fmt.Errorf("failed to checkout branch: %w", err)
 	}
+	g.logger.Debug("switched branch", "branch", branchName)
 
 	return nil
 }
@@ -385,6 +427,7 @@ func (g *GitFlow) CommitBeans(message string) error {
 	if err != nil {
 		return fmt.Errorf("failed to commit: %w", err)
 	}
+	g.logger.Debug("committed beans", "message", message)
 
 	return nil
 }
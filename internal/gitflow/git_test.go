@@ -3,6 +3,7 @@ package gitflow
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/go-git/go-git/v5"
@@ -194,6 +195,34 @@ func TestCreateBranch(t *testing.T) {
 	}
 }
 
+func TestCreateBeanBranch_Template(t *testing.T) {
+	tmpDir, _ := setupTestRepo(t)
+	gf, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if err := gf.SetBranchTemplate("{{.Slug}}"); err == nil {
+		t.Error("SetBranchTemplate() should reject templates without {{.ID}}")
+	}
+	if err := gf.SetBranchTemplate("feature/{{.Type}}/{{.ID}}-{{.Slug}}"); err != nil {
+		t.Fatalf("SetBranchTemplate() error = %v", err)
+	}
+
+	branchName, err := gf.CreateBeanBranch(BranchNameData{ID: "beans-test", Slug: "login", Type: "epic"}, "main")
+	if err != nil {
+		t.Fatalf("CreateBeanBranch() error = %v", err)
+	}
+
+	expectedName := "feature/epic/beans-test-login"
+	if branchName != expectedName {
+		t.Errorf("CreateBeanBranch() = %q, want %q", branchName, expectedName)
+	}
+	if exists, _ := gf.BranchExists(expectedName); !exists {
+		t.Error("branch was not created")
+	}
+}
+
 func TestCreateBranch_FromBaseBranch(t *testing.T) {
 	tmpDir, repo := setupTestRepo(t)
 	gf, err := New(tmpDir)
@@ -615,3 +644,51 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestChangedFiles(t *testing.T) {
+	tmpDir, _ := setupTestRepo(t)
+	gf, err := New(tmpDir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	gitCmd(t, tmpDir, "checkout", "--quiet", "-b", "feature")
+	os.MkdirAll(filepath.Join(tmpDir, "packages", "api"), 0755)
+	os.WriteFile(filepath.Join(tmpDir, "packages", "api", "main.go"), []byte("package main\n"), 0644)
+	os.WriteFile(filepath.Join(tmpDir, "README.md"), []byte("# Changed\n"), 0644)
+	gitCmd(t, tmpDir, "add", ".")
+	gitCmd(t, tmpDir, "commit", "--quiet", "-m", "feature work")
+
+	// Changes on main after the branch diverged don't count
+	gitCmd(t, tmpDir, "checkout", "--quiet", "main")
+	os.WriteFile(filepath.Join(tmpDir, "other.txt"), []byte("other\n"), 0644)
+	gitCmd(t, tmpDir, "add", "other.txt")
+	gitCmd(t, tmpDir, "commit", "--quiet", "-m", "main work")
+
+	want := []string{"README.md", "packages/api/main.go"}
+	files, err := gf.ChangedFiles("feature", "main")
+	if err != nil {
+		t.Fatalf("ChangedFiles() error = %v", err)
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("ChangedFiles() = %v, want %v", files, want)
+	}
+
+	// After a merge commit, the branch's changes up to the merge are reported
+	gitCmd(t, tmpDir, "merge", "--quiet", "--no-ff", "-m", "merge feature", "feature")
+	os.WriteFile(filepath.Join(tmpDir, "later.txt"), []byte("later\n"), 0644)
+	gitCmd(t, tmpDir, "add", "later.txt")
+	gitCmd(t, tmpDir, "commit", "--quiet", "-m", "after merge")
+	files, err = gf.ChangedFiles("feature", "main")
+	if err != nil {
+		t.Fatalf("ChangedFiles() after merge error = %v", err)
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("ChangedFiles() after merge = %v, want %v", files, want)
+	}
+
+	// An unknown revision is an error
+	if _, err := gf.ChangedFiles("nope", "main"); err == nil {
+		t.Error("ChangedFiles() with unknown revision should fail")
+	}
+}
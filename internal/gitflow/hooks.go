@@ -0,0 +1,261 @@
+package gitflow
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// HookMarker identifies hook scripts managed by beans, so they can be updated
+// or removed without touching hooks installed by other tools.
+const HookMarker = "# managed by beans"
+
+// PrepareCommitMsgHook is the name of the git hook that appends bean trailers.
+const PrepareCommitMsgHook = "prepare-commit-msg"
+
+// PostMergeHook and PostCheckoutHook run bean sync after merges and branch switches.
+const (
+	PostMergeHook    = "post-merge"
+	PostCheckoutHook = "post-checkout"
+)
+
+// BeanTrailer is the commit message trailer key used to reference beans.
+const BeanTrailer = "Bean"
+
+// ErrForeignHook is returned when a hook exists that was not installed by beans.
+var ErrForeignHook = errors.New("hook exists and is not managed by beans")
+
+// HookScripts maps hook names to the scripts beans installs for them.
+// Hooks never fail the git operation: beans errors are swallowed.
+var HookScripts = map[string]string{
+	PrepareCommitMsgHook: `#!/bin/sh
+` + HookMarker + `
+# Appends a "Bean: <id>" trailer when committing on a bean branch.
+command -v beans >/dev/null 2>&1 || exit 0
+beans git prepare-commit-msg "$@" || true
+`,
+	PostMergeHook:    syncHookScript(PostMergeHook),
+	PostCheckoutHook: syncHookScript(PostCheckoutHook),
+}
+
+// syncHookScript returns a hook script that runs bean sync for the given hook.
+// Whether anything happens is controlled by git.sync_hook in .beans.yml.
+func syncHookScript(name string) string {
+	return `#!/bin/sh
+` + HookMarker + `
+# Syncs bean status with git branches (see git.sync_hook in .beans.yml).
+command -v beans >/dev/null 2>&1 || exit 0
+beans git sync-hook ` + name + ` "$@" || true
+`
+}
+
+// HooksDir returns the path to the repository's hooks directory, as git sees
+// it: core.hooksPath if set, and the main repository's hooks in a linked
+// worktree or submodule.
+func (g *GitFlow) HooksDir() (string, error) {
+	dir, err := g.runGit("rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", fmt.Errorf("failed to find hooks directory: %w", err)
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(g.repoPath, dir)
+	}
+	return dir, nil
+}
+
+// InstallHooks installs the named hooks (see InstallHook) and returns their
+// paths. Every hook is checked before any is written, so a hook installed by
+// another tool leaves all hooks untouched unless force is set.
+func (g *GitFlow) InstallHooks(names []string, force bool) ([]string, error) {
+	dir, err := g.HooksDir()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if _, ok := HookScripts[name]; !ok {
+			return nil, fmt.Errorf("unknown hook %q", name)
+		}
+		if !force {
+			if err := checkForeignHook(filepath.Join(dir, name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(names))
+	for _, name := range names {
+		path, err := g.InstallHook(name, force)
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// InstallHook writes the beans-managed script for the named hook.
+// Existing beans-managed hooks are overwritten; hooks installed by other
+// tools are left alone and ErrForeignHook is returned unless force is set.
+func (g *GitFlow) InstallHook(name string, force bool) (string, error) {
+	script, ok := HookScripts[name]
+	if !ok {
+		return "", fmt.Errorf("unknown hook %q", name)
+	}
+
+	dir, err := g.HooksDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name)
+
+	if !force {
+		if err := checkForeignHook(path); err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		return "", fmt.Errorf("failed to write hook: %w", err)
+	}
+	return path, nil
+}
+
+// checkForeignHook returns ErrForeignHook if a hook not installed by beans
+// exists at path.
+func checkForeignHook(path string) error {
+	managed, exists, err := isManagedHook(path)
+	if err != nil {
+		return err
+	}
+	if exists && !managed {
+		return fmt.Errorf("%s: %w (use --force to overwrite)", path, ErrForeignHook)
+	}
+	return nil
+}
+
+// UninstallHook removes the named hook if it is managed by beans.
+// Returns false if there was nothing to remove.
+func (g *GitFlow) UninstallHook(name string) (bool, error) {
+	dir, err := g.HooksDir()
+	if err != nil {
+		return false, err
+	}
+	path := filepath.Join(dir, name)
+
+	managed, exists, err := isManagedHook(path)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+	if !managed {
+		return false, fmt.Errorf("%s: %w", path, ErrForeignHook)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return false, fmt.Errorf("failed to remove hook: %w", err)
+	}
+	return true, nil
+}
+
+// isManagedHook reports whether a hook file exists and whether beans installed it.
+func isManagedHook(path string) (managed, exists bool, err error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, fmt.Errorf("failed to read hook: %w", err)
+	}
+	return bytes.Contains(data, []byte(HookMarker)), true, nil
+}
+
+// AppendTrailer adds a "key: value" trailer to a commit message unless an
+// identical trailer is already present. Git comment lines at the end of the
+// message (as written by `git commit` into the editor template) are kept
+// after the trailer.
+func AppendTrailer(message, key, value string) string {
+	trailer := key + ": " + value
+
+	lines := strings.Split(message, "\n")
+	for _, line := range lines {
+		if strings.EqualFold(strings.TrimSpace(line), trailer) {
+			return message
+		}
+	}
+
+	// Split off the trailing block of comments and blank lines
+	end := len(lines)
+	for end > 0 {
+		trimmed := strings.TrimSpace(lines[end-1])
+		if trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+		end--
+	}
+	body, tail := lines[:end], lines[end:]
+
+	var out []string
+	out = append(out, body...)
+	if len(body) == 0 {
+		// Empty message: leave room for the subject line above the trailer
+		out = append(out, "", "")
+	} else if !endsWithTrailerBlock(body) {
+		out = append(out, "")
+	}
+	out = append(out, trailer)
+
+	// Keep the comment block separated from the message
+	if len(tail) > 0 && strings.TrimSpace(tail[0]) != "" {
+		out = append(out, "")
+	}
+	out = append(out, tail...)
+
+	result := strings.Join(out, "\n")
+	if !strings.HasSuffix(result, "\n") {
+		result += "\n"
+	}
+	return result
+}
+
+// endsWithTrailerBlock reports whether the last paragraph of a message consists
+// only of trailers. The subject line is never treated as a trailer block.
+func endsWithTrailerBlock(lines []string) bool {
+	start := -1
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.TrimSpace(lines[i]) == "" {
+			start = i + 1
+			break
+		}
+	}
+	if start <= 0 || start >= len(lines) {
+		return false
+	}
+	for _, line := range lines[start:] {
+		if !isTrailerLine(line) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTrailerLine reports whether a line looks like a git trailer ("Key: value").
+func isTrailerLine(line string) bool {
+	key, value, ok := strings.Cut(line, ": ")
+	if !ok || key == "" || strings.TrimSpace(value) == "" {
+		return false
+	}
+	for _, r := range key {
+		if !(r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
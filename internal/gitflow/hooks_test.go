@@ -0,0 +1,176 @@
+package gitflow
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppendTrailer(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "subject only",
+			message: "feat: add login\n",
+			want:    "feat: add login\n\nBean: beans-abc1\n",
+		},
+		{
+			name:    "subject and body",
+			message: "feat: add login\n\nAdds a login form.\n",
+			want:    "feat: add login\n\nAdds a login form.\n\nBean: beans-abc1\n",
+		},
+		{
+			name:    "existing trailer block",
+			message: "feat: add login\n\nSigned-off-by: Jane <jane@example.com>\n",
+			want:    "feat: add login\n\nSigned-off-by: Jane <jane@example.com>\nBean: beans-abc1\n",
+		},
+		{
+			name:    "already present",
+			message: "feat: add login\n\nBean: beans-abc1\n",
+			want:    "feat: add login\n\nBean: beans-abc1\n",
+		},
+		{
+			name:    "editor template with comments",
+			message: "feat: add login\n# Please enter the commit message\n# Lines starting with '#' will be ignored\n",
+			want:    "feat: add login\n\nBean: beans-abc1\n\n# Please enter the commit message\n# Lines starting with '#' will be ignored\n",
+		},
+		{
+			name:    "empty message with comments",
+			message: "\n# Please enter the commit message\n",
+			want:    "\n\nBean: beans-abc1\n\n# Please enter the commit message\n",
+		},
+		{
+			name:    "no trailing newline",
+			message: "fix: typo",
+			want:    "fix: typo\n\nBean: beans-abc1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AppendTrailer(tt.message, BeanTrailer, "beans-abc1")
+			if got != tt.want {
+				t.Errorf("AppendTrailer() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstallHook(t *testing.T) {
+	dir, _ := setupTestRepo(t)
+	gf, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	hookPath := filepath.Join(dir, ".git", "hooks", PrepareCommitMsgHook)
+
+	t.Run("installs executable hook", func(t *testing.T) {
+		path, err := gf.InstallHook(PrepareCommitMsgHook, false)
+		if err != nil {
+			t.Fatalf("InstallHook() error = %v", err)
+		}
+		if path != hookPath {
+			t.Errorf("InstallHook() path = %q, want %q", path, hookPath)
+		}
+		info, err := os.Stat(hookPath)
+		if err != nil {
+			t.Fatalf("hook not written: %v", err)
+		}
+		if info.Mode()&0111 == 0 {
+			t.Error("hook should be executable")
+		}
+		data, _ := os.ReadFile(hookPath)
+		if !strings.Contains(string(data), HookMarker) {
+			t.Error("hook should contain the beans marker")
+		}
+	})
+
+	t.Run("reinstall over managed hook", func(t *testing.T) {
+		if _, err := gf.InstallHook(PrepareCommitMsgHook, false); err != nil {
+			t.Errorf("InstallHook() over managed hook error = %v", err)
+		}
+	})
+
+	t.Run("uninstall managed hook", func(t *testing.T) {
+		removed, err := gf.UninstallHook(PrepareCommitMsgHook)
+		if err != nil || !removed {
+			t.Fatalf("UninstallHook() = %v, %v; want true, nil", removed, err)
+		}
+		if _, err := os.Stat(hookPath); !os.IsNotExist(err) {
+			t.Error("hook file should be removed")
+		}
+		removed, err = gf.UninstallHook(PrepareCommitMsgHook)
+		if err != nil || removed {
+			t.Errorf("UninstallHook() on missing hook = %v, %v; want false, nil", removed, err)
+		}
+	})
+
+	t.Run("foreign hook is protected", func(t *testing.T) {
+		foreign := "#!/bin/sh\necho custom\n"
+		if err := os.WriteFile(hookPath, []byte(foreign), 0755); err != nil {
+			t.Fatalf("failed to write foreign hook: %v", err)
+		}
+
+		if _, err := gf.InstallHook(PrepareCommitMsgHook, false); !errors.Is(err, ErrForeignHook) {
+			t.Errorf("InstallHook() error = %v, want ErrForeignHook", err)
+		}
+		if _, err := gf.UninstallHook(PrepareCommitMsgHook); !errors.Is(err, ErrForeignHook) {
+			t.Errorf("UninstallHook() error = %v, want ErrForeignHook", err)
+		}
+		data, _ := os.ReadFile(hookPath)
+		if string(data) != foreign {
+			t.Error("foreign hook should be left untouched")
+		}
+
+		if _, err := gf.InstallHook(PrepareCommitMsgHook, true); err != nil {
+			t.Errorf("InstallHook() with force error = %v", err)
+		}
+	})
+
+	t.Run("unknown hook", func(t *testing.T) {
+		if _, err := gf.InstallHook("pre-push", false); err == nil {
+			t.Error("InstallHook() with unknown hook should fail")
+		}
+	})
+
+	t.Run("foreign hook blocks installing all hooks", func(t *testing.T) {
+		hooksDir := filepath.Join(dir, ".git", "hooks")
+		os.Remove(filepath.Join(hooksDir, PrepareCommitMsgHook))
+		foreign := filepath.Join(hooksDir, PostCheckoutHook)
+		if err := os.WriteFile(foreign, []byte("#!/bin/sh\necho custom\n"), 0755); err != nil {
+			t.Fatalf("failed to write foreign hook: %v", err)
+		}
+		defer os.Remove(foreign)
+
+		names := []string{PrepareCommitMsgHook, PostMergeHook, PostCheckoutHook}
+		paths, err := gf.InstallHooks(names, false)
+		if !errors.Is(err, ErrForeignHook) || len(paths) != 0 {
+			t.Fatalf("InstallHooks() = %v, %v; want no paths and ErrForeignHook", paths, err)
+		}
+		for _, name := range names[:2] {
+			if _, err := os.Stat(filepath.Join(hooksDir, name)); !os.IsNotExist(err) {
+				t.Errorf("%s was installed although another hook is foreign", name)
+			}
+		}
+
+		if paths, err := gf.InstallHooks(names, true); err != nil || len(paths) != 3 {
+			t.Errorf("InstallHooks() with force = %v, %v; want all three installed", paths, err)
+		}
+	})
+
+	t.Run("honours core.hooksPath", func(t *testing.T) {
+		gitCmd(t, dir, "config", "core.hooksPath", ".githooks")
+		path, err := gf.InstallHook(PostMergeHook, false)
+		if err != nil {
+			t.Fatalf("InstallHook() error = %v", err)
+		}
+		if want := filepath.Join(dir, ".githooks", PostMergeHook); path != want {
+			t.Errorf("InstallHook() path = %q, want %q", path, want)
+		}
+	})
+}
@@ -0,0 +1,247 @@
+package gitflow
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// MergeStrategy is a technique used by IsBranchMerged to detect whether a
+// branch has landed in the base branch.
+type MergeStrategy string
+
+const (
+	// MergeStrategyAncestry: the branch tip is an ancestor of the base branch
+	// (regular merges, fast-forwards).
+	MergeStrategyAncestry MergeStrategy = "ancestry"
+	// MergeStrategyMessage: a merge commit on the base branch mentions the
+	// branch name (works for deleted branches with merge commits).
+	MergeStrategyMessage MergeStrategy = "message"
+	// MergeStrategyTree: every file the branch changed has the same content on
+	// the base branch (squash and rebase merges).
+	MergeStrategyTree MergeStrategy = "tree"
+	// MergeStrategyRemote: also inspect remote-tracking branches
+	// (e.g. origin/<branch>) when applying the other strategies, so branches
+	// deleted locally can still be checked, and unmerged branches that only
+	// exist remotely aren't considered deleted.
+	MergeStrategyRemote MergeStrategy = "remote"
+)
+
+// DefaultMergeStrategies are used when no strategies are configured.
+var DefaultMergeStrategies = []MergeStrategy{MergeStrategyAncestry, MergeStrategyMessage}
+
+// IsValidMergeStrategy returns true if the given merge detection strategy is recognized.
+func IsValidMergeStrategy(s string) bool {
+	switch MergeStrategy(s) {
+	case MergeStrategyAncestry, MergeStrategyMessage, MergeStrategyTree, MergeStrategyRemote:
+		return true
+	}
+	return false
+}
+
+// SetMergeStrategies sets the strategies IsBranchMerged uses, in order.
+// An empty list restores DefaultMergeStrategies.
+func (g *GitFlow) SetMergeStrategies(strategies []string) error {
+	parsed := make([]MergeStrategy, 0, len(strategies))
+	for _, s := range strategies {
+		if !IsValidMergeStrategy(s) {
+			return fmt.Errorf("invalid merge detection strategy %q (must be ancestry, message, tree or remote)", s)
+		}
+		parsed = append(parsed, MergeStrategy(s))
+	}
+	g.mergeStrategies = parsed
+	return nil
+}
+
+// MergeStrategies returns the strategies IsBranchMerged uses, in order.
+func (g *GitFlow) MergeStrategies() []MergeStrategy {
+	if len(g.mergeStrategies) == 0 {
+		return DefaultMergeStrategies
+	}
+	return g.mergeStrategies
+}
+
+// hasMergeStrategy returns true if the given strategy is enabled.
+func (g *GitFlow) hasMergeStrategy(s MergeStrategy) bool {
+	for _, enabled := range g.MergeStrategies() {
+		if enabled == s {
+			return true
+		}
+	}
+	return false
+}
+
+// branchTips returns the commits to check for a branch: the local branch
+// and, if the remote strategy is enabled, its remote-tracking branches
+// (origin first). Returns an empty list if the branch exists nowhere.
+func (g *GitFlow) branchTips(branchName string) ([]plumbing.Hash, error) {
+	var tips []plumbing.Hash
+
+	ref, err := g.repo.Reference(plumbing.NewBranchReferenceName(branchName), true)
+	if err == nil {
+		tips = append(tips, ref.Hash())
+	} else if !errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, fmt.Errorf("failed to get branch reference: %w", err)
+	}
+
+	if g.hasMergeStrategy(MergeStrategyRemote) {
+		remoteTips, err := g.remoteBranchTips(branchName)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range remoteTips {
+			if len(tips) == 0 || tips[0] != h {
+				tips = append(tips, h)
+			}
+		}
+	}
+
+	return tips, nil
+}
+
+// remoteBranchTips returns the tips of refs/remotes/<remote>/<branch> for
+// every configured remote that has the branch, with origin first.
+func (g *GitFlow) remoteBranchTips(branchName string) ([]plumbing.Hash, error) {
+	remotes, err := g.repo.Remotes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+
+	names := make([]string, 0, len(remotes))
+	for _, r := range remotes {
+		names = append(names, r.Config().Name)
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return names[i] == "origin" && names[j] != "origin"
+	})
+
+	var tips []plumbing.Hash
+	for _, name := range names {
+		ref, err := g.repo.Reference(plumbing.NewRemoteReferenceName(name, branchName), true)
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get remote branch reference: %w", err)
+		}
+		tips = append(tips, ref.Hash())
+	}
+	return tips, nil
+}
+
+// RemoteBranchExists reports whether any remote-tracking branch exists for the branch.
+func (g *GitFlow) RemoteBranchExists(branchName string) (bool, error) {
+	tips, err := g.remoteBranchTips(branchName)
+	if err != nil {
+		return false, err
+	}
+	return len(tips) > 0, nil
+}
+
+// isAncestorMerged checks whether tip is reachable from the base commit.
+func (g *GitFlow) isAncestorMerged(tip plumbing.Hash, baseCommit *object.Commit) (bool, error) {
+	tipCommit, err := g.repo.CommitObject(tip)
+	if err != nil {
+		return false, fmt.Errorf("failed to get branch commit: %w", err)
+	}
+	isAncestor, err := tipCommit.IsAncestor(baseCommit)
+	if err != nil {
+		return false, fmt.Errorf("failed to check ancestry: %w", err)
+	}
+	return isAncestor, nil
+}
+
+// findTreeMerge detects squash and rebase merges by content: it collects the
+// files the branch changed since its merge base with the base branch, and
+// checks whether the base branch has identical content for all of them.
+// Returns the earliest first-parent commit on the base branch from which the
+// content has been present continuously (the squash commit, typically),
+// or nil if the branch's changes aren't (or are no longer) on the base branch.
+func (g *GitFlow) findTreeMerge(tip plumbing.Hash, baseCommit *object.Commit) (*plumbing.Hash, error) {
+	tipCommit, err := g.repo.CommitObject(tip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch commit: %w", err)
+	}
+
+	bases, err := tipCommit.MergeBase(baseCommit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge base: %w", err)
+	}
+	if len(bases) == 0 {
+		return nil, nil
+	}
+	mergeBase := bases[0]
+
+	mergeBaseTree, err := mergeBase.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merge base tree: %w", err)
+	}
+	tipTree, err := tipCommit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch tree: %w", err)
+	}
+
+	changes, err := object.DiffTree(mergeBaseTree, tipTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff branch: %w", err)
+	}
+	if len(changes) == 0 {
+		// Nothing to compare; ancestry covers branches without changes
+		return nil, nil
+	}
+
+	// The content the branch produced, keyed by path (zero hash = deleted)
+	want := make(map[string]plumbing.Hash, len(changes))
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			if name != "" {
+				want[name] = treeFileHash(tipTree, name)
+			}
+		}
+	}
+
+	// Walk the base branch's first-parent history back to the merge base,
+	// remembering the oldest commit that still contains the branch's content.
+	var found *plumbing.Hash
+	for c := baseCommit; c != nil && c.Hash != mergeBase.Hash; {
+		tree, err := c.Tree()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get base tree: %w", err)
+		}
+		if !treeMatches(tree, want) {
+			break
+		}
+		hash := c.Hash
+		found = &hash
+
+		if c.NumParents() == 0 {
+			break
+		}
+		if c, err = c.Parent(0); err != nil {
+			return nil, fmt.Errorf("failed to get parent commit: %w", err)
+		}
+	}
+	return found, nil
+}
+
+// treeMatches returns true if the tree has the given content for every path.
+func treeMatches(tree *object.Tree, want map[string]plumbing.Hash) bool {
+	for name, hash := range want {
+		if treeFileHash(tree, name) != hash {
+			return false
+		}
+	}
+	return true
+}
+
+// treeFileHash returns the blob hash of a file in the tree, or the zero hash if absent.
+func treeFileHash(tree *object.Tree, name string) plumbing.Hash {
+	entry, err := tree.FindEntry(name)
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	return entry.Hash
+}
@@ -0,0 +1,208 @@
+package gitflow
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// setupMergeScenario creates a "feature" branch with one commit off main and
+// leaves main checked out. Returns the feature tip.
+func setupMergeScenario(t *testing.T, repo *git.Repository) plumbing.Hash {
+	t.Helper()
+	w, _ := repo.Worktree()
+	mainRef, _ := repo.Reference(plumbing.NewBranchReferenceName("main"), true)
+	featureRef := plumbing.NewBranchReferenceName("feature")
+	repo.Storer.SetReference(plumbing.NewHashReference(featureRef, mainRef.Hash()))
+	w.Checkout(&git.CheckoutOptions{Branch: featureRef})
+	tip := commitFile(t, repo, "feature.txt", "feature content", "Add feature")
+	w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("main")})
+	return tip
+}
+
+// commitOnMain commits the feature's content to main, optionally as a merge commit.
+func commitOnMain(t *testing.T, repo *git.Repository, dir, message string, parents ...plumbing.Hash) plumbing.Hash {
+	t.Helper()
+	w, _ := repo.Worktree()
+	os.WriteFile(filepath.Join(dir, "feature.txt"), []byte("feature content"), 0644)
+	w.Add("feature.txt")
+	opts := &git.CommitOptions{Author: &object.Signature{Name: "Test User", Email: "test@example.com"}}
+	if len(parents) > 0 {
+		head, _ := repo.Head()
+		opts.Parents = append([]plumbing.Hash{head.Hash()}, parents...)
+	}
+	hash, err := w.Commit(message, opts)
+	if err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	return hash
+}
+
+func moveToRemote(t *testing.T, repo *git.Repository, tip plumbing.Hash) {
+	t.Helper()
+	if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{"https://example.com/repo.git"}}); err != nil {
+		t.Fatalf("CreateRemote() error = %v", err)
+	}
+	repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "feature"), tip))
+	repo.Storer.RemoveReference(plumbing.NewBranchReferenceName("feature"))
+}
+
+func TestIsBranchMerged_Strategies(t *testing.T) {
+	// Each scenario sets up main and the feature branch. Squash scenarios return
+	// the squash commit, which the tree strategy should report as merge commit.
+	scenarios := []struct {
+		name  string
+		setup func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash
+		want  map[string]bool // strategies (comma-separated) → merged
+	}{
+		{
+			name: "unmerged",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				setupMergeScenario(t, repo)
+				return plumbing.ZeroHash
+			},
+			want: map[string]bool{"": false, "ancestry,tree": false, "ancestry,tree,remote": false, "message": false},
+		},
+		{
+			name: "fast-forward",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				tip := setupMergeScenario(t, repo)
+				repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("main"), tip))
+				return plumbing.ZeroHash
+			},
+			want: map[string]bool{"": true, "ancestry,tree": true, "ancestry,tree,remote": true, "message": false},
+		},
+		{
+			name: "merge commit",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				tip := setupMergeScenario(t, repo)
+				commitFile(t, repo, "other.txt", "other", "Other work")
+				commitOnMain(t, repo, dir, "Merge branch 'feature'", tip)
+				return plumbing.ZeroHash
+			},
+			want: map[string]bool{"": true, "ancestry,tree": true, "ancestry,tree,remote": true, "message": true},
+		},
+		{
+			name: "squash",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				setupMergeScenario(t, repo)
+				squash := commitOnMain(t, repo, dir, "Add feature (#1)")
+				commitFile(t, repo, "later.txt", "later", "Later work")
+				return squash
+			},
+			want: map[string]bool{"": false, "ancestry,tree": true, "ancestry,tree,remote": true, "message": false},
+		},
+		{
+			name: "squash, branch deleted everywhere",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				setupMergeScenario(t, repo)
+				commitOnMain(t, repo, dir, "Add feature (#1)")
+				repo.Storer.RemoveReference(plumbing.NewBranchReferenceName("feature"))
+				return plumbing.ZeroHash
+			},
+			want: map[string]bool{"": false, "ancestry,tree": false, "ancestry,tree,remote": false, "message": false},
+		},
+		{
+			name: "squash, branch only on remote",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				tip := setupMergeScenario(t, repo)
+				squash := commitOnMain(t, repo, dir, "Add feature (#1)")
+				moveToRemote(t, repo, tip)
+				return squash
+			},
+			want: map[string]bool{"": false, "ancestry,tree": false, "ancestry,tree,remote": true, "message": false},
+		},
+		{
+			name: "squash, content changed again on main",
+			setup: func(t *testing.T, dir string, repo *git.Repository) plumbing.Hash {
+				setupMergeScenario(t, repo)
+				commitOnMain(t, repo, dir, "Add feature (#1)")
+				commitFile(t, repo, "feature.txt", "rewritten", "Rewrite feature")
+				return plumbing.ZeroHash
+			},
+			want: map[string]bool{"": false, "ancestry,tree": false, "ancestry,tree,remote": false, "message": false},
+		},
+	}
+
+	for _, sc := range scenarios {
+		for strategies, want := range sc.want {
+			name := sc.name + "/" + strategies
+			if strategies == "" {
+				name = sc.name + "/default"
+			}
+			t.Run(name, func(t *testing.T) {
+				dir, repo := setupTestRepo(t)
+				wantHash := sc.setup(t, dir, repo)
+
+				gf, err := New(dir)
+				if err != nil {
+					t.Fatalf("New() error = %v", err)
+				}
+				var list []string
+				if strategies != "" {
+					list = strings.Split(strategies, ",")
+				}
+				if err := gf.SetMergeStrategies(list); err != nil {
+					t.Fatalf("SetMergeStrategies() error = %v", err)
+				}
+
+				merged, hash, err := gf.IsBranchMerged("feature", "main")
+				if err != nil {
+					t.Fatalf("IsBranchMerged() error = %v", err)
+				}
+				if merged != want {
+					t.Fatalf("IsBranchMerged() = %v, want %v", merged, want)
+				}
+				if merged && hash == nil {
+					t.Error("IsBranchMerged() hash = nil, want merge commit")
+				}
+				if merged && !wantHash.IsZero() && *hash != wantHash {
+					t.Errorf("IsBranchMerged() hash = %s, want %s", hash, wantHash)
+				}
+			})
+		}
+	}
+}
+
+func TestSetMergeStrategies_Invalid(t *testing.T) {
+	dir, _ := setupTestRepo(t)
+	gf, _ := New(dir)
+	if err := gf.SetMergeStrategies([]string{"ancestry", "psychic"}); err == nil {
+		t.Error("SetMergeStrategies() should reject unknown strategies")
+	}
+	if got := gf.MergeStrategies(); len(got) != len(DefaultMergeStrategies) {
+		t.Errorf("MergeStrategies() = %v, want defaults after failed set", got)
+	}
+}
+
+func TestGetBranchStatus_RemoteOnlyBranch(t *testing.T) {
+	tests := []struct {
+		strategies []string
+		want       BranchStatus
+	}{
+		{nil, BranchStatusDeleted},
+		{[]string{"ancestry", "remote"}, BranchStatusActive},
+	}
+
+	for _, tt := range tests {
+		dir, repo := setupTestRepo(t)
+		tip := setupMergeScenario(t, repo)
+		moveToRemote(t, repo, tip)
+
+		gf, _ := New(dir)
+		gf.SetMergeStrategies(tt.strategies)
+		status, err := gf.GetBranchStatus("feature", "main")
+		if err != nil {
+			t.Fatalf("GetBranchStatus() error = %v", err)
+		}
+		if status != tt.want {
+			t.Errorf("GetBranchStatus() with %v = %v, want %v", tt.strategies, status, tt.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package gitflow
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// MergeDriverName is the merge driver name used in .gitattributes and git config.
+const MergeDriverName = "beans"
+
+// MergeText performs a three-way line merge of text using `git merge-file`.
+// Returns the merged text and whether it contains conflict markers; labels
+// name the ours, base and theirs versions in those markers.
+func MergeText(base, ours, theirs string, labels [3]string) (string, bool, error) {
+	dir, err := os.MkdirTemp("", "beans-merge-")
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	paths := make([]string, 3)
+	for i, content := range []string{ours, base, theirs} {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("%d", i))
+		if err := os.WriteFile(paths[i], []byte(content), 0644); err != nil {
+			return "", false, fmt.Errorf("failed to write temp file: %w", err)
+		}
+	}
+
+	cmd := exec.Command("git", "merge-file", "-p",
+		"-L", labels[0], "-L", labels[1], "-L", labels[2],
+		paths[0], paths[1], paths[2])
+	out, err := cmd.Output()
+
+	// merge-file exits with the number of conflicts (capped at 127), or 255 on errors
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 && exitErr.ExitCode() < 128 {
+		return string(out), true, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("git merge-file failed: %w", err)
+	}
+	return string(out), false, nil
+}
+
+// mergeDriverCommand is the command git runs for the merge driver:
+// %O = base, %A = ours (and result), %B = theirs, %P = path in the repository.
+const mergeDriverCommand = "beans git merge-driver %O %A %B %P"
+
+// InstallMergeDriver registers the beans merge driver in the repository's git
+// config and routes bean files matching pattern (e.g. ".beans/**/*.md") to it
+// via .gitattributes. Returns the path of the .gitattributes file.
+func (g *GitFlow) InstallMergeDriver(pattern string) (string, error) {
+	cfg, err := g.repo.Config()
+	if err != nil {
+		return "", fmt.Errorf("failed to read git config: %w", err)
+	}
+	section := cfg.Raw.Section("merge").Subsection(MergeDriverName)
+	section.SetOption("name", "beans front matter aware merge")
+	section.SetOption("driver", mergeDriverCommand)
+	if err := g.repo.SetConfig(cfg); err != nil {
+		return "", fmt.Errorf("failed to write git config: %w", err)
+	}
+
+	path, err := g.gitattributesPath()
+	if err != nil {
+		return "", err
+	}
+	line := pattern + " merge=" + MergeDriverName
+	lines, err := readLines(path)
+	if err != nil {
+		return "", err
+	}
+	for _, l := range lines {
+		if l == line {
+			return path, nil
+		}
+	}
+	lines = append(lines, line)
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// UninstallMergeDriver removes the beans merge driver from the git config and
+// drops all .gitattributes lines routing files to it.
+func (g *GitFlow) UninstallMergeDriver() error {
+	cfg, err := g.repo.Config()
+	if err != nil {
+		return fmt.Errorf("failed to read git config: %w", err)
+	}
+	cfg.Raw.Section("merge").RemoveSubsection(MergeDriverName)
+	if err := g.repo.SetConfig(cfg); err != nil {
+		return fmt.Errorf("failed to write git config: %w", err)
+	}
+
+	path, err := g.gitattributesPath()
+	if err != nil {
+		return err
+	}
+	lines, err := readLines(path)
+	if err != nil || lines == nil {
+		return err
+	}
+	kept := lines[:0]
+	for _, l := range lines {
+		if !strings.HasSuffix(l, " merge="+MergeDriverName) {
+			kept = append(kept, l)
+		}
+	}
+	if len(kept) == 0 {
+		return os.Remove(path)
+	}
+	return os.WriteFile(path, []byte(strings.Join(kept, "\n")+"\n"), 0644)
+}
+
+// gitattributesPath returns the path of the .gitattributes file at the worktree root.
+func (g *GitFlow) gitattributesPath() (string, error) {
+	w, err := g.repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("failed to get worktree: %w", err)
+	}
+	return filepath.Join(w.Filesystem.Root(), ".gitattributes"), nil
+}
+
+// readLines reads a file into lines, returning nil if it doesn't exist.
+func readLines(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	content := strings.TrimRight(string(data), "\n")
+	if content == "" {
+		return nil, nil
+	}
+	return strings.Split(content, "\n"), nil
+}
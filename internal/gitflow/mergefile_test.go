@@ -0,0 +1,64 @@
+package gitflow
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeText(t *testing.T) {
+	labels := [3]string{"ours", "base", "theirs"}
+
+	merged, conflict, err := MergeText("a\nb\nc\n", "A\nb\nc\n", "a\nb\nC\n", labels)
+	if err != nil || conflict {
+		t.Fatalf("MergeText() = conflict %v, err %v; want clean merge", conflict, err)
+	}
+	if merged != "A\nb\nC\n" {
+		t.Errorf("MergeText() = %q, want %q", merged, "A\nb\nC\n")
+	}
+
+	merged, conflict, err = MergeText("a\n", "ours\n", "theirs\n", labels)
+	if err != nil || !conflict {
+		t.Fatalf("MergeText() = conflict %v, err %v; want conflict", conflict, err)
+	}
+	if !strings.Contains(merged, "<<<<<<< ours") || !strings.Contains(merged, ">>>>>>> theirs") {
+		t.Errorf("MergeText() should contain labeled conflict markers, got %q", merged)
+	}
+}
+
+func TestInstallMergeDriver(t *testing.T) {
+	dir, repo := setupTestRepo(t)
+	gf, _ := New(dir)
+
+	attrsPath := filepath.Join(dir, ".gitattributes")
+	os.WriteFile(attrsPath, []byte("*.png binary\n"), 0644)
+
+	// Installing twice must not duplicate the attributes line
+	for i := 0; i < 2; i++ {
+		if _, err := gf.InstallMergeDriver(".beans/**/*.md"); err != nil {
+			t.Fatalf("InstallMergeDriver() error = %v", err)
+		}
+	}
+
+	attrs, _ := os.ReadFile(attrsPath)
+	if string(attrs) != "*.png binary\n.beans/**/*.md merge=beans\n" {
+		t.Errorf(".gitattributes = %q", attrs)
+	}
+	cfg, _ := repo.Config()
+	if got := cfg.Raw.Section("merge").Subsection("beans").Option("driver"); got != mergeDriverCommand {
+		t.Errorf("merge.beans.driver = %q, want %q", got, mergeDriverCommand)
+	}
+
+	if err := gf.UninstallMergeDriver(); err != nil {
+		t.Fatalf("UninstallMergeDriver() error = %v", err)
+	}
+	attrs, _ = os.ReadFile(attrsPath)
+	if string(attrs) != "*.png binary\n" {
+		t.Errorf(".gitattributes after uninstall = %q", attrs)
+	}
+	cfg, _ = repo.Config()
+	if cfg.Raw.Section("merge").HasSubsection("beans") {
+		t.Error("merge.beans section should be removed")
+	}
+}
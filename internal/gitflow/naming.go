@@ -96,3 +96,117 @@ func containsBranchName(message, branchName string) bool {
 
 	return false
 }
+
+// MaxBranchNameLength limits the length of branch names produced from templates.
+const MaxBranchNameLength = 100
+
+// BranchNameData holds the values available to branch name templates.
+type BranchNameData struct {
+	ID   string
+	Slug string
+	Type string
+}
+
+// templatePlaceholder matches template placeholders like "{{.ID}}" or "{{ .Slug }}".
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*\.(\w+)\s*\}\}`)
+
+// danglingSeparator matches separators next to a slash and repeatedSlashes
+// runs of slashes, both left behind by empty placeholder values.
+var (
+	danglingSeparator = regexp.MustCompile(`/[-_]+|[-_]+/`)
+	repeatedSlashes   = regexp.MustCompile(`/{2,}`)
+)
+
+// ValidateBranchTemplate checks that a branch name template only uses known
+// placeholders ({{.ID}}, {{.Slug}}, {{.Type}}), includes {{.ID}} so branches
+// can be traced back to their bean, and produces valid git ref names.
+// An empty template is valid and selects the default "{{.ID}}/{{.Slug}}" naming.
+func ValidateBranchTemplate(tmpl string) error {
+	if tmpl == "" {
+		return nil
+	}
+
+	hasID := false
+	for _, m := range templatePlaceholder.FindAllStringSubmatch(tmpl, -1) {
+		switch m[1] {
+		case "ID":
+			hasID = true
+		case "Slug", "Type":
+		default:
+			return fmt.Errorf("branch template: unknown placeholder %q (use {{.ID}}, {{.Slug}} or {{.Type}})", m[0])
+		}
+	}
+	if !hasID {
+		return fmt.Errorf("branch template must include {{.ID}}")
+	}
+
+	literal := templatePlaceholder.ReplaceAllString(tmpl, "")
+	if strings.Contains(literal, "{{") || strings.Contains(literal, "}}") {
+		return fmt.Errorf("branch template: unsupported template syntax in %q", tmpl)
+	}
+
+	sample := BranchNameData{ID: "beans-abc1", Slug: "example", Type: "feature"}
+	if _, err := RenderBranchName(tmpl, sample); err != nil {
+		return fmt.Errorf("branch template: %w", err)
+	}
+	return nil
+}
+
+// RenderBranchName builds a branch name from a template and bean data.
+// Slug and type are sanitized; separators left dangling by an empty slug or
+// type are removed. An empty template falls back to BuildBranchName.
+func RenderBranchName(tmpl string, data BranchNameData) (string, error) {
+	if tmpl == "" {
+		return BuildBranchName(data.ID, data.Slug), nil
+	}
+
+	values := map[string]string{
+		"ID":   data.ID,
+		"Slug": SanitizeSlug(data.Slug),
+		"Type": SanitizeSlug(data.Type),
+	}
+	name := templatePlaceholder.ReplaceAllStringFunc(tmpl, func(m string) string {
+		return values[templatePlaceholder.FindStringSubmatch(m)[1]]
+	})
+
+	// Clean up separators around empty values (e.g. "feature//beans-abc1-")
+	name = danglingSeparator.ReplaceAllString(name, "/")
+	name = repeatedSlashes.ReplaceAllString(name, "/")
+	name = strings.Trim(name, "/-_")
+
+	if err := ValidateBranchName(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// ValidateBranchName checks a branch name against git's ref name rules
+// (see git-check-ref-format) and MaxBranchNameLength.
+func ValidateBranchName(name string) error {
+	if name == "" {
+		return fmt.Errorf("branch name is empty")
+	}
+	if len(name) > MaxBranchNameLength {
+		return fmt.Errorf("branch name %q exceeds %d characters", name, MaxBranchNameLength)
+	}
+	if name == "@" || strings.Contains(name, "@{") {
+		return fmt.Errorf("branch name %q contains \"@{\" or is \"@\"", name)
+	}
+	if strings.Contains(name, "..") || strings.Contains(name, "//") {
+		return fmt.Errorf("branch name %q contains \"..\" or \"//\"", name)
+	}
+	if strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") || strings.HasSuffix(name, ".") {
+		return fmt.Errorf("branch name %q must not start or end with \"/\" or end with \".\"", name)
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("branch name %q contains illegal character %q", name, r)
+		}
+	}
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") || strings.HasSuffix(part, ".lock") {
+			return fmt.Errorf("branch name %q has a component starting with \".\" or ending with \".lock\"", name)
+		}
+	}
+	return nil
+}
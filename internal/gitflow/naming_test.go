@@ -300,3 +300,124 @@ func TestSanitizeSlugConsecutiveHyphens(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderBranchName(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    string
+		data    BranchNameData
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "empty template uses default naming",
+			tmpl: "",
+			data: BranchNameData{ID: "beans-abc1", Slug: "login"},
+			want: "beans-abc1/login",
+		},
+		{
+			name: "type and slug",
+			tmpl: "feature/{{.Type}}/{{.ID}}-{{.Slug}}",
+			data: BranchNameData{ID: "beans-abc1", Slug: "User Login", Type: "epic"},
+			want: "feature/epic/beans-abc1-user-login",
+		},
+		{
+			name: "placeholder with spaces",
+			tmpl: "{{ .ID }}/{{ .Slug }}",
+			data: BranchNameData{ID: "beans-abc1", Slug: "login"},
+			want: "beans-abc1/login",
+		},
+		{
+			name: "empty slug and type are cleaned up",
+			tmpl: "feature/{{.Type}}/{{.ID}}-{{.Slug}}",
+			data: BranchNameData{ID: "beans-abc1"},
+			want: "feature/beans-abc1",
+		},
+		{
+			name:    "illegal characters in template",
+			tmpl:    "feat~{{.ID}}",
+			data:    BranchNameData{ID: "beans-abc1"},
+			wantErr: true,
+		},
+		{
+			name:    "too long",
+			tmpl:    strings.Repeat("x", MaxBranchNameLength) + "/{{.ID}}",
+			data:    BranchNameData{ID: "beans-abc1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderBranchName(tt.tmpl, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderBranchName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RenderBranchName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBranchTemplate(t *testing.T) {
+	tests := []struct {
+		tmpl    string
+		wantErr bool
+	}{
+		{"", false},
+		{"{{.ID}}/{{.Slug}}", false},
+		{"feature/{{.Type}}/{{.ID}}-{{.Slug}}", false},
+		{"{{.Slug}}", true},             // missing ID
+		{"{{.ID}}/{{.Title}}", true},    // unknown placeholder
+		{"{{.ID}}/{{if .Slug}}x", true}, // unsupported syntax
+		{"{{.ID}} {{.Slug}}", true},     // space is illegal in refs
+		{"{{.ID}}..{{.Slug}}", true},
+		{".hidden/{{.ID}}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			err := ValidateBranchTemplate(tt.tmpl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBranchTemplate(%q) error = %v, wantErr %v", tt.tmpl, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBranchName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"beans-abc1/login", false},
+		{"feature/task/beans-abc1-login", false},
+		{"", true},
+		{"@", true},
+		{"a@{b", true},
+		{"a..b", true},
+		{"a//b", true},
+		{"/a", true},
+		{"a/", true},
+		{"a.", true},
+		{"a/b.lock", true},
+		{"a/.b", true},
+		{"a b", true},
+		{"a:b", true},
+		{"a?b", true},
+		{"a*b", true},
+		{"a[b", true},
+		{"a\\b", true},
+		{"a\tb", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBranchName(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBranchName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
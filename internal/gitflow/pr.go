@@ -0,0 +1,124 @@
+package gitflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// PRState is the state of a pull request as reported by the hosting provider.
+type PRState string
+
+const (
+	PRStateOpen   PRState = "open"
+	PRStateMerged PRState = "merged"
+	PRStateClosed PRState = "closed" // closed without merging
+)
+
+// ErrUnsupportedPRURL is returned when no provider can handle a pull request URL.
+var ErrUnsupportedPRURL = errors.New("unsupported pull request URL")
+
+// PRStatus describes the current state of a pull request.
+type PRStatus struct {
+	State       PRState
+	MergedAt    *time.Time
+	MergeCommit string
+}
+
+// PRProvider looks up pull request state from a hosting provider's API.
+type PRProvider interface {
+	// PRStatus returns the status of the pull request at the given URL.
+	// Returns ErrUnsupportedPRURL if the provider can't handle the URL.
+	PRStatus(ctx context.Context, prURL string) (*PRStatus, error)
+}
+
+// githubPRPattern matches GitHub pull request URLs, e.g. https://github.com/owner/repo/pull/123.
+var githubPRPattern = regexp.MustCompile(`^/([^/]+)/([^/]+)/pull/(\d+)/?$`)
+
+// GitHubProvider looks up pull requests via the GitHub REST API.
+type GitHubProvider struct {
+	// BaseURL is the API root (default "https://api.github.com").
+	BaseURL string
+	// Host is the web host PR URLs must use (default "github.com").
+	Host string
+	// Token is an optional API token (raises rate limits, required for private repos).
+	Token string
+	// Client is the HTTP client used for requests (default: 10s timeout).
+	Client *http.Client
+}
+
+// NewGitHubProvider returns a GitHubProvider for github.com using the given token.
+func NewGitHubProvider(token string) *GitHubProvider {
+	return &GitHubProvider{
+		BaseURL: "https://api.github.com",
+		Host:    "github.com",
+		Token:   token,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ParseGitHubPRURL extracts owner, repository and number from a GitHub pull request URL.
+func ParseGitHubPRURL(prURL, host string) (owner, repo, number string, err error) {
+	u, err := url.Parse(prURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || !strings.EqualFold(u.Host, host) {
+		return "", "", "", fmt.Errorf("%w: %s", ErrUnsupportedPRURL, prURL)
+	}
+	m := githubPRPattern.FindStringSubmatch(u.Path)
+	if m == nil {
+		return "", "", "", fmt.Errorf("%w: %s", ErrUnsupportedPRURL, prURL)
+	}
+	return m[1], m[2], m[3], nil
+}
+
+// PRStatus implements PRProvider.
+func (p *GitHubProvider) PRStatus(ctx context.Context, prURL string) (*PRStatus, error) {
+	owner, repo, number, err := ParseGitHubPRURL(prURL, p.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("%s/repos/%s/%s/pulls/%s", strings.TrimRight(p.BaseURL, "/"), owner, repo, number)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Token)
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pull request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query pull request %s: %s", prURL, resp.Status)
+	}
+
+	var pr struct {
+		State          string     `json:"state"`
+		Merged         bool       `json:"merged"`
+		MergedAt       *time.Time `json:"merged_at"`
+		MergeCommitSHA string     `json:"merge_commit_sha"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return nil, fmt.Errorf("failed to decode pull request: %w", err)
+	}
+
+	switch {
+	case pr.Merged || pr.MergedAt != nil:
+		return &PRStatus{State: PRStateMerged, MergedAt: pr.MergedAt, MergeCommit: pr.MergeCommitSHA}, nil
+	case pr.State == "closed":
+		return &PRStatus{State: PRStateClosed}, nil
+	default:
+		return &PRStatus{State: PRStateOpen}, nil
+	}
+}
@@ -0,0 +1,104 @@
+package gitflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseGitHubPRURL(t *testing.T) {
+	tests := []struct {
+		url       string
+		wantOwner string
+		wantRepo  string
+		wantNum   string
+		wantErr   bool
+	}{
+		{"https://github.com/hmans/beans/pull/42", "hmans", "beans", "42", false},
+		{"https://github.com/hmans/beans/pull/42/", "hmans", "beans", "42", false},
+		{"https://GitHub.com/hmans/beans/pull/7", "hmans", "beans", "7", false},
+		{"https://github.com/hmans/beans/issues/42", "", "", "", true},
+		{"https://gitlab.com/hmans/beans/pull/42", "", "", "", true},
+		{"github.com/hmans/beans/pull/42", "", "", "", true},
+		{"not a url", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			owner, repo, num, err := ParseGitHubPRURL(tt.url, "github.com")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseGitHubPRURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrUnsupportedPRURL) {
+				t.Errorf("error should wrap ErrUnsupportedPRURL, got %v", err)
+			}
+			if owner != tt.wantOwner || repo != tt.wantRepo || num != tt.wantNum {
+				t.Errorf("ParseGitHubPRURL() = %q, %q, %q; want %q, %q, %q", owner, repo, num, tt.wantOwner, tt.wantRepo, tt.wantNum)
+			}
+		})
+	}
+}
+
+func TestGitHubProvider_PRStatus(t *testing.T) {
+	responses := map[string]string{
+		"/repos/o/r/pulls/1": `{"state": "open", "merged": false}`,
+		"/repos/o/r/pulls/2": `{"state": "closed", "merged": true, "merged_at": "2024-05-01T12:00:00Z", "merge_commit_sha": "abc123"}`,
+		"/repos/o/r/pulls/3": `{"state": "closed", "merged": false}`,
+	}
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	provider := NewGitHubProvider("secret")
+	provider.BaseURL = server.URL
+	provider.Client = server.Client()
+
+	tests := []struct {
+		name       string
+		url        string
+		wantState  PRState
+		wantCommit string
+		wantErr    bool
+	}{
+		{"open", "https://github.com/o/r/pull/1", PRStateOpen, "", false},
+		{"merged", "https://github.com/o/r/pull/2", PRStateMerged, "abc123", false},
+		{"closed", "https://github.com/o/r/pull/3", PRStateClosed, "", false},
+		{"not found", "https://github.com/o/r/pull/4", "", "", true},
+		{"unsupported", "https://example.com/o/r/pull/1", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := provider.PRStatus(context.Background(), tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PRStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if status.State != tt.wantState {
+				t.Errorf("PRStatus().State = %q, want %q", status.State, tt.wantState)
+			}
+			if status.MergeCommit != tt.wantCommit {
+				t.Errorf("PRStatus().MergeCommit = %q, want %q", status.MergeCommit, tt.wantCommit)
+			}
+			if tt.wantState == PRStateMerged && status.MergedAt == nil {
+				t.Error("PRStatus().MergedAt should be set for merged PRs")
+			}
+		})
+	}
+
+	if gotAuth != "Bearer secret" {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, "Bearer secret")
+	}
+}
@@ -0,0 +1,104 @@
+package gitflow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrDiverged is returned by Pull when the local branch has commits the
+// remote branch doesn't, so it can't be fast-forwarded.
+var ErrDiverged = errors.New("branch has diverged from its remote - merge or rebase it first")
+
+// File change kinds reported by Pull.
+const (
+	FileAdded    = "added"
+	FileModified = "modified"
+	FileDeleted  = "deleted"
+)
+
+// FileChange is a file changed between two commits.
+type FileChange struct {
+	Change string // FileAdded, FileModified or FileDeleted
+	Path   string // relative to the repository root
+}
+
+// PullResult describes what Pull did.
+type PullResult struct {
+	Remote  string
+	Branch  string
+	From    string // commit the branch was at before
+	To      string // commit the branch is at now (From if nothing was pulled)
+	Changes []FileChange
+}
+
+// Pull fetches branch from remote and fast-forwards the local branch to it.
+// It never merges: if the local branch has diverged, it fails with ErrDiverged
+// and leaves everything as it was. When branch is checked out, the working
+// tree is updated too, which git refuses if that would overwrite uncommitted
+// changes. Changes lists the files matching paths (git pathspecs, all files
+// if none) that the fast-forward changed.
+func (g *GitFlow) Pull(remote, branch string, paths ...string) (*PullResult, error) {
+	result := &PullResult{Remote: remote, Branch: branch}
+	remoteRef := "refs/remotes/" + remote + "/" + branch
+
+	if _, err := g.runGit("fetch", remote, "+refs/heads/"+branch+":"+remoteRef); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s from %s: %w", branch, remote, err)
+	}
+	from, err := g.runGit("rev-parse", "--verify", "refs/heads/"+branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve branch %s: %w", branch, err)
+	}
+	to, err := g.runGit("rev-parse", "--verify", remoteRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s/%s: %w", remote, branch, err)
+	}
+	result.From, result.To = from, from
+	if from == to {
+		return result, nil
+	}
+
+	if _, err := g.runGit("merge-base", "--is-ancestor", from, to); err != nil {
+		// Local commits the remote doesn't have yet are fine, as long as the
+		// remote has nothing new either.
+		if _, err := g.runGit("merge-base", "--is-ancestor", to, from); err == nil {
+			return result, nil
+		}
+		return nil, fmt.Errorf("cannot pull %s/%s: %w", remote, branch, ErrDiverged)
+	}
+
+	current, err := g.GetCurrentBranch()
+	if err != nil {
+		return nil, err
+	}
+	if current == branch {
+		_, err = g.runGit("merge", "--ff-only", "--quiet", remoteRef)
+	} else {
+		_, err = g.runGit("update-ref", "refs/heads/"+branch, to, from)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fast-forward %s: %w", branch, err)
+	}
+	result.To = to
+
+	args := append([]string{"diff", "--name-status", "--no-renames", from, to, "--"}, paths...)
+	out, err := g.runGit(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pulled changes: %w", err)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		status, path, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		change := FileModified
+		switch status {
+		case "A":
+			change = FileAdded
+		case "D":
+			change = FileDeleted
+		}
+		result.Changes = append(result.Changes, FileChange{Change: change, Path: path})
+	}
+	return result, nil
+}
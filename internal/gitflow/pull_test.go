@@ -0,0 +1,133 @@
+package gitflow
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// gitCmd runs a git command in dir, failing the test on error.
+func gitCmd(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	args = append([]string{"-C", dir, "-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)
+	out, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+	return string(out)
+}
+
+// setupPullRepos creates a repository with an origin remote, plus a second
+// clone of the remote to push upstream changes from.
+func setupPullRepos(t *testing.T) (local, upstream string) {
+	t.Helper()
+	local, _ = setupTestRepo(t)
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	gitCmd(t, local, "clone", "--quiet", "--bare", local, remote)
+	gitCmd(t, local, "remote", "add", "origin", remote)
+	gitCmd(t, local, "fetch", "--quiet", "origin")
+
+	upstream = filepath.Join(t.TempDir(), "upstream")
+	gitCmd(t, local, "clone", "--quiet", "--branch", "main", remote, upstream)
+	return local, upstream
+}
+
+// pushUpstream commits files to the upstream clone and pushes them.
+func pushUpstream(t *testing.T, upstream string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(upstream, name)
+		if content == "" {
+			gitCmd(t, upstream, "rm", "--quiet", name)
+			continue
+		}
+		os.MkdirAll(filepath.Dir(path), 0755)
+		os.WriteFile(path, []byte(content), 0644)
+		gitCmd(t, upstream, "add", name)
+	}
+	gitCmd(t, upstream, "commit", "--quiet", "-m", "upstream change")
+	gitCmd(t, upstream, "push", "--quiet", "origin", "main")
+}
+
+func TestPull(t *testing.T) {
+	local, upstream := setupPullRepos(t)
+	gf, err := New(local)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	result, err := gf.Pull("origin", "main", ".beans")
+	if err != nil {
+		t.Fatalf("Pull() error = %v", err)
+	}
+	if result.From != result.To || len(result.Changes) != 0 {
+		t.Errorf("Pull() when up to date = %+v", result)
+	}
+
+	pushUpstream(t, upstream, map[string]string{".beans/a.md": "a\n", ".beans/b.md": "b\n", "other.txt": "x\n"})
+	pushUpstream(t, upstream, map[string]string{".beans/a.md": "changed\n", ".beans/b.md": ""})
+	pushUpstream(t, upstream, map[string]string{".beans/c.md": "c\n"})
+	gitCmd(t, upstream, "rm", "--quiet", ".beans/c.md")
+	gitCmd(t, upstream, "commit", "--quiet", "-m", "remove c")
+	gitCmd(t, upstream, "push", "--quiet", "origin", "main")
+
+	result, err = gf.Pull("origin", "main", ".beans")
+	if err != nil {
+		t.Fatalf("Pull() error = %v", err)
+	}
+	if want := []FileChange{{FileAdded, ".beans/a.md"}}; !reflect.DeepEqual(result.Changes, want) {
+		t.Errorf("Changes = %+v, want %+v", result.Changes, want)
+	}
+	if content, _ := os.ReadFile(filepath.Join(local, ".beans", "a.md")); string(content) != "changed\n" {
+		t.Errorf("a.md = %q, want the pulled content", content)
+	}
+
+	// A branch that isn't checked out is fast-forwarded without touching the tree
+	gitCmd(t, local, "checkout", "--quiet", "-b", "feature")
+	pushUpstream(t, upstream, map[string]string{".beans/d.md": "d\n"})
+	result, err = gf.Pull("origin", "main", ".beans")
+	if err != nil {
+		t.Fatalf("Pull() on another branch error = %v", err)
+	}
+	if want := []FileChange{{FileAdded, ".beans/d.md"}}; !reflect.DeepEqual(result.Changes, want) {
+		t.Errorf("Changes = %+v, want %+v", result.Changes, want)
+	}
+	if head := gitCmd(t, local, "rev-parse", "main"); head[:len(result.To)] != result.To {
+		t.Errorf("main = %s, want %s", head, result.To)
+	}
+	if _, err := os.Stat(filepath.Join(local, ".beans", "d.md")); err == nil {
+		t.Error("d.md should not be checked out on the feature branch")
+	}
+}
+
+func TestPull_Diverged(t *testing.T) {
+	local, upstream := setupPullRepos(t)
+	gf, _ := New(local)
+
+	pushUpstream(t, upstream, map[string]string{".beans/a.md": "theirs\n"})
+	os.MkdirAll(filepath.Join(local, ".beans"), 0755)
+	os.WriteFile(filepath.Join(local, ".beans", "a.md"), []byte("ours\n"), 0644)
+	gitCmd(t, local, "add", ".beans/a.md")
+	gitCmd(t, local, "commit", "--quiet", "-m", "local change")
+	before := gitCmd(t, local, "rev-parse", "main")
+
+	if _, err := gf.Pull("origin", "main", ".beans"); !errors.Is(err, ErrDiverged) {
+		t.Fatalf("Pull() error = %v, want ErrDiverged", err)
+	}
+	if after := gitCmd(t, local, "rev-parse", "main"); after != before {
+		t.Error("Pull() moved a diverged branch")
+	}
+
+	// Local commits alone are nothing to pull
+	gitCmd(t, local, "reset", "--quiet", "--hard", "origin/main")
+	os.WriteFile(filepath.Join(local, ".beans", "b.md"), []byte("new\n"), 0644)
+	gitCmd(t, local, "add", ".beans/b.md")
+	gitCmd(t, local, "commit", "--quiet", "-m", "ahead")
+	result, err := gf.Pull("origin", "main", ".beans")
+	if err != nil || result.From != result.To {
+		t.Errorf("Pull() when ahead = %+v, %v; want nothing pulled", result, err)
+	}
+}
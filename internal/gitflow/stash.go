@@ -0,0 +1,50 @@
+package gitflow
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// go-git doesn't implement stashing, so these helpers shell out to the git CLI.
+
+// Stash saves all uncommitted changes (including untracked files) to the git
+// stash and cleans the working tree. Returns false if there was nothing to stash.
+func (g *GitFlow) Stash(message string) (bool, error) {
+	clean, err := g.IsWorkingTreeClean()
+	if err != nil {
+		return false, err
+	}
+	if clean {
+		return false, nil
+	}
+
+	if _, err := g.runGit("stash", "push", "--include-untracked", "-m", message); err != nil {
+		return false, fmt.Errorf("failed to stash changes: %w", err)
+	}
+	return true, nil
+}
+
+// StashPop restores the most recent stash entry onto the working tree.
+// If restoring fails (e.g. due to conflicts), the entry is kept in the stash.
+func (g *GitFlow) StashPop() error {
+	if _, err := g.runGit("stash", "pop"); err != nil {
+		return fmt.Errorf("failed to restore stashed changes: %w", err)
+	}
+	return nil
+}
+
+// runGit runs a git command in the repository and returns its trimmed output.
+func (g *GitFlow) runGit(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", g.repoPath}, args...)...)
+	g.logger.Debug("running git", "args", strings.Join(args, " "))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		msg := strings.TrimSpace(string(out))
+		if msg == "" {
+			return "", err
+		}
+		return "", fmt.Errorf("%w: %s", err, msg)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
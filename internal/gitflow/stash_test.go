@@ -0,0 +1,46 @@
+package gitflow
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStash(t *testing.T) {
+	dir, _ := setupTestRepo(t)
+	gf, err := New(dir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// Nothing to stash on a clean tree
+	stashed, err := gf.Stash("test")
+	if err != nil || stashed {
+		t.Fatalf("Stash() on clean tree = %v, %v; want false, nil", stashed, err)
+	}
+
+	os.WriteFile(filepath.Join(dir, "README.md"), []byte("changed\n"), 0644)
+	os.WriteFile(filepath.Join(dir, "new.txt"), []byte("untracked\n"), 0644)
+
+	stashed, err = gf.Stash("test")
+	if err != nil || !stashed {
+		t.Fatalf("Stash() = %v, %v; want true, nil", stashed, err)
+	}
+	if clean, _ := gf.IsWorkingTreeClean(); !clean {
+		t.Error("working tree should be clean after Stash()")
+	}
+
+	if err := gf.StashPop(); err != nil {
+		t.Fatalf("StashPop() error = %v", err)
+	}
+	if content, _ := os.ReadFile(filepath.Join(dir, "README.md")); string(content) != "changed\n" {
+		t.Errorf("README.md = %q, want restored change", content)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "new.txt")); err != nil {
+		t.Errorf("untracked file should be restored: %v", err)
+	}
+
+	if err := gf.StashPop(); err == nil {
+		t.Error("StashPop() with empty stash should fail")
+	}
+}
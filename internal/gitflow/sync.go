@@ -21,6 +21,13 @@ func (g *GitFlow) GetBranchStatus(branchName, baseBranch string) (BranchStatus,
 		return BranchStatusUnknown, err
 	}
 
+	// With remote inspection, a branch that only exists on a remote is still alive
+	if !exists && g.hasMergeStrategy(MergeStrategyRemote) {
+		if exists, err = g.RemoteBranchExists(branchName); err != nil {
+			return BranchStatusUnknown, err
+		}
+	}
+
 	if !exists {
 		// Branch doesn't exist - check if it was merged and deleted
 		merged, _, err := g.IsBranchMerged(branchName, baseBranch)
@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hmans/beans/internal/config"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrCodeForbidden is the error code (in the error's extensions) of
+// mutations sent with a token that only has read scope.
+const ErrCodeForbidden = "FORBIDDEN"
+
+// ErrUnauthorized is returned for missing, unknown or invalid tokens.
+var ErrUnauthorized = errors.New("invalid or missing bearer token")
+
+// Authenticator checks bearer tokens for the HTTP server.
+type Authenticator interface {
+	// Authenticate returns the scope (config.ScopeRead or config.ScopeWrite)
+	// the token grants, or an error if it isn't valid.
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// authenticator accepts static tokens and, if configured, OIDC-issued JWTs.
+type authenticator struct {
+	tokens []staticToken
+	oidc   *oidcVerifier
+}
+
+type staticToken struct {
+	value []byte
+	scope string
+}
+
+// NewAuthenticator returns an Authenticator for the auth settings, or nil
+// if auth isn't enabled. Tokens read from the environment must be set.
+func NewAuthenticator(cfg config.AuthConfig) (Authenticator, error) {
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+	a := &authenticator{}
+	for _, t := range cfg.Tokens {
+		value := t.Value()
+		if value == "" {
+			if t.TokenEnv != "" {
+				return nil, fmt.Errorf("auth token %s: environment variable %s is not set", t.Name, t.TokenEnv)
+			}
+			return nil, fmt.Errorf("auth token %s: token is empty", t.Name)
+		}
+		a.tokens = append(a.tokens, staticToken{value: []byte(value), scope: t.GetScope()})
+	}
+	if cfg.OIDC != nil {
+		a.oidc = newOIDCVerifier(*cfg.OIDC)
+	}
+	return a, nil
+}
+
+func (a *authenticator) Authenticate(ctx context.Context, token string) (string, error) {
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(t.value, []byte(token)) == 1 {
+			return t.scope, nil
+		}
+	}
+	if a.oidc != nil && strings.Count(token, ".") == 2 {
+		return a.oidc.verify(ctx, token)
+	}
+	return "", ErrUnauthorized
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+type scopeKey struct{}
+
+// withScope returns a context carrying the authenticated client's scope.
+func withScope(ctx context.Context, scope string) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// requireWriteScope is an executor extension that rejects mutations from
+// clients whose token only has read scope.
+type requireWriteScope struct{}
+
+var _ graphql.OperationContextMutator = requireWriteScope{}
+
+func (requireWriteScope) ExtensionName() string { return "RequireWriteScope" }
+
+func (requireWriteScope) Validate(graphql.ExecutableSchema) error { return nil }
+
+func (requireWriteScope) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Mutation {
+		return nil
+	}
+	if scope, _ := ctx.Value(scopeKey{}).(string); scope == config.ScopeWrite {
+		return nil
+	}
+	err := gqlerror.Errorf("mutations require a token with %s scope", config.ScopeWrite)
+	err.Extensions = map[string]any{"code": ErrCodeForbidden}
+	return err
+}
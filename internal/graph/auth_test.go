@@ -0,0 +1,212 @@
+package graph
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestHandlerAuth(t *testing.T) {
+	_, core := setupTestResolver(t)
+	createTestBean(t, core, "srv-1", "Served", "todo")
+	auth, err := NewAuthenticator(config.AuthConfig{Tokens: []config.AuthToken{
+		{Name: "dashboard", Token: "read-token"},
+		{Name: "ci", Token: "write-token", Scope: config.ScopeWrite},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(core, ServerOptions{Auth: auth})
+
+	query := `{"query": "{ bean(id: \"srv-1\") { title } }"}`
+	mutation := `{"query": "mutation { updateBean(id: \"srv-1\", input: { status: \"completed\" }) { status } }"}`
+	request := func(body, token string) *http.Request {
+		req := postGraphQL(body)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req
+	}
+
+	tests := []struct {
+		name     string
+		req      *http.Request
+		wantCode int
+		wantErr  string // error code in extensions, if any
+	}{
+		{"no token", request(query, ""), http.StatusUnauthorized, ""},
+		{"unknown token", request(query, "nope"), http.StatusUnauthorized, ""},
+		{"read token query", request(query, "read-token"), http.StatusOK, ""},
+		{"read token mutation", request(mutation, "read-token"), http.StatusOK, ErrCodeForbidden},
+		{"write token mutation", request(mutation, "write-token"), http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := doGraphQL(t, h, tt.req)
+			if code != tt.wantCode {
+				t.Fatalf("status = %d, want %d (errors %v)", code, tt.wantCode, resp.Errors)
+			}
+			switch {
+			case code != http.StatusOK:
+				if len(resp.Errors) == 0 {
+					t.Error("expected an error message")
+				}
+			case tt.wantErr == "" && len(resp.Errors) > 0:
+				t.Errorf("unexpected errors %v", resp.Errors)
+			case tt.wantErr != "" && (len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != tt.wantErr):
+				t.Errorf("errors = %v, want %s", resp.Errors, tt.wantErr)
+			}
+		})
+	}
+
+	if b, _ := core.Get("srv-1"); b.Status != "completed" {
+		t.Errorf("status = %s, want completed after the write token's mutation", b.Status)
+	}
+}
+
+func TestNewAuthenticatorTokenEnv(t *testing.T) {
+	cfg := config.AuthConfig{Tokens: []config.AuthToken{{Name: "ci", TokenEnv: "BEANS_TEST_TOKEN"}}}
+
+	if _, err := NewAuthenticator(cfg); err == nil {
+		t.Error("expected an error when the token's environment variable isn't set")
+	}
+
+	t.Setenv("BEANS_TEST_TOKEN", "secret")
+	auth, err := NewAuthenticator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scope, err := auth.Authenticate(context.Background(), "secret"); err != nil || scope != config.ScopeRead {
+		t.Errorf("Authenticate() = %q, %v; want read", scope, err)
+	}
+
+	if auth, _ := NewAuthenticator(config.AuthConfig{}); auth != nil {
+		t.Error("expected no authenticator without auth settings")
+	}
+}
+
+// testIssuer is an OIDC provider serving discovery and JWKS documents.
+type testIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	iss := &testIssuer{kid: "key-1"}
+	iss.rotate(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": iss.server.URL, "jwks_uri": iss.server.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		pub := iss.key.PublicKey
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA", "kid": iss.kid, "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}}})
+	})
+	iss.server = httptest.NewServer(mux)
+	t.Cleanup(iss.server.Close)
+	return iss
+}
+
+// rotate replaces the signing key.
+func (iss *testIssuer) rotate(t *testing.T) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss.key = key
+}
+
+// sign returns an RS256 JWT with the given claims.
+func (iss *testIssuer) sign(t *testing.T, alg string, claims map[string]any) string {
+	t.Helper()
+	encode := func(v any) string {
+		data, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	input := encode(map[string]string{"alg": alg, "kid": iss.kid, "typ": "JWT"}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, iss.key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDCVerifier(t *testing.T) {
+	iss := newTestIssuer(t)
+	now := time.Now()
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{"iss": iss.server.URL, "aud": "beans", "exp": now.Add(time.Hour).Unix(), "scope": "openid"}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+
+	v := newOIDCVerifier(config.OIDCConfig{Issuer: iss.server.URL, Audience: "beans"})
+	tests := []struct {
+		name      string
+		token     string
+		wantScope string
+	}{
+		{"valid", iss.sign(t, "RS256", claims(nil)), config.ScopeRead},
+		{"write scope", iss.sign(t, "RS256", claims(map[string]any{"scope": "openid beans:write"})), config.ScopeWrite},
+		{"write scope in scp", iss.sign(t, "RS256", claims(map[string]any{"scope": nil, "scp": []string{"beans:write"}})), config.ScopeWrite},
+		{"audience list", iss.sign(t, "RS256", claims(map[string]any{"aud": []string{"other", "beans"}})), config.ScopeRead},
+		{"expired", iss.sign(t, "RS256", claims(map[string]any{"exp": now.Add(-time.Hour).Unix()})), ""},
+		{"no expiry", iss.sign(t, "RS256", claims(map[string]any{"exp": nil})), ""},
+		{"not yet valid", iss.sign(t, "RS256", claims(map[string]any{"nbf": now.Add(time.Hour).Unix()})), ""},
+		{"wrong audience", iss.sign(t, "RS256", claims(map[string]any{"aud": "other"})), ""},
+		{"wrong issuer", iss.sign(t, "RS256", claims(map[string]any{"iss": "https://evil.example"})), ""},
+		{"unsupported algorithm", iss.sign(t, "HS256", claims(nil)), ""},
+		{"tampered", iss.sign(t, "RS256", claims(nil)) + "x", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := v.verify(context.Background(), tt.token)
+			if tt.wantScope == "" {
+				if !errors.Is(err, ErrUnauthorized) {
+					t.Errorf("verify() = %q, %v; want ErrUnauthorized", scope, err)
+				}
+				return
+			}
+			if err != nil || scope != tt.wantScope {
+				t.Errorf("verify() = %q, %v; want %q", scope, err, tt.wantScope)
+			}
+		})
+	}
+
+	t.Run("key rotation", func(t *testing.T) {
+		iss.rotate(t)
+		iss.kid = "key-2"
+		token := iss.sign(t, "RS256", claims(nil))
+
+		// Keys were fetched moments ago, so the new key isn't picked up yet.
+		if _, err := v.verify(context.Background(), token); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("verify() error = %v, want ErrUnauthorized before refresh", err)
+		}
+		v.now = func() time.Time { return now.Add(2 * oidcRefreshInterval) }
+		if _, err := v.verify(context.Background(), token); err != nil {
+			t.Errorf("verify() error = %v after refresh", err)
+		}
+	})
+}
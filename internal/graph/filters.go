@@ -1,6 +1,12 @@
 package graph
 
 import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
 	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/graph/model"
@@ -15,6 +21,25 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 
 	result := beans
 
+	// Filter expression, applied clause by clause
+	if filter.Query != nil && *filter.Query != "" {
+		clauses, err := ParseQuery(*filter.Query, core.Config(), time.Now())
+		if err != nil {
+			return nil
+		}
+		for _, clause := range clauses {
+			result = ApplyFilter(result, clause, core)
+		}
+	}
+
+	// Title filters
+	if filter.TitleMatches != nil && *filter.TitleMatches != "" {
+		result = filterByTitlePattern(result, *filter.TitleMatches)
+	}
+	if filter.TitleFuzzy != nil && *filter.TitleFuzzy != "" {
+		result = filterByTitleFuzzy(result, *filter.TitleFuzzy)
+	}
+
 	// Status filters
 	if len(filter.Status) > 0 {
 		result = filterByField(result, filter.Status, func(b *bean.Bean) string { return b.Status })
@@ -47,6 +72,14 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 		result = excludeByTags(result, filter.ExcludeTags)
 	}
 
+	// Scope filters
+	if len(filter.Scope) > 0 {
+		result = filterByScope(result, filter.Scope, true)
+	}
+	if len(filter.ExcludeScope) > 0 {
+		result = filterByScope(result, filter.ExcludeScope, false)
+	}
+
 	// Parent filters
 	if filter.HasParent != nil && *filter.HasParent {
 		result = filterByHasParent(result)
@@ -102,7 +135,108 @@ func ApplyFilter(beans []*bean.Bean, filter *model.BeanFilter, core *beancore.Co
 			result = filterByGitBranchNotMerged(result)
 		}
 	}
+	if filter.GitBranch != nil && *filter.GitBranch != "" {
+		result = filterByGitBranch(result, *filter.GitBranch, core)
+	}
+	if filter.HasLink != nil {
+		result = filterByHasLink(result, filter.HasLink, core)
+	}
+
+	// SLA filter
+	if filter.SLABreached != nil {
+		result = filterBySLABreached(result, *filter.SLABreached, core)
+	}
+
+	// Time filters
+	if filter.CreatedAfter != nil || filter.CreatedBefore != nil {
+		result = filterByTime(result, filter.CreatedAfter, filter.CreatedBefore, func(b *bean.Bean) *time.Time { return b.CreatedAt })
+	}
+	if filter.UpdatedAfter != nil || filter.UpdatedBefore != nil {
+		result = filterByTime(result, filter.UpdatedAfter, filter.UpdatedBefore, func(b *bean.Bean) *time.Time { return b.UpdatedAt })
+	}
+
+	return result
+}
+
+// filterByTime filters beans to those whose time (from getter) is at or
+// after after and before before; either bound may be nil. Beans without the
+// time are left out.
+func filterByTime(beans []*bean.Bean, after, before *time.Time, getter func(*bean.Bean) *time.Time) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		t := getter(b)
+		if t == nil || (after != nil && t.Before(*after)) || (before != nil && !t.Before(*before)) {
+			continue
+		}
+		result = append(result, b)
+	}
+	return result
+}
 
+// compileTitlePattern compiles a titleMatches regular expression, matching
+// case-insensitively.
+func compileTitlePattern(pattern string) (*regexp.Regexp, error) {
+	// Compile as given first, so errors quote the user's pattern
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, err
+	}
+	return regexp.Compile("(?i)" + pattern)
+}
+
+// filterByTitlePattern filters beans to those whose title matches the
+// regular expression. An invalid expression matches nothing (the beans query
+// reports it).
+func filterByTitlePattern(beans []*bean.Bean, pattern string) []*bean.Bean {
+	re, err := compileTitlePattern(pattern)
+	if err != nil {
+		return nil
+	}
+	var result []*bean.Bean
+	for _, b := range beans {
+		if re.MatchString(b.Title) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByTitleFuzzy filters beans to those whose title fuzzily matches query.
+func filterByTitleFuzzy(beans []*bean.Bean, query string) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if fuzzyMatch(query, b.Title) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// fuzzyMatch reports whether s contains the characters of query in order,
+// ignoring case and the spaces in query ("lgn tkn" matches "Login token").
+func fuzzyMatch(query, s string) bool {
+	rest := []rune(strings.ToLower(s))
+	for _, r := range strings.ToLower(query) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		i := slices.Index(rest, r)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+1:]
+	}
+	return true
+}
+
+// filterBySLABreached filters beans by whether they breach their SLA.
+func filterBySLABreached(beans []*bean.Bean, breached bool, core *beancore.Core) []*bean.Bean {
+	now := time.Now()
+	var result []*bean.Bean
+	for _, b := range beans {
+		if _, ok := core.SLABreachFor(b, now); ok == breached {
+			result = append(result, b)
+		}
+	}
 	return result
 }
 
@@ -181,40 +315,61 @@ func excludeByPriority(beans []*bean.Bean, priorities []string) []*bean.Bean {
 }
 
 // filterByTags filters beans to include only those with any of the given tags (OR logic).
+// Tags ending in "/*" match every tag in that namespace.
 func filterByTags(beans []*bean.Bean, tags []string) []*bean.Bean {
-	tagSet := make(map[string]bool, len(tags))
-	for _, t := range tags {
-		tagSet[t] = true
-	}
-
 	var result []*bean.Bean
 	for _, b := range beans {
-		for _, t := range b.Tags {
-			if tagSet[t] {
-				result = append(result, b)
-				break
-			}
+		if hasMatchingTag(b, tags) {
+			result = append(result, b)
 		}
 	}
 	return result
 }
 
 // excludeByTags filters beans to exclude those with any of the given tags.
+// Tags ending in "/*" match every tag in that namespace.
 func excludeByTags(beans []*bean.Bean, tags []string) []*bean.Bean {
-	tagSet := make(map[string]bool, len(tags))
-	for _, t := range tags {
-		tagSet[t] = true
+	var result []*bean.Bean
+	for _, b := range beans {
+		if !hasMatchingTag(b, tags) {
+			result = append(result, b)
+		}
 	}
+	return result
+}
 
+// hasMatchingTag returns true if any of the bean's tags matches any of the patterns.
+func hasMatchingTag(b *bean.Bean, patterns []string) bool {
+	for _, t := range b.Tags {
+		for _, p := range patterns {
+			if bean.MatchTag(p, t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// excludeDrafts filters out draft beans.
+func excludeDrafts(beans []*bean.Bean) []*bean.Bean {
 	var result []*bean.Bean
-outer:
 	for _, b := range beans {
-		for _, t := range b.Tags {
-			if tagSet[t] {
-				continue outer
-			}
+		if !b.Draft {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByScope filters beans to those whose scope is within any of the
+// given scopes (include), or to those whose scope is within none (exclude).
+func filterByScope(beans []*bean.Bean, scopes []string, include bool) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		inScope := b.Scope != "" && slices.ContainsFunc(scopes, func(s string) bool { return bean.InScope(b.Scope, s) })
+		if inScope == include {
+			result = append(result, b)
 		}
-		result = append(result, b)
 	}
 	return result
 }
@@ -391,3 +546,51 @@ func filterByGitBranchNotMerged(beans []*bean.Bean) []*bean.Bean {
 	}
 	return result
 }
+
+// filterByGitBranch filters beans to those belonging to the given git branch.
+func filterByGitBranch(beans []*bean.Bean, branch string, core *beancore.Core) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if core.IsBeanBranch(b, branch) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// filterByHasLink filters beans to those with at least one typed link matching the filter.
+func filterByHasLink(beans []*bean.Bean, filter *model.LinkFilter, core *beancore.Core) []*bean.Bean {
+	var result []*bean.Bean
+	for _, b := range beans {
+		if len(matchLinks(core.TypedLinks(b.ID), filter, core)) > 0 {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// matchLinks returns the typed links matching the filter (all links if filter is nil).
+func matchLinks(links []beancore.TypedLink, filter *model.LinkFilter, core *beancore.Core) []beancore.TypedLink {
+	if filter == nil {
+		return links
+	}
+	var beanID string
+	if filter.BeanID != nil && *filter.BeanID != "" {
+		beanID, _ = core.NormalizeID(*filter.BeanID)
+	}
+
+	var result []beancore.TypedLink
+	for _, link := range links {
+		if len(filter.Types) > 0 && !slices.Contains(filter.Types, link.Type) {
+			continue
+		}
+		if filter.Direction != nil && (*filter.Direction == model.LinkDirectionIncoming) != link.Incoming {
+			continue
+		}
+		if beanID != "" && link.BeanID != beanID {
+			continue
+		}
+		result = append(result, link)
+	}
+	return result
+}
@@ -2,6 +2,16 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
 // Filter options for querying beans
 type BeanFilter struct {
 	// Full-text search across slug, title, and body using Bleve query syntax.
@@ -18,6 +28,17 @@ type BeanFilter struct {
 	// - "title:login" - search only title field
 	// - "body:auth" - search only body field
 	Search *string `json:"search,omitempty"`
+	// Filter expression, the same language as beans list -f. Terms like status:todo
+	// are AND-ed (explicitly or by juxtaposition), negated with NOT or -, and OR
+	// combines values of one field. Examples:
+	// - "status:todo AND NOT tag:blocked AND priority>=high"
+	// - "type:bug,feature has:parent updated>=7d"
+	// - "title:auth.*token is:blocked"
+	Query *string `json:"query,omitempty"`
+	// Include only beans whose title matches this regular expression (case-insensitive, e.g. auth.*token)
+	TitleMatches *string `json:"titleMatches,omitempty"`
+	// Include only beans whose title contains these characters in order (case-insensitive, e.g. lgn matches login)
+	TitleFuzzy *string `json:"titleFuzzy,omitempty"`
 	// Include only beans with these statuses (OR logic)
 	Status []string `json:"status,omitempty"`
 	// Exclude beans with these statuses
@@ -30,10 +51,14 @@ type BeanFilter struct {
 	Priority []string `json:"priority,omitempty"`
 	// Exclude beans with these priorities
 	ExcludePriority []string `json:"excludePriority,omitempty"`
-	// Include only beans with any of these tags (OR logic)
+	// Include only beans with any of these tags (OR logic). A tag ending in /* matches its whole namespace (e.g. area/*)
 	Tags []string `json:"tags,omitempty"`
-	// Exclude beans with any of these tags
+	// Exclude beans with any of these tags. A tag ending in /* matches its whole namespace
 	ExcludeTags []string `json:"excludeTags,omitempty"`
+	// Include only beans scoped to one of these paths or below it (e.g. packages matches packages/api)
+	Scope []string `json:"scope,omitempty"`
+	// Exclude beans scoped to one of these paths or below it
+	ExcludeScope []string `json:"excludeScope,omitempty"`
 	// Include only beans with a parent
 	HasParent *bool `json:"hasParent,omitempty"`
 	// Include only beans with this specific parent ID
@@ -58,6 +83,36 @@ type BeanFilter struct {
 	HasGitBranch *bool `json:"hasGitBranch,omitempty"`
 	// Include only beans with merged branches
 	GitBranchMerged *bool `json:"gitBranchMerged,omitempty"`
+	// Include only beans belonging to this git branch (recorded branch, or the name produced by the branch template)
+	GitBranch *string `json:"gitBranch,omitempty"`
+	// Include only beans with at least one typed link (in either direction) matching this filter
+	HasLink *LinkFilter `json:"hasLink,omitempty"`
+	// Include only beans that breach (true) or don't breach (false) their SLA
+	SLABreached *bool `json:"slaBreached,omitempty"`
+	// Include only beans created at or after this time
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+	// Include only beans created before this time
+	CreatedBefore *time.Time `json:"createdBefore,omitempty"`
+	// Include only beans last updated at or after this time
+	UpdatedAfter *time.Time `json:"updatedAfter,omitempty"`
+	// Include only beans last updated before this time
+	UpdatedBefore *time.Time `json:"updatedBefore,omitempty"`
+	// Include draft beans, which are left out by default
+	IncludeDrafts *bool `json:"includeDrafts,omitempty"`
+}
+
+// A typed link between two beans, seen from one of them
+type BeanLink struct {
+	// Link type name (e.g. duplicates)
+	Type string `json:"type"`
+	// Label from this bean's point of view: the link type, or its reverse label for incoming links (e.g. 'duplicated by')
+	Label string `json:"label"`
+	// Whether this bean links to the other bean or the other way around
+	Direction LinkDirection `json:"direction"`
+	// ID of the bean at the other end
+	BeanID string `json:"beanId"`
+	// The bean at the other end (null if it doesn't exist)
+	Bean *bean.Bean `json:"bean,omitempty"`
 }
 
 // Structured body modifications applied atomically.
@@ -76,14 +131,28 @@ type BodyModification struct {
 type CreateBeanInput struct {
 	// Bean title (required)
 	Title string `json:"title"`
-	// Bean type (defaults to 'task')
+	// Bean type (defaults to the directory's default type, then the configured default)
 	Type *string `json:"type,omitempty"`
 	// Status (defaults to 'todo')
 	Status *string `json:"status,omitempty"`
 	// Priority level (defaults to 'normal')
 	Priority *string `json:"priority,omitempty"`
+	// Story point estimate
+	Points *int `json:"points,omitempty"`
 	// Tags for categorization
 	Tags []string `json:"tags,omitempty"`
+	// Other identifiers the bean can be referred to by (e.g. JIRA-123 from an imported tracker)
+	Aliases []string `json:"aliases,omitempty"`
+	// Monorepo component, as a path relative to the repository root (e.g. packages/api)
+	Scope *string `json:"scope,omitempty"`
+	// Create the bean as a draft, hidden from default queries until published
+	Draft *bool `json:"draft,omitempty"`
+	// Create a local-only bean in .beans/local/, which is never committed, exported or synced
+	Local *bool `json:"local,omitempty"`
+	// Directory (relative to .beans/) to create the bean in; its _defaults.yml files apply
+	Dir *string `json:"dir,omitempty"`
+	// Web page the bean was created from
+	SourceURL *string `json:"sourceUrl,omitempty"`
 	// Markdown body content
 	Body *string `json:"body,omitempty"`
 	// Parent bean ID (validated against type hierarchy)
@@ -96,6 +165,16 @@ type CreateBeanInput struct {
 	Prefix *string `json:"prefix,omitempty"`
 }
 
+// Filter for typed links between beans
+type LinkFilter struct {
+	// Include only links of these types (e.g. duplicates, supersedes)
+	Types []string `json:"types,omitempty"`
+	// Include only links in this direction
+	Direction *LinkDirection `json:"direction,omitempty"`
+	// Include only links to or from this bean ID
+	BeanID *string `json:"beanId,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -110,6 +189,14 @@ type ReplaceOperation struct {
 	New string `json:"new"`
 }
 
+// Time a bean has spent in a status
+type StatusDuration struct {
+	// The status
+	Status string `json:"status"`
+	// Total time in the status, in seconds
+	Seconds int `json:"seconds"`
+}
+
 // Input for updating an existing bean
 type UpdateBeanInput struct {
 	// New title
@@ -120,12 +207,78 @@ type UpdateBeanInput struct {
 	Type *string `json:"type,omitempty"`
 	// New priority
 	Priority *string `json:"priority,omitempty"`
+	// New story point estimate
+	Points *int `json:"points,omitempty"`
 	// Replace all tags (nil preserves existing)
 	Tags []string `json:"tags,omitempty"`
+	// Replace all aliases (nil preserves existing)
+	Aliases []string `json:"aliases,omitempty"`
+	// New monorepo component path (empty string clears it)
+	Scope *string `json:"scope,omitempty"`
 	// New body content (full replacement, mutually exclusive with bodyMod)
 	Body *string `json:"body,omitempty"`
 	// Structured body modifications (mutually exclusive with body)
 	BodyMod *BodyModification `json:"bodyMod,omitempty"`
+	// Pull request URL (empty string clears it)
+	GitPrURL *string `json:"gitPrUrl,omitempty"`
 	// ETag for optimistic concurrency control (optional)
 	IfMatch *string `json:"ifMatch,omitempty"`
 }
+
+// Direction of a typed link, relative to the bean it is listed on
+type LinkDirection string
+
+const (
+	// This bean links to the other bean
+	LinkDirectionOutgoing LinkDirection = "OUTGOING"
+	// The other bean links to this bean
+	LinkDirectionIncoming LinkDirection = "INCOMING"
+)
+
+var AllLinkDirection = []LinkDirection{
+	LinkDirectionOutgoing,
+	LinkDirectionIncoming,
+}
+
+func (e LinkDirection) IsValid() bool {
+	switch e {
+	case LinkDirectionOutgoing, LinkDirectionIncoming:
+		return true
+	}
+	return false
+}
+
+func (e LinkDirection) String() string {
+	return string(e)
+}
+
+func (e *LinkDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LinkDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LinkDirection", str)
+	}
+	return nil
+}
+
+func (e LinkDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LinkDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LinkDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
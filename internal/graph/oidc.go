@@ -0,0 +1,318 @@
+package graph
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hmans/beans/internal/config"
+)
+
+// oidcLeeway is the clock skew allowed when checking exp and nbf.
+const oidcLeeway = time.Minute
+
+// oidcRefreshInterval limits how often signing keys are re-fetched when a
+// token names an unknown key.
+const oidcRefreshInterval = time.Minute
+
+// oidcVerifier validates JWTs issued by an OpenID Connect provider. Signing
+// keys are discovered from the issuer on first use and re-fetched when a
+// token is signed with a key that isn't known yet (key rotation).
+type oidcVerifier struct {
+	issuer     string
+	audience   string
+	writeScope string
+	client     *http.Client
+	now        func() time.Time
+
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	fetched time.Time
+}
+
+func newOIDCVerifier(cfg config.OIDCConfig) *oidcVerifier {
+	return &oidcVerifier{
+		issuer:     strings.TrimSuffix(cfg.Issuer, "/"),
+		audience:   cfg.Audience,
+		writeScope: cfg.GetWriteScope(),
+		client:     &http.Client{Timeout: 10 * time.Second},
+		now:        time.Now,
+	}
+}
+
+// jwtClaims are the claims checked by the verifier.
+type jwtClaims struct {
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+	Scope     string          `json:"scope"`
+	Scp       json.RawMessage `json:"scp"`
+}
+
+// verify checks the token's signature and claims and returns the scope it
+// grants.
+func (v *oidcVerifier) verify(ctx context.Context, token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrUnauthorized
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", ErrUnauthorized
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrUnauthorized
+	}
+	key, err := v.key(ctx, header.Kid)
+	if err != nil {
+		return "", err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return "", ErrUnauthorized
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return "", ErrUnauthorized
+	}
+	now := v.now()
+	switch {
+	case strings.TrimSuffix(claims.Issuer, "/") != v.issuer,
+		!slices.Contains(stringOrList(claims.Audience), v.audience),
+		claims.ExpiresAt == nil || now.After(unixTime(*claims.ExpiresAt).Add(oidcLeeway)),
+		claims.NotBefore != nil && now.Add(oidcLeeway).Before(unixTime(*claims.NotBefore)):
+		return "", ErrUnauthorized
+	}
+
+	scopes := append(strings.Fields(claims.Scope), stringOrList(claims.Scp)...)
+	if slices.Contains(scopes, v.writeScope) {
+		return config.ScopeWrite, nil
+	}
+	return config.ScopeRead, nil
+}
+
+// key returns the signing key with the given ID, fetching the issuer's keys
+// if it isn't known. An empty ID matches the only key if there is just one.
+func (v *oidcVerifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	lookup := func() crypto.PublicKey {
+		if kid == "" && len(v.keys) == 1 {
+			for _, k := range v.keys {
+				return k
+			}
+		}
+		return v.keys[kid]
+	}
+	if k := lookup(); k != nil {
+		return k, nil
+	}
+	if !v.fetched.IsZero() && v.now().Sub(v.fetched) < oidcRefreshInterval {
+		return nil, ErrUnauthorized
+	}
+	v.fetched = v.now()
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching OIDC signing keys: %w", err)
+	}
+	v.keys = keys
+	if k := lookup(); k != nil {
+		return k, nil
+	}
+	return nil, ErrUnauthorized
+}
+
+// fetchKeys discovers the issuer's JWKS endpoint and loads its signing keys.
+func (v *oidcVerifier) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := v.getJSON(ctx, v.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != v.issuer {
+		return nil, fmt.Errorf("discovery document is for issuer %q, want %q", discovery.Issuer, v.issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document has no jwks_uri")
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := v.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are skipped rather than failing the set.
+		if k, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = k
+		}
+	}
+	return keys, nil
+}
+
+func (v *oidcVerifier) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// jsonWebKey is an RSA or EC public key in JWK format.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exp := new(big.Int).SetBytes(e)
+		if !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		var ecdhCurve ecdh.Curve
+		switch k.Crv {
+		case "P-256":
+			curve, ecdhCurve = elliptic.P256(), ecdh.P256()
+		case "P-384":
+			curve, ecdhCurve = elliptic.P384(), ecdh.P384()
+		case "P-521":
+			curve, ecdhCurve = elliptic.P521(), ecdh.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) != size || len(y) != size {
+			return nil, fmt.Errorf("invalid EC point")
+		}
+		// Let crypto/ecdh check that the point is on the curve.
+		if _, err := ecdhCurve.NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// verifySignature checks a JWS signature. Only asymmetric algorithms are
+// accepted; "none" and HMAC algorithms are rejected.
+func verifySignature(alg string, key crypto.PublicKey, input string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write([]byte(input))
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return fmt.Errorf("algorithm %s doesn't match RSA key", alg)
+		}
+		return rsa.VerifyPKCS1v15(key, hash, digest, sig)
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if !strings.HasPrefix(alg, "ES") || len(sig) != 2*size {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported key type %T", key)
+}
+
+// decodeSegment decodes a base64url-encoded JSON JWT segment.
+func decodeSegment(seg string, out any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// stringOrList decodes a claim that is either a string or a list of strings.
+func stringOrList(raw json.RawMessage) []string {
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.Fields(s)
+	}
+	return nil
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
@@ -0,0 +1,408 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph/model"
+)
+
+// A filter expression narrows beans with terms like status:todo, joined by
+// AND (or just spaces) and negated with NOT or a leading "-":
+//
+//	status:todo AND NOT tag:blocked AND priority>=high
+//
+// OR combines values of the same field (status:todo OR status:draft, the
+// same as status:todo,draft). ParseQuery turns an expression into one
+// BeanFilter per AND-ed clause; a bean matches if it matches all of them.
+//
+// Fields:
+//
+//	status, type, priority, tag, scope  field:a,b   (negatable)
+//	priority                            priority>=high, >, <=, <
+//	title                               title:regex, title~fuzzy
+//	parent                              parent:<id>
+//	link                                link:<type>
+//	has                                 has:parent, blocking, blocked-by, branch
+//	is                                  is:blocked, breached, merged
+//	created, updated                    created>=2025-01-01, updated<7d (a time, or a duration ago)
+
+// queryTerm is a single field comparison in a filter expression.
+type queryTerm struct {
+	negated bool
+	field   string
+	op      string // ":", "~", ">=", ">", "<=" or "<"
+	values  []string
+}
+
+// ParseQuery parses a filter expression (see above) into BeanFilters that
+// must all match. Priorities are ordered by cfg, and durations in time
+// comparisons count back from now.
+func ParseQuery(query string, cfg *config.Config, now time.Time) ([]*model.BeanFilter, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []*model.BeanFilter
+	var clause []queryTerm
+	negate := false
+	expectTerm := true // after AND/OR/NOT, or at the start
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		switch strings.ToUpper(tok) {
+		case "AND":
+			if expectTerm {
+				return nil, fmt.Errorf("AND must come between two terms")
+			}
+			filter, err := clauseFilter(clause, cfg, now)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filter)
+			clause = nil
+			expectTerm = true
+			continue
+		case "OR":
+			if expectTerm || len(clause) == 0 {
+				return nil, fmt.Errorf("OR must come between two terms")
+			}
+			expectTerm = true
+			continue
+		case "NOT":
+			negate = !negate
+			expectTerm = true
+			continue
+		}
+
+		if !expectTerm {
+			// Terms next to each other are AND-ed
+			filter, err := clauseFilter(clause, cfg, now)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filter)
+			clause = nil
+		}
+		term, err := parseQueryTerm(tok)
+		if err != nil {
+			return nil, err
+		}
+		term.negated = term.negated != negate
+		clause = append(clause, term)
+		negate = false
+		expectTerm = false
+	}
+	if expectTerm && len(tokens) > 0 {
+		return nil, fmt.Errorf("expression ends with %s", tokens[len(tokens)-1])
+	}
+	if len(clause) > 0 {
+		filter, err := clauseFilter(clause, cfg, now)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// tokenizeQuery splits an expression at whitespace, keeping quoted text
+// (status:"in progress") together and dropping the quotes.
+func tokenizeQuery(query string) ([]string, error) {
+	var tokens []string
+	var tok strings.Builder
+	inToken := false
+	var quote rune
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				tok.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inToken = true
+		case unicode.IsSpace(r):
+			if inToken {
+				tokens = append(tokens, tok.String())
+				tok.Reset()
+				inToken = false
+			}
+		default:
+			tok.WriteRune(r)
+			inToken = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in %q", query)
+	}
+	if inToken {
+		tokens = append(tokens, tok.String())
+	}
+	return tokens, nil
+}
+
+// queryOperators are the operators between a field and its value, longest
+// first so >= isn't read as >.
+var queryOperators = []string{">=", "<=", ":", "~", ">", "<", "="}
+
+// parseQueryTerm parses a term like status:todo,draft or -priority>=high.
+func parseQueryTerm(tok string) (queryTerm, error) {
+	var term queryTerm
+	if rest, ok := strings.CutPrefix(tok, "-"); ok {
+		term.negated = true
+		tok = rest
+	}
+	end := strings.IndexFunc(tok, func(r rune) bool { return !unicode.IsLetter(r) && r != '-' && r != '_' })
+	if end <= 0 {
+		return term, fmt.Errorf("%q is not a field:value term", tok)
+	}
+	term.field = strings.ToLower(tok[:end])
+	for _, op := range queryOperators {
+		if value, ok := strings.CutPrefix(tok[end:], op); ok {
+			term.op = op
+			if op == "=" {
+				term.op = ":"
+			}
+			if value == "" {
+				return term, fmt.Errorf("%s has no value", tok)
+			}
+			if term.field == "title" {
+				// Commas are part of title patterns
+				term.values = []string{value}
+			} else {
+				term.values = strings.Split(value, ",")
+			}
+			return term, nil
+		}
+	}
+	return term, fmt.Errorf("%q is not a field:value term", tok)
+}
+
+// clauseFilter turns OR-ed terms into a filter. Only values of the same
+// list field can be OR-ed.
+func clauseFilter(terms []queryTerm, cfg *config.Config, now time.Time) (*model.BeanFilter, error) {
+	filter := &model.BeanFilter{}
+	for _, term := range terms {
+		if len(terms) > 1 {
+			if term.field != terms[0].field || term.negated || terms[0].negated {
+				return nil, fmt.Errorf("OR can only combine values of one field, without NOT (e.g. status:todo OR status:draft)")
+			}
+		}
+		if err := applyQueryTerm(filter, term, cfg, now); err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+// applyQueryTerm adds a term to a filter.
+func applyQueryTerm(filter *model.BeanFilter, term queryTerm, cfg *config.Config, now time.Time) error {
+	isList := func() error {
+		if term.op != ":" {
+			return fmt.Errorf("%s takes field:value (got %s)", term.field, term.op)
+		}
+		return nil
+	}
+	single := func() (string, error) {
+		if err := isList(); err != nil {
+			return "", err
+		}
+		if term.negated {
+			return "", fmt.Errorf("%s:%s can't be negated", term.field, term.values[0])
+		}
+		if len(term.values) > 1 {
+			return "", fmt.Errorf("%s takes a single value", term.field)
+		}
+		return term.values[0], nil
+	}
+	list := func(include, exclude *[]string) error {
+		if err := isList(); err != nil {
+			return err
+		}
+		if term.negated {
+			*exclude = append(*exclude, term.values...)
+		} else {
+			*include = append(*include, term.values...)
+		}
+		return nil
+	}
+	flag := func(value bool) *bool {
+		value = value != term.negated
+		return &value
+	}
+
+	switch term.field {
+	case "status":
+		return list(&filter.Status, &filter.ExcludeStatus)
+	case "type":
+		return list(&filter.Type, &filter.ExcludeType)
+	case "tag", "tags":
+		return list(&filter.Tags, &filter.ExcludeTags)
+	case "scope":
+		return list(&filter.Scope, &filter.ExcludeScope)
+	case "priority":
+		if term.op == ":" {
+			return list(&filter.Priority, &filter.ExcludePriority)
+		}
+		priorities, err := comparePriorities(term, cfg)
+		if err != nil {
+			return err
+		}
+		if term.negated {
+			filter.ExcludePriority = append(filter.ExcludePriority, priorities...)
+		} else {
+			filter.Priority = append(filter.Priority, priorities...)
+		}
+	case "title":
+		switch {
+		case term.negated:
+			return fmt.Errorf("title can't be negated")
+		case term.op == ":":
+			if _, err := compileTitlePattern(term.values[0]); err != nil {
+				return fmt.Errorf("title:%s: %w", term.values[0], err)
+			}
+			filter.TitleMatches = &term.values[0]
+		case term.op == "~":
+			filter.TitleFuzzy = &term.values[0]
+		default:
+			return fmt.Errorf("title takes title:regex or title~fuzzy")
+		}
+	case "parent":
+		id, err := single()
+		if err != nil {
+			return err
+		}
+		filter.ParentID = &id
+	case "link":
+		if err := isList(); err != nil {
+			return err
+		}
+		if term.negated {
+			return fmt.Errorf("link can't be negated")
+		}
+		if filter.HasLink == nil {
+			filter.HasLink = &model.LinkFilter{}
+		}
+		filter.HasLink.Types = append(filter.HasLink.Types, term.values...)
+	case "has":
+		if err := isList(); err != nil {
+			return err
+		}
+		yes := true
+		for _, v := range term.values {
+			switch v {
+			case "parent":
+				if term.negated {
+					filter.NoParent = &yes
+				} else {
+					filter.HasParent = &yes
+				}
+			case "blocking":
+				if term.negated {
+					filter.NoBlocking = &yes
+				} else {
+					filter.HasBlocking = &yes
+				}
+			case "blocked-by":
+				if term.negated {
+					filter.NoBlockedBy = &yes
+				} else {
+					filter.HasBlockedBy = &yes
+				}
+			case "branch":
+				filter.HasGitBranch = flag(true)
+			default:
+				return fmt.Errorf("unknown has:%s (use parent, blocking, blocked-by or branch)", v)
+			}
+		}
+	case "is":
+		if err := isList(); err != nil {
+			return err
+		}
+		for _, v := range term.values {
+			switch v {
+			case "blocked":
+				filter.IsBlocked = flag(true)
+			case "breached":
+				filter.SLABreached = flag(true)
+			case "merged":
+				filter.GitBranchMerged = flag(true)
+			default:
+				return fmt.Errorf("unknown is:%s (use blocked, breached or merged)", v)
+			}
+		}
+	case "created", "updated":
+		return applyTimeTerm(filter, term, now)
+	default:
+		return fmt.Errorf("unknown field %q", term.field)
+	}
+	return nil
+}
+
+// comparePriorities returns the priorities a comparison like priority>=high
+// selects. Priorities are ordered most urgent first, so >= high means high
+// or more urgent.
+func comparePriorities(term queryTerm, cfg *config.Config) ([]string, error) {
+	if term.op == "~" || len(term.values) > 1 {
+		return nil, fmt.Errorf("priority takes priority:a,b or a comparison like priority>=high")
+	}
+	names := cfg.PriorityNames()
+	i := slices.Index(names, term.values[0])
+	if i < 0 {
+		return nil, fmt.Errorf("unknown priority %q (must be %s)", term.values[0], strings.Join(names, ", "))
+	}
+	switch term.op {
+	case ">=":
+		return names[:i+1], nil
+	case ">":
+		return names[:i], nil
+	case "<=":
+		return names[i:], nil
+	default: // "<"
+		return names[i+1:], nil
+	}
+}
+
+// applyTimeTerm adds a created/updated comparison to a filter. Values are
+// dates (2006-01-02), RFC 3339 times, or durations ago (36h, 7d, 2w).
+func applyTimeTerm(filter *model.BeanFilter, term queryTerm, now time.Time) error {
+	if len(term.values) != 1 || term.op == ":" || term.op == "~" {
+		return fmt.Errorf("%s takes a comparison like %s>=7d or %s<2025-01-01", term.field, term.field, term.field)
+	}
+	value := term.values[0]
+	var t time.Time
+	if d, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		// A date is the whole day, so > and <= bound at the end of it
+		t = d
+		if term.op == ">" || term.op == "<=" {
+			t = d.AddDate(0, 0, 1)
+		}
+	} else if d, err := time.Parse(time.RFC3339, value); err == nil {
+		t = d
+	} else if d, err := config.ParseSLADuration(value); err == nil {
+		t = now.Add(-d)
+	} else {
+		return fmt.Errorf("%s%s%s: %q is not a date, time or duration (use e.g. 2025-01-01 or 7d)", term.field, term.op, value, value)
+	}
+
+	// NOT created>=t is created<t, and the other way round
+	after := strings.HasPrefix(term.op, ">") != term.negated
+	switch {
+	case term.field == "created" && after:
+		filter.CreatedAfter = &t
+	case term.field == "created":
+		filter.CreatedBefore = &t
+	case after:
+		filter.UpdatedAfter = &t
+	default:
+		filter.UpdatedBefore = &t
+	}
+	return nil
+}
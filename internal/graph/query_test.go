@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph/model"
+)
+
+func TestParseQuery(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	weekAgo := now.Add(-7 * 24 * time.Hour)
+	yes, no := true, false
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		query string
+		want  []*model.BeanFilter
+	}{
+		{"", nil},
+		{"status:todo", []*model.BeanFilter{{Status: []string{"todo"}}}},
+		{"status=todo,draft", []*model.BeanFilter{{Status: []string{"todo", "draft"}}}},
+		{"status:todo OR status:draft", []*model.BeanFilter{{Status: []string{"todo", "draft"}}}},
+		{
+			"status:todo AND NOT tag:blocked AND priority>=high",
+			[]*model.BeanFilter{
+				{Status: []string{"todo"}},
+				{ExcludeTags: []string{"blocked"}},
+				{Priority: []string{"critical", "high"}},
+			},
+		},
+		{"tag:a tag:b", []*model.BeanFilter{{Tags: []string{"a"}}, {Tags: []string{"b"}}}},
+		{"-type:epic", []*model.BeanFilter{{ExcludeType: []string{"epic"}}}},
+		{"not NOT scope:ui", []*model.BeanFilter{{Scope: []string{"ui"}}}},
+		{"priority<normal", []*model.BeanFilter{{Priority: []string{"low", "deferred"}}}},
+		{"NOT priority>high", []*model.BeanFilter{{ExcludePriority: []string{"critical"}}}},
+		{`title:"auth token"`, []*model.BeanFilter{{TitleMatches: str("auth token")}}},
+		{"title~lgn", []*model.BeanFilter{{TitleFuzzy: str("lgn")}}},
+		{"parent:abc", []*model.BeanFilter{{ParentID: str("abc")}}},
+		{"link:related", []*model.BeanFilter{{HasLink: &model.LinkFilter{Types: []string{"related"}}}}},
+		{"has:parent -has:blocking", []*model.BeanFilter{{HasParent: &yes}, {NoBlocking: &yes}}},
+		{"-has:branch is:blocked", []*model.BeanFilter{{HasGitBranch: &no}, {IsBlocked: &yes}}},
+		{"NOT is:breached", []*model.BeanFilter{{SLABreached: &no}}},
+		{"updated>=7d", []*model.BeanFilter{{UpdatedAfter: &weekAgo}}},
+		{"NOT created>1w", []*model.BeanFilter{{CreatedBefore: &weekAgo}}},
+		{"created<2025-06-08T12:00:00Z", []*model.BeanFilter{{CreatedBefore: &weekAgo}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := ParseQuery(tt.query, config.Default(), now)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q) error = %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQuery(%q) = %+v, want %+v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQueryDates(t *testing.T) {
+	midday := time.Date(2025, 6, 8, 12, 0, 0, 0, time.Local)
+	b := &bean.Bean{ID: "b1", Title: "Test", CreatedAt: &midday}
+	tests := []struct {
+		query string
+		match bool
+	}{
+		{"created>2025-06-08", false},
+		{"created>=2025-06-08", true},
+		{"created<2025-06-08", false},
+		{"created<=2025-06-08", true},
+		{"NOT created>2025-06-08", true},
+		{"NOT created<=2025-06-08", false},
+		{"created>2025-06-07", true},
+		{"created<=2025-06-07", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			filters, err := ParseQuery(tt.query, config.Default(), midday)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q) error = %v", tt.query, err)
+			}
+			f := filters[0]
+			got := len(filterByTime([]*bean.Bean{b}, f.CreatedAfter, f.CreatedBefore, func(b *bean.Bean) *time.Time { return b.CreatedAt })) == 1
+			if got != tt.match {
+				t.Errorf("%q matches a bean created at midday on 2025-06-08: %v, want %v", tt.query, got, tt.match)
+			}
+		})
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	for _, query := range []string{
+		"todo",
+		"status:",
+		"color:red",
+		"status:todo AND",
+		"AND status:todo",
+		"status:todo OR type:bug",
+		"status:todo OR NOT status:draft",
+		"priority>=urgent",
+		"title:(",
+		"NOT parent:abc",
+		"has:children",
+		"created:today",
+		"updated>=soon",
+		`title:"unterminated`,
+	} {
+		if _, err := ParseQuery(query, config.Default(), time.Now()); err == nil {
+			t.Errorf("ParseQuery(%q): expected an error", query)
+		}
+	}
+}
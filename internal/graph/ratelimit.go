@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Idle clients are forgotten at least every limiterSweepInterval, and
+// sooner once the rate limiter tracks maxLimiterClients.
+const (
+	limiterSweepInterval = time.Minute
+	maxLimiterClients    = 1024
+)
+
+// rateLimiter is a token bucket rate limiter keyed by client.
+type rateLimiter struct {
+	rate  float64 // tokens added per second
+	burst float64 // bucket size
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time // when idle clients were last forgotten
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:    requestsPerSecond,
+		burst:   float64(burst),
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow takes a token from the client's bucket. If the bucket is empty it
+// returns false and how long until the next token is available.
+func (l *rateLimiter) allow(client string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.swept) >= limiterSweepInterval || len(l.buckets) >= maxLimiterClients {
+		l.forgetIdle(now)
+		l.swept = now
+	}
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[client] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// forgetIdle drops clients whose buckets have refilled, since a fresh bucket
+// behaves the same.
+func (l *rateLimiter) forgetIdle(now time.Time) {
+	for client, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, client)
+		}
+	}
+}
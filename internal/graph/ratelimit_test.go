@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	for i := range 3 {
+		if ok, _ := l.allow("a"); !ok {
+			t.Fatalf("request %d within burst was rejected", i+1)
+		}
+	}
+	ok, wait := l.allow("a")
+	if ok || wait != 500*time.Millisecond {
+		t.Errorf("allow() = %v, %v; want rejected with 500ms wait", ok, wait)
+	}
+	if ok, _ := l.allow("b"); !ok {
+		t.Error("other clients should have their own bucket")
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if ok, _ := l.allow("a"); !ok {
+		t.Error("a token should have been refilled after 500ms")
+	}
+	if ok, _ := l.allow("a"); ok {
+		t.Error("only one token should have been refilled")
+	}
+
+	now = now.Add(time.Hour)
+	if ok, _ := l.allow("c"); !ok {
+		t.Error("a new client should be allowed")
+	}
+	if len(l.buckets) != 1 {
+		t.Errorf("%d clients remembered, want only c after the idle ones were forgotten", len(l.buckets))
+	}
+}
@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// redactedFieldNames maps Bean GraphQL fields to the JSON fields they expose
+// where the names don't simply convert (parentId → parent). Fields computed
+// from other fields (the checklist from the body, cycle times from the status
+// history, ...) are redacted along with any of them.
+var redactedFieldNames = map[string][]string{
+	"parentId":             {"parent"},
+	"blockingIds":          {"blocking"},
+	"blockedByIds":         {"blocked_by"},
+	"sections":             {"body"},
+	"section":              {"body"},
+	"checklist":            {"body"},
+	"mentions":             {"body"},
+	"mentionedBy":          {"body"},
+	"links":                {"links", "body"},
+	"titleSuggestion":      {"title"},
+	"children":             {"parent"},
+	"pointsRollup":         {"points"},
+	"estimateTotal":        {"points"},
+	"estimateMissingCount": {"points"},
+	"timeInStatus":         {"status_history", "status"},
+	"cycleTime":            {"status_history", "status"},
+	"slaDeadline":          {"status_history", "status", "priority"},
+	"slaBreached":          {"status_history", "status", "priority"},
+	"branchCycleTime":      {"git_created_at", "git_merged_at"},
+	"owners":               {"scope", "git_branch"},
+}
+
+// RedactFields returns a field middleware that applies the redaction policy
+// to Bean fields: excluded fields resolve to null if nullable and otherwise to
+// their zero value (empty string, empty list or empty object), and masked
+// fields to bean.RedactedValue.
+func RedactFields(policy config.RedactConfig) graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if policy.IsEmpty() || fc == nil || fc.Object != "Bean" {
+			return next(ctx)
+		}
+		names, ok := redactedFieldNames[fc.Field.Name]
+		if !ok {
+			names = []string{snakeCase(fc.Field.Name)}
+		}
+		var excluded, masked bool
+		for _, name := range names {
+			for _, source := range append([]string{name}, bean.DerivedFields[name]...) {
+				excluded = excluded || policy.IsExcluded(source)
+				masked = masked || policy.IsMasked(source)
+			}
+		}
+		if !excluded && !masked {
+			return next(ctx)
+		}
+
+		res, err := next(ctx)
+		if err != nil || res == nil {
+			return res, err
+		}
+		if masked {
+			if value, ok := maskValue(res); ok {
+				return value, nil
+			}
+		}
+		if !fc.Field.Definition.Type.NonNull {
+			return nil, nil
+		}
+		return zeroValue(res), nil
+	}
+}
+
+// zeroValue returns the zero value of v's type. Pointers point to a zero
+// value instead of being nil, since non-null fields can't resolve to null.
+func zeroValue(v any) any {
+	t := reflect.TypeOf(v)
+	if t.Kind() == reflect.Pointer {
+		return reflect.New(t.Elem()).Interface()
+	}
+	return reflect.Zero(t).Interface()
+}
+
+// maskValue replaces strings, string pointers and string lists with
+// bean.RedactedValue. The second return value is false for other types.
+func maskValue(v any) (any, bool) {
+	switch v := v.(type) {
+	case string:
+		return bean.RedactedValue, true
+	case *string:
+		masked := bean.RedactedValue
+		return &masked, true
+	case []string:
+		masked := make([]string, len(v))
+		for i := range masked {
+			masked[i] = bean.RedactedValue
+		}
+		return masked, true
+	}
+	return nil, false
+}
+
+// snakeCase converts a GraphQL field name to its JSON name (gitPrUrl → git_pr_url).
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -8,12 +8,26 @@ package graph
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/gitflow"
 	"github.com/hmans/beans/internal/graph/model"
 )
 
+// Bean is the resolver for the bean field.
+func (r *activityEventResolver) Bean(ctx context.Context, obj *beancore.ActivityEvent) (*bean.Bean, error) {
+	b, err := r.Core.Get(obj.BeanID)
+	if err == beancore.ErrNotFound {
+		return nil, nil
+	}
+	return b, err
+}
+
 // ParentID is the resolver for the parentId field.
 func (r *beanResolver) ParentID(ctx context.Context, obj *bean.Bean) (*string, error) {
 	if obj.Parent == "" {
@@ -81,12 +95,162 @@ func (r *beanResolver) Children(ctx context.Context, obj *bean.Bean, filter *mod
 	return ApplyFilter(result, filter, r.Core), nil
 }
 
+// Links is the resolver for the links field.
+func (r *beanResolver) Links(ctx context.Context, obj *bean.Bean, filter *model.LinkFilter) ([]*model.BeanLink, error) {
+	links := matchLinks(r.Core.TypedLinks(obj.ID), filter, r.Core)
+	result := make([]*model.BeanLink, 0, len(links))
+	for _, link := range links {
+		bl := &model.BeanLink{
+			Type:      link.Type,
+			Label:     link.Type,
+			Direction: model.LinkDirectionOutgoing,
+			BeanID:    link.BeanID,
+		}
+		if link.Incoming {
+			bl.Direction = model.LinkDirectionIncoming
+			if link.Type == beancore.MentionLinkType {
+				bl.Label = "mentioned by"
+			} else if lt := r.Core.Config().GetLinkType(link.Type); lt != nil {
+				bl.Label = lt.ReverseLabel()
+			}
+		}
+		if b, err := r.Core.Get(link.BeanID); err == nil {
+			bl.Bean = b
+		}
+		result = append(result, bl)
+	}
+	return result, nil
+}
+
+// Mentions is the resolver for the mentions field.
+func (r *beanResolver) Mentions(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return ApplyFilter(r.Core.Mentions(obj.ID), filter, r.Core), nil
+}
+
+// MentionedBy is the resolver for the mentionedBy field.
+func (r *beanResolver) MentionedBy(ctx context.Context, obj *bean.Bean, filter *model.BeanFilter) ([]*bean.Bean, error) {
+	return ApplyFilter(r.Core.MentionedBy(obj.ID), filter, r.Core), nil
+}
+
+// Commits is the resolver for the commits field.
+func (r *beanResolver) Commits(ctx context.Context, obj *bean.Bean, limit *int) ([]*gitflow.CommitInfo, error) {
+	if !r.Core.IsGitFlowEnabled() {
+		return []*gitflow.CommitInfo{}, nil
+	}
+
+	n := 0
+	if limit != nil {
+		n = *limit
+	}
+
+	commits, err := r.Core.FindCommits(obj.ID, n)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*gitflow.CommitInfo, len(commits))
+	for i := range commits {
+		result[i] = &commits[i]
+	}
+	return result, nil
+}
+
+// PointsRollup is the resolver for the pointsRollup field.
+func (r *beanResolver) PointsRollup(ctx context.Context, obj *bean.Bean) (*beancore.PointsRollup, error) {
+	rollup := r.Core.RollupPoints(obj.ID)
+	return &rollup, nil
+}
+
+// EstimateTotal is the resolver for the estimateTotal field.
+func (r *beanResolver) EstimateTotal(ctx context.Context, obj *bean.Bean) (int, error) {
+	return r.Core.RollupPoints(obj.ID).Total, nil
+}
+
+// EstimateMissingCount is the resolver for the estimateMissingCount field.
+func (r *beanResolver) EstimateMissingCount(ctx context.Context, obj *bean.Bean) (int, error) {
+	return r.Core.RollupPoints(obj.ID).Unestimated, nil
+}
+
+// TimeInStatus is the resolver for the timeInStatus field.
+func (r *beanResolver) TimeInStatus(ctx context.Context, obj *bean.Bean) ([]*model.StatusDuration, error) {
+	durations := obj.TimeInStatus(time.Now().UTC())
+
+	// Report statuses in the order they were first entered
+	result := []*model.StatusDuration{}
+	seen := make(map[string]bool)
+	for _, c := range obj.StatusHistory {
+		if seen[c.Status] {
+			continue
+		}
+		seen[c.Status] = true
+		result = append(result, &model.StatusDuration{
+			Status:  c.Status,
+			Seconds: int(durations[c.Status].Seconds()),
+		})
+	}
+	return result, nil
+}
+
+// CycleTime is the resolver for the cycleTime field.
+func (r *beanResolver) CycleTime(ctx context.Context, obj *bean.Bean) (*int, error) {
+	d, ok := obj.CycleTime()
+	if !ok {
+		return nil, nil
+	}
+	seconds := int(d.Seconds())
+	return &seconds, nil
+}
+
+// BranchCycleTime is the resolver for the branchCycleTime field.
+func (r *beanResolver) BranchCycleTime(ctx context.Context, obj *bean.Bean) (*int, error) {
+	d, ok := obj.BranchCycleTime()
+	if !ok {
+		return nil, nil
+	}
+	seconds := int(d.Seconds())
+	return &seconds, nil
+}
+
+// SLADeadline is the resolver for the slaDeadline field.
+func (r *beanResolver) SLADeadline(ctx context.Context, obj *bean.Bean) (*time.Time, error) {
+	deadline, ok := r.Core.SLADeadline(obj)
+	if !ok {
+		return nil, nil
+	}
+	return &deadline, nil
+}
+
+// SLABreached is the resolver for the slaBreached field.
+func (r *beanResolver) SLABreached(ctx context.Context, obj *bean.Bean) (bool, error) {
+	_, breached := r.Core.SLABreachFor(obj, time.Now())
+	return breached, nil
+}
+
+// Owners is the resolver for the owners field.
+func (r *beanResolver) Owners(ctx context.Context, obj *bean.Bean) ([]string, error) {
+	o, err := r.Core.Ownership(obj)
+	if err == beancore.ErrNoCodeOwners {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o.Owners, nil
+}
+
+// TitleSuggestion is the resolver for the titleSuggestion field.
+func (r *beanResolver) TitleSuggestion(ctx context.Context, obj *bean.Bean) (*string, error) {
+	if suggestion := r.Core.SuggestTitle(obj.Title); suggestion != "" {
+		return &suggestion, nil
+	}
+	return nil, nil
+}
+
 // CreateBean is the resolver for the createBean field.
 func (r *mutationResolver) CreateBean(ctx context.Context, input model.CreateBeanInput) (*bean.Bean, error) {
 	b := &bean.Bean{
 		Slug:     bean.Slugify(input.Title),
 		Title:    input.Title,
-		Type:     "task", // default
 		Blocking: []string{},
 	}
 
@@ -100,12 +264,33 @@ func (r *mutationResolver) CreateBean(ctx context.Context, input model.CreateBea
 	if input.Priority != nil {
 		b.Priority = *input.Priority
 	}
+	if input.Points != nil {
+		if *input.Points < 0 {
+			return nil, fmt.Errorf("points cannot be negative")
+		}
+		b.Points = input.Points
+	}
 	if input.Body != nil {
 		b.Body = *input.Body
 	}
 	if len(input.Tags) > 0 {
 		b.Tags = input.Tags
 	}
+	if len(input.Aliases) > 0 {
+		b.Aliases = input.Aliases
+	}
+	if input.Scope != nil {
+		b.Scope = bean.CleanScope(*input.Scope)
+	}
+	if input.Draft != nil {
+		b.Draft = *input.Draft
+	}
+	if input.SourceURL != nil {
+		if *input.SourceURL != "" && !bean.ValidSourceURL(*input.SourceURL) {
+			return nil, fmt.Errorf("invalid source URL: %s (must be an http or https URL)", *input.SourceURL)
+		}
+		b.SourceURL = *input.SourceURL
+	}
 
 	// Handle parent (with validation)
 	if input.Parent != nil && *input.Parent != "" {
@@ -156,14 +341,18 @@ func (r *mutationResolver) CreateBean(ctx context.Context, input model.CreateBea
 
 	// Handle custom prefix - pre-generate ID if prefix is provided
 	if input.Prefix != nil && *input.Prefix != "" {
-		idLength := 4 // default
-		if cfg := r.Core.Config(); cfg != nil && cfg.Beans.IDLength > 0 {
-			idLength = cfg.Beans.IDLength
-		}
-		b.ID = bean.NewID(*input.Prefix, idLength)
+		b.ID = r.Core.NewID(*input.Prefix)
 	}
 
-	if err := r.Core.Create(b); err != nil {
+	// The directory's defaults (and then the project's default type) fill in the rest
+	dir := ""
+	if input.Dir != nil {
+		dir = *input.Dir
+	}
+	if input.Local != nil && *input.Local {
+		dir = filepath.Join(beancore.LocalDir, dir)
+	}
+	if err := r.Core.CreateInDir(b, dir); err != nil {
 		return nil, err
 	}
 
@@ -181,6 +370,14 @@ func (r *mutationResolver) UpdateBean(ctx context.Context, id string, input mode
 	if input.Body != nil && input.BodyMod != nil {
 		return nil, fmt.Errorf("cannot specify both body and bodyMod")
 	}
+	if input.Points != nil && *input.Points < 0 {
+		return nil, fmt.Errorf("points cannot be negative")
+	}
+	if input.GitPrURL != nil && *input.GitPrURL != "" {
+		if u, err := url.Parse(*input.GitPrURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid pull request URL: %s", *input.GitPrURL)
+		}
+	}
 
 	// Update fields if provided
 	if input.Title != nil {
@@ -195,6 +392,9 @@ func (r *mutationResolver) UpdateBean(ctx context.Context, id string, input mode
 	if input.Priority != nil {
 		b.Priority = *input.Priority
 	}
+	if input.Points != nil {
+		b.Points = input.Points
+	}
 	if input.Body != nil {
 		b.Body = *input.Body
 	} else if input.BodyMod != nil {
@@ -222,6 +422,16 @@ func (r *mutationResolver) UpdateBean(ctx context.Context, id string, input mode
 	if input.Tags != nil {
 		b.Tags = input.Tags
 	}
+	if input.Aliases != nil {
+		b.Aliases = input.Aliases
+	}
+	if input.Scope != nil {
+		b.Scope = bean.CleanScope(*input.Scope)
+	}
+	if input.GitPrURL != nil && *input.GitPrURL != b.GitPRURL {
+		b.GitPRURL = *input.GitPrURL
+		b.GitPRState = ""
+	}
 
 	// ETag validation now happens inside Update() under write lock
 	if err := r.Core.Update(b, input.IfMatch); err != nil {
@@ -234,26 +444,45 @@ func (r *mutationResolver) UpdateBean(ctx context.Context, id string, input mode
 // DeleteBean is the resolver for the deleteBean field.
 func (r *mutationResolver) DeleteBean(ctx context.Context, id string) (bool, error) {
 	// Verify bean exists
-	_, err := r.Core.Get(id)
+	b, err := r.Core.Get(id)
 	if err != nil {
 		return false, err
 	}
 
-	// Remove incoming links first
-	if _, err := r.Core.RemoveLinksTo(id); err != nil {
+	// Remove incoming links and mentions first
+	if _, err := r.Core.RemoveLinksTo(b.ID); err != nil {
 		return false, err
 	}
 
 	// Delete the bean
-	if err := r.Core.Delete(id); err != nil {
+	if err := r.Core.Delete(b.ID); err != nil {
 		return false, err
 	}
 
 	return true, nil
 }
 
+// ChangeBeanID is the resolver for the changeBeanId field.
+func (r *mutationResolver) ChangeBeanID(ctx context.Context, id string, newID string) (*bean.Bean, error) {
+	return r.Core.ChangeID(id, newID)
+}
+
+// MergeBeans is the resolver for the mergeBeans field.
+func (r *mutationResolver) MergeBeans(ctx context.Context, id string, into string) (*bean.Bean, error) {
+	return r.Core.MergeBeans(id, into)
+}
+
+// SplitBean is the resolver for the splitBean field.
+func (r *mutationResolver) SplitBean(ctx context.Context, id string, items []int, sections []string, typeArg *string) ([]*bean.Bean, error) {
+	opts := beancore.SplitOptions{Items: items, Sections: sections}
+	if typeArg != nil {
+		opts.Type = *typeArg
+	}
+	return r.Core.SplitBean(id, opts)
+}
+
 // SetParent is the resolver for the setParent field.
-func (r *mutationResolver) SetParent(ctx context.Context, id string, parentID *string, ifMatch *string) (*bean.Bean, error) {
+func (r *mutationResolver) SetParent(ctx context.Context, id string, parentID *string, ifMatch *string, moveFiles *bool) (*bean.Bean, error) {
 	b, err := r.Core.Get(id)
 	if err != nil {
 		return nil, err
@@ -272,7 +501,7 @@ func (r *mutationResolver) SetParent(ctx context.Context, id string, parentID *s
 		}
 		// Check for cycles
 		if cycle := r.Core.DetectCycle(b.ID, "parent", newParent); cycle != nil {
-			return nil, fmt.Errorf("would create cycle: %v", cycle)
+			return nil, fmt.Errorf("would create cycle (a bean cannot become its own ancestor): %v", cycle)
 		}
 	}
 
@@ -281,6 +510,18 @@ func (r *mutationResolver) SetParent(ctx context.Context, id string, parentID *s
 	if err := r.Core.Update(b, ifMatch); err != nil {
 		return nil, err
 	}
+
+	if moveFiles != nil && *moveFiles {
+		dir := ""
+		if newParent != "" {
+			if dir, err = r.Core.BeanDir(newParent); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := r.Core.MoveSubtree(b.ID, dir); err != nil {
+			return nil, fmt.Errorf("moving files: %w", err)
+		}
+	}
 	return b, nil
 }
 
@@ -394,18 +635,135 @@ func (r *mutationResolver) RemoveBlockedBy(ctx context.Context, id string, targe
 	return b, nil
 }
 
+// AddLink is the resolver for the addLink field.
+func (r *mutationResolver) AddLink(ctx context.Context, id string, typeArg string, targetID string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.Core.Config().IsValidLinkType(typeArg) {
+		return nil, fmt.Errorf("invalid link type: %s (must be %s)", typeArg, r.Core.Config().LinkTypeList())
+	}
+
+	// Normalise short ID to full ID
+	normalizedTargetID, _ := r.Core.NormalizeID(targetID)
+
+	if normalizedTargetID == b.ID {
+		return nil, fmt.Errorf("bean cannot link to itself")
+	}
+
+	// Check target exists
+	if _, err := r.Core.Get(normalizedTargetID); err != nil {
+		return nil, fmt.Errorf("target bean not found: %s", targetID)
+	}
+
+	b.AddLink(typeArg, normalizedTargetID)
+	// ETag validation now happens inside Update() under write lock
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// RemoveLink is the resolver for the removeLink field.
+func (r *mutationResolver) RemoveLink(ctx context.Context, id string, typeArg string, targetID string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Normalise short ID to full ID
+	normalizedTargetID, _ := r.Core.NormalizeID(targetID)
+
+	b.RemoveLink(typeArg, normalizedTargetID)
+	// ETag validation now happens inside Update() under write lock
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UpdateBeanSection is the resolver for the updateBeanSection field.
+func (r *mutationResolver) UpdateBeanSection(ctx context.Context, id string, section string, content string, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(section) == "" {
+		return nil, fmt.Errorf("section heading cannot be empty")
+	}
+
+	b.SetSection(section, content)
+	// ETag validation now happens inside Update() under write lock
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ToggleChecklistItem is the resolver for the toggleChecklistItem field.
+func (r *mutationResolver) ToggleChecklistItem(ctx context.Context, id string, index int, done *bool, ifMatch *string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if done != nil {
+		err = b.SetChecklistItem(index, *done)
+	} else {
+		_, err = b.ToggleChecklistItem(index)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// ETag validation now happens inside Update() under write lock
+	if err := r.Core.Update(b, ifMatch); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// RenameTag is the resolver for the renameTag field.
+func (r *mutationResolver) RenameTag(ctx context.Context, tag string, to string) ([]*bean.Bean, error) {
+	return r.Core.RenameTag(tag, to)
+}
+
+// DeleteTag is the resolver for the deleteTag field.
+func (r *mutationResolver) DeleteTag(ctx context.Context, tag string) ([]*bean.Bean, error) {
+	return r.Core.RemoveTag(tag)
+}
+
+// ApplyAging is the resolver for the applyAging field.
+func (r *mutationResolver) ApplyAging(ctx context.Context) ([]*beancore.AgingAction, error) {
+	actions, err := r.Core.ApplyAging(time.Now())
+	result := make([]*beancore.AgingAction, len(actions))
+	for i := range actions {
+		result[i] = &actions[i]
+	}
+	return result, err
+}
+
 // AppendToBody is the resolver for the appendToBody field.
 func (r *mutationResolver) AppendToBody(ctx context.Context, id string, content string, ifMatch *string) (*bean.Bean, error) {
 	panic(fmt.Errorf("not implemented: AppendToBody - appendToBody"))
 }
 
 // SyncGitBranches is the resolver for the syncGitBranches field.
-func (r *mutationResolver) SyncGitBranches(ctx context.Context) ([]*bean.Bean, error) {
+func (r *mutationResolver) SyncGitBranches(ctx context.Context, dryRun *bool) ([]*bean.Bean, error) {
 	if !r.Core.IsGitFlowEnabled() {
 		return nil, fmt.Errorf("git integration is not enabled")
 	}
 
-	result, err := r.Core.SyncGitBranches()
+	var result *beancore.SyncResult
+	var err error
+	if dryRun != nil && *dryRun {
+		result, err = r.Core.PreviewGitSync()
+	} else {
+		result, err = r.Core.SyncGitBranches()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -422,6 +780,89 @@ func (r *mutationResolver) SyncGitBranches(ctx context.Context) ([]*bean.Bean, e
 	return result.Updated, nil
 }
 
+// StartBean is the resolver for the startBean field.
+func (r *mutationResolver) StartBean(ctx context.Context, id string, createBranch *bool) (*bean.Bean, error) {
+	return r.Core.StartBean(id, createBranch == nil || *createBranch)
+}
+
+// PublishBean is the resolver for the publishBean field.
+func (r *mutationResolver) PublishBean(ctx context.Context, id string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !b.Draft {
+		return nil, fmt.Errorf("bean %s is not a draft", b.ID)
+	}
+	b.Draft = false
+	if err := r.Core.Update(b, nil); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// BranchBean is the resolver for the branchBean field.
+func (r *mutationResolver) BranchBean(ctx context.Context, id string) (*bean.Bean, error) {
+	return r.Core.BranchBean(id)
+}
+
+// InferScope is the resolver for the inferScope field.
+func (r *mutationResolver) InferScope(ctx context.Context, id string) (*bean.Bean, error) {
+	b, err := r.Core.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := r.Core.InferScope(b)
+	if err != nil {
+		return nil, err
+	}
+	if scope == "" {
+		return nil, fmt.Errorf("none of the files changed by %s are in a configured scope", b.ID)
+	}
+	if scope == b.Scope {
+		return b, nil
+	}
+	b.Scope = scope
+	if err := r.Core.Update(b, nil); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// FinishBean is the resolver for the finishBean field.
+func (r *mutationResolver) FinishBean(ctx context.Context, id string, force *bool, archive *bool) (*bean.Bean, error) {
+	return r.Core.FinishBean(id, beancore.FinishOptions{
+		Force:   force != nil && *force,
+		Archive: archive != nil && *archive,
+	})
+}
+
+// CloneBean is the resolver for the cloneBean field.
+func (r *mutationResolver) CloneBean(ctx context.Context, id string, title *string, withChildren *bool) (*bean.Bean, error) {
+	opts := beancore.CloneOptions{Children: withChildren != nil && *withChildren}
+	if title != nil {
+		opts.Title = *title
+	}
+	return r.Core.CloneBean(id, opts)
+}
+
+// ReorderBean is the resolver for the reorderBean field.
+func (r *mutationResolver) ReorderBean(ctx context.Context, id string, afterID *string, beforeID *string) (*bean.Bean, error) {
+	var after, before string
+	if afterID != nil {
+		after = *afterID
+	}
+	if beforeID != nil {
+		before = *beforeID
+	}
+	return r.Core.ReorderBean(id, after, before)
+}
+
+// RankBeans is the resolver for the rankBeans field.
+func (r *mutationResolver) RankBeans(ctx context.Context, ids []string) ([]*bean.Bean, error) {
+	return r.Core.RankBeans(ids)
+}
+
 // Bean is the resolver for the bean field.
 func (r *queryResolver) Bean(ctx context.Context, id string) (*bean.Bean, error) {
 	b, err := r.Core.Get(id)
@@ -432,9 +873,20 @@ func (r *queryResolver) Bean(ctx context.Context, id string) (*bean.Bean, error)
 }
 
 // Beans is the resolver for the beans field.
-func (r *queryResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]*bean.Bean, error) {
+func (r *queryResolver) Beans(ctx context.Context, filter *model.BeanFilter, orderBy *string) ([]*bean.Bean, error) {
 	var beans []*bean.Bean
 
+	if filter != nil && filter.TitleMatches != nil {
+		if _, err := compileTitlePattern(*filter.TitleMatches); err != nil {
+			return nil, fmt.Errorf("invalid titleMatches: %w", err)
+		}
+	}
+	if filter != nil && filter.Query != nil {
+		if _, err := ParseQuery(*filter.Query, r.Core.Config(), time.Now()); err != nil {
+			return nil, fmt.Errorf("invalid query: %w", err)
+		}
+	}
+
 	// If search filter is provided, start with search results
 	if filter != nil && filter.Search != nil && *filter.Search != "" {
 		searchResults, err := r.Core.Search(*filter.Search)
@@ -446,9 +898,67 @@ func (r *queryResolver) Beans(ctx context.Context, filter *model.BeanFilter) ([]
 		beans = r.Core.All()
 	}
 
-	return ApplyFilter(beans, filter, r.Core), nil
+	// Drafts are left out unless asked for
+	if filter == nil || filter.IncludeDrafts == nil || !*filter.IncludeDrafts {
+		beans = excludeDrafts(beans)
+	}
+
+	beans = ApplyFilter(beans, filter, r.Core)
+	if orderBy != nil {
+		cfg := r.Core.Config()
+		if err := bean.SortBy(beans, *orderBy, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames()); err != nil {
+			return nil, fmt.Errorf("invalid orderBy: %w", err)
+		}
+	}
+	return beans, nil
+}
+
+// Activity is the resolver for the activity field.
+func (r *queryResolver) Activity(ctx context.Context, since time.Time) ([]*beancore.ActivityEvent, error) {
+	events, err := r.Core.Activity(since)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*beancore.ActivityEvent, len(events))
+	for i := range events {
+		result[i] = &events[i]
+	}
+	return result, nil
+}
+
+// Generation is the resolver for the generation field.
+func (r *queryResolver) Generation(ctx context.Context) (int, error) {
+	return int(r.Core.Generation()), nil
 }
 
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context) ([]*beancore.TagCount, error) {
+	counts := r.Core.TagCounts()
+	result := make([]*beancore.TagCount, len(counts))
+	for i := range counts {
+		result[i] = &counts[i]
+	}
+	return result, nil
+}
+
+// AgingReport is the resolver for the agingReport field.
+func (r *queryResolver) AgingReport(ctx context.Context) ([]*beancore.AgingAction, error) {
+	actions := r.Core.PlanAging(time.Now())
+	result := make([]*beancore.AgingAction, len(actions))
+	for i := range actions {
+		result[i] = &actions[i]
+	}
+	return result, nil
+}
+
+// StaleBeans is the resolver for the staleBeans field.
+func (r *queryResolver) StaleBeans(ctx context.Context) ([]*bean.Bean, error) {
+	return r.Core.StaleBeans()
+}
+
+// ActivityEvent returns ActivityEventResolver implementation.
+func (r *Resolver) ActivityEvent() ActivityEventResolver { return &activityEventResolver{r} }
+
 // Bean returns BeanResolver implementation.
 func (r *Resolver) Bean() BeanResolver { return &beanResolver{r} }
 
@@ -458,6 +968,7 @@ func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+type activityEventResolver struct{ *Resolver }
 type beanResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
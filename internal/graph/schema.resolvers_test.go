@@ -3,8 +3,10 @@ package graph
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -107,7 +109,7 @@ func TestQueryBeans(t *testing.T) {
 
 	t.Run("no filter", func(t *testing.T) {
 		qr := resolver.Query()
-		got, err := qr.Beans(ctx, nil)
+		got, err := qr.Beans(ctx, nil, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -121,7 +123,7 @@ func TestQueryBeans(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"todo"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -138,7 +140,7 @@ func TestQueryBeans(t *testing.T) {
 		filter := &model.BeanFilter{
 			Status: []string{"todo", "in-progress"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -152,7 +154,7 @@ func TestQueryBeans(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludeStatus: []string{"completed"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -179,7 +181,7 @@ func TestQueryBeansWithTags(t *testing.T) {
 		filter := &model.BeanFilter{
 			Tags: []string{"frontend"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -193,7 +195,7 @@ func TestQueryBeansWithTags(t *testing.T) {
 		filter := &model.BeanFilter{
 			Tags: []string{"frontend", "backend"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -207,7 +209,7 @@ func TestQueryBeansWithTags(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludeTags: []string{"urgent"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -217,6 +219,70 @@ func TestQueryBeansWithTags(t *testing.T) {
 	})
 }
 
+func TestQueryBeansWithTagNamespaces(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	core.Create(&bean.Bean{ID: "ns-1", Title: "Frontend", Status: "todo", Tags: []string{"area/frontend"}})
+	core.Create(&bean.Bean{ID: "ns-2", Title: "Backend", Status: "todo", Tags: []string{"area/backend", "team/core"}})
+	core.Create(&bean.Bean{ID: "ns-3", Title: "Core", Status: "todo", Tags: []string{"team/core"}})
+
+	tests := []struct {
+		name   string
+		filter *model.BeanFilter
+		want   int
+	}{
+		{"namespace prefix", &model.BeanFilter{Tags: []string{"area/*"}}, 2},
+		{"exact namespaced tag", &model.BeanFilter{Tags: []string{"area/frontend"}}, 1},
+		{"bare namespace does not match", &model.BeanFilter{Tags: []string{"area"}}, 0},
+		{"exclude namespace", &model.BeanFilter{ExcludeTags: []string{"area/*"}}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.Query().Beans(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("Beans() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("Beans() count = %d, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryBeansWithScope(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	core.Create(&bean.Bean{ID: "sc-1", Title: "API", Status: "todo", Scope: "packages/api"})
+	core.Create(&bean.Bean{ID: "sc-2", Title: "Web", Status: "todo", Scope: "packages/web"})
+	core.Create(&bean.Bean{ID: "sc-3", Title: "Docs", Status: "todo", Scope: "docs"})
+	core.Create(&bean.Bean{ID: "sc-4", Title: "Unscoped", Status: "todo"})
+
+	tests := []struct {
+		name   string
+		filter *model.BeanFilter
+		want   int
+	}{
+		{"exact scope", &model.BeanFilter{Scope: []string{"packages/api"}}, 1},
+		{"parent directory", &model.BeanFilter{Scope: []string{"packages"}}, 2},
+		{"multiple scopes (OR)", &model.BeanFilter{Scope: []string{"packages/web", "./docs/"}}, 2},
+		{"prefix is not a scope", &model.BeanFilter{Scope: []string{"pack"}}, 0},
+		{"exclude scope", &model.BeanFilter{ExcludeScope: []string{"packages"}}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.Query().Beans(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("Beans() error = %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("Beans() count = %d, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
 func TestQueryBeansWithPriority(t *testing.T) {
 	resolver, core := setupTestResolver(t)
 	ctx := context.Background()
@@ -239,7 +305,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"normal"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -261,7 +327,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"critical"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -278,7 +344,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			Priority: []string{"critical", "high"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -292,7 +358,7 @@ func TestQueryBeansWithPriority(t *testing.T) {
 		filter := &model.BeanFilter{
 			ExcludePriority: []string{"normal"},
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -446,7 +512,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			HasParent: &hasParentBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -464,7 +530,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			NoParent: &noParentBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -479,7 +545,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			HasBlocking: &hasBlocksBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -497,7 +563,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlockedBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -515,7 +581,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlockedBool,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -537,7 +603,7 @@ func TestQueryBeansWithParentAndBlocks(t *testing.T) {
 		filter := &model.BeanFilter{
 			ParentID: &parentID,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -629,7 +695,7 @@ func TestIsBlockedFilterWithResolvedBlockers(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlocked,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -663,7 +729,7 @@ func TestIsBlockedFilterWithResolvedBlockers(t *testing.T) {
 		filter := &model.BeanFilter{
 			IsBlocked: &isBlocked,
 		}
-		got, err := qr.Beans(ctx, filter)
+		got, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -780,6 +846,14 @@ func TestMutationCreateBean(t *testing.T) {
 			t.Errorf("CreateBean().Blocking count = %d, want 1", len(got.Blocking))
 		}
 	})
+
+	t.Run("reject non-http source URL", func(t *testing.T) {
+		sourceURL := "javascript:alert(1)"
+		_, err := resolver.Mutation().CreateBean(ctx, model.CreateBeanInput{Title: "Bad URL", SourceURL: &sourceURL})
+		if err == nil {
+			t.Error("CreateBean() with a javascript: source URL should fail")
+		}
+	})
 }
 
 func TestMutationCreateBeanWithCustomPrefix(t *testing.T) {
@@ -944,7 +1018,7 @@ func TestMutationSetParent(t *testing.T) {
 	t.Run("set parent", func(t *testing.T) {
 		mr := resolver.Mutation()
 		parentID := "parent-1"
-		got, err := mr.SetParent(ctx, "child-1", &parentID, nil)
+		got, err := mr.SetParent(ctx, "child-1", &parentID, nil, nil)
 		if err != nil {
 			t.Fatalf("SetParent() error = %v", err)
 		}
@@ -955,7 +1029,7 @@ func TestMutationSetParent(t *testing.T) {
 
 	t.Run("clear parent", func(t *testing.T) {
 		mr := resolver.Mutation()
-		got, err := mr.SetParent(ctx, "child-1", nil, nil)
+		got, err := mr.SetParent(ctx, "child-1", nil, nil, nil)
 		if err != nil {
 			t.Fatalf("SetParent() error = %v", err)
 		}
@@ -967,13 +1041,60 @@ func TestMutationSetParent(t *testing.T) {
 	t.Run("set parent on nonexistent bean", func(t *testing.T) {
 		mr := resolver.Mutation()
 		parentID := "parent-1"
-		_, err := mr.SetParent(ctx, "nonexistent", &parentID, nil)
+		_, err := mr.SetParent(ctx, "nonexistent", &parentID, nil, nil)
 		if err == nil {
 			t.Error("SetParent() expected error for nonexistent bean")
 		}
 	})
 }
 
+func TestMutationSetParentSubtree(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	milestone := &bean.Bean{ID: "ms-1", Slug: "ms", Title: "Milestone", Status: "todo", Type: "milestone", Path: "q3/ms-1--ms.md"}
+	epic := &bean.Bean{ID: "epic-1", Slug: "epic", Title: "Epic", Status: "todo", Type: "epic"}
+	sub := &bean.Bean{ID: "feat-2", Slug: "sub", Title: "Sub Feature", Status: "todo", Type: "feature", Parent: "epic-1"}
+	subTask := &bean.Bean{ID: "task-2", Slug: "subtask", Title: "Sub Task", Status: "todo", Type: "task", Parent: "feat-2"}
+	task := &bean.Bean{ID: "task-1", Slug: "task", Title: "Task", Status: "todo", Type: "task", Parent: "epic-1"}
+	for _, b := range []*bean.Bean{milestone, epic, sub, subTask, task} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	t.Run("rejects becoming own ancestor", func(t *testing.T) {
+		// Type rules alone prevent cycles, so start from data that already
+		// violates them: an epic whose parent is a feature
+		feature := &bean.Bean{ID: "feat-1", Slug: "feat", Title: "Feature", Status: "todo", Type: "feature"}
+		looped := &bean.Bean{ID: "epic-3", Slug: "looped", Title: "Looped", Status: "todo", Type: "epic", Parent: "feat-1"}
+		core.Create(feature)
+		core.Create(looped)
+
+		parentID := "epic-3"
+		_, err := mr.SetParent(ctx, "feat-1", &parentID, nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "own ancestor") {
+			t.Errorf("SetParent() error = %v, want cycle error", err)
+		}
+	})
+
+	t.Run("moves subtree files", func(t *testing.T) {
+		parentID := "ms-1"
+		moveFiles := true
+		got, err := mr.SetParent(ctx, "epic-1", &parentID, nil, &moveFiles)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+		if got.Parent != "ms-1" || got.Path != "q3/epic-1--epic.md" {
+			t.Errorf("got parent %q path %q, want ms-1 and q3/epic-1--epic.md", got.Parent, got.Path)
+		}
+		if task.Path != "q3/task-1--task.md" || sub.Path != "q3/feat-2--sub.md" || subTask.Path != "q3/task-2--subtask.md" {
+			t.Errorf("descendants not moved: %q, %q, %q", task.Path, sub.Path, subTask.Path)
+		}
+	})
+}
+
 func TestMutationAddRemoveBlocking(t *testing.T) {
 	resolver, core := setupTestResolver(t)
 	ctx := context.Background()
@@ -1077,6 +1198,19 @@ func TestMutationDeleteBean(t *testing.T) {
 		}
 	})
 
+	t.Run("delete strikes through mentions", func(t *testing.T) {
+		core.Create(&bean.Bean{ID: "mentioned", Title: "Mentioned", Status: "todo", Type: "task"})
+		core.Create(&bean.Bean{ID: "mentioner", Title: "Mentioner", Status: "todo", Type: "task", Body: "Follows [[mentioned]]."})
+
+		if _, err := resolver.Mutation().DeleteBean(ctx, "mentioned"); err != nil {
+			t.Fatalf("DeleteBean() error = %v", err)
+		}
+		updated, _ := resolver.Query().Bean(ctx, "mentioner")
+		if updated.Body != "Follows ~~mentioned~~." {
+			t.Errorf("Body = %q, want mention struck through", updated.Body)
+		}
+	})
+
 	t.Run("delete nonexistent bean", func(t *testing.T) {
 		mr := resolver.Mutation()
 		_, err := mr.DeleteBean(ctx, "nonexistent")
@@ -1086,6 +1220,30 @@ func TestMutationDeleteBean(t *testing.T) {
 	})
 }
 
+func TestMutationChangeBeanID(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	core.Create(&bean.Bean{ID: "old-id", Title: "Renamed", Status: "todo", Type: "task"})
+	core.Create(&bean.Bean{ID: "child", Title: "Child", Status: "todo", Type: "task", Parent: "old-id", Body: "See #old-id."})
+
+	got, err := resolver.Mutation().ChangeBeanID(ctx, "old-id", "new-id")
+	if err != nil {
+		t.Fatalf("ChangeBeanID() error = %v", err)
+	}
+	if got.ID != "new-id" {
+		t.Errorf("ID = %q, want new-id", got.ID)
+	}
+
+	child, _ := resolver.Query().Bean(ctx, "child")
+	if child.Parent != "new-id" || child.Body != "See #new-id." {
+		t.Errorf("child parent = %q, body = %q", child.Parent, child.Body)
+	}
+	if _, err := resolver.Mutation().ChangeBeanID(ctx, "new-id", "child"); err == nil {
+		t.Error("ChangeBeanID() to a taken ID should fail")
+	}
+}
+
 func TestRelationshipFieldsWithFilter(t *testing.T) {
 	resolver, core := setupTestResolver(t)
 	ctx := context.Background()
@@ -1423,7 +1581,7 @@ func TestRequireIfMatchConfig(t *testing.T) {
 
 		mr := resolver.Mutation()
 		parentID := "req-parent"
-		_, err := mr.SetParent(ctx, "req-child", &parentID, nil)
+		_, err := mr.SetParent(ctx, "req-child", &parentID, nil, nil)
 		if err == nil {
 			t.Error("SetParent() without etag should fail when require_if_match is true")
 		}
@@ -1479,7 +1637,7 @@ func TestShortIDNormalization(t *testing.T) {
 		mr := resolver.Mutation()
 		// Use short ID (without prefix)
 		shortParentID := "parent1"
-		got, err := mr.SetParent(ctx, "beans-child1", &shortParentID, nil)
+		got, err := mr.SetParent(ctx, "beans-child1", &shortParentID, nil, nil)
 		if err != nil {
 			t.Fatalf("SetParent() error = %v", err)
 		}
@@ -2179,7 +2337,7 @@ func TestSetParentWithETag(t *testing.T) {
 		currentETag := child.ETag()
 		parentID := "parent-etag"
 
-		got, err := resolver.Mutation().SetParent(ctx, "child-etag-1", &parentID, &currentETag)
+		got, err := resolver.Mutation().SetParent(ctx, "child-etag-1", &parentID, &currentETag, nil)
 		if err != nil {
 			t.Fatalf("SetParent() with correct etag failed: %v", err)
 		}
@@ -2200,7 +2358,7 @@ func TestSetParentWithETag(t *testing.T) {
 		wrongETag := "wrongetag123"
 		parentID := "parent-etag"
 
-		_, err := resolver.Mutation().SetParent(ctx, "child-etag-2", &parentID, &wrongETag)
+		_, err := resolver.Mutation().SetParent(ctx, "child-etag-2", &parentID, &wrongETag, nil)
 		if err == nil {
 			t.Error("SetParent() with wrong etag should fail")
 		}
@@ -2453,7 +2611,7 @@ func TestQueryBeansFilter_HasGitBranch(t *testing.T) {
 			HasGitBranch: &hasGitBranch,
 		}
 		
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -2473,7 +2631,7 @@ func TestQueryBeansFilter_HasGitBranch(t *testing.T) {
 			HasGitBranch: &hasGitBranch,
 		}
 		
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -2525,7 +2683,7 @@ func TestQueryBeansFilter_GitBranchMerged(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -2545,7 +2703,7 @@ func TestQueryBeansFilter_GitBranchMerged(t *testing.T) {
 			GitBranchMerged: &gitBranchMerged,
 		}
 
-		beans, err := qr.Beans(ctx, filter)
+		beans, err := qr.Beans(ctx, filter, nil)
 		if err != nil {
 			t.Fatalf("Beans() error = %v", err)
 		}
@@ -2596,7 +2754,7 @@ func setupTestResolverWithGit(t *testing.T) (*Resolver, *beancore.Core, *git.Rep
 	cfg.Beans.Git.BaseBranch = "main"
 
 	core := beancore.New(beansDir, cfg)
-	core.SetWarnWriter(nil)
+	core.SetLogger(nil)
 	if err := core.Load(); err != nil {
 		t.Fatalf("failed to load core: %v", err)
 	}
@@ -2827,7 +2985,7 @@ func TestMutationSyncGitBranches(t *testing.T) {
 
 		// Call SyncGitBranches
 		mr := resolver.Mutation()
-		updated, err := mr.SyncGitBranches(ctx)
+		updated, err := mr.SyncGitBranches(ctx, nil)
 		if err != nil {
 			t.Fatalf("SyncGitBranches() error = %v", err)
 		}
@@ -2860,7 +3018,7 @@ func TestMutationSyncGitBranches_NoBeans(t *testing.T) {
 
 	t.Run("sync with no beans returns empty", func(t *testing.T) {
 		mr := resolver.Mutation()
-		updated, err := mr.SyncGitBranches(ctx)
+		updated, err := mr.SyncGitBranches(ctx, nil)
 		if err != nil {
 			t.Fatalf("SyncGitBranches() error = %v", err)
 		}
@@ -2877,7 +3035,7 @@ func TestMutationSyncGitBranches_GitDisabled(t *testing.T) {
 
 	t.Run("sync when git disabled returns error", func(t *testing.T) {
 		mr := resolver.Mutation()
-		_, err := mr.SyncGitBranches(ctx)
+		_, err := mr.SyncGitBranches(ctx, nil)
 		if err == nil {
 			t.Error("SyncGitBranches() expected error when git disabled")
 		}
@@ -2886,3 +3044,812 @@ func TestMutationSyncGitBranches_GitDisabled(t *testing.T) {
 		}
 	})
 }
+
+func TestPointsAndRollup(t *testing.T) {
+	resolver, _ := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	epicType := "epic"
+	epic, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Epic", Type: &epicType})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+
+	three, five := 3, 5
+	completed := "completed"
+	_, err = mr.CreateBean(ctx, model.CreateBeanInput{Title: "Done", Parent: &epic.ID, Points: &three, Status: &completed})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	open, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Open", Parent: &epic.ID, Points: &three})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	if open.Points == nil || *open.Points != 3 {
+		t.Fatalf("CreateBean() points = %v, want 3", open.Points)
+	}
+
+	t.Run("update points", func(t *testing.T) {
+		updated, err := mr.UpdateBean(ctx, open.ID, model.UpdateBeanInput{Points: &five})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if *updated.Points != 5 {
+			t.Errorf("UpdateBean() points = %d, want 5", *updated.Points)
+		}
+	})
+
+	t.Run("negative points rejected", func(t *testing.T) {
+		negative := -1
+		if _, err := mr.UpdateBean(ctx, open.ID, model.UpdateBeanInput{Points: &negative}); err == nil {
+			t.Error("UpdateBean() with negative points should fail")
+		}
+		if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Bad", Points: &negative}); err == nil {
+			t.Error("CreateBean() with negative points should fail")
+		}
+	})
+
+	t.Run("rollup on parent", func(t *testing.T) {
+		rollup, err := resolver.Bean().PointsRollup(ctx, epic)
+		if err != nil {
+			t.Fatalf("PointsRollup() error = %v", err)
+		}
+		if rollup.Total != 8 || rollup.Completed != 3 || rollup.Remaining != 5 {
+			t.Errorf("PointsRollup() = %+v, want total 8, completed 3, remaining 5", rollup)
+		}
+	})
+
+	t.Run("estimate fields flag unestimated children", func(t *testing.T) {
+		if _, err := mr.CreateBean(ctx, model.CreateBeanInput{Title: "Unestimated", Parent: &epic.ID}); err != nil {
+			t.Fatalf("CreateBean() error = %v", err)
+		}
+		total, _ := resolver.Bean().EstimateTotal(ctx, epic)
+		missing, _ := resolver.Bean().EstimateMissingCount(ctx, epic)
+		if total != 8 || missing != 1 {
+			t.Errorf("estimateTotal = %d, estimateMissingCount = %d; want 8, 1", total, missing)
+		}
+	})
+}
+
+func TestBeanStatusTracking(t *testing.T) {
+	resolver, _ := setupTestResolver(t)
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &bean.Bean{ID: "st-1", Status: "completed", StatusHistory: []bean.StatusChange{
+		{Status: "todo", ChangedAt: start},
+		{Status: "in-progress", ChangedAt: start.Add(time.Hour)},
+		{Status: "completed", ChangedAt: start.Add(3 * time.Hour)},
+	}}
+
+	t.Run("time in status", func(t *testing.T) {
+		durations, err := resolver.Bean().TimeInStatus(ctx, b)
+		if err != nil {
+			t.Fatalf("TimeInStatus() error = %v", err)
+		}
+		if len(durations) != 3 {
+			t.Fatalf("TimeInStatus() returned %d entries, want 3", len(durations))
+		}
+		if durations[0].Status != "todo" || durations[0].Seconds != 3600 {
+			t.Errorf("durations[0] = %+v, want todo / 3600", durations[0])
+		}
+		if durations[1].Status != "in-progress" || durations[1].Seconds != 7200 {
+			t.Errorf("durations[1] = %+v, want in-progress / 7200", durations[1])
+		}
+	})
+
+	t.Run("cycle time", func(t *testing.T) {
+		cycle, err := resolver.Bean().CycleTime(ctx, b)
+		if err != nil {
+			t.Fatalf("CycleTime() error = %v", err)
+		}
+		if cycle == nil || *cycle != 7200 {
+			t.Errorf("CycleTime() = %v, want 7200", cycle)
+		}
+	})
+
+	t.Run("no cycle time while open", func(t *testing.T) {
+		open := &bean.Bean{ID: "st-2", Status: "in-progress"}
+		cycle, err := resolver.Bean().CycleTime(ctx, open)
+		if err != nil {
+			t.Fatalf("CycleTime() error = %v", err)
+		}
+		if cycle != nil {
+			t.Errorf("CycleTime() = %d, want nil", *cycle)
+		}
+	})
+}
+
+func TestBeanCommits(t *testing.T) {
+	resolver, core, repo := setupTestResolverWithGit(t)
+	ctx := context.Background()
+
+	b := createTestBean(t, core, "beans-cm1", "Commit Linked", "todo")
+
+	w, _ := repo.Worktree()
+	for i, msg := range []string{"feat: start work (beans-cm1)", "chore: unrelated", "fix: done\n\nfixes beans-cm1"} {
+		name := fmt.Sprintf("file%d.txt", i)
+		os.WriteFile(filepath.Join(w.Filesystem.Root(), name), []byte(msg), 0644)
+		w.Add(name)
+		if _, err := w.Commit(msg, &git.CommitOptions{
+			Author: &object.Signature{Name: "Test", Email: "test@example.com"},
+		}); err != nil {
+			t.Fatalf("failed to commit: %v", err)
+		}
+	}
+
+	t.Run("returns matching commits", func(t *testing.T) {
+		commits, err := resolver.Bean().Commits(ctx, b, nil)
+		if err != nil {
+			t.Fatalf("Commits() error = %v", err)
+		}
+		if len(commits) != 2 {
+			t.Errorf("Commits() count = %d, want 2", len(commits))
+		}
+	})
+
+	t.Run("respects limit", func(t *testing.T) {
+		limit := 1
+		commits, err := resolver.Bean().Commits(ctx, b, &limit)
+		if err != nil {
+			t.Fatalf("Commits() error = %v", err)
+		}
+		if len(commits) != 1 {
+			t.Errorf("Commits() count = %d, want 1", len(commits))
+		}
+	})
+
+	t.Run("empty when git disabled", func(t *testing.T) {
+		plain, plainCore := setupTestResolver(t)
+		other := createTestBean(t, plainCore, "beans-cm2", "No Git", "todo")
+		commits, err := plain.Bean().Commits(ctx, other, nil)
+		if err != nil {
+			t.Fatalf("Commits() error = %v", err)
+		}
+		if len(commits) != 0 {
+			t.Errorf("Commits() count = %d, want 0", len(commits))
+		}
+	})
+}
+
+func TestMutationUpdateBean_GitPrURL(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	b := createTestBean(t, core, "pr-1", "With PR", "in-progress")
+	b.GitPRState = "open"
+
+	t.Run("set url resets state", func(t *testing.T) {
+		prURL := "https://github.com/o/r/pull/1"
+		updated, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{GitPrURL: &prURL})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if updated.GitPRURL != prURL {
+			t.Errorf("GitPRURL = %q, want %q", updated.GitPRURL, prURL)
+		}
+		if updated.GitPRState != "" {
+			t.Errorf("GitPRState = %q, want empty", updated.GitPRState)
+		}
+	})
+
+	t.Run("invalid url rejected", func(t *testing.T) {
+		bad := "not a url"
+		if _, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{GitPrURL: &bad}); err == nil {
+			t.Error("UpdateBean() with invalid PR URL should fail")
+		}
+	})
+
+	t.Run("empty string clears", func(t *testing.T) {
+		empty := ""
+		updated, err := mr.UpdateBean(ctx, b.ID, model.UpdateBeanInput{GitPrURL: &empty})
+		if err != nil {
+			t.Fatalf("UpdateBean() error = %v", err)
+		}
+		if updated.GitPRURL != "" {
+			t.Errorf("GitPRURL = %q, want empty", updated.GitPRURL)
+		}
+	})
+}
+
+func TestMutationReorderBean(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	for _, id := range []string{"a-1", "b-1", "c-1"} {
+		if err := core.Create(&bean.Bean{ID: id, Slug: id, Title: id, Status: "todo"}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	ranked, err := mr.RankBeans(ctx, []string{"c-1", "b-1", "a-1"})
+	if err != nil {
+		t.Fatalf("RankBeans() error = %v", err)
+	}
+	if ranked[0].ID != "c-1" || ranked[0].Rank >= ranked[2].Rank {
+		t.Errorf("RankBeans() = %s (%q) first, want c-1 ranked lowest", ranked[0].ID, ranked[0].Rank)
+	}
+
+	// Move a-1 to the start, before c-1
+	before := "c-1"
+	b, err := mr.ReorderBean(ctx, "a-1", nil, &before)
+	if err != nil {
+		t.Fatalf("ReorderBean() error = %v", err)
+	}
+	if b.Rank >= ranked[0].Rank {
+		t.Errorf("ReorderBean() rank = %q, want before c-1 (%q)", b.Rank, ranked[0].Rank)
+	}
+
+	after := "a-1"
+	if _, err := mr.ReorderBean(ctx, "b-1", &after, &before); err != nil {
+		t.Errorf("ReorderBean() between neighbours error = %v", err)
+	}
+	if _, err := mr.ReorderBean(ctx, "b-1", &before, &after); err == nil {
+		t.Error("ReorderBean() with neighbours out of order should fail")
+	}
+}
+
+func TestTypedLinks(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	for _, id := range []string{"new-1", "old-1", "spec-1"} {
+		if err := core.Create(&bean.Bean{ID: id, Slug: id, Title: id, Status: "todo"}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	if _, err := mr.AddLink(ctx, "new-1", "supersedes", "old-1", nil); err != nil {
+		t.Fatalf("AddLink() error = %v", err)
+	}
+	if _, err := mr.AddLink(ctx, "new-1", "implements", "spec-1", nil); err != nil {
+		t.Fatalf("AddLink() error = %v", err)
+	}
+
+	t.Run("rejects invalid links", func(t *testing.T) {
+		if _, err := mr.AddLink(ctx, "new-1", "blocking", "old-1", nil); err == nil || !strings.Contains(err.Error(), "invalid link type") {
+			t.Errorf("AddLink(blocking) error = %v, want invalid link type", err)
+		}
+		if _, err := mr.AddLink(ctx, "new-1", "related", "new-1", nil); err == nil {
+			t.Error("AddLink() to itself should fail")
+		}
+		if _, err := mr.AddLink(ctx, "new-1", "related", "nope", nil); err == nil {
+			t.Error("AddLink() to missing bean should fail")
+		}
+	})
+
+	t.Run("links with reverse labels", func(t *testing.T) {
+		old, _ := core.Get("old-1")
+		links, err := resolver.Bean().Links(ctx, old, nil)
+		if err != nil {
+			t.Fatalf("Links() error = %v", err)
+		}
+		if len(links) != 1 {
+			t.Fatalf("Links() = %d links, want 1", len(links))
+		}
+		l := links[0]
+		if l.Label != "superseded by" || l.Direction != model.LinkDirectionIncoming || l.BeanID != "new-1" || l.Bean == nil {
+			t.Errorf("Links()[0] = %+v, want incoming 'superseded by' from new-1", l)
+		}
+	})
+
+	t.Run("links filter", func(t *testing.T) {
+		nb, _ := core.Get("new-1")
+		outgoing := model.LinkDirectionOutgoing
+		links, _ := resolver.Bean().Links(ctx, nb, &model.LinkFilter{Types: []string{"implements"}, Direction: &outgoing})
+		if len(links) != 1 || links[0].BeanID != "spec-1" || links[0].Label != "implements" {
+			t.Errorf("Links(implements) = %+v, want spec-1", links)
+		}
+
+		qr := resolver.Query()
+		beans, _ := qr.Beans(ctx, &model.BeanFilter{HasLink: &model.LinkFilter{Types: []string{"supersedes"}}}, nil)
+		if len(beans) != 2 {
+			t.Errorf("Beans(hasLink supersedes) = %d beans, want new-1 and old-1", len(beans))
+		}
+		incoming := model.LinkDirectionIncoming
+		beans, _ = qr.Beans(ctx, &model.BeanFilter{HasLink: &model.LinkFilter{Direction: &incoming}}, nil)
+		if len(beans) != 2 {
+			t.Errorf("Beans(hasLink incoming) = %d beans, want old-1 and spec-1", len(beans))
+		}
+	})
+
+	t.Run("remove link", func(t *testing.T) {
+		b, err := mr.RemoveLink(ctx, "new-1", "supersedes", "old-1", nil)
+		if err != nil {
+			t.Fatalf("RemoveLink() error = %v", err)
+		}
+		if b.HasLink("supersedes", "old-1") {
+			t.Errorf("Links = %v, want supersedes removed", b.Links)
+		}
+	})
+}
+
+func TestBeanMentions(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	core.Create(&bean.Bean{ID: "spec-1", Title: "Spec", Status: "todo", Body: "Follow-up: [[impl-1]]"})
+	core.Create(&bean.Bean{ID: "impl-1", Title: "Impl", Status: "completed", Body: "Implements #spec-1."})
+	core.Create(&bean.Bean{ID: "note-1", Title: "Note", Status: "todo", Body: "See [[spec-1]]."})
+
+	spec, _ := core.Get("spec-1")
+	mentions, err := resolver.Bean().Mentions(ctx, spec, nil)
+	if err != nil {
+		t.Fatalf("Mentions() error = %v", err)
+	}
+	if len(mentions) != 1 || mentions[0].ID != "impl-1" {
+		t.Errorf("Mentions() = %v, want impl-1", mentions)
+	}
+	mentionedBy, _ := resolver.Bean().MentionedBy(ctx, spec, &model.BeanFilter{ExcludeStatus: []string{"completed"}})
+	if len(mentionedBy) != 1 || mentionedBy[0].ID != "note-1" {
+		t.Errorf("MentionedBy(not completed) = %v, want note-1", mentionedBy)
+	}
+
+	incoming := model.LinkDirectionIncoming
+	links, _ := resolver.Bean().Links(ctx, spec, &model.LinkFilter{Direction: &incoming})
+	if len(links) != 2 || links[0].Label != "mentioned by" || links[0].Type != "mentions" {
+		t.Errorf("Links(incoming) = %+v, want two 'mentioned by' links", links)
+	}
+
+	beans, _ := resolver.Query().Beans(ctx, &model.BeanFilter{HasLink: &model.LinkFilter{Types: []string{"mentions"}, Direction: &incoming}}, nil)
+	if len(beans) != 2 {
+		t.Errorf("Beans(hasLink incoming mentions) = %d beans, want spec-1 and impl-1", len(beans))
+	}
+}
+
+func TestQueryGeneration(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	qr := resolver.Query()
+
+	before, err := qr.Generation(ctx)
+	if err != nil {
+		t.Fatalf("Generation() error = %v", err)
+	}
+	createTestBean(t, core, "gen-1", "Gen", "todo")
+	after, _ := qr.Generation(ctx)
+	if after <= before {
+		t.Errorf("Generation() = %d after create, want > %d", after, before)
+	}
+}
+
+func TestMutationUpdateBeanSection(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	b := &bean.Bean{ID: "sec-1", Slug: "sec", Title: "Sections", Status: "todo",
+		Body: "Intro\n\n## Repro Steps\n\nOld steps\n\n## Notes\n\nKeep me"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	updated, err := mr.UpdateBeanSection(ctx, "sec-1", "repro steps", "1. New", nil)
+	if err != nil {
+		t.Fatalf("UpdateBeanSection() error = %v", err)
+	}
+	if s, ok := updated.Section("Repro Steps"); !ok || s.Content != "1. New" {
+		t.Errorf("Repro Steps = %q, want %q", s.Content, "1. New")
+	}
+	if s, _ := updated.Section("Notes"); s.Content != "Keep me" {
+		t.Errorf("Notes = %q, want it unchanged", s.Content)
+	}
+
+	// Missing sections are appended
+	updated, err = mr.UpdateBeanSection(ctx, "sec-1", "Acceptance Criteria", "- [ ] Works", nil)
+	if err != nil {
+		t.Fatalf("UpdateBeanSection() error = %v", err)
+	}
+	sections := updated.Sections()
+	if last := sections[len(sections)-1]; last.Heading != "Acceptance Criteria" || last.Content != "- [ ] Works" {
+		t.Errorf("last section = %+v, want appended Acceptance Criteria", last)
+	}
+
+	stale := "stale"
+	if _, err := mr.UpdateBeanSection(ctx, "sec-1", "Notes", "x", &stale); err == nil {
+		t.Error("UpdateBeanSection() with stale etag should fail")
+	}
+	if _, err := mr.UpdateBeanSection(ctx, "sec-1", " ", "x", nil); err == nil {
+		t.Error("UpdateBeanSection() with empty heading should fail")
+	}
+}
+
+func TestMutationToggleChecklistItem(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	mr := resolver.Mutation()
+
+	b := &bean.Bean{ID: "chk-1", Slug: "chk", Title: "Checklist", Status: "todo", Body: "- [ ] One\n- [x] Two"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	updated, err := mr.ToggleChecklistItem(ctx, "chk-1", 1, nil, nil)
+	if err != nil {
+		t.Fatalf("ToggleChecklistItem() error = %v", err)
+	}
+	if c := updated.Checklist(); c.Done != 2 || c.Total != 2 {
+		t.Errorf("checklist = %d/%d, want 2/2", c.Done, c.Total)
+	}
+
+	done := true
+	updated, err = mr.ToggleChecklistItem(ctx, "chk-1", 2, &done, nil)
+	if err != nil {
+		t.Fatalf("ToggleChecklistItem(done: true) error = %v", err)
+	}
+	if !updated.Checklist().Items[1].Done {
+		t.Error("explicit done should leave an already checked item checked")
+	}
+
+	if _, err := mr.ToggleChecklistItem(ctx, "chk-1", 3, nil, nil); err == nil {
+		t.Error("ToggleChecklistItem() with out of range index should fail")
+	}
+}
+
+func TestTagMutations(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	for id, tags := range map[string][]string{"tag-1": {"ui", "api"}, "tag-2": {"ui"}} {
+		if err := core.Create(&bean.Bean{ID: id, Slug: id, Title: id, Status: "todo", Tags: tags}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	tags, err := resolver.Query().Tags(ctx)
+	if err != nil {
+		t.Fatalf("Tags() error = %v", err)
+	}
+	if len(tags) != 2 || tags[0].Tag != "api" || tags[1].Tag != "ui" || tags[1].Count != 2 {
+		t.Errorf("Tags() = %+v, want api (1), ui (2)", tags)
+	}
+
+	renamed, err := resolver.Mutation().RenameTag(ctx, "ui", "frontend")
+	if err != nil {
+		t.Fatalf("RenameTag() error = %v", err)
+	}
+	if len(renamed) != 2 {
+		t.Errorf("RenameTag() updated %d beans, want 2", len(renamed))
+	}
+
+	deleted, err := resolver.Mutation().DeleteTag(ctx, "api")
+	if err != nil {
+		t.Fatalf("DeleteTag() error = %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].HasTag("api") {
+		t.Errorf("DeleteTag() = %v, want tag-1 without api", deleted)
+	}
+
+	none, err := resolver.Mutation().DeleteTag(ctx, "missing")
+	if err != nil || none == nil || len(none) != 0 {
+		t.Errorf("DeleteTag(missing) = %v, %v; want empty list", none, err)
+	}
+}
+
+func TestSLAFields(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	core.Config().Beans.SLA = map[string]map[string]string{"critical": {"todo": "2d"}}
+
+	old := time.Now().UTC().Add(-5 * 24 * time.Hour)
+	late := &bean.Bean{ID: "sla-1", Title: "Late", Status: "todo", Priority: "critical"}
+	onTime := &bean.Bean{ID: "sla-2", Title: "On time", Status: "todo", Priority: "critical"}
+	core.Create(late)
+	core.Create(onTime)
+	core.Create(&bean.Bean{ID: "sla-3", Title: "No SLA", Status: "todo"})
+	late.StatusHistory = []bean.StatusChange{{Status: "todo", ChangedAt: old}}
+
+	breached := true
+	got, err := resolver.Query().Beans(ctx, &model.BeanFilter{SLABreached: &breached}, nil)
+	if err != nil {
+		t.Fatalf("Beans() error = %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "sla-1" {
+		t.Errorf("Beans(slaBreached: true) = %v, want [sla-1]", got)
+	}
+
+	br := resolver.Bean()
+	if ok, _ := br.SLABreached(ctx, late); !ok {
+		t.Error("SLABreached(sla-1) = false, want true")
+	}
+	if ok, _ := br.SLABreached(ctx, onTime); ok {
+		t.Error("SLABreached(sla-2) = true, want false")
+	}
+	if deadline, _ := br.SLADeadline(ctx, late); deadline == nil || !deadline.Equal(old.Add(48*time.Hour)) {
+		t.Errorf("SLADeadline(sla-1) = %v, want %v", deadline, old.Add(48*time.Hour))
+	}
+	noSLA, _ := core.Get("sla-3")
+	if deadline, _ := br.SLADeadline(ctx, noSLA); deadline != nil {
+		t.Errorf("SLADeadline(sla-3) = %v, want nil", deadline)
+	}
+}
+
+func TestBeansTimeFilters(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	daysAgo := func(days int) *time.Time {
+		t := now.AddDate(0, 0, -days)
+		return &t
+	}
+	for _, tt := range []struct {
+		id               string
+		created, updated int
+	}{
+		{"time-1", 30, 20},
+		{"time-2", 10, 1},
+		{"time-3", 2, 2},
+	} {
+		b := &bean.Bean{ID: tt.id, Title: tt.id, Status: "todo"}
+		core.Create(b)
+		b.CreatedAt = daysAgo(tt.created)
+		b.UpdatedAt = daysAgo(tt.updated)
+	}
+	core.Create(&bean.Bean{ID: "time-4", Title: "No times", Status: "todo"})
+	untimed, _ := core.Get("time-4")
+	untimed.CreatedAt, untimed.UpdatedAt = nil, nil
+
+	tests := []struct {
+		name   string
+		filter *model.BeanFilter
+		want   []string
+	}{
+		{"createdAfter", &model.BeanFilter{CreatedAfter: daysAgo(15)}, []string{"time-2", "time-3"}},
+		{"createdBefore", &model.BeanFilter{CreatedBefore: daysAgo(15)}, []string{"time-1"}},
+		{"updatedAfter", &model.BeanFilter{UpdatedAfter: daysAgo(5)}, []string{"time-2", "time-3"}},
+		{"updatedBefore", &model.BeanFilter{UpdatedBefore: daysAgo(5)}, []string{"time-1"}},
+		{"range", &model.BeanFilter{CreatedAfter: daysAgo(15), UpdatedBefore: daysAgo(1)}, []string{"time-3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.Query().Beans(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("Beans() error = %v", err)
+			}
+			var ids []string
+			for _, b := range got {
+				ids = append(ids, b.ID)
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Beans(%s) = %v, want %v", tt.name, ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestAgingResolvers(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	core.Config().Beans.Aging = []config.AgingRule{{After: "14d", Priority: "high"}}
+
+	b := &bean.Bean{ID: "age-1", Title: "Old", Status: "todo", Priority: "normal"}
+	core.Create(b)
+	b.StatusHistory = []bean.StatusChange{{Status: "todo", ChangedAt: time.Now().UTC().Add(-20 * 24 * time.Hour)}}
+
+	report, err := resolver.Query().AgingReport(ctx)
+	if err != nil {
+		t.Fatalf("AgingReport() error = %v", err)
+	}
+	if len(report) != 1 || report[0].NewPriority != "high" {
+		t.Fatalf("AgingReport() = %+v, want age-1 → high", report)
+	}
+
+	applied, err := resolver.Mutation().ApplyAging(ctx)
+	if err != nil {
+		t.Fatalf("ApplyAging() error = %v", err)
+	}
+	if len(applied) != 1 {
+		t.Errorf("ApplyAging() = %+v, want one action", applied)
+	}
+	if got, _ := core.Get("age-1"); got.Priority != "high" {
+		t.Errorf("priority after ApplyAging() = %s, want high", got.Priority)
+	}
+}
+
+func TestDrafts(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	createTestBean(t, core, "pub-1", "Published", "todo")
+
+	draft := true
+	b, err := resolver.Mutation().CreateBean(ctx, model.CreateBeanInput{Title: "Still writing", Draft: &draft})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	if !b.Draft {
+		t.Fatal("CreateBean(draft: true) didn't create a draft")
+	}
+
+	// Hidden by default, with or without a filter
+	if got, _ := resolver.Query().Beans(ctx, nil, nil); len(got) != 1 || got[0].ID != "pub-1" {
+		t.Errorf("Beans(nil) = %v, want [pub-1]", got)
+	}
+	if got, _ := resolver.Query().Beans(ctx, &model.BeanFilter{Status: []string{"todo"}}, nil); len(got) != 1 {
+		t.Errorf("Beans(status: todo) = %v, want only pub-1", got)
+	}
+	if got, _ := resolver.Query().Beans(ctx, &model.BeanFilter{IncludeDrafts: &draft}, nil); len(got) != 2 {
+		t.Errorf("Beans(includeDrafts: true) = %d beans, want 2", len(got))
+	}
+	if got, _ := resolver.Query().Bean(ctx, b.ID); got == nil {
+		t.Error("Bean() doesn't find the draft by ID")
+	}
+
+	published, err := resolver.Mutation().PublishBean(ctx, b.ID)
+	if err != nil {
+		t.Fatalf("PublishBean() error = %v", err)
+	}
+	if published.Draft {
+		t.Error("PublishBean() left the bean a draft")
+	}
+	if got, _ := resolver.Query().Beans(ctx, nil, nil); len(got) != 2 {
+		t.Errorf("Beans(nil) after publishing = %d beans, want 2", len(got))
+	}
+	if _, err := resolver.Mutation().PublishBean(ctx, b.ID); err == nil {
+		t.Error("PublishBean() on a published bean should fail")
+	}
+}
+
+func TestTitleSuggestion(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	core.Config().Beans.Titles = config.TitlesConfig{Case: config.TitleCaseSentence, ForbiddenPrefixes: []string{"WIP:"}}
+
+	if _, err := resolver.Mutation().CreateBean(ctx, model.CreateBeanInput{Title: "add Dark Mode"}); err == nil {
+		t.Error("CreateBean() with a title that isn't in sentence case should fail")
+	}
+	b, err := resolver.Mutation().CreateBean(ctx, model.CreateBeanInput{Title: "Add dark mode"})
+	if err != nil {
+		t.Fatalf("CreateBean() error = %v", err)
+	}
+	if suggestion, _ := resolver.Bean().TitleSuggestion(ctx, b); suggestion != nil {
+		t.Errorf("TitleSuggestion() = %q for a title following the policy", *suggestion)
+	}
+
+	title := "WIP: Add Dark Mode"
+	b, err = resolver.Mutation().UpdateBean(ctx, b.ID, model.UpdateBeanInput{Title: &title})
+	if err != nil {
+		t.Fatalf("UpdateBean() error = %v", err)
+	}
+	if suggestion, _ := resolver.Bean().TitleSuggestion(ctx, b); suggestion == nil || *suggestion != "Add dark mode" {
+		t.Errorf("TitleSuggestion() = %v, want %q", suggestion, "Add dark mode")
+	}
+}
+
+func TestBeansTitleFilters(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	createTestBean(t, core, "title-1", "Fix login token refresh", "todo")
+	createTestBean(t, core, "title-2", "Auth token rotation", "todo")
+	createTestBean(t, core, "title-3", "Update docs", "todo")
+
+	pattern := func(s string) *string { return &s }
+	tests := []struct {
+		name   string
+		filter *model.BeanFilter
+		want   []string
+	}{
+		{"regexp", &model.BeanFilter{TitleMatches: pattern("auth.*token")}, []string{"title-2"}},
+		{"regexp ignores case", &model.BeanFilter{TitleMatches: pattern("^fix")}, []string{"title-1"}},
+		{"fuzzy", &model.BeanFilter{TitleFuzzy: pattern("lgn")}, []string{"title-1"}},
+		{"fuzzy in order", &model.BeanFilter{TitleFuzzy: pattern("tkn rf")}, []string{"title-1"}},
+		{"fuzzy no match", &model.BeanFilter{TitleFuzzy: pattern("ngl")}, nil},
+		{"both", &model.BeanFilter{TitleMatches: pattern("token"), TitleFuzzy: pattern("ath")}, []string{"title-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.Query().Beans(ctx, tt.filter, nil)
+			if err != nil {
+				t.Fatalf("Beans() error = %v", err)
+			}
+			var ids []string
+			for _, b := range got {
+				ids = append(ids, b.ID)
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Beans(%s) = %v, want %v", tt.name, ids, tt.want)
+			}
+		})
+	}
+
+	if _, err := resolver.Query().Beans(ctx, &model.BeanFilter{TitleMatches: pattern("(")}, nil); err == nil {
+		t.Error("Beans() with an invalid titleMatches: expected an error")
+	}
+}
+
+func TestBeansQueryFilter(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	for _, b := range []*bean.Bean{
+		{ID: "q-1", Title: "Urgent fix", Status: "todo", Priority: "critical", Tags: []string{"backend"}},
+		{ID: "q-2", Title: "Blocked fix", Status: "todo", Priority: "high", Tags: []string{"backend", "blocked"}},
+		{ID: "q-3", Title: "Someday", Status: "todo", Priority: "low"},
+		{ID: "q-4", Title: "Done", Status: "completed", Priority: "high", Tags: []string{"frontend"}},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"status:todo AND NOT tag:blocked AND priority>=high", []string{"q-1"}},
+		{"tag:backend tag:blocked", []string{"q-2"}},
+		{"status:completed OR priority:low", nil},
+		{"priority:low OR priority:critical", []string{"q-1", "q-3"}},
+		{"-status:todo title~dn", []string{"q-4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query := tt.query
+			got, err := resolver.Query().Beans(ctx, &model.BeanFilter{Query: &query}, nil)
+			if tt.want == nil {
+				if err == nil {
+					t.Errorf("Beans(%q): expected an error", tt.query)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Beans(%q) error = %v", tt.query, err)
+			}
+			var ids []string
+			for _, b := range got {
+				ids = append(ids, b.ID)
+			}
+			slices.Sort(ids)
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Beans(%q) = %v, want %v", tt.query, ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestBeansOrderBy(t *testing.T) {
+	resolver, core := setupTestResolver(t)
+	ctx := context.Background()
+	for _, b := range []*bean.Bean{
+		{ID: "o-1", Title: "Low", Status: "todo", Priority: "low"},
+		{ID: "o-2", Title: "Critical done", Status: "completed", Priority: "critical"},
+		{ID: "o-3", Title: "High", Status: "todo", Priority: "high"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	order := func(s string) *string { return &s }
+	ids := func(beans []*bean.Bean) []string {
+		var ids []string
+		for _, b := range beans {
+			ids = append(ids, b.ID)
+		}
+		return ids
+	}
+
+	got, err := resolver.Query().Beans(ctx, nil, order("priority"))
+	if err != nil {
+		t.Fatalf("Beans() error = %v", err)
+	}
+	if want := []string{"o-2", "o-3", "o-1"}; !slices.Equal(ids(got), want) {
+		t.Errorf("Beans(orderBy: priority) = %v, want %v", ids(got), want)
+	}
+
+	got, err = resolver.Query().Beans(ctx, nil, order("status"))
+	if err != nil {
+		t.Fatalf("Beans() error = %v", err)
+	}
+	if want := []string{"o-3", "o-1", "o-2"}; !slices.Equal(ids(got), want) {
+		t.Errorf("Beans(orderBy: status) = %v, want %v", ids(got), want)
+	}
+
+	if _, err := resolver.Query().Beans(ctx, nil, order("title")); err == nil {
+		t.Error("Beans() with an unknown orderBy: expected an error")
+	}
+}
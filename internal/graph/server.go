@@ -0,0 +1,450 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"mime"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// ServerOptions configures the GraphQL HTTP handler.
+type ServerOptions struct {
+	// Introspection allows schema introspection queries.
+	Introspection bool
+	// Redact hides bean fields from responses.
+	Redact config.RedactConfig
+	// ReadOnly rejects mutations.
+	ReadOnly bool
+	// Auth, if set, requires a bearer token on every request. Mutations
+	// need a token with write scope.
+	Auth Authenticator
+	// RateLimit limits requests per client IP address.
+	RateLimit config.RateLimitConfig
+	// Logger, if set, logs every request.
+	Logger *slog.Logger
+}
+
+// requestParams is a GraphQL request as sent over HTTP.
+type requestParams struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// handler serves GraphQL over HTTP.
+type handler struct {
+	exec    *executor.Executor
+	auth    Authenticator
+	limiter *rateLimiter
+	logger  *slog.Logger
+}
+
+// NewHandler returns an http.Handler that executes GraphQL requests against
+// core: POST with a JSON body ({"query", "variables", "operationName"}), or
+// GET with query, variables and operationName URL parameters. Mutations
+// must be POSTed.
+func NewHandler(core *beancore.Core, opts ServerOptions) http.Handler {
+	exec := executor.New(NewExecutableSchema(Config{Resolvers: &Resolver{Core: core}}))
+	exec.SetErrorPresenter(PresentError)
+	if opts.Introspection {
+		exec.Use(enableIntrospection{})
+	}
+	if opts.ReadOnly {
+		exec.Use(ReadOnly{})
+	}
+	if opts.Auth != nil {
+		exec.Use(requireWriteScope{})
+	}
+	if !opts.Redact.IsEmpty() {
+		exec.AroundFields(RedactFields(opts.Redact))
+	}
+	h := &handler{exec: exec, auth: opts.Auth, logger: opts.Logger}
+	if opts.Logger != nil {
+		exec.AroundFields(countBeans)
+	}
+	if opts.RateLimit.RequestsPerSecond > 0 {
+		h.limiter = newRateLimiter(opts.RateLimit.RequestsPerSecond, opts.RateLimit.GetBurst())
+	}
+	return h
+}
+
+// requestStats collects what a request did, for the request log.
+type requestStats struct {
+	operation string
+	opType    string
+	beans     atomic.Int64
+}
+
+type requestStatsKey struct{}
+
+// statusRecorder remembers the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to
+// flush incremental responses.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	stats := &requestStats{}
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	client := clientAddr(r)
+	if h.logger != nil {
+		defer func() {
+			h.logger.LogAttrs(r.Context(), slog.LevelInfo, "request",
+				slog.String("method", r.Method),
+				slog.String("client", client),
+				slog.Int("status", rec.status),
+				slog.String("type", stats.opType),
+				slog.String("operation", stats.operation),
+				slog.Duration("duration", time.Since(start)),
+				slog.Int64("beans", stats.beans.Load()),
+			)
+		}()
+	}
+
+	if h.limiter != nil {
+		if ok, wait := h.limiter.allow(client); !ok {
+			rec.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			writeError(rec, http.StatusTooManyRequests, "rate limit exceeded")
+			return
+		}
+	}
+	h.serve(rec, r.WithContext(context.WithValue(r.Context(), requestStatsKey{}, stats)))
+}
+
+// clientAddr returns the client's IP address.
+func clientAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (h *handler) serve(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.auth != nil {
+		token, ok := bearerToken(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="beans"`)
+			writeError(w, http.StatusUnauthorized, ErrUnauthorized.Error())
+			return
+		}
+		scope, err := h.auth.Authenticate(ctx, token)
+		if errors.Is(err, ErrUnauthorized) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="beans", error="invalid_token"`)
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		} else if err != nil {
+			writeError(w, http.StatusServiceUnavailable, "authentication unavailable: "+err.Error())
+			return
+		}
+		ctx = withScope(ctx, scope)
+	}
+
+	var params requestParams
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		params.Query = q.Get("query")
+		params.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &params.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid variables: "+err.Error())
+				return
+			}
+		}
+	case http.MethodPost:
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+			writeError(w, http.StatusUnsupportedMediaType, "content type must be application/json")
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if params.Query == "" {
+		writeError(w, http.StatusBadRequest, "no query provided")
+		return
+	}
+	// Mutations only by POST, so a page can't change beans with a link or <img>
+	// (POST requires application/json, which forms can't send cross-site)
+	if r.Method == http.MethodGet && isMutation(params) {
+		w.Header().Set("Allow", "POST")
+		writeError(w, http.StatusMethodNotAllowed, "mutations must be sent by POST")
+		return
+	}
+
+	if acceptsIncremental(r) {
+		h.serveIncremental(w, ctx, params)
+		return
+	}
+	writeJSON(w, http.StatusOK, h.execute(ctx, params))
+}
+
+// execute runs a GraphQL request and returns its response, with deferred
+// fragments resolved in place.
+func (h *handler) execute(ctx context.Context, params requestParams) *graphql.Response {
+	responses, ctx := h.dispatch(ctx, params, false)
+	return responses(ctx)
+}
+
+// dispatch starts a GraphQL request and returns its responses: the first
+// has the data that isn't deferred, the others each a deferred fragment.
+// Unless incremental is set, @defer is ignored and there is only one.
+func (h *handler) dispatch(ctx context.Context, params requestParams, incremental bool) (graphql.ResponseHandler, context.Context) {
+	ctx = graphql.StartOperationTrace(ctx)
+	opCtx, errs := h.exec.CreateOperationContext(ctx, &graphql.RawParams{
+		Query:         params.Query,
+		Variables:     params.Variables,
+		OperationName: params.OperationName,
+	})
+	if stats, ok := ctx.Value(requestStatsKey{}).(*requestStats); ok && opCtx != nil && opCtx.Operation != nil {
+		stats.opType = string(opCtx.Operation.Operation)
+		stats.operation = opCtx.OperationName
+		if stats.operation == "" {
+			stats.operation = opCtx.Operation.Name
+		}
+	}
+	if errs != nil {
+		return graphql.OneShot(h.exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), errs)), ctx
+	}
+	if !incremental {
+		RemoveDefer(opCtx.Doc)
+	}
+	return h.exec.DispatchOperation(ctx, opCtx)
+}
+
+// acceptsIncremental reports whether the client takes incremental delivery
+// (multipart/mixed), so deferred fragments can be sent as they resolve.
+func acceptsIncremental(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(accept, ",") {
+			if mediaType, _, _ := mime.ParseMediaType(strings.TrimSpace(part)); mediaType == "multipart/mixed" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// incrementalBoundary separates the parts of an incremental response.
+const incrementalBoundary = "-"
+
+// serveIncremental writes a GraphQL request's responses as parts of a
+// multipart/mixed response as they become available: the initial result,
+// then one {"incremental": [...], "hasNext": ...} part per deferred fragment,
+// in the format gqlgen's own transport and Apollo Client use.
+func (h *handler) serveIncremental(w http.ResponseWriter, ctx context.Context, params requestParams) {
+	rc := http.NewResponseController(w)
+	responses, ctx := h.dispatch(ctx, params, true)
+	w.Header().Set("Content-Type", `multipart/mixed; boundary="`+incrementalBoundary+`"; deferSpec=20220824`)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for initial := true; ; initial = false {
+		resp := responses(ctx)
+		if resp == nil {
+			break
+		}
+		hasNext := resp.HasNext != nil && *resp.HasNext
+		var part any = resp
+		if !initial {
+			part = struct {
+				Incremental []*graphql.Response `json:"incremental"`
+				HasNext     bool                `json:"hasNext"`
+			}{[]*graphql.Response{resp}, hasNext}
+		}
+		data, _ := json.Marshal(part)
+		fmt.Fprintf(w, "--%s\r\nContent-Type: application/json\r\n\r\n%s\r\n", incrementalBoundary, data)
+		_ = rc.Flush()
+		if !hasNext {
+			break
+		}
+	}
+	fmt.Fprintf(w, "--%s--\r\n", incrementalBoundary)
+	_ = rc.Flush()
+}
+
+// RemoveDefer drops the @defer directives from a query, so its deferred
+// fragments are resolved with the rest of the result. Use it before
+// dispatching a query whose responses after the first are ignored.
+func RemoveDefer(doc *ast.QueryDocument) {
+	dropDefer := func(directives ast.DirectiveList) ast.DirectiveList {
+		return slices.DeleteFunc(directives, func(d *ast.Directive) bool { return d.Name == "defer" })
+	}
+	var walk func(ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				walk(sel.SelectionSet)
+			case *ast.InlineFragment:
+				sel.Directives = dropDefer(sel.Directives)
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				sel.Directives = dropDefer(sel.Directives)
+			}
+		}
+	}
+	for _, op := range doc.Operations {
+		walk(op.SelectionSet)
+	}
+	for _, frag := range doc.Fragments {
+		walk(frag.SelectionSet)
+	}
+}
+
+// countBeans is a field middleware that counts the beans a request returns.
+func countBeans(ctx context.Context, next graphql.Resolver) (any, error) {
+	res, err := next(ctx)
+	stats, ok := ctx.Value(requestStatsKey{}).(*requestStats)
+	if !ok {
+		return res, err
+	}
+	switch v := res.(type) {
+	case *bean.Bean:
+		if v != nil {
+			stats.beans.Add(1)
+		}
+	case []*bean.Bean:
+		stats.beans.Add(int64(len(v)))
+	}
+	return res, err
+}
+
+// Introspect runs the standard introspection query against the schema and
+// returns the result, for tools that consume introspection JSON.
+func Introspect(core *beancore.Core) (json.RawMessage, error) {
+	h := NewHandler(core, ServerOptions{Introspection: true}).(*handler)
+	resp := h.execute(context.Background(), requestParams{Query: introspection.Query})
+	if len(resp.Errors) > 0 {
+		return nil, resp.Errors
+	}
+	return resp.Data, nil
+}
+
+// writeError writes a request-level error in GraphQL response format.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, &graphql.Response{Errors: gqlerror.List{{Message: message}}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// enableIntrospection is an executor extension that enables schema introspection,
+// which the executor disables by default.
+type enableIntrospection struct{}
+
+var _ graphql.OperationContextMutator = enableIntrospection{}
+
+func (enableIntrospection) ExtensionName() string { return "Introspection" }
+
+func (enableIntrospection) Validate(graphql.ExecutableSchema) error { return nil }
+
+func (enableIntrospection) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	opCtx.DisableIntrospection = false
+	return nil
+}
+
+// isMutation reports whether the request's operation is a mutation. Queries
+// that don't parse aren't mutations; the executor reports their errors.
+func isMutation(params requestParams) bool {
+	doc, err := parser.ParseQuery(&ast.Source{Input: params.Query})
+	if err != nil {
+		return false
+	}
+	if params.OperationName != "" {
+		op := doc.Operations.ForName(params.OperationName)
+		return op != nil && op.Operation == ast.Mutation
+	}
+	for _, op := range doc.Operations {
+		if op.Operation == ast.Mutation {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrCodeAmbiguousID is the error code (in the error's extensions) of
+// lookups by a partial ID that matches several beans. The extensions also
+// list the matching IDs as "candidates".
+const ErrCodeAmbiguousID = "AMBIGUOUS_ID"
+
+// PresentError is an error presenter that adds the code and candidates to
+// errors for ambiguous IDs, and otherwise presents errors as gqlgen does.
+func PresentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var ambiguous *beancore.AmbiguousIDError
+	if errors.As(err, &ambiguous) {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]any{}
+		}
+		gqlErr.Extensions["code"] = ErrCodeAmbiguousID
+		gqlErr.Extensions["candidates"] = ambiguous.Candidates
+	}
+	return gqlErr
+}
+
+// ErrCodeReadOnly is the error code (in the error's extensions) of mutations
+// rejected by ReadOnly.
+const ErrCodeReadOnly = "READ_ONLY"
+
+// ReadOnly is an executor extension that rejects mutations, so the API can be
+// exposed without risking writes. Rejected operations fail with an error
+// whose extensions carry {"code": "READ_ONLY"}.
+type ReadOnly struct{}
+
+var _ graphql.OperationContextMutator = ReadOnly{}
+
+func (ReadOnly) ExtensionName() string { return "ReadOnly" }
+
+func (ReadOnly) Validate(graphql.ExecutableSchema) error { return nil }
+
+func (ReadOnly) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Mutation {
+		return nil
+	}
+	err := gqlerror.Errorf("mutations are disabled in read-only mode")
+	err.Extensions = map[string]any{"code": ErrCodeReadOnly}
+	return err
+}
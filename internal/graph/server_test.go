@@ -0,0 +1,424 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// graphqlResponse is a decoded GraphQL HTTP response.
+type graphqlResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func doGraphQL(t *testing.T, h http.Handler, req *http.Request) (int, graphqlResponse) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	var resp graphqlResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decoding response %q: %v", rec.Body.String(), err)
+	}
+	return rec.Code, resp
+}
+
+func postGraphQL(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestHandler(t *testing.T) {
+	_, core := setupTestResolver(t)
+	createTestBean(t, core, "srv-1", "Served", "todo")
+	h := NewHandler(core, ServerOptions{})
+
+	t.Run("POST query with variables", func(t *testing.T) {
+		code, resp := doGraphQL(t, h, postGraphQL(`{"query": "query($id: ID!) { bean(id: $id) { title } }", "variables": {"id": "srv-1"}}`))
+		if code != http.StatusOK || len(resp.Errors) > 0 {
+			t.Fatalf("status %d, errors %v", code, resp.Errors)
+		}
+		if title := resp.Data["bean"].(map[string]any)["title"]; title != "Served" {
+			t.Errorf("title = %v, want Served", title)
+		}
+	})
+
+	t.Run("GET query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape("{ beans { id } }"), nil)
+		code, resp := doGraphQL(t, h, req)
+		if code != http.StatusOK || len(resp.Data["beans"].([]any)) != 1 {
+			t.Errorf("status %d, data %v, errors %v", code, resp.Data, resp.Errors)
+		}
+	})
+
+	t.Run("GET mutation", func(t *testing.T) {
+		mutation := `mutation { updateBean(id: "srv-1", input: { status: "completed" }) { status } }`
+		req := httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(mutation), nil)
+		code, resp := doGraphQL(t, h, req)
+		if code != http.StatusMethodNotAllowed || len(resp.Errors) == 0 {
+			t.Errorf("status %d, errors %v; want %d with an error", code, resp.Errors, http.StatusMethodNotAllowed)
+		}
+		if b, _ := core.Get("srv-1"); b.Status != "todo" {
+			t.Errorf("status = %s, want todo (mutation should not run)", b.Status)
+		}
+	})
+
+	t.Run("GraphQL errors", func(t *testing.T) {
+		code, resp := doGraphQL(t, h, postGraphQL(`{"query": "{ nope }"}`))
+		if code != http.StatusOK || len(resp.Errors) == 0 {
+			t.Errorf("status %d, errors %v; want 200 with errors", code, resp.Errors)
+		}
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *http.Request
+			want int
+		}{
+			{"wrong method", httptest.NewRequest(http.MethodPut, "/graphql", nil), http.StatusMethodNotAllowed},
+			{"wrong content type", httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{}`)), http.StatusUnsupportedMediaType},
+			{"invalid body", postGraphQL(`{`), http.StatusBadRequest},
+			{"no query", postGraphQL(`{}`), http.StatusBadRequest},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				code, resp := doGraphQL(t, h, tt.req)
+				if code != tt.want || len(resp.Errors) == 0 {
+					t.Errorf("status %d, errors %v; want %d with an error", code, resp.Errors, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestHandlerDefer(t *testing.T) {
+	_, core := setupTestResolver(t)
+	if err := core.Create(&bean.Bean{ID: "epic-1", Title: "Epic", Status: "todo", Type: "epic"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.Create(&bean.Bean{ID: "srv-1", Title: "Served", Status: "todo", Type: "task", Parent: "epic-1"}); err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(core, ServerOptions{})
+	query := `{"query": "{ beans { id ... @defer(label: \"details\") { parentId } } }"}`
+
+	t.Run("resolved in place without multipart", func(t *testing.T) {
+		_, resp := doGraphQL(t, h, postGraphQL(query))
+		parents := map[any]any{}
+		for _, b := range resp.Data["beans"].([]any) {
+			parents[b.(map[string]any)["id"]] = b.(map[string]any)["parentId"]
+		}
+		if len(resp.Errors) > 0 || parents["srv-1"] != "epic-1" {
+			t.Errorf("data %v, errors %v; want the deferred parentId in place", resp.Data, resp.Errors)
+		}
+	})
+
+	t.Run("incremental with multipart", func(t *testing.T) {
+		req := postGraphQL(query)
+		req.Header.Set("Accept", "multipart/mixed; deferSpec=20220824, application/json")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		mediaType, params, err := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+		if err != nil || mediaType != "multipart/mixed" {
+			t.Fatalf("Content-Type = %q, want multipart/mixed", rec.Header().Get("Content-Type"))
+		}
+		var parts []map[string]any
+		mr := multipart.NewReader(rec.Body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("reading parts of %q: %v", rec.Body.String(), err)
+			}
+			var part map[string]any
+			if err := json.NewDecoder(p).Decode(&part); err != nil {
+				t.Fatal(err)
+			}
+			parts = append(parts, part)
+		}
+		if len(parts) < 2 {
+			t.Fatalf("got %d parts, want an initial and incremental ones: %v", len(parts), parts)
+		}
+
+		initial := parts[0]["data"].(map[string]any)["beans"].([]any)
+		if len(initial) != 2 || parts[0]["hasNext"] != true {
+			t.Errorf("initial part = %v, want both beans and hasNext", parts[0])
+		}
+		for _, b := range initial {
+			if b.(map[string]any)["parentId"] != nil {
+				t.Errorf("initial part has parentId: %v", b)
+			}
+		}
+		var parentIDs []any
+		for _, p := range parts[1:] {
+			for _, inc := range p["incremental"].([]any) {
+				inc := inc.(map[string]any)
+				if inc["label"] != "details" {
+					t.Errorf("incremental label = %v, want details", inc["label"])
+				}
+				parentIDs = append(parentIDs, inc["data"].(map[string]any)["parentId"])
+			}
+		}
+		if len(parentIDs) != 2 || parts[len(parts)-1]["hasNext"] != false {
+			t.Errorf("incremental parts = %v, want both beans' parentId, the last without hasNext", parts[1:])
+		}
+	})
+}
+
+func TestHandlerIntrospection(t *testing.T) {
+	_, core := setupTestResolver(t)
+	query := `{"query": "{ __schema { queryType { name } } }"}`
+
+	_, resp := doGraphQL(t, NewHandler(core, ServerOptions{}), postGraphQL(query))
+	if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0].Message, "introspection disabled") {
+		t.Errorf("introspection should be disabled by default, got %v", resp)
+	}
+
+	_, resp = doGraphQL(t, NewHandler(core, ServerOptions{Introspection: true}), postGraphQL(query))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("introspection enabled: errors %v", resp.Errors)
+	}
+	if name := resp.Data["__schema"].(map[string]any)["queryType"].(map[string]any)["name"]; name != "Query" {
+		t.Errorf("queryType = %v, want Query", name)
+	}
+
+	data, err := Introspect(core)
+	if err != nil || !strings.Contains(string(data), `"__schema"`) {
+		t.Errorf("Introspect() = %.80s, %v", data, err)
+	}
+}
+
+func TestHandlerRedaction(t *testing.T) {
+	_, core := setupTestResolver(t)
+	createTestBean(t, core, "srv-1", "Customer ACME", "todo")
+	b, _ := core.Get("srv-1")
+	b.Body = "## Notes\n\n- [ ] Call jane@acme.example\n"
+
+	h := NewHandler(core, ServerOptions{Redact: config.RedactConfig{Exclude: []string{"body"}, Mask: []string{"title"}}})
+	_, resp := doGraphQL(t, h, postGraphQL(`{"query": "{ bean(id: \"srv-1\") { id title status body sections { heading } checklist { total items { text } } } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors %v", resp.Errors)
+	}
+	got := resp.Data["bean"].(map[string]any)
+	if got["title"] != "[redacted]" {
+		t.Errorf("title = %v, want [redacted]", got["title"])
+	}
+	if got["body"] != "" || len(got["sections"].([]any)) != 0 || got["checklist"].(map[string]any)["total"] != float64(0) {
+		t.Errorf("body fields not excluded: %v", got)
+	}
+	if got["id"] != "srv-1" || got["status"] != "todo" {
+		t.Errorf("unredacted fields changed: %v", got)
+	}
+}
+
+func TestHandlerRedactionDerivedFields(t *testing.T) {
+	_, core := setupTestResolver(t)
+	createTestBean(t, core, "srv-p", "Customer ACME epic", "todo")
+	createTestBean(t, core, "srv-1", "Customer ACME", "todo")
+	b, _ := core.Get("srv-1")
+	b.Parent = "srv-p"
+
+	h := NewHandler(core, ServerOptions{Redact: config.RedactConfig{Exclude: []string{"parent"}, Mask: []string{"title"}}})
+	_, resp := doGraphQL(t, h, postGraphQL(`{"query": "{ bean(id: \"srv-1\") { path parentId parent { id } } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors %v", resp.Errors)
+	}
+	got := resp.Data["bean"].(map[string]any)
+	if got["path"] != "[redacted]" {
+		t.Errorf("path = %v, want [redacted]", got["path"])
+	}
+	if got["parent"] != nil || got["parentId"] != nil {
+		t.Errorf("parent = %v, parentId = %v, want null", got["parent"], got["parentId"])
+	}
+}
+
+func TestHandlerRedactionComputedFields(t *testing.T) {
+	_, core := setupTestResolver(t)
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "CODEOWNERS"), []byte("/packages/api/ @api-team\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	core.Config().SetConfigDir(root)
+	core.Config().Beans.SLA = map[string]map[string]string{"critical": {"completed": "1h"}}
+
+	createTestBean(t, core, "srv-1", "Customer ACME", "completed")
+	b, _ := core.Get("srv-1")
+	points := 3
+	started, done := time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour)
+	b.Points = &points
+	b.Priority = "critical"
+	b.Scope = "packages/api"
+	b.Body = "Contact: jane@acme.example"
+	b.StatusHistory = []bean.StatusChange{{Status: "in-progress", ChangedAt: started}, {Status: "completed", ChangedAt: done}}
+	b.GitCreatedAt, b.GitMergedAt = &started, &done
+
+	query := `{"query": "{ bean(id: \"srv-1\") { etag pointsRollup { total } estimateTotal estimateMissingCount timeInStatus { seconds } cycleTime slaDeadline slaBreached branchCycleTime owners } }"}`
+	fields := func(t *testing.T, redact config.RedactConfig) map[string]any {
+		t.Helper()
+		_, resp := doGraphQL(t, NewHandler(core, ServerOptions{Redact: redact}), postGraphQL(query))
+		if len(resp.Errors) > 0 {
+			t.Fatalf("errors %v", resp.Errors)
+		}
+		return resp.Data["bean"].(map[string]any)
+	}
+
+	tests := []struct {
+		source  string
+		derived map[string]any
+	}{
+		{"points", map[string]any{"pointsRollup": map[string]any{"total": float64(0)}, "estimateTotal": float64(0)}},
+		{"status_history", map[string]any{"timeInStatus": []any{}, "cycleTime": nil, "slaDeadline": nil, "slaBreached": false}},
+		{"status", map[string]any{"timeInStatus": []any{}, "cycleTime": nil, "slaDeadline": nil, "slaBreached": false}},
+		{"priority", map[string]any{"slaDeadline": nil, "slaBreached": false}},
+		{"git_created_at", map[string]any{"branchCycleTime": nil}},
+		{"git_merged_at", map[string]any{"branchCycleTime": nil}},
+		{"scope", map[string]any{"owners": []any{}}},
+		{"body", map[string]any{"etag": ""}},
+	}
+	plain := fields(t, config.RedactConfig{})
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			got := fields(t, config.RedactConfig{Exclude: []string{tt.source}})
+			for field, want := range tt.derived {
+				if reflect.DeepEqual(plain[field], want) {
+					t.Fatalf("%s = %v without redaction, want a value to redact", field, plain[field])
+				}
+				if !reflect.DeepEqual(got[field], want) {
+					t.Errorf("%s = %v with %s excluded, want %v", field, got[field], tt.source, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHandlerReadOnly(t *testing.T) {
+	_, core := setupTestResolver(t)
+	createTestBean(t, core, "srv-1", "Served", "todo")
+	h := NewHandler(core, ServerOptions{ReadOnly: true})
+
+	_, resp := doGraphQL(t, h, postGraphQL(`{"query": "{ bean(id: \"srv-1\") { title } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("queries should work in read-only mode, got errors %v", resp.Errors)
+	}
+
+	_, resp = doGraphQL(t, h, postGraphQL(`{"query": "mutation { updateBean(id: \"srv-1\", input: { status: \"completed\" }) { status } }"}`))
+	if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != ErrCodeReadOnly {
+		t.Fatalf("errors = %v, want one %s error", resp.Errors, ErrCodeReadOnly)
+	}
+	if b, _ := core.Get("srv-1"); b.Status != "todo" {
+		t.Errorf("status = %s, want todo (mutation should not run)", b.Status)
+	}
+}
+
+func TestHandlerAmbiguousID(t *testing.T) {
+	_, core := setupTestResolver(t)
+	core.Config().Beans.PrefixMatching = true
+	createTestBean(t, core, "srv-1", "One", "todo")
+	createTestBean(t, core, "srv-2", "Two", "todo")
+	h := NewHandler(core, ServerOptions{})
+
+	_, resp := doGraphQL(t, h, postGraphQL(`{"query": "{ bean(id: \"srv-1\") { id } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors %v", resp.Errors)
+	}
+
+	_, resp = doGraphQL(t, h, postGraphQL(`{"query": "{ bean(id: \"srv\") { id } }"}`))
+	if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != ErrCodeAmbiguousID {
+		t.Fatalf("errors = %v, want one %s error", resp.Errors, ErrCodeAmbiguousID)
+	}
+	candidates, _ := resp.Errors[0].Extensions["candidates"].([]any)
+	if len(candidates) != 2 || candidates[0] != "srv-1" || candidates[1] != "srv-2" {
+		t.Errorf("candidates = %v, want [srv-1 srv-2]", resp.Errors[0].Extensions["candidates"])
+	}
+}
+
+func TestHandlerAliases(t *testing.T) {
+	_, core := setupTestResolver(t)
+	h := NewHandler(core, ServerOptions{})
+
+	_, resp := doGraphQL(t, h, postGraphQL(`{"query": "mutation { createBean(input: { title: \"Imported\", aliases: [\"JIRA-123\"] }) { id } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors %v", resp.Errors)
+	}
+	id := resp.Data["createBean"].(map[string]any)["id"]
+
+	_, resp = doGraphQL(t, h, postGraphQL(`{"query": "{ bean(id: \"JIRA-123\") { id aliases } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors %v", resp.Errors)
+	}
+	got := resp.Data["bean"].(map[string]any)
+	if got["id"] != id || !reflect.DeepEqual(got["aliases"], []any{"JIRA-123"}) {
+		t.Errorf("bean(JIRA-123) = %v, want %v with aliases [JIRA-123]", got, id)
+	}
+
+	_, resp = doGraphQL(t, h, postGraphQL(`{"query": "mutation { updateBean(id: \"JIRA-123\", input: { aliases: [] }) { aliases } }"}`))
+	if len(resp.Errors) > 0 {
+		t.Fatalf("errors %v", resp.Errors)
+	}
+	if aliases := resp.Data["updateBean"].(map[string]any)["aliases"]; !reflect.DeepEqual(aliases, []any{}) {
+		t.Errorf("aliases after update = %v, want []", aliases)
+	}
+}
+
+func TestHandlerRateLimitAndLogging(t *testing.T) {
+	_, core := setupTestResolver(t)
+	createTestBean(t, core, "srv-1", "One", "todo")
+	createTestBean(t, core, "srv-2", "Two", "todo")
+
+	var logs bytes.Buffer
+	h := NewHandler(core, ServerOptions{
+		RateLimit: config.RateLimitConfig{RequestsPerSecond: 1},
+		Logger:    slog.New(slog.NewJSONHandler(&logs, nil)),
+	})
+
+	code, _ := doGraphQL(t, h, postGraphQL(`{"query": "query ListBeans { beans { id } }"}`))
+	if code != http.StatusOK {
+		t.Fatalf("first request: status %d", code)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, postGraphQL(`{"query": "{ beans { id } }"}`))
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("second request: status %d, Retry-After %q; want 429 with Retry-After", rec.Code, rec.Header().Get("Retry-After"))
+	}
+
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("decoding log line %q: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d log entries, want 2: %s", len(entries), logs.String())
+	}
+	first := entries[0]
+	if first["operation"] != "ListBeans" || first["type"] != "query" || first["beans"] != float64(2) || first["status"] != float64(200) {
+		t.Errorf("first log entry = %v", first)
+	}
+	if entries[1]["status"] != float64(http.StatusTooManyRequests) {
+		t.Errorf("second log entry = %v, want status 429", entries[1])
+	}
+}
@@ -0,0 +1,161 @@
+// Package logging builds the leveled, structured loggers (log/slog) used
+// across beans. Text output is meant for humans at a terminal ("warning:
+// failed to index bean bean=abc1"); JSON output is meant for log collectors,
+// e.g. when running beans serve.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Environment variables that set the log level and format when the
+// corresponding flags aren't given.
+const (
+	EnvLevel  = "BEANS_LOG_LEVEL"
+	EnvFormat = "BEANS_LOG_FORMAT"
+)
+
+// Log formats.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Formats lists the supported log formats.
+var Formats = []string{FormatText, FormatJSON}
+
+// Discard is a logger that drops all records.
+var Discard = slog.New(slog.DiscardHandler)
+
+// ParseLevel parses a level name: debug, info, warn (or warning) or error.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("invalid log level %q (must be debug, info, warn or error)", s)
+}
+
+// ParseFormat validates a format name.
+func ParseFormat(s string) (string, error) {
+	f := strings.ToLower(strings.TrimSpace(s))
+	if !slices.Contains(Formats, f) {
+		return "", fmt.Errorf("invalid log format %q (must be %s)", s, strings.Join(Formats, " or "))
+	}
+	return f, nil
+}
+
+// New returns a logger writing records at or above level to w in the given
+// format (FormatText or FormatJSON).
+func New(w io.Writer, level slog.Level, format string) *slog.Logger {
+	if format == FormatJSON {
+		return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level}))
+	}
+	return slog.New(&textHandler{w: w, level: level, mu: &sync.Mutex{}})
+}
+
+// textHandler writes one line per record: the level as a prefix (none for
+// info), the message, then the attributes as key=value pairs.
+type textHandler struct {
+	w      io.Writer
+	level  slog.Level
+	mu     *sync.Mutex
+	attrs  string // preformatted attributes from WithAttrs
+	prefix string // group prefix from WithGroup, e.g. "request."
+}
+
+func (h *textHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *textHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	switch {
+	case r.Level >= slog.LevelError:
+		b.WriteString("error: ")
+	case r.Level >= slog.LevelWarn:
+		b.WriteString("warning: ")
+	case r.Level < slog.LevelInfo:
+		b.WriteString("debug: ")
+	}
+	b.WriteString(r.Message)
+	b.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		writeAttr(&b, h.prefix, a)
+		return true
+	})
+	b.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+func (h *textHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	for _, a := range attrs {
+		writeAttr(&b, h.prefix, a)
+	}
+	h2 := *h
+	h2.attrs += b.String()
+	return &h2
+}
+
+func (h *textHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.prefix += name + "."
+	return &h2
+}
+
+// writeAttr writes " key=value", flattening groups into dotted keys.
+func writeAttr(b *strings.Builder, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			writeAttr(b, prefix, ga)
+		}
+		return
+	}
+
+	var value string
+	switch a.Value.Kind() {
+	case slog.KindTime:
+		value = a.Value.Time().Format(time.RFC3339)
+	case slog.KindDuration:
+		value = a.Value.Duration().Round(time.Microsecond).String()
+	default:
+		value = a.Value.String()
+	}
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		value = strconv.Quote(value)
+	}
+	b.WriteByte(' ')
+	b.WriteString(prefix)
+	b.WriteString(a.Key)
+	b.WriteByte('=')
+	b.WriteString(value)
+}
@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    slog.Level
+		wantErr bool
+	}{
+		{"debug", slog.LevelDebug, false},
+		{"INFO", slog.LevelInfo, false},
+		{"warn", slog.LevelWarn, false},
+		{"warning", slog.LevelWarn, false},
+		{" error ", slog.LevelError, false},
+		{"loud", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLevel(tt.in)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseLevel(%q) = %v, %v; want %v (error %v)", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	if f, err := ParseFormat("JSON"); err != nil || f != FormatJSON {
+		t.Errorf("ParseFormat(JSON) = %q, %v", f, err)
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) should fail")
+	}
+}
+
+func TestTextHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, slog.LevelInfo, FormatText)
+
+	logger.Debug("hidden")
+	logger.Info("started", "addr", "127.0.0.1:7373")
+	logger.With("bean", "abc1").Warn("failed to index bean", "error", errors.New("disk full"))
+	logger.WithGroup("request").Error("failed", "took", 1500*time.Millisecond, "note", "")
+
+	want := `started addr=127.0.0.1:7373
+warning: failed to index bean bean=abc1 error="disk full"
+error: failed request.took=1.5s request.note=""
+`
+	if buf.String() != want {
+		t.Errorf("output =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestJSONHandler(t *testing.T) {
+	var buf bytes.Buffer
+	New(&buf, slog.LevelDebug, FormatJSON).Debug("loaded", "beans", 3)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("output %q is not JSON: %v", buf.String(), err)
+	}
+	if entry["level"] != "DEBUG" || entry["msg"] != "loaded" || entry["beans"] != float64(3) {
+		t.Errorf("entry = %v", entry)
+	}
+}
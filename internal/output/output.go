@@ -1,7 +1,6 @@
 package output
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 
@@ -17,6 +16,8 @@ const (
 	ErrValidation    = "VALIDATION_ERROR"
 	ErrConflict      = "CONFLICT"
 	ErrGit           = "GIT_ERROR"
+	ErrRemote        = "REMOTE_ERROR" // an external tracker's API failed
+	ErrAmbiguousID   = "AMBIGUOUS_ID" // a partial ID matches several beans
 )
 
 // Response is the standard JSON response envelope.
@@ -30,48 +31,54 @@ type Response struct {
 	Error    string       `json:"error,omitempty"`
 	Code     string       `json:"code,omitempty"`
 	Path     string       `json:"path,omitempty"`
+	// Candidates are the IDs an ambiguous partial ID matches.
+	Candidates []string `json:"candidates,omitempty"`
+	// Redaction is applied to the beans in the response.
+	Redaction bean.Redaction `json:"-"`
 }
 
 // JSON outputs a response as JSON to stdout.
 func JSON(resp Response) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "  ")
-	return enc.Encode(resp)
+	return resp.Redaction.Encode(os.Stdout, resp)
 }
 
 // Success outputs a successful single-bean response.
-func Success(b *bean.Bean, message string) error {
+func Success(r bean.Redaction, b *bean.Bean, message string) error {
 	return JSON(Response{
-		Success: true,
-		Bean:    b,
-		Message: message,
+		Success:   true,
+		Bean:      b,
+		Message:   message,
+		Redaction: r,
 	})
 }
 
 // SuccessWithWarnings outputs a successful single-bean response with warnings.
-func SuccessWithWarnings(b *bean.Bean, message string, warnings []string) error {
+func SuccessWithWarnings(r bean.Redaction, b *bean.Bean, message string, warnings []string) error {
 	return JSON(Response{
-		Success:  true,
-		Bean:     b,
-		Message:  message,
-		Warnings: warnings,
+		Success:   true,
+		Bean:      b,
+		Message:   message,
+		Warnings:  warnings,
+		Redaction: r,
 	})
 }
 
 // SuccessSingle outputs a single bean directly (no wrapper).
 // This allows intuitive jq usage: beans show --json <id> | jq '.title'
-func SuccessSingle(b *bean.Bean) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "  ")
-	return enc.Encode(b)
+func SuccessSingle(r bean.Redaction, b *bean.Bean) error {
+	return r.Encode(os.Stdout, b)
 }
 
 // SuccessMultiple outputs a bean array directly (no wrapper).
 // This allows intuitive jq usage: beans list --json | jq '.[]'
-func SuccessMultiple(beans []*bean.Bean) error {
-	enc := json.NewEncoder(os.Stdout)
-	enc.SetIndent("", "  ")
-	return enc.Encode(beans)
+func SuccessMultiple(r bean.Redaction, beans []*bean.Bean) error {
+	return r.Encode(os.Stdout, beans)
+}
+
+// SuccessGroups outputs an array of bean groups directly (no wrapper), like
+// SuccessMultiple: beans list --group-by status --json | jq '.[].beans[]'
+func SuccessGroups(r bean.Redaction, groups any) error {
+	return r.Encode(os.Stdout, groups)
 }
 
 // SuccessMessage outputs a success response with just a message.
@@ -101,6 +108,18 @@ func Error(code string, message string) error {
 	return fmt.Errorf("%s", message)
 }
 
+// ErrorWithCandidates outputs an error response listing the IDs an
+// ambiguous partial ID matches.
+func ErrorWithCandidates(code string, message string, candidates []string) error {
+	_ = JSON(Response{
+		Success:    false,
+		Error:      message,
+		Code:       code,
+		Candidates: candidates,
+	})
+	return fmt.Errorf("%s", message)
+}
+
 // ErrorFrom outputs an error response from an existing error.
 func ErrorFrom(code string, err error) error {
 	return Error(code, err.Error())
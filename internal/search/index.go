@@ -14,10 +14,11 @@ type Index struct {
 
 // beanDocument is the structure stored in the Bleve index.
 type beanDocument struct {
-	ID    string `json:"id"`
-	Slug  string `json:"slug"`
-	Title string `json:"title"`
-	Body  string `json:"body"`
+	ID      string   `json:"id"`
+	Slug    string   `json:"slug"`
+	Title   string   `json:"title"`
+	Body    string   `json:"body"`
+	Aliases []string `json:"aliases"`
 }
 
 // NewIndex creates a new in-memory Bleve index.
@@ -46,6 +47,7 @@ func buildIndexMapping() mapping.IndexMapping {
 	beanMapping.AddFieldMappingsAt("slug", textFieldMapping)
 	beanMapping.AddFieldMappingsAt("title", textFieldMapping)
 	beanMapping.AddFieldMappingsAt("body", textFieldMapping)
+	beanMapping.AddFieldMappingsAt("aliases", textFieldMapping)
 
 	// Create the index mapping with BM25 scoring for better relevance ranking
 	indexMapping := bleve.NewIndexMapping()
@@ -71,10 +73,11 @@ func (idx *Index) Close() error {
 // IndexBean adds or updates a bean in the search index.
 func (idx *Index) IndexBean(b *bean.Bean) error {
 	doc := beanDocument{
-		ID:    b.ID,
-		Slug:  b.Slug,
-		Title: b.Title,
-		Body:  b.Body,
+		ID:      b.ID,
+		Slug:    b.Slug,
+		Title:   b.Title,
+		Body:    b.Body,
+		Aliases: b.AllAliases(),
 	}
 	return idx.index.Index(b.ID, doc)
 }
@@ -124,10 +127,11 @@ func (idx *Index) IndexBeans(beans []*bean.Bean) error {
 	batch := idx.index.NewBatch()
 	for _, b := range beans {
 		doc := beanDocument{
-			ID:    b.ID,
-			Slug:  b.Slug,
-			Title: b.Title,
-			Body:  b.Body,
+			ID:      b.ID,
+			Slug:    b.Slug,
+			Title:   b.Title,
+			Body:    b.Body,
+			Aliases: b.AllAliases(),
 		}
 		if err := batch.Index(b.ID, doc); err != nil {
 			return err
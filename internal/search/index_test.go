@@ -126,6 +126,28 @@ func TestSearch_MatchSlug(t *testing.T) {
 	}
 }
 
+func TestSearch_MatchAlias(t *testing.T) {
+	idx := setupTestIndex(t)
+
+	beans := []*bean.Bean{
+		{ID: "aaa1", Title: "Feature A", Aliases: []string{"JIRA-123"}},
+		{ID: "bbb2", Title: "Feature B", Aliases: []string{"JIRA-456"}},
+	}
+	if err := idx.IndexBeans(beans); err != nil {
+		t.Fatalf("IndexBeans() error = %v", err)
+	}
+
+	for _, query := range []string{`"JIRA-123"`, "aliases:123"} {
+		ids, err := idx.Search(query, 10)
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if len(ids) != 1 || ids[0] != "aaa1" {
+			t.Errorf("Search(%s) = %v, want [aaa1]", query, ids)
+		}
+	}
+}
+
 func TestSearch_MultipleResults(t *testing.T) {
 	idx := setupTestIndex(t)
 
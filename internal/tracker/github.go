@@ -0,0 +1,373 @@
+// Package tracker implements the external trackers beans can be synced with
+// (see beancore.RemoteTracker).
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+)
+
+// GitHubProject syncs beans with the cards of a GitHub project (Projects v2)
+// via the GitHub GraphQL API. Beans become draft issues; cards for issues and
+// pull requests already on the board become beans, and are updated in place.
+type GitHubProject struct {
+	// URL is the GraphQL endpoint (default "https://api.github.com/graphql").
+	URL string
+	// Token is the API token; the projects API requires one.
+	Token string
+	// Client is the HTTP client used for requests (default: 30s timeout).
+	Client *http.Client
+
+	// Owner is the user or organization the project belongs to.
+	Owner string
+	// Number is the project's number, as in its URL.
+	Number int
+	// StatusField is the single select field holding the card's column.
+	StatusField string
+	// Columns maps bean statuses to column names.
+	Columns map[string]string
+	// Statuses lists all statuses in order, to map columns several
+	// statuses share back to the first of them.
+	Statuses []string
+
+	projectID string
+	fieldID   string
+	options   map[string]string // column name -> option ID
+	cards     map[string]githubCard
+}
+
+// githubCard is what's known about a card from the last Items call.
+type githubCard struct {
+	contentID   string
+	contentType string // DraftIssue, Issue or PullRequest
+	column      string
+	title       string
+	body        string
+	updatedAt   time.Time
+}
+
+// NewGitHubProject returns a GitHubProject for github.com.
+func NewGitHubProject(token, owner string, number int) *GitHubProject {
+	return &GitHubProject{
+		URL:         "https://api.github.com/graphql",
+		Token:       token,
+		Client:      &http.Client{Timeout: 30 * time.Second},
+		Owner:       owner,
+		Number:      number,
+		StatusField: "Status",
+	}
+}
+
+// MappingName returns the name of the project's sync mapping.
+func (p *GitHubProject) MappingName() string {
+	return fmt.Sprintf("github-project-%s-%d", strings.ToLower(p.Owner), p.Number)
+}
+
+// query runs a GraphQL query or mutation, decoding its data into out.
+func (p *GitHubProject) query(ctx context.Context, query string, vars map[string]any, out any) error {
+	var auth string
+	if p.Token != "" {
+		auth = "Bearer " + p.Token
+	}
+	return graphQL(ctx, p.Client, p.URL, auth, "GitHub", query, vars, out)
+}
+
+const githubProjectQuery = `query($owner: String!, $number: Int!, $field: String!) {
+  repositoryOwner(login: $owner) {
+    ... on ProjectV2Owner {
+      projectV2(number: $number) {
+        id
+        field(name: $field) {
+          ... on ProjectV2SingleSelectField { id options { id name } }
+        }
+      }
+    }
+  }
+}`
+
+// resolve looks up the project and its column field, once.
+func (p *GitHubProject) resolve(ctx context.Context) error {
+	if p.projectID != "" {
+		return nil
+	}
+	var data struct {
+		RepositoryOwner *struct {
+			ProjectV2 *struct {
+				ID    string `json:"id"`
+				Field *struct {
+					ID      string `json:"id"`
+					Options []struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+					} `json:"options"`
+				} `json:"field"`
+			} `json:"projectV2"`
+		} `json:"repositoryOwner"`
+	}
+	vars := map[string]any{"owner": p.Owner, "number": p.Number, "field": p.StatusField}
+	if err := p.query(ctx, githubProjectQuery, vars, &data); err != nil {
+		return err
+	}
+	if data.RepositoryOwner == nil || data.RepositoryOwner.ProjectV2 == nil {
+		return fmt.Errorf("project %d of %s not found", p.Number, p.Owner)
+	}
+	project := data.RepositoryOwner.ProjectV2
+	if project.Field == nil || project.Field.ID == "" {
+		return fmt.Errorf("project %d of %s has no single select field %q", p.Number, p.Owner, p.StatusField)
+	}
+	p.projectID = project.ID
+	p.fieldID = project.Field.ID
+	p.options = make(map[string]string, len(project.Field.Options))
+	for _, o := range project.Field.Options {
+		p.options[o.Name] = o.ID
+	}
+	return nil
+}
+
+const githubItemsQuery = `query($project: ID!, $cursor: String, $field: String!) {
+  node(id: $project) {
+    ... on ProjectV2 {
+      items(first: 100, after: $cursor) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          id
+          isArchived
+          updatedAt
+          fieldValueByName(name: $field) {
+            ... on ProjectV2ItemFieldSingleSelectValue { name }
+          }
+          content {
+            __typename
+            ... on DraftIssue { id title body updatedAt }
+            ... on Issue { id title body updatedAt }
+            ... on PullRequest { id title body updatedAt }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// Items implements beancore.RemoteTracker. Archived cards are left out.
+func (p *GitHubProject) Items(ctx context.Context) ([]beancore.RemoteItem, error) {
+	if err := p.resolve(ctx); err != nil {
+		return nil, err
+	}
+
+	p.cards = make(map[string]githubCard)
+	var items []beancore.RemoteItem
+	var cursor *string
+	for {
+		var data struct {
+			Node struct {
+				Items struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []struct {
+						ID         string    `json:"id"`
+						IsArchived bool      `json:"isArchived"`
+						UpdatedAt  time.Time `json:"updatedAt"`
+						Field      *struct {
+							Name string `json:"name"`
+						} `json:"fieldValueByName"`
+						Content *struct {
+							Type      string    `json:"__typename"`
+							ID        string    `json:"id"`
+							Title     string    `json:"title"`
+							Body      string    `json:"body"`
+							UpdatedAt time.Time `json:"updatedAt"`
+						} `json:"content"`
+					} `json:"nodes"`
+				} `json:"items"`
+			} `json:"node"`
+		}
+		vars := map[string]any{"project": p.projectID, "cursor": cursor, "field": p.StatusField}
+		if err := p.query(ctx, githubItemsQuery, vars, &data); err != nil {
+			return nil, err
+		}
+
+		for _, n := range data.Node.Items.Nodes {
+			// Archived cards, and cards whose content we may not see, aren't synced
+			if n.IsArchived || n.Content == nil || n.Content.ID == "" {
+				continue
+			}
+			card := githubCard{
+				contentID:   n.Content.ID,
+				contentType: n.Content.Type,
+				title:       n.Content.Title,
+				body:        n.Content.Body,
+			}
+			if n.Field != nil {
+				card.column = n.Field.Name
+			}
+			card.updatedAt = n.UpdatedAt
+			if n.Content.UpdatedAt.After(card.updatedAt) {
+				card.updatedAt = n.Content.UpdatedAt
+			}
+			p.cards[n.ID] = card
+			items = append(items, card.item(n.ID, p))
+		}
+
+		page := data.Node.Items.PageInfo
+		if !page.HasNextPage {
+			return items, nil
+		}
+		cursor = &page.EndCursor
+	}
+}
+
+// statusesFor returns the statuses that map to a column, in status order.
+func (p *GitHubProject) statusesFor(column string) []string {
+	if column == "" {
+		return nil
+	}
+	var statuses []string
+	for _, s := range p.Statuses {
+		if p.Columns[s] == column {
+			statuses = append(statuses, s)
+		}
+	}
+	return statuses
+}
+
+const githubAddDraftMutation = `mutation($project: ID!, $title: String!, $body: String) {
+  addProjectV2DraftIssue(input: {projectId: $project, title: $title, body: $body}) {
+    projectItem { id updatedAt content { ... on DraftIssue { id } } }
+  }
+}`
+
+// CreateItem implements beancore.RemoteTracker, adding a draft issue to the
+// project in the bean's column.
+func (p *GitHubProject) CreateItem(ctx context.Context, b *bean.Bean) (*beancore.RemoteItem, error) {
+	if err := p.resolve(ctx); err != nil {
+		return nil, err
+	}
+	var data struct {
+		Add struct {
+			Item struct {
+				ID        string    `json:"id"`
+				UpdatedAt time.Time `json:"updatedAt"`
+				Content   struct {
+					ID string `json:"id"`
+				} `json:"content"`
+			} `json:"projectItem"`
+		} `json:"addProjectV2DraftIssue"`
+	}
+	vars := map[string]any{"project": p.projectID, "title": b.Title, "body": b.Body}
+	if err := p.query(ctx, githubAddDraftMutation, vars, &data); err != nil {
+		return nil, err
+	}
+
+	item := data.Add.Item
+	card := githubCard{contentID: item.Content.ID, contentType: "DraftIssue", title: b.Title, body: b.Body, updatedAt: item.UpdatedAt}
+	if p.cards == nil {
+		p.cards = make(map[string]githubCard)
+	}
+	p.cards[item.ID] = card
+	return p.updateColumn(ctx, item.ID, b)
+}
+
+// UpdateItem implements beancore.RemoteTracker, updating the card's title,
+// body and column where they differ from the bean's.
+func (p *GitHubProject) UpdateItem(ctx context.Context, id string, b *bean.Bean) (*beancore.RemoteItem, error) {
+	if err := p.resolve(ctx); err != nil {
+		return nil, err
+	}
+	card, ok := p.cards[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown project item %s", id)
+	}
+
+	if card.title != b.Title || strings.TrimSpace(card.body) != strings.TrimSpace(b.Body) {
+		mutation, ok := githubContentMutations[card.contentType]
+		if !ok {
+			return nil, fmt.Errorf("cannot update %s cards", card.contentType)
+		}
+		var data map[string]struct {
+			Content struct {
+				UpdatedAt time.Time `json:"updatedAt"`
+			} `json:"content"`
+		}
+		vars := map[string]any{"id": card.contentID, "title": b.Title, "body": b.Body}
+		if err := p.query(ctx, mutation, vars, &data); err != nil {
+			return nil, err
+		}
+		card.title, card.body = b.Title, b.Body
+		if result := data["result"]; result.Content.UpdatedAt.After(card.updatedAt) {
+			card.updatedAt = result.Content.UpdatedAt
+		}
+		p.cards[id] = card
+	}
+	return p.updateColumn(ctx, id, b)
+}
+
+// githubContentMutations update the title and body of a card's content, by
+// content type. Each aliases its result's content as "content".
+var githubContentMutations = map[string]string{
+	"DraftIssue": `mutation($id: ID!, $title: String!, $body: String) {
+  result: updateProjectV2DraftIssue(input: {draftIssueId: $id, title: $title, body: $body}) { content: draftIssue { updatedAt } }
+}`,
+	"Issue": `mutation($id: ID!, $title: String!, $body: String) {
+  result: updateIssue(input: {id: $id, title: $title, body: $body}) { content: issue { updatedAt } }
+}`,
+	"PullRequest": `mutation($id: ID!, $title: String!, $body: String) {
+  result: updatePullRequest(input: {pullRequestId: $id, title: $title, body: $body}) { content: pullRequest { updatedAt } }
+}`,
+}
+
+const githubSetColumnMutation = `mutation($project: ID!, $item: ID!, $field: ID!, $option: String!) {
+  updateProjectV2ItemFieldValue(input: {projectId: $project, itemId: $item, fieldId: $field, value: {singleSelectOptionId: $option}}) {
+    projectV2Item { updatedAt }
+  }
+}`
+
+// updateColumn moves the card to the bean's column if it isn't there yet,
+// and returns the card as it is now.
+func (p *GitHubProject) updateColumn(ctx context.Context, id string, b *bean.Bean) (*beancore.RemoteItem, error) {
+	card := p.cards[id]
+	column, mapped := p.Columns[b.Status]
+	if mapped && column != card.column {
+		option, ok := p.options[column]
+		if !ok {
+			return nil, fmt.Errorf("project has no %q column for status %s", column, b.Status)
+		}
+		var data struct {
+			Update struct {
+				Item struct {
+					UpdatedAt time.Time `json:"updatedAt"`
+				} `json:"projectV2Item"`
+			} `json:"updateProjectV2ItemFieldValue"`
+		}
+		vars := map[string]any{"project": p.projectID, "item": id, "field": p.fieldID, "option": option}
+		if err := p.query(ctx, githubSetColumnMutation, vars, &data); err != nil {
+			return nil, err
+		}
+		if data.Update.Item.UpdatedAt.After(card.updatedAt) {
+			card.updatedAt = data.Update.Item.UpdatedAt
+		}
+		card.column = column
+		p.cards[id] = card
+	}
+
+	item := card.item(id, p)
+	return &item, nil
+}
+
+// item returns the card as a beancore.RemoteItem.
+func (c githubCard) item(id string, p *GitHubProject) beancore.RemoteItem {
+	return beancore.RemoteItem{
+		ID:        id,
+		Title:     c.title,
+		Body:      c.body,
+		Statuses:  p.statusesFor(c.column),
+		UpdatedAt: c.updatedAt,
+	}
+}
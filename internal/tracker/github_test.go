@@ -0,0 +1,142 @@
+package tracker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+)
+
+// fakeGitHub answers the GraphQL requests GitHubProject makes, recording
+// the mutations it receives.
+func fakeGitHub(t *testing.T, mutations *[]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		var data string
+		switch {
+		case strings.Contains(req.Query, "repositoryOwner"):
+			data = `{"repositoryOwner": {"projectV2": {"id": "P1", "field": {"id": "F1", "options": [
+				{"id": "O1", "name": "Todo"}, {"id": "O2", "name": "In Progress"}, {"id": "O3", "name": "Done"}]}}}}`
+		case strings.Contains(req.Query, "items(first"):
+			data = `{"node": {"items": {"pageInfo": {"hasNextPage": false}, "nodes": [
+				{"id": "I1", "updatedAt": "2025-01-01T00:00:00Z", "fieldValueByName": {"name": "Todo"},
+				 "content": {"__typename": "DraftIssue", "id": "D1", "title": "Draft card", "body": "Notes", "updatedAt": "2025-01-02T00:00:00Z"}},
+				{"id": "I2", "updatedAt": "2025-01-01T00:00:00Z", "fieldValueByName": {"name": "Done"},
+				 "content": {"__typename": "Issue", "id": "X2", "title": "Issue card", "body": "", "updatedAt": "2024-12-01T00:00:00Z"}},
+				{"id": "I3", "isArchived": true, "updatedAt": "2025-01-01T00:00:00Z",
+				 "content": {"__typename": "DraftIssue", "id": "D3", "title": "Old", "updatedAt": "2025-01-01T00:00:00Z"}},
+				{"id": "I4", "updatedAt": "2025-01-01T00:00:00Z", "content": {}}]}}}`
+		case strings.Contains(req.Query, "addProjectV2DraftIssue"):
+			*mutations = append(*mutations, "add "+req.Variables["title"].(string))
+			data = `{"addProjectV2DraftIssue": {"projectItem": {"id": "I9", "updatedAt": "2025-02-01T00:00:00Z", "content": {"id": "D9"}}}}`
+		case strings.Contains(req.Query, "updateProjectV2ItemFieldValue"):
+			*mutations = append(*mutations, "move "+req.Variables["item"].(string)+" "+req.Variables["option"].(string))
+			data = `{"updateProjectV2ItemFieldValue": {"projectV2Item": {"updatedAt": "2025-02-02T00:00:00Z"}}}`
+		case strings.Contains(req.Query, "updateIssue"):
+			*mutations = append(*mutations, "edit issue "+req.Variables["id"].(string))
+			data = `{"result": {"content": {"updatedAt": "2025-02-03T00:00:00Z"}}}`
+		default:
+			w.Write([]byte(`{"errors": [{"message": "unexpected query"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data": ` + data + `}`))
+	}))
+}
+
+func newTestProject(url string) *GitHubProject {
+	p := NewGitHubProject("secret", "octo", 1)
+	p.URL = url
+	p.Columns = map[string]string{"draft": "Todo", "todo": "Todo", "in-progress": "In Progress", "completed": "Done"}
+	p.Statuses = []string{"in-progress", "todo", "draft", "completed", "scrapped"}
+	return p
+}
+
+func TestGitHubProjectItems(t *testing.T) {
+	var mutations []string
+	server := fakeGitHub(t, &mutations)
+	defer server.Close()
+	p := newTestProject(server.URL)
+
+	items, err := p.Items(context.Background())
+	if err != nil {
+		t.Fatalf("Items() error = %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Items() = %+v, want the 2 unarchived, visible cards", items)
+	}
+	if items[0].Title != "Draft card" || items[0].Body != "Notes" || !slices.Equal(items[0].Statuses, []string{"todo", "draft"}) {
+		t.Errorf("items[0] = %+v", items[0])
+	}
+	// The newer of the card's and its content's update times
+	if got := items[0].UpdatedAt.Format("2006-01-02"); got != "2025-01-02" {
+		t.Errorf("items[0].UpdatedAt = %s", got)
+	}
+	if !slices.Equal(items[1].Statuses, []string{"completed"}) {
+		t.Errorf("items[1].Statuses = %v", items[1].Statuses)
+	}
+	if p.MappingName() != "github-project-octo-1" {
+		t.Errorf("MappingName() = %q", p.MappingName())
+	}
+}
+
+func TestGitHubProjectCreateAndUpdate(t *testing.T) {
+	var mutations []string
+	server := fakeGitHub(t, &mutations)
+	defer server.Close()
+	p := newTestProject(server.URL)
+	ctx := context.Background()
+	if _, err := p.Items(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := p.CreateItem(ctx, &bean.Bean{Title: "New bean", Status: "in-progress"})
+	if err != nil {
+		t.Fatalf("CreateItem() error = %v", err)
+	}
+	if item.ID != "I9" || !slices.Equal(item.Statuses, []string{"in-progress"}) {
+		t.Errorf("CreateItem() = %+v", item)
+	}
+
+	// Only what differs is updated: the issue's title, not its column
+	if _, err := p.UpdateItem(ctx, "I2", &bean.Bean{Title: "Renamed", Status: "completed"}); err != nil {
+		t.Fatalf("UpdateItem() error = %v", err)
+	}
+	// ... and statuses without a column leave it alone
+	if _, err := p.UpdateItem(ctx, "I1", &bean.Bean{Title: "Draft card", Body: "Notes\n", Status: "scrapped"}); err != nil {
+		t.Fatalf("UpdateItem() error = %v", err)
+	}
+
+	want := []string{"add New bean", "move I9 O2", "edit issue X2"}
+	if !slices.Equal(mutations, want) {
+		t.Errorf("mutations = %q, want %q", mutations, want)
+	}
+}
+
+func TestGitHubProjectErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"repositoryOwner": null}, "errors": [{"message": "Could not resolve to a ProjectOwner"}]}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestProject(server.URL).Items(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "Could not resolve") {
+		t.Errorf("Items() error = %v, want the API's error", err)
+	}
+}
@@ -0,0 +1,372 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+)
+
+// linearCyclePrefix prefixes the number of a Linear cycle in the sprint it
+// stands for ("cycle-12").
+const linearCyclePrefix = "cycle-"
+
+// Linear syncs beans with the issues of a Linear team via the Linear GraphQL
+// API. Workflow states map to statuses, priorities to priorities and cycles
+// to sprints (see beancore.SprintTracker).
+type Linear struct {
+	// URL is the GraphQL endpoint (default "https://api.linear.app/graphql").
+	URL string
+	// Token is a Linear API key.
+	Token string
+	// Client is the HTTP client used for requests (default: 30s timeout).
+	Client *http.Client
+
+	// Team is the key of the team whose issues are synced.
+	Team string
+	// States maps bean statuses to the names or types of workflow states.
+	States map[string]string
+	// Priorities maps bean priorities to Linear's (0 to 4).
+	Priorities map[string]int
+	// Statuses and PriorityNames list all statuses and priorities in order,
+	// to map states and priorities several share back to the first of them.
+	Statuses      []string
+	PriorityNames []string
+
+	teamID string
+	states []linearState // by position
+	cycles map[int]string
+	issues map[string]linearIssue
+}
+
+// linearState is one of the team's workflow states.
+type linearState struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Position float64 `json:"position"`
+}
+
+// linearIssue is an issue as the API returns it.
+type linearIssue struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Priority    int       `json:"priority"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	State       struct {
+		ID string `json:"id"`
+	} `json:"state"`
+	Cycle *struct {
+		Number int `json:"number"`
+	} `json:"cycle"`
+}
+
+// linearIssueFields are the fields of linearIssue.
+const linearIssueFields = `id title description priority updatedAt state { id } cycle { number }`
+
+// NewLinear returns a Linear for linear.app.
+func NewLinear(token, team string) *Linear {
+	return &Linear{
+		URL:    "https://api.linear.app/graphql",
+		Token:  token,
+		Client: &http.Client{Timeout: 30 * time.Second},
+		Team:   team,
+	}
+}
+
+// MappingName returns the name of the team's sync mapping.
+func (l *Linear) MappingName() string {
+	return "linear-" + strings.ToLower(l.Team)
+}
+
+// HasSprints implements beancore.SprintTracker: cycles are sprints.
+func (l *Linear) HasSprints() bool {
+	return true
+}
+
+// query runs a GraphQL query or mutation, decoding its data into out.
+func (l *Linear) query(ctx context.Context, query string, vars map[string]any, out any) error {
+	return graphQL(ctx, l.Client, l.URL, l.Token, "Linear", query, vars, out)
+}
+
+const linearTeamQuery = `query($key: String!) {
+  teams(filter: {key: {eq: $key}}) {
+    nodes {
+      id
+      states(first: 100) { nodes { id name type position } }
+      cycles(first: 250) { nodes { id number } }
+    }
+  }
+}`
+
+// resolve looks up the team, its workflow states and cycles, once.
+func (l *Linear) resolve(ctx context.Context) error {
+	if l.teamID != "" {
+		return nil
+	}
+	var data struct {
+		Teams struct {
+			Nodes []struct {
+				ID     string `json:"id"`
+				States struct {
+					Nodes []linearState `json:"nodes"`
+				} `json:"states"`
+				Cycles struct {
+					Nodes []struct {
+						ID     string `json:"id"`
+						Number int    `json:"number"`
+					} `json:"nodes"`
+				} `json:"cycles"`
+			} `json:"nodes"`
+		} `json:"teams"`
+	}
+	if err := l.query(ctx, linearTeamQuery, map[string]any{"key": l.Team}, &data); err != nil {
+		return err
+	}
+	if len(data.Teams.Nodes) == 0 {
+		return fmt.Errorf("team %s not found", l.Team)
+	}
+	team := data.Teams.Nodes[0]
+	l.teamID = team.ID
+	l.states = team.States.Nodes
+	sort.SliceStable(l.states, func(i, j int) bool { return l.states[i].Position < l.states[j].Position })
+	l.cycles = make(map[int]string, len(team.Cycles.Nodes))
+	for _, c := range team.Cycles.Nodes {
+		l.cycles[c.Number] = c.ID
+	}
+	return nil
+}
+
+const linearIssuesQuery = `query($team: String!, $cursor: String) {
+  team(id: $team) {
+    issues(first: 100, after: $cursor) {
+      pageInfo { hasNextPage endCursor }
+      nodes { ` + linearIssueFields + ` }
+    }
+  }
+}`
+
+// Items implements beancore.RemoteTracker. Archived issues are left out.
+func (l *Linear) Items(ctx context.Context) ([]beancore.RemoteItem, error) {
+	if err := l.resolve(ctx); err != nil {
+		return nil, err
+	}
+
+	l.issues = make(map[string]linearIssue)
+	var items []beancore.RemoteItem
+	var cursor *string
+	for {
+		var data struct {
+			Team struct {
+				Issues struct {
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+					Nodes []linearIssue `json:"nodes"`
+				} `json:"issues"`
+			} `json:"team"`
+		}
+		vars := map[string]any{"team": l.teamID, "cursor": cursor}
+		if err := l.query(ctx, linearIssuesQuery, vars, &data); err != nil {
+			return nil, err
+		}
+
+		for _, issue := range data.Team.Issues.Nodes {
+			l.issues[issue.ID] = issue
+			items = append(items, l.item(issue))
+		}
+
+		page := data.Team.Issues.PageInfo
+		if !page.HasNextPage {
+			return items, nil
+		}
+		cursor = &page.EndCursor
+	}
+}
+
+const linearCreateMutation = `mutation($input: IssueCreateInput!) {
+  issueCreate(input: $input) { issue { ` + linearIssueFields + ` } }
+}`
+
+// CreateItem implements beancore.RemoteTracker, adding an issue to the team.
+func (l *Linear) CreateItem(ctx context.Context, b *bean.Bean) (*beancore.RemoteItem, error) {
+	if err := l.resolve(ctx); err != nil {
+		return nil, err
+	}
+	input, err := l.changes(linearIssue{}, b)
+	if err != nil {
+		return nil, err
+	}
+	input["teamId"] = l.teamID
+	input["title"] = b.Title
+
+	var data struct {
+		Create struct {
+			Issue linearIssue `json:"issue"`
+		} `json:"issueCreate"`
+	}
+	if err := l.query(ctx, linearCreateMutation, map[string]any{"input": input}, &data); err != nil {
+		return nil, err
+	}
+	return l.remember(data.Create.Issue), nil
+}
+
+const linearUpdateMutation = `mutation($id: String!, $input: IssueUpdateInput!) {
+  issueUpdate(id: $id, input: $input) { issue { ` + linearIssueFields + ` } }
+}`
+
+// UpdateItem implements beancore.RemoteTracker, updating the issue's title,
+// description, state, priority and cycle where they differ from the bean's.
+func (l *Linear) UpdateItem(ctx context.Context, id string, b *bean.Bean) (*beancore.RemoteItem, error) {
+	if err := l.resolve(ctx); err != nil {
+		return nil, err
+	}
+	issue, ok := l.issues[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown issue %s", id)
+	}
+	input, err := l.changes(issue, b)
+	if err != nil {
+		return nil, err
+	}
+	if len(input) == 0 {
+		item := l.item(issue)
+		return &item, nil
+	}
+
+	var data struct {
+		Update struct {
+			Issue linearIssue `json:"issue"`
+		} `json:"issueUpdate"`
+	}
+	if err := l.query(ctx, linearUpdateMutation, map[string]any{"id": id, "input": input}, &data); err != nil {
+		return nil, err
+	}
+	return l.remember(data.Update.Issue), nil
+}
+
+// changes returns the issue input fields that need to change for the issue
+// to match the bean.
+func (l *Linear) changes(issue linearIssue, b *bean.Bean) (map[string]any, error) {
+	input := map[string]any{}
+	if issue.Title != b.Title {
+		input["title"] = b.Title
+	}
+	if strings.TrimSpace(issue.Description) != strings.TrimSpace(b.Body) {
+		input["description"] = b.Body
+	}
+	if state, ok := l.stateFor(b.Status); ok && !slices.Contains(l.statusesFor(issue.State.ID), b.Status) {
+		input["stateId"] = state
+	}
+	if priority, ok := l.Priorities[b.Priority]; ok && !slices.Contains(l.prioritiesFor(issue.Priority), b.Priority) {
+		input["priority"] = priority
+	}
+
+	var current int
+	if issue.Cycle != nil {
+		current = issue.Cycle.Number
+	}
+	wanted := 0
+	if sprint := beancore.BeanSprint(b); sprint != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(sprint, linearCyclePrefix))
+		if err != nil || !strings.HasPrefix(sprint, linearCyclePrefix) || l.cycles[n] == "" {
+			return nil, fmt.Errorf("%s: sprint %s isn't a cycle of team %s", b.ID, sprint, l.Team)
+		}
+		wanted = n
+	}
+	if wanted != current {
+		if wanted == 0 {
+			input["cycleId"] = nil
+		} else {
+			input["cycleId"] = l.cycles[wanted]
+		}
+	}
+	return input, nil
+}
+
+// remember caches an issue returned by a mutation and returns it as an item.
+func (l *Linear) remember(issue linearIssue) *beancore.RemoteItem {
+	if l.issues == nil {
+		l.issues = make(map[string]linearIssue)
+	}
+	l.issues[issue.ID] = issue
+	item := l.item(issue)
+	return &item
+}
+
+// stateFor returns the ID of the workflow state a status maps to: the state
+// of that name, or else the first state of that type.
+func (l *Linear) stateFor(status string) (string, bool) {
+	target, ok := l.States[status]
+	if !ok {
+		return "", false
+	}
+	for _, s := range l.states {
+		if s.Name == target {
+			return s.ID, true
+		}
+	}
+	for _, s := range l.states {
+		if s.Type == target {
+			return s.ID, true
+		}
+	}
+	return "", false
+}
+
+// statusesFor returns the statuses that map to a workflow state, by name or
+// type, in status order.
+func (l *Linear) statusesFor(stateID string) []string {
+	i := slices.IndexFunc(l.states, func(s linearState) bool { return s.ID == stateID })
+	if i < 0 {
+		return nil
+	}
+	state := l.states[i]
+	var statuses []string
+	for _, s := range l.Statuses {
+		if target, ok := l.States[s]; ok && (target == state.Name || target == state.Type) {
+			statuses = append(statuses, s)
+		}
+	}
+	return statuses
+}
+
+// prioritiesFor returns the priorities that map to a Linear priority, in
+// priority order. No priority maps to normal unless one maps to it.
+func (l *Linear) prioritiesFor(priority int) []string {
+	var priorities []string
+	for _, p := range l.PriorityNames {
+		if n, ok := l.Priorities[p]; ok && n == priority {
+			priorities = append(priorities, p)
+		}
+	}
+	if len(priorities) == 0 && priority == 0 {
+		return []string{"normal"}
+	}
+	return priorities
+}
+
+// item returns the issue as a beancore.RemoteItem.
+func (l *Linear) item(issue linearIssue) beancore.RemoteItem {
+	item := beancore.RemoteItem{
+		ID:         issue.ID,
+		Title:      issue.Title,
+		Body:       issue.Description,
+		Statuses:   l.statusesFor(issue.State.ID),
+		Priorities: l.prioritiesFor(issue.Priority),
+		UpdatedAt:  issue.UpdatedAt,
+	}
+	if issue.Cycle != nil {
+		item.Sprint = linearCyclePrefix + strconv.Itoa(issue.Cycle.Number)
+	}
+	return item
+}
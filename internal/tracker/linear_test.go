@@ -0,0 +1,145 @@
+package tracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+// fakeLinear answers the GraphQL requests Linear makes, recording the inputs
+// of the mutations it receives.
+func fakeLinear(t *testing.T, inputs *[]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "lin_secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		var data string
+		switch {
+		case strings.Contains(req.Query, "teams("):
+			data = `{"teams": {"nodes": [{"id": "T1",
+				"states": {"nodes": [
+					{"id": "S3", "name": "In Progress", "type": "started", "position": 3},
+					{"id": "S1", "name": "Backlog", "type": "backlog", "position": 1},
+					{"id": "S2", "name": "Todo", "type": "unstarted", "position": 2},
+					{"id": "S4", "name": "In Review", "type": "started", "position": 4},
+					{"id": "S5", "name": "Done", "type": "completed", "position": 5}]},
+				"cycles": {"nodes": [{"id": "C7", "number": 7}, {"id": "C8", "number": 8}]}}]}}`
+		case strings.Contains(req.Query, "issues("):
+			data = `{"team": {"issues": {"pageInfo": {"hasNextPage": false}, "nodes": [
+				{"id": "I1", "title": "Fix login", "description": "Steps", "priority": 1, "updatedAt": "2025-01-02T00:00:00Z",
+				 "state": {"id": "S4"}, "cycle": {"number": 7}},
+				{"id": "I2", "title": "Idea", "description": null, "priority": 0, "updatedAt": "2025-01-01T00:00:00Z",
+				 "state": {"id": "S1"}, "cycle": null}]}}}`
+		case strings.Contains(req.Query, "issueCreate"), strings.Contains(req.Query, "issueUpdate"):
+			input, _ := json.Marshal(req.Variables["input"])
+			*inputs = append(*inputs, string(input))
+			mutation := "issueUpdate"
+			if strings.Contains(req.Query, "issueCreate") {
+				mutation = "issueCreate"
+			}
+			data = fmt.Sprintf(`{%q: {"issue": {"id": "I9", "title": "New", "priority": 2, "updatedAt": "2025-02-01T00:00:00Z",
+				"state": {"id": "S3"}, "cycle": {"number": 8}}}}`, mutation)
+		default:
+			w.Write([]byte(`{"errors": [{"message": "unexpected query"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data": ` + data + `}`))
+	}))
+}
+
+func newTestLinear(url string) *Linear {
+	l := NewLinear("lin_secret", "ENG")
+	l.URL = url
+	l.States = config.DefaultLinearStates
+	l.Priorities = config.DefaultLinearPriorities
+	l.Statuses = []string{"in-progress", "todo", "draft", "completed", "scrapped"}
+	l.PriorityNames = []string{"critical", "high", "normal", "low", "deferred"}
+	return l
+}
+
+func TestLinearItems(t *testing.T) {
+	var inputs []string
+	server := fakeLinear(t, &inputs)
+	defer server.Close()
+	l := newTestLinear(server.URL)
+
+	items, err := l.Items(context.Background())
+	if err != nil {
+		t.Fatalf("Items() error = %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Items() = %+v", items)
+	}
+	first := items[0]
+	if first.Title != "Fix login" || first.Body != "Steps" || first.Sprint != "cycle-7" ||
+		!slices.Equal(first.Statuses, []string{"in-progress"}) || !slices.Equal(first.Priorities, []string{"critical"}) {
+		t.Errorf("items[0] = %+v", first)
+	}
+	second := items[1]
+	if second.Body != "" || second.Sprint != "" ||
+		!slices.Equal(second.Statuses, []string{"draft"}) || !slices.Equal(second.Priorities, []string{"normal"}) {
+		t.Errorf("items[1] = %+v", second)
+	}
+	if l.MappingName() != "linear-eng" {
+		t.Errorf("MappingName() = %q", l.MappingName())
+	}
+}
+
+func TestLinearCreateAndUpdate(t *testing.T) {
+	var inputs []string
+	server := fakeLinear(t, &inputs)
+	defer server.Close()
+	l := newTestLinear(server.URL)
+	ctx := context.Background()
+	if _, err := l.Items(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := l.CreateItem(ctx, &bean.Bean{Title: "New", Status: "in-progress", Priority: "high", Tags: []string{"sprint/cycle-8"}})
+	if err != nil {
+		t.Fatalf("CreateItem() error = %v", err)
+	}
+	if item.ID != "I9" || item.Sprint != "cycle-8" || !slices.Equal(item.Priorities, []string{"high"}) {
+		t.Errorf("CreateItem() = %+v", item)
+	}
+
+	// Only what differs is updated: "In Review" already is in-progress
+	if _, err := l.UpdateItem(ctx, "I1", &bean.Bean{Title: "Fix login", Body: "Steps\n", Status: "in-progress", Priority: "low"}); err != nil {
+		t.Fatalf("UpdateItem() error = %v", err)
+	}
+	// Nothing differs, nothing is sent
+	if _, err := l.UpdateItem(ctx, "I2", &bean.Bean{Title: "Idea", Status: "draft", Priority: "normal"}); err != nil {
+		t.Fatalf("UpdateItem() error = %v", err)
+	}
+
+	want := []string{
+		`{"cycleId":"C8","priority":2,"stateId":"S3","teamId":"T1","title":"New"}`,
+		`{"cycleId":null,"priority":4}`,
+	}
+	if !slices.Equal(inputs, want) {
+		t.Errorf("inputs = %q, want %q", inputs, want)
+	}
+
+	_, err = l.UpdateItem(ctx, "I2", &bean.Bean{Title: "Idea", Status: "draft", Tags: []string{"sprint/q3"}})
+	if err == nil || !strings.Contains(err.Error(), "isn't a cycle") {
+		t.Errorf("UpdateItem() with an unknown sprint: error = %v", err)
+	}
+}
@@ -61,7 +61,7 @@ func (d blockingItemDelegate) Render(w io.Writer, m list.Model, index int, listI
 
 	var cursor string
 	if index == m.Index() {
-		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
 	} else {
 		cursor = "  "
 	}
@@ -103,7 +103,7 @@ type blockingPickerModel struct {
 
 func newBlockingPickerModel(beanID, beanTitle string, currentBlocking []string, resolver *graph.Resolver, cfg *config.Config, width, height int) blockingPickerModel {
 	// Fetch all beans
-	allBeans, _ := resolver.Query().Beans(context.Background(), nil)
+	allBeans, _ := resolver.Query().Beans(context.Background(), nil, nil)
 
 	// Create maps for original and pending state
 	originalBlocking := make(map[string]bool)
@@ -155,6 +155,7 @@ func newBlockingPickerModel(beanID, beanTitle string, currentBlocking []string,
 	delegate := blockingItemDelegate{cfg: cfg, pendingBlocking: &pendingBlocking}
 
 	l := list.New(items, delegate, listWidth, listHeight)
+	plainPagination(&l)
 	l.Title = "Manage Blocking"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
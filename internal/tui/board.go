@@ -0,0 +1,547 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// boardMinColumnWidth is the narrowest a status column is drawn
+const boardMinColumnWidth = 14
+
+// boardGrouping selects how the board is split into swimlanes
+type boardGrouping int
+
+const (
+	groupNone boardGrouping = iota
+	groupEpic
+	groupType
+	groupAssignee
+)
+
+func (g boardGrouping) String() string {
+	switch g {
+	case groupEpic:
+		return "epic"
+	case groupType:
+		return "type"
+	case groupAssignee:
+		return "assignee"
+	default:
+		return "none"
+	}
+}
+
+// parseGrouping returns the grouping named s (see String), or groupNone
+func parseGrouping(s string) boardGrouping {
+	switch s {
+	case "epic":
+		return groupEpic
+	case "type":
+		return groupType
+	case "assignee":
+		return groupAssignee
+	default:
+		return groupNone
+	}
+}
+
+// next returns the grouping after g, wrapping around
+func (g boardGrouping) next() boardGrouping {
+	return (g + 1) % 4
+}
+
+// boardLane is a swimlane: one cell of beans per status column
+type boardLane struct {
+	title string
+	cells [][]*bean.Bean
+}
+
+// openBoardMsg requests switching to the board view
+type openBoardMsg struct{}
+
+// closeBoardMsg requests switching back to the list view
+type closeBoardMsg struct{}
+
+// boardLoadedMsg is sent when the board's beans are loaded
+type boardLoadedMsg struct {
+	beans   []*bean.Bean
+	focusID string
+	owners  map[string]string // only loaded when grouping by assignee
+}
+
+// boardModel shows beans as cards in status columns, optionally split into
+// swimlanes per epic, type or assignee
+type boardModel struct {
+	resolver *graph.Resolver
+	config   *config.Config
+	width    int
+	height   int
+	err      error
+
+	grouping boardGrouping
+	columns  []string
+	beans    []*bean.Bean
+	lanes    []boardLane
+	focusID  string            // ID of the bean in focus, marked on its card
+	owners   map[string]string // bean ID to its assignee (its code's main owner)
+
+	// Cursor: a column, and a position among that column's cards in all lanes
+	col int
+	row int
+
+	// Bean to move the cursor to once beans are loaded (e.g. restored state)
+	pendingSelect string
+
+	// Status message to display in footer
+	statusMessage string
+}
+
+func newBoardModel(resolver *graph.Resolver, cfg *config.Config) boardModel {
+	return boardModel{resolver: resolver, config: cfg, columns: boardColumns(cfg)}
+}
+
+func (m boardModel) Init() tea.Cmd {
+	return m.loadBeans
+}
+
+func (m boardModel) loadBeans() tea.Msg {
+	beans, err := m.resolver.Query().Beans(context.Background(), nil, nil)
+	if err != nil {
+		return errMsg{err}
+	}
+	msg := boardLoadedMsg{beans: beans}
+	if state, err := m.resolver.Core.LoadState(); err == nil {
+		msg.focusID = state.Focus
+	}
+	if m.grouping == groupAssignee {
+		msg.owners = m.loadOwners(beans)
+	}
+	return msg
+}
+
+// loadOwners returns the assignee of each bean: the first owner suggested by
+// CODEOWNERS for the code it touches. Beans without one are left out.
+func (m boardModel) loadOwners(beans []*bean.Bean) map[string]string {
+	owners := make(map[string]string)
+	if _, err := m.resolver.Core.CodeOwners(); err != nil {
+		return owners
+	}
+	for _, b := range beans {
+		o, err := m.resolver.Core.Ownership(b)
+		if err == nil && len(o.Owners) > 0 {
+			owners[b.ID] = o.Owners[0]
+		}
+	}
+	return owners
+}
+
+// boardColumns returns the statuses shown as board columns, in workflow order
+func boardColumns(cfg *config.Config) []string {
+	return cfg.WorkflowStatuses()
+}
+
+// buildBoard sorts beans into swimlanes and status columns. Lanes are ordered
+// like their epics or the configured types, or by assignee (from owners);
+// beans outside any lane's epic go into a trailing "No epic" lane, and beans
+// without an assignee into "Unassigned". Epics and milestones aren't shown as
+// cards when grouping by epic, as they form the lanes.
+func buildBoard(beans []*bean.Bean, columns []string, grouping boardGrouping, owners map[string]string, cfg *config.Config) []boardLane {
+	columnIndex := make(map[string]int, len(columns))
+	for i, s := range columns {
+		columnIndex[s] = i
+	}
+	byID := make(map[string]*bean.Bean, len(beans))
+	for _, b := range beans {
+		byID[b.ID] = b
+	}
+	sortFn := func(beans []*bean.Bean) {
+		bean.SortByStatusPriorityAndType(beans, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+	}
+
+	var keys []string
+	titles := make(map[string]string)
+	switch grouping {
+	case groupEpic:
+		var epics []*bean.Bean
+		for _, b := range beans {
+			if b.Type == "epic" {
+				epics = append(epics, b)
+			}
+		}
+		sortFn(epics)
+		for _, e := range epics {
+			keys = append(keys, e.ID)
+			titles[e.ID] = e.Title
+		}
+		keys = append(keys, "")
+		titles[""] = "No epic"
+	case groupType:
+		keys = cfg.TypeNames()
+		seen := make(map[string]bool)
+		for _, t := range keys {
+			seen[t] = true
+		}
+		var extra []string
+		for _, b := range beans {
+			if !seen[b.Type] {
+				seen[b.Type] = true
+				extra = append(extra, b.Type)
+			}
+		}
+		slices.Sort(extra)
+		keys = append(slices.Clone(keys), extra...)
+		for _, t := range keys {
+			titles[t] = t
+		}
+	case groupAssignee:
+		seen := make(map[string]bool)
+		for _, b := range beans {
+			if owner := owners[b.ID]; owner != "" && !seen[owner] {
+				seen[owner] = true
+				keys = append(keys, owner)
+			}
+		}
+		slices.Sort(keys)
+		for _, owner := range keys {
+			titles[owner] = owner
+		}
+		keys = append(keys, "")
+		titles[""] = "Unassigned"
+	default:
+		keys = []string{""}
+	}
+
+	cells := make(map[string][][]*bean.Bean, len(keys))
+	for _, k := range keys {
+		cells[k] = make([][]*bean.Bean, len(columns))
+	}
+	for _, b := range beans {
+		col, ok := columnIndex[b.Status]
+		if !ok {
+			continue
+		}
+		var key string
+		switch grouping {
+		case groupEpic:
+			if b.Type == "epic" || b.Type == "milestone" {
+				continue
+			}
+			key = epicOf(b, byID)
+		case groupType:
+			key = b.Type
+		case groupAssignee:
+			key = owners[b.ID]
+		}
+		cells[key][col] = append(cells[key][col], b)
+	}
+
+	var lanes []boardLane
+	for _, k := range keys {
+		empty := true
+		for _, cell := range cells[k] {
+			sortFn(cell)
+			empty = empty && len(cell) == 0
+		}
+		if empty && grouping != groupNone {
+			continue
+		}
+		lanes = append(lanes, boardLane{title: titles[k], cells: cells[k]})
+	}
+	return lanes
+}
+
+// epicOf returns the ID of the nearest epic above b, or "" if there is none
+func epicOf(b *bean.Bean, byID map[string]*bean.Bean) string {
+	seen := make(map[string]bool)
+	for p := byID[b.Parent]; p != nil && !seen[p.ID]; p = byID[p.Parent] {
+		if p.Type == "epic" {
+			return p.ID
+		}
+		seen[p.ID] = true
+	}
+	return ""
+}
+
+// columnCards returns the cards of a column across all lanes, top to bottom
+func (m boardModel) columnCards(col int) []*bean.Bean {
+	var cards []*bean.Bean
+	for _, lane := range m.lanes {
+		if col < len(lane.cells) {
+			cards = append(cards, lane.cells[col]...)
+		}
+	}
+	return cards
+}
+
+// selected returns the bean under the cursor, if any
+func (m boardModel) selected() *bean.Bean {
+	cards := m.columnCards(m.col)
+	if m.row < 0 || m.row >= len(cards) {
+		return nil
+	}
+	return cards[m.row]
+}
+
+// rebuild regroups the loaded beans, keeping the cursor on the same bean (or
+// moving it to pendingSelect) if it's on the board
+func (m *boardModel) rebuild() {
+	var target string
+	if current := m.selected(); current != nil {
+		target = current.ID
+	}
+	if m.pendingSelect != "" && len(m.beans) > 0 {
+		target, m.pendingSelect = m.pendingSelect, ""
+	}
+	m.lanes = buildBoard(m.beans, m.columns, m.grouping, m.owners, m.config)
+	if target != "" {
+		for col := range m.columns {
+			for row, b := range m.columnCards(col) {
+				if b.ID == target {
+					m.col, m.row = col, row
+					return
+				}
+			}
+		}
+	}
+	m.clampCursor()
+}
+
+// clampCursor keeps the cursor within the current column's cards
+func (m *boardModel) clampCursor() {
+	m.col = max(0, min(m.col, len(m.columns)-1))
+	m.row = max(0, min(m.row, len(m.columnCards(m.col))-1))
+}
+
+func (m boardModel) Update(msg tea.Msg) (boardModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case boardLoadedMsg:
+		m.beans = msg.beans
+		m.focusID = msg.focusID
+		if msg.owners != nil {
+			m.owners = msg.owners
+		}
+		m.rebuild()
+
+	case errMsg:
+		m.err = msg.err
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			m.row--
+			m.clampCursor()
+		case "down", "j":
+			m.row++
+			m.clampCursor()
+		case "left", "h":
+			m.col--
+			m.clampCursor()
+		case "right", "l":
+			m.col++
+			m.clampCursor()
+		case "G":
+			m.grouping = m.grouping.next()
+			m.rebuild()
+			// Assignees are only worked out once needed, as that runs git
+			if m.grouping == groupAssignee && m.owners == nil && m.resolver != nil {
+				return m, m.loadBeans
+			}
+		case "enter":
+			if b := m.selected(); b != nil {
+				return m, func() tea.Msg {
+					return selectBeanMsg{bean: b}
+				}
+			}
+		case "s":
+			if b := m.selected(); b != nil {
+				return m, func() tea.Msg {
+					return openStatusPickerMsg{beanIDs: []string{b.ID}, beanTitle: b.Title, currentStatus: b.Status}
+				}
+			}
+		case "S", "shift+right":
+			if b := m.selected(); b != nil {
+				return m, func() tea.Msg { return advanceStatusMsg{beanIDs: []string{b.ID}} }
+			}
+		case "t":
+			if b := m.selected(); b != nil {
+				return m, func() tea.Msg {
+					return openTagEditorMsg{beanID: b.ID, beanTitle: b.Title}
+				}
+			}
+		case "e":
+			if b := m.selected(); b != nil {
+				return m, func() tea.Msg {
+					return openEditorMsg{beanID: b.ID, beanPath: b.Path}
+				}
+			}
+		case "y":
+			if b := m.selected(); b != nil {
+				return m, func() tea.Msg {
+					return copyBeanIDMsg{ids: []string{b.ID}}
+				}
+			}
+		case "J", "K":
+			// Move the selected card down/up among its siblings in the column
+			if b := m.selected(); b != nil {
+				delta := 1
+				if msg.String() == "K" {
+					delta = -1
+				}
+				if reorder, ok := reorderSiblings(m.columnCards(m.col), b.ID, delta); ok {
+					return m, func() tea.Msg {
+						return reorder
+					}
+				}
+			}
+		case "v", "esc", "backspace":
+			return m, func() tea.Msg {
+				return closeBoardMsg{}
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m boardModel) View() string {
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n\nPress q to quit.", m.err)
+	}
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	colWidth := max(boardMinColumnWidth, (m.width-2)/max(1, len(m.columns)))
+	cell := lipgloss.NewStyle().Width(colWidth)
+	laneStyle := lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true)
+
+	title := listTitleStyle.Render("Board")
+	if m.grouping != groupNone {
+		title += helpStyle.Render(" [lanes: " + m.grouping.String() + "]")
+	}
+
+	var header strings.Builder
+	header.WriteString(" ")
+	for col, status := range m.columns {
+		label := fmt.Sprintf("%s (%d)", status, len(m.columnCards(col)))
+		colors := m.config.GetBeanColors(status, "", "")
+		header.WriteString(cell.Render(ui.RenderStatusTextWithColor(truncateText(label, colWidth-1), colors.StatusColor, colors.IsArchive)))
+	}
+
+	// Render every lane, remembering the line with the cursor for scrolling
+	var lines []string
+	cursorLine := 0
+	offsets := make([]int, len(m.columns))
+	for _, lane := range m.lanes {
+		if m.grouping != groupNone {
+			count := 0
+			for _, c := range lane.cells {
+				count += len(c)
+			}
+			lines = append(lines, " "+laneStyle.Render(truncateText(lane.title, m.width-10))+helpStyle.Render(fmt.Sprintf(" (%d)", count)))
+		}
+		height := 0
+		for _, c := range lane.cells {
+			height = max(height, len(c))
+		}
+		for i := range height {
+			var line strings.Builder
+			line.WriteString(" ")
+			for col, c := range lane.cells {
+				if i >= len(c) {
+					line.WriteString(cell.Render(""))
+					continue
+				}
+				selected := col == m.col && offsets[col]+i == m.row
+				if selected {
+					cursorLine = len(lines)
+				}
+				line.WriteString(cell.Render(m.renderCard(c[i], colWidth-1, selected)))
+			}
+			lines = append(lines, line.String())
+		}
+		for col, c := range lane.cells {
+			offsets[col] += len(c)
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, helpStyle.Render(" No beans"))
+	}
+
+	// Title, header and footer take 4 lines
+	visible := max(1, m.height-4)
+	start := 0
+	if cursorLine >= visible {
+		start = cursorLine - visible + 1
+	}
+	end := min(len(lines), start+visible)
+	body := strings.Join(lines[start:end], "\n")
+	if pad := visible - (end - start); pad > 0 {
+		body += strings.Repeat("\n", pad)
+	}
+
+	return " " + title + "\n" + header.String() + "\n" + body + "\n\n" + m.Footer()
+}
+
+// renderCard renders a bean as a single-line card of at most width characters
+func (m boardModel) renderCard(b *bean.Bean, width int, selected bool) string {
+	colors := m.config.GetBeanColors(b.Status, b.Type, b.Priority)
+	var marker string
+	if b.ID == m.focusID {
+		marker = lipgloss.NewStyle().Foreground(ui.ColorCyan).Bold(true).Render(ui.FocusGlyph.String()) + " "
+		width -= 2
+	}
+	text := marker + truncateText(b.Title, width-len(b.ID)-3)
+	if selected {
+		cursor := lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String())
+		return cursor + ui.ID.Render(b.ID) + " " + lipgloss.NewStyle().Bold(true).Foreground(ui.ColorPrimary).Render(text)
+	}
+	id := ui.ID.Render(b.ID)
+	if colors.TypeColor != "" {
+		id = lipgloss.NewStyle().Foreground(ui.ResolveColor(colors.TypeColor)).Render(b.ID)
+	}
+	return " " + id + " " + text
+}
+
+// truncateText shortens s to at most width columns, marking the cut with "…"
+// ("..." in accessible mode). Wide characters count as two columns.
+func truncateText(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if ellipsis := ui.EllipsisGlyph.String(); ansi.StringWidth(ellipsis) < width {
+		return ansi.Truncate(s, width, ellipsis)
+	}
+	return ansi.Truncate(s, width, "")
+}
+
+// Footer renders the help footer for the board view
+func (m boardModel) Footer() string {
+	if m.statusMessage != "" {
+		return lipgloss.NewStyle().Foreground(ui.ColorSuccess).Bold(true).Render(m.statusMessage)
+	}
+	return helpKeyStyle.Render(ui.LeftRightGlyph.String()) + " " + helpStyle.Render("column") + "  " +
+		helpKeyStyle.Render(ui.UpDownGlyph.String()) + " " + helpStyle.Render("card") + "  " +
+		helpKeyStyle.Render("enter") + " " + helpStyle.Render("view") + "  " +
+		helpKeyStyle.Render("s/S") + " " + helpStyle.Render("status/next") + "  " +
+		helpKeyStyle.Render("t") + " " + helpStyle.Render("tags") + "  " +
+		helpKeyStyle.Render("e") + " " + helpStyle.Render("edit") + "  " +
+		helpKeyStyle.Render("J/K") + " " + helpStyle.Render("reorder") + "  " +
+		helpKeyStyle.Render("G") + " " + helpStyle.Render("lanes: "+m.grouping.next().String()) + "  " +
+		helpKeyStyle.Render("v") + " " + helpStyle.Render("list") + "  " +
+		helpKeyStyle.Render("?") + " " + helpStyle.Render("help") + "  " +
+		helpKeyStyle.Render("q") + " " + helpStyle.Render("quit")
+}
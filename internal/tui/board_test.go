@@ -0,0 +1,187 @@
+package tui
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func boardTestBeans() []*bean.Bean {
+	return []*bean.Bean{
+		{ID: "m1", Title: "Milestone", Type: "milestone", Status: "todo"},
+		{ID: "e1", Title: "Auth", Type: "epic", Status: "in-progress", Parent: "m1"},
+		{ID: "e2", Title: "Billing", Type: "epic", Status: "todo"},
+		{ID: "f1", Title: "Login", Type: "feature", Status: "todo", Parent: "e1"},
+		{ID: "t1", Title: "Login form", Type: "task", Status: "in-progress", Parent: "f1"},
+		{ID: "b1", Title: "Crash", Type: "bug", Status: "todo"},
+		{ID: "t2", Title: "Old", Type: "task", Status: "scrapped", Parent: "e1"},
+	}
+}
+
+// laneIDs returns the IDs in each cell of a lane
+func laneIDs(lane boardLane) [][]string {
+	ids := make([][]string, len(lane.cells))
+	for i, cell := range lane.cells {
+		for _, b := range cell {
+			ids[i] = append(ids[i], b.ID)
+		}
+	}
+	return ids
+}
+
+func TestBuildBoard(t *testing.T) {
+	cfg := config.Default()
+	columns := boardColumns(cfg)
+	if want := []string{"draft", "todo", "in-progress", "completed"}; !slices.Equal(columns, want) {
+		t.Fatalf("boardColumns() = %v, want %v", columns, want)
+	}
+	todo, inProgress := 1, 2
+
+	t.Run("single lane without grouping", func(t *testing.T) {
+		lanes := buildBoard(boardTestBeans(), columns, groupNone, nil, cfg)
+		if len(lanes) != 1 {
+			t.Fatalf("got %d lanes, want 1", len(lanes))
+		}
+		ids := laneIDs(lanes[0])
+		if len(ids[todo]) != 4 || len(ids[inProgress]) != 2 {
+			t.Errorf("cells = %v", ids)
+		}
+	})
+
+	t.Run("lanes per epic", func(t *testing.T) {
+		lanes := buildBoard(boardTestBeans(), columns, groupEpic, nil, cfg)
+		// Billing has no cards, so only Auth and the "No epic" lane remain
+		if len(lanes) != 2 || lanes[0].title != "Auth" || lanes[1].title != "No epic" {
+			t.Fatalf("lanes = %+v", lanes)
+		}
+		auth := laneIDs(lanes[0])
+		if len(auth[todo]) != 1 || auth[todo][0] != "f1" || len(auth[inProgress]) != 1 || auth[inProgress][0] != "t1" {
+			t.Errorf("Auth lane = %v, want f1 todo and t1 in progress", auth)
+		}
+		if none := laneIDs(lanes[1]); len(none[todo]) != 1 || none[todo][0] != "b1" {
+			t.Errorf("No epic lane = %v, want only b1", none)
+		}
+	})
+
+	t.Run("lanes per type", func(t *testing.T) {
+		lanes := buildBoard(boardTestBeans(), columns, groupType, nil, cfg)
+		var titles []string
+		for _, l := range lanes {
+			titles = append(titles, l.title)
+		}
+		want := []string{"milestone", "epic", "bug", "feature", "task"}
+		if len(titles) != len(want) {
+			t.Fatalf("lanes = %v, want %v", titles, want)
+		}
+		for i := range want {
+			if titles[i] != want[i] {
+				t.Errorf("lanes = %v, want %v", titles, want)
+				break
+			}
+		}
+	})
+}
+
+func TestBuildBoardByAssignee(t *testing.T) {
+	cfg := config.Default()
+	columns := boardColumns(cfg)
+	owners := map[string]string{"f1": "@bob", "t1": "@alice", "b1": "@bob"}
+	lanes := buildBoard(boardTestBeans(), columns, groupAssignee, owners, cfg)
+
+	var titles []string
+	for _, l := range lanes {
+		titles = append(titles, l.title)
+	}
+	if want := []string{"@alice", "@bob", "Unassigned"}; !slices.Equal(titles, want) {
+		t.Fatalf("lanes = %v, want %v", titles, want)
+	}
+	todo := 1
+	if bob := laneIDs(lanes[1]); !slices.Equal(bob[todo], []string{"b1", "f1"}) {
+		t.Errorf("@bob lane = %v, want b1 and f1 todo", bob)
+	}
+}
+
+func TestBoardModelNavigation(t *testing.T) {
+	cfg := config.Default()
+	m := newBoardModel(nil, cfg)
+	m, _ = m.Update(boardLoadedMsg{beans: boardTestBeans()})
+
+	key := func(k string) {
+		var msg tea.KeyMsg
+		switch k {
+		case "right", "left", "down", "up":
+			msg = tea.KeyMsg{Type: map[string]tea.KeyType{"right": tea.KeyRight, "left": tea.KeyLeft, "down": tea.KeyDown, "up": tea.KeyUp}[k]}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		m, _ = m.Update(msg)
+	}
+
+	// The first column (draft) is empty; move to in-progress
+	if m.selected() != nil {
+		t.Fatalf("selected = %v in the empty draft column", m.selected())
+	}
+	key("right")
+	key("right")
+	key("down")
+	if b := m.selected(); b == nil || b.Status != "in-progress" {
+		t.Fatalf("selected = %+v, want an in-progress bean", b)
+	}
+	selected := m.selected().ID
+
+	// Regrouping keeps the cursor on the same bean
+	key("G")
+	if m.grouping != groupEpic {
+		t.Fatalf("grouping = %v, want epic", m.grouping)
+	}
+	if b := m.selected(); b == nil || b.ID != selected {
+		t.Errorf("selected after regrouping = %+v, want %s", b, selected)
+	}
+
+	// Moving past the last card stays on it
+	for range 5 {
+		key("down")
+	}
+	if m.selected() == nil {
+		t.Error("cursor moved off the column")
+	}
+}
+
+func TestBoardModelReorder(t *testing.T) {
+	cfg := config.Default()
+	m := newBoardModel(nil, cfg)
+	m, _ = m.Update(boardLoadedMsg{beans: []*bean.Bean{
+		{ID: "a", Title: "A", Type: "task", Status: "todo", Rank: "a"},
+		{ID: "b", Title: "B", Type: "task", Status: "todo", Rank: "b"},
+		{ID: "c", Title: "C", Type: "task", Status: "todo", Rank: "c"},
+	}})
+	m.col = slices.Index(m.columns, "todo")
+
+	reorder := func(k string) (reorderBeanMsg, bool) {
+		t.Helper()
+		var cmd tea.Cmd
+		m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		if cmd == nil {
+			return reorderBeanMsg{}, false
+		}
+		msg, ok := cmd().(reorderBeanMsg)
+		return msg, ok
+	}
+
+	if _, ok := reorder("K"); ok {
+		t.Error("K on the top card should not reorder")
+	}
+	msg, ok := reorder("J")
+	if !ok || msg.beanID != "a" || msg.afterID != "b" || msg.beforeID != "c" {
+		t.Errorf("J = %+v, %v, want a moved between b and c", msg, ok)
+	}
+
+	m.row = 2
+	msg, ok = reorder("K")
+	if !ok || msg.beanID != "c" || msg.afterID != "a" || msg.beforeID != "b" {
+		t.Errorf("K = %+v, %v, want c moved between a and b", msg, ok)
+	}
+}
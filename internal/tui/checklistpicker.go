@@ -0,0 +1,194 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// openChecklistPickerMsg requests opening the checklist picker for a bean
+type openChecklistPickerMsg struct {
+	beanID    string
+	beanTitle string
+}
+
+// closeChecklistPickerMsg is sent when the checklist picker is closed
+type closeChecklistPickerMsg struct {
+	beanID string
+}
+
+// checklistItem wraps a bean checklist item to implement list.Item
+type checklistItem struct {
+	item bean.ChecklistItem
+}
+
+func (i checklistItem) Title() string       { return i.item.Text }
+func (i checklistItem) Description() string { return "" }
+func (i checklistItem) FilterValue() string { return i.item.Text }
+
+// checklistItemDelegate handles rendering of checklist picker items
+type checklistItemDelegate struct{}
+
+func (d checklistItemDelegate) Height() int                             { return 1 }
+func (d checklistItemDelegate) Spacing() int                            { return 0 }
+func (d checklistItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d checklistItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(checklistItem)
+	if !ok {
+		return
+	}
+
+	var cursor string
+	if index == m.Index() {
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
+	} else {
+		cursor = "  "
+	}
+
+	box := "[ ] "
+	text := item.item.Text
+	if item.item.Done {
+		box = ui.Success.Render("[x] ")
+		text = ui.Muted.Render(text)
+	}
+
+	fmt.Fprint(w, cursor+box+text)
+}
+
+// checklistPickerModel lets the user toggle a bean's checklist items.
+// Items are toggled in place; the picker stays open until closed.
+type checklistPickerModel struct {
+	list      list.Model
+	beanID    string
+	beanTitle string
+	resolver  *graph.Resolver
+	err       error
+	width     int
+	height    int
+}
+
+func newChecklistPickerModel(beanID, beanTitle string, resolver *graph.Resolver, width, height int) checklistPickerModel {
+	modalWidth := max(40, min(80, width*60/100))
+	modalHeight := max(10, min(20, height*60/100))
+	listWidth := modalWidth - 6
+	listHeight := modalHeight - 7
+
+	l := list.New(nil, checklistItemDelegate{}, listWidth, listHeight)
+	plainPagination(&l)
+	l.Title = "Checklist"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.SetShowPagination(false)
+	l.Styles.Title = listTitleStyle
+	l.Styles.TitleBar = lipgloss.NewStyle().Padding(0, 0, 0, 0)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+
+	m := checklistPickerModel{
+		list:      l,
+		beanID:    beanID,
+		beanTitle: beanTitle,
+		resolver:  resolver,
+		width:     width,
+		height:    height,
+	}
+	if b, err := resolver.Query().Bean(context.Background(), beanID); err == nil && b != nil {
+		m.setItems(b)
+	}
+	return m
+}
+
+// setItems replaces the picker items with the bean's current checklist.
+func (m *checklistPickerModel) setItems(b *bean.Bean) {
+	checklist := b.Checklist()
+	items := make([]list.Item, len(checklist.Items))
+	for i, item := range checklist.Items {
+		items[i] = checklistItem{item: item}
+	}
+	m.list.SetItems(items)
+}
+
+func (m checklistPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m checklistPickerModel) Update(msg tea.Msg) (checklistPickerModel, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		modalWidth := max(40, min(80, msg.Width*60/100))
+		modalHeight := max(10, min(20, msg.Height*60/100))
+		m.list.SetSize(modalWidth-6, modalHeight-7)
+
+	case tea.KeyMsg:
+		if m.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "enter", " ", "x":
+				if item, ok := m.list.SelectedItem().(checklistItem); ok {
+					b, err := m.resolver.Mutation().ToggleChecklistItem(context.Background(), m.beanID, item.item.Index, nil, nil)
+					m.err = err
+					if err == nil {
+						m.setItems(b)
+					}
+				}
+				return m, nil
+			case "esc", "backspace":
+				return m, func() tea.Msg {
+					return closeChecklistPickerMsg{beanID: m.beanID}
+				}
+			}
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m checklistPickerModel) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	var description string
+	switch {
+	case m.err != nil:
+		description = m.err.Error()
+	case len(m.list.Items()) == 0:
+		description = "This bean has no checklist items ('- [ ] ...')"
+	default:
+		done := 0
+		for _, item := range m.list.Items() {
+			if item.(checklistItem).item.Done {
+				done++
+			}
+		}
+		description = fmt.Sprintf("%d/%d done %s enter to toggle, esc to close", done, len(m.list.Items()), ui.SeparatorGlyph)
+	}
+
+	return renderPickerModal(pickerModalConfig{
+		Title:       "Checklist",
+		BeanTitle:   m.beanTitle,
+		BeanID:      m.beanID,
+		ListContent: m.list.View(),
+		Description: description,
+		Width:       m.width,
+	})
+}
+
+// ModalView returns the picker rendered as a centered modal overlay on top of the background
+func (m checklistPickerModel) ModalView(bgView string, fullWidth, fullHeight int) string {
+	modal := m.View()
+	return overlayModal(bgView, modal, fullWidth, fullHeight)
+}
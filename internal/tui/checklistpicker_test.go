@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+)
+
+func TestChecklistPickerToggle(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create .beans dir: %v", err)
+	}
+	core := beancore.New(beansDir, config.Default())
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	b := &bean.Bean{ID: "one", Slug: "one", Title: "One", Status: "todo", Body: "- [ ] First\n- [ ] Second"}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	m := newChecklistPickerModel("one", "One", &graph.Resolver{Core: core}, 100, 40)
+	if got := len(m.list.Items()); got != 2 {
+		t.Fatalf("items = %d, want 2", got)
+	}
+
+	// Toggle the second item; the picker stays open with refreshed items
+	m.list.Select(1)
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Error("toggling should not close the picker")
+	}
+	if item := m.list.Items()[1].(checklistItem); !item.item.Done {
+		t.Error("second item should be checked in the picker")
+	}
+
+	saved, err := core.Get("one")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if checklist := saved.Checklist(); checklist.Done != 1 || !checklist.Items[1].Done {
+		t.Errorf("saved checklist = %+v, want second item done", checklist)
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("esc should close the picker")
+	}
+	if msg, ok := cmd().(closeChecklistPickerMsg); !ok || msg.beanID != "one" {
+		t.Errorf("esc = %#v, want closeChecklistPickerMsg for one", cmd())
+	}
+}
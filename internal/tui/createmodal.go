@@ -92,7 +92,7 @@ func (m createModalModel) View() string {
 
 	// Input field
 	inputBox := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorMuted).
 		Padding(0, 1).
 		Width(modalWidth - 6).
@@ -107,7 +107,7 @@ func (m createModalModel) View() string {
 
 	// Border style
 	border := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorPrimary).
 		Padding(1, 2).
 		Width(modalWidth)
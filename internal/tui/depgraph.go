@@ -0,0 +1,182 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// depSection is a part of the dependency graph, in the order it's drawn
+type depSection int
+
+const (
+	depBlockers depSection = iota // beans blocking this one, above it
+	depSelf                       // the bean itself
+	depBlocked                    // beans this one blocks, below it
+	depChildren                   // children, below and indented
+)
+
+// depSectionLabels label the sections other than the bean itself
+var depSectionLabels = map[depSection]string{
+	depBlockers: "Blocked by",
+	depBlocked:  "Blocking",
+	depChildren: "Children",
+}
+
+type depNode struct {
+	bean    *bean.Bean
+	section depSection
+}
+
+// depGraph is a bean's immediate dependency neighbourhood: its blockers, the
+// beans it blocks and its children, with a cursor moving between them
+type depGraph struct {
+	nodes  []depNode
+	cursor int
+}
+
+// newDepGraph builds the graph of b from its resolved links. The cursor
+// starts on b.
+func newDepGraph(b *bean.Bean, links []resolvedLink, cfg *config.Config) depGraph {
+	sections := make(map[depSection][]*bean.Bean)
+	for _, l := range links {
+		switch {
+		case l.linkType == "blocking" && l.incoming:
+			sections[depBlockers] = append(sections[depBlockers], l.bean)
+		case l.linkType == "blocking":
+			sections[depBlocked] = append(sections[depBlocked], l.bean)
+		case l.linkType == "parent" && l.incoming:
+			sections[depChildren] = append(sections[depChildren], l.bean)
+		}
+	}
+	sections[depSelf] = []*bean.Bean{b}
+
+	var g depGraph
+	for s := depBlockers; s <= depChildren; s++ {
+		beans := sections[s]
+		bean.SortByStatusPriorityAndType(beans, cfg.StatusNames(), cfg.PriorityNames(), cfg.TypeNames())
+		if s == depSelf {
+			g.cursor = len(g.nodes)
+		}
+		for _, sb := range beans {
+			g.nodes = append(g.nodes, depNode{bean: sb, section: s})
+		}
+	}
+	return g
+}
+
+// selected returns the bean under the cursor
+func (g depGraph) selected() *bean.Bean {
+	if g.cursor < 0 || g.cursor >= len(g.nodes) {
+		return nil
+	}
+	return g.nodes[g.cursor].bean
+}
+
+// move moves the cursor by delta nodes, stopping at the ends
+func (g *depGraph) move(delta int) {
+	g.cursor = max(0, min(g.cursor+delta, len(g.nodes)-1))
+}
+
+// jumpSection moves the cursor to the first node of the next (delta > 0) or
+// previous (delta < 0) section that has any
+func (g *depGraph) jumpSection(delta int) {
+	if len(g.nodes) == 0 {
+		return
+	}
+	current := g.nodes[g.cursor].section
+	if delta > 0 {
+		for i := g.cursor + 1; i < len(g.nodes); i++ {
+			if g.nodes[i].section != current {
+				g.cursor = i
+				return
+			}
+		}
+		return
+	}
+	for i := g.cursor - 1; i >= 0; i-- {
+		if g.nodes[i].section != current {
+			// Go to the first node of that section
+			target := g.nodes[i].section
+			for i > 0 && g.nodes[i-1].section == target {
+				i--
+			}
+			g.cursor = i
+			return
+		}
+	}
+}
+
+// render draws the graph in at most width columns and height lines,
+// scrolled to keep the cursor in view: blockers above the bean, an arrow
+// to it, and an arrow down to the beans it blocks and its children.
+func (g depGraph) render(cfg *config.Config, width, height int) string {
+	var lines []string
+	cursorLine := 0
+	edge := func() {
+		lines = append(lines, "    "+ui.Muted.Render(ui.EdgeGlyph.String()), "    "+ui.Muted.Render(ui.ArrowGlyph.String()))
+	}
+
+	var prev depSection = -1
+	for i, n := range g.nodes {
+		if n.section != prev {
+			if n.section == depSelf && i > 0 {
+				edge()
+			}
+			if n.section != depSelf && prev == depSelf {
+				edge()
+			}
+			if label, ok := depSectionLabels[n.section]; ok {
+				lines = append(lines, ui.Muted.Render(label))
+			}
+			prev = n.section
+		}
+
+		indent := "  "
+		if n.section == depChildren {
+			branch := ui.BranchGlyph
+			if i == len(g.nodes)-1 {
+				branch = ui.LastBranchGlyph
+			}
+			indent += ui.Muted.Render(branch.String()) + " "
+		}
+		if i == g.cursor {
+			cursorLine = len(lines)
+		}
+		lines = append(lines, g.renderNode(n, i == g.cursor, indent, cfg, width))
+	}
+	if len(g.nodes) <= 1 {
+		lines = append(lines, "", ui.Muted.Render("No blockers, blocked beans or children"))
+	}
+
+	start := 0
+	if height > 0 && cursorLine >= height {
+		start = cursorLine - height + 1
+	}
+	end := len(lines)
+	if height > 0 {
+		end = min(end, start+height)
+	}
+	return strings.Join(lines[start:end], "\n")
+}
+
+// renderNode renders one bean of the graph as a line
+func (g depGraph) renderNode(n depNode, selected bool, indent string, cfg *config.Config, width int) string {
+	cursor := "  "
+	if selected {
+		cursor = ui.Primary.Render(ui.PointerGlyph.String() + " ")
+	}
+	b := n.bean
+	colors := cfg.GetBeanColors(b.Status, b.Type, b.Priority)
+	status := ui.RenderStatusTextWithColor(b.Status, colors.StatusColor, colors.IsArchive)
+
+	prefix := cursor + indent + ui.ID.Render(b.ID) + " " + status + " "
+	title := truncateText(b.Title, width-lipgloss.Width(prefix))
+	if n.section == depSelf || selected {
+		title = lipgloss.NewStyle().Bold(true).Render(title)
+	}
+	return prefix + title
+}
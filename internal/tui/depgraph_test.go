@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestDepGraph(t *testing.T) {
+	cfg := config.Default()
+	self := &bean.Bean{ID: "self", Title: "Ship it", Status: "todo"}
+	links := []resolvedLink{
+		{linkType: "blocking", bean: &bean.Bean{ID: "b1", Title: "Blocker", Status: "todo"}, incoming: true},
+		{linkType: "blocking", bean: &bean.Bean{ID: "b2", Title: "Other blocker", Status: "in-progress"}, incoming: true},
+		{linkType: "blocking", bean: &bean.Bean{ID: "d1", Title: "Downstream", Status: "todo"}},
+		{linkType: "parent", bean: &bean.Bean{ID: "p1", Title: "Parent", Status: "todo"}},
+		{linkType: "parent", bean: &bean.Bean{ID: "c1", Title: "Child", Status: "todo"}, incoming: true},
+		{linkType: "related", bean: &bean.Bean{ID: "r1", Title: "Related", Status: "todo"}},
+	}
+	g := newDepGraph(self, links, cfg)
+
+	var ids []string
+	for _, n := range g.nodes {
+		ids = append(ids, n.bean.ID)
+	}
+	// Blockers sorted like the list (in-progress first), then self, blocked, children
+	if got, want := strings.Join(ids, " "), "b2 b1 self d1 c1"; got != want {
+		t.Fatalf("nodes = %s, want %s", got, want)
+	}
+	if g.selected().ID != "self" {
+		t.Errorf("cursor starts on %s, want self", g.selected().ID)
+	}
+
+	g.jumpSection(1)
+	if g.selected().ID != "d1" {
+		t.Errorf("right from self = %s, want d1", g.selected().ID)
+	}
+	g.move(1)
+	if g.selected().ID != "c1" {
+		t.Errorf("down from d1 = %s, want c1", g.selected().ID)
+	}
+	g.move(1)
+	if g.selected().ID != "c1" {
+		t.Errorf("down from the last node = %s, want to stay on c1", g.selected().ID)
+	}
+	g.jumpSection(-1)
+	g.jumpSection(-1)
+	g.jumpSection(-1)
+	if g.selected().ID != "b2" {
+		t.Errorf("left to the blockers = %s, want their first, b2", g.selected().ID)
+	}
+
+	out := ansi.Strip(g.render(cfg, 80, 0))
+	for _, want := range []string{"Blocked by", "Blocking", "Children", "self", "c1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("render() is missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "b1") > strings.Index(out, "self") || strings.Index(out, "self") > strings.Index(out, "d1") {
+		t.Errorf("render() should draw blockers above the bean and blocked beans below:\n%s", out)
+	}
+
+	// Scrolled to keep the cursor in view
+	g.cursor = len(g.nodes) - 1
+	if out := ansi.Strip(g.render(cfg, 80, 3)); !strings.Contains(out, "c1") || strings.Count(out, "\n") != 2 {
+		t.Errorf("render() with 3 lines =\n%s\nwant the last 3 lines", out)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
 	"sync"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -14,8 +15,10 @@ import (
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
 	"github.com/hmans/beans/internal/ui"
 )
 
@@ -30,7 +33,7 @@ func getGlamourRenderer() *glamour.TermRenderer {
 		var err error
 		// Use DarkStyle instead of WithAutoStyle() to avoid slow terminal detection
 		// that can cause multi-second delays in some terminals
-		glamourRenderer, err = glamour.NewTermRenderer(glamour.WithStylePath("dark"))
+		glamourRenderer, err = glamour.NewTermRenderer(glamour.WithStylePath(ui.MarkdownStyle("dark")))
 		if err != nil {
 			glamourRenderer = nil
 		}
@@ -83,7 +86,7 @@ func (d linkDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 	// Cursor indicator
 	cursor := "  "
 	if index == m.Index() {
-		cursor = ui.Primary.Render("▸ ")
+		cursor = ui.Primary.Render(ui.PointerGlyph.String() + " ")
 	}
 
 	// Format the link type label
@@ -139,6 +142,8 @@ type detailModel struct {
 	linksActive   bool                 // true = links section focused
 	cols          ui.ResponsiveColumns // responsive column widths for links
 	statusMessage string               // Status message to display in footer
+	graphActive   bool                 // true = dependency graph shown instead of the body
+	graph         depGraph             // dependency neighbourhood, navigable in the graph tab
 }
 
 func newDetailModel(b *bean.Bean, resolver *graph.Resolver, cfg *config.Config, width, height int) detailModel {
@@ -154,6 +159,7 @@ func newDetailModel(b *bean.Bean, resolver *graph.Resolver, cfg *config.Config,
 
 	// Resolve all links
 	m.links = m.resolveAllLinks()
+	m.graph = newDepGraph(b, m.links, cfg)
 
 	// Check if any linked beans have tags
 	hasTags := false
@@ -215,6 +221,7 @@ func (m detailModel) createLinkList() list.Model {
 	listHeight := min(len(m.links), maxHeight) + 2
 
 	l := list.New(items, delegate, m.width-8, listHeight)
+	plainPagination(&l)
 	l.Title = "Linked Beans"
 	l.SetShowStatusBar(false)
 	l.SetShowHelp(false)
@@ -295,12 +302,44 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 			return m, cmd
 		}
 
+		// The dependency graph tab takes the navigation keys
+		if m.graphActive {
+			switch msg.String() {
+			case "up", "k":
+				m.graph.move(-1)
+				return m, nil
+			case "down", "j":
+				m.graph.move(1)
+				return m, nil
+			case "left", "h":
+				m.graph.jumpSection(-1)
+				return m, nil
+			case "right", "l":
+				m.graph.jumpSection(1)
+				return m, nil
+			case "enter":
+				if target := m.graph.selected(); target != nil && target.ID != m.bean.ID {
+					return m, func() tea.Msg {
+						return selectBeanMsg{bean: target}
+					}
+				}
+				return m, nil
+			case "tab":
+				return m, nil
+			}
+		}
+
 		switch msg.String() {
 		case "esc", "backspace":
 			return m, func() tea.Msg {
 				return backToListMsg{}
 			}
 
+		case "d":
+			// Toggle the dependency graph tab
+			m.graphActive = !m.graphActive
+			return m, nil
+
 		case "tab":
 			// Toggle focus between links and body
 			if len(m.links) > 0 {
@@ -330,6 +369,12 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 				}
 			}
 
+		case "S", "shift+right":
+			// Advance to the next status
+			return m, func() tea.Msg {
+				return advanceStatusMsg{beanIDs: []string{m.bean.ID}}
+			}
+
 		case "s":
 			// Open status picker
 			return m, func() tea.Msg {
@@ -341,6 +386,12 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 			}
 
 		case "t":
+			// Open tag editor
+			return m, func() tea.Msg {
+				return openTagEditorMsg{beanID: m.bean.ID, beanTitle: m.bean.Title}
+			}
+
+		case "T":
 			// Open type picker
 			return m, func() tea.Msg {
 				return openTypePickerMsg{
@@ -370,6 +421,22 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 				}
 			}
 
+		case "L":
+			// Open link picker
+			return m, func() tea.Msg {
+				return openLinkPickerMsg{beanID: m.bean.ID, beanTitle: m.bean.Title}
+			}
+
+		case "X":
+			// Remove the selected link
+			if m.linksActive {
+				if item, ok := m.linkList.SelectedItem().(linkItem); ok {
+					return m, func() tea.Msg {
+						return removeLinkMsg{beanID: m.bean.ID, link: item.link}
+					}
+				}
+			}
+
 		case "e":
 			// Open editor for this bean
 			return m, func() tea.Msg {
@@ -379,6 +446,12 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 				}
 			}
 
+		case "x":
+			// Open checklist picker
+			return m, func() tea.Msg {
+				return openChecklistPickerMsg{beanID: m.bean.ID, beanTitle: m.bean.Title}
+			}
+
 		case "y":
 			// Copy bean ID to clipboard
 			return m, func() tea.Msg {
@@ -388,6 +461,9 @@ func (m detailModel) Update(msg tea.Msg) (detailModel, tea.Cmd) {
 	}
 
 	// Forward updates to the appropriate component
+	if m.graphActive {
+		return m, nil
+	}
 	if m.linksActive && len(m.links) > 0 {
 		m.linkList, cmd = m.linkList.Update(msg)
 		cmds = append(cmds, cmd)
@@ -425,39 +501,59 @@ func (m detailModel) View() string {
 			linksBorderColor = ui.ColorPrimary
 		}
 		linksBorder := lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(ui.Border()).
 			BorderForeground(linksBorderColor).
 			Width(m.width - 4)
 		linksSection = linksBorder.Render(m.linkList.View()) + "\n"
 	}
 
-	// Body
+	// Body, or the dependency graph in its place
 	bodyBorderColor := ui.ColorMuted
-	if !m.linksActive {
+	if !m.linksActive || m.graphActive {
 		bodyBorderColor = ui.ColorPrimary
 	}
 	bodyBorder := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(bodyBorderColor).
 		Width(m.width - 4)
-	body := bodyBorder.Render(m.viewport.View())
+	var body string
+	if m.graphActive {
+		graph := m.graph.render(m.config, m.viewport.Width-2, m.viewport.Height)
+		body = bodyBorder.Render(lipgloss.NewStyle().Padding(0, 1).Height(m.viewport.Height).Render(graph))
+	} else {
+		body = bodyBorder.Render(m.viewport.View())
+	}
 
 	// Footer
-	scrollPct := int(m.viewport.ScrollPercent() * 100)
-	footer := helpStyle.Render(fmt.Sprintf("%d%%", scrollPct)) + "  "
-	if len(m.links) > 0 {
+	var footer string
+	if m.graphActive {
+		footer = helpKeyStyle.Render(ui.UpDownGlyph.String()) + " " + helpStyle.Render("bean") + "  " +
+			helpKeyStyle.Render(ui.LeftRightGlyph.String()) + " " + helpStyle.Render("section") + "  " +
+			helpKeyStyle.Render("enter") + " " + helpStyle.Render("go to") + "  " +
+			helpKeyStyle.Render("d") + " " + helpStyle.Render("description") + "  "
+	} else {
+		scrollPct := int(m.viewport.ScrollPercent() * 100)
+		footer = helpStyle.Render(fmt.Sprintf("%d%%", scrollPct)) + "  "
+		footer += helpKeyStyle.Render("d") + " " + helpStyle.Render("dependencies") + "  "
+	}
+	if len(m.links) > 0 && !m.graphActive {
 		footer += helpKeyStyle.Render("tab") + " " + helpStyle.Render("switch") + "  "
 		if m.linksActive {
 			footer += helpKeyStyle.Render("/") + " " + helpStyle.Render("filter") + "  "
 		}
 		footer += helpKeyStyle.Render("enter") + " " + helpStyle.Render("go to") + "  "
+		if m.linksActive {
+			footer += helpKeyStyle.Render("X") + " " + helpStyle.Render("unlink") + "  "
+		}
 	}
 	footer += helpKeyStyle.Render("b") + " " + helpStyle.Render("blocking") + "  " +
 		helpKeyStyle.Render("e") + " " + helpStyle.Render("edit") + "  " +
+		helpKeyStyle.Render("L") + " " + helpStyle.Render("link") + "  " +
 		helpKeyStyle.Render("p") + " " + helpStyle.Render("parent") + "  " +
 		helpKeyStyle.Render("P") + " " + helpStyle.Render("priority") + "  " +
-		helpKeyStyle.Render("s") + " " + helpStyle.Render("status") + "  " +
-		helpKeyStyle.Render("t") + " " + helpStyle.Render("type") + "  " +
+		helpKeyStyle.Render("s/S") + " " + helpStyle.Render("status/next") + "  " +
+		helpKeyStyle.Render("t/T") + " " + helpStyle.Render("tags/type") + "  " +
+		helpKeyStyle.Render("x") + " " + helpStyle.Render("checklist") + "  " +
 		helpKeyStyle.Render("y") + " " + helpStyle.Render("copy id") + "  " +
 		helpKeyStyle.Render("j/k") + " " + helpStyle.Render("scroll") + "  " +
 		helpKeyStyle.Render("?") + " " + helpStyle.Render("help") + "  " +
@@ -517,9 +613,23 @@ func (m detailModel) renderHeader() string {
 		headerContent.WriteString(ui.RenderTags(m.bean.Tags))
 	}
 
+	// Add when the bean was created and last updated
+	now := time.Now()
+	var times []string
+	if m.bean.CreatedAt != nil {
+		times = append(times, "created "+ui.FormatTime(*m.bean.CreatedAt, now))
+	}
+	if m.bean.UpdatedAt != nil {
+		times = append(times, "updated "+ui.FormatTime(*m.bean.UpdatedAt, now))
+	}
+	if len(times) > 0 {
+		headerContent.WriteString("  ")
+		headerContent.WriteString(ui.Muted.Render(strings.Join(times, " "+ui.SeparatorGlyph.String()+" ")))
+	}
+
 	// Header box style - always muted border (not focused, links section is separate)
 	headerBox := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorMuted).
 		Padding(0, 1).
 		Width(m.width - 4)
@@ -535,9 +645,14 @@ func (m detailModel) formatLinkLabel(linkType string, incoming bool) string {
 			return "Blocked by"
 		case "parent":
 			return "Child"
-		default:
-			return linkType + " (incoming)"
+		case beancore.MentionLinkType:
+			return "Mentioned by"
+		}
+		// Typed links use the configured reverse label (e.g. "Duplicated by")
+		if lt := m.config.GetLinkType(linkType); lt != nil {
+			return capitalize(lt.ReverseLabel())
 		}
+		return linkType + " (incoming)"
 	}
 
 	// Outgoing labels - capitalize first letter
@@ -547,10 +662,18 @@ func (m detailModel) formatLinkLabel(linkType string, incoming bool) string {
 	case "parent":
 		return "Parent"
 	default:
-		return linkType
+		return capitalize(linkType)
 	}
 }
 
+// capitalize upper-cases the first letter of s.
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
 func (m detailModel) resolveAllLinks() []resolvedLink {
 	var links []resolvedLink
 	ctx := context.Background()
@@ -578,6 +701,15 @@ func (m detailModel) resolveAllLinks() []resolvedLink {
 		}
 	}
 
+	// Typed links (duplicates, supersedes, ...) in both directions
+	if typed, _ := beanResolver.Links(ctx, m.bean, nil); typed != nil {
+		for _, l := range typed {
+			if l.Bean != nil {
+				links = append(links, resolvedLink{linkType: l.Type, bean: l.Bean, incoming: l.Direction == model.LinkDirectionIncoming})
+			}
+		}
+	}
+
 	// Sort all links by link type label first, then by bean status/type/title
 	// This keeps link categories together while ordering beans consistently with the main list
 	statusNames := m.config.StatusNames()
@@ -650,6 +782,13 @@ func compareBeansByStatusPriorityAndType(a, b *bean.Bean, statusNames, priorityN
 	if oi != oj {
 		return oi < oj
 	}
+	// Manual rank within a status: ranked beans first, in rank order
+	if a.Rank != b.Rank {
+		if a.Rank == "" || b.Rank == "" {
+			return b.Rank == ""
+		}
+		return a.Rank < b.Rank
+	}
 	// Secondary: priority order
 	pi, pj := getPriorityOrder(a.Priority), getPriorityOrder(b.Priority)
 	if pi != pj {
@@ -678,10 +817,22 @@ func (m detailModel) renderBody(_ int) string {
 		return m.bean.Body
 	}
 
-	rendered, err := renderer.Render(m.bean.Body)
+	rendered, err := renderer.Render(m.annotateMentions(m.bean.Body))
 	if err != nil {
 		return m.bean.Body
 	}
 
 	return strings.TrimSpace(rendered)
 }
+
+// annotateMentions highlights the body's mentions of other beans and adds
+// their titles; the mentioned beans are in the links list to navigate to.
+func (m detailModel) annotateMentions(body string) string {
+	return bean.ReplaceMentions(body, func(text, ref string) string {
+		b, err := m.resolver.Core.Get(ref)
+		if err != nil {
+			return text
+		}
+		return "**" + text + "** (" + b.Title + ")"
+	})
+}
@@ -82,12 +82,22 @@ func (m helpOverlayModel) View() string {
 	content.WriteString(shortcut("enter", "View bean details") + "\n")
 	content.WriteString(shortcut("b", "Manage blocking") + "\n")
 	content.WriteString(shortcut("c", "Create new bean") + "\n")
+	content.WriteString(shortcut("d", "Dependency graph (detail view)") + "\n")
 	content.WriteString(shortcut("e", "Edit in $EDITOR") + "\n")
+	content.WriteString(shortcut("f", "Focus on bean (toggle)") + "\n")
+	content.WriteString(shortcut("L", "Add link (detail view)") + "\n")
 	content.WriteString(shortcut("p", "Set parent") + "\n")
 	content.WriteString(shortcut("P", "Change priority") + "\n")
 	content.WriteString(shortcut("s", "Change status") + "\n")
-	content.WriteString(shortcut("t", "Change type") + "\n")
+	content.WriteString(shortcut("S", "Next status (u to undo)") + "\n")
+	content.WriteString(shortcut("t", "Edit tags") + "\n")
+	content.WriteString(shortcut("T", "Change type") + "\n")
+	content.WriteString(shortcut("x", "Toggle checklist items") + "\n")
+	content.WriteString(shortcut("X", "Remove selected link (detail view)") + "\n")
+	content.WriteString(shortcut("J/K", "Move bean down/up") + "\n")
 	content.WriteString(shortcut("y", "Copy bean ID") + "\n")
+	content.WriteString(shortcut("v", "Toggle board view") + "\n")
+	content.WriteString(shortcut("G", "Board swimlanes by epic/type/assignee") + "\n")
 	content.WriteString(shortcut("/", "Filter") + "\n")
 	content.WriteString(shortcut("g t", "Filter by tag") + "\n")
 	content.WriteString(shortcut("q", "Quit") + "\n")
@@ -98,7 +108,7 @@ func (m helpOverlayModel) View() string {
 
 	// Border style
 	border := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorPrimary).
 		Padding(1, 2).
 		Width(modalWidth)
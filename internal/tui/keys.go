@@ -1,6 +1,9 @@
 package tui
 
-import "github.com/charmbracelet/bubbles/key"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/hmans/beans/internal/ui"
+)
 
 // KeyMap defines the key bindings for the TUI
 type KeyMap struct {
@@ -18,11 +21,11 @@ func DefaultKeyMap() KeyMap {
 	return KeyMap{
 		Up: key.NewBinding(
 			key.WithKeys("up", "k"),
-			key.WithHelp("↑/k", "up"),
+			key.WithHelp(ui.UpGlyph.String()+"/k", "up"),
 		),
 		Down: key.NewBinding(
 			key.WithKeys("down", "j"),
-			key.WithHelp("↓/j", "down"),
+			key.WithHelp(ui.DownGlyph.String()+"/j", "down"),
 		),
 		Enter: key.NewBinding(
 			key.WithKeys("enter"),
@@ -73,11 +76,11 @@ func DefaultDetailKeyMap() DetailKeyMap {
 	return DetailKeyMap{
 		Up: key.NewBinding(
 			key.WithKeys("up", "k"),
-			key.WithHelp("↑/k", "scroll up"),
+			key.WithHelp(ui.UpGlyph.String()+"/k", "scroll up"),
 		),
 		Down: key.NewBinding(
 			key.WithKeys("down", "j"),
-			key.WithHelp("↓/j", "scroll down"),
+			key.WithHelp(ui.DownGlyph.String()+"/j", "scroll down"),
 		),
 		Back: key.NewBinding(
 			key.WithKeys("esc", "backspace"),
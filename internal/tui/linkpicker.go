@@ -0,0 +1,233 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// openLinkPickerMsg requests opening the link picker for a bean
+type openLinkPickerMsg struct {
+	beanID    string
+	beanTitle string
+}
+
+// closeLinkPickerMsg is sent when the link picker is cancelled
+type closeLinkPickerMsg struct{}
+
+// linkSelectedMsg is sent when a link type and target are picked
+type linkSelectedMsg struct {
+	beanID   string
+	linkType string // "blocking", "blocked_by", "parent" or a typed link
+	targetID string
+}
+
+// removeLinkMsg requests removing one of a bean's links
+type removeLinkMsg struct {
+	beanID string
+	link   resolvedLink
+}
+
+// linkPickerModel picks a bean to link to, fuzzy matched by title or ID,
+// and the kind of link, cycled with tab.
+type linkPickerModel struct {
+	list      list.Model
+	beanID    string
+	beanTitle string
+	linkTypes []string
+	typeIndex int
+	config    *config.Config
+	width     int
+	height    int
+}
+
+func newLinkPickerModel(beanID, beanTitle string, resolver *graph.Resolver, cfg *config.Config, width, height int) linkPickerModel {
+	allBeans, _ := resolver.Query().Beans(context.Background(), nil, nil)
+	statusNames := cfg.StatusNames()
+	priorityNames := cfg.PriorityNames()
+	typeNames := cfg.TypeNames()
+	bean.SortByStatusPriorityAndType(allBeans, statusNames, priorityNames, typeNames)
+
+	var items []list.Item
+	for _, b := range allBeans {
+		if b.ID != beanID {
+			items = append(items, parentItem{bean: b, cfg: cfg})
+		}
+	}
+
+	linkTypes := []string{"blocking", "blocked_by", "parent"}
+	for _, lt := range cfg.LinkTypes() {
+		linkTypes = append(linkTypes, lt.Name)
+	}
+
+	modalWidth := max(40, min(80, width*60/100))
+	modalHeight := max(10, min(20, height*60/100))
+
+	l := list.New(items, parentItemDelegate{cfg: cfg}, modalWidth-6, modalHeight-7)
+	plainPagination(&l)
+	l.Title = "Link To"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.SetShowPagination(false)
+	l.Styles.Title = listTitleStyle
+	l.Styles.TitleBar = lipgloss.NewStyle().Padding(0, 0, 0, 0)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+
+	return linkPickerModel{
+		list:      l,
+		beanID:    beanID,
+		beanTitle: beanTitle,
+		linkTypes: linkTypes,
+		config:    cfg,
+		width:     width,
+		height:    height,
+	}
+}
+
+// linkTypeLabel describes a link type from the linking bean's side
+func linkTypeLabel(linkType string) string {
+	switch linkType {
+	case "blocking":
+		return "blocks"
+	case "blocked_by":
+		return "is blocked by"
+	case "parent":
+		return "is a child of"
+	}
+	return linkType
+}
+
+func (m linkPickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m linkPickerModel) Update(msg tea.Msg) (linkPickerModel, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		modalWidth := max(40, min(80, msg.Width*60/100))
+		modalHeight := max(10, min(20, msg.Height*60/100))
+		m.list.SetSize(modalWidth-6, modalHeight-7)
+
+	case tea.KeyMsg:
+		if m.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "tab":
+				m.typeIndex = (m.typeIndex + 1) % len(m.linkTypes)
+				return m, nil
+			case "shift+tab":
+				m.typeIndex = (m.typeIndex + len(m.linkTypes) - 1) % len(m.linkTypes)
+				return m, nil
+			case "enter":
+				if item, ok := m.list.SelectedItem().(parentItem); ok {
+					linkType := m.linkTypes[m.typeIndex]
+					return m, func() tea.Msg {
+						return linkSelectedMsg{beanID: m.beanID, linkType: linkType, targetID: item.bean.ID}
+					}
+				}
+			case "esc", "backspace":
+				return m, func() tea.Msg {
+					return closeLinkPickerMsg{}
+				}
+			}
+		}
+	}
+
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m linkPickerModel) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	linkType := lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(linkTypeLabel(m.linkTypes[m.typeIndex]))
+	description := "This bean " + linkType + " the selected bean " + ui.Muted.Render("(tab to change)")
+
+	return renderPickerModal(pickerModalConfig{
+		Title:       "Link To",
+		BeanTitle:   m.beanTitle,
+		BeanID:      m.beanID,
+		ListContent: m.list.View(),
+		Description: description,
+		Width:       m.width,
+		WidthPct:    60,
+		MaxWidth:    80,
+	})
+}
+
+// ModalView returns the picker rendered as a centered modal overlay on top of the background
+func (m linkPickerModel) ModalView(bgView string, fullWidth, fullHeight int) string {
+	modal := m.View()
+	return overlayModal(bgView, modal, fullWidth, fullHeight)
+}
+
+// addLink links a bean to a target through the same mutations the GraphQL
+// API offers.
+func addLink(resolver *graph.Resolver, beanID, linkType, targetID string) error {
+	ctx := context.Background()
+	mutation := resolver.Mutation()
+	var err error
+	switch linkType {
+	case "blocking":
+		_, err = mutation.AddBlocking(ctx, beanID, targetID, nil)
+	case "blocked_by":
+		_, err = mutation.AddBlockedBy(ctx, beanID, targetID, nil)
+	case "parent":
+		_, err = mutation.SetParent(ctx, beanID, &targetID, nil, nil)
+	default:
+		_, err = mutation.AddLink(ctx, beanID, linkType, targetID, nil)
+	}
+	return err
+}
+
+// removeLink removes a link shown in b's detail view, from whichever bean
+// records it.
+func removeLink(resolver *graph.Resolver, b *bean.Bean, link resolvedLink) error {
+	ctx := context.Background()
+	mutation := resolver.Mutation()
+	target := link.bean.ID
+	var err error
+	switch {
+	case link.linkType == beancore.MentionLinkType:
+		return fmt.Errorf("mentions are removed by editing the body")
+	case link.linkType == "parent" && !link.incoming:
+		_, err = mutation.SetParent(ctx, b.ID, nil, nil, nil)
+	case link.linkType == "parent":
+		_, err = mutation.SetParent(ctx, target, nil, nil, nil)
+	case link.linkType == "blocking" && !link.incoming:
+		// b blocks target, recorded on either side
+		if slices.Contains(b.Blocking, target) {
+			_, err = mutation.RemoveBlocking(ctx, b.ID, target, nil)
+		} else {
+			_, err = mutation.RemoveBlockedBy(ctx, target, b.ID, nil)
+		}
+	case link.linkType == "blocking":
+		// target blocks b, recorded on either side
+		if slices.Contains(b.BlockedBy, target) {
+			_, err = mutation.RemoveBlockedBy(ctx, b.ID, target, nil)
+		} else {
+			_, err = mutation.RemoveBlocking(ctx, target, b.ID, nil)
+		}
+	case link.incoming:
+		_, err = mutation.RemoveLink(ctx, target, link.linkType, b.ID, nil)
+	default:
+		_, err = mutation.RemoveLink(ctx, b.ID, link.linkType, target, nil)
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+)
+
+func TestLinkPicker(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create .beans dir: %v", err)
+	}
+	cfg := config.Default()
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for _, b := range []*bean.Bean{
+		{ID: "epic", Title: "Epic", Status: "todo", Type: "epic"},
+		{ID: "task", Title: "Task", Status: "todo", Type: "task"},
+		{ID: "other", Title: "Other", Status: "todo", Type: "task"},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	resolver := &graph.Resolver{Core: core}
+	get := func(id string) *bean.Bean {
+		b, err := core.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	m := newLinkPickerModel("task", "Task", resolver, cfg, 100, 40)
+	for _, item := range m.list.Items() {
+		if item.(parentItem).bean.ID == "task" {
+			t.Error("the picker offers the bean itself")
+		}
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if got := m.linkTypes[m.typeIndex]; got != "implements" {
+		t.Errorf("link type after shift+tab = %q, want the last configured type", got)
+	}
+
+	tests := []struct {
+		linkType string
+		target   string
+		linked   func() bool
+	}{
+		{"blocking", "other", func() bool { return slices.Contains(get("task").Blocking, "other") }},
+		{"blocked_by", "epic", func() bool { return slices.Contains(get("task").BlockedBy, "epic") }},
+		{"parent", "epic", func() bool { return get("task").Parent == "epic" }},
+		{"related", "other", func() bool { return slices.Contains(get("task").Links["related"], "other") }},
+	}
+	for _, tt := range tests {
+		if err := addLink(resolver, "task", tt.linkType, tt.target); err != nil {
+			t.Fatalf("addLink(%s) error = %v", tt.linkType, err)
+		}
+		if !tt.linked() {
+			t.Fatalf("addLink(%s) didn't link", tt.linkType)
+		}
+	}
+
+	// Links are removed from either end
+	removals := []struct {
+		from *bean.Bean
+		link resolvedLink
+	}{
+		{get("task"), resolvedLink{linkType: "blocking", bean: get("other")}},
+		{get("epic"), resolvedLink{linkType: "blocking", bean: get("task")}},
+		{get("epic"), resolvedLink{linkType: "parent", bean: get("task"), incoming: true}},
+		{get("other"), resolvedLink{linkType: "related", bean: get("task"), incoming: true}},
+	}
+	for _, r := range removals {
+		if err := removeLink(resolver, r.from, r.link); err != nil {
+			t.Errorf("removeLink(%s) error = %v", r.link.linkType, err)
+		}
+	}
+	for _, tt := range tests {
+		if tt.linked() {
+			t.Errorf("%s link wasn't removed", tt.linkType)
+		}
+	}
+
+	if err := removeLink(resolver, get("task"), resolvedLink{linkType: beancore.MentionLinkType, bean: get("other")}); err == nil {
+		t.Error("expected an error removing a mention")
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/graph"
 	"github.com/hmans/beans/internal/graph/model"
@@ -24,7 +27,7 @@ type beanItem struct {
 }
 
 func (i beanItem) Title() string       { return i.bean.Title }
-func (i beanItem) Description() string { return i.bean.ID + " · " + i.bean.Status }
+func (i beanItem) Description() string { return i.bean.ID + " " + ui.SeparatorGlyph.String() + " " + i.bean.Status }
 func (i beanItem) FilterValue() string { return i.bean.Title + " " + i.bean.ID }
 
 // itemDelegate handles rendering of list items
@@ -35,6 +38,7 @@ type itemDelegate struct {
 	cols          ui.ResponsiveColumns // cached responsive columns
 	idColWidth    int                  // ID column width (accounts for tree prefix)
 	selectedBeans *map[string]bool     // pointer to marked beans for multi-select
+	focusID       string               // ID of the bean in focus, if any
 }
 
 func newItemDelegate(cfg *config.Config) itemDelegate {
@@ -63,8 +67,16 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 	if d.cols.ShowTags {
 		baseWidth += d.cols.Tags
 	}
+	if d.cols.ShowUpdated {
+		baseWidth += ui.ColWidthUpdated + 1
+	}
 	maxTitleWidth := max(0, m.Width()-baseWidth)
 
+	var updated string
+	if item.bean.UpdatedAt != nil {
+		updated = ui.FormatTime(*item.bean.UpdatedAt, time.Now())
+	}
+
 	// Check if bean is marked for multi-select
 	var isMarked bool
 	if d.selectedBeans != nil {
@@ -90,16 +102,33 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 			ShowTags:      d.cols.ShowTags,
 			TagsColWidth:  d.cols.Tags,
 			MaxTags:       d.cols.MaxTags,
+			Updated:       updated,
+			ShowUpdated:   d.cols.ShowUpdated,
 			TreePrefix:    item.treePrefix,
 			Dimmed:        !item.matched,
 			IDColWidth:    d.idColWidth,
 			UseFullNames:  d.cols.UseFullTypeStatus,
+			TitleSuffix:   titleSuffix(item.bean),
+			IsFocused:     item.bean.ID == d.focusID,
 		},
 	)
 
 	fmt.Fprint(w, str)
 }
 
+// titleSuffix returns the extra text shown after a bean's title: checklist
+// progress, and a marker for local-only beans.
+func titleSuffix(b *bean.Bean) string {
+	suffix := ui.ChecklistProgress(b)
+	if beancore.IsLocalPath(b.Path) {
+		if suffix != "" {
+			suffix += " "
+		}
+		suffix += "local"
+	}
+	return suffix
+}
+
 // listModel is the model for the bean list view
 type listModel struct {
 	list     list.Model
@@ -122,6 +151,29 @@ type listModel struct {
 
 	// Status message to display in footer
 	statusMessage string
+
+	// Bean to move the cursor to once beans are reloaded (e.g. after reordering)
+	pendingSelect string
+
+	// Text filter to apply once beans are loaded (restored state)
+	pendingFilter string
+
+	// ID of the bean in focus (see beans focus), highlighted in the list
+	focusID string
+
+	// Last built tree, reused while the bean set is unchanged
+	cache *treeCache
+}
+
+// treeCache holds the last result of loadBeans, so reloads that happen without
+// any change to the bean set (same core generation and filter) skip querying
+// and rebuilding the tree. Shared between copies of the list model.
+type treeCache struct {
+	mu         sync.Mutex
+	valid      bool
+	generation uint64
+	tagFilter  string
+	msg        beansLoadedMsg
 }
 
 func newListModel(resolver *graph.Resolver, cfg *config.Config) listModel {
@@ -129,6 +181,7 @@ func newListModel(resolver *graph.Resolver, cfg *config.Config) listModel {
 	delegate := itemDelegate{cfg: cfg, selectedBeans: &selectedBeans}
 
 	l := list.New([]list.Item{}, delegate, 0, 0)
+	plainPagination(&l)
 	l.Title = "Beans"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
@@ -143,6 +196,7 @@ func newListModel(resolver *graph.Resolver, cfg *config.Config) listModel {
 		resolver:      resolver,
 		config:        cfg,
 		selectedBeans: selectedBeans,
+		cache:         &treeCache{},
 	}
 }
 
@@ -150,6 +204,7 @@ func newListModel(resolver *graph.Resolver, cfg *config.Config) listModel {
 type beansLoadedMsg struct {
 	items      []ui.FlatItem // flattened tree items
 	idColWidth int           // calculated ID column width for tree
+	focusID    string        // ID of the bean in focus
 }
 
 // errMsg is sent when an error occurs
@@ -167,6 +222,24 @@ func (m listModel) Init() tea.Cmd {
 }
 
 func (m listModel) loadBeans() tea.Msg {
+	// The focus lives outside the beans, so it's read on every load
+	var focusID string
+	if state, err := m.resolver.Core.LoadState(); err == nil {
+		focusID = state.Focus
+	}
+
+	// Reuse the last tree if nothing changed since it was built
+	generation := m.resolver.Core.Generation()
+	if m.cache != nil {
+		m.cache.mu.Lock()
+		defer m.cache.mu.Unlock()
+		if m.cache.valid && m.cache.generation == generation && m.cache.tagFilter == m.tagFilter {
+			msg := m.cache.msg
+			msg.focusID = focusID
+			return msg
+		}
+	}
+
 	// Build filter if tag filter is set
 	var filter *model.BeanFilter
 	if m.tagFilter != "" {
@@ -174,13 +247,13 @@ func (m listModel) loadBeans() tea.Msg {
 	}
 
 	// Query filtered beans
-	filteredBeans, err := m.resolver.Query().Beans(context.Background(), filter)
+	filteredBeans, err := m.resolver.Query().Beans(context.Background(), filter, nil)
 	if err != nil {
 		return errMsg{err}
 	}
 
 	// Query all beans for tree context (ancestors)
-	allBeans, err := m.resolver.Query().Beans(context.Background(), nil)
+	allBeans, err := m.resolver.Query().Beans(context.Background(), nil, nil)
 	if err != nil {
 		return errMsg{err}
 	}
@@ -208,7 +281,14 @@ func (m listModel) loadBeans() tea.Msg {
 		idColWidth += maxDepth * 3 // 3 chars per depth level (├─ + space)
 	}
 
-	return beansLoadedMsg{items: items, idColWidth: idColWidth}
+	msg := beansLoadedMsg{items: items, idColWidth: idColWidth, focusID: focusID}
+	if m.cache != nil {
+		m.cache.valid = true
+		m.cache.generation = generation
+		m.cache.tagFilter = m.tagFilter
+		m.cache.msg = msg
+	}
+	return msg
 }
 
 // setTagFilter sets the tag filter
@@ -259,7 +339,21 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 			}
 		}
 		m.list.SetItems(items)
+		if m.pendingFilter != "" {
+			m.list.SetFilterText(m.pendingFilter)
+			m.pendingFilter = ""
+		}
+		if m.pendingSelect != "" {
+			for i, item := range m.list.VisibleItems() {
+				if item.(beanItem).bean.ID == m.pendingSelect {
+					m.list.Select(i)
+					break
+				}
+			}
+			m.pendingSelect = ""
+		}
 		m.idColWidth = msg.idColWidth
+		m.focusID = msg.focusID
 		// Calculate responsive columns based on hasTags and width
 		m.cols = ui.CalculateResponsiveColumns(m.width, m.hasTags)
 		m.updateDelegate()
@@ -322,6 +416,20 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 						}
 					}
 				}
+			case "S", "shift+right":
+				// Advance the selected bean(s) to the next status
+				ids := make([]string, 0, len(m.selectedBeans))
+				for id := range m.selectedBeans {
+					ids = append(ids, id)
+				}
+				if len(ids) == 0 {
+					if item, ok := m.list.SelectedItem().(beanItem); ok {
+						ids = append(ids, item.bean.ID)
+					}
+				}
+				if len(ids) > 0 {
+					return m, func() tea.Msg { return advanceStatusMsg{beanIDs: ids} }
+				}
 			case "s":
 				// Open status picker for selected bean(s)
 				if len(m.selectedBeans) > 0 {
@@ -346,6 +454,13 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 					}
 				}
 			case "t":
+				// Open tag editor for the bean under the cursor
+				if item, ok := m.list.SelectedItem().(beanItem); ok {
+					return m, func() tea.Msg {
+						return openTagEditorMsg{beanID: item.bean.ID, beanTitle: item.bean.Title}
+					}
+				}
+			case "T":
 				// Open type picker for selected bean(s)
 				if len(m.selectedBeans) > 0 {
 					// Multi-select mode
@@ -402,6 +517,29 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 						}
 					}
 				}
+			case "x":
+				// Open checklist picker for selected bean
+				if item, ok := m.list.SelectedItem().(beanItem); ok {
+					return m, func() tea.Msg {
+						return openChecklistPickerMsg{beanID: item.bean.ID, beanTitle: item.bean.Title}
+					}
+				}
+			case "f":
+				// Focus on the selected bean, or clear the focus if it's already focused
+				if item, ok := m.list.SelectedItem().(beanItem); ok {
+					id := item.bean.ID
+					if id == m.focusID {
+						id = ""
+					}
+					return m, func() tea.Msg {
+						return setFocusMsg{beanID: id}
+					}
+				}
+			case "v":
+				// Switch to the board view
+				return m, func() tea.Msg {
+					return openBoardMsg{}
+				}
 			case "c":
 				// Open create modal
 				return m, func() tea.Msg {
@@ -434,6 +572,26 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 						return copyBeanIDMsg{ids: []string{item.bean.ID}}
 					}
 				}
+			case "J", "K":
+				// Move the selected bean down/up among its siblings
+				if item, ok := m.list.SelectedItem().(beanItem); ok {
+					delta := 1
+					if msg.String() == "K" {
+						delta = -1
+					}
+					var beans []*bean.Bean
+					for _, it := range m.list.Items() {
+						if bi, ok := it.(beanItem); ok {
+							beans = append(beans, bi.bean)
+						}
+					}
+					if reorder, ok := reorderSiblings(beans, item.bean.ID, delta); ok {
+						return m, func() tea.Msg {
+							return reorder
+						}
+					}
+				}
+				return m, nil
 			case "esc", "backspace":
 				// First clear selection if any beans are selected
 				if len(m.selectedBeans) > 0 {
@@ -468,6 +626,64 @@ func (m listModel) Update(msg tea.Msg) (listModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// reorderSiblings builds the request to move a bean delta positions among its
+// siblings, i.e. displayed beans (in display order) with the same parent and
+// status. If all
+// siblings are ranked, the bean gets a rank between its new neighbours;
+// otherwise the whole sibling group is ranked in its new order. Returns false
+// if the bean can't move in that direction.
+func reorderSiblings(beans []*bean.Bean, id string, delta int) (reorderBeanMsg, bool) {
+	var current *bean.Bean
+	for _, b := range beans {
+		if b.ID == id {
+			current = b
+			break
+		}
+	}
+	if current == nil {
+		return reorderBeanMsg{}, false
+	}
+
+	var siblings []*bean.Bean
+	pos := -1
+	allRanked := true
+	for _, b := range beans {
+		if b.Parent != current.Parent || b.Status != current.Status {
+			continue
+		}
+		if b.ID == id {
+			pos = len(siblings)
+		}
+		if !bean.IsValidRank(b.Rank) {
+			allRanked = false
+		}
+		siblings = append(siblings, b)
+	}
+	target := pos + delta
+	if target < 0 || target >= len(siblings) {
+		return reorderBeanMsg{}, false
+	}
+
+	msg := reorderBeanMsg{beanID: id}
+	if !allRanked {
+		siblings[pos], siblings[target] = siblings[target], siblings[pos]
+		for _, b := range siblings {
+			msg.order = append(msg.order, b.ID)
+		}
+		return msg, true
+	}
+
+	// Neighbours at the new position, skipping the bean itself
+	rest := append(append([]*bean.Bean{}, siblings[:pos]...), siblings[pos+1:]...)
+	if target > 0 {
+		msg.afterID = rest[target-1].ID
+	}
+	if target < len(rest) {
+		msg.beforeID = rest[target].ID
+	}
+	return msg, true
+}
+
 // updateDelegate updates the list delegate with current responsive columns
 func (m *listModel) updateDelegate() {
 	delegate := itemDelegate{
@@ -477,6 +693,7 @@ func (m *listModel) updateDelegate() {
 		cols:          m.cols,
 		idColWidth:    m.idColWidth,
 		selectedBeans: &m.selectedBeans,
+		focusID:       m.focusID,
 	}
 	m.list.SetDelegate(delegate)
 }
@@ -505,7 +722,7 @@ func (m listModel) View() string {
 // innerHeight is the content height inside the border (not including border lines).
 func (m listModel) viewContent(innerHeight int) string {
 	border := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorMuted).
 		Width(m.width - 2).
 		Height(innerHeight)
@@ -528,8 +745,8 @@ func (m listModel) Footer() string {
 		// When beans are selected, show esc to clear selection
 		help = helpKeyStyle.Render("space") + " " + helpStyle.Render("toggle") + "  " +
 			helpKeyStyle.Render("P") + " " + helpStyle.Render("priority") + "  " +
-			helpKeyStyle.Render("s") + " " + helpStyle.Render("status") + "  " +
-			helpKeyStyle.Render("t") + " " + helpStyle.Render("type") + "  " +
+			helpKeyStyle.Render("s/S") + " " + helpStyle.Render("status/next") + "  " +
+			helpKeyStyle.Render("t/T") + " " + helpStyle.Render("tags/type") + "  " +
 			helpKeyStyle.Render("y") + " " + helpStyle.Render("copy id") + "  " +
 			helpKeyStyle.Render("esc") + " " + helpStyle.Render("clear selection") + "  " +
 			helpKeyStyle.Render("?") + " " + helpStyle.Render("help") + "  " +
@@ -542,8 +759,9 @@ func (m listModel) Footer() string {
 			helpKeyStyle.Render("e") + " " + helpStyle.Render("edit") + "  " +
 			helpKeyStyle.Render("p") + " " + helpStyle.Render("parent") + "  " +
 			helpKeyStyle.Render("P") + " " + helpStyle.Render("priority") + "  " +
-			helpKeyStyle.Render("s") + " " + helpStyle.Render("status") + "  " +
-			helpKeyStyle.Render("t") + " " + helpStyle.Render("type") + "  " +
+			helpKeyStyle.Render("s/S") + " " + helpStyle.Render("status/next") + "  " +
+			helpKeyStyle.Render("t/T") + " " + helpStyle.Render("tags/type") + "  " +
+			helpKeyStyle.Render("J/K") + " " + helpStyle.Render("reorder") + "  " +
 			helpKeyStyle.Render("y") + " " + helpStyle.Render("copy id") + "  " +
 			helpKeyStyle.Render("esc") + " " + helpStyle.Render("clear filter") + "  " +
 			helpKeyStyle.Render("?") + " " + helpStyle.Render("help") + "  " +
@@ -556,9 +774,11 @@ func (m listModel) Footer() string {
 			helpKeyStyle.Render("e") + " " + helpStyle.Render("edit") + "  " +
 			helpKeyStyle.Render("p") + " " + helpStyle.Render("parent") + "  " +
 			helpKeyStyle.Render("P") + " " + helpStyle.Render("priority") + "  " +
-			helpKeyStyle.Render("s") + " " + helpStyle.Render("status") + "  " +
-			helpKeyStyle.Render("t") + " " + helpStyle.Render("type") + "  " +
+			helpKeyStyle.Render("s/S") + " " + helpStyle.Render("status/next") + "  " +
+			helpKeyStyle.Render("t/T") + " " + helpStyle.Render("tags/type") + "  " +
+			helpKeyStyle.Render("J/K") + " " + helpStyle.Render("reorder") + "  " +
 			helpKeyStyle.Render("y") + " " + helpStyle.Render("copy id") + "  " +
+			helpKeyStyle.Render("v") + " " + helpStyle.Render("board") + "  " +
 			helpKeyStyle.Render("/") + " " + helpStyle.Render("filter") + "  " +
 			helpKeyStyle.Render("?") + " " + helpStyle.Render("help") + "  " +
 			helpKeyStyle.Render("q") + " " + helpStyle.Render("quit")
@@ -1,9 +1,16 @@
 package tui
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/ui"
 )
 
 func TestSortBeans(t *testing.T) {
@@ -195,6 +202,20 @@ func TestCompareBeansByStatusPriorityAndType(t *testing.T) {
 		}
 	})
 
+	t.Run("compares by rank within same status", func(t *testing.T) {
+		a := &bean.Bean{ID: "1", Status: "todo", Type: "task", Priority: "critical", Title: "A"}
+		b := &bean.Bean{ID: "2", Status: "todo", Type: "task", Priority: "low", Title: "B", Rank: "m"}
+
+		// Ranked beans come before unranked ones, regardless of priority
+		if !compareBeansByStatusPriorityAndType(b, a, statusNames, priorityNames, typeNames) {
+			t.Error("ranked bean should come before unranked bean")
+		}
+		a.Rank = "n"
+		if !compareBeansByStatusPriorityAndType(b, a, statusNames, priorityNames, typeNames) {
+			t.Error("lower rank should come first")
+		}
+	})
+
 	t.Run("compares by priority within same status", func(t *testing.T) {
 		a := &bean.Bean{ID: "1", Status: "todo", Type: "task", Priority: "low", Title: "A"}
 		b := &bean.Bean{ID: "2", Status: "todo", Type: "task", Priority: "high", Title: "B"}
@@ -281,3 +302,121 @@ func TestCompareBeansByStatusPriorityAndType(t *testing.T) {
 		}
 	})
 }
+
+func TestReorderSiblings(t *testing.T) {
+	beansOf := func(beans ...*bean.Bean) []*bean.Bean { return beans }
+	epic := &bean.Bean{ID: "epic", Status: "todo"}
+
+	t.Run("ranked siblings get a rank between neighbours", func(t *testing.T) {
+		items := beansOf(epic,
+			&bean.Bean{ID: "a", Status: "todo", Parent: "epic", Rank: "a"},
+			&bean.Bean{ID: "other", Status: "completed", Parent: "epic"},
+			&bean.Bean{ID: "b", Status: "todo", Parent: "epic", Rank: "b"},
+			&bean.Bean{ID: "c", Status: "todo", Parent: "epic", Rank: "c"},
+		)
+
+		tests := []struct {
+			id            string
+			delta         int
+			after, before string
+		}{
+			{"a", 1, "b", "c"},
+			{"b", 1, "c", ""},
+			{"b", -1, "", "a"},
+			{"c", -1, "a", "b"},
+		}
+		for _, tt := range tests {
+			msg, ok := reorderSiblings(items, tt.id, tt.delta)
+			if !ok {
+				t.Fatalf("reorderSiblings(%s, %d) not ok", tt.id, tt.delta)
+			}
+			if msg.afterID != tt.after || msg.beforeID != tt.before || msg.order != nil {
+				t.Errorf("reorderSiblings(%s, %d) = %+v, want after %q before %q", tt.id, tt.delta, msg, tt.after, tt.before)
+			}
+		}
+	})
+
+	t.Run("unranked siblings are ranked in new order", func(t *testing.T) {
+		items := beansOf(epic,
+			&bean.Bean{ID: "a", Status: "todo", Parent: "epic", Rank: "a"},
+			&bean.Bean{ID: "b", Status: "todo", Parent: "epic"},
+			&bean.Bean{ID: "c", Status: "todo", Parent: "epic"},
+		)
+
+		msg, ok := reorderSiblings(items, "c", -1)
+		if !ok {
+			t.Fatal("reorderSiblings() not ok")
+		}
+		want := []string{"a", "c", "b"}
+		if len(msg.order) != len(want) {
+			t.Fatalf("order = %v, want %v", msg.order, want)
+		}
+		for i := range want {
+			if msg.order[i] != want[i] {
+				t.Errorf("order = %v, want %v", msg.order, want)
+				break
+			}
+		}
+	})
+
+	t.Run("cannot move past the ends", func(t *testing.T) {
+		items := beansOf(epic,
+			&bean.Bean{ID: "a", Status: "todo", Parent: "epic"},
+			&bean.Bean{ID: "b", Status: "todo", Parent: "epic"},
+		)
+		if _, ok := reorderSiblings(items, "a", -1); ok {
+			t.Error("moving first sibling up should not be possible")
+		}
+		if _, ok := reorderSiblings(items, "b", 1); ok {
+			t.Error("moving last sibling down should not be possible")
+		}
+		if _, ok := reorderSiblings(items, "epic", 1); ok {
+			t.Error("moving a bean without siblings should not be possible")
+		}
+	})
+}
+
+func TestLoadBeansCache(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create .beans dir: %v", err)
+	}
+	cfg := config.Default()
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if err := core.Create(&bean.Bean{ID: "one", Slug: "one", Title: "One", Status: "todo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	m := newListModel(&graph.Resolver{Core: core}, cfg)
+
+	itemsOf := func(msg tea.Msg) []ui.FlatItem {
+		loaded, ok := msg.(beansLoadedMsg)
+		if !ok {
+			t.Fatalf("loadBeans() = %T, want beansLoadedMsg", msg)
+		}
+		return loaded.items
+	}
+
+	first := itemsOf(m.loadBeans())
+	second := itemsOf(m.loadBeans())
+	if len(first) != 1 || &first[0] != &second[0] {
+		t.Error("unchanged bean set should reuse the cached tree")
+	}
+
+	// Changing the filter rebuilds the tree
+	m.setTagFilter("nope")
+	if items := itemsOf(m.loadBeans()); len(items) != 0 {
+		t.Errorf("filtered items = %d, want 0", len(items))
+	}
+	m.clearFilter()
+
+	// Changing the bean set rebuilds the tree
+	if err := core.Create(&bean.Bean{ID: "two", Slug: "two", Title: "Two", Status: "todo"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if items := itemsOf(m.loadBeans()); len(items) != 2 {
+		t.Errorf("items after create = %d, want 2", len(items))
+	}
+}
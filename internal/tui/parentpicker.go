@@ -55,7 +55,7 @@ func (d parentItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return ni
 func (d parentItemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
 	var cursor string
 	if index == m.Index() {
-		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
 	} else {
 		cursor = "  "
 	}
@@ -106,7 +106,7 @@ func newParentPickerModel(beanIDs []string, beanTitle string, beanTypes []string
 	}
 
 	// Fetch all beans and filter to eligible parents
-	allBeans, _ := resolver.Query().Beans(context.Background(), nil)
+	allBeans, _ := resolver.Query().Beans(context.Background(), nil, nil)
 
 	// Collect all descendants of all selected beans (to prevent cycles)
 	allDescendants := make(map[string]bool)
@@ -189,6 +189,7 @@ func newParentPickerModel(beanIDs []string, beanTitle string, beanTypes []string
 	listHeight := modalHeight - 7 // border (2) + subtitle (1) + help (1) + padding (3)
 
 	l := list.New(items, delegate, listWidth, listHeight)
+	plainPagination(&l)
 	l.Title = "Select Parent"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
@@ -88,7 +88,7 @@ func (m previewModel) renderBean() string {
 
 	// Border - use exact height to prevent overflow
 	borderStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorMuted).
 		Padding(0, 1).
 		Width(m.width - 2).
@@ -54,7 +54,7 @@ func (d priorityItemDelegate) Render(w io.Writer, m list.Model, index int, listI
 
 	var cursor string
 	if index == m.Index() {
-		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
 	} else {
 		cursor = "  "
 	}
@@ -112,6 +112,7 @@ func newPriorityPickerModel(beanIDs []string, beanTitle, currentPriority string,
 	listHeight := modalHeight - 7
 
 	l := list.New(items, delegate, listWidth, listHeight)
+	plainPagination(&l)
 	l.Title = "Select Priority"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
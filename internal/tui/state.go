@@ -0,0 +1,62 @@
+package tui
+
+import "github.com/hmans/beans/internal/beancore"
+
+// restoreState puts the TUI back where it was last quit (see
+// beancore.TUIState): in the same view, on the same bean, with the same
+// filters and board lanes.
+func (a *App) restoreState() {
+	state, err := a.core.LoadState()
+	if err != nil {
+		return
+	}
+	s := state.TUI
+	a.list.tagFilter = s.TagFilter
+	a.list.pendingFilter = s.Filter
+	a.list.pendingSelect = s.Selected
+	a.board.grouping = parseGrouping(s.Lanes)
+	if s.View == "board" {
+		a.state = viewBoard
+		a.baseView = viewBoard
+		a.board.pendingSelect = s.Selected
+	}
+}
+
+// tuiState returns the TUI's current view, cursor and filters. The bean open
+// in the detail view counts as selected.
+func (a *App) tuiState() beancore.TUIState {
+	s := beancore.TUIState{
+		View:      "list",
+		TagFilter: a.list.tagFilter,
+		Lanes:     a.board.grouping.String(),
+	}
+	if a.board.grouping == groupNone {
+		s.Lanes = ""
+	}
+	if a.list.list.IsFiltered() {
+		s.Filter = a.list.list.FilterValue()
+	}
+
+	if a.baseView == viewBoard {
+		s.View = "board"
+		if b := a.board.selected(); b != nil {
+			s.Selected = b.ID
+		}
+	} else if item, ok := a.list.list.SelectedItem().(beanItem); ok {
+		s.Selected = item.bean.ID
+	}
+	if a.state == viewDetail && a.detail.bean != nil {
+		s.Selected = a.detail.bean.ID
+	}
+	return s
+}
+
+// saveState records the TUI's state for its next launch.
+func (a *App) saveState() error {
+	state, err := a.core.LoadState()
+	if err != nil {
+		return err
+	}
+	state.TUI = a.tuiState()
+	return a.core.SaveState(state)
+}
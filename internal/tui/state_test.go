@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestStateRoundTrip(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create .beans dir: %v", err)
+	}
+	cfg := config.Default()
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	for _, b := range []*bean.Bean{
+		{ID: "one", Title: "One", Status: "todo", Tags: []string{"ui"}},
+		{ID: "two", Title: "Two", Status: "in-progress", Tags: []string{"ui"}},
+	} {
+		if err := core.Create(b); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	if _, err := core.SetFocus("one"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Leave the app on the board, grouped by type, on "two"
+	app := New(core, cfg)
+	app.list.setTagFilter("ui")
+	app.board.grouping = groupType
+	app.baseView, app.state = viewBoard, viewBoard
+	app.board, _ = app.board.Update(app.board.loadBeans())
+	for range app.board.columns {
+		if b := app.board.selected(); b != nil && b.ID == "two" {
+			break
+		}
+		app.board, _ = app.board.Update(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	if err := app.saveState(); err != nil {
+		t.Fatalf("saveState() error = %v", err)
+	}
+
+	state, err := core.LoadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := beancore.TUIState{View: "board", Selected: "two", TagFilter: "ui", Lanes: "type"}
+	if state.TUI != want || state.Focus != "one" {
+		t.Errorf("saved state = %+v, focus %q; want %+v, focus kept", state.TUI, state.Focus, want)
+	}
+
+	// A new app opens where the last one was left
+	restored := New(core, cfg)
+	restored.restoreState()
+	if restored.state != viewBoard || restored.list.tagFilter != "ui" || restored.board.grouping != groupType {
+		t.Errorf("restored view %v, tag filter %q, lanes %v", restored.state, restored.list.tagFilter, restored.board.grouping)
+	}
+	restored.board, _ = restored.board.Update(restored.board.loadBeans())
+	if b := restored.board.selected(); b == nil || b.ID != "two" {
+		t.Errorf("restored board cursor on %v, want two", b)
+	}
+}
@@ -55,7 +55,7 @@ func (d statusItemDelegate) Render(w io.Writer, m list.Model, index int, listIte
 
 	var cursor string
 	if index == m.Index() {
-		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
 	} else {
 		cursor = "  "
 	}
@@ -113,6 +113,7 @@ func newStatusPickerModel(beanIDs []string, beanTitle, currentStatus string, cfg
 	listHeight := modalHeight - 7
 
 	l := list.New(items, delegate, listWidth, listHeight)
+	plainPagination(&l)
 	l.Title = "Select Status"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
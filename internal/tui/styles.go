@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/paginator"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/internal/ui"
 )
@@ -27,3 +29,11 @@ var (
 			Foreground(ui.ColorPrimary).
 			Bold(true)
 )
+
+// plainPagination shows a list's pages as numbers instead of dots in
+// accessible mode.
+func plainPagination(l *list.Model) {
+	if ui.Accessible() {
+		l.Paginator.Type = paginator.Arabic
+	}
+}
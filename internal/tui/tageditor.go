@@ -0,0 +1,247 @@
+package tui
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/graph"
+	"github.com/hmans/beans/internal/graph/model"
+	"github.com/hmans/beans/internal/ui"
+)
+
+// tagEditorRows is how many matching tags the tag editor shows at once
+const tagEditorRows = 8
+
+// openTagEditorMsg requests opening the tag editor for a bean
+type openTagEditorMsg struct {
+	beanID    string
+	beanTitle string
+}
+
+// closeTagEditorMsg is sent when the tag editor is closed
+type closeTagEditorMsg struct {
+	beanID string
+}
+
+// tagEditorModel lets the user add and remove a bean's tags. Typing narrows
+// the bean's tags and the ones used elsewhere in the repository; toggling a
+// tag saves the bean right away, and the editor stays open until closed.
+type tagEditorModel struct {
+	input     textinput.Model
+	beanID    string
+	beanTitle string
+	tags      []string // the bean's tags
+	known     []string // tags used in the repository, most used first
+	matches   []string
+	cursor    int
+	resolver  *graph.Resolver
+	err       error
+	width     int
+	height    int
+}
+
+func newTagEditorModel(b *bean.Bean, known []tagWithCount, resolver *graph.Resolver, width, height int) tagEditorModel {
+	ti := textinput.New()
+	ti.Placeholder = "Type a tag..."
+	ti.CharLimit = 100
+	ti.Width = 40
+	ti.Focus()
+	ti.PromptStyle = lipgloss.NewStyle().Foreground(ui.ColorPrimary)
+	ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(ui.ColorMuted)
+	ti.Prompt = "# "
+
+	sort.Slice(known, func(i, j int) bool {
+		if known[i].count != known[j].count {
+			return known[i].count > known[j].count
+		}
+		return known[i].tag < known[j].tag
+	})
+	var tags []string
+	for _, t := range known {
+		// Namespace entries ("area/*") only make sense as filters
+		if !strings.HasSuffix(t.tag, bean.TagNamespaceSeparator+"*") {
+			tags = append(tags, t.tag)
+		}
+	}
+
+	m := tagEditorModel{
+		input:     ti,
+		beanID:    b.ID,
+		beanTitle: b.Title,
+		tags:      slices.Clone(b.Tags),
+		known:     tags,
+		resolver:  resolver,
+		width:     width,
+		height:    height,
+	}
+	m.updateMatches()
+	return m
+}
+
+// updateMatches lists the tags matching the input: the bean's own first,
+// then the repository's. A tag that doesn't exist yet heads the list, so
+// enter adds it.
+func (m *tagEditorModel) updateMatches() {
+	query := bean.NormalizeTag(m.input.Value())
+	m.matches = nil
+	for _, tag := range append(slices.Sorted(slices.Values(m.tags)), m.known...) {
+		if strings.Contains(tag, query) && !slices.Contains(m.matches, tag) {
+			m.matches = append(m.matches, tag)
+		}
+	}
+	if query != "" && !slices.Contains(m.matches, query) {
+		m.matches = slices.Insert(m.matches, 0, query)
+	}
+	m.cursor = min(m.cursor, max(len(m.matches)-1, 0))
+}
+
+// toggle adds the tag to the bean, or removes it if the bean has it, and
+// saves the bean.
+func (m *tagEditorModel) toggle(tag string) {
+	tags := slices.Clone(m.tags)
+	if i := slices.Index(tags, tag); i >= 0 {
+		tags = slices.Delete(tags, i, i+1)
+	} else {
+		if err := bean.ValidateTag(tag); err != nil {
+			m.err = err
+			return
+		}
+		tags = append(tags, tag)
+	}
+
+	b, err := m.resolver.Mutation().UpdateBean(context.Background(), m.beanID, model.UpdateBeanInput{Tags: tags})
+	m.err = err
+	if err != nil {
+		return
+	}
+	m.tags = slices.Clone(b.Tags)
+	if !slices.Contains(m.known, tag) {
+		m.known = append(m.known, tag)
+	}
+	m.input.SetValue("")
+	m.cursor = 0
+	m.updateMatches()
+}
+
+func (m tagEditorModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m tagEditorModel) Update(msg tea.Msg) (tagEditorModel, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter":
+			if m.cursor < len(m.matches) {
+				m.toggle(m.matches[m.cursor])
+			}
+			return m, nil
+		case "tab":
+			// Complete the input to the highlighted tag
+			if m.cursor < len(m.matches) {
+				m.input.SetValue(m.matches[m.cursor])
+				m.input.CursorEnd()
+				m.updateMatches()
+			}
+			return m, nil
+		case "up", "ctrl+p":
+			m.cursor = max(m.cursor-1, 0)
+			return m, nil
+		case "down", "ctrl+n":
+			m.cursor = min(m.cursor+1, max(len(m.matches)-1, 0))
+			return m, nil
+		case "esc":
+			return m, func() tea.Msg {
+				return closeTagEditorMsg{beanID: m.beanID}
+			}
+		}
+	}
+
+	before := m.input.Value()
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != before {
+		m.err = nil
+		m.cursor = 0
+		m.updateMatches()
+	}
+	return m, cmd
+}
+
+func (m tagEditorModel) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	modalWidth := max(40, min(60, m.width*50/100))
+
+	titleWidth := modalWidth - 4
+	beanTitle := truncateText(m.beanTitle, titleWidth)
+	header := lipgloss.NewStyle().Bold(true).Render(beanTitle)
+	subtitle := ui.Muted.Render(m.beanID)
+
+	// Keep the cursor in the visible window of matches
+	start := max(0, m.cursor-tagEditorRows+1)
+	end := min(len(m.matches), start+tagEditorRows)
+
+	var rows []string
+	for i := start; i < end; i++ {
+		tag := m.matches[i]
+		cursor := "  "
+		if i == m.cursor {
+			cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
+		}
+		box := "[ ] "
+		if slices.Contains(m.tags, tag) {
+			box = ui.Success.Render("[x] ")
+		}
+		label := ui.RenderTag(tag)
+		if !slices.Contains(m.tags, tag) && !slices.Contains(m.known, tag) {
+			label += ui.Muted.Render(" (new)")
+		}
+		rows = append(rows, cursor+box+label)
+	}
+	if len(rows) == 0 {
+		rows = append(rows, ui.Muted.Render("No tags yet; type one to add it"))
+	}
+
+	var description string
+	if m.err != nil {
+		description = ui.Danger.Render(m.err.Error())
+	} else {
+		description = ui.Muted.Render(strings.Join(m.tags, ", "))
+	}
+
+	help := helpKeyStyle.Render("enter") + " " + helpStyle.Render("add/remove") + "  " +
+		helpKeyStyle.Render("tab") + " " + helpStyle.Render("complete") + "  " +
+		helpKeyStyle.Render("esc") + " " + helpStyle.Render("close")
+
+	border := lipgloss.NewStyle().
+		Border(ui.Border()).
+		BorderForeground(ui.ColorPrimary).
+		Padding(0, 1).
+		Width(modalWidth)
+
+	content := header + "\n" + subtitle + "\n\n" + m.input.View() + "\n\n" + strings.Join(rows, "\n")
+	if description != "" {
+		content += "\n\n" + description
+	}
+	return border.Render(content + "\n\n" + help)
+}
+
+// ModalView returns the tag editor rendered as a centered modal overlay on top of the background
+func (m tagEditorModel) ModalView(bgView string, fullWidth, fullHeight int) string {
+	modal := m.View()
+	return overlayModal(bgView, modal, fullWidth, fullHeight)
+}
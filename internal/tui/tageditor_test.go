@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+	"github.com/hmans/beans/internal/graph"
+)
+
+func TestTagEditor(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create .beans dir: %v", err)
+	}
+	core := beancore.New(beansDir, config.Default())
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	b := &bean.Bean{ID: "one", Slug: "one", Title: "One", Status: "todo", Tags: []string{"backend"}}
+	if err := core.Create(b); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	known := []tagWithCount{{tag: "backend", count: 1}, {tag: "frontend", count: 3}, {tag: "area/*", count: 1}}
+
+	m := newTagEditorModel(b, known, &graph.Resolver{Core: core}, 100, 40)
+	if want := []string{"backend", "frontend"}; !slices.Equal(m.matches, want) {
+		t.Fatalf("matches = %v, want %v", m.matches, want)
+	}
+	tags := func() []string {
+		b, err := core.Get("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b.Tags
+	}
+	typeText := func(s string) {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+	}
+	press := func(k tea.KeyType) {
+		m, _ = m.Update(tea.KeyMsg{Type: k})
+	}
+
+	// Typing narrows the matches; enter adds the highlighted tag
+	typeText("fro")
+	if want := []string{"fro", "frontend"}; !slices.Equal(m.matches, want) {
+		t.Errorf("matches for %q = %v, want %v", "fro", m.matches, want)
+	}
+	press(tea.KeyDown)
+	press(tea.KeyEnter)
+	if got := tags(); !slices.Equal(got, []string{"backend", "frontend"}) {
+		t.Errorf("tags after adding = %v", got)
+	}
+
+	// Enter on a tag the bean has removes it
+	press(tea.KeyEnter)
+	if got := tags(); !slices.Equal(got, []string{"frontend"}) {
+		t.Errorf("tags after removing = %v", got)
+	}
+
+	// New tags are added once valid
+	typeText("Needs Review")
+	press(tea.KeyEnter)
+	if m.err == nil {
+		t.Error("expected an error adding an invalid tag")
+	}
+	if got := tags(); !slices.Equal(got, []string{"frontend"}) {
+		t.Errorf("tags after an invalid tag = %v", got)
+	}
+}
+
+func TestTagEditorLongTitle(t *testing.T) {
+	b := &bean.Bean{ID: "one", Title: strings.Repeat("日本語のタイトル", 20), Status: "todo"}
+	for width := 80; width <= 120; width++ {
+		m := newTagEditorModel(b, nil, nil, width, 40)
+		if view := m.View(); !utf8.ValidString(view) {
+			t.Errorf("View() at width %d cut a multibyte character: %q", width, view)
+		}
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/ui"
 )
 
@@ -17,10 +19,13 @@ type tagWithCount struct {
 	count int
 }
 
-// tagItem wraps a tag with count to implement list.Item
+// tagItem wraps a tag with count to implement list.Item. Namespace items
+// ("area/*") head the group of tags in that namespace and select all of them.
 type tagItem struct {
-	tag   string
-	count int
+	tag       string
+	count     int
+	namespace bool
+	grouped   bool
 }
 
 func (i tagItem) Title() string       { return i.tag }
@@ -42,15 +47,23 @@ func (d tagItemDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 
 	var cursor string
 	if index == m.Index() {
-		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
 	} else {
 		cursor = "  "
 	}
 
-	tagBadge := ui.RenderTag(item.tag)
+	var label string
+	switch {
+	case item.namespace:
+		label = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(item.tag)
+	case item.grouped:
+		label = "  " + ui.RenderTag(item.tag)
+	default:
+		label = ui.RenderTag(item.tag)
+	}
 	count := ui.Muted.Render(fmt.Sprintf(" (%d)", item.count))
 
-	fmt.Fprint(w, cursor+tagBadge+count)
+	fmt.Fprint(w, cursor+label+count)
 }
 
 // tagPickerModel is the model for the tag picker view
@@ -71,13 +84,10 @@ func newTagPickerModel(tags []tagWithCount, width, height int) tagPickerModel {
 	})
 
 	delegate := tagItemDelegate{}
-
-	items := make([]list.Item, len(tags))
-	for i, t := range tags {
-		items[i] = tagItem{tag: t.tag, count: t.count}
-	}
+	items := groupTagItems(tags)
 
 	l := list.New(items, delegate, width-4, height-6)
+	plainPagination(&l)
 	l.Title = "Select a Tag"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
@@ -95,6 +105,40 @@ func newTagPickerModel(tags []tagWithCount, width, height int) tagPickerModel {
 	}
 }
 
+// groupTagItems builds the picker items from sorted tags: tags without a
+// namespace first, then each namespace ("area/*") followed by its tags.
+// Namespace entries in tags provide the group counts.
+func groupTagItems(tags []tagWithCount) []list.Item {
+	var items []list.Item
+	groups := make(map[string][]tagWithCount)
+	namespaceCounts := make(map[string]int)
+	for _, t := range tags {
+		if ns, ok := strings.CutSuffix(t.tag, bean.TagNamespaceSeparator+"*"); ok {
+			namespaceCounts[ns] = t.count
+			continue
+		}
+		if ns := bean.TagNamespace(t.tag); ns != "" {
+			groups[ns] = append(groups[ns], t)
+			continue
+		}
+		items = append(items, tagItem{tag: t.tag, count: t.count})
+	}
+
+	namespaces := make([]string, 0, len(groups))
+	for ns := range groups {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	for _, ns := range namespaces {
+		items = append(items, tagItem{tag: ns + bean.TagNamespaceSeparator + "*", count: namespaceCounts[ns], namespace: true})
+		for _, t := range groups[ns] {
+			items = append(items, tagItem{tag: t.tag, count: t.count, grouped: true})
+		}
+	}
+	return items
+}
+
 func (m tagPickerModel) Init() tea.Cmd {
 	return nil
 }
@@ -137,7 +181,7 @@ func (m tagPickerModel) View() string {
 
 	// Simple bordered container
 	border := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(ui.Border()).
 		BorderForeground(ui.ColorPrimary).
 		Width(m.width - 2).
 		Height(m.height - 4)
@@ -0,0 +1,35 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestGroupTagItems(t *testing.T) {
+	tags := []tagWithCount{
+		{tag: "area/backend", count: 3},
+		{tag: "urgent", count: 2},
+		{tag: "team/core", count: 2},
+		{tag: "area/frontend", count: 1},
+		{tag: "area/*", count: 4},
+		{tag: "team/*", count: 2},
+	}
+
+	items := groupTagItems(tags)
+
+	want := []tagItem{
+		{tag: "urgent", count: 2},
+		{tag: "area/*", count: 4, namespace: true},
+		{tag: "area/backend", count: 3, grouped: true},
+		{tag: "area/frontend", count: 1, grouped: true},
+		{tag: "team/*", count: 2, namespace: true},
+		{tag: "team/core", count: 2, grouped: true},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("groupTagItems() returned %d items, want %d", len(items), len(want))
+	}
+	for i, w := range want {
+		if got := items[i].(tagItem); got != w {
+			t.Errorf("item %d = %+v, want %+v", i, got, w)
+		}
+	}
+}
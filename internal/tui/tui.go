@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/hmans/beans/internal/bean"
 	"github.com/hmans/beans/internal/beancore"
 	"github.com/hmans/beans/internal/config"
 	"github.com/hmans/beans/internal/graph"
@@ -23,6 +25,7 @@ type viewState int
 
 const (
 	viewList viewState = iota
+	viewBoard
 	viewDetail
 	viewTagPicker
 	viewParentPicker
@@ -30,6 +33,9 @@ const (
 	viewTypePicker
 	viewBlockingPicker
 	viewPriorityPicker
+	viewChecklistPicker
+	viewTagEditor
+	viewLinkPicker
 	viewCreateModal
 	viewHelpOverlay
 )
@@ -75,6 +81,34 @@ type copyBeanIDMsg struct {
 	ids []string
 }
 
+// reorderBeanMsg requests moving a bean among its siblings. If order is set,
+// those beans are ranked in that order; otherwise the bean is ranked between
+// afterID and beforeID.
+type reorderBeanMsg struct {
+	beanID   string
+	afterID  string
+	beforeID string
+	order    []string
+}
+
+// setFocusMsg requests focusing on a bean (see beans focus); an empty ID
+// clears the focus
+type setFocusMsg struct {
+	beanID string
+}
+
+// advanceStatusMsg requests moving beans to their next status in workflow
+// order (see beancore.Core.NextStatus)
+type advanceStatusMsg struct {
+	beanIDs []string
+}
+
+// statusChange is a status change that can be undone
+type statusChange struct {
+	beanID string
+	from   string
+}
+
 // openEditorMsg requests opening the editor for a bean
 type openEditorMsg struct {
 	beanID   string
@@ -96,25 +130,29 @@ type openParentPickerMsg struct {
 
 // App is the main TUI application model
 type App struct {
-	state          viewState
-	list           listModel
-	detail         detailModel
-	preview        previewModel
-	tagPicker      tagPickerModel
-	parentPicker   parentPickerModel
-	statusPicker   statusPickerModel
-	typePicker     typePickerModel
-	blockingPicker blockingPickerModel
-	priorityPicker priorityPickerModel
-	createModal    createModalModel
-	helpOverlay    helpOverlayModel
-	history        []detailModel // stack of previous detail views for back navigation
-	core           *beancore.Core
-	resolver       *graph.Resolver
-	config         *config.Config
-	width          int
-	height         int
-	program        *tea.Program // reference to program for sending messages from watcher
+	state           viewState
+	list            listModel
+	board           boardModel
+	detail          detailModel
+	preview         previewModel
+	tagPicker       tagPickerModel
+	parentPicker    parentPickerModel
+	statusPicker    statusPickerModel
+	typePicker      typePickerModel
+	blockingPicker  blockingPickerModel
+	priorityPicker  priorityPickerModel
+	checklistPicker checklistPickerModel
+	tagEditor       tagEditorModel
+	linkPicker      linkPickerModel
+	createModal     createModalModel
+	helpOverlay     helpOverlayModel
+	history         []detailModel // stack of previous detail views for back navigation
+	core            *beancore.Core
+	resolver        *graph.Resolver
+	config          *config.Config
+	width           int
+	height          int
+	program         *tea.Program // reference to program for sending messages from watcher
 
 	// Key chord state - tracks partial key sequences like "g" waiting for "t"
 	pendingKey string
@@ -122,6 +160,12 @@ type App struct {
 	// Modal state - tracks view behind modal pickers
 	previousState viewState
 
+	// Base view (list or board) that detail views return to
+	baseView viewState
+
+	// Status changes made with S, undone with u while their toast is showing
+	undo []statusChange
+
 	// Editor state - tracks bean being edited to update updated_at on save
 	editingBeanID      string
 	editingBeanModTime time.Time
@@ -136,12 +180,16 @@ func New(core *beancore.Core, cfg *config.Config) *App {
 		resolver: resolver,
 		config:   cfg,
 		list:     newListModel(resolver, cfg),
+		board:    newBoardModel(resolver, cfg),
 		preview:  newPreviewModel(nil, 0, 0),
 	}
 }
 
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
+	if a.baseView == viewBoard {
+		return tea.Batch(a.list.Init(), a.board.Init())
+	}
 	return a.list.Init()
 }
 
@@ -170,6 +218,14 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Clear status messages on any keypress
 		a.list.statusMessage = ""
 		a.detail.statusMessage = ""
+		a.board.statusMessage = ""
+
+		// u undoes the last status advance while its toast was showing
+		undo := a.undo
+		a.undo = nil
+		if msg.String() == "u" && len(undo) > 0 && a.acceptsShortcuts() {
+			return a, a.undoStatusChanges(undo)
+		}
 
 		// Handle key chord sequences
 		if a.state == viewList && a.list.list.FilterState() != 1 {
@@ -201,14 +257,14 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, tea.Quit
 		case "?":
 			// Open help overlay if not already showing it (and not in a picker/modal)
-			if a.state == viewList || a.state == viewDetail {
+			if a.state == viewList || a.state == viewBoard || a.state == viewDetail {
 				a.previousState = a.state
 				a.helpOverlay = newHelpOverlayModel(a.width, a.height)
 				a.state = viewHelpOverlay
 				return a, a.helpOverlay.Init()
 			}
 		case "q":
-			if a.state == viewDetail || a.state == viewTagPicker || a.state == viewParentPicker || a.state == viewStatusPicker || a.state == viewTypePicker || a.state == viewBlockingPicker || a.state == viewPriorityPicker || a.state == viewHelpOverlay {
+			if a.state == viewDetail || a.state == viewBoard || a.state == viewTagPicker || a.state == viewParentPicker || a.state == viewStatusPicker || a.state == viewTypePicker || a.state == viewBlockingPicker || a.state == viewPriorityPicker || a.state == viewChecklistPicker || a.state == viewLinkPicker || a.state == viewHelpOverlay {
 				return a, tea.Quit
 			}
 			// For list, only quit if not filtering
@@ -248,15 +304,67 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Try to reload the current bean via GraphQL
 			updatedBean, err := a.resolver.Query().Bean(context.Background(), a.detail.bean.ID)
 			if err != nil || updatedBean == nil {
-				// Bean was deleted - return to list
-				a.state = viewList
+				// Bean was deleted - return to list or board
+				a.state = a.baseView
 				a.history = nil
 			} else {
 				// Recreate detail view with fresh bean data
+				graphActive := a.detail.graphActive
 				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
+				a.detail.graphActive = graphActive
 			}
 		}
-		// Trigger list refresh
+		// Trigger list refresh, and board refresh if it's showing
+		if a.baseView == viewBoard {
+			return a, tea.Batch(a.list.loadBeans, a.board.loadBeans)
+		}
+		return a, a.list.loadBeans
+
+	case openBoardMsg:
+		a.state = viewBoard
+		a.baseView = viewBoard
+		a.board, _ = a.board.Update(tea.WindowSizeMsg{Width: a.width, Height: a.height})
+		return a, a.board.loadBeans
+
+	case closeBoardMsg:
+		a.state = viewList
+		a.baseView = viewList
+		a.list, cmd = a.list.Update(tea.WindowSizeMsg{Width: a.width, Height: a.height})
+		return a, cmd
+
+	case boardLoadedMsg:
+		// Handled here so the board refreshes while a picker is open over it
+		a.board, cmd = a.board.Update(msg)
+		return a, cmd
+
+	case reorderBeanMsg:
+		var err error
+		if len(msg.order) > 0 {
+			_, err = a.resolver.Mutation().RankBeans(context.Background(), msg.order)
+		} else {
+			var after, before *string
+			if msg.afterID != "" {
+				after = &msg.afterID
+			}
+			if msg.beforeID != "" {
+				before = &msg.beforeID
+			}
+			_, err = a.resolver.Mutation().ReorderBean(context.Background(), msg.beanID, after, before)
+		}
+		if err != nil {
+			if a.state == viewBoard {
+				a.board.statusMessage = fmt.Sprintf("Reorder failed: %v", err)
+			} else {
+				a.list.statusMessage = fmt.Sprintf("Reorder failed: %v", err)
+			}
+			return a, nil
+		}
+		// Keep the cursor on the moved bean after the refresh (the board
+		// keeps it there by itself)
+		a.list.pendingSelect = msg.beanID
+		if a.state == viewBoard {
+			return a, tea.Batch(a.list.loadBeans, a.board.loadBeans)
+		}
 		return a, a.list.loadBeans
 
 	case openTagPickerMsg:
@@ -304,6 +412,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.state = a.previousState
 		return a, a.list.loadBeans
 
+	case advanceStatusMsg:
+		return a, a.advanceStatus(msg.beanIDs)
+
 	case statusSelectedMsg:
 		// Update all beans' status via GraphQL mutations
 		for _, beanID := range msg.beanIDs {
@@ -405,6 +516,73 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.state = a.previousState
 		return a, nil
 
+	case openChecklistPickerMsg:
+		a.previousState = a.state
+		a.checklistPicker = newChecklistPickerModel(msg.beanID, msg.beanTitle, a.resolver, a.width, a.height)
+		a.state = viewChecklistPicker
+		return a, a.checklistPicker.Init()
+
+	case closeChecklistPickerMsg:
+		// Items were toggled in place; return to the previous view and refresh
+		a.state = a.previousState
+		if a.state == viewDetail {
+			updatedBean, _ := a.resolver.Query().Bean(context.Background(), msg.beanID)
+			if updatedBean != nil {
+				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
+			}
+		}
+		return a, a.list.loadBeans
+
+	case openTagEditorMsg:
+		b, err := a.resolver.Query().Bean(context.Background(), msg.beanID)
+		if err != nil || b == nil {
+			return a, nil
+		}
+		a.previousState = a.state
+		a.tagEditor = newTagEditorModel(b, a.collectTagsWithCounts(), a.resolver, a.width, a.height)
+		a.state = viewTagEditor
+		return a, a.tagEditor.Init()
+
+	case closeTagEditorMsg:
+		// Tags were saved as they were toggled; return to the previous view and refresh
+		a.state = a.previousState
+		if a.state == viewDetail {
+			updatedBean, _ := a.resolver.Query().Bean(context.Background(), msg.beanID)
+			if updatedBean != nil {
+				a.detail = newDetailModel(updatedBean, a.resolver, a.config, a.width, a.height)
+			}
+		}
+		return a, tea.Batch(a.list.loadBeans, a.board.loadBeans)
+
+	case openLinkPickerMsg:
+		a.previousState = a.state
+		a.linkPicker = newLinkPickerModel(msg.beanID, msg.beanTitle, a.resolver, a.config, a.width, a.height)
+		a.state = viewLinkPicker
+		return a, a.linkPicker.Init()
+
+	case closeLinkPickerMsg:
+		a.state = a.previousState
+		return a, nil
+
+	case linkSelectedMsg:
+		a.state = a.previousState
+		if err := addLink(a.resolver, msg.beanID, msg.linkType, msg.targetID); err != nil {
+			a.detail.statusMessage = fmt.Sprintf("Failed to link: %v", err)
+			return a, nil
+		}
+		return a, a.afterBeanChange(fmt.Sprintf("Linked: %s %s", linkTypeLabel(msg.linkType), msg.targetID))
+
+	case removeLinkMsg:
+		b, err := a.resolver.Query().Bean(context.Background(), msg.beanID)
+		if err == nil && b != nil {
+			err = removeLink(a.resolver, b, msg.link)
+		}
+		if err != nil {
+			a.detail.statusMessage = fmt.Sprintf("Failed to unlink: %v", err)
+			return a, nil
+		}
+		return a, a.afterBeanChange("Unlinked " + msg.link.bean.ID)
+
 	case openBlockingPickerMsg:
 		a.previousState = a.state
 		a.blockingPicker = newBlockingPickerModel(msg.beanID, msg.beanTitle, msg.currentBlocking, a.resolver, a.config, a.width, a.height)
@@ -475,7 +653,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case openEditorMsg:
 		// Launch editor for the bean file
-		editor := getEditor()
+		editor := strings.Fields(a.config.GetEditor())
 		fullPath := filepath.Join(a.core.Root(), msg.beanPath)
 
 		// Record the bean ID and file mod time before editing
@@ -484,7 +662,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.editingBeanModTime = info.ModTime()
 		}
 
-		c := exec.Command(editor, fullPath)
+		c := exec.Command(editor[0], append(editor[1:], fullPath)...)
 		return a, tea.ExecProcess(c, func(err error) tea.Msg {
 			return editorFinishedMsg{err: err}
 		})
@@ -518,7 +696,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			parentID = &msg.parentID
 		}
 		for _, beanID := range msg.beanIDs {
-			_, err := a.resolver.Mutation().SetParent(context.Background(), beanID, parentID, nil)
+			_, err := a.resolver.Mutation().SetParent(context.Background(), beanID, parentID, nil, nil)
 			if err != nil {
 				// Continue with other beans even if one fails
 				continue
@@ -537,6 +715,18 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return a, a.list.loadBeans
 
+	case setFocusMsg:
+		b, err := a.core.SetFocus(msg.beanID)
+		switch {
+		case err != nil:
+			a.list.statusMessage = fmt.Sprintf("Failed to set focus: %v", err)
+		case b != nil:
+			a.list.statusMessage = fmt.Sprintf("Focused on %s", b.ID)
+		default:
+			a.list.statusMessage = "Focus cleared"
+		}
+		return a, a.list.loadBeans
+
 	case clearFilterMsg:
 		a.list.clearFilter()
 		return a, a.list.loadBeans
@@ -562,12 +752,16 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a, nil
 
 	case selectBeanMsg:
-		// Push current detail view to history if we're already viewing a bean
+		// Push current detail view to history if we're already viewing a bean,
+		// and stay in the dependency graph when walking it
+		graphActive := false
 		if a.state == viewDetail {
 			a.history = append(a.history, a.detail)
+			graphActive = a.detail.graphActive
 		}
 		a.state = viewDetail
 		a.detail = newDetailModel(msg.bean, a.resolver, a.config, a.width, a.height)
+		a.detail.graphActive = graphActive
 		return a, a.detail.Init()
 
 	case backToListMsg:
@@ -576,6 +770,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.detail = a.history[len(a.history)-1]
 			a.history = a.history[:len(a.history)-1]
 			// Stay in viewDetail state
+		} else if a.baseView == viewBoard {
+			a.state = viewBoard
+			a.board, cmd = a.board.Update(tea.WindowSizeMsg{Width: a.width, Height: a.height})
+			return a, cmd
 		} else {
 			a.state = viewList
 			// Force list to pick up any size changes that happened while in detail view
@@ -589,6 +787,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch a.state {
 	case viewList:
 		a.list, cmd = a.list.Update(msg)
+	case viewBoard:
+		a.board, cmd = a.board.Update(msg)
 	case viewDetail:
 		a.detail, cmd = a.detail.Update(msg)
 	case viewTagPicker:
@@ -603,6 +803,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.priorityPicker, cmd = a.priorityPicker.Update(msg)
 	case viewBlockingPicker:
 		a.blockingPicker, cmd = a.blockingPicker.Update(msg)
+	case viewChecklistPicker:
+		a.checklistPicker, cmd = a.checklistPicker.Update(msg)
+	case viewTagEditor:
+		a.tagEditor, cmd = a.tagEditor.Update(msg)
+	case viewLinkPicker:
+		a.linkPicker, cmd = a.linkPicker.Update(msg)
 	case viewCreateModal:
 		a.createModal, cmd = a.createModal.Update(msg)
 	case viewHelpOverlay:
@@ -612,13 +818,104 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return a, cmd
 }
 
-// collectTagsWithCounts returns all tags with their usage counts
+// acceptsShortcuts reports whether a key press is a shortcut rather than
+// text typed into a filter or picker
+func (a *App) acceptsShortcuts() bool {
+	switch a.state {
+	case viewList:
+		return a.list.list.FilterState() != list.Filtering
+	case viewBoard:
+		return true
+	case viewDetail:
+		return a.detail.linkList.FilterState() != list.Filtering
+	}
+	return false
+}
+
+// advanceStatus moves beans to their next status, offering to undo it
+func (a *App) advanceStatus(ids []string) tea.Cmd {
+	var changes []statusChange
+	var message string
+	for _, id := range ids {
+		b, err := a.core.Get(id)
+		if err != nil {
+			continue
+		}
+		from := b.Status
+		next, err := a.core.NextStatus(b)
+		if err == nil {
+			_, err = a.resolver.Mutation().UpdateBean(context.Background(), id, model.UpdateBeanInput{Status: &next})
+		}
+		if err != nil {
+			message = err.Error()
+			continue
+		}
+		changes = append(changes, statusChange{beanID: id, from: from})
+		if len(ids) == 1 {
+			message = fmt.Sprintf("Moved %s to %s", id, next)
+		}
+	}
+	clear(a.list.selectedBeans)
+
+	if len(changes) > 0 {
+		a.undo = changes
+		if len(ids) > 1 {
+			message = fmt.Sprintf("Moved %d of %d beans to their next status", len(changes), len(ids))
+		}
+		message += " (u to undo)"
+	}
+	return a.afterBeanChange(message)
+}
+
+// undoStatusChanges puts beans back to their previous status
+func (a *App) undoStatusChanges(changes []statusChange) tea.Cmd {
+	message := fmt.Sprintf("Undid status change of %d beans", len(changes))
+	if len(changes) == 1 {
+		message = fmt.Sprintf("Moved %s back to %s", changes[0].beanID, changes[0].from)
+	}
+	for _, c := range changes {
+		if _, err := a.resolver.Mutation().UpdateBean(context.Background(), c.beanID, model.UpdateBeanInput{Status: &c.from}); err != nil {
+			message = fmt.Sprintf("Undo failed: %v", err)
+		}
+	}
+	return a.afterBeanChange(message)
+}
+
+// afterBeanChange shows message in the current view and refreshes it after
+// beans were changed in place
+func (a *App) afterBeanChange(message string) tea.Cmd {
+	switch a.state {
+	case viewBoard:
+		a.board.statusMessage = message
+		return tea.Batch(a.list.loadBeans, a.board.loadBeans)
+	case viewDetail:
+		if updated, _ := a.resolver.Query().Bean(context.Background(), a.detail.bean.ID); updated != nil {
+			graphActive := a.detail.graphActive
+			a.detail = newDetailModel(updated, a.resolver, a.config, a.width, a.height)
+			a.detail.graphActive = graphActive
+		}
+		a.detail.statusMessage = message
+	default:
+		a.list.statusMessage = message
+	}
+	return a.list.loadBeans
+}
+
+// collectTagsWithCounts returns all tags with their usage counts, plus a
+// "namespace/*" entry per tag namespace counting the beans in it
 func (a *App) collectTagsWithCounts() []tagWithCount {
-	beans, _ := a.resolver.Query().Beans(context.Background(), nil)
+	beans, _ := a.resolver.Query().Beans(context.Background(), nil, nil)
 	tagCounts := make(map[string]int)
 	for _, b := range beans {
+		namespaces := make(map[string]bool)
 		for _, tag := range b.Tags {
 			tagCounts[tag]++
+			if ns := bean.TagNamespace(tag); ns != "" {
+				namespaces[ns] = true
+			}
+		}
+		for ns := range namespaces {
+			tagCounts[ns+bean.TagNamespaceSeparator+"*"]++
 		}
 	}
 
@@ -660,6 +957,8 @@ func (a *App) View() string {
 			return a.renderTwoColumnView()
 		}
 		return a.list.View()
+	case viewBoard:
+		return a.board.View()
 	case viewDetail:
 		return a.detail.View()
 	case viewTagPicker:
@@ -674,6 +973,12 @@ func (a *App) View() string {
 		return a.priorityPicker.ModalView(a.getBackgroundView(), a.width, a.height)
 	case viewBlockingPicker:
 		return a.blockingPicker.ModalView(a.getBackgroundView(), a.width, a.height)
+	case viewChecklistPicker:
+		return a.checklistPicker.ModalView(a.getBackgroundView(), a.width, a.height)
+	case viewTagEditor:
+		return a.tagEditor.ModalView(a.getBackgroundView(), a.width, a.height)
+	case viewLinkPicker:
+		return a.linkPicker.ModalView(a.getBackgroundView(), a.width, a.height)
 	case viewCreateModal:
 		return a.createModal.ModalView(a.getBackgroundView(), a.width, a.height)
 	case viewHelpOverlay:
@@ -687,6 +992,8 @@ func (a *App) getBackgroundView() string {
 	switch a.previousState {
 	case viewList:
 		return a.list.View()
+	case viewBoard:
+		return a.board.View()
 	case viewDetail:
 		return a.detail.View()
 	default:
@@ -694,25 +1001,10 @@ func (a *App) getBackgroundView() string {
 	}
 }
 
-// getEditor returns the user's preferred editor using the fallback chain:
-// $VISUAL -> $EDITOR -> vi -> nano
-func getEditor() string {
-	if editor := os.Getenv("VISUAL"); editor != "" {
-		return editor
-	}
-	if editor := os.Getenv("EDITOR"); editor != "" {
-		return editor
-	}
-	// Fallback chain: vi is more universal, nano as last resort
-	if _, err := exec.LookPath("vi"); err == nil {
-		return "vi"
-	}
-	return "nano"
-}
-
 // Run starts the TUI application with file watching
 func Run(core *beancore.Core, cfg *config.Config) error {
 	app := New(core, cfg)
+	app.restoreState()
 	p := tea.NewProgram(app, tea.WithAltScreen())
 
 	// Store reference to program for sending messages from watcher
@@ -738,6 +1030,11 @@ func Run(core *beancore.Core, cfg *config.Config) error {
 		}
 	}()
 
-	_, err := p.Run()
-	return err
+	if _, err := p.Run(); err != nil {
+		return err
+	}
+	if err := app.saveState(); err != nil {
+		core.Logger().Warn("could not save TUI state", "error", err)
+	}
+	return nil
 }
@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hmans/beans/internal/bean"
+	"github.com/hmans/beans/internal/beancore"
+	"github.com/hmans/beans/internal/config"
+)
+
+func TestAdvanceStatus(t *testing.T) {
+	beansDir := filepath.Join(t.TempDir(), ".beans")
+	if err := os.MkdirAll(beansDir, 0755); err != nil {
+		t.Fatalf("failed to create .beans dir: %v", err)
+	}
+	cfg := config.Default()
+	core := beancore.New(beansDir, cfg)
+	if err := core.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if err := core.Create(&bean.Bean{ID: "one", Title: "One", Status: "todo"}); err != nil {
+		t.Fatal(err)
+	}
+	app := New(core, cfg)
+	status := func() string {
+		b, err := core.Get("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b.Status
+	}
+
+	app.Update(advanceStatusMsg{beanIDs: []string{"one"}})
+	if got := status(); got != "in-progress" {
+		t.Fatalf("status after S = %q, want in-progress", got)
+	}
+	if !strings.Contains(app.list.statusMessage, "u to undo") {
+		t.Errorf("status message = %q, want an undo hint", app.list.statusMessage)
+	}
+
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	if got := status(); got != "todo" {
+		t.Errorf("status after u = %q, want todo", got)
+	}
+
+	// The undo is only offered until the next key
+	app.Update(advanceStatusMsg{beanIDs: []string{"one"}})
+	app.Update(tea.KeyMsg{Type: tea.KeyDown})
+	app.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	if got := status(); got != "in-progress" {
+		t.Errorf("status after a late u = %q, want in-progress", got)
+	}
+}
@@ -54,7 +54,7 @@ func (d typeItemDelegate) Render(w io.Writer, m list.Model, index int, listItem
 
 	var cursor string
 	if index == m.Index() {
-		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render("▌") + " "
+		cursor = lipgloss.NewStyle().Foreground(ui.ColorPrimary).Bold(true).Render(ui.CursorGlyph.String()) + " "
 	} else {
 		cursor = "  "
 	}
@@ -111,6 +111,7 @@ func newTypePickerModel(beanIDs []string, beanTitle, currentType string, cfg *co
 	listHeight := modalHeight - 7
 
 	l := list.New(items, delegate, listWidth, listHeight)
+	plainPagination(&l)
 	l.Title = "Select Type"
 	l.SetShowStatusBar(false)
 	l.SetFilteringEnabled(true)
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// EnvAccessible turns on accessible mode when set to a true value (1, true).
+const EnvAccessible = "BEANS_ACCESSIBLE"
+
+// accessible is whether rendering is in accessible mode (see SetAccessible).
+var accessible bool
+
+// SetAccessible switches rendering to accessible mode, for screen readers and
+// terminals without Unicode: colors are turned off, glyphs are replaced with
+// plain text labels and boxes aren't drawn.
+func SetAccessible(on bool) {
+	accessible = on
+	if on {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+}
+
+// Accessible reports whether rendering is in accessible mode.
+func Accessible() bool {
+	return accessible
+}
+
+// Glyph is a symbol used in rendering, with a plain text stand-in for
+// accessible mode.
+type Glyph struct {
+	Symbol string
+	Plain  string
+}
+
+// String returns the symbol, or its plain stand-in in accessible mode.
+func (g Glyph) String() string {
+	if accessible {
+		return g.Plain
+	}
+	return g.Symbol
+}
+
+// Glyphs shared by the ui and tui renderers.
+var (
+	// CursorGlyph marks the selected row of a list.
+	CursorGlyph = Glyph{"▌", ">"}
+	// PointerGlyph marks the selected item of an inline list.
+	PointerGlyph = Glyph{"▸", ">"}
+	// FocusGlyph marks the bean in focus (see beans focus).
+	FocusGlyph = Glyph{"◉", "(focus)"}
+	// EllipsisGlyph marks truncated text.
+	EllipsisGlyph = Glyph{"…", "..."}
+	// SeparatorGlyph separates inline items.
+	SeparatorGlyph = Glyph{"·", "-"}
+	// RuleGlyph is repeated to draw horizontal dividers.
+	RuleGlyph = Glyph{"─", "-"}
+	// UpDownGlyph and LeftRightGlyph name the arrow keys in help texts.
+	UpDownGlyph    = Glyph{"↑/↓", "up/down"}
+	LeftRightGlyph = Glyph{"←/→", "left/right"}
+	UpGlyph        = Glyph{"↑", "up"}
+	DownGlyph      = Glyph{"↓", "down"}
+	// EdgeGlyph and ArrowGlyph draw a downward edge between graph nodes.
+	EdgeGlyph  = Glyph{"│", "|"}
+	ArrowGlyph = Glyph{"▼", "v"}
+	// BranchGlyph and LastBranchGlyph connect children to their parent.
+	BranchGlyph     = Glyph{"├─", "|-"}
+	LastBranchGlyph = Glyph{"└─", "`-"}
+)
+
+// Border returns the border to draw boxes with: rounded, or blank (keeping
+// the layout) in accessible mode.
+func Border() lipgloss.Border {
+	if accessible {
+		return lipgloss.HiddenBorder()
+	}
+	return lipgloss.RoundedBorder()
+}
+
+// MarkdownStyle returns the glamour style to render markdown with: style, or
+// the plain ASCII one in accessible mode.
+func MarkdownStyle(style string) string {
+	if accessible {
+		return "ascii"
+	}
+	return style
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// setAccessible turns on accessible mode for the rest of the test, with
+// colors on before so they can be seen to go.
+func setAccessible(t *testing.T) {
+	t.Helper()
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	SetAccessible(true)
+	t.Cleanup(func() {
+		SetAccessible(false)
+		lipgloss.SetColorProfile(profile)
+	})
+}
+
+func TestAccessibleMode(t *testing.T) {
+	if got := FocusGlyph.String(); got != "◉" {
+		t.Errorf("FocusGlyph = %q, want the symbol", got)
+	}
+	if got := GetPrioritySymbol("high"); got != "!" {
+		t.Errorf("GetPrioritySymbol(high) = %q, want the symbol", got)
+	}
+
+	setAccessible(t)
+	if got := FocusGlyph.String(); got != "(focus)" {
+		t.Errorf("FocusGlyph = %q, want the plain text", got)
+	}
+	if got := GetPrioritySymbol("high"); got != "(high)" {
+		t.Errorf("GetPrioritySymbol(high) = %q, want (high)", got)
+	}
+	if got := GetPrioritySymbol("normal"); got != "" {
+		t.Errorf("GetPrioritySymbol(normal) = %q, want nothing", got)
+	}
+	if got := RenderTag("area/ui"); got != "#area/ui" {
+		t.Errorf("RenderTag() = %q, want #area/ui", got)
+	}
+	if got := Border(); got != lipgloss.HiddenBorder() {
+		t.Errorf("Border() = %+v, want a hidden border", got)
+	}
+}
+
+func TestRenderBeanRowAccessible(t *testing.T) {
+	setAccessible(t)
+
+	row := RenderBeanRow("abc1", "todo", "bug", "A rather long title for the row", BeanRowConfig{
+		StatusColor:   "green",
+		Priority:      "critical",
+		PriorityColor: "red",
+		ShowCursor:    true,
+		IsSelected:    true,
+		IsFocused:     true,
+		MaxTitleWidth: 30,
+	})
+	if strings.Contains(row, "\x1b[") {
+		t.Errorf("row %q has escape codes", row)
+	}
+	for _, r := range row {
+		if r > 127 {
+			t.Fatalf("row %q has non-ASCII %q", row, r)
+		}
+	}
+	if !strings.HasPrefix(row, ">abc1") || !strings.Contains(row, "(focus) (critical) A rather") {
+		t.Errorf("row = %q, want plain cursor, focus and priority labels", row)
+	}
+	if title := row[strings.Index(row, "(focus)"):]; len(title) > 30 {
+		t.Errorf("title column %q is wider than 30", title)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // Color palette
@@ -86,8 +87,11 @@ var TagBadge = lipgloss.NewStyle().
 	Background(ColorMuted).
 	Padding(0, 1)
 
-// RenderTag renders a single tag as a badge
+// RenderTag renders a single tag as a badge, or as #tag in accessible mode
 func RenderTag(tag string) string {
+	if accessible {
+		return "#" + tag
+	}
 	return TagBadge.Render(tag)
 }
 
@@ -312,9 +316,13 @@ func ShortStatus(s string) string {
 	}
 }
 
-// GetPrioritySymbol returns the raw symbol for a priority without styling.
+// GetPrioritySymbol returns the raw symbol for a priority without styling,
+// or the priority's name in parentheses in accessible mode.
 // Returns empty string for normal/empty priority.
 func GetPrioritySymbol(priority string) string {
+	if accessible && priority != "" && priority != "normal" {
+		return "(" + priority + ")"
+	}
 	switch priority {
 	case "critical":
 		return "‼"
@@ -358,20 +366,25 @@ type BeanRowConfig struct {
 	IsMarked      bool     // Marked for multi-select batch operations
 	Tags          []string // Tags to display (optional)
 	ShowTags      bool     // Whether to show tags column
+	Updated       string   // When the bean was last updated (see FormatTime)
+	ShowUpdated   bool     // Whether to show the updated column
 	TagsColWidth  int      // Width of tags column (0 = default)
 	MaxTags       int      // Max tags to show (0 = default of 1)
 	TreePrefix    string   // Tree prefix (e.g., "├─" or "  └─") to prepend to ID
 	Dimmed        bool     // Render row dimmed (for unmatched ancestor beans in tree)
 	IDColWidth    int      // Width of ID column (0 = default of ColWidthID)
 	UseFullNames  bool     // Use full type/status names instead of single-char abbreviations
+	TitleSuffix   string   // Extra muted text rendered after the title (e.g., point rollups)
+	IsFocused     bool     // Bean is in focus (see beans focus); marked before the title
 }
 
 // Base column widths for bean lists (minimum sizes)
 const (
-	ColWidthID     = 12
-	ColWidthStatus = 3
-	ColWidthType   = 3
-	ColWidthTags   = 24
+	ColWidthID      = 12
+	ColWidthStatus  = 3
+	ColWidthType    = 3
+	ColWidthTags    = 24
+	ColWidthUpdated = 10
 )
 
 // ResponsiveColumns holds calculated column widths based on available space
@@ -380,8 +393,9 @@ type ResponsiveColumns struct {
 	Status            int
 	Type              int
 	Tags              int
-	MaxTags           int  // How many tags to show
+	MaxTags           int // How many tags to show
 	ShowTags          bool
+	ShowUpdated       bool // Show when beans were last updated
 	UseFullTypeStatus bool // Use full names instead of single-char abbreviations
 }
 
@@ -405,6 +419,12 @@ func CalculateResponsiveColumns(totalWidth int, hasTags bool) ResponsiveColumns
 		cols.Type = 10   // "milestone" needs 9 chars
 	}
 
+	// Show when beans were updated once there's room next to a useful title
+	const minWidthForUpdated = 100
+	if totalWidth >= minWidthForUpdated {
+		cols.ShowUpdated = true
+	}
+
 	// Don't show tags in narrow viewports - prioritize title space
 	// Only consider showing tags if terminal is wide enough (140+ columns)
 	const minWidthForTags = 140
@@ -416,7 +436,7 @@ func CalculateResponsiveColumns(totalWidth int, hasTags bool) ResponsiveColumns
 	// At this point we have at least 140 columns
 	// Base usage: cursor (2) + ID + status + type (use responsive widths)
 	cursorWidth := 2
-	baseWidth := cursorWidth + cols.ID + cols.Status + cols.Type
+	baseWidth := cursorWidth + cols.ID + cols.Status + cols.Type + ColWidthUpdated + 1
 	available := totalWidth - baseWidth
 
 	// Reserve generous space for title, then allocate remaining to tags
@@ -452,7 +472,7 @@ func CalculateResponsiveColumns(totalWidth int, hasTags bool) ResponsiveColumns
 	return cols
 }
 
-// RenderBeanRow renders a bean as a single row with ID, Type, Status, Tags (optional), Title
+// RenderBeanRow renders a bean as a single row with ID, Type, Status, Updated (optional), Title, Tags (optional)
 func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string {
 	// Column styles - use responsive widths if provided
 	idColWidth := ColWidthID
@@ -538,6 +558,12 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 		}
 	}
 
+	// Updated column (optional)
+	var updatedCol string
+	if cfg.ShowUpdated {
+		updatedCol = Muted.Render(fmt.Sprintf("%-*s", ColWidthUpdated, cfg.Updated)) + " "
+	}
+
 	// Priority symbol (prepended to title)
 	var prioritySymbol string
 	if !cfg.Dimmed {
@@ -547,17 +573,28 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 		}
 	}
 
+	// Focus marker (prepended to title, before the priority symbol)
+	var focusMarker string
+	if cfg.IsFocused {
+		focusMarker = lipgloss.NewStyle().Foreground(ColorCyan).Bold(true).Render(FocusGlyph.String()) + " "
+	}
+
 	// Title (truncate if needed, accounting for priority symbol width)
 	displayTitle := title
 	titleColWidth := cfg.MaxTitleWidth // Save original for padding
 	maxWidth := cfg.MaxTitleWidth
-	if maxWidth > 0 && prioritySymbol != "" {
-		maxWidth -= 2 // Account for symbol + space
+	if maxWidth > 0 {
+		maxWidth -= lipgloss.Width(prioritySymbol) + lipgloss.Width(focusMarker) // Account for symbol/marker + space
+	}
+	if maxWidth > 0 && cfg.TitleSuffix != "" {
+		maxWidth -= ansi.StringWidth(cfg.TitleSuffix) + 1 // Account for suffix + space
 	}
-	if maxWidth > 3 && len(title) > maxWidth {
-		displayTitle = title[:maxWidth-3] + "..."
-	} else if maxWidth > 0 && maxWidth <= 3 && len(title) > maxWidth {
-		displayTitle = title[:maxWidth]
+	// Truncate by display width, not bytes, so multi-byte and wide
+	// characters are never cut in half
+	if maxWidth > 3 {
+		displayTitle = ansi.Truncate(title, maxWidth, "...")
+	} else if maxWidth > 0 {
+		displayTitle = ansi.Truncate(title, maxWidth, "")
 	}
 
 	// Cursor and title styling
@@ -565,7 +602,7 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 	var titleStyled string
 	if cfg.ShowCursor {
 		if cfg.IsSelected {
-			cursor = lipgloss.NewStyle().Foreground(ColorPrimary).Bold(true).Render("▌")
+			cursor = lipgloss.NewStyle().Foreground(ColorPrimary).Bold(true).Render(CursorGlyph.String())
 			titleStyled = lipgloss.NewStyle().Bold(true).Foreground(ColorPrimary).Render(displayTitle)
 		} else {
 			cursor = " "
@@ -584,18 +621,23 @@ func RenderBeanRow(id, status, typeName, title string, cfg BeanRowConfig) string
 		}
 	}
 
+	if cfg.TitleSuffix != "" {
+		titleStyled += " " + Muted.Render(cfg.TitleSuffix)
+	}
+
 	if cfg.ShowTags {
 		// Pad title column to fixed width so tags align in a column
 		// Calculate padding needed: titleColWidth - (priority symbol width + title length)
-		titleLen := len(displayTitle)
-		if prioritySymbol != "" {
-			titleLen += 2 // symbol + space
+		titleLen := ansi.StringWidth(displayTitle)
+		if cfg.TitleSuffix != "" {
+			titleLen += ansi.StringWidth(cfg.TitleSuffix) + 1
 		}
+		titleLen += lipgloss.Width(prioritySymbol) + lipgloss.Width(focusMarker) // symbol/marker + space
 		padding := ""
 		if titleColWidth > titleLen {
 			padding = strings.Repeat(" ", titleColWidth-titleLen)
 		}
-		return cursor + idCol + " " + typeCol + " " + statusCol + " " + prioritySymbol + titleStyled + padding + " " + tagsCol
+		return cursor + idCol + " " + typeCol + " " + statusCol + " " + updatedCol + focusMarker + prioritySymbol + titleStyled + padding + " " + tagsCol
 	}
-	return cursor + idCol + " " + typeCol + " " + statusCol + " " + prioritySymbol + titleStyled
+	return cursor + idCol + " " + typeCol + " " + statusCol + " " + updatedCol + focusMarker + prioritySymbol + titleStyled
 }
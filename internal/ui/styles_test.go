@@ -1,6 +1,12 @@
 package ui
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/x/ansi"
+)
 
 func TestRenderBeanRow_NarrowWidth(t *testing.T) {
 	// Test that RenderBeanRow doesn't panic with very small MaxTitleWidth values
@@ -131,3 +137,16 @@ func TestShortStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderBeanRow_WideCharacters(t *testing.T) {
+	// Titles are truncated by display width, never in the middle of a
+	// multi-byte character
+	row := RenderBeanRow("abc123", "todo", "task", "日本語のタイトルはとても長いです", BeanRowConfig{MaxTitleWidth: 12})
+	if !utf8.ValidString(row) {
+		t.Fatalf("row %q isn't valid UTF-8", row)
+	}
+	title := row[strings.LastIndex(row, " ")+1:]
+	if w := ansi.StringWidth(title); w > 12 || !strings.HasSuffix(title, "...") {
+		t.Errorf("title %q is %d columns wide, want at most 12 ending in ...", title, w)
+	}
+}
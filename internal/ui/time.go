@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+)
+
+// absoluteDates is whether times are shown as dates (see SetAbsoluteDates).
+var absoluteDates bool
+
+// SetAbsoluteDates switches FormatTime from relative times ("2d ago") to
+// dates ("2006-01-02").
+func SetAbsoluteDates(on bool) {
+	absoluteDates = on
+}
+
+// FormatTime formats t for bean rows and headers: how long before now it
+// was ("just now", "5m ago", "2d ago", "3mo ago"), or its local date when
+// absolute dates are on.
+func FormatTime(t, now time.Time) string {
+	if absoluteDates {
+		return t.Local().Format("2006-01-02")
+	}
+
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d/time.Minute))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d/time.Hour))
+	}
+	days := int(d / (24 * time.Hour))
+	switch {
+	case days < 14:
+		return fmt.Sprintf("%dd ago", days)
+	case days < 60:
+		return fmt.Sprintf("%dw ago", days/7)
+	case days < 365:
+		return fmt.Sprintf("%dmo ago", days/30)
+	}
+	return fmt.Sprintf("%dy ago", days/365)
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTime(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{-time.Hour, "just now"}, // clock skew
+		{30 * time.Second, "just now"},
+		{5 * time.Minute, "5m ago"},
+		{3 * time.Hour, "3h ago"},
+		{49 * time.Hour, "2d ago"},
+		{20 * 24 * time.Hour, "2w ago"},
+		{100 * 24 * time.Hour, "3mo ago"},
+		{800 * 24 * time.Hour, "2y ago"},
+	}
+	for _, tt := range tests {
+		if got := FormatTime(now.Add(-tt.ago), now); got != tt.want {
+			t.Errorf("FormatTime(now - %s) = %q, want %q", tt.ago, got, tt.want)
+		}
+	}
+
+	SetAbsoluteDates(true)
+	defer SetAbsoluteDates(false)
+	created := time.Date(2025, 1, 2, 12, 0, 0, 0, time.Local)
+	if got := FormatTime(created, now); got != "2025-01-02" {
+		t.Errorf("FormatTime() with absolute dates = %q, want 2025-01-02", got)
+	}
+}
@@ -1,7 +1,9 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hmans/beans/internal/bean"
@@ -13,6 +15,9 @@ type TreeNode struct {
 	Bean     *bean.Bean
 	Children []*TreeNode
 	Matched  bool // true if this bean matched the filter (vs. shown for context)
+
+	// Annotation is optional extra text rendered after the title (e.g., point rollups).
+	Annotation string
 }
 
 // TreeNodeJSON is the JSON-serializable version of TreeNode.
@@ -149,11 +154,15 @@ func buildNodes(beans []*bean.Bean, children map[string][]*bean.Bean, matchedSet
 
 // Tree rendering constants
 const (
-	treeBranch     = "├─ "
-	treeLastBranch = "└─ "
-	treePipe       = "│  " // vertical line for ongoing branches
-	treeSpace      = "   " // empty space for completed branches
-	treeIndent     = 3     // width of connector
+	treeSpace  = "   " // empty space for completed branches
+	treeIndent = 3     // width of connector
+)
+
+// Tree connectors, with ASCII stand-ins for accessible mode
+var (
+	treeBranch     = Glyph{"├─ ", "|- "}
+	treeLastBranch = Glyph{"└─ ", "`- "}
+	treePipe       = Glyph{"│  ", "|  "} // vertical line for ongoing branches
 )
 
 // calculateMaxDepth returns the maximum depth of the tree.
@@ -196,6 +205,9 @@ func RenderTree(nodes []*TreeNode, cfg *config.Config, maxIDWidth int, hasTags b
 	if cols.ShowTags {
 		titleWidth -= cols.Tags
 	}
+	if cols.ShowUpdated {
+		titleWidth -= ColWidthUpdated + 1
+	}
 	if titleWidth < 20 {
 		titleWidth = 20
 	}
@@ -206,14 +218,18 @@ func RenderTree(nodes []*TreeNode, cfg *config.Config, maxIDWidth int, hasTags b
 	typeHeader := headerCol.Render("T") + strings.Repeat(" ", ColWidthType-1)
 	statusHeader := headerCol.Render("S") + strings.Repeat(" ", ColWidthStatus-1)
 
-	header := idHeader + typeHeader + statusHeader + headerCol.Render("TITLE")
+	header := idHeader + typeHeader + statusHeader
+	if cols.ShowUpdated {
+		header += headerCol.Render("UPDATED") + strings.Repeat(" ", ColWidthUpdated+1-len("UPDATED"))
+	}
+	header += headerCol.Render("TITLE")
 	if cols.ShowTags && titleWidth > 5 {
 		header += strings.Repeat(" ", titleWidth-5+3) + headerCol.Render("TAGS") // +3 for priority/spacing
 	}
 	dividerWidth := termWidth - 1 // -1 to avoid wrapping on exact terminal width
 	sb.WriteString(header)
 	sb.WriteString("\n")
-	sb.WriteString(Muted.Render(strings.Repeat("─", dividerWidth)))
+	sb.WriteString(Muted.Render(strings.Repeat(RuleGlyph.String(), dividerWidth)))
 	sb.WriteString("\n")
 
 	// Build render config from responsive columns
@@ -221,6 +237,7 @@ func RenderTree(nodes []*TreeNode, cfg *config.Config, maxIDWidth int, hasTags b
 		treeColWidth: treeColWidth,
 		titleWidth:   titleWidth,
 		cols:         cols,
+		now:          time.Now(),
 	}
 
 	// Render nodes (depth 0 = root level, no ancestry yet)
@@ -234,6 +251,7 @@ type treeRenderConfig struct {
 	treeColWidth int
 	titleWidth   int
 	cols         ResponsiveColumns
+	now          time.Time // for relative updated times
 }
 
 // renderNodes recursively renders tree nodes with proper indentation.
@@ -267,19 +285,24 @@ func renderNode(sb *strings.Builder, node *TreeNode, depth int, isLast bool, anc
 			if wasLast {
 				prefix += treeSpace
 			} else {
-				prefix += treePipe
+				prefix += treePipe.String()
 			}
 		}
 		if isLast {
-			prefix += treeLastBranch
+			prefix += treeLastBranch.String()
 		} else {
-			prefix += treeBranch
+			prefix += treeBranch.String()
 		}
 	}
 
 	// Get colors from config
 	colors := cfg.GetBeanColors(b.Status, b.Type, b.Priority)
 
+	var updated string
+	if b.UpdatedAt != nil {
+		updated = FormatTime(*b.UpdatedAt, renderCfg.now)
+	}
+
 	// Use shared RenderBeanRow function with responsive columns
 	row := RenderBeanRow(b.ID, b.Status, b.Type, b.Title, BeanRowConfig{
 		StatusColor:   colors.StatusColor,
@@ -291,17 +314,30 @@ func renderNode(sb *strings.Builder, node *TreeNode, depth int, isLast bool, anc
 		ShowCursor:    false,
 		Tags:          b.Tags,
 		ShowTags:      renderCfg.cols.ShowTags,
+		Updated:       updated,
+		ShowUpdated:   renderCfg.cols.ShowUpdated,
 		TagsColWidth:  renderCfg.cols.Tags,
 		MaxTags:       renderCfg.cols.MaxTags,
 		TreePrefix:    prefix,
 		Dimmed:        !node.Matched,
 		IDColWidth:    renderCfg.treeColWidth,
+		TitleSuffix:   node.Annotation,
 	})
 
 	sb.WriteString(row)
 	sb.WriteString("\n")
 }
 
+// ChecklistProgress formats a bean's checklist progress for bean rows,
+// e.g. "[2/5]", or returns "" if its body has no checklist.
+func ChecklistProgress(b *bean.Bean) string {
+	checklist := b.Checklist()
+	if checklist.Total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("[%d/%d]", checklist.Done, checklist.Total)
+}
+
 // FlatItem represents a flattened tree node with rendering context.
 // Used by TUI to render tree structure in a flat list.
 type FlatItem struct {
@@ -334,14 +370,14 @@ func flattenNodes(nodes []*TreeNode, depth int, ancestry []bool, items *[]FlatIt
 				if wasLast {
 					prefix += treeSpace // parent was last child, no continuation line
 				} else {
-					prefix += treePipe // parent has more siblings, show continuation line
+					prefix += treePipe.String() // parent has more siblings, show continuation line
 				}
 			}
 			// Add connector for this node
 			if isLast {
-				prefix += treeLastBranch
+				prefix += treeLastBranch.String()
 			} else {
-				prefix += treeBranch
+				prefix += treeBranch.String()
 			}
 		}
 
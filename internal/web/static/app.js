@@ -0,0 +1,202 @@
+// beans web UI: a board, a tree and a detail view over the GraphQL API.
+// Views are picked by the URL hash (#/board, #/tree, #/bean/<id>) and are
+// re-rendered whenever the bean set's generation changes.
+"use strict";
+
+const LIST_QUERY = `{
+  generation
+  beans { id title status type priority tags parentId updatedAt }
+}`;
+
+const DETAIL_QUERY = `query($id: ID!) {
+  bean(id: $id) {
+    id title status type priority tags body createdAt updatedAt
+    parent { id title status }
+    children { id title status }
+    blockedBy { id title status }
+    blocking { id title status }
+  }
+}`;
+
+let settings = { title: "beans", columns: [], auth: false };
+let beans = [];
+let generation = -1;
+
+const view = document.getElementById("view");
+const filterInput = document.getElementById("filter");
+
+async function graphql(query, variables) {
+  const headers = { "Content-Type": "application/json" };
+  const token = localStorage.getItem("beans-token");
+  if (token) headers.Authorization = "Bearer " + token;
+
+  const res = await fetch("graphql", {
+    method: "POST",
+    headers,
+    body: JSON.stringify({ query, variables }),
+  });
+  if (res.status === 401 && settings.auth) {
+    const entered = prompt("This beans server needs an API token:");
+    if (!entered) throw new Error("not authenticated");
+    localStorage.setItem("beans-token", entered);
+    return graphql(query, variables);
+  }
+  const json = await res.json();
+  if (json.errors && json.errors.length) throw new Error(json.errors[0].message);
+  return json.data;
+}
+
+function el(tag, attrs, ...children) {
+  const node = document.createElement(tag);
+  for (const [key, value] of Object.entries(attrs || {})) {
+    if (key === "class") node.className = value;
+    else node.setAttribute(key, value);
+  }
+  for (const child of children.flat()) {
+    if (child == null) continue;
+    node.append(child instanceof Node ? child : String(child));
+  }
+  return node;
+}
+
+function statusClass(status) {
+  const column = settings.columns.find((c) => c.status === status);
+  return column ? "status-" + column.color : "";
+}
+
+function beanLink(b, cls) {
+  return el("a", { href: "#/bean/" + encodeURIComponent(b.id), class: cls || "" }, b.title);
+}
+
+function tags(b) {
+  return (b.tags || []).map((t) => el("span", { class: "tag" }, t));
+}
+
+function matches(b) {
+  const q = filterInput.value.trim().toLowerCase();
+  if (!q) return true;
+  return b.title.toLowerCase().includes(q) ||
+    b.id.toLowerCase().includes(q) ||
+    (b.tags || []).some((t) => t.includes(q));
+}
+
+function renderBoard() {
+  const visible = beans.filter(matches);
+  const statuses = settings.columns.length
+    ? settings.columns.map((c) => c.status)
+    : [...new Set(beans.map((b) => b.status))];
+
+  return el("div", { class: "board" }, statuses.map((status) => {
+    const cards = visible
+      .filter((b) => b.status === status)
+      .sort((a, b) => b.updatedAt.localeCompare(a.updatedAt))
+      .map((b) => el("a", { href: "#/bean/" + encodeURIComponent(b.id), class: "card " + statusClass(b.status) },
+        el("div", {}, b.title),
+        el("div", { class: "meta" }, el("span", { class: "id" }, b.id), " · ", b.type, " · ", b.priority),
+        tags(b)));
+    return el("section", { class: "column" }, el("h2", {}, status, " (", cards.length, ")"), cards);
+  }));
+}
+
+function renderTree() {
+  const ids = new Set(beans.map((b) => b.id));
+  const children = new Map();
+  for (const b of beans) {
+    const parent = b.parentId && ids.has(b.parentId) ? b.parentId : "";
+    if (!children.has(parent)) children.set(parent, []);
+    children.get(parent).push(b);
+  }
+
+  // A bean is shown if it or any of its descendants matches the filter.
+  const shown = new Map();
+  const visit = (b) => {
+    let show = matches(b);
+    for (const child of children.get(b.id) || []) show = visit(child) || show;
+    shown.set(b.id, show);
+    return show;
+  };
+  (children.get("") || []).forEach(visit);
+
+  const list = (parent) => {
+    const items = (children.get(parent) || [])
+      .filter((b) => shown.get(b.id))
+      .sort((a, b) => a.title.localeCompare(b.title))
+      .map((b) => el("li", {},
+        el("span", { class: "status" }, b.status),
+        beanLink(b), " ", el("span", { class: "id" }, b.id), " ", tags(b),
+        list(b.id)));
+    return items.length ? el("ul", {}, items) : null;
+  };
+  return el("div", { class: "tree" }, list("") || el("p", { class: "meta" }, "No beans."));
+}
+
+function relatedList(label, related) {
+  if (!related || (Array.isArray(related) && !related.length)) return [];
+  const items = [].concat(related).map((b) => el("div", {}, beanLink(b), " ", el("span", { class: "meta" }, b.status)));
+  return [el("dt", {}, label), el("dd", {}, items)];
+}
+
+async function renderDetail(id) {
+  const data = await graphql(DETAIL_QUERY, { id });
+  const b = data.bean;
+  if (!b) return el("p", { class: "error" }, "Bean ", id, " not found.");
+  return el("article", { class: "detail" },
+    el("h2", {}, b.title),
+    el("dl", {},
+      el("dt", {}, "ID"), el("dd", { class: "id" }, b.id),
+      el("dt", {}, "Status"), el("dd", {}, b.status),
+      el("dt", {}, "Type"), el("dd", {}, b.type),
+      el("dt", {}, "Priority"), el("dd", {}, b.priority),
+      b.tags.length ? [el("dt", {}, "Tags"), el("dd", {}, tags(b))] : [],
+      el("dt", {}, "Created"), el("dd", {}, new Date(b.createdAt).toLocaleString()),
+      el("dt", {}, "Updated"), el("dd", {}, new Date(b.updatedAt).toLocaleString()),
+      relatedList("Parent", b.parent),
+      relatedList("Children", b.children),
+      relatedList("Blocked by", b.blockedBy),
+      relatedList("Blocking", b.blocking)),
+    b.body ? el("div", { class: "body" }, b.body) : null);
+}
+
+async function render() {
+  const [route, arg] = location.hash.replace(/^#\/?/, "").split("/");
+  for (const link of document.querySelectorAll("nav a")) {
+    link.classList.toggle("active", link.dataset.view === (route || "board"));
+  }
+  filterInput.hidden = route === "bean";
+
+  let content;
+  try {
+    if (route === "bean") content = await renderDetail(decodeURIComponent(arg || ""));
+    else if (route === "tree") content = renderTree();
+    else content = renderBoard();
+  } catch (err) {
+    content = el("p", { class: "error" }, err.message);
+  }
+  view.replaceChildren(content);
+}
+
+async function refresh() {
+  try {
+    const data = await graphql(LIST_QUERY);
+    if (data.generation === generation) return;
+    generation = data.generation;
+    beans = data.beans;
+    await render();
+  } catch (err) {
+    view.replaceChildren(el("p", { class: "error" }, err.message));
+  }
+}
+
+async function start() {
+  const res = await fetch("config.json");
+  if (res.ok) settings = await res.json();
+  document.getElementById("title").textContent = settings.title || "beans";
+  document.title = (settings.title ? settings.title + " · " : "") + "beans";
+
+  window.addEventListener("hashchange", render);
+  filterInput.addEventListener("input", render);
+  await refresh();
+  setInterval(refresh, 5000);
+}
+
+start();
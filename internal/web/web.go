@@ -0,0 +1,54 @@
+// Package web serves a small browser UI for beans: a board, a tree and a
+// detail view, all backed by the GraphQL API at /graphql.
+package web
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// Column is a board column, one per status.
+type Column struct {
+	Status  string `json:"status"`
+	Color   string `json:"color"`
+	Archive bool   `json:"archive"`
+}
+
+// Options configures the UI.
+type Options struct {
+	// Title is shown in the page header, usually the project name.
+	Title string `json:"title"`
+	// Columns are the board columns, in display order.
+	Columns []Column `json:"columns"`
+	// Auth tells the UI that the API wants a bearer token, which it asks for.
+	Auth bool `json:"auth"`
+	// ReadOnly tells the UI that the API rejects mutations.
+	ReadOnly bool `json:"readOnly"`
+}
+
+// Handler returns a handler serving the UI's static files, plus its settings
+// as JSON at config.json.
+func Handler(opts Options) http.Handler {
+	files, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err)
+	}
+	settings, err := json.Marshal(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/config.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-cache")
+		_, _ = w.Write(settings)
+	})
+	mux.Handle("/", http.FileServer(http.FS(files)))
+	return mux
+}
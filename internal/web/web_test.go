@@ -0,0 +1,43 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	h := Handler(Options{Title: "demo", Columns: []Column{{Status: "todo", Color: "green"}}, Auth: true})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	for path, want := range map[string]string{
+		"/":          "<script src=\"app.js\">",
+		"/app.js":    "LIST_QUERY",
+		"/style.css": ".board",
+	} {
+		rec := get(path)
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("GET %s = %d, body missing %q", path, rec.Code, want)
+		}
+	}
+
+	rec := get("/config.json")
+	var got Options
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decoding config.json %q: %v", rec.Body.String(), err)
+	}
+	if got.Title != "demo" || !got.Auth || len(got.Columns) != 1 || got.Columns[0].Status != "todo" {
+		t.Errorf("config.json = %+v", got)
+	}
+
+	if rec := get("/missing.js"); rec.Code != http.StatusNotFound {
+		t.Errorf("GET /missing.js = %d, want 404", rec.Code)
+	}
+}